@@ -17,18 +17,26 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"flag"
 	"strconv"
 	"strings"
+	"time"
 
+	gosarama "github.com/Shopify/sarama"
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
 	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/health"
 	commonk8s "knative.dev/eventing-kafka/pkg/channel/distributed/common/k8s"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/metrics"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/controller"
@@ -37,10 +45,12 @@ import (
 	dispatcherhealth "knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/health"
 	"knative.dev/eventing-kafka/pkg/client/clientset/versioned"
 	"knative.dev/eventing-kafka/pkg/client/informers/externalversions"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	kncontroller "knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	eventingmetrics "knative.dev/pkg/metrics"
 	"knative.dev/pkg/signals"
+	"knative.dev/pkg/system"
 )
 
 // Variables
@@ -76,12 +86,73 @@ func main() {
 		logger.Fatal("Failed To Load Sarama Settings", zap.Error(err))
 	}
 
+	// Set The Kafka Client ID Template & Derive This KafkaChannel's Dispatcher Client ID (For Broker-Side Quotas & Request Logs)
+	err = kafkautil.SetClientIdTemplate(ekConfig.Kafka.ClientIdTemplate)
+	if err != nil {
+		logger.Fatal("Invalid Kafka Client ID Template - Terminating", zap.Error(err))
+	}
+	channelNamespace, channelName, err := cache.SplitMetaNamespaceKey(environment.ChannelKey)
+	if err != nil {
+		logger.Fatal("Invalid KafkaChannel Key - Terminating", zap.String("ChannelKey", environment.ChannelKey), zap.Error(err))
+	}
+	clientId := kafkautil.ClientId(channelNamespace, channelName, kafkaconstants.ClientIdRoleDispatcher)
+
 	// Update The Sarama Config - Username/Password Overrides (EnvVars From Secret Take Precedence Over ConfigMap)
-	sarama.UpdateSaramaConfig(saramaConfig, constants.Component, environment.KafkaUsername, environment.KafkaPassword)
+	sarama.UpdateSaramaConfig(saramaConfig, clientId, environment.KafkaUsername, environment.KafkaPassword)
+
+	// Update The Sarama Config - SASL Mechanism Override (EnvVar From Secret Takes Precedence Over ConfigMap)
+	err = sarama.UpdateSaramaConfigSaslMechanism(saramaConfig, environment.KafkaSaslMechanism)
+	if err != nil {
+		logger.Fatal("Invalid Kafka SASL Mechanism - Terminating", zap.Error(err))
+	}
+
+	// Update The Sarama Config - mTLS Client Certificate (EnvVars From Secret)
+	tlsCertificate, err := sarama.ParseTLSCertificate(environment.KafkaTLSCert, environment.KafkaTLSKey)
+	if err != nil {
+		logger.Fatal("Invalid Kafka TLS Client Certificate - Terminating", zap.Error(err))
+	} else if tlsCertificate != nil {
+		sarama.UpdateSaramaConfigTLSCertificates(saramaConfig, []tls.Certificate{*tlsCertificate})
+	}
+
+	// Update The Sarama Config - TLS Enable Override (EnvVar From Secret Takes Precedence Over ConfigMap)
+	err = sarama.UpdateSaramaConfigTLSEnable(saramaConfig, environment.KafkaTLSEnable)
+	if err != nil {
+		logger.Fatal("Invalid Kafka TLS Enable - Terminating", zap.Error(err))
+	}
+
+	// Update The Sarama Config - Per-Channel Consumer Config Overrides (EnvVar From KafkaChannel Annotations, Ignored If Invalid)
+	err = sarama.UpdateSaramaConfigConsumerOverrides(saramaConfig, environment.KafkaConsumerConfigOverrides)
+	if err != nil {
+		logger.Error("Invalid Kafka Consumer Config Overrides - Ignoring", zap.Any("Overrides", environment.KafkaConsumerConfigOverrides), zap.Error(err))
+	}
+
+	// Update The Sarama Config - Offset Commit Strategy & Interval
+	sarama.UpdateSaramaConfigOffsetCommit(saramaConfig, ekConfig.Dispatcher.OffsetCommit)
 
 	// Enable Sarama Logging If Specified In ConfigMap
 	sarama.EnableSaramaLogging(ekConfig.Kafka.EnableSaramaLogging)
 
+	// Set The Kafka ConsumerGroup Name Template (Must Remain Consistent Across Restarts To Retain Committed Offsets)
+	err = kafkautil.SetConsumerGroupIdTemplate(ekConfig.Kafka.ConsumerGroupNameTemplate)
+	if err != nil {
+		logger.Fatal("Invalid Kafka ConsumerGroup Name Template - Terminating", zap.Error(err))
+	}
+
+	// Update The Sarama Config - Kafka Rack ID (Explicit EnvVar Or Derived From The Node's Zone) For Fetching From The Closest Replica
+	rackId := environment.KafkaRackId
+	if len(rackId) <= 0 && len(environment.NodeName) > 0 {
+		rackId, err = commonk8s.NodeZone(ctx, environment.NodeName)
+		if err != nil {
+			logger.Warn("Failed To Determine Kafka Rack ID From Node Zone - Continuing Without", zap.String("Node", environment.NodeName), zap.Error(err))
+		}
+	}
+	if len(rackId) > 0 {
+		logger.Info("Setting Kafka Rack ID", zap.String("RackId", rackId))
+		if !sarama.UpdateSaramaConfigRackId(saramaConfig, rackId) {
+			logger.Warn("Kafka Rack ID Requires Kafka Version 2.3.0 Or Later - Will Be Ignored", zap.String("Version", saramaConfig.Version.String()))
+		}
+	}
+
 	// Initialize Tracing (Watches config-tracing ConfigMap, Assumes Context Came From LoggingContext With Embedded K8S Client Key)
 	err = commonconfig.InitializeTracing(logger.Sugar(), ctx, environment.ServiceName)
 	if err != nil {
@@ -96,21 +167,42 @@ func main() {
 
 	// Start The Liveness And Readiness Servers
 	healthServer := dispatcherhealth.NewDispatcherHealthServer(strconv.Itoa(environment.HealthPort))
+	healthServer.HandleFunc(health.SaramaConfigDebugPath, sarama.NewSaramaConfigDebugHandler(logger, effectiveSaramaConfig))
 	healthServer.Start(logger)
 
 	statsReporter := metrics.NewStatsReporter(logger)
 
+	// Resolve The Kafka Brokers (From The DNS SRV Record If Configured, Otherwise The Kafka Secret)
+	brokerResolver := sarama.NewBrokerResolver(logger, ekConfig.Kafka.BrokerDiscovery, strings.Split(environment.KafkaBrokers, ","))
+
 	// Create The Dispatcher With Specified Configuration
 	dispatcherConfig := dispatch.DispatcherConfig{
 		Logger:        logger,
-		ClientId:      constants.Component,
-		Brokers:       strings.Split(environment.KafkaBrokers, ","),
+		ClientId:      clientId,
+		Brokers:       brokerResolver.Brokers(),
 		Topic:         environment.KafkaTopic,
 		Username:      environment.KafkaUsername,
 		Password:      environment.KafkaPassword,
 		ChannelKey:    environment.ChannelKey,
 		StatsReporter: statsReporter,
 		SaramaConfig:  saramaConfig,
+		TLSEnable:     environment.KafkaTLSEnable,
+
+		ConsumerConfigOverrides:    environment.KafkaConsumerConfigOverrides,
+		SubscriberConcurrency:      environment.KafkaSubscriberConcurrency,
+		SubscriberOrdering:         environment.KafkaSubscriberOrdering,
+		SubscriberDeadLetterTopics: environment.KafkaSubscriberDeadLetterTopics,
+		SubscriberInitialOffsets:   environment.KafkaSubscriberInitialOffsets,
+		SubscriberFilters:          environment.KafkaSubscriberFilters,
+		ExtensionFilter:            environment.KafkaExtensionFilter,
+		ReplayFromTimestamp:        environment.KafkaReplayFromTimestamp,
+		DrainTimeout:               time.Duration(environment.DrainTimeoutSeconds) * time.Second,
+		KafkaExtensions:            ekConfig.Dispatcher.EnableKafkaExtensions,
+		OffsetCommit:               ekConfig.Dispatcher.OffsetCommit,
+		MaxInFlight:                ekConfig.Dispatcher.MaxInFlight,
+		ConfigMapsClient:           kubeclient.Get(ctx).CoreV1(),
+		OffsetConfigMapNamespace:   system.Namespace(),
+		OffsetConfigMapName:        environment.ServiceName + constants.OffsetConfigMapNameSuffix,
 	}
 	dispatcher = dispatch.NewDispatcher(dispatcherConfig)
 
@@ -120,6 +212,12 @@ func main() {
 		logger.Fatal("Failed To Initialize ConfigMap Watcher", zap.Error(err))
 	}
 
+	// Watch The Root CA ConfigMap (If Referenced) For CA Rotation
+	err = commonconfig.InitializeRootCAConfigWatcher(ctx, logger.Sugar(), ekConfig, rootCAConfigMapObserver)
+	if err != nil {
+		logger.Fatal("Failed To Initialize Root CA ConfigMap Watcher", zap.Error(err))
+	}
+
 	config, err := clientcmd.BuildConfigFromFlags(*serverURL, *kubeconfig)
 	if err != nil {
 		logger.Fatal("Error building kubeconfig", zap.Error(err))
@@ -160,6 +258,15 @@ func main() {
 	healthServer.SetAlive(true)
 	healthServer.SetDispatcherReady(true)
 
+	// Periodically Re-Resolve The Kafka Brokers (If Discovered Via DNS SRV Record)
+	go brokerResolver.Run(ctx, brokersObserver)
+
+	// Periodically Verify Kafka Connectivity For The Kafka Readiness (/readyz) Endpoint
+	go monitorKafkaReadiness(ctx, healthServer, time.Duration(environment.KafkaReadinessIntervalSeconds)*time.Second)
+
+	// Periodically Record The Consumer Lag Of Each Subscription For The kafka_channel_consumer_lag Metric
+	go monitorConsumerLag(ctx, time.Duration(environment.ConsumerLagIntervalSeconds)*time.Second)
+
 	// Start The Controllers (Blocking WaitGroup.Wait Call)
 	logger.Info("Starting controllers.")
 	kncontroller.StartAll(ctx, controllers[:]...)
@@ -167,7 +274,7 @@ func main() {
 	// Reset The Liveness and Readiness Flags In Preparation For Shutdown
 	healthServer.Shutdown()
 
-	// Shutdown The Dispatcher (Close ConsumerGroups)
+	// Shutdown The Dispatcher (Drain In-Flight Deliveries, Commit Offsets & Close ConsumerGroups)
 	dispatcher.Shutdown()
 
 	// Stop The Liveness And Readiness Servers
@@ -179,6 +286,42 @@ func flush(logger *zap.Logger) {
 	eventingmetrics.FlushExporter()
 }
 
+// Update The Health Server's Kafka Readiness Flag From The (Current) Dispatcher At The Specified Interval Until Done
+func monitorKafkaReadiness(ctx context.Context, healthServer *dispatcherhealth.Server, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		healthServer.SetKafkaReady(dispatcher.KafkaReady())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Get The Effective Sarama Config Of The (Current) Dispatcher For The Sarama Config Debug Endpoint (Nil During Startup)
+func effectiveSaramaConfig() *gosarama.Config {
+	if dispatcher == nil {
+		return nil
+	}
+	return dispatcher.EffectiveSaramaConfig()
+}
+
+// Record The Consumer Lag Via The (Current) Dispatcher At The Specified Interval Until Done
+func monitorConsumerLag(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			dispatcher.ReportConsumerLag()
+		}
+	}
+}
+
 // configMapObserver is the callback function that handles changes to our ConfigMap
 func configMapObserver(configMap *v1.ConfigMap) {
 	if configMap == nil {
@@ -199,3 +342,47 @@ func configMapObserver(configMap *v1.ConfigMap) {
 		dispatcher = newDispatcher
 	}
 }
+
+// rootCAConfigMapObserver is the callback function that handles changes to the Root CA ConfigMap
+func rootCAConfigMapObserver(configMap *v1.ConfigMap) {
+	if configMap == nil {
+		logger.Warn("Nil ConfigMap passed to rootCAConfigMapObserver; ignoring")
+		return
+	}
+
+	// Update The Root CAs From The ConfigMap (Ignoring Unchanged Or Invalid CAs)
+	changed, err := sarama.UpdateRootCAConfigMap(configMap)
+	if err != nil {
+		logger.Error("Invalid Root CA ConfigMap; ignoring", zap.Error(err))
+		return
+	} else if !changed {
+		logger.Debug("No Root CA Changes Detected In ConfigMap; ignoring")
+		return
+	}
+	if dispatcher == nil {
+		// This typically happens during startup
+		logger.Info("Dispatcher is nil during call to rootCAConfigMapObserver; ignoring changes")
+		return
+	}
+
+	// Recreate The Dispatcher With The Rotated Root CAs
+	newDispatcher := dispatcher.RootCAsChanged(sarama.RootCAConfigMapPool())
+	if newDispatcher != nil {
+		dispatcher = newDispatcher
+	}
+}
+
+// brokersObserver is the callback function that handles changes to the Kafka brokers resolved from a DNS SRV record
+func brokersObserver(brokers []string) {
+	if dispatcher == nil {
+		// This typically happens during startup
+		logger.Info("Dispatcher is nil during call to brokersObserver; ignoring changes")
+		return
+	}
+
+	// Recreate The Dispatcher With The Re-Resolved Brokers
+	newDispatcher := dispatcher.BrokersChanged(brokers)
+	if newDispatcher != nil {
+		dispatcher = newDispatcher
+	}
+}
@@ -18,10 +18,12 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"flag"
 	nethttp "net/http"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/cloudevents/sdk-go/v2/binding"
 	"github.com/google/uuid"
@@ -29,6 +31,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
 	commonk8s "knative.dev/eventing-kafka/pkg/channel/distributed/common/k8s"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
 	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/metrics"
@@ -38,9 +41,11 @@ import (
 	channelhealth "knative.dev/eventing-kafka/pkg/channel/distributed/receiver/health"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/receiver/producer"
 	eventingchannel "knative.dev/eventing/pkg/channel"
+	"knative.dev/eventing/pkg/kncloudevents"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
 	eventingmetrics "knative.dev/pkg/metrics"
+	"knative.dev/pkg/system"
 )
 
 // Variables
@@ -49,6 +54,7 @@ var (
 	serverURL     = flag.String("server", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
 	kubeconfig    = flag.String("kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
 	kafkaProducer *producer.Producer
+	extensions    atomic.Value // The Default CloudEvent Extension Allow / Deny Lists (commonconfig.EKExtensionsConfig)
 )
 
 // The Main Function (Go Command)
@@ -79,12 +85,60 @@ func main() {
 		logger.Fatal("Failed To Load Sarama Settings", zap.Error(err))
 	}
 
+	// Initialize The Default CloudEvent Extension Allow / Deny Lists (Updated By The configMapObserver)
+	extensions.Store(ekConfig.Kafka.Extensions)
+
+	// Set The Kafka Client ID Template & Derive The Receiver's Producer Client ID (Shared By All KafkaChannels Of The Kafka Secret)
+	err = kafkautil.SetClientIdTemplate(ekConfig.Kafka.ClientIdTemplate)
+	if err != nil {
+		logger.Fatal("Invalid Kafka Client ID Template - Terminating", zap.Error(err))
+	}
+	clientId := kafkautil.ClientId(system.Namespace(), environment.ServiceName, kafkaconstants.ClientIdRoleProducer)
+
 	// Update The Sarama Config - Username/Password Overrides (EnvVars From Secret Take Precedence Over ConfigMap)
-	sarama.UpdateSaramaConfig(saramaConfig, constants.Component, environment.KafkaUsername, environment.KafkaPassword)
+	sarama.UpdateSaramaConfig(saramaConfig, clientId, environment.KafkaUsername, environment.KafkaPassword)
+
+	// Update The Sarama Config - SASL Mechanism Override (EnvVar From Secret Takes Precedence Over ConfigMap)
+	err = sarama.UpdateSaramaConfigSaslMechanism(saramaConfig, environment.KafkaSaslMechanism)
+	if err != nil {
+		logger.Fatal("Invalid Kafka SASL Mechanism - Terminating", zap.Error(err))
+	}
+
+	// Update The Sarama Config - mTLS Client Certificate (EnvVars From Secret)
+	tlsCertificate, err := sarama.ParseTLSCertificate(environment.KafkaTLSCert, environment.KafkaTLSKey)
+	if err != nil {
+		logger.Fatal("Invalid Kafka TLS Client Certificate - Terminating", zap.Error(err))
+	} else if tlsCertificate != nil {
+		sarama.UpdateSaramaConfigTLSCertificates(saramaConfig, []tls.Certificate{*tlsCertificate})
+	}
+
+	// Update The Sarama Config - TLS Enable Override (EnvVar From Secret Takes Precedence Over ConfigMap)
+	err = sarama.UpdateSaramaConfigTLSEnable(saramaConfig, environment.KafkaTLSEnable)
+	if err != nil {
+		logger.Fatal("Invalid Kafka TLS Enable - Terminating", zap.Error(err))
+	}
+
+	// Update The Sarama Config - Producer RequiredAcks Override (Must Be WaitForAll If Idempotent)
+	err = sarama.UpdateSaramaConfigRequiredAcks(saramaConfig, ekConfig.Kafka.RequiredAcks, ekConfig.Kafka.EnableIdempotentProducer)
+	if err != nil {
+		logger.Fatal("Invalid Producer RequiredAcks Configuration - Terminating", zap.Error(err))
+	}
+
+	// Update The Sarama Config - Idempotent Producer (Forces The Dependent Acks / In-Flight Settings)
+	err = sarama.UpdateSaramaConfigIdempotentProducer(saramaConfig, ekConfig.Kafka.EnableIdempotentProducer)
+	if err != nil {
+		logger.Fatal("Invalid Idempotent Producer Configuration - Terminating", zap.Error(err))
+	}
 
 	// Enable Sarama Logging If Specified In ConfigMap
 	sarama.EnableSaramaLogging(ekConfig.Kafka.EnableSaramaLogging)
 
+	// Set The Kafka Topic Name Template (Must Match The Controller's In Order To Produce To The Correct Topics)
+	err = kafkautil.SetTopicNameTemplate(ekConfig.Kafka.TopicNameTemplate)
+	if err != nil {
+		logger.Fatal("Invalid Kafka Topic Name Template - Terminating", zap.Error(err))
+	}
+
 	// Initialize Tracing (Watches config-tracing ConfigMap, Assumes Context Came From LoggingContext With Embedded K8S Client Key)
 	err = commonconfig.InitializeTracing(logger.Sugar(), ctx, environment.ServiceName)
 	if err != nil {
@@ -117,13 +171,26 @@ func main() {
 		logger.Fatal("Failed To Initialize ConfigMap Watcher", zap.Error(err))
 	}
 
+	// Watch The Root CA ConfigMap (If Referenced) For CA Rotation
+	err = commonconfig.InitializeRootCAConfigWatcher(ctx, logger.Sugar(), ekConfig, rootCAConfigMapObserver)
+	if err != nil {
+		logger.Fatal("Failed To Initialize Root CA ConfigMap Watcher", zap.Error(err))
+	}
+
+	// Resolve The Kafka Brokers (From The DNS SRV Record If Configured, Otherwise The Kafka Secret)
+	brokerResolver := sarama.NewBrokerResolver(logger, ekConfig.Kafka.BrokerDiscovery, strings.Split(environment.KafkaBrokers, ","))
+
 	// Initialize The Kafka Producer In Order To Start Processing Status Events
-	kafkaProducer, err = producer.NewProducer(logger, saramaConfig, strings.Split(environment.KafkaBrokers, ","), statsReporter, healthServer)
+	kafkaProducer, err = producer.NewProducer(logger, saramaConfig, brokerResolver.Brokers(), statsReporter, healthServer)
 	if err != nil {
 		logger.Fatal("Failed To Initialize Kafka Producer", zap.Error(err))
 	}
+	kafkaProducer.SetTLSEnable(environment.KafkaTLSEnable)
 	defer kafkaProducer.Close()
 
+	// Periodically Re-Resolve The Kafka Brokers (If Discovered Via DNS SRV Record)
+	go brokerResolver.Run(ctx, brokersObserver)
+
 	channelReporter := eventingchannel.NewStatsReporter(environment.ContainerName, kmeta.ChildName(environment.PodName, uuid.New().String()))
 
 	// Create A New Knative Eventing MessageReceiver (Parses The Channel From The Host Header)
@@ -135,8 +202,8 @@ func main() {
 	// Set The Liveness Flag - Readiness Is Set By Individual Components
 	healthServer.SetAlive(true)
 
-	// Start The Message Receiver (Blocking)
-	err = messageReceiver.Start(ctx)
+	// Start The Message Receiver (Blocking) - Responding With The Status Code Appropriate To Any Kafka Produce Error
+	err = kncloudevents.NewHTTPMessageReceiver(constants.HttpPort).StartListen(ctx, producer.NewProduceErrorStatusHandler(messageReceiver))
 	if err != nil {
 		logger.Error("Failed To Start MessageReceiver", zap.Error(err))
 	}
@@ -171,6 +238,22 @@ func handleMessage(ctx context.Context, channelReference eventingchannel.Channel
 		return err
 	}
 
+	// Select The KafkaChannel's Partition Key CloudEvent Attribute (If Annotated)
+	ctx = producer.WithPartitionKeyAttribute(ctx, channel.PartitionKeyAttribute(channelReference))
+
+	// Produce The Event With The KafkaChannel's Required Acks (If Annotated)
+	ctx = producer.WithRequiredAcks(ctx, channel.RequiredAcks(channelReference))
+
+	// Encode The Produced Event In The KafkaChannel's CloudEvent Content Mode (If Annotated)
+	ctx = producer.WithContentMode(ctx, channel.ContentMode(channelReference))
+
+	// Strip The CloudEvent Extensions Not Allowed By The KafkaChannel (If Annotated) Or The Default Allow / Deny Lists
+	defaultExtensions, _ := extensions.Load().(commonconfig.EKExtensionsConfig)
+	ctx = producer.WithExtensionFilter(ctx, channel.ExtensionFilter(channelReference, defaultExtensions.Allow, defaultExtensions.Deny))
+
+	// Limit The Size Of The Produced Event To The KafkaChannel's Max Message Bytes (If Annotated)
+	ctx = producer.WithMaxMessageBytes(ctx, channel.MaxMessageBytes(channelReference))
+
 	// Produce The CloudEvent Binding Message (Send To The Appropriate Kafka Topic)
 	err = kafkaProducer.ProduceKafkaMessage(ctx, channelReference, message, transformers...)
 	if err != nil {
@@ -188,6 +271,11 @@ func configMapObserver(configMap *v1.ConfigMap) {
 		logger.Warn("Nil ConfigMap passed to configMapObserver; ignoring")
 		return
 	}
+
+	// Update The Default CloudEvent Extension Allow / Deny Lists (Ignoring Invalid Config)
+	if ekConfig, err := sarama.LoadEventingKafkaSettings(configMap); err == nil && ekConfig != nil {
+		extensions.Store(ekConfig.Kafka.Extensions)
+	}
 	if kafkaProducer == nil {
 		// This typically happens during startup
 		logger.Debug("Producer is nil during call to configMapObserver; ignoring changes")
@@ -202,3 +290,49 @@ func configMapObserver(configMap *v1.ConfigMap) {
 		kafkaProducer = newProducer
 	}
 }
+
+// rootCAConfigMapObserver is the callback function that handles changes to the Root CA ConfigMap
+func rootCAConfigMapObserver(configMap *v1.ConfigMap) {
+	if configMap == nil {
+		logger.Warn("Nil ConfigMap passed to rootCAConfigMapObserver; ignoring")
+		return
+	}
+
+	// Update The Root CAs From The ConfigMap (Ignoring Unchanged Or Invalid CAs)
+	changed, err := sarama.UpdateRootCAConfigMap(configMap)
+	if err != nil {
+		logger.Error("Invalid Root CA ConfigMap; ignoring", zap.Error(err))
+		return
+	} else if !changed {
+		logger.Debug("No Root CA Changes Detected In ConfigMap; ignoring")
+		return
+	}
+	if kafkaProducer == nil {
+		// This typically happens during startup
+		logger.Debug("Producer is nil during call to rootCAConfigMapObserver; ignoring changes")
+		return
+	}
+
+	// Recreate The Producer With The Rotated Root CAs
+	newProducer := kafkaProducer.RootCAsChanged(sarama.RootCAConfigMapPool())
+	if newProducer != nil {
+		logger.Info("Producer Reconfigured With New Root CAs; Switching To New Producer")
+		kafkaProducer = newProducer
+	}
+}
+
+// brokersObserver is the callback function that handles changes to the Kafka brokers resolved from a DNS SRV record
+func brokersObserver(brokers []string) {
+	if kafkaProducer == nil {
+		// This typically happens during startup
+		logger.Debug("Producer is nil during call to brokersObserver; ignoring changes")
+		return
+	}
+
+	// Recreate The Producer With The Re-Resolved Brokers
+	newProducer := kafkaProducer.BrokersChanged(brokers)
+	if newProducer != nil {
+		logger.Info("Producer Reconfigured With New Brokers; Switching To New Producer")
+		kafkaProducer = newProducer
+	}
+}
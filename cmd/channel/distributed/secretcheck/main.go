@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	commonk8s "knative.dev/eventing-kafka/pkg/channel/distributed/common/k8s"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/secretcheck"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	injectionclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/system"
+)
+
+// The Sarama ClientID Used For The Validation Connections
+const clientId = "eventing-kafka-secretcheck"
+
+// Variables
+var (
+	secretName = flag.String("secret", "", "The name of the Kafka Secret to validate (required).")
+	namespace  = flag.String("namespace", commonconstants.KnativeEventingNamespace, "The namespace of the Kafka Secret & the eventing-kafka ConfigMap.")
+	timeout    = flag.Duration("timeout", 10*time.Second, "The timeout for each connection attempt to the Kafka brokers.")
+	serverURL  = flag.String("server", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
+	kubeconfig = flag.String("kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
+)
+
+// The Main Function (Go Command) - Validates A Kafka Secret & Exits Non-Zero If Any Check Fails
+func main() {
+
+	// Parse & Validate The Flags
+	flag.Parse()
+	if len(*secretName) <= 0 {
+		fmt.Fprintln(os.Stderr, "The -secret flag is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// The eventing-kafka ConfigMap Is Loaded From The SYSTEM_NAMESPACE (As For The Controller)
+	err := os.Setenv(system.NamespaceEnvKey, *namespace)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed To Set %s: %v\n", system.NamespaceEnvKey, err)
+		os.Exit(2)
+	}
+
+	// Put The Kubernetes Client Into The Context Where The Injection Framework Expects It
+	ctx := context.WithValue(context.Background(), injectionclient.Key{}, commonk8s.K8sClientWrapper(*serverURL, *kubeconfig))
+
+	// Run The Checks & Print The Report
+	fmt.Printf("Validating Kafka Secret %s/%s\n", *namespace, *secretName)
+	report := secretcheck.Run(ctx, *namespace, *secretName, clientId, *timeout)
+	report.Write(os.Stdout)
+	if !report.Passed() {
+		os.Exit(1)
+	}
+}
@@ -46,4 +46,7 @@ func (cs *KafkaChannelSpec) SetDefaults(ctx context.Context) {
 	if cs.ReplicationFactor == 0 {
 		cs.ReplicationFactor = constants.DefaultReplicationFactor
 	}
+	if cs.CleanupPolicy == "" {
+		cs.CleanupPolicy = constants.DefaultCleanupPolicy
+	}
 }
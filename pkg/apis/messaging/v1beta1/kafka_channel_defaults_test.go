@@ -25,6 +25,8 @@ import (
 const (
 	testNumPartitions     = 10
 	testReplicationFactor = 5
+	testRetentionDuration = "PT1H"
+	testCleanupPolicy     = CleanupPolicyCompact
 )
 
 func TestKafkaChannelDefaults(t *testing.T) {
@@ -41,6 +43,7 @@ func TestKafkaChannelDefaults(t *testing.T) {
 				Spec: KafkaChannelSpec{
 					NumPartitions:     constants.DefaultNumPartitions,
 					ReplicationFactor: constants.DefaultReplicationFactor,
+					CleanupPolicy:     constants.DefaultCleanupPolicy,
 				},
 			},
 		},
@@ -57,6 +60,7 @@ func TestKafkaChannelDefaults(t *testing.T) {
 				Spec: KafkaChannelSpec{
 					NumPartitions:     constants.DefaultNumPartitions,
 					ReplicationFactor: testReplicationFactor,
+					CleanupPolicy:     constants.DefaultCleanupPolicy,
 				},
 			},
 		},
@@ -73,6 +77,28 @@ func TestKafkaChannelDefaults(t *testing.T) {
 				Spec: KafkaChannelSpec{
 					NumPartitions:     testNumPartitions,
 					ReplicationFactor: constants.DefaultReplicationFactor,
+					CleanupPolicy:     constants.DefaultCleanupPolicy,
+				},
+			},
+		},
+		"retentionDuration and cleanupPolicy set": {
+			initial: KafkaChannel{
+				Spec: KafkaChannelSpec{
+					NumPartitions:     testNumPartitions,
+					ReplicationFactor: testReplicationFactor,
+					RetentionDuration: testRetentionDuration,
+					CleanupPolicy:     testCleanupPolicy,
+				},
+			},
+			expected: KafkaChannel{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"messaging.knative.dev/subscribable": "v1"},
+				},
+				Spec: KafkaChannelSpec{
+					NumPartitions:     testNumPartitions,
+					ReplicationFactor: testReplicationFactor,
+					RetentionDuration: testRetentionDuration,
+					CleanupPolicy:     testCleanupPolicy,
 				},
 			},
 		},
@@ -61,12 +61,28 @@ const (
 	// Because this uses ExternalName, there are no endpoints to check.
 	KafkaChannelConditionChannelServiceReady apis.ConditionType = "ChannelServiceReady"
 
-	// KafkaChannelConditionTopicReady has status True when the Kafka topic to use by the channel exists.
+	// KafkaChannelConditionTopicReady has status True when the Kafka topic to use by the channel exists and all of
+	// its partitions have an elected leader (i.e. the topic is actually writable).
 	KafkaChannelConditionTopicReady apis.ConditionType = "TopicReady"
 
 	// KafkaChannelConditionConfigReady has status True when the Kafka configuration to use by the channel exists and is valid
 	// (ie. the connection has been established).
 	KafkaChannelConditionConfigReady apis.ConditionType = "ConfigurationReady"
+
+	// KafkaChannelConditionDeadLetterSinkResolved has status True when the channel-level dead letter sink
+	// (Spec.Delivery.DeadLetterSink) has been resolved to a URI. It is not part of the condition set which
+	// determines readiness, so failing to resolve the sink does not prevent event delivery to subscribers.
+	KafkaChannelConditionDeadLetterSinkResolved apis.ConditionType = "DeadLetterSinkResolved"
+
+	// KafkaChannelConditionConnectionReady has status True when the Kafka brokers were reachable (ie. a Kafka
+	// AdminClient could be created) during the last reconciliation. It is not part of the condition set which
+	// determines readiness, and instead exists to make broker connectivity problems distinguishable.
+	KafkaChannelConditionConnectionReady apis.ConditionType = "ConnectionReady"
+
+	// KafkaChannelConditionPaused has status True when consumption of the channel has been paused (ie. the
+	// Dispatcher scaled to zero) for maintenance, and is absent otherwise. It is not part of the condition set
+	// which determines readiness, since events are still accepted and retained in the Kafka topic while paused.
+	KafkaChannelConditionPaused apis.ConditionType = "Paused"
 )
 
 // RegisterAlternateKafkaChannelConditionSet register a different apis.ConditionSet.
@@ -181,6 +197,19 @@ func (cs *KafkaChannelStatus) MarkTopicFailed(reason, messageFormat string, mess
 	cs.GetConditionSet().Manage(cs).MarkFalse(KafkaChannelConditionTopicReady, reason, messageFormat, messageA...)
 }
 
+// MarkTopicNotReady sets the TopicReady condition to Unknown to indicate the Kafka topic exists but is not (yet)
+// writable, e.g. because some of its partitions have no elected leader during broker churn.
+// The topic is re-probed when the KafkaChannel is requeued.
+func (cs *KafkaChannelStatus) MarkTopicNotReady(reason, messageFormat string, messageA ...interface{}) {
+	cs.GetConditionSet().Manage(cs).MarkUnknown(KafkaChannelConditionTopicReady, reason, messageFormat, messageA...)
+}
+
+// MarkTopicDryRun sets the TopicReady condition to Unknown to indicate the Kafka topic operations were
+// only computed (and not performed) because the controller is running in "dry-run" mode.
+func (cs *KafkaChannelStatus) MarkTopicDryRun(reason, messageFormat string, messageA ...interface{}) {
+	cs.GetConditionSet().Manage(cs).MarkUnknown(KafkaChannelConditionTopicReady, reason, messageFormat, messageA...)
+}
+
 func (cs *KafkaChannelStatus) MarkConfigTrue() {
 	cs.GetConditionSet().Manage(cs).MarkTrue(KafkaChannelConditionConfigReady)
 }
@@ -188,3 +217,51 @@ func (cs *KafkaChannelStatus) MarkConfigTrue() {
 func (cs *KafkaChannelStatus) MarkConfigFailed(reason, messageFormat string, messageA ...interface{}) {
 	cs.GetConditionSet().Manage(cs).MarkFalse(KafkaChannelConditionConfigReady, reason, messageFormat, messageA...)
 }
+
+// MarkDeadLetterSinkResolved sets the resolved channel-level dead letter sink URI and marks the
+// DeadLetterSinkResolved condition as True.
+func (cs *KafkaChannelStatus) MarkDeadLetterSinkResolved(uri *apis.URL) {
+	cs.DeadLetterSinkURI = uri
+	cs.GetConditionSet().Manage(cs).MarkTrue(KafkaChannelConditionDeadLetterSinkResolved)
+}
+
+// MarkDeadLetterSinkResolvedFailed clears the channel-level dead letter sink URI and marks the
+// DeadLetterSinkResolved condition as False.
+func (cs *KafkaChannelStatus) MarkDeadLetterSinkResolvedFailed(reason, messageFormat string, messageA ...interface{}) {
+	cs.DeadLetterSinkURI = nil
+	cs.GetConditionSet().Manage(cs).MarkFalse(KafkaChannelConditionDeadLetterSinkResolved, reason, messageFormat, messageA...)
+}
+
+// MarkDeadLetterSinkNotConfigured clears the channel-level dead letter sink URI and removes the
+// DeadLetterSinkResolved condition, as there is no dead letter sink to resolve.
+func (cs *KafkaChannelStatus) MarkDeadLetterSinkNotConfigured() {
+	cs.DeadLetterSinkURI = nil
+	_ = cs.GetConditionSet().Manage(cs).ClearCondition(KafkaChannelConditionDeadLetterSinkResolved)
+}
+
+// MarkConnectionTrue marks the ConnectionReady condition as True, with a message describing the Kafka
+// brokers which were reachable.
+func (cs *KafkaChannelStatus) MarkConnectionTrue(reason, messageFormat string, messageA ...interface{}) {
+	cs.GetConditionSet().Manage(cs).MarkTrueWithReason(KafkaChannelConditionConnectionReady, reason, messageFormat, messageA...)
+}
+
+// MarkPaused marks the Paused condition as True, with a message describing why consumption is paused.
+func (cs *KafkaChannelStatus) MarkPaused(reason, messageFormat string, messageA ...interface{}) {
+	cs.GetConditionSet().Manage(cs).MarkTrueWithReason(KafkaChannelConditionPaused, reason, messageFormat, messageA...)
+}
+
+// MarkNotPaused removes the Paused condition, as consumption of the channel is not paused.
+func (cs *KafkaChannelStatus) MarkNotPaused() {
+	_ = cs.GetConditionSet().Manage(cs).ClearCondition(KafkaChannelConditionPaused)
+}
+
+// IsPaused returns true if the Paused condition is True.
+func (cs *KafkaChannelStatus) IsPaused() bool {
+	return cs.GetCondition(KafkaChannelConditionPaused).IsTrue()
+}
+
+// MarkConnectionFailed marks the ConnectionReady condition as False, with a message describing the Kafka
+// brokers which could not be reached.
+func (cs *KafkaChannelStatus) MarkConnectionFailed(reason, messageFormat string, messageA ...interface{}) {
+	cs.GetConditionSet().Manage(cs).MarkFalse(KafkaChannelConditionConnectionReady, reason, messageFormat, messageA...)
+}
@@ -373,6 +373,96 @@ func TestChannelIsReady(t *testing.T) {
 	}
 }
 
+func TestChannelMarkTopicDryRun(t *testing.T) {
+	cs := &KafkaChannelStatus{}
+	cs.InitializeConditions()
+	cs.MarkTopicDryRun("TopicDryRun", "testing %s", "dry-run")
+	condition := cs.GetCondition(KafkaChannelConditionTopicReady)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionUnknown, condition.Status)
+	assert.Equal(t, "TopicDryRun", condition.Reason)
+	assert.Equal(t, "testing dry-run", condition.Message)
+	assert.False(t, cs.IsReady())
+}
+
+func TestChannelMarkTopicNotReady(t *testing.T) {
+	cs := &KafkaChannelStatus{}
+	cs.InitializeConditions()
+	cs.MarkTopicTrue()
+	cs.MarkTopicNotReady("TopicLeaderNotAvailable", "testing %s", "no-leader")
+	condition := cs.GetCondition(KafkaChannelConditionTopicReady)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionUnknown, condition.Status)
+	assert.Equal(t, "TopicLeaderNotAvailable", condition.Reason)
+	assert.Equal(t, "testing no-leader", condition.Message)
+	assert.False(t, cs.IsReady())
+}
+
+func TestChannelMarkDeadLetterSink(t *testing.T) {
+	cs := &KafkaChannelStatus{}
+	cs.InitializeConditions()
+	deadLetterSinkURI := apis.HTTP("dls.example.com")
+
+	cs.MarkDeadLetterSinkResolved(deadLetterSinkURI)
+	condition := cs.GetCondition(KafkaChannelConditionDeadLetterSinkResolved)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, deadLetterSinkURI, cs.DeadLetterSinkURI)
+
+	cs.MarkDeadLetterSinkResolvedFailed("Unresolvable", "testing %s", "failure")
+	condition = cs.GetCondition(KafkaChannelConditionDeadLetterSinkResolved)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, "Unresolvable", condition.Reason)
+	assert.Equal(t, "testing failure", condition.Message)
+	assert.Nil(t, cs.DeadLetterSinkURI)
+	assert.Equal(t, corev1.ConditionUnknown, cs.GetCondition(KafkaChannelConditionReady).Status) // Not A Readiness Dependent
+
+	cs.MarkDeadLetterSinkResolved(deadLetterSinkURI)
+	cs.MarkDeadLetterSinkNotConfigured()
+	assert.Nil(t, cs.GetCondition(KafkaChannelConditionDeadLetterSinkResolved))
+	assert.Nil(t, cs.DeadLetterSinkURI)
+}
+
+func TestChannelMarkConnection(t *testing.T) {
+	cs := &KafkaChannelStatus{}
+	cs.InitializeConditions()
+
+	cs.MarkConnectionTrue("KafkaConnected", "testing %s", "brokers")
+	condition := cs.GetCondition(KafkaChannelConditionConnectionReady)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "KafkaConnected", condition.Reason)
+	assert.Equal(t, "testing brokers", condition.Message)
+
+	cs.MarkConnectionFailed("KafkaConnectionFailed", "testing %s", "failure")
+	condition = cs.GetCondition(KafkaChannelConditionConnectionReady)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, "KafkaConnectionFailed", condition.Reason)
+	assert.Equal(t, "testing failure", condition.Message)
+	assert.Equal(t, corev1.ConditionUnknown, cs.GetCondition(KafkaChannelConditionReady).Status) // Not A Readiness Dependent
+}
+
+func TestChannelMarkPaused(t *testing.T) {
+	cs := &KafkaChannelStatus{}
+	cs.InitializeConditions()
+	assert.False(t, cs.IsPaused())
+
+	cs.MarkPaused("DispatcherPaused", "testing %s", "pause")
+	condition := cs.GetCondition(KafkaChannelConditionPaused)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "DispatcherPaused", condition.Reason)
+	assert.Equal(t, "testing pause", condition.Message)
+	assert.True(t, cs.IsPaused())
+	assert.Equal(t, corev1.ConditionUnknown, cs.GetCondition(KafkaChannelConditionReady).Status) // Not A Readiness Dependent
+
+	cs.MarkNotPaused()
+	assert.Nil(t, cs.GetCondition(KafkaChannelConditionPaused))
+	assert.False(t, cs.IsPaused())
+}
+
 func TestKafkaChannelStatus_SetAddressable(t *testing.T) {
 	testCases := map[string]struct {
 		url  *apis.URL
@@ -17,9 +17,14 @@ limitations under the License.
 package v1beta1
 
 import (
+	"time"
+
+	"github.com/rickb777/date/period"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -67,14 +72,84 @@ type KafkaChannelSpec struct {
 	// ReplicationFactor is the replication factor of a Kafka topic. By default, it is set to 1.
 	ReplicationFactor int16 `json:"replicationFactor"`
 
+	// RetentionDuration is the duration for which events will be retained in the Kafka Topic (retention.ms).
+	// It is expressed as an ISO-8601 duration (e.g. "PT168H"). If not specified, the controller's configured
+	// default retention is used.
+	// +optional
+	RetentionDuration string `json:"retentionDuration,omitempty"`
+
+	// CleanupPolicy is the cleanup policy of a Kafka topic (cleanup.policy) and must be either "delete" or
+	// "compact". By default, it is set to "delete".
+	// +optional
+	CleanupPolicy string `json:"cleanupPolicy,omitempty"`
+
 	// Channel conforms to Duck type Channelable.
 	eventingduck.ChannelableSpec `json:",inline"`
 }
 
+// KafkaChannel Topic CleanupPolicy Values
+const (
+	CleanupPolicyDelete  = "delete"
+	CleanupPolicyCompact = "compact"
+)
+
+// ParseRetentionDuration returns the RetentionDuration as a time.Duration.
+func (cs *KafkaChannelSpec) ParseRetentionDuration() (time.Duration, error) {
+	retentionPeriod, err := period.Parse(cs.RetentionDuration)
+	if err != nil {
+		return 0, err
+	}
+	return retentionPeriod.DurationApprox(), nil
+}
+
 // KafkaChannelStatus represents the current state of a KafkaChannel.
 type KafkaChannelStatus struct {
 	// Channel conforms to Duck type Channelable.
 	eventingduck.ChannelableStatus `json:",inline"`
+
+	// DeadLetterSinkURI is the resolved URI of the channel-level dead letter sink (Spec.Delivery.DeadLetterSink)
+	// to which events are sent when a subscriber (without its own dead letter sink) exhausts its retries.
+	// +optional
+	DeadLetterSinkURI *apis.URL `json:"deadLetterSinkUri,omitempty"`
+
+	// SubscriberConsumerGroups summarizes the Kafka ConsumerGroup of each subscriber (as last observed by the
+	// controller), complementing the delivery readiness reported by the dispatcher in Subscribers.
+	// +optional
+	SubscriberConsumerGroups []SubscriberConsumerGroupStatus `json:"subscriberConsumerGroups,omitempty"`
+}
+
+// SubscriberConsumerGroupStatus describes the Kafka ConsumerGroup of a single KafkaChannel subscriber.
+type SubscriberConsumerGroupStatus struct {
+	// UID is the UID of the subscriber (matching the UID in Spec.Subscribers and Status.Subscribers).
+	UID types.UID `json:"uid,omitempty"`
+
+	// ConsumerGroup is the ID of the subscriber's Kafka ConsumerGroup.
+	ConsumerGroup string `json:"consumerGroup,omitempty"`
+
+	// State is the Kafka state of the ConsumerGroup (e.g. Stable, PreparingRebalance, Empty or Dead).
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Ready is True when the ConsumerGroup is Stable with at least one member, False when it has no
+	// members, and Unknown while it is rebalancing or could not be described.
+	Ready corev1.ConditionStatus `json:"ready,omitempty"`
+
+	// Members is the number of members (dispatcher consumers) of the ConsumerGroup.
+	// +optional
+	Members int32 `json:"members,omitempty"`
+
+	// CommittedPartitions is the number of the KafkaChannel topic's partitions for which the ConsumerGroup
+	// has committed an offset.
+	// +optional
+	CommittedPartitions int32 `json:"committedPartitions,omitempty"`
+
+	// CommittedOffsets is the sum of the ConsumerGroup's committed offsets across those partitions.
+	// +optional
+	CommittedOffsets int64 `json:"committedOffsets,omitempty"`
+
+	// Message describes why the ConsumerGroup could not be described (if it could not).
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
@@ -47,3 +48,17 @@ func TestKafkaChannelGetStatus(t *testing.T) {
 		t.Errorf("GetStatus did not retrieve status. Got=%v Want=%v", config.GetStatus(), status)
 	}
 }
+
+func TestKafkaChannelSpec_ParseRetentionDuration(t *testing.T) {
+	spec := KafkaChannelSpec{RetentionDuration: "PT168H"}
+	duration, err := spec.ParseRetentionDuration()
+	if err != nil || duration != 168*time.Hour {
+		t.Errorf("Unexpected ParseRetentionDuration() result. Got=%v/%v Want=%v", duration, err, 168*time.Hour)
+	}
+
+	spec = KafkaChannelSpec{RetentionDuration: "invalid"}
+	_, err = spec.ParseRetentionDuration()
+	if err == nil {
+		t.Errorf("Expected ParseRetentionDuration() error for invalid duration")
+	}
+}
@@ -54,6 +54,20 @@ func (cs *KafkaChannelSpec) Validate(ctx context.Context) *apis.FieldError {
 		errs = errs.Also(fe)
 	}
 
+	if cs.RetentionDuration != "" {
+		if retentionDuration, err := cs.ParseRetentionDuration(); err != nil || retentionDuration <= 0 {
+			fe := apis.ErrInvalidValue(cs.RetentionDuration, "retentionDuration")
+			fe.Details = "expected a positive ISO-8601 duration (e.g. PT168H)"
+			errs = errs.Also(fe)
+		}
+	}
+
+	if cs.CleanupPolicy != "" && cs.CleanupPolicy != CleanupPolicyDelete && cs.CleanupPolicy != CleanupPolicyCompact {
+		fe := apis.ErrInvalidValue(cs.CleanupPolicy, "cleanupPolicy")
+		fe.Details = "expected either 'delete' or 'compact'"
+		errs = errs.Also(fe)
+	}
+
 	for i, subscriber := range cs.SubscribableSpec.Subscribers {
 		if subscriber.ReplyURI == nil && subscriber.SubscriberURI == nil {
 			fe := apis.ErrMissingField("replyURI", "subscriberURI")
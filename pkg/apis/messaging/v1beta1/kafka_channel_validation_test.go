@@ -72,6 +72,59 @@ func TestKafkaChannelValidation(t *testing.T) {
 				return fe
 			}(),
 		},
+		"invalid retentionDuration": {
+			cr: &KafkaChannel{
+				Spec: KafkaChannelSpec{
+					NumPartitions:     1,
+					ReplicationFactor: 1,
+					RetentionDuration: "1 week",
+				},
+			},
+			want: func() *apis.FieldError {
+				fe := apis.ErrInvalidValue("1 week", "spec.retentionDuration")
+				fe.Details = "expected a positive ISO-8601 duration (e.g. PT168H)"
+				return fe
+			}(),
+		},
+		"negative retentionDuration": {
+			cr: &KafkaChannel{
+				Spec: KafkaChannelSpec{
+					NumPartitions:     1,
+					ReplicationFactor: 1,
+					RetentionDuration: "-PT1H",
+				},
+			},
+			want: func() *apis.FieldError {
+				fe := apis.ErrInvalidValue("-PT1H", "spec.retentionDuration")
+				fe.Details = "expected a positive ISO-8601 duration (e.g. PT168H)"
+				return fe
+			}(),
+		},
+		"invalid cleanupPolicy": {
+			cr: &KafkaChannel{
+				Spec: KafkaChannelSpec{
+					NumPartitions:     1,
+					ReplicationFactor: 1,
+					CleanupPolicy:     "forever",
+				},
+			},
+			want: func() *apis.FieldError {
+				fe := apis.ErrInvalidValue("forever", "spec.cleanupPolicy")
+				fe.Details = "expected either 'delete' or 'compact'"
+				return fe
+			}(),
+		},
+		"valid retentionDuration and cleanupPolicy": {
+			cr: &KafkaChannel{
+				Spec: KafkaChannelSpec{
+					NumPartitions:     1,
+					ReplicationFactor: 1,
+					RetentionDuration: "P1D",
+					CleanupPolicy:     CleanupPolicyCompact,
+				},
+			},
+			want: nil,
+		},
 		"valid subscribers array": {
 			cr: &KafkaChannel{
 				Spec: KafkaChannelSpec{
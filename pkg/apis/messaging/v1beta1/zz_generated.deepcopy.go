@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	apis "knative.dev/pkg/apis"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -106,6 +107,16 @@ func (in *KafkaChannelSpec) DeepCopy() *KafkaChannelSpec {
 func (in *KafkaChannelStatus) DeepCopyInto(out *KafkaChannelStatus) {
 	*out = *in
 	in.ChannelableStatus.DeepCopyInto(&out.ChannelableStatus)
+	if in.DeadLetterSinkURI != nil {
+		in, out := &in.DeadLetterSinkURI, &out.DeadLetterSinkURI
+		*out = new(apis.URL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubscriberConsumerGroups != nil {
+		in, out := &in.SubscriberConsumerGroups, &out.SubscriberConsumerGroups
+		*out = make([]SubscriberConsumerGroupStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -118,3 +129,19 @@ func (in *KafkaChannelStatus) DeepCopy() *KafkaChannelStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriberConsumerGroupStatus) DeepCopyInto(out *SubscriberConsumerGroupStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriberConsumerGroupStatus.
+func (in *SubscriberConsumerGroupStatus) DeepCopy() *SubscriberConsumerGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriberConsumerGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
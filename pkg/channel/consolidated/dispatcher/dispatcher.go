@@ -35,6 +35,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"knative.dev/eventing-kafka/pkg/channel/consolidated/utils"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
+	kafkaproducer "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/producer"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/metrics"
 	"knative.dev/eventing-kafka/pkg/common/consumer"
 	eventingchannels "knative.dev/eventing/pkg/channel"
 	"knative.dev/eventing/pkg/channel/fanout"
@@ -51,6 +53,7 @@ type KafkaDispatcher struct {
 	dispatcher *eventingchannels.MessageDispatcherImpl
 
 	kafkaAsyncProducer   sarama.AsyncProducer
+	statsReporter        metrics.StatsReporter
 	channelSubscriptions map[eventingchannels.ChannelReference][]types.UID
 	subsConsumerGroups   map[types.UID]sarama.ConsumerGroup
 	subscriptions        map[types.UID]Subscription
@@ -104,6 +107,7 @@ func NewDispatcher(ctx context.Context, args *KafkaDispatcherArgs) (*KafkaDispat
 		subsConsumerGroups:   make(map[types.UID]sarama.ConsumerGroup),
 		subscriptions:        make(map[types.UID]Subscription),
 		kafkaAsyncProducer:   producer,
+		statsReporter:        metrics.NewStatsReporter(args.Logger.Desugar()),
 		logger:               args.Logger,
 		topicFunc:            args.TopicFunc,
 	}
@@ -120,7 +124,8 @@ func NewDispatcher(ctx context.Context, args *KafkaDispatcherArgs) (*KafkaDispat
 	receiverFunc, err := eventingchannels.NewMessageReceiver(
 		func(ctx context.Context, channel eventingchannels.ChannelReference, message binding.Message, transformers []binding.Transformer, _ nethttp.Header) error {
 			kafkaProducerMessage := sarama.ProducerMessage{
-				Topic: dispatcher.topicFunc(utils.KafkaChannelSeparator, channel.Namespace, channel.Name),
+				Topic:    dispatcher.topicFunc(utils.KafkaChannelSeparator, channel.Namespace, channel.Name),
+				Metadata: channel, // Returned with any produce error in order to tag the error metric by channel
 			}
 
 			dispatcher.logger.Debugw("Received a new message from MessageReceiver, dispatching to Kafka", zap.Any("channel", channel))
@@ -322,7 +327,7 @@ func (d *KafkaDispatcher) Start(ctx context.Context) error {
 		for {
 			select {
 			case e := <-d.kafkaAsyncProducer.Errors():
-				d.logger.Warn("Got", zap.Error(e))
+				d.handleProducerError(e)
 			case s := <-d.kafkaAsyncProducer.Successes():
 				d.logger.Info("Sent", zap.Any("success", s))
 			case <-ctx.Done():
@@ -334,6 +339,20 @@ func (d *KafkaDispatcher) Start(ctx context.Context) error {
 	return d.receiver.Start(ctx)
 }
 
+// handleProducerError logs and counts an asynchronous produce failure, tagged by channel and error type.
+func (d *KafkaDispatcher) handleProducerError(producerError *sarama.ProducerError) {
+	produceError := kafkaproducer.NewProduceError(producerError.Err)
+	channelName := ""
+	if producerError.Msg != nil {
+		channelName = producerError.Msg.Topic
+		if channelRef, ok := producerError.Msg.Metadata.(eventingchannels.ChannelReference); ok {
+			channelName = channelRef.String()
+		}
+	}
+	d.logger.Warnw("Failed to produce message to kafka", zap.String("channel", channelName), zap.String("errorType", produceError.ErrorType), zap.Error(producerError.Err))
+	d.statsReporter.ReportProduceError(channelName, produceError.ErrorType)
+}
+
 // subscribe reads kafkaConsumers which gets updated in UpdateConfig in a separate go-routine.
 // subscribe must be called under updateLock.
 func (d *KafkaDispatcher) subscribe(channelRef eventingchannels.ChannelReference, sub Subscription) error {
@@ -445,6 +445,46 @@ func TestKafkaDispatcher_Start(t *testing.T) {
 	}
 }
 
+type mockStatsReporter struct {
+	produceErrors map[string]int
+}
+
+func (r *mockStatsReporter) Report(map[string]map[string]interface{}) {}
+
+func (r *mockStatsReporter) ReportProduceError(channelName string, produceErrorType string) {
+	r.produceErrors[channelName+"|"+produceErrorType]++
+}
+
+func TestKafkaDispatcher_handleProducerError(t *testing.T) {
+	reporter := &mockStatsReporter{produceErrors: make(map[string]int)}
+	d := &KafkaDispatcher{
+		statsReporter: reporter,
+		logger:        zaptest.NewLogger(t).Sugar(),
+	}
+
+	channelRef := eventingchannels.ChannelReference{Name: "test-channel", Namespace: "test-ns"}
+	d.handleProducerError(&sarama.ProducerError{
+		Msg: &sarama.ProducerMessage{Topic: "knative-messaging-kafka.test-ns.test-channel", Metadata: channelRef},
+		Err: sarama.ErrNotEnoughReplicas,
+	})
+	d.handleProducerError(&sarama.ProducerError{
+		Msg: &sarama.ProducerMessage{Topic: "knative-messaging-kafka.test-ns.test-channel", Metadata: channelRef},
+		Err: sarama.ErrNotEnoughReplicas,
+	})
+	d.handleProducerError(&sarama.ProducerError{
+		Msg: &sarama.ProducerMessage{Topic: "test-topic"},
+		Err: errors.New("test error"),
+	})
+
+	want := map[string]int{
+		"test-ns/test-channel|not_enough_replicas": 2,
+		"test-topic|unknown":                       1,
+	}
+	if diff := cmp.Diff(want, reporter.produceErrors); diff != "" {
+		t.Errorf("unexpected produce errors (-want, +got) = %v", diff)
+	}
+}
+
 func TestNewDispatcher(t *testing.T) {
 	args := &KafkaDispatcherArgs{
 		ClientID:  "kafka-ch-dispatcher",
@@ -422,7 +422,14 @@ func (r *Reconciler) reconcileChannelService(ctx context.Context, dispatcherName
 	// We don't do anything with the service because it's status contains nothing useful, so just do
 	// an existence check. Then below we check the endpoints targeting it.
 	// We may change this name later, so we have to ensure we use proper addressable when resolving these.
-	expected, err := resources.MakeK8sService(channel, resources.ExternalService(dispatcherNamespace, dispatcherName))
+	opts := []resources.ServiceOption{resources.ExternalService(dispatcherNamespace, dispatcherName)}
+	if r.kafkaConfig.ChannelServicePortName != "" || r.kafkaConfig.ChannelServicePortNumber != 0 || r.kafkaConfig.ChannelServiceAppProtocol != "" {
+		// The ExternalName service has no ports of its own, so define the (default) port for the appProtocol too.
+		opts = append(opts,
+			resources.WithPort(r.kafkaConfig.ChannelServicePortName, r.kafkaConfig.ChannelServicePortNumber),
+			resources.WithAppProtocol(r.kafkaConfig.ChannelServiceAppProtocol))
+	}
+	expected, err := resources.MakeK8sService(channel, opts...)
 	if err != nil {
 		logger.Errorw("failed to create the channel service object", zap.Error(err))
 		channel.Status.MarkChannelServiceFailed("ChannelServiceFailed", fmt.Sprintf("Channel Service failed: %s", err))
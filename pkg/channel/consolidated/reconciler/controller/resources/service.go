@@ -53,6 +53,96 @@ func ExternalService(namespace, service string) ServiceOption {
 	}
 }
 
+// WithPort is a functional option for MakeK8sService to override the name and number of the service port (e.g. to
+// satisfy service-mesh port naming policies). An empty name or zero number falls back to the default value.
+func WithPort(name string, number int32) ServiceOption {
+	return func(svc *corev1.Service) error {
+		if name == "" {
+			name = portName
+		}
+		if number == 0 {
+			number = portNumber
+		}
+		svc.Spec.Ports = []corev1.ServicePort{
+			{
+				Name:     name,
+				Protocol: corev1.ProtocolTCP,
+				Port:     number,
+			},
+		}
+		return nil
+	}
+}
+
+// WithAppProtocol is a functional option for MakeK8sService to set the application protocol (e.g. "http" or
+// "kafka") of the service ports, for HTTP/2 and service-mesh telemetry. An empty appProtocol leaves the ports
+// unchanged. It must be applied after any option which defines the ports.
+func WithAppProtocol(appProtocol string) ServiceOption {
+	return func(svc *corev1.Service) error {
+		if appProtocol == "" {
+			return nil
+		}
+		for i := range svc.Spec.Ports {
+			svc.Spec.Ports[i].AppProtocol = &appProtocol
+		}
+		return nil
+	}
+}
+
+// HeadlessService is a functional option for MakeK8sService to create a headless K8s service (ClusterIP "None")
+// so that clients can resolve the individual pods backing it. The port definitions are left intact.
+func HeadlessService() ServiceOption {
+	return func(svc *corev1.Service) error {
+		svc.Spec.ClusterIP = corev1.ClusterIPNone
+		return nil
+	}
+}
+
+// WithSelector is a functional option for MakeK8sService to set the pod selector of the service, so that it routes
+// directly to the selected (e.g. dispatcher) pods rather than relying on manually managed endpoints. An empty selector
+// leaves the service unchanged. Selectors are ignored by ExternalName services, so combining this option with
+// ExternalService is rejected.
+func WithSelector(selector map[string]string) ServiceOption {
+	return func(svc *corev1.Service) error {
+		if len(selector) == 0 {
+			return nil
+		}
+		if svc.Spec.Type == corev1.ServiceTypeExternalName {
+			return fmt.Errorf("cannot set a selector on ExternalName service %q", svc.Name)
+		}
+		svc.Spec.Selector = make(map[string]string, len(selector))
+		for key, value := range selector {
+			svc.Spec.Selector[key] = value
+		}
+		return nil
+	}
+}
+
+// WithAdditionalMetadata is a functional option for MakeK8sService to merge additional labels and annotations
+// (e.g. cost-allocation labels or service-mesh annotations) onto the service. Entries never overwrite existing
+// keys, so controller-owned labels such as MessagingRoleLabel are preserved.
+func WithAdditionalMetadata(labels map[string]string, annotations map[string]string) ServiceOption {
+	return func(svc *corev1.Service) error {
+		for key, value := range labels {
+			if svc.Labels == nil {
+				svc.Labels = make(map[string]string, len(labels))
+			}
+			if _, exists := svc.Labels[key]; !exists {
+				svc.Labels[key] = value
+			}
+		}
+		for key, value := range annotations {
+			if svc.Annotations == nil {
+				svc.Annotations = make(map[string]string, len(annotations))
+			}
+			if _, exists := svc.Annotations[key]; !exists {
+				svc.Annotations[key] = value
+			}
+		}
+		return nil
+	}
+}
+
 // MakeK8sService creates a new K8s Service for a Channel resource. It also sets the appropriate
 // OwnerReferences on the resource so handleObject can discover the Channel resource that 'owns' it.
 // As well as being garbage collected when the Channel is deleted.
@@ -82,6 +82,43 @@ func TestMakeService(t *testing.T) {
 	if diff := cmp.Diff(want, got); diff != "" {
 		t.Errorf("unexpected condition (-want, +got) = %v", diff)
 	}
+
+	// An unset appProtocol must not change the service.
+	got, err = MakeK8sService(imc, WithAppProtocol(""))
+	if err != nil {
+		t.Fatalf("Failed to create new service: %s", err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected condition (-want, +got) = %v", diff)
+	}
+}
+
+func TestMakeServiceWithAppProtocol(t *testing.T) {
+	imc := &v1beta1.KafkaChannel{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kcName,
+			Namespace: testNS,
+		},
+	}
+	appProtocol := "http"
+	want := []corev1.ServicePort{
+		{
+			Name:        "http-channel",
+			Protocol:    corev1.ProtocolTCP,
+			Port:        8080,
+			AppProtocol: &appProtocol,
+		},
+	}
+
+	got, err := MakeK8sService(imc, WithPort("http-channel", 8080), WithAppProtocol(appProtocol))
+	if err != nil {
+		t.Fatalf("Failed to create new service: %s", err)
+	}
+
+	if diff := cmp.Diff(want, got.Spec.Ports); diff != "" {
+		t.Errorf("unexpected ports (-want, +got) = %v", diff)
+	}
 }
 
 func TestMakeServiceWithExternal(t *testing.T) {
@@ -122,6 +159,179 @@ func TestMakeServiceWithExternal(t *testing.T) {
 	}
 }
 
+func TestMakeServiceWithPort(t *testing.T) {
+	imc := &v1beta1.KafkaChannel{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kcName,
+			Namespace: testNS,
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		portName   string
+		portNumber int32
+		wantName   string
+		wantNumber int32
+	}{
+		{
+			name:       "override",
+			portName:   "http-channel",
+			portNumber: 8080,
+			wantName:   "http-channel",
+			wantNumber: 8080,
+		},
+		{
+			name:       "default",
+			wantName:   portName,
+			wantNumber: portNumber,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			want := []corev1.ServicePort{
+				{
+					Name:     tc.wantName,
+					Protocol: corev1.ProtocolTCP,
+					Port:     tc.wantNumber,
+				},
+			}
+
+			// The port must survive being composed after the ExternalService option, which replaces the spec.
+			got, err := MakeK8sService(imc, ExternalService(testDispatcherNS, testDispatcherName), WithPort(tc.portName, tc.portNumber))
+			if err != nil {
+				t.Fatalf("Failed to create new service: %s", err)
+			}
+
+			if diff := cmp.Diff(want, got.Spec.Ports); diff != "" {
+				t.Errorf("unexpected ports (-want, +got) = %v", diff)
+			}
+			if got.Spec.Type != corev1.ServiceTypeExternalName {
+				t.Errorf("Want service type %q, got %q", corev1.ServiceTypeExternalName, got.Spec.Type)
+			}
+		})
+	}
+}
+
+func TestMakeServiceWithHeadless(t *testing.T) {
+	imc := &v1beta1.KafkaChannel{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kcName,
+			Namespace: testNS,
+		},
+	}
+	want := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-kn-channel", kcName),
+			Namespace: testNS,
+			Labels: map[string]string{
+				MessagingRoleLabel: MessagingRole,
+				"test-label":       "test-value",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(imc),
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Ports: []corev1.ServicePort{
+				{
+					Name:     portName,
+					Protocol: corev1.ProtocolTCP,
+					Port:     portNumber,
+				},
+			},
+		},
+	}
+
+	// Compose with another option to verify the spec and ports are preserved.
+	withLabel := func(svc *corev1.Service) error {
+		svc.Labels["test-label"] = "test-value"
+		return nil
+	}
+	got, err := MakeK8sService(imc, HeadlessService(), withLabel)
+	if err != nil {
+		t.Fatalf("Failed to create new service: %s", err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected condition (-want, +got) = %v", diff)
+	}
+}
+
+func TestMakeServiceWithSelector(t *testing.T) {
+	imc := &v1beta1.KafkaChannel{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kcName,
+			Namespace: testNS,
+		},
+	}
+	selector := map[string]string{
+		"messaging.knative.dev/channel": "kafka-channel",
+		"messaging.knative.dev/role":    "dispatcher",
+	}
+	want := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-kn-channel", kcName),
+			Namespace: testNS,
+			Labels: map[string]string{
+				MessagingRoleLabel: MessagingRole,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(imc),
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: selector,
+			Ports: []corev1.ServicePort{
+				{
+					Name:     portName,
+					Protocol: corev1.ProtocolTCP,
+					Port:     portNumber,
+				},
+			},
+		},
+	}
+
+	got, err := MakeK8sService(imc, WithSelector(selector))
+	if err != nil {
+		t.Fatalf("Failed to create new service: %s", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected condition (-want, +got) = %v", diff)
+	}
+
+	// The selector must be copied rather than shared with the caller.
+	selector["messaging.knative.dev/role"] = "receiver"
+	if got.Spec.Selector["messaging.knative.dev/role"] != "dispatcher" {
+		t.Errorf("Service selector was modified via the caller's map: %v", got.Spec.Selector)
+	}
+
+	// An empty selector leaves the service unchanged.
+	got, err = MakeK8sService(imc, WithSelector(nil))
+	if err != nil {
+		t.Fatalf("Failed to create new service: %s", err)
+	}
+	if got.Spec.Selector != nil {
+		t.Errorf("Expected no selector but got %v", got.Spec.Selector)
+	}
+
+	// A selector is meaningless on an ExternalName service.
+	_, err = MakeK8sService(imc, ExternalService(testDispatcherNS, testDispatcherName), WithSelector(selector))
+	if err == nil {
+		t.Fatalf("Expected error from new ExternalName service with a selector but got none")
+	}
+}
+
 func TestMakeServiceWithFailingOption(t *testing.T) {
 	imc := &v1beta1.KafkaChannel{
 		ObjectMeta: metav1.ObjectMeta{
@@ -134,3 +344,35 @@ func TestMakeServiceWithFailingOption(t *testing.T) {
 		t.Fatalf("Expcted error from new service but got none")
 	}
 }
+
+func TestMakeServiceWithAdditionalMetadata(t *testing.T) {
+	imc := &v1beta1.KafkaChannel{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kcName,
+			Namespace: testNS,
+		},
+	}
+	labels := map[string]string{
+		MessagingRoleLabel: "not-the-messaging-role",
+		"cost-center":      "eventing",
+	}
+	annotations := map[string]string{
+		"sidecar.istio.io/inject": "true",
+	}
+
+	got, err := MakeK8sService(imc, WithAdditionalMetadata(labels, annotations))
+	if err != nil {
+		t.Fatalf("Failed to create new service: %s", err)
+	}
+
+	wantLabels := map[string]string{
+		MessagingRoleLabel: MessagingRole,
+		"cost-center":      "eventing",
+	}
+	if diff := cmp.Diff(wantLabels, got.Labels); diff != "" {
+		t.Errorf("unexpected labels (-want, +got) = %v", diff)
+	}
+	if diff := cmp.Diff(annotations, got.Annotations); diff != "" {
+		t.Errorf("unexpected annotations (-want, +got) = %v", diff)
+	}
+}
@@ -31,6 +31,9 @@ const (
 	BrokerConfigMapKey           = "bootstrapServers"
 	MaxIdleConnectionsKey        = "maxIdleConns"
 	MaxIdleConnectionsPerHostKey = "maxIdleConnsPerHost"
+	ChannelServicePortNameKey    = "channelServicePortName"
+	ChannelServicePortNumberKey  = "channelServicePortNumber"
+	ChannelServiceAppProtocolKey = "channelServiceAppProtocol"
 
 	KafkaChannelSeparator = "."
 
@@ -44,6 +47,10 @@ type KafkaConfig struct {
 	Brokers             []string
 	MaxIdleConns        int32
 	MaxIdleConnsPerHost int32
+	// Optional overrides of the channel service port (empty / zero keeps the defaults).
+	ChannelServicePortName    string
+	ChannelServicePortNumber  int32
+	ChannelServiceAppProtocol string
 }
 
 // GetKafkaConfig returns the details of the Kafka cluster.
@@ -63,6 +70,9 @@ func GetKafkaConfig(configMap map[string]string) (*KafkaConfig, error) {
 		configmap.AsString(BrokerConfigMapKey, &bootstrapServers),
 		configmap.AsInt32(MaxIdleConnectionsKey, &config.MaxIdleConns),
 		configmap.AsInt32(MaxIdleConnectionsPerHostKey, &config.MaxIdleConnsPerHost),
+		configmap.AsString(ChannelServicePortNameKey, &config.ChannelServicePortName),
+		configmap.AsInt32(ChannelServicePortNumberKey, &config.ChannelServicePortNumber),
+		configmap.AsString(ChannelServiceAppProtocolKey, &config.ChannelServiceAppProtocol),
 	)
 	if err != nil {
 		return nil, err
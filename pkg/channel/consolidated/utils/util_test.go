@@ -142,6 +142,32 @@ func TestGetKafkaConfig(t *testing.T) {
 				MaxIdleConnsPerHost: 600,
 			},
 		},
+		{
+			name: "channel service port overrides",
+			data: map[string]string{"bootstrapServers": "kafkabroker.kafka:9092", "channelServicePortName": "http-channel", "channelServicePortNumber": "8080"},
+			expected: &KafkaConfig{
+				Brokers:                  []string{"kafkabroker.kafka:9092"},
+				MaxIdleConns:             1000,
+				MaxIdleConnsPerHost:      100,
+				ChannelServicePortName:   "http-channel",
+				ChannelServicePortNumber: 8080,
+			},
+		},
+		{
+			name: "channel service app protocol",
+			data: map[string]string{"bootstrapServers": "kafkabroker.kafka:9092", "channelServiceAppProtocol": "http"},
+			expected: &KafkaConfig{
+				Brokers:                   []string{"kafkabroker.kafka:9092"},
+				MaxIdleConns:              1000,
+				MaxIdleConnsPerHost:       100,
+				ChannelServiceAppProtocol: "http",
+			},
+		},
+		{
+			name:     "invalid channel service port number",
+			data:     map[string]string{"bootstrapServers": "kafkabroker.kafka:9092", "channelServicePortNumber": "foo"},
+			getError: `failed to parse "channelServicePortNumber": strconv.ParseInt: parsing "foo": invalid syntax`,
+		},
 	}
 
 	for _, tc := range testCases {
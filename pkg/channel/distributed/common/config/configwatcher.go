@@ -20,9 +20,11 @@ import (
 	"context"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/injection/sharedmain"
@@ -33,42 +35,193 @@ import (
 // stored in the config-eventing-kafka configmap.  The sub-structs are explicitly declared so that they
 // can have their own JSON tags in the overall EventingKafkaConfig
 type EKKubernetesConfig struct {
-	CpuLimit      resource.Quantity `json:"cpuLimit,omitempty"`
-	CpuRequest    resource.Quantity `json:"cpuRequest,omitempty"`
-	MemoryLimit   resource.Quantity `json:"memoryLimit,omitempty"`
-	MemoryRequest resource.Quantity `json:"memoryRequest,omitempty"`
-	Replicas      int               `json:"replicas,omitempty"`
+	CpuLimit      resource.Quantity   `json:"cpuLimit,omitempty"`
+	CpuRequest    resource.Quantity   `json:"cpuRequest,omitempty"`
+	MemoryLimit   resource.Quantity   `json:"memoryLimit,omitempty"`
+	MemoryRequest resource.Quantity   `json:"memoryRequest,omitempty"`
+	Replicas      int                 `json:"replicas,omitempty"`
+	NodeSelector  map[string]string   `json:"nodeSelector,omitempty"`
+	Tolerations   []corev1.Toleration `json:"tolerations,omitempty"`
+	Affinity      *corev1.Affinity    `json:"affinity,omitempty"`
 }
 
-// The Receiver config has the base Kubernetes fields (Cpu, Memory, Replicas) only
+// The Receiver config has the base Kubernetes fields (Cpu, Memory, Replicas, Scheduling) only
 type EKReceiverConfig struct {
 	EKKubernetesConfig
 }
 
-// The Dispatcher config has the base Kubernetes fields (Cpu, Memory, Replicas) only
+// The Dispatcher config has the base Kubernetes fields (Cpu, Memory, Replicas, Scheduling), the Kafka readiness check interval, the startup probe timings, the shutdown drain timeout, the Kafka rack ID, the consumer lag polling interval, the optional Kafka record CloudEvent extensions, the optional KEDA autoscaling, the optional PodDisruptionBudget, any additional Volumes / VolumeMounts (e.g. certificate files) and any additional Env vars (e.g. proxy settings or Secret / ConfigMap references)
 type EKDispatcherConfig struct {
 	EKKubernetesConfig
+	ReadinessIntervalSeconds   int32                       `json:"readinessIntervalSeconds,omitempty"`
+	StartupProbe               EKStartupProbeConfig        `json:"startupProbe,omitempty"`
+	DrainTimeoutSeconds        int32                       `json:"drainTimeoutSeconds,omitempty"`
+	RackId                     string                      `json:"rackId,omitempty"`
+	RackIdFromNodeZone         bool                        `json:"rackIdFromNodeZone,omitempty"`
+	ConsumerLagIntervalSeconds int32                       `json:"consumerLagIntervalSeconds,omitempty"`
+	EnableKafkaExtensions      bool                        `json:"enableKafkaExtensions,omitempty"`
+	Keda                       EKKedaConfig                `json:"keda,omitempty"`
+	PodDisruptionBudget        EKPodDisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
+	OffsetCommit               EKOffsetCommitConfig        `json:"offsetCommit,omitempty"`
+	MaxInFlight                EKMaxInFlightConfig         `json:"maxInFlight,omitempty"`
+	Volumes                    []corev1.Volume             `json:"volumes,omitempty"`
+	VolumeMounts               []corev1.VolumeMount        `json:"volumeMounts,omitempty"`
+	Env                        []corev1.EnvVar             `json:"env,omitempty"`
 }
 
-// EKKafkaTopicConfig contains some defaults that are only used if not provided by the channel spec
+// EKOffsetCommitConfig controls when (and where) each Dispatcher's ConsumerGroups commit the offsets of consumed messages
+type EKOffsetCommitConfig struct {
+	Strategy       string `json:"strategy,omitempty"`
+	IntervalMillis int64  `json:"intervalMillis,omitempty"`
+	Store          string `json:"store,omitempty"`
+}
+
+// EKMaxInFlightConfig limits the concurrent in-flight deliveries of each Dispatcher & each of its Subscriptions (zero is unlimited)
+type EKMaxInFlightConfig struct {
+	Dispatcher   int32 `json:"dispatcher,omitempty"`
+	Subscription int32 `json:"subscription,omitempty"`
+}
+
+// EKStartupProbeConfig controls the startup probe of each Dispatcher Deployment (which must succeed before the liveness probe applies)
+type EKStartupProbeConfig struct {
+	PeriodSeconds    int32 `json:"periodSeconds,omitempty"`
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+// EKKedaConfig controls the (feature flagged) KEDA ScaledObject generated for each Dispatcher Deployment
+type EKKedaConfig struct {
+	Enabled           bool   `json:"enabled,omitempty"`
+	MinReplicaCount   int32  `json:"minReplicaCount,omitempty"`
+	MaxReplicaCount   int32  `json:"maxReplicaCount,omitempty"`
+	PollingInterval   int32  `json:"pollingInterval,omitempty"`
+	CooldownPeriod    int32  `json:"cooldownPeriod,omitempty"`
+	LagThreshold      int64  `json:"lagThreshold,omitempty"`
+	AuthenticationRef string `json:"authenticationRef,omitempty"`
+}
+
+// EKPodDisruptionBudgetConfig controls the (feature flagged) PodDisruptionBudget generated for each Dispatcher Deployment
+type EKPodDisruptionBudgetConfig struct {
+	Enabled      bool                `json:"enabled,omitempty"`
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+}
+
+// EKKafkaTopicConfig contains some defaults that are only used if not provided by the channel spec, along with
+// optional relative weights (by broker ID) for assigning the replicas of new topics (empty for automatic assignment)
 type EKKafkaTopicConfig struct {
-	DefaultNumPartitions     int32 `json:"defaultNumPartitions,omitempty"`
-	DefaultReplicationFactor int16 `json:"defaultReplicationFactor,omitempty"`
-	DefaultRetentionMillis   int64 `json:"defaultRetentionMillis,omitempty"`
+	DefaultNumPartitions     int32           `json:"defaultNumPartitions,omitempty"`
+	DefaultReplicationFactor int16           `json:"defaultReplicationFactor,omitempty"`
+	DefaultRetentionMillis   int64           `json:"defaultRetentionMillis,omitempty"`
+	BrokerWeights            map[int32]int32 `json:"brokerWeights,omitempty"`
+}
+
+// EKRootCAConfigMapConfig references a ConfigMap key (in the knative-eventing namespace) holding the CA PEM(s)
+type EKRootCAConfigMapConfig struct {
+	Name string `json:"name,omitempty"`
+	Key  string `json:"key,omitempty"`
+}
+
+// EKRequeueConfig controls the (optionally jittered) delay before retrying reconciliations failed by transient Kafka errors
+type EKRequeueConfig struct {
+	DelayMillis  int64   `json:"delayMillis,omitempty"`
+	JitterFactor float64 `json:"jitterFactor,omitempty"`
+}
+
+// EKTopicACLConfig controls the read / write ACLs granted to the dispatcher & receiver principals on each Kafka Topic
+type EKTopicACLConfig struct {
+	Enabled             bool   `json:"enabled,omitempty"`
+	DispatcherPrincipal string `json:"dispatcherPrincipal,omitempty"`
+	ReceiverPrincipal   string `json:"receiverPrincipal,omitempty"`
+}
+
+// EKTopicFinalizationConfig bounds the Kafka Topic deletion performed when finalizing a KafkaChannel (optionally orphaning the Topic on timeout)
+type EKTopicFinalizationConfig struct {
+	TimeoutMillis int64 `json:"timeoutMillis,omitempty"`
+	AllowOrphan   bool  `json:"allowOrphan,omitempty"`
+}
+
+// EKTopicOwnershipConfig records the Kafka Topics created by each KafkaChannel (in its status) & only deletes those
+type EKTopicOwnershipConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
 }
 
-// EKKafkaConfig contains items relevant to Kafka specifically, and the Sarama logging flag
+// EKCircuitBreakerConfig controls the per-Kafka-Secret circuit breaker which stops the controller connecting to an unreachable cluster
+type EKCircuitBreakerConfig struct {
+	Enabled            bool  `json:"enabled,omitempty"`
+	FailureThreshold   int   `json:"failureThreshold,omitempty"`
+	OpenDurationMillis int64 `json:"openDurationMillis,omitempty"`
+}
+
+// EKReconcileShortCircuitConfig controls skipping the Kafka work when reconciling unchanged & healthy KafkaChannels
+type EKReconcileShortCircuitConfig struct {
+	Enabled                      bool  `json:"enabled,omitempty"`
+	DeepReconcileIntervalSeconds int64 `json:"deepReconcileIntervalSeconds,omitempty"`
+}
+
+// EKTLSConfig contains overrides for the TLS connections to the Kafka brokers (e.g. when reached via a TLS-terminating proxy)
+type EKTLSConfig struct {
+	ServerName         string `json:"serverName,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// EKBrokerDiscoveryConfig optionally resolves (and periodically re-resolves) the Kafka brokers from a DNS SRV record
+type EKBrokerDiscoveryConfig struct {
+	SrvRecord              string `json:"srvRecord,omitempty"`
+	RefreshIntervalSeconds int64  `json:"refreshIntervalSeconds,omitempty"`
+}
+
+// EKConsumerConfig optionally overrides the Sarama consumer tunables, named for parity with the KafkaSource (durations such as "3s")
+type EKConsumerConfig struct {
+	MaxPollRecords    int    `json:"maxPollRecords,omitempty"`
+	HeartbeatInterval string `json:"heartbeatInterval,omitempty"`
+	MaxProcessingTime string `json:"maxProcessingTime,omitempty"`
+}
+
+// EKExtensionsConfig is the default allow / deny list of CloudEvent extension names propagated by the Receiver & Dispatcher (overridden per KafkaChannel via annotations)
+type EKExtensionsConfig struct {
+	Allow []string `json:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty"`
+}
+
+// EKKafkaConfig contains items relevant to Kafka specifically, and the Sarama logging, producer idempotence / acks & consumer settings
 type EKKafkaConfig struct {
-	EnableSaramaLogging bool               `json:"enableSaramaLogging,omitempty"`
-	Topic               EKKafkaTopicConfig `json:"topic,omitempty"`
-	AdminType           string             `json:"adminType,omitempty"`
+	EnableSaramaLogging          bool                          `json:"enableSaramaLogging,omitempty"`
+	EnableIdempotentProducer     bool                          `json:"enableIdempotentProducer,omitempty"`
+	RequiredAcks                 string                        `json:"requiredAcks,omitempty"`
+	Version                      string                        `json:"version,omitempty"`
+	Topic                        EKKafkaTopicConfig            `json:"topic,omitempty"`
+	AdminType                    string                        `json:"adminType,omitempty"`
+	AdminClientIdleTimeoutMillis int64                         `json:"adminClientIdleTimeoutMillis,omitempty"`
+	DryRun                       bool                          `json:"dryRun,omitempty"`
+	DisableTopicAutoCreate       bool                          `json:"disableTopicAutoCreate,omitempty"`
+	TopicNameTemplate            string                        `json:"topicNameTemplate,omitempty"`
+	ConsumerGroupNameTemplate    string                        `json:"consumerGroupNameTemplate,omitempty"`
+	ClientIdTemplate             string                        `json:"clientIdTemplate,omitempty"`
+	RootCAConfigMap              EKRootCAConfigMapConfig       `json:"rootCAConfigMap,omitempty"`
+	TransientErrorRequeue        EKRequeueConfig               `json:"transientErrorRequeue,omitempty"`
+	TopicACLs                    EKTopicACLConfig              `json:"topicAcls,omitempty"`
+	BootstrapTopics              bool                          `json:"bootstrapTopics,omitempty"`
+	TLS                          EKTLSConfig                   `json:"tls,omitempty"`
+	BrokerDiscovery              EKBrokerDiscoveryConfig       `json:"brokerDiscovery,omitempty"`
+	CircuitBreaker               EKCircuitBreakerConfig        `json:"circuitBreaker,omitempty"`
+	TopicFinalization            EKTopicFinalizationConfig     `json:"topicFinalization,omitempty"`
+	TopicOwnership               EKTopicOwnershipConfig        `json:"topicOwnership,omitempty"`
+	ReconcileShortCircuit        EKReconcileShortCircuitConfig `json:"reconcileShortCircuit,omitempty"`
+	Consumer                     EKConsumerConfig              `json:"consumer,omitempty"`
+	Extensions                   EKExtensionsConfig            `json:"extensions,omitempty"`
 }
 
-// EventingKafkaConfig is the main struct that holds the Receiver, Dispatcher, and Kafka sub-items
+// EKMetadataConfig contains additional labels & annotations merged onto the generated Deployments and Services
+type EKMetadataConfig struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// EventingKafkaConfig is the main struct that holds the Receiver, Dispatcher, Kafka and Metadata sub-items
 type EventingKafkaConfig struct {
 	Receiver   EKReceiverConfig   `json:"receiver,omitempty"`
 	Dispatcher EKDispatcherConfig `json:"dispatcher,omitempty"`
 	Kafka      EKKafkaConfig      `json:"kafka,omitempty"`
+	Metadata   EKMetadataConfig   `json:"metadata,omitempty"`
 }
 
 //
@@ -76,18 +229,31 @@ type EventingKafkaConfig struct {
 // Much Of This Function Is Taken From The knative.dev sharedmain Package
 //
 func InitializeConfigWatcher(ctx context.Context, logger *zap.SugaredLogger, handler configmap.Observer) error {
+	return initializeConfigMapWatcher(ctx, logger, SettingsConfigMapName, handler)
+}
+
+// Initialize The Specified Context With A Watcher On The Root CA ConfigMap (If Referenced In The Config)
+func InitializeRootCAConfigWatcher(ctx context.Context, logger *zap.SugaredLogger, config *EventingKafkaConfig, handler configmap.Observer) error {
+	if config == nil || len(config.Kafka.RootCAConfigMap.Name) <= 0 {
+		return nil
+	}
+	return initializeConfigMapWatcher(ctx, logger, config.Kafka.RootCAConfigMap.Name, handler)
+}
+
+// Watch The Specified ConfigMap (In The System Namespace) With The Specified Handler
+func initializeConfigMapWatcher(ctx context.Context, logger *zap.SugaredLogger, name string, handler configmap.Observer) error {
 
-	// Create A Watcher On The Configuration Settings ConfigMap & Dynamically Update Configuration
+	// Create A Watcher On The Specified ConfigMap & Dynamically Update Configuration
 	// Since this is designed to be called by the main() function, the default KNative package behavior here
 	// is a fatal exit if the watch cannot be set up.
 	watcher := sharedmain.SetupConfigMapWatchOrDie(ctx, logger)
 
 	// Start The ConfigMap Watcher
 	// Taken from knative.dev/pkg/injection/sharedmain/main.go::WatchObservabilityConfigOrDie
-	if _, err := kubeclient.Get(ctx).CoreV1().ConfigMaps(system.Namespace()).Get(ctx, SettingsConfigMapName, metav1.GetOptions{}); err == nil {
-		watcher.Watch(SettingsConfigMapName, handler)
+	if _, err := kubeclient.Get(ctx).CoreV1().ConfigMaps(system.Namespace()).Get(ctx, name, metav1.GetOptions{}); err == nil {
+		watcher.Watch(name, handler)
 	} else if !apierrors.IsNotFound(err) {
-		logger.Error("Error reading ConfigMap "+SettingsConfigMapName, zap.Error(err))
+		logger.Error("Error reading ConfigMap "+name, zap.Error(err))
 		return err
 	}
 
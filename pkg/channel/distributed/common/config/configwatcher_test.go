@@ -96,6 +96,42 @@ func TestInitializeConfigWatcher(t *testing.T) {
 	assert.Equal(t, getWatchedMap().Data["sarama"], commontesting.NewSaramaConfig)
 }
 
+// Test The InitializeRootCAConfigWatcher() Functionality
+func TestInitializeRootCAConfigWatcher(t *testing.T) {
+
+	// Test Data
+	logger := logtesting.TestLogger(t)
+	rootCAConfigMapName := "test-root-ca"
+	rootCAConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: rootCAConfigMapName, Namespace: constants.KnativeEventingNamespace},
+		Data:       map[string]string{RootCAConfigMapKeyDefault: "TestCaPem"},
+	}
+
+	// Setup Environment
+	assert.Nil(t, os.Setenv(system.NamespaceEnvKey, constants.KnativeEventingNamespace))
+
+	// Add A Fake K8S Client With The Root CA ConfigMap To The Context
+	ctx, cancel := context.WithCancel(context.WithValue(context.TODO(), injectionclient.Key{}, fake.NewSimpleClientset(rootCAConfigMap)))
+	defer cancel()
+
+	// Verify Nothing Is Watched If The Root CA ConfigMap Is Not Referenced
+	setWatchedMap(nil)
+	assert.Nil(t, InitializeRootCAConfigWatcher(ctx, logger, nil, configWatcherHandler))
+	assert.Nil(t, InitializeRootCAConfigWatcher(ctx, logger, &EventingKafkaConfig{}, configWatcherHandler))
+	assert.Nil(t, getWatchedMap())
+
+	// Perform The Test (Initialize The Root CA ConfigMap Watcher)
+	config := &EventingKafkaConfig{Kafka: EKKafkaConfig{RootCAConfigMap: EKRootCAConfigMapConfig{Name: rootCAConfigMapName}}}
+	assert.Nil(t, InitializeRootCAConfigWatcher(ctx, logger, config, configWatcherHandler))
+
+	// Wait for the configWatcherHandler to be called (happens pretty quickly; loop usually only runs once)
+	for try := 0; getWatchedMap() == nil && try < 100; try++ {
+		time.Sleep(5 * time.Millisecond)
+	}
+	assert.NotNil(t, getWatchedMap())
+	assert.Equal(t, "TestCaPem", getWatchedMap().Data[RootCAConfigMapKeyDefault])
+}
+
 func getWatchedMap() *corev1.ConfigMap {
 	configMapMutex.Lock()
 	defer configMapMutex.Unlock()
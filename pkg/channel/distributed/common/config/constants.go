@@ -23,4 +23,16 @@ const (
 	// The name of the keys in the Data section of the eventing-kafka configmap that holds Sarama and Eventing-Kafka configuration YAML
 	SaramaSettingsConfigKey        = "sarama"
 	EventingKafkaSettingsConfigKey = "eventing-kafka"
+	// The name of the key in the Data section of the eventing-kafka configmap that pauses all KafkaChannel reconciliation ("true" / "false")
+	ReconcilePausedConfigKey = "reconcile-paused"
+	// The default key in the Data section of the (optional) Root CA configmap that holds the CA PEM(s)
+	RootCAConfigMapKeyDefault = "ca.crt"
+	// The Dispatcher offset commit strategies (auto is the default)
+	OffsetCommitStrategyAuto           = "auto"
+	OffsetCommitStrategyManualAfterAck = "manual-after-ack"
+	// The Dispatcher offset stores (kafka is the default)
+	OffsetStoreKafka     = "kafka"
+	OffsetStoreConfigMap = "configmap"
+	// The default interval at which the Kafka brokers are re-resolved from any configured DNS SRV record
+	BrokerDiscoveryRefreshIntervalSecondsDefault = 60
 )
@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+//
+// Validate The Additional Env Vars Of The Specified Component
+//
+// The checks mirror the Kubernetes API server validations (unique & legal names, and either a literal value
+// or exactly one valueFrom source) so that an invalid ConfigMap is reported when it is loaded, rather than
+// as a rejected Deployment for every Dispatcher.
+//
+func ValidateEnvConfig(name string, envVars []corev1.EnvVar) error {
+	envVarNames := make(map[string]bool, len(envVars))
+	for index, envVar := range envVars {
+		if errs := validation.IsEnvVarName(envVar.Name); len(errs) > 0 {
+			return fmt.Errorf("%s.env[%d]: invalid name %q: %s", name, index, envVar.Name, strings.Join(errs, "; "))
+		}
+		if envVarNames[envVar.Name] {
+			return fmt.Errorf("%s.env[%d]: duplicate name %q", name, index, envVar.Name)
+		}
+		envVarNames[envVar.Name] = true
+		if envVar.ValueFrom != nil {
+			if len(envVar.Value) > 0 {
+				return fmt.Errorf("%s.env[%d]: may not specify both value and valueFrom", name, index)
+			}
+			if sources := countEnvVarSources(*envVar.ValueFrom); sources != 1 {
+				return fmt.Errorf("%s.env[%d]: valueFrom must specify exactly one source (found %d)", name, index, sources)
+			}
+		}
+	}
+	return nil
+}
+
+// Count The Populated Sources (SecretKeyRef, ConfigMapKeyRef, FieldRef, etc.) Of An EnvVarSource
+func countEnvVarSources(envVarSource corev1.EnvVarSource) int {
+	count := 0
+	value := reflect.ValueOf(envVarSource)
+	for i := 0; i < value.NumField(); i++ {
+		if field := value.Field(i); field.Kind() == reflect.Ptr && !field.IsNil() {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Test The ValidateEnvConfig() Functionality
+func TestValidateEnvConfig(t *testing.T) {
+
+	// Test Data
+	secretKeyRef := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tracing"}, Key: "token"}
+	configMapKeyRef := &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tracing"}, Key: "endpoint"}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only    bool
+		name    string
+		envVars []corev1.EnvVar
+		wantErr bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Empty"},
+		{name: "Valid", envVars: []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:3128"}, {Name: "TRACING_TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretKeyRef}}, {Name: "TRACING_ENDPOINT", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: configMapKeyRef}}}},
+		{name: "Empty Value", envVars: []corev1.EnvVar{{Name: "HTTP_PROXY"}}},
+		{name: "Invalid Name", envVars: []corev1.EnvVar{{Name: "1HTTP=PROXY", Value: "http://proxy:3128"}}, wantErr: true},
+		{name: "Empty Name", envVars: []corev1.EnvVar{{Value: "http://proxy:3128"}}, wantErr: true},
+		{name: "Duplicate Name", envVars: []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:3128"}, {Name: "HTTP_PROXY", Value: "http://proxy:8080"}}, wantErr: true},
+		{name: "Value And ValueFrom", envVars: []corev1.EnvVar{{Name: "TRACING_TOKEN", Value: "token", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretKeyRef}}}, wantErr: true},
+		{name: "No ValueFrom Source", envVars: []corev1.EnvVar{{Name: "TRACING_TOKEN", ValueFrom: &corev1.EnvVarSource{}}}, wantErr: true},
+		{name: "Multiple ValueFrom Sources", envVars: []corev1.EnvVar{{Name: "TRACING_TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretKeyRef, ConfigMapKeyRef: configMapKeyRef}}}, wantErr: true},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateEnvConfig("dispatcher", testCase.envVars)
+			assert.Equal(t, testCase.wantErr, err != nil)
+		})
+	}
+}
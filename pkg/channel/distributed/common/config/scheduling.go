@@ -0,0 +1,219 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+//
+// Validate The Pod Scheduling Controls (NodeSelector, Tolerations & Affinity) Of The Specified Kubernetes Config
+//
+// The checks mirror the most common Kubernetes API server validations so that an invalid ConfigMap is
+// reported when it is loaded, rather than as a rejected Deployment for every Receiver / Dispatcher.
+//
+func ValidateSchedulingConfig(name string, kubernetesConfig EKKubernetesConfig) error {
+	for key, value := range kubernetesConfig.NodeSelector {
+		if err := validateLabel(key, value); err != nil {
+			return fmt.Errorf("%s.nodeSelector: %v", name, err)
+		}
+	}
+	for index, toleration := range kubernetesConfig.Tolerations {
+		if err := validateToleration(toleration); err != nil {
+			return fmt.Errorf("%s.tolerations[%d]: %v", name, index, err)
+		}
+	}
+	if err := validateAffinity(kubernetesConfig.Affinity); err != nil {
+		return fmt.Errorf("%s.affinity: %v", name, err)
+	}
+	return nil
+}
+
+// Validate A Single Label Key / Value Pair
+func validateLabel(key string, value string) error {
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return fmt.Errorf("invalid label key %q: %s", key, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+		return fmt.Errorf("invalid label value %q: %s", value, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Validate A Single Toleration
+func validateToleration(toleration corev1.Toleration) error {
+	if len(toleration.Key) > 0 {
+		if errs := validation.IsQualifiedName(toleration.Key); len(errs) > 0 {
+			return fmt.Errorf("invalid key %q: %s", toleration.Key, strings.Join(errs, "; "))
+		}
+	} else if toleration.Operator != corev1.TolerationOpExists {
+		return fmt.Errorf("operator must be %s when key is empty", corev1.TolerationOpExists)
+	}
+	switch toleration.Operator {
+	case "", corev1.TolerationOpEqual:
+		if errs := validation.IsValidLabelValue(toleration.Value); len(errs) > 0 {
+			return fmt.Errorf("invalid value %q: %s", toleration.Value, strings.Join(errs, "; "))
+		}
+	case corev1.TolerationOpExists:
+		if len(toleration.Value) > 0 {
+			return fmt.Errorf("value must be empty when operator is %s", corev1.TolerationOpExists)
+		}
+	default:
+		return fmt.Errorf("unsupported operator %q", toleration.Operator)
+	}
+	switch toleration.Effect {
+	case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+	default:
+		return fmt.Errorf("unsupported effect %q", toleration.Effect)
+	}
+	if toleration.TolerationSeconds != nil && toleration.Effect != corev1.TaintEffectNoExecute {
+		return fmt.Errorf("tolerationSeconds requires effect %s", corev1.TaintEffectNoExecute)
+	}
+	return nil
+}
+
+// Validate The (Optional) Affinity
+func validateAffinity(affinity *corev1.Affinity) error {
+	if affinity == nil {
+		return nil
+	}
+	if nodeAffinity := affinity.NodeAffinity; nodeAffinity != nil {
+		if required := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+			if len(required.NodeSelectorTerms) == 0 {
+				return fmt.Errorf("nodeAffinity requires at least one nodeSelectorTerm")
+			}
+			for _, term := range required.NodeSelectorTerms {
+				if err := validateNodeSelectorTerm(term); err != nil {
+					return fmt.Errorf("nodeAffinity: %v", err)
+				}
+			}
+		}
+		for _, preferred := range nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			if err := validateWeight(preferred.Weight); err != nil {
+				return fmt.Errorf("nodeAffinity: %v", err)
+			}
+			if err := validateNodeSelectorTerm(preferred.Preference); err != nil {
+				return fmt.Errorf("nodeAffinity: %v", err)
+			}
+		}
+	}
+	if podAffinity := affinity.PodAffinity; podAffinity != nil {
+		if err := validatePodAffinityTerms(podAffinity.RequiredDuringSchedulingIgnoredDuringExecution, podAffinity.PreferredDuringSchedulingIgnoredDuringExecution); err != nil {
+			return fmt.Errorf("podAffinity: %v", err)
+		}
+	}
+	if podAntiAffinity := affinity.PodAntiAffinity; podAntiAffinity != nil {
+		if err := validatePodAffinityTerms(podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution); err != nil {
+			return fmt.Errorf("podAntiAffinity: %v", err)
+		}
+	}
+	return nil
+}
+
+// Validate A NodeSelectorTerm's Expressions & Fields
+func validateNodeSelectorTerm(term corev1.NodeSelectorTerm) error {
+	for _, requirement := range term.MatchExpressions {
+		if errs := validation.IsQualifiedName(requirement.Key); len(errs) > 0 {
+			return fmt.Errorf("invalid matchExpressions key %q: %s", requirement.Key, strings.Join(errs, "; "))
+		}
+		if err := validateNodeSelectorRequirement(requirement); err != nil {
+			return err
+		}
+	}
+	for _, requirement := range term.MatchFields {
+		if requirement.Key != "metadata.name" {
+			return fmt.Errorf("unsupported matchFields key %q", requirement.Key)
+		}
+		if requirement.Operator != corev1.NodeSelectorOpIn && requirement.Operator != corev1.NodeSelectorOpNotIn {
+			return fmt.Errorf("unsupported matchFields operator %q", requirement.Operator)
+		}
+		if len(requirement.Values) != 1 {
+			return fmt.Errorf("matchFields requires exactly one value")
+		}
+	}
+	return nil
+}
+
+// Validate A NodeSelectorRequirement's Operator & Values
+func validateNodeSelectorRequirement(requirement corev1.NodeSelectorRequirement) error {
+	switch requirement.Operator {
+	case corev1.NodeSelectorOpIn, corev1.NodeSelectorOpNotIn:
+		if len(requirement.Values) == 0 {
+			return fmt.Errorf("operator %s for key %q requires values", requirement.Operator, requirement.Key)
+		}
+	case corev1.NodeSelectorOpExists, corev1.NodeSelectorOpDoesNotExist:
+		if len(requirement.Values) > 0 {
+			return fmt.Errorf("operator %s for key %q does not accept values", requirement.Operator, requirement.Key)
+		}
+	case corev1.NodeSelectorOpGt, corev1.NodeSelectorOpLt:
+		if len(requirement.Values) != 1 {
+			return fmt.Errorf("operator %s for key %q requires exactly one value", requirement.Operator, requirement.Key)
+		}
+		if _, err := strconv.ParseInt(requirement.Values[0], 10, 64); err != nil {
+			return fmt.Errorf("operator %s for key %q requires an integer value", requirement.Operator, requirement.Key)
+		}
+	default:
+		return fmt.Errorf("unsupported operator %q for key %q", requirement.Operator, requirement.Key)
+	}
+	return nil
+}
+
+// Validate The Required & Preferred PodAffinityTerms Of A PodAffinity / PodAntiAffinity
+func validatePodAffinityTerms(required []corev1.PodAffinityTerm, preferred []corev1.WeightedPodAffinityTerm) error {
+	for _, term := range required {
+		if err := validatePodAffinityTerm(term); err != nil {
+			return err
+		}
+	}
+	for _, weightedTerm := range preferred {
+		if err := validateWeight(weightedTerm.Weight); err != nil {
+			return err
+		}
+		if err := validatePodAffinityTerm(weightedTerm.PodAffinityTerm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate A Single PodAffinityTerm's TopologyKey & LabelSelector
+func validatePodAffinityTerm(term corev1.PodAffinityTerm) error {
+	if len(term.TopologyKey) == 0 {
+		return fmt.Errorf("topologyKey must not be empty")
+	}
+	if errs := validation.IsQualifiedName(term.TopologyKey); len(errs) > 0 {
+		return fmt.Errorf("invalid topologyKey %q: %s", term.TopologyKey, strings.Join(errs, "; "))
+	}
+	if _, err := metav1.LabelSelectorAsSelector(term.LabelSelector); err != nil {
+		return fmt.Errorf("invalid labelSelector: %v", err)
+	}
+	return nil
+}
+
+// Validate A Preferred Scheduling Term Weight
+func validateWeight(weight int32) error {
+	if weight < 1 || weight > 100 {
+		return fmt.Errorf("weight %d must be in the range 1-100", weight)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// Test The ValidateSchedulingConfig() Functionality
+func TestValidateSchedulingConfig(t *testing.T) {
+
+	// Utility Function For Creating A NodeAffinity With A Single Required NodeSelectorRequirement
+	newNodeAffinity := func(requirement corev1.NodeSelectorRequirement) *corev1.Affinity {
+		return &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{requirement}}},
+				},
+			},
+		}
+	}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only    bool
+		name    string
+		config  EKKubernetesConfig
+		wantErr bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:   "Empty",
+			config: EKKubernetesConfig{},
+		},
+		{
+			name: "Valid",
+			config: EKKubernetesConfig{
+				NodeSelector: map[string]string{"node.kubernetes.io/pool": "kafka"},
+				Tolerations: []corev1.Toleration{
+					{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "kafka", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "node.kubernetes.io/unreachable", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: pointer.Int64Ptr(30)},
+					{Operator: corev1.TolerationOpExists},
+				},
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+								MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gpu", Operator: corev1.NodeSelectorOpDoesNotExist}},
+							}},
+						},
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{{
+							Weight:     50,
+							Preference: corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "cpus", Operator: corev1.NodeSelectorOpGt, Values: []string{"8"}}}},
+						}},
+					},
+					PodAntiAffinity: &corev1.PodAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+							Weight: 100,
+							PodAffinityTerm: corev1.PodAffinityTerm{
+								LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "dispatcher"}},
+								TopologyKey:   "kubernetes.io/hostname",
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name:    "Invalid NodeSelector Key",
+			config:  EKKubernetesConfig{NodeSelector: map[string]string{"invalid key": "kafka"}},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid NodeSelector Value",
+			config:  EKKubernetesConfig{NodeSelector: map[string]string{"pool": "invalid value"}},
+			wantErr: true,
+		},
+		{
+			name:    "Toleration Without Key Or Exists Operator",
+			config:  EKKubernetesConfig{Tolerations: []corev1.Toleration{{Value: "kafka"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Toleration Exists With Value",
+			config:  EKKubernetesConfig{Tolerations: []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists, Value: "kafka"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Toleration Unknown Operator",
+			config:  EKKubernetesConfig{Tolerations: []corev1.Toleration{{Key: "dedicated", Operator: "Matches"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Toleration Unknown Effect",
+			config:  EKKubernetesConfig{Tolerations: []corev1.Toleration{{Key: "dedicated", Effect: "NoRun"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Toleration Seconds Without NoExecute",
+			config:  EKKubernetesConfig{Tolerations: []corev1.Toleration{{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule, TolerationSeconds: pointer.Int64Ptr(30)}}},
+			wantErr: true,
+		},
+		{
+			name:    "NodeAffinity Without Terms",
+			config:  EKKubernetesConfig{Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{}}}},
+			wantErr: true,
+		},
+		{
+			name:    "NodeAffinity In Without Values",
+			config:  EKKubernetesConfig{Affinity: newNodeAffinity(corev1.NodeSelectorRequirement{Key: "pool", Operator: corev1.NodeSelectorOpIn})},
+			wantErr: true,
+		},
+		{
+			name:    "NodeAffinity Exists With Values",
+			config:  EKKubernetesConfig{Affinity: newNodeAffinity(corev1.NodeSelectorRequirement{Key: "pool", Operator: corev1.NodeSelectorOpExists, Values: []string{"kafka"}})},
+			wantErr: true,
+		},
+		{
+			name:    "NodeAffinity Gt Non-Integer",
+			config:  EKKubernetesConfig{Affinity: newNodeAffinity(corev1.NodeSelectorRequirement{Key: "cpus", Operator: corev1.NodeSelectorOpGt, Values: []string{"many"}})},
+			wantErr: true,
+		},
+		{
+			name:    "NodeAffinity Unknown Operator",
+			config:  EKKubernetesConfig{Affinity: newNodeAffinity(corev1.NodeSelectorRequirement{Key: "pool", Operator: "Matches"})},
+			wantErr: true,
+		},
+		{
+			name: "NodeAffinity Invalid Weight",
+			config: EKKubernetesConfig{Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{{Weight: 0}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "PodAffinity Empty TopologyKey",
+			config: EKKubernetesConfig{Affinity: &corev1.Affinity{PodAffinity: &corev1.PodAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "PodAntiAffinity Invalid LabelSelector",
+			config: EKKubernetesConfig{Affinity: &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+					TopologyKey:   "kubernetes.io/hostname",
+					LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Matches"}}},
+				}},
+			}}},
+			wantErr: true,
+		},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateSchedulingConfig("dispatcher", testCase.config)
+			assert.Equal(t, testCase.wantErr, err != nil, "unexpected error: %v", err)
+		})
+	}
+}
@@ -21,7 +21,9 @@ import (
 	"errors"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
+	commontracing "knative.dev/eventing-kafka/pkg/common/tracing"
 	injectionclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/system"
@@ -49,6 +51,11 @@ func InitializeTracing(logger *zap.SugaredLogger, ctx context.Context, service s
 		return err
 	}
 
+	// Enable Trace Propagation Through The Channel Only While A Tracing Backend Is Configured
+	cmw.Watch(tracingconfig.ConfigName, func(configMap *corev1.ConfigMap) {
+		updateTracingEnabled(logger, configMap)
+	})
+
 	// Start The Tracing ConfigMap Watcher
 	if err := cmw.Start(ctx.Done()); err != nil {
 		logger.Error("Failed To Start ConfigMap Watcher", zap.Error(err))
@@ -57,3 +64,17 @@ func InitializeTracing(logger *zap.SugaredLogger, ctx context.Context, service s
 
 	return nil
 }
+
+// Enable / Disable Trace Propagation Based On Whether The Tracing ConfigMap Specifies A Backend
+func updateTracingEnabled(logger *zap.SugaredLogger, configMap *corev1.ConfigMap) {
+	tracingConfig, err := tracingconfig.NewTracingConfigFromConfigMap(configMap)
+	if err != nil {
+		logger.Warn("Failed To Parse Tracing ConfigMap - Trace Propagation Unchanged", zap.Error(err))
+		return
+	}
+	enabled := tracingConfig.Backend != tracingconfig.None
+	if enabled != commontracing.Enabled() {
+		logger.Info("Updating Trace Propagation", zap.Bool("Enabled", enabled))
+		commontracing.SetEnabled(enabled)
+	}
+}
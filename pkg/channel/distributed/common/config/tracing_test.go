@@ -26,6 +26,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 	"knative.dev/eventing-kafka/pkg/common/constants"
+	commontracing "knative.dev/eventing-kafka/pkg/common/tracing"
 	injectionclient "knative.dev/pkg/client/injection/kube/client"
 	logtesting "knative.dev/pkg/logging/testing"
 	"knative.dev/pkg/system"
@@ -86,3 +87,31 @@ func TestInitializeTracing_Failure(t *testing.T) {
 	err = InitializeTracing(logtesting.TestLogger(t), ctx, "TestService")
 	assert.NotNil(t, err)
 }
+
+// Test The updateTracingEnabled() Functionality
+func TestUpdateTracingEnabled(t *testing.T) {
+
+	// Restore The Default Trace Propagation Post-Test
+	defer commontracing.SetEnabled(true)
+
+	// Create A Tracing ConfigMap With The Specified Data
+	tracingConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{Name: tracingconfig.ConfigName, Namespace: system.Namespace()},
+			Data:       data,
+		}
+	}
+	logger := logtesting.TestLogger(t)
+
+	// Verify Trace Propagation Is Disabled Without A Backend
+	updateTracingEnabled(logger, tracingConfigMap(map[string]string{"backend": "none"}))
+	assert.False(t, commontracing.Enabled())
+
+	// Verify Trace Propagation Is Unchanged By An Invalid ConfigMap
+	updateTracingEnabled(logger, tracingConfigMap(map[string]string{"backend": "invalid"}))
+	assert.False(t, commontracing.Enabled())
+
+	// Verify Trace Propagation Is Enabled With A Backend
+	updateTracingEnabled(logger, tracingConfigMap(map[string]string{"backend": "zipkin", "zipkin-endpoint": "http://zipkin.istio-system.svc.cluster.local:9411/api/v2/spans"}))
+	assert.True(t, commontracing.Enabled())
+}
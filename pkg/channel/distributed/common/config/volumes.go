@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+//
+// Validate The Additional Volumes & VolumeMounts Of The Specified Component
+//
+// The checks mirror the most common Kubernetes API server validations (unique & legal names, exactly one
+// volume source, and mounts of known volumes at unique absolute paths) so that an invalid ConfigMap is
+// reported when it is loaded, rather than as a rejected Deployment for every Dispatcher.
+//
+func ValidateVolumesConfig(name string, volumes []corev1.Volume, volumeMounts []corev1.VolumeMount) error {
+	volumeNames := make(map[string]bool, len(volumes))
+	for index, volume := range volumes {
+		if errs := validation.IsDNS1123Label(volume.Name); len(errs) > 0 {
+			return fmt.Errorf("%s.volumes[%d]: invalid name %q: %s", name, index, volume.Name, strings.Join(errs, "; "))
+		}
+		if volumeNames[volume.Name] {
+			return fmt.Errorf("%s.volumes[%d]: duplicate name %q", name, index, volume.Name)
+		}
+		volumeNames[volume.Name] = true
+		if sources := countVolumeSources(volume.VolumeSource); sources != 1 {
+			return fmt.Errorf("%s.volumes[%d]: must specify exactly one volume source (found %d)", name, index, sources)
+		}
+	}
+	mountPaths := make(map[string]bool, len(volumeMounts))
+	for index, volumeMount := range volumeMounts {
+		if !volumeNames[volumeMount.Name] {
+			return fmt.Errorf("%s.volumeMounts[%d]: unknown volume %q", name, index, volumeMount.Name)
+		}
+		if !path.IsAbs(volumeMount.MountPath) {
+			return fmt.Errorf("%s.volumeMounts[%d]: mountPath %q must be absolute", name, index, volumeMount.MountPath)
+		}
+		mountPath := path.Clean(volumeMount.MountPath)
+		if mountPaths[mountPath] {
+			return fmt.Errorf("%s.volumeMounts[%d]: duplicate mountPath %q", name, index, mountPath)
+		}
+		mountPaths[mountPath] = true
+	}
+	return nil
+}
+
+// Count The Populated Sources (Secret, ConfigMap, EmptyDir, etc.) Of A VolumeSource
+func countVolumeSources(volumeSource corev1.VolumeSource) int {
+	count := 0
+	value := reflect.ValueOf(volumeSource)
+	for i := 0; i < value.NumField(); i++ {
+		if field := value.Field(i); field.Kind() == reflect.Ptr && !field.IsNil() {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Test The ValidateVolumesConfig() Functionality
+func TestValidateVolumesConfig(t *testing.T) {
+
+	// Test Data
+	secretVolume := corev1.Volume{Name: "kafka-certs", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "kafka-certs"}}}
+	configMapVolume := corev1.Volume{Name: "kafka-ca", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "kafka-ca"}}}}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only         bool
+		name         string
+		volumes      []corev1.Volume
+		volumeMounts []corev1.VolumeMount
+		wantErr      bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Empty"},
+		{name: "Valid", volumes: []corev1.Volume{secretVolume, configMapVolume}, volumeMounts: []corev1.VolumeMount{{Name: "kafka-certs", MountPath: "/etc/kafka/certs"}, {Name: "kafka-ca", MountPath: "/etc/kafka/ca"}}},
+		{name: "Unmounted Volume", volumes: []corev1.Volume{secretVolume}},
+		{name: "Invalid Volume Name", volumes: []corev1.Volume{{Name: "Kafka_Certs", VolumeSource: secretVolume.VolumeSource}}, wantErr: true},
+		{name: "Empty Volume Name", volumes: []corev1.Volume{{VolumeSource: secretVolume.VolumeSource}}, wantErr: true},
+		{name: "Duplicate Volume Name", volumes: []corev1.Volume{secretVolume, secretVolume}, wantErr: true},
+		{name: "No Volume Source", volumes: []corev1.Volume{{Name: "kafka-certs"}}, wantErr: true},
+		{name: "Multiple Volume Sources", volumes: []corev1.Volume{{Name: "kafka-certs", VolumeSource: corev1.VolumeSource{Secret: secretVolume.Secret, ConfigMap: configMapVolume.ConfigMap}}}, wantErr: true},
+		{name: "Unknown Volume Mount", volumes: []corev1.Volume{secretVolume}, volumeMounts: []corev1.VolumeMount{{Name: "unknown", MountPath: "/etc/kafka/certs"}}, wantErr: true},
+		{name: "Empty Mount Path", volumes: []corev1.Volume{secretVolume}, volumeMounts: []corev1.VolumeMount{{Name: "kafka-certs"}}, wantErr: true},
+		{name: "Relative Mount Path", volumes: []corev1.Volume{secretVolume}, volumeMounts: []corev1.VolumeMount{{Name: "kafka-certs", MountPath: "etc/kafka/certs"}}, wantErr: true},
+		{name: "Duplicate Mount Path", volumes: []corev1.Volume{secretVolume, configMapVolume}, volumeMounts: []corev1.VolumeMount{{Name: "kafka-certs", MountPath: "/etc/kafka"}, {Name: "kafka-ca", MountPath: "/etc/kafka/"}}, wantErr: true},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateVolumesConfig("dispatcher", testCase.volumes, testCase.volumeMounts)
+			assert.Equal(t, testCase.wantErr, err != nil)
+		})
+	}
+}
@@ -28,17 +28,34 @@ const (
 	ContainerNameEnvVarKEy  = "CONTAINER_NAME"
 
 	// Kafka Authorization
-	KafkaBrokerEnvVarKey   = "KAFKA_BROKERS"
-	KafkaUsernameEnvVarKey = "KAFKA_USERNAME"
-	KafkaPasswordEnvVarKey = "KAFKA_PASSWORD"
+	KafkaBrokerEnvVarKey        = "KAFKA_BROKERS"
+	KafkaUsernameEnvVarKey      = "KAFKA_USERNAME"
+	KafkaPasswordEnvVarKey      = "KAFKA_PASSWORD"
+	KafkaSaslMechanismEnvVarKey = "KAFKA_SASL_MECHANISM"
+	KafkaTLSCertEnvVarKey       = "KAFKA_TLS_CERT"
+	KafkaTLSKeyEnvVarKey        = "KAFKA_TLS_KEY"
+	KafkaTLSEnableEnvVarKey     = "KAFKA_TLS_ENABLE"
 
 	// Kafka Configuration
-	KafkaTopicEnvVarKey = "KAFKA_TOPIC"
+	KafkaTopicEnvVarKey                      = "KAFKA_TOPIC"
+	KafkaConsumerConfigOverridesEnvVarKey    = "KAFKA_CONSUMER_CONFIG_OVERRIDES"
+	KafkaSubscriberConcurrencyEnvVarKey      = "KAFKA_SUBSCRIBER_CONCURRENCY"
+	KafkaSubscriberFiltersEnvVarKey          = "KAFKA_SUBSCRIBER_FILTERS"
+	KafkaSubscriberOrderingEnvVarKey         = "KAFKA_SUBSCRIBER_ORDERING"
+	KafkaSubscriberDeadLetterTopicsEnvVarKey = "KAFKA_SUBSCRIBER_DEAD_LETTER_TOPICS"
+	KafkaSubscriberInitialOffsetsEnvVarKey   = "KAFKA_SUBSCRIBER_INITIAL_OFFSETS"
+	KafkaReplayFromTimestampEnvVarKey        = "KAFKA_REPLAY_FROM_TIMESTAMP"
+	KafkaExtensionFilterEnvVarKey            = "KAFKA_EXTENSION_FILTER"
 
 	// Knative Logging Configuration
 	KnativeLoggingConfigMapNameEnvVarKey = "CONFIG_LOGGING_NAME" // Note - Matches value of configMapNameEnv constant in Knative.dev/pkg/logging !
 
 	// Dispatcher Configuration
-	ChannelKeyEnvVarKey  = "CHANNEL_KEY"
-	ServiceNameEnvVarKey = "SERVICE_NAME"
+	ChannelKeyEnvVarKey             = "CHANNEL_KEY"
+	ServiceNameEnvVarKey            = "SERVICE_NAME"
+	KafkaReadinessIntervalEnvVarKey = "KAFKA_READINESS_INTERVAL_SECONDS"
+	DrainTimeoutEnvVarKey           = "DRAIN_TIMEOUT_SECONDS"
+	KafkaRackIdEnvVarKey            = "KAFKA_RACK_ID"
+	NodeNameEnvVarKey               = "NODE_NAME"
+	ConsumerLagIntervalEnvVarKey    = "CONSUMER_LAG_INTERVAL_SECONDS"
 )
@@ -21,4 +21,10 @@ const (
 	// Default Health Configuration
 	LivenessPath  = "/healthz" // The Endpoint Of The Liveness Check
 	ReadinessPath = "/healthy" // The Endpoint Of The Readiness Check
+
+	// Kafka Connectivity Health Configuration
+	KafkaReadinessPath = "/readyz" // The Endpoint Of The Kafka Connectivity Readiness Check
+
+	// Debug Configuration
+	SaramaConfigDebugPath = "/debug/sarama-config" // The Endpoint Dumping The (Redacted) Effective Sarama Config
 )
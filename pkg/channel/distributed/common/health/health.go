@@ -34,7 +34,8 @@ type Status interface {
 
 // Structure Containing Basic Liveness Information For Health Server
 type Server struct {
-	server   *http.Server // The Golang HTTP Server Instance
+	server   *http.Server   // The Golang HTTP Server Instance
+	serveMux *http.ServeMux // The Golang HTTP Request Multiplexer
 	status   Status
 	HttpPort string // The HTTP Port The Dispatcher Server Listens On
 
@@ -83,6 +84,12 @@ func (hs *Server) initializeServer(httpPort string) {
 
 	// Set The Initialized HTTP Server
 	hs.server = server
+	hs.serveMux = serveMux
+}
+
+// Register An Additional HTTP Request Handler For The Specified Path (Must Be Called Before Start)
+func (hs *Server) HandleFunc(path string, handler http.HandlerFunc) {
+	hs.serveMux.HandleFunc(path, handler)
 }
 
 // Start The HTTP Server (Blocking Call)
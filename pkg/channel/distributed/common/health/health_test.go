@@ -132,6 +132,21 @@ func TestHealthHandler(t *testing.T) {
 	getEventToHandler(t, health.HandleLiveness, livenessPath, http.StatusInternalServerError)
 }
 
+// Test Registering An Additional Handler With The Health Server
+func TestHandleFunc(t *testing.T) {
+
+	// Create A New Health Server With An Additional Handler
+	health := getTestHealthServer()
+	health.HandleFunc(KafkaReadinessPath, func(responseWriter http.ResponseWriter, _ *http.Request) {
+		responseWriter.WriteHeader(http.StatusAccepted)
+	})
+
+	// Verify The Additional Handler Is Served By The Health Server's HTTP Handler
+	responseRecorder := httptest.NewRecorder()
+	health.server.Handler.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, KafkaReadinessPath, nil))
+	assert.Equal(t, http.StatusAccepted, responseRecorder.Code)
+}
+
 // Test The Health Server Via Live HTTP Calls
 func TestHealthServer(t *testing.T) {
 
@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	injectionclient "knative.dev/pkg/client/injection/kube/client"
+)
+
+// K8S Node Topology Zone Labels (Preferred & Deprecated)
+const (
+	NodeTopologyZoneLabel      = "topology.kubernetes.io/zone"
+	NodeFailureDomainZoneLabel = "failure-domain.beta.kubernetes.io/zone"
+)
+
+// Get The Availability Zone Of The Specified K8S Node (Requires A K8S Client In The Context & "get" Access To Nodes)
+func NodeZone(ctx context.Context, nodeName string) (string, error) {
+
+	// Validate The Node Name
+	if len(nodeName) <= 0 {
+		return "", fmt.Errorf("unable to determine zone of unspecified node")
+	}
+
+	// Get The Node From The K8S Client In The Context
+	node, err := injectionclient.Get(ctx).CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	// Return The Zone From The Preferred Label, Falling Back To The Deprecated Label
+	if zone := node.Labels[NodeTopologyZoneLabel]; len(zone) > 0 {
+		return zone, nil
+	}
+	if zone := node.Labels[NodeFailureDomainZoneLabel]; len(zone) > 0 {
+		return zone, nil
+	}
+	return "", fmt.Errorf("node '%s' has no '%s' label", nodeName, NodeTopologyZoneLabel)
+}
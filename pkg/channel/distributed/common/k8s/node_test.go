@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	injectionclient "knative.dev/pkg/client/injection/kube/client"
+)
+
+// Test The NodeZone() Functionality
+func TestNodeZone(t *testing.T) {
+
+	// Create Test Nodes With The Various Zone Labels
+	newNode := func(name string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	topologyNode := newNode("topology-node", map[string]string{NodeTopologyZoneLabel: "us-east-1a", NodeFailureDomainZoneLabel: "us-east-1b"})
+	failureDomainNode := newNode("failure-domain-node", map[string]string{NodeFailureDomainZoneLabel: "us-east-1c"})
+	unlabelledNode := newNode("unlabelled-node", nil)
+
+	// Create A Context With A Fake K8S Client Containing The Test Nodes
+	fakeK8sClient := fake.NewSimpleClientset(topologyNode, failureDomainNode, unlabelledNode)
+	ctx := context.WithValue(context.TODO(), injectionclient.Key{}, fakeK8sClient)
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name         string
+		nodeName     string
+		expectedZone string
+		expectErr    bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Topology Zone Label", nodeName: topologyNode.Name, expectedZone: "us-east-1a"},
+		{name: "Failure Domain Zone Label", nodeName: failureDomainNode.Name, expectedZone: "us-east-1c"},
+		{name: "No Zone Label", nodeName: unlabelledNode.Name, expectErr: true},
+		{name: "Unknown Node", nodeName: "unknown-node", expectErr: true},
+		{name: "Empty Node Name", nodeName: "", expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			zone, err := NodeZone(ctx, testCase.nodeName)
+			assert.Equal(t, testCase.expectedZone, zone)
+			assert.Equal(t, testCase.expectErr, err != nil)
+		})
+	}
+}
@@ -20,15 +20,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Shopify/sarama"
+	corev1 "k8s.io/api/core/v1"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
 	"knative.dev/eventing-kafka/pkg/common/constants"
 )
 
 // Sarama ClusterAdmin Wrapping Interface To Facilitate Other Implementations (e.g. Azure EventHubs)
 type AdminClientInterface interface {
 	CreateTopic(context.Context, string, *sarama.TopicDetail) *sarama.TopicError
+	CreateTopics(context.Context, map[string]*sarama.TopicDetail) map[string]*sarama.TopicError
 	DeleteTopic(context.Context, string) *sarama.TopicError
+	DescribeTopic(context.Context, string) (*sarama.TopicMetadata, *sarama.TopicError)
+	CreatePartitions(context.Context, string, int32) *sarama.TopicError
+	DescribeTopicConfig(context.Context, string) (map[string]string, *sarama.TopicError)
+	AlterTopicConfig(context.Context, string, map[string]*string) *sarama.TopicError
+	DescribeCluster(context.Context) ([]*sarama.Broker, error)
+	ListManagedTopics(context.Context) (map[string]sarama.TopicDetail, error)
+	DescribeConsumerGroups(context.Context, []string) ([]*sarama.GroupDescription, error)
+	ListConsumerGroupOffsets(context.Context, string, map[string][]int32) (*sarama.OffsetFetchResponse, error)
+	CreateTopicACL(context.Context, string, sarama.Acl) *sarama.TopicError
+	DeleteTopicACL(context.Context, string, sarama.Acl) *sarama.TopicError
 	Close() error
 	GetKafkaSecretName(topicName string) string
 }
@@ -40,9 +54,26 @@ const (
 	Kafka AdminClientType = iota
 	EventHub
 	Custom
+	Confluent
 	Unknown
 )
 
+// Return A String Representation Of The AdminClientType (Used As A Metrics Tag Value)
+func (t AdminClientType) String() string {
+	switch t {
+	case Kafka:
+		return "kafka"
+	case EventHub:
+		return "eventhub"
+	case Custom:
+		return "custom"
+	case Confluent:
+		return "confluent"
+	default:
+		return "unknown"
+	}
+}
+
 //
 // Create A New Kafka AdminClient Of Specified Type - Using Credentials From Kafka Secret(s) In Specified K8S Namespace
 //
@@ -64,9 +95,33 @@ const (
 //        password: Endpoint=sb://<azure-namespace>.servicebus.windows.net/;SharedAccessKeyName=<shared-access-key-name>;SharedAccessKey=<shared-access-key-value>
 //		  namespace: <azure-namespace>
 //
+// For the Confluent REST use case there should be only one Secret with the following content (the username and
+// password being a Confluent Cloud API Key & Secret for the Kafka cluster)...
+//
+//      data:
+//        brokers: SASL_SSL://<host>.<region>.aws.confluent.cloud:9092
+//        username: <api-key>
+//        password: <api-secret>
+//        rest.endpoint: https://<host>.<region>.aws.confluent.cloud:443
+//        cluster.id: <cluster-id>
+//
 // * If no authorization is required (local dev instance) then specify username and password as the empty string ""
 //
+// The returned AdminClient is wrapped in an InstrumentedAdminClient which records the latency and error
+// count of each operation, and the latency of the AdminClient creation itself is recorded as "connect".
+//
 func CreateAdminClient(ctx context.Context, saramaConfig *sarama.Config, clientId string, adminClientType AdminClientType) (AdminClientInterface, error) {
+	startTime := time.Now()
+	adminClient, err := createAdminClient(ctx, saramaConfig, clientId, adminClientType)
+	recordAdminClientOperation(ctx, adminClientType, OperationConnect, startTime, err != nil)
+	if err != nil {
+		return nil, err
+	}
+	return NewInstrumentedAdminClient(adminClient, adminClientType), nil
+}
+
+// Create The Actual (Uninstrumented) Kafka AdminClient Of The Specified Type
+func createAdminClient(ctx context.Context, saramaConfig *sarama.Config, clientId string, adminClientType AdminClientType) (AdminClientInterface, error) {
 	switch adminClientType {
 	case Kafka:
 		return NewKafkaAdminClientWrapper(ctx, saramaConfig, clientId, constants.KnativeEventingNamespace)
@@ -74,6 +129,8 @@ func CreateAdminClient(ctx context.Context, saramaConfig *sarama.Config, clientI
 		return NewEventHubAdminClientWrapper(ctx, constants.KnativeEventingNamespace)
 	case Custom:
 		return NewCustomAdminClientWrapper(ctx, constants.KnativeEventingNamespace)
+	case Confluent:
+		return NewConfluentAdminClientWrapper(ctx, constants.KnativeEventingNamespace)
 	case Unknown:
 		return nil, errors.New("received unknown AdminClientType") // Should Never Happen But...
 	default:
@@ -81,6 +138,73 @@ func CreateAdminClient(ctx context.Context, saramaConfig *sarama.Config, clientI
 	}
 }
 
+//
+// Explicit Kafka Secret Selection
+//
+// By default the Kafka AdminClient implicitly selects the single Kafka Secret in the Knative Eventing namespace.
+// When running against multiple Kafka clusters (one Kafka Secret each) the caller may instead explicitly select
+// one of them by name via the Context, in which case only that (labelled) Kafka Secret is considered.
+//
+
+// Context Key For The Explicitly Selected Kafka Secret Name
+type kafkaSecretNameKey struct{}
+
+// Return A Copy Of The Context Which Explicitly Selects The Named Kafka Secret (Empty Name Retains Implicit Selection)
+func WithKafkaSecretName(ctx context.Context, kafkaSecretName string) context.Context {
+	if len(kafkaSecretName) <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, kafkaSecretNameKey{}, kafkaSecretName)
+}
+
+// Get The Explicitly Selected Kafka Secret Name From The Context (Empty If Using Implicit Selection)
+func KafkaSecretNameFromContext(ctx context.Context) string {
+	kafkaSecretName, _ := ctx.Value(kafkaSecretNameKey{}).(string)
+	return kafkaSecretName
+}
+
+// Filter The Specified Kafka Secrets Down To The One Explicitly Selected In The Context (If Any)
+func filterKafkaSecrets(ctx context.Context, kafkaSecrets *corev1.SecretList) *corev1.SecretList {
+	kafkaSecretName := KafkaSecretNameFromContext(ctx)
+	if len(kafkaSecretName) <= 0 || kafkaSecrets == nil {
+		return kafkaSecrets
+	}
+	filteredKafkaSecrets := &corev1.SecretList{}
+	for _, kafkaSecret := range kafkaSecrets.Items {
+		if kafkaSecret.Name == kafkaSecretName {
+			filteredKafkaSecrets.Items = append(filteredKafkaSecrets.Items, kafkaSecret)
+		}
+	}
+	return filteredKafkaSecrets
+}
+
+//
+// Create Multiple Topics One At A Time Via The Specified AdminClient's CreateTopic()
+//
+// Used by those AdminClient implementations whose underlying API has no bulk topic creation, so that they can
+// still satisfy CreateTopics() with the same per-topic results (keyed by topic name) as a single bulk request.
+//
+func createTopicsIndividually(ctx context.Context, adminClient AdminClientInterface, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+	topicErrors := make(map[string]*sarama.TopicError, len(topicDetails))
+	for topicName, topicDetail := range topicDetails {
+		topicError := adminClient.CreateTopic(ctx, topicName, topicDetail)
+		if topicError == nil {
+			topicError = adminutil.NewTopicError(sarama.ErrNoError, "successfully created topic")
+		}
+		topicErrors[topicName] = topicError
+	}
+	return topicErrors
+}
+
+// Return The Same TopicError For Each Of The Specified Topics (Used When An Entire Bulk Request Fails)
+func newTopicErrorMap(topicDetails map[string]*sarama.TopicDetail, topicError *sarama.TopicError) map[string]*sarama.TopicError {
+	topicErrors := make(map[string]*sarama.TopicError, len(topicDetails))
+	for topicName := range topicDetails {
+		topicErrors[topicName] = topicError
+	}
+	return topicErrors
+}
+
 // New Kafka AdminClient Wrapper To Facilitate Unit Testing
 var NewKafkaAdminClientWrapper = func(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (AdminClientInterface, error) {
 	return NewKafkaAdminClient(ctx, saramaConfig, clientId, namespace)
@@ -95,3 +219,8 @@ var NewEventHubAdminClientWrapper = func(ctx context.Context, namespace string)
 var NewCustomAdminClientWrapper = func(ctx context.Context, namespace string) (AdminClientInterface, error) {
 	return NewCustomAdminClient(ctx, namespace)
 }
+
+// New Confluent AdminClient Wrapper To Facilitate Unit Testing
+var NewConfluentAdminClientWrapper = func(ctx context.Context, namespace string) (AdminClientInterface, error) {
+	return NewConfluentAdminClient(ctx, namespace)
+}
@@ -0,0 +1,524 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/logging"
+)
+
+//
+// This is an implementation of the AdminClient interface backed by the Confluent Kafka REST (v3) API.  Some Confluent
+// Cloud administrative operations are better performed via their REST API than via the Sarama ClusterAdmin, so the
+// Topic operations are instead mapped onto the equivalent REST endpoints of the Kafka cluster identified by the Kafka
+// Secret.  As with the EventHub implementation this "mapping" of Kafka structs/functions/etc is imprecise by nature,
+// and any operations without a REST equivalent fail cleanly rather than being emulated.
+//
+
+// Ensure The ConfluentAdminClient Struct Implements The AdminClientInterface
+var _ AdminClientInterface = &ConfluentAdminClient{}
+
+// Confluent AdminClient Definition
+type ConfluentAdminClient struct {
+	logger       *zap.Logger
+	namespace    string
+	kafkaSecret  string
+	restEndpoint string
+	clusterId    string
+	username     string
+	password     string
+	httpClient   *http.Client
+}
+
+// Confluent Kafka REST Topic Representation (Subset Of The v3 TopicData)
+type confluentTopic struct {
+	TopicName         string `json:"topic_name"`
+	PartitionsCount   int32  `json:"partitions_count,omitempty"`
+	ReplicationFactor int16  `json:"replication_factor,omitempty"`
+	IsInternal        bool   `json:"is_internal,omitempty"`
+}
+
+// Confluent Kafka REST Topic Config Representation (Subset Of The v3 TopicConfigData)
+type confluentTopicConfig struct {
+	Name      string  `json:"name"`
+	Value     *string `json:"value,omitempty"`
+	Operation string  `json:"operation,omitempty"`
+}
+
+// Confluent Kafka REST Create Topic Request
+type confluentCreateTopicRequest struct {
+	confluentTopic
+	Configs []confluentTopicConfig `json:"configs,omitempty"`
+}
+
+// Confluent Kafka REST Update Partitions Request
+type confluentUpdatePartitionsRequest struct {
+	PartitionsCount int32 `json:"partitions_count"`
+}
+
+// Confluent Kafka REST Topic List Response
+type confluentTopicList struct {
+	Data []confluentTopic `json:"data"`
+}
+
+// Confluent Kafka REST Topic Config List Response & Alter Configs Request
+type confluentTopicConfigList struct {
+	Data []confluentTopicConfig `json:"data"`
+}
+
+// Confluent Kafka REST Error Response
+type confluentError struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// Create A New Confluent AdminClient Based On The Kafka Secret In The Specified K8S Namespace
+func NewConfluentAdminClient(ctx context.Context, namespace string) (AdminClientInterface, error) {
+
+	// Get The Logger From The Context
+	logger := logging.FromContext(ctx).Desugar()
+
+	// Get The K8S Client From The Context
+	k8sClient := kubeclient.Get(ctx)
+
+	// Get A List Of The Kafka Secrets
+	kafkaSecrets, err := adminutil.GetKafkaSecrets(ctx, k8sClient, namespace)
+	if err != nil {
+		logger.Error("Failed To Get Kafka Authentication Secrets", zap.Error(err))
+		return nil, err
+	}
+
+	// Restrict To The Explicitly Selected Kafka Secret (If Any) Which Must Exist
+	if kafkaSecretName := KafkaSecretNameFromContext(ctx); len(kafkaSecretName) > 0 {
+		kafkaSecrets = filterKafkaSecrets(ctx, kafkaSecrets)
+		if len(kafkaSecrets.Items) <= 0 {
+			logger.Error("Selected Kafka Secret Not Found", zap.String("Secret", kafkaSecretName))
+			return nil, fmt.Errorf("kafka secret '%s' not found", kafkaSecretName)
+		}
+	}
+
+	// Currently Only Support One Kafka Secret - Invalid AdminClient For All Other Cases!
+	var kafkaSecret corev1.Secret
+	if len(kafkaSecrets.Items) != 1 {
+		logger.Warn(fmt.Sprintf("Expected 1 Kafka Secret But Found %d - Confluent AdminClient Will Not Be Functional!", len(kafkaSecrets.Items)))
+		return nil, nil
+	} else {
+		logger.Info("Found 1 Kafka Secret", zap.String("Secret", kafkaSecrets.Items[0].Name))
+		kafkaSecret = kafkaSecrets.Items[0]
+	}
+
+	// Validate Secret Data
+	if !adminutil.ValidateKafkaSecret(logger, &kafkaSecret) {
+		err = errors.New("invalid Kafka Secret found")
+		return nil, err
+	}
+
+	// Extract & Validate The Confluent REST Data From The Kafka Secret
+	restEndpoint := strings.TrimSuffix(strings.TrimSpace(string(kafkaSecret.Data[constants.KafkaSecretKeyRestEndpoint])), "/")
+	clusterId := strings.TrimSpace(string(kafkaSecret.Data[constants.KafkaSecretKeyClusterId]))
+	if len(restEndpoint) <= 0 || len(clusterId) <= 0 {
+		logger.Error("Kafka Secret Missing Confluent REST Endpoint / Cluster ID", zap.String("Secret", kafkaSecret.Name))
+		return nil, fmt.Errorf("kafka secret '%s' must specify '%s' and '%s' for the confluent AdminClient", kafkaSecret.Name, constants.KafkaSecretKeyRestEndpoint, constants.KafkaSecretKeyClusterId)
+	}
+
+	// Create And Return A New Confluent AdminClient
+	logger.Debug("Successfully Created New Confluent AdminClient")
+	return &ConfluentAdminClient{
+		logger:       logger,
+		namespace:    namespace,
+		kafkaSecret:  kafkaSecret.Name,
+		restEndpoint: restEndpoint,
+		clusterId:    clusterId,
+		username:     string(kafkaSecret.Data[constants.KafkaSecretKeyUsername]),
+		password:     string(kafkaSecret.Data[constants.KafkaSecretKeyPassword]),
+		httpClient:   &http.Client{Timeout: constants.ConfluentRestTimeoutMillis * time.Millisecond},
+	}, nil
+}
+
+// Kafka AdminClient CreateTopics Implementation Using The Confluent REST API
+func (c *ConfluentAdminClient) CreateTopic(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+
+	// Validate Topic
+	if len(topicName) <= 0 || topicDetail == nil {
+		c.logger.Warn("Received Empty/Nil Topic Configuration", zap.String("Topic", topicName), zap.Any("TopicDetail", topicDetail))
+		return adminutil.NewTopicError(sarama.ErrInvalidRequest, "received empty/nil topic name and / or detail")
+	}
+
+	// Map The Sarama TopicDetail To A Confluent Create Topic Request
+	createTopicRequest := confluentCreateTopicRequest{
+		confluentTopic: confluentTopic{
+			TopicName:         topicName,
+			PartitionsCount:   topicDetail.NumPartitions,
+			ReplicationFactor: topicDetail.ReplicationFactor,
+		},
+		Configs: newConfluentTopicConfigs(topicDetail.ConfigEntries),
+	}
+
+	// Create The Topic Via The POST Rest Endpoint
+	response, topicError := c.doRequest(ctx, http.MethodPost, c.topicsUrl(""), createTopicRequest)
+	defer c.safeCloseHTTPResponseBody(response)
+	if topicError != nil {
+		return topicError
+	}
+
+	// Map The HTTP Response Into A Sarama TopicError & Return
+	return c.mapHttpResponse("create", topicName, response, nil)
+}
+
+// Create Multiple Topics - The Confluent REST API Has No Bulk Creation So They Are Created Individually
+func (c *ConfluentAdminClient) CreateTopics(ctx context.Context, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+	return createTopicsIndividually(ctx, c, topicDetails)
+}
+
+// Delete A Single Topic Via The Confluent REST API
+func (c *ConfluentAdminClient) DeleteTopic(ctx context.Context, topicName string) *sarama.TopicError {
+
+	// Validate The Topic
+	if len(topicName) <= 0 {
+		c.logger.Warn("Received Empty Topic Name")
+		return adminutil.NewTopicError(sarama.ErrInvalidRequest, "received empty topic name")
+	}
+
+	// Delete The Topic Via The DELETE Rest Endpoint
+	response, topicError := c.doRequest(ctx, http.MethodDelete, c.topicsUrl(topicName), nil)
+	defer c.safeCloseHTTPResponseBody(response)
+	if topicError != nil {
+		return topicError
+	}
+
+	// Map The HTTP Response Into A Sarama TopicError & Return
+	return c.mapHttpResponse("delete", topicName, response, nil)
+}
+
+//
+// Describe A Single Topic Via The Confluent REST API
+//
+// The Confluent REST Topic summary includes the partition count and replication factor but not the individual
+// partition assignments, so the returned metadata contains the expected number of partitions each with the
+// expected number of (unidentified) replicas, which is sufficient for partition / replication drift detection.
+//
+func (c *ConfluentAdminClient) DescribeTopic(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+
+	// Get The Topic Via The GET Rest Endpoint
+	response, topicError := c.doRequest(ctx, http.MethodGet, c.topicsUrl(topicName), nil)
+	defer c.safeCloseHTTPResponseBody(response)
+	if topicError != nil {
+		return nil, topicError
+	}
+
+	// Map The HTTP Response & Decode The Topic
+	topic := &confluentTopic{}
+	topicError = c.mapHttpResponse("describe", topicName, response, topic)
+	if topicError != nil && topicError.Err != sarama.ErrNoError {
+		return nil, topicError
+	}
+
+	// Map The Confluent Topic To Sarama TopicMetadata
+	topicMetadata := &sarama.TopicMetadata{Name: topic.TopicName, Partitions: make([]*sarama.PartitionMetadata, topic.PartitionsCount)}
+	for partition := int32(0); partition < topic.PartitionsCount; partition++ {
+		topicMetadata.Partitions[partition] = &sarama.PartitionMetadata{ID: partition, Replicas: make([]int32, topic.ReplicationFactor)}
+	}
+	return topicMetadata, nil
+}
+
+// Increase The Partition Count Of A Single Topic Via The Confluent REST API
+func (c *ConfluentAdminClient) CreatePartitions(ctx context.Context, topicName string, count int32) *sarama.TopicError {
+
+	// Update The Topic's Partition Count Via The PATCH Rest Endpoint
+	response, topicError := c.doRequest(ctx, http.MethodPatch, c.topicsUrl(topicName), confluentUpdatePartitionsRequest{PartitionsCount: count})
+	defer c.safeCloseHTTPResponseBody(response)
+	if topicError != nil {
+		return topicError
+	}
+
+	// Map The HTTP Response Into A Sarama TopicError & Return
+	return c.mapHttpResponse("create partitions", topicName, response, nil)
+}
+
+// Describe The Configuration Of A Single Topic Via The Confluent REST API
+func (c *ConfluentAdminClient) DescribeTopicConfig(ctx context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+
+	// Get The Topic Configs Via The GET Rest Endpoint
+	response, topicError := c.doRequest(ctx, http.MethodGet, c.topicsUrl(topicName)+"/configs", nil)
+	defer c.safeCloseHTTPResponseBody(response)
+	if topicError != nil {
+		return nil, topicError
+	}
+
+	// Map The HTTP Response & Decode The Topic Configs
+	topicConfigList := &confluentTopicConfigList{}
+	topicError = c.mapHttpResponse("describe config", topicName, response, topicConfigList)
+	if topicError != nil && topicError.Err != sarama.ErrNoError {
+		return nil, topicError
+	}
+
+	// Map The Confluent Topic Configs To A Simple Name / Value Map (Excluding Null Values)
+	topicConfig := make(map[string]string, len(topicConfigList.Data))
+	for _, config := range topicConfigList.Data {
+		if config.Value != nil {
+			topicConfig[config.Name] = *config.Value
+		}
+	}
+	return topicConfig, nil
+}
+
+// Alter The Configuration Of A Single Topic Via The Confluent REST API (Nil Values Are Reset To Their Defaults)
+func (c *ConfluentAdminClient) AlterTopicConfig(ctx context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+
+	// Batch Alter The Topic Configs Via The POST Rest Endpoint
+	alterConfigsRequest := confluentTopicConfigList{Data: newConfluentTopicConfigs(configEntries)}
+	response, topicError := c.doRequest(ctx, http.MethodPost, c.topicsUrl(topicName)+"/configs:alter", alterConfigsRequest)
+	defer c.safeCloseHTTPResponseBody(response)
+	if topicError != nil {
+		return topicError
+	}
+
+	// Map The HTTP Response Into A Sarama TopicError & Return
+	return c.mapHttpResponse("alter config", topicName, response, nil)
+}
+
+// Describe The Kafka Cluster - Not Supported (Brokers Are Managed By Confluent)
+func (c *ConfluentAdminClient) DescribeCluster(_ context.Context) ([]*sarama.Broker, error) {
+	c.logger.Debug("Describing The Cluster Is Not Supported By Confluent AdminClient")
+	return nil, fmt.Errorf("describing the cluster is not supported by the confluent AdminClient")
+}
+
+// Describe ConsumerGroups - Not Supported By The Confluent AdminClient
+func (c *ConfluentAdminClient) DescribeConsumerGroups(_ context.Context, _ []string) ([]*sarama.GroupDescription, error) {
+	c.logger.Debug("Describing ConsumerGroups Is Not Supported By Confluent AdminClient")
+	return nil, fmt.Errorf("describing consumer groups is not supported by the confluent AdminClient")
+}
+
+// List ConsumerGroup Offsets - Not Supported By The Confluent AdminClient
+func (c *ConfluentAdminClient) ListConsumerGroupOffsets(_ context.Context, _ string, _ map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	c.logger.Debug("Listing ConsumerGroup Offsets Is Not Supported By Confluent AdminClient")
+	return nil, fmt.Errorf("listing consumer group offsets is not supported by the confluent AdminClient")
+}
+
+// List The Topics Managed By The Controller Via The Confluent REST API (Topic Configuration Is Not Included)
+func (c *ConfluentAdminClient) ListManagedTopics(ctx context.Context) (map[string]sarama.TopicDetail, error) {
+
+	// List The Topics Via The GET Rest Endpoint
+	response, topicError := c.doRequest(ctx, http.MethodGet, c.topicsUrl(""), nil)
+	defer c.safeCloseHTTPResponseBody(response)
+	if topicError != nil {
+		return nil, topicError
+	}
+
+	// Map The HTTP Response & Decode The Topics
+	topicList := &confluentTopicList{}
+	topicError = c.mapHttpResponse("list", "", response, topicList)
+	if topicError != nil && topicError.Err != sarama.ErrNoError {
+		return nil, topicError
+	}
+
+	// Map The Managed Topics To Kafka TopicDetails
+	managedTopicDetails := make(map[string]sarama.TopicDetail)
+	for _, topic := range topicList.Data {
+		if !topic.IsInternal && commonkafkautil.IsManagedTopicName(topic.TopicName) {
+			managedTopicDetails[topic.TopicName] = sarama.TopicDetail{
+				NumPartitions:     topic.PartitionsCount,
+				ReplicationFactor: topic.ReplicationFactor,
+				ConfigEntries:     map[string]*string{},
+			}
+		}
+	}
+
+	// Return The Managed Topics
+	return managedTopicDetails, nil
+}
+
+// Create An ACL On A Single Topic - Not Supported (Confluent Cloud Access Is Managed Via Service Accounts)
+func (c *ConfluentAdminClient) CreateTopicACL(_ context.Context, topicName string, _ sarama.Acl) *sarama.TopicError {
+	c.logger.Warn("Creating Topic ACLs Is Not Supported By Confluent AdminClient", zap.String("Topic", topicName))
+	return adminutil.NewTopicError(sarama.ErrInvalidRequest, fmt.Sprintf("confluent AdminClient does not support ACLs - unable to create ACL for topic '%s'", topicName))
+}
+
+// Delete An ACL From A Single Topic - Not Supported (Confluent Cloud Access Is Managed Via Service Accounts)
+func (c *ConfluentAdminClient) DeleteTopicACL(_ context.Context, topicName string, _ sarama.Acl) *sarama.TopicError {
+	c.logger.Warn("Deleting Topic ACLs Is Not Supported By Confluent AdminClient", zap.String("Topic", topicName))
+	return adminutil.NewTopicError(sarama.ErrInvalidRequest, fmt.Sprintf("confluent AdminClient does not support ACLs - unable to delete ACL for topic '%s'", topicName))
+}
+
+// Get The K8S Secret With Kafka Credentials For The Specified Topic Name
+func (c *ConfluentAdminClient) GetKafkaSecretName(_ string) string {
+	return c.kafkaSecret // Only supports 1 Kafka Secret so just return its name ; )
+}
+
+// Kafka AdminClient Close Implementation Using The Confluent REST API
+func (c *ConfluentAdminClient) Close() error {
+	return nil // Nothing to "close" in the Confluent implementation (just a REST client) so this is just a compatibility no-op.
+}
+
+// Get The Confluent REST Topics URL For The Kafka Cluster (Optionally For A Specific Topic)
+func (c *ConfluentAdminClient) topicsUrl(topicName string) string {
+	topicsUrl := fmt.Sprintf("%s/kafka/v3/clusters/%s/topics", c.restEndpoint, url.PathEscape(c.clusterId))
+	if len(topicName) > 0 {
+		topicsUrl = topicsUrl + "/" + url.PathEscape(topicName)
+	}
+	return topicsUrl
+}
+
+// Perform An Authenticated Confluent REST Request With The Specified (JSON Marshalled) Body
+func (c *ConfluentAdminClient) doRequest(ctx context.Context, method string, requestUrl string, body interface{}) (*http.Response, *sarama.TopicError) {
+
+	// Marshal The Request Body (If Any)
+	var requestBody io.Reader
+	if body != nil {
+		requestBodyBytes, err := json.Marshal(body)
+		if err != nil {
+			c.logger.Error("Failed To Marshal Confluent REST Request Body", zap.String("Method", method), zap.String("URL", requestUrl), zap.Error(err))
+			return nil, adminutil.NewTopicError(sarama.ErrInvalidConfig, fmt.Sprintf("failed to marshal request body for %s '%s'", method, requestUrl))
+		}
+		requestBody = bytes.NewBuffer(requestBodyBytes)
+	}
+
+	// Create The HTTP Request With Basic Auth (Confluent Cloud API Key & Secret)
+	request, err := http.NewRequestWithContext(ctx, method, requestUrl, requestBody)
+	if err != nil {
+		c.logger.Error("Failed To Create New Confluent REST Request", zap.String("Method", method), zap.String("URL", requestUrl), zap.Error(err))
+		return nil, adminutil.NewTopicError(sarama.ErrUnknown, fmt.Sprintf("failed to create new http request for %s '%s'", method, requestUrl))
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if len(c.username) > 0 || len(c.password) > 0 {
+		request.SetBasicAuth(c.username, c.password)
+	}
+
+	// Make The HTTP Request
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		c.logger.Error("Confluent REST Request Failed", zap.String("Method", method), zap.String("URL", requestUrl), zap.Error(err))
+		return response, adminutil.NewTopicError(sarama.ErrNetworkException, fmt.Sprintf("failed to make http request for %s '%s': %v", method, requestUrl, err))
+	}
+	return response, nil
+}
+
+// Safely Close The Specified HTTP Response Body
+func (c *ConfluentAdminClient) safeCloseHTTPResponseBody(response *http.Response) {
+	if response != nil && response.Body != nil {
+		err := response.Body.Close()
+		if err != nil {
+			c.logger.Error("Failed To Close HTTP Response Body", zap.Error(err))
+		}
+	}
+}
+
+//
+// Utility Function For Mapping Confluent REST Responses To Sarama TopicError Struct
+//
+// Successful responses are decoded into the specified target (if any).  Failures are mapped (imprecisely) to the
+// Kafka error code which best conveys whether the controller should retry them, namely missing topics, existing
+// topics, authorization failures (permanent) and throttling / server errors (transient).
+//
+func (c *ConfluentAdminClient) mapHttpResponse(operation string, topicName string, response *http.Response, target interface{}) *sarama.TopicError {
+
+	// Verify There Is A Response
+	if response == nil {
+		return adminutil.NewTopicError(sarama.ErrUnknown, "received nil http response")
+	}
+
+	// Read The Response Body
+	statusCode := response.StatusCode
+	responseBodyBytes, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		c.logger.Warn("Failed To Read Response Body", zap.Error(err))
+	}
+
+	// Decode Successful Responses Into The Target (If Any)
+	if statusCode >= 200 && statusCode <= 299 {
+		if target != nil {
+			err = json.Unmarshal(responseBodyBytes, target)
+			if err != nil {
+				c.logger.Error("Failed To Unmarshal Confluent REST Response", zap.String("Operation", operation), zap.Error(err))
+				return adminutil.NewTopicError(sarama.ErrUnknown, fmt.Sprintf("failed to unmarshal confluent topic '%s' operation response for topic '%s': %v", operation, topicName, err))
+			}
+		}
+		return adminutil.NewTopicError(sarama.ErrNoError, fmt.Sprintf("confluent topic '%s' operation succeeded for topic '%s' with status code '%d'", operation, topicName, statusCode))
+	}
+
+	// Map The Failure To The Closest Kafka Error
+	message := fmt.Sprintf("confluent topic '%s' operation failed for topic '%s' with status code '%d' and body '%s'", operation, topicName, statusCode, string(responseBodyBytes))
+	c.logger.Warn("Confluent REST Request Failed", zap.String("Operation", operation), zap.String("Topic", topicName), zap.Int("StatusCode", statusCode))
+	switch {
+	case getConfluentErrorCode(responseBodyBytes) == constants.ConfluentErrorCodeTopicAlreadyExists:
+		return adminutil.NewTopicError(sarama.ErrTopicAlreadyExists, message)
+	case statusCode == http.StatusNotFound:
+		return adminutil.NewTopicError(sarama.ErrUnknownTopicOrPartition, message)
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return adminutil.NewTopicError(sarama.ErrTopicAuthorizationFailed, message)
+	case statusCode == http.StatusTooManyRequests || statusCode >= 500:
+		return adminutil.NewTopicError(sarama.ErrNetworkException, message)
+	default:
+		return adminutil.NewTopicError(sarama.ErrInvalidRequest, message)
+	}
+}
+
+// Utility Function For Mapping Sarama ConfigEntries To Confluent Topic Configs In Name Order (Nil Values Are Reset To Their Defaults)
+func newConfluentTopicConfigs(configEntries map[string]*string) []confluentTopicConfig {
+	names := make([]string, 0, len(configEntries))
+	for name := range configEntries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	topicConfigs := make([]confluentTopicConfig, 0, len(configEntries))
+	for _, name := range names {
+		value := configEntries[name]
+		if value != nil {
+			topicConfigs = append(topicConfigs, confluentTopicConfig{Name: name, Value: value})
+		} else {
+			topicConfigs = append(topicConfigs, confluentTopicConfig{Name: name, Operation: "DELETE"})
+		}
+	}
+	return topicConfigs
+}
+
+//
+// Utility Function For Extracting Error Code From Confluent REST Error Responses
+//
+// Confluent REST error responses are formatted as...
+//
+//   {"error_code": 40002, "message": "Topic 'TestTopic' already exists."}
+//
+func getConfluentErrorCode(responseBody []byte) int {
+	if len(responseBody) <= 0 {
+		return constants.ConfluentErrorCodeUnknown
+	}
+	confluentErr := &confluentError{}
+	if err := json.Unmarshal(responseBody, confluentErr); err != nil {
+		return constants.ConfluentErrorCodeParseFailure
+	}
+	return confluentErr.ErrorCode
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	injectionclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/logging"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Confluent Test Data
+const (
+	confluentTestClusterId = "lkc-test"
+	confluentTestUsername  = "TestApiKey"
+	confluentTestPassword  = "TestApiSecret"
+	confluentTestTopicName = "test-namespace.test-name"
+	confluentTopicsPath    = "/kafka/v3/clusters/" + confluentTestClusterId + "/topics"
+)
+
+// Test The NewConfluentAdminClient() Functionality - Success Path
+func TestNewConfluentAdminClientSuccess(t *testing.T) {
+
+	// Test Data
+	namespace := "TestNamespace"
+	kafkaSecretName := "TestKafkaSecretName"
+	kafkaSecret := createKafkaSecret(kafkaSecretName, namespace, "TestKafkaSecretBrokers", confluentTestUsername, confluentTestPassword)
+	kafkaSecret.Data[constants.KafkaSecretKeyRestEndpoint] = []byte("https://test.confluent.cloud:443/")
+	kafkaSecret.Data[constants.KafkaSecretKeyClusterId] = []byte(confluentTestClusterId)
+
+	// Create A Context With Test Logger & K8S Client
+	ctx := logging.WithLogger(context.TODO(), logtesting.TestLogger(t))
+	ctx = context.WithValue(ctx, injectionclient.Key{}, fake.NewSimpleClientset(kafkaSecret))
+
+	// Perform The Test
+	adminClient, err := NewConfluentAdminClient(ctx, namespace)
+
+	// Verify The Result
+	assert.Nil(t, err)
+	confluentAdminClient, ok := adminClient.(*ConfluentAdminClient)
+	assert.True(t, ok)
+	assert.Equal(t, kafkaSecretName, confluentAdminClient.kafkaSecret)
+	assert.Equal(t, "https://test.confluent.cloud:443", confluentAdminClient.restEndpoint)
+	assert.Equal(t, confluentTestClusterId, confluentAdminClient.clusterId)
+	assert.Equal(t, confluentTestUsername, confluentAdminClient.username)
+	assert.Equal(t, confluentTestPassword, confluentAdminClient.password)
+	assert.NotNil(t, confluentAdminClient.httpClient)
+}
+
+// Test The NewConfluentAdminClient() Constructor - No / Invalid Kafka Secrets
+func TestNewConfluentAdminClientInvalidKafkaSecret(t *testing.T) {
+
+	// Test Data
+	namespace := "TestNamespace"
+
+	// Verify No Kafka Secrets Results In A Nil AdminClient
+	ctx := logging.WithLogger(context.TODO(), logtesting.TestLogger(t))
+	noSecretCtx := context.WithValue(ctx, injectionclient.Key{}, fake.NewSimpleClientset())
+	adminClient, err := NewConfluentAdminClient(noSecretCtx, namespace)
+	assert.Nil(t, err)
+	assert.Nil(t, adminClient)
+
+	// Verify A Kafka Secret Without The Confluent REST Endpoint / Cluster ID Is Rejected
+	kafkaSecret := createKafkaSecret("TestKafkaSecretName", namespace, "TestKafkaSecretBrokers", confluentTestUsername, confluentTestPassword)
+	invalidSecretCtx := context.WithValue(ctx, injectionclient.Key{}, fake.NewSimpleClientset(kafkaSecret))
+	adminClient, err = NewConfluentAdminClient(invalidSecretCtx, namespace)
+	assert.NotNil(t, err)
+	assert.Nil(t, adminClient)
+}
+
+// Test The Confluent AdminClient CreateTopic() Functionality
+func TestConfluentAdminClientCreateTopic(t *testing.T) {
+
+	// Test Data
+	retentionMillis := "604800000"
+	topicDetail := &sarama.TopicDetail{
+		NumPartitions:     4,
+		ReplicationFactor: 3,
+		ConfigEntries:     map[string]*string{constants.TopicDetailConfigRetentionMs: &retentionMillis},
+	}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name         string
+		statusCode   int
+		responseBody string
+		expectedErr  sarama.KError
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Created", statusCode: http.StatusCreated, responseBody: `{"topic_name":"` + confluentTestTopicName + `"}`, expectedErr: sarama.ErrNoError},
+		{name: "Already Exists", statusCode: http.StatusBadRequest, responseBody: `{"error_code":40002,"message":"Topic already exists."}`, expectedErr: sarama.ErrTopicAlreadyExists},
+		{name: "Invalid Request", statusCode: http.StatusBadRequest, responseBody: `{"error_code":40000,"message":"Bad request."}`, expectedErr: sarama.ErrInvalidRequest},
+		{name: "Unauthorized", statusCode: http.StatusUnauthorized, responseBody: `{"error_code":401,"message":"Unauthorized"}`, expectedErr: sarama.ErrTopicAuthorizationFailed},
+		{name: "Throttled", statusCode: http.StatusTooManyRequests, responseBody: ``, expectedErr: sarama.ErrNetworkException},
+		{name: "Unavailable", statusCode: http.StatusServiceUnavailable, responseBody: `unavailable`, expectedErr: sarama.ErrNetworkException},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Mock Confluent REST Server & AdminClient
+			server := newMockConfluentServer(t, http.MethodPost, confluentTopicsPath, testCase.statusCode, testCase.responseBody)
+			defer server.Close()
+			adminClient := newTestConfluentAdminClient(t, server)
+
+			// Perform The Test
+			topicError := adminClient.CreateTopic(context.TODO(), confluentTestTopicName, topicDetail)
+
+			// Verify The Results
+			assert.NotNil(t, topicError)
+			assert.Equal(t, testCase.expectedErr, topicError.Err)
+			assert.Len(t, server.requests, 1)
+			createTopicRequest := &confluentCreateTopicRequest{}
+			assert.Nil(t, json.Unmarshal(server.requests[0], createTopicRequest))
+			assert.Equal(t, confluentTestTopicName, createTopicRequest.TopicName)
+			assert.Equal(t, topicDetail.NumPartitions, createTopicRequest.PartitionsCount)
+			assert.Equal(t, topicDetail.ReplicationFactor, createTopicRequest.ReplicationFactor)
+			assert.Equal(t, []confluentTopicConfig{{Name: constants.TopicDetailConfigRetentionMs, Value: &retentionMillis}}, createTopicRequest.Configs)
+		})
+	}
+
+	// Verify An Invalid Topic Is Rejected Without A Request
+	adminClient := &ConfluentAdminClient{logger: logtesting.TestLogger(t).Desugar()}
+	topicError := adminClient.CreateTopic(context.TODO(), "", nil)
+	assert.Equal(t, sarama.ErrInvalidRequest, topicError.Err)
+}
+
+// Test The Confluent AdminClient DeleteTopic() Functionality
+func TestConfluentAdminClientDeleteTopic(t *testing.T) {
+
+	// Verify Successful Deletion
+	server := newMockConfluentServer(t, http.MethodDelete, confluentTopicsPath+"/"+confluentTestTopicName, http.StatusNoContent, "")
+	topicError := newTestConfluentAdminClient(t, server).DeleteTopic(context.TODO(), confluentTestTopicName)
+	server.Close()
+	assert.Equal(t, sarama.ErrNoError, topicError.Err)
+
+	// Verify A Missing Topic Is Mapped To ErrUnknownTopicOrPartition
+	server = newMockConfluentServer(t, http.MethodDelete, confluentTopicsPath+"/"+confluentTestTopicName, http.StatusNotFound, `{"error_code":40403,"message":"This server does not host this topic-partition."}`)
+	topicError = newTestConfluentAdminClient(t, server).DeleteTopic(context.TODO(), confluentTestTopicName)
+	server.Close()
+	assert.Equal(t, sarama.ErrUnknownTopicOrPartition, topicError.Err)
+
+	// Verify A Connection Failure Is Mapped To ErrNetworkException
+	topicError = newTestConfluentAdminClient(t, server).DeleteTopic(context.TODO(), confluentTestTopicName)
+	assert.Equal(t, sarama.ErrNetworkException, topicError.Err)
+}
+
+// Test The Confluent AdminClient DescribeTopic() & CreatePartitions() Functionality
+func TestConfluentAdminClientPartitions(t *testing.T) {
+
+	// Verify The Topic Metadata Is Mapped From The Partition Count & Replication Factor
+	server := newMockConfluentServer(t, http.MethodGet, confluentTopicsPath+"/"+confluentTestTopicName, http.StatusOK,
+		`{"topic_name":"`+confluentTestTopicName+`","partitions_count":2,"replication_factor":3}`)
+	topicMetadata, topicError := newTestConfluentAdminClient(t, server).DescribeTopic(context.TODO(), confluentTestTopicName)
+	server.Close()
+	assert.Nil(t, topicError)
+	assert.Equal(t, confluentTestTopicName, topicMetadata.Name)
+	assert.Len(t, topicMetadata.Partitions, 2)
+	assert.Equal(t, int32(1), topicMetadata.Partitions[1].ID)
+	assert.Len(t, topicMetadata.Partitions[0].Replicas, 3)
+
+	// Verify A Missing Topic Is Mapped To ErrUnknownTopicOrPartition
+	server = newMockConfluentServer(t, http.MethodGet, confluentTopicsPath+"/"+confluentTestTopicName, http.StatusNotFound, "")
+	topicMetadata, topicError = newTestConfluentAdminClient(t, server).DescribeTopic(context.TODO(), confluentTestTopicName)
+	server.Close()
+	assert.Nil(t, topicMetadata)
+	assert.Equal(t, sarama.ErrUnknownTopicOrPartition, topicError.Err)
+
+	// Verify The Partitions Are Increased Via PATCH
+	server = newMockConfluentServer(t, http.MethodPatch, confluentTopicsPath+"/"+confluentTestTopicName, http.StatusOK, "{}")
+	topicError = newTestConfluentAdminClient(t, server).CreatePartitions(context.TODO(), confluentTestTopicName, 8)
+	server.Close()
+	assert.Equal(t, sarama.ErrNoError, topicError.Err)
+	assert.JSONEq(t, `{"partitions_count":8}`, string(server.requests[0]))
+}
+
+// Test The Confluent AdminClient DescribeTopicConfig() & AlterTopicConfig() Functionality
+func TestConfluentAdminClientTopicConfig(t *testing.T) {
+
+	// Verify The Topic Configs Are Mapped (Excluding Null Values)
+	server := newMockConfluentServer(t, http.MethodGet, confluentTopicsPath+"/"+confluentTestTopicName+"/configs", http.StatusOK,
+		`{"data":[{"name":"retention.ms","value":"604800000"},{"name":"cleanup.policy","value":"delete"},{"name":"unset.config","value":null}]}`)
+	topicConfig, topicError := newTestConfluentAdminClient(t, server).DescribeTopicConfig(context.TODO(), confluentTestTopicName)
+	server.Close()
+	assert.Nil(t, topicError)
+	assert.Equal(t, map[string]string{"retention.ms": "604800000", "cleanup.policy": "delete"}, topicConfig)
+
+	// Verify An Unparseable Response Is Returned As An Error
+	server = newMockConfluentServer(t, http.MethodGet, confluentTopicsPath+"/"+confluentTestTopicName+"/configs", http.StatusOK, "not json")
+	topicConfig, topicError = newTestConfluentAdminClient(t, server).DescribeTopicConfig(context.TODO(), confluentTestTopicName)
+	server.Close()
+	assert.Nil(t, topicConfig)
+	assert.Equal(t, sarama.ErrUnknown, topicError.Err)
+
+	// Verify The Topic Configs Are Altered In Name Order (Nil Values Reset To Defaults)
+	retentionMillis := "86400000"
+	server = newMockConfluentServer(t, http.MethodPost, confluentTopicsPath+"/"+confluentTestTopicName+"/configs:alter", http.StatusNoContent, "")
+	topicError = newTestConfluentAdminClient(t, server).AlterTopicConfig(context.TODO(), confluentTestTopicName, map[string]*string{
+		constants.TopicDetailConfigRetentionMs:   &retentionMillis,
+		constants.TopicDetailConfigCleanupPolicy: nil,
+	})
+	server.Close()
+	assert.Equal(t, sarama.ErrNoError, topicError.Err)
+	alterConfigsRequest := &confluentTopicConfigList{}
+	assert.Nil(t, json.Unmarshal(server.requests[0], alterConfigsRequest))
+	assert.Equal(t, []confluentTopicConfig{
+		{Name: constants.TopicDetailConfigCleanupPolicy, Operation: "DELETE"},
+		{Name: constants.TopicDetailConfigRetentionMs, Value: &retentionMillis},
+	}, alterConfigsRequest.Data)
+}
+
+// Test The Confluent AdminClient ListManagedTopics() Functionality
+func TestConfluentAdminClientListManagedTopics(t *testing.T) {
+
+	// Restore The Default Topic Name Template When Done
+	defer func() { assert.Nil(t, commonkafkautil.SetTopicNameTemplate("")) }()
+	assert.Nil(t, commonkafkautil.SetTopicNameTemplate(""))
+
+	// Verify Only The Managed (Non-Internal) Topics Are Returned
+	server := newMockConfluentServer(t, http.MethodGet, confluentTopicsPath, http.StatusOK,
+		`{"data":[{"topic_name":"`+confluentTestTopicName+`","partitions_count":4,"replication_factor":3},{"topic_name":"unmanaged","partitions_count":1,"replication_factor":3},{"topic_name":"internal.topic","partitions_count":1,"replication_factor":3,"is_internal":true}]}`)
+	topicDetails, err := newTestConfluentAdminClient(t, server).ListManagedTopics(context.TODO())
+	server.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]sarama.TopicDetail{
+		confluentTestTopicName: {NumPartitions: 4, ReplicationFactor: 3, ConfigEntries: map[string]*string{}},
+	}, topicDetails)
+
+	// Verify Failures Are Returned
+	server = newMockConfluentServer(t, http.MethodGet, confluentTopicsPath, http.StatusForbidden, "")
+	topicDetails, err = newTestConfluentAdminClient(t, server).ListManagedTopics(context.TODO())
+	server.Close()
+	assert.NotNil(t, err)
+	assert.Nil(t, topicDetails)
+}
+
+// Test The Confluent AdminClient Unsupported & Trivial Functionality
+func TestConfluentAdminClientUnsupported(t *testing.T) {
+
+	// Create A Confluent AdminClient (No Requests Expected)
+	adminClient := &ConfluentAdminClient{logger: logtesting.TestLogger(t).Desugar(), kafkaSecret: "TestKafkaSecretName"}
+
+	// Verify The Unsupported Operations Fail Cleanly
+	brokers, err := adminClient.DescribeCluster(context.TODO())
+	assert.NotNil(t, err)
+	assert.Nil(t, brokers)
+	groupDescriptions, err := adminClient.DescribeConsumerGroups(context.TODO(), []string{"TestGroupId"})
+	assert.NotNil(t, err)
+	assert.Nil(t, groupDescriptions)
+	offsetFetchResponse, err := adminClient.ListConsumerGroupOffsets(context.TODO(), "TestGroupId", nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, offsetFetchResponse)
+	assert.Equal(t, sarama.ErrInvalidRequest, adminClient.CreateTopicACL(context.TODO(), confluentTestTopicName, sarama.Acl{}).Err)
+	assert.Equal(t, sarama.ErrInvalidRequest, adminClient.DeleteTopicACL(context.TODO(), confluentTestTopicName, sarama.Acl{}).Err)
+
+	// Verify The Trivial Operations
+	assert.Nil(t, adminClient.Close())
+	assert.Equal(t, "TestKafkaSecretName", adminClient.GetKafkaSecretName(confluentTestTopicName))
+}
+
+// Test The getConfluentErrorCode() Functionality
+func TestGetConfluentErrorCode(t *testing.T) {
+	assert.Equal(t, constants.ConfluentErrorCodeTopicAlreadyExists, getConfluentErrorCode([]byte(`{"error_code":40002,"message":"Topic already exists."}`)))
+	assert.Equal(t, constants.ConfluentErrorCodeUnknown, getConfluentErrorCode(nil))
+	assert.Equal(t, constants.ConfluentErrorCodeParseFailure, getConfluentErrorCode([]byte("not json")))
+}
+
+//
+// Test HTTP Server - Pretending To Be The Confluent REST API
+//
+
+// MockConfluentServer Struct
+type MockConfluentServer struct {
+	*httptest.Server
+	requests [][]byte // Bodies Of The Received Requests
+}
+
+// Create & Start A MockConfluentServer Which Verifies The Expected Request & Returns The Specified Response
+func newMockConfluentServer(t *testing.T, method string, path string, statusCode int, responseBody string) *MockConfluentServer {
+	mockConfluentServer := &MockConfluentServer{requests: make([][]byte, 0)}
+	mockConfluentServer.Server = httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		username, password, ok := request.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, confluentTestUsername, username)
+		assert.Equal(t, confluentTestPassword, password)
+		assert.Equal(t, method, request.Method)
+		assert.Equal(t, path, request.URL.Path)
+		bodyBytes, err := ioutil.ReadAll(request.Body)
+		assert.Nil(t, err)
+		mockConfluentServer.requests = append(mockConfluentServer.requests, bodyBytes)
+		responseWriter.WriteHeader(statusCode)
+		_, _ = responseWriter.Write([]byte(responseBody))
+	}))
+	return mockConfluentServer
+}
+
+// Create A Confluent AdminClient Against The Specified MockConfluentServer
+func newTestConfluentAdminClient(t *testing.T, server *MockConfluentServer) *ConfluentAdminClient {
+	return &ConfluentAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		kafkaSecret:  "TestKafkaSecretName",
+		restEndpoint: server.URL,
+		clusterId:    confluentTestClusterId,
+		username:     confluentTestUsername,
+		password:     confluentTestPassword,
+		httpClient:   server.Client(),
+	}
+}
@@ -153,6 +153,11 @@ func (c *CustomAdminClient) CreateTopic(_ context.Context, topicName string, top
 	return c.mapHttpResponse("create", response)
 }
 
+// Create Multiple Topics - The REST Sidecar API Has No Bulk Creation So They Are Created Individually
+func (c *CustomAdminClient) CreateTopics(ctx context.Context, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+	return createTopicsIndividually(ctx, c, topicDetails)
+}
+
 // Custom REST Pass-Through Function For Deleting Topics
 func (c *CustomAdminClient) DeleteTopic(_ context.Context, topicName string) *sarama.TopicError {
 
@@ -187,6 +192,76 @@ func (c *CustomAdminClient) DeleteTopic(_ context.Context, topicName string) *sa
 	return c.mapHttpResponse("delete", response)
 }
 
+//
+// Describe A Single Topic
+//
+// The REST sidecar API only supports the creation / deletion of topics, so nil metadata is
+// returned which effectively disables any partition / replication drift detection.
+//
+func (c *CustomAdminClient) DescribeTopic(_ context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+	c.logger.Debug("Describing Topic Is Not Supported By Custom AdminClient - Returning Nil Metadata", zap.String("Topic", topicName))
+	return nil, nil
+}
+
+// Increase The Partition Count Of A Single Topic - Not Supported By The REST Sidecar API
+func (c *CustomAdminClient) CreatePartitions(_ context.Context, topicName string, _ int32) *sarama.TopicError {
+	c.logger.Warn("Increasing Topic Partitions Is Not Supported By Custom AdminClient", zap.String("Topic", topicName))
+	return adminutil.NewTopicError(sarama.ErrInvalidRequest, fmt.Sprintf("custom AdminClient does not support increasing partitions - unable to alter topic '%s'", topicName))
+}
+
+//
+// Describe The Configuration Of A Single Topic
+//
+// The REST sidecar API only supports the creation / deletion of topics, so an empty configuration
+// is returned which effectively disables any configuration drift detection.
+//
+func (c *CustomAdminClient) DescribeTopicConfig(_ context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+	c.logger.Debug("Describing Topic Configuration Is Not Supported By Custom AdminClient - Returning Empty Configuration", zap.String("Topic", topicName))
+	return map[string]string{}, nil
+}
+
+// Alter The Configuration Of A Single Topic - Not Supported By The REST Sidecar API
+func (c *CustomAdminClient) AlterTopicConfig(_ context.Context, topicName string, _ map[string]*string) *sarama.TopicError {
+	c.logger.Warn("Altering Topic Configuration Is Not Supported By Custom AdminClient - Ignoring", zap.String("Topic", topicName))
+	return nil
+}
+
+// Describe The Kafka Cluster - Not Supported By The REST Sidecar API
+func (c *CustomAdminClient) DescribeCluster(_ context.Context) ([]*sarama.Broker, error) {
+	c.logger.Debug("Describing The Cluster Is Not Supported By Custom AdminClient")
+	return nil, fmt.Errorf("describing the cluster is not supported by the custom AdminClient")
+}
+
+// Describe ConsumerGroups - Not Supported By The REST Sidecar API
+func (c *CustomAdminClient) DescribeConsumerGroups(_ context.Context, _ []string) ([]*sarama.GroupDescription, error) {
+	c.logger.Debug("Describing ConsumerGroups Is Not Supported By Custom AdminClient")
+	return nil, fmt.Errorf("describing consumer groups is not supported by the custom AdminClient")
+}
+
+// List ConsumerGroup Offsets - Not Supported By The REST Sidecar API
+func (c *CustomAdminClient) ListConsumerGroupOffsets(_ context.Context, _ string, _ map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	c.logger.Debug("Listing ConsumerGroup Offsets Is Not Supported By Custom AdminClient")
+	return nil, fmt.Errorf("listing consumer group offsets is not supported by the custom AdminClient")
+}
+
+// List The Topics Managed By The Controller - Not Supported By The REST Sidecar API
+func (c *CustomAdminClient) ListManagedTopics(_ context.Context) (map[string]sarama.TopicDetail, error) {
+	c.logger.Debug("Listing Topics Is Not Supported By Custom AdminClient")
+	return nil, fmt.Errorf("listing topics is not supported by the custom AdminClient")
+}
+
+// Create An ACL On A Single Topic - Not Supported By The REST Sidecar API
+func (c *CustomAdminClient) CreateTopicACL(_ context.Context, topicName string, _ sarama.Acl) *sarama.TopicError {
+	c.logger.Warn("Creating Topic ACLs Is Not Supported By Custom AdminClient", zap.String("Topic", topicName))
+	return adminutil.NewTopicError(sarama.ErrInvalidRequest, fmt.Sprintf("custom AdminClient does not support ACLs - unable to create ACL for topic '%s'", topicName))
+}
+
+// Delete An ACL From A Single Topic - Not Supported By The REST Sidecar API
+func (c *CustomAdminClient) DeleteTopicACL(_ context.Context, topicName string, _ sarama.Acl) *sarama.TopicError {
+	c.logger.Warn("Deleting Topic ACLs Is Not Supported By Custom AdminClient", zap.String("Topic", topicName))
+	return adminutil.NewTopicError(sarama.ErrInvalidRequest, fmt.Sprintf("custom AdminClient does not support ACLs - unable to delete ACL for topic '%s'", topicName))
+}
+
 // Custom REST Pass-Through Function For Closing The Admin Client
 func (c *CustomAdminClient) Close() error {
 	return nil // Nothing to "close" in the Custom implementation (just a REST client) so this is just a compatibility no-op.
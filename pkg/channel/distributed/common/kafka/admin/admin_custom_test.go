@@ -197,6 +197,49 @@ func TestCustomAdminClientDeleteTopic(t *testing.T) {
 	}
 }
 
+// Test The Custom AdminClient DescribeTopic(), CreatePartitions(), DescribeTopicConfig() & AlterTopicConfig() Functionality
+func TestCustomAdminClientTopicConfig(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName := "TestTopicName"
+	retentionMillis := "3600000"
+
+	// Create A New Custom AdminClient To Test
+	adminClient := &CustomAdminClient{logger: logtesting.TestLogger(t).Desugar()}
+
+	// Perform The Tests & Verify The Results (Not Supported By Sidecar)
+	topicMetadata, resultTopicError := adminClient.DescribeTopic(ctx, topicName)
+	assert.Nil(t, resultTopicError)
+	assert.Nil(t, topicMetadata)
+	resultTopicError = adminClient.CreatePartitions(ctx, topicName, 8)
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrInvalidRequest, resultTopicError.Err)
+	topicConfig, resultTopicError := adminClient.DescribeTopicConfig(ctx, topicName)
+	assert.Nil(t, resultTopicError)
+	assert.Empty(t, topicConfig)
+	resultTopicError = adminClient.AlterTopicConfig(ctx, topicName, map[string]*string{"retention.ms": &retentionMillis})
+	assert.Nil(t, resultTopicError)
+	brokers, err := adminClient.DescribeCluster(ctx)
+	assert.NotNil(t, err)
+	assert.Nil(t, brokers)
+	topicDetails, err := adminClient.ListManagedTopics(ctx)
+	assert.NotNil(t, err)
+	assert.Nil(t, topicDetails)
+	groupDescriptions, err := adminClient.DescribeConsumerGroups(ctx, []string{"TestGroupId"})
+	assert.NotNil(t, err)
+	assert.Nil(t, groupDescriptions)
+	offsetFetchResponse, err := adminClient.ListConsumerGroupOffsets(ctx, "TestGroupId", nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, offsetFetchResponse)
+	resultTopicError = adminClient.CreateTopicACL(ctx, topicName, sarama.Acl{})
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrInvalidRequest, resultTopicError.Err)
+	resultTopicError = adminClient.DeleteTopicACL(ctx, topicName, sarama.Acl{})
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrInvalidRequest, resultTopicError.Err)
+}
+
 // Test The Custom AdminClient Close() Functionality
 func TestCustomAdminClientClose(t *testing.T) {
 
@@ -22,6 +22,7 @@ import (
 	"math"
 	"regexp"
 	"strconv"
+	"strings"
 
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
 	"github.com/Shopify/sarama"
@@ -29,6 +30,7 @@ import (
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/eventhubcache"
 	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/pkg/logging"
 )
 
@@ -87,6 +89,11 @@ func NewEventHubAdminClient(ctx context.Context, namespace string) (AdminClientI
 // Kafka AdminClient CreateTopics Implementation Using Azure EventHub API
 func (c *EventHubAdminClient) CreateTopic(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
 
+	// Azure EventHubs Do Not Support Log Compaction - Reject Any Such Request
+	if topicError := c.validateCleanupPolicy(topicName, topicDetail.ConfigEntries); topicError != nil {
+		return topicError
+	}
+
 	// Extract The Kafka TopicSpecification Configuration
 	topicNumPartitions := topicDetail.NumPartitions
 	topicRetentionMillis, err := strconv.ParseInt(*topicDetail.ConfigEntries[constants.TopicDetailConfigRetentionMs], 10, 64)
@@ -118,10 +125,13 @@ func (c *EventHubAdminClient) CreateTopic(ctx context.Context, topicName string,
 		return adminutil.NewTopicError(sarama.ErrInvalidConfig, fmt.Sprintf("azure namespace has invalid HubManager - unable to create EventHub '%s'", topicName))
 	}
 
-	// Create The EventHub (Topic) Via The PUT Rest Endpoint
-	_, err = eventHubNamespace.HubManager.Put(ctx, topicName,
-		eventhub.HubWithPartitionCount(topicNumPartitions),
-		eventhub.HubWithMessageRetentionInDays(topicRetentionDays))
+	// Create The EventHub (Topic) Via The PUT Rest Endpoint (Retrying Once With Refreshed Credentials On Auth Failure)
+	err = c.retryOnAuthFailure(ctx, eventHubNamespace, func() error {
+		_, putErr := eventHubNamespace.HubManager.Put(ctx, topicName,
+			eventhub.HubWithPartitionCount(topicNumPartitions),
+			eventhub.HubWithMessageRetentionInDays(topicRetentionDays))
+		return putErr
+	})
 	if err != nil {
 
 		// Handle Specific EventHub Error Codes (To Emulate Kafka Admin Behavior)
@@ -150,6 +160,11 @@ func (c *EventHubAdminClient) CreateTopic(ctx context.Context, topicName string,
 	return adminutil.NewTopicError(sarama.ErrNoError, "successfully created topic")
 }
 
+// Create Multiple Topics (EventHubs) - The Azure EventHub API Has No Bulk Creation So They Are Created Individually
+func (c *EventHubAdminClient) CreateTopics(ctx context.Context, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+	return createTopicsIndividually(ctx, c, topicDetails)
+}
+
 // Delete A Single Topic (EventHub) Via The Azure EventHub API
 func (c *EventHubAdminClient) DeleteTopic(ctx context.Context, topicName string) *sarama.TopicError {
 
@@ -170,8 +185,10 @@ func (c *EventHubAdminClient) DeleteTopic(ctx context.Context, topicName string)
 		return adminutil.NewTopicError(sarama.ErrInvalidConfig, fmt.Sprintf("azure namespace has invalid HubManager - unable to delete EventHub '%s'", topicName))
 	}
 
-	// Delete The Specified Topic (EventHub)
-	err := eventHubNamespace.HubManager.Delete(ctx, topicName)
+	// Delete The Specified Topic (EventHub) (Retrying Once With Refreshed Credentials On Auth Failure)
+	err := c.retryOnAuthFailure(ctx, eventHubNamespace, func() error {
+		return eventHubNamespace.HubManager.Delete(ctx, topicName)
+	})
 	if err != nil {
 
 		// Delete API Returns Success For Non-Existent Topics - Nothing To Map - Just Return Error
@@ -186,6 +203,126 @@ func (c *EventHubAdminClient) DeleteTopic(ctx context.Context, topicName string)
 	return adminutil.NewTopicError(sarama.ErrNoError, "successfully deleted topic")
 }
 
+//
+// Describe A Single Topic (EventHub)
+//
+// The Azure EventHub API does not expose Kafka topic metadata, so nil metadata is returned which
+// effectively disables any partition / replication drift detection for EventHubs.
+//
+func (c *EventHubAdminClient) DescribeTopic(_ context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+	c.logger.Debug("Describing EventHub Is Not Supported - Returning Nil Metadata", zap.String("Topic", topicName))
+	return nil, nil
+}
+
+// Increase The Partition Count Of A Single Topic (EventHub) - Not Supported
+func (c *EventHubAdminClient) CreatePartitions(_ context.Context, topicName string, _ int32) *sarama.TopicError {
+	c.logger.Warn("Increasing EventHub Partitions Is Not Supported", zap.String("Topic", topicName))
+	return adminutil.NewTopicError(sarama.ErrInvalidRequest, fmt.Sprintf("azure eventhub does not support increasing partitions - unable to alter EventHub '%s'", topicName))
+}
+
+//
+// Describe The Configuration Of A Single Topic (EventHub)
+//
+// The Azure EventHub API does not expose Kafka topic configuration, so an empty configuration is
+// returned which effectively disables any configuration drift detection for EventHubs.
+//
+func (c *EventHubAdminClient) DescribeTopicConfig(_ context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+	c.logger.Debug("Describing EventHub Configuration Is Not Supported - Returning Empty Configuration", zap.String("Topic", topicName))
+	return map[string]string{}, nil
+}
+
+// Describe The Kafka Cluster (EventHub) - Not Supported (Brokers Are Managed By Azure)
+func (c *EventHubAdminClient) DescribeCluster(_ context.Context) ([]*sarama.Broker, error) {
+	c.logger.Debug("Describing EventHub Cluster Is Not Supported")
+	return nil, fmt.Errorf("azure eventhub does not support describing the cluster")
+}
+
+// Describe ConsumerGroups (EventHub) - Not Supported By The Azure EventHub API
+func (c *EventHubAdminClient) DescribeConsumerGroups(_ context.Context, _ []string) ([]*sarama.GroupDescription, error) {
+	c.logger.Debug("Describing EventHub ConsumerGroups Is Not Supported")
+	return nil, fmt.Errorf("azure eventhub does not support describing consumer groups")
+}
+
+// List ConsumerGroup Offsets (EventHub) - Not Supported By The Azure EventHub API
+func (c *EventHubAdminClient) ListConsumerGroupOffsets(_ context.Context, _ string, _ map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	c.logger.Debug("Listing EventHub ConsumerGroup Offsets Is Not Supported")
+	return nil, fmt.Errorf("azure eventhub does not support listing consumer group offsets")
+}
+
+//
+// List The Topics (EventHubs) Managed By The Controller Across All Of The Cached Namespaces
+//
+// Only those EventHubs matching the topic name template are included.  The EventHub partition count and
+// message retention are mapped to their Kafka equivalents, while the replication factor (managed by Azure)
+// is reported as -1.
+//
+func (c *EventHubAdminClient) ListManagedTopics(ctx context.Context) (map[string]sarama.TopicDetail, error) {
+
+	// Loop Over The Namespaces In The Cache
+	managedTopicDetails := make(map[string]sarama.TopicDetail)
+	for _, eventHubNamespace := range c.cache.GetNamespaces() {
+
+		// If The HubManager Is Not Valid Then Return Error
+		if eventHubNamespace.HubManager == nil {
+			c.logger.Warn("Found EventHub Namespace With Invalid HubManager - Unable To List Topics", zap.String("Namespace", eventHubNamespace.Name))
+			return nil, fmt.Errorf("azure namespace '%s' has invalid HubManager - unable to list EventHubs", eventHubNamespace.Name)
+		}
+
+		// List The EventHubs In The Namespace (Retrying Once With Refreshed Credentials On Auth Failure)
+		var hubEntities []*eventhub.HubEntity
+		err := c.retryOnAuthFailure(ctx, eventHubNamespace, func() error {
+			var listErr error
+			hubEntities, listErr = eventHubNamespace.HubManager.List(ctx)
+			return listErr
+		})
+		if err != nil {
+			c.logger.Error("Failed To List EventHubs In Namespace", zap.String("Namespace", eventHubNamespace.Name), zap.Error(err))
+			return nil, err
+		}
+
+		// Map The Managed EventHubs To Kafka TopicDetails
+		for _, hubEntity := range hubEntities {
+			if hubEntity != nil && commonkafkautil.IsManagedTopicName(hubEntity.Name) {
+				managedTopicDetails[hubEntity.Name] = newEventHubTopicDetail(hubEntity)
+			}
+		}
+	}
+
+	// Return The Managed Topics
+	return managedTopicDetails, nil
+}
+
+// Create An ACL On A Single Topic (EventHub) - Not Supported (Access Is Managed Via Azure Shared Access Policies)
+func (c *EventHubAdminClient) CreateTopicACL(_ context.Context, topicName string, _ sarama.Acl) *sarama.TopicError {
+	c.logger.Warn("Creating EventHub ACLs Is Not Supported", zap.String("Topic", topicName))
+	return adminutil.NewTopicError(sarama.ErrInvalidRequest, fmt.Sprintf("azure eventhub does not support ACLs - unable to create ACL for EventHub '%s'", topicName))
+}
+
+// Delete An ACL From A Single Topic (EventHub) - Not Supported (Access Is Managed Via Azure Shared Access Policies)
+func (c *EventHubAdminClient) DeleteTopicACL(_ context.Context, topicName string, _ sarama.Acl) *sarama.TopicError {
+	c.logger.Warn("Deleting EventHub ACLs Is Not Supported", zap.String("Topic", topicName))
+	return adminutil.NewTopicError(sarama.ErrInvalidRequest, fmt.Sprintf("azure eventhub does not support ACLs - unable to delete ACL for EventHub '%s'", topicName))
+}
+
+// Alter The Configuration Of A Single Topic (EventHub) - Not Supported Other Than Rejecting Compaction
+func (c *EventHubAdminClient) AlterTopicConfig(_ context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+	if topicError := c.validateCleanupPolicy(topicName, configEntries); topicError != nil {
+		return topicError
+	}
+	c.logger.Warn("Altering EventHub Configuration Is Not Supported - Ignoring", zap.String("Topic", topicName))
+	return nil
+}
+
+// Return A TopicError If The Specified ConfigEntries Request A "compact" cleanup.policy (Unsupported By EventHubs)
+func (c *EventHubAdminClient) validateCleanupPolicy(topicName string, configEntries map[string]*string) *sarama.TopicError {
+	cleanupPolicy := configEntries[constants.TopicDetailConfigCleanupPolicy]
+	if cleanupPolicy != nil && strings.Contains(*cleanupPolicy, constants.TopicDetailConfigCleanupPolicyCompact) {
+		c.logger.Warn("Azure EventHubs Do Not Support Compaction - Rejecting Topic Configuration", zap.String("Topic", topicName), zap.String("CleanupPolicy", *cleanupPolicy))
+		return adminutil.NewTopicError(sarama.ErrInvalidConfig, fmt.Sprintf("azure eventhub does not support cleanup.policy '%s' - unable to configure EventHub '%s'", *cleanupPolicy, topicName))
+	}
+	return nil
+}
+
 // Get The K8S Secret With Kafka Credentials For The Specified Topic (EventHub)
 func (c *EventHubAdminClient) GetKafkaSecretName(topicName string) string {
 
@@ -202,6 +339,33 @@ func (c *EventHubAdminClient) GetKafkaSecretName(topicName string) string {
 	return kafkaSecretName
 }
 
+//
+// Perform The Specified EventHub Operation, Retrying Once If It Fails Due To An Auth Failure
+//
+// Azure EventHub connection strings may be rotated (via the Kafka Secret) at any time, in which case the cached
+// HubManager's credentials are stale.  Rather than failing until the process restarts, the Namespace's Kafka
+// Secret is reloaded and its HubManager rebuilt before retrying the operation.
+//
+func (c *EventHubAdminClient) retryOnAuthFailure(ctx context.Context, eventHubNamespace *eventhubcache.Namespace, operation func() error) error {
+
+	// Perform The Operation & Return Any Non-Auth Failure Results
+	err := operation()
+	if err == nil || getEventHubErrorCode(err) != constants.EventHubErrorCodeUnauthorized {
+		return err
+	}
+
+	// Refresh The Namespace's Credentials From Its Kafka Secret (Returning The Original Error On Failure)
+	c.logger.Warn("EventHub Auth Failure - Refreshing Namespace Credentials From Kafka Secret", zap.String("Namespace", eventHubNamespace.Name), zap.Error(err))
+	refreshErr := c.cache.RefreshNamespace(ctx, eventHubNamespace)
+	if refreshErr != nil {
+		c.logger.Error("Failed To Refresh EventHub Namespace Credentials", zap.String("Namespace", eventHubNamespace.Name), zap.Error(refreshErr))
+		return err
+	}
+
+	// Retry The Operation With The Refreshed Credentials
+	return operation()
+}
+
 // Kafka AdminClient Close Implementation Using Azure EventHub API
 func (c *EventHubAdminClient) Close() error {
 	return nil // Nothing to "close" in the HubManager (just a REST client) so this is just a compatibility no-op.
@@ -212,6 +376,21 @@ func convertMillisToDays(millis int64) int32 {
 	return int32(math.Ceil(float64(millis) / float64(constants.MillisPerDay)))
 }
 
+// Utility Function For Mapping An EventHub's Description To A Kafka TopicDetail
+func newEventHubTopicDetail(hubEntity *eventhub.HubEntity) sarama.TopicDetail {
+	topicDetail := sarama.TopicDetail{ReplicationFactor: -1, ConfigEntries: map[string]*string{}}
+	if hubEntity.HubDescription != nil {
+		if hubEntity.PartitionCount != nil {
+			topicDetail.NumPartitions = *hubEntity.PartitionCount
+		}
+		if hubEntity.MessageRetentionInDays != nil {
+			retentionMillis := strconv.FormatInt(int64(*hubEntity.MessageRetentionInDays)*constants.MillisPerDay, 10)
+			topicDetail.ConfigEntries[constants.TopicDetailConfigRetentionMs] = &retentionMillis
+		}
+	}
+	return topicDetail
+}
+
 //
 // Utility Function For Extracting Error Code From EventHub Errors
 //
@@ -26,8 +26,13 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/eventhubcache"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	injectionclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/logging"
 	logtesting "knative.dev/pkg/logging/testing"
 )
 
@@ -166,6 +171,172 @@ func TestEventHubAdminClientCreateTopicInvalidConfig(t *testing.T) {
 	assert.Equal(t, "failed to parse retention millis from TopicDetail", *resultTopicError.ErrMsg)
 }
 
+// Test The EventHub AdminClient CreateTopic() Functionality - Compaction Path
+func TestEventHubAdminClientCreateTopicCompaction(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName := "TestTopicName"
+	topicRetentionMillisString := "604800000"
+	topicCleanupPolicy := constants.TopicDetailConfigCleanupPolicyCompact
+
+	// Create The Sarama TopicDetail For The Topic/EventHub To Be Created
+	topicDetail := &sarama.TopicDetail{
+		NumPartitions: int32(4),
+		ConfigEntries: map[string]*string{
+			constants.TopicDetailConfigRetentionMs:   &topicRetentionMillisString,
+			constants.TopicDetailConfigCleanupPolicy: &topicCleanupPolicy,
+		},
+	}
+
+	// Create A New EventHub AdminClient Without Mock Cache To Test
+	adminClient := &EventHubAdminClient{logger: logtesting.TestLogger(t).Desugar()}
+
+	// Perform The Test
+	resultTopicError := adminClient.CreateTopic(ctx, topicName, topicDetail)
+
+	// Verify The Results
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrInvalidConfig, resultTopicError.Err)
+	assert.Equal(t, "azure eventhub does not support cleanup.policy 'compact' - unable to configure EventHub 'TestTopicName'", *resultTopicError.ErrMsg)
+}
+
+// Test The EventHub AdminClient DescribeTopicConfig() & AlterTopicConfig() Functionality
+func TestEventHubAdminClientTopicConfig(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName := "TestTopicName"
+	deletePolicy := "delete"
+	compactPolicy := constants.TopicDetailConfigCleanupPolicyCompact
+
+	// Create A New EventHub AdminClient Without Mock Cache To Test
+	adminClient := &EventHubAdminClient{logger: logtesting.TestLogger(t).Desugar()}
+
+	// Verify DescribeTopic() Returns Nil Metadata & CreatePartitions() Is Rejected
+	topicMetadata, resultTopicError := adminClient.DescribeTopic(ctx, topicName)
+	assert.Nil(t, resultTopicError)
+	assert.Nil(t, topicMetadata)
+	resultTopicError = adminClient.CreatePartitions(ctx, topicName, 8)
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrInvalidRequest, resultTopicError.Err)
+
+	// Verify DescribeTopicConfig() Returns An Empty Configuration
+	topicConfig, resultTopicError := adminClient.DescribeTopicConfig(ctx, topicName)
+	assert.Nil(t, resultTopicError)
+	assert.Empty(t, topicConfig)
+
+	// Verify AlterTopicConfig() Ignores Non-Compaction Changes
+	resultTopicError = adminClient.AlterTopicConfig(ctx, topicName, map[string]*string{constants.TopicDetailConfigCleanupPolicy: &deletePolicy})
+	assert.Nil(t, resultTopicError)
+
+	// Verify AlterTopicConfig() Rejects Compaction
+	resultTopicError = adminClient.AlterTopicConfig(ctx, topicName, map[string]*string{constants.TopicDetailConfigCleanupPolicy: &compactPolicy})
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrInvalidConfig, resultTopicError.Err)
+
+	// Verify DescribeCluster() Is Not Supported
+	brokers, err := adminClient.DescribeCluster(ctx)
+	assert.NotNil(t, err)
+	assert.Nil(t, brokers)
+
+	// Verify ConsumerGroups Are Not Supported
+	groupDescriptions, err := adminClient.DescribeConsumerGroups(ctx, []string{"TestGroupId"})
+	assert.NotNil(t, err)
+	assert.Nil(t, groupDescriptions)
+	offsetFetchResponse, err := adminClient.ListConsumerGroupOffsets(ctx, "TestGroupId", nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, offsetFetchResponse)
+
+	// Verify Topic ACLs Are Not Supported
+	resultTopicError = adminClient.CreateTopicACL(ctx, topicName, sarama.Acl{})
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrInvalidRequest, resultTopicError.Err)
+	resultTopicError = adminClient.DeleteTopicACL(ctx, topicName, sarama.Acl{})
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrInvalidRequest, resultTopicError.Err)
+}
+
+// Test The EventHub AdminClient ListManagedTopics() Functionality
+func TestEventHubAdminClientListManagedTopics(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	partitionCount := int32(4)
+	retentionDays := int32(3)
+	retentionMillis := strconv.FormatInt(int64(retentionDays)*constants.MillisPerDay, 10)
+
+	// Create Mock HubManagers With Managed & Unmanaged EventHubs
+	mockHubManager1 := &MockHubManager{}
+	mockHubManager1.On("List", ctx).Return([]*eventhub.HubEntity{
+		{Name: "test-namespace.test-name-1", HubDescription: &eventhub.HubDescription{PartitionCount: &partitionCount, MessageRetentionInDays: &retentionDays}},
+		{Name: "UnmanagedEventHub", HubDescription: &eventhub.HubDescription{PartitionCount: &partitionCount}},
+	}, nil)
+	mockHubManager2 := &MockHubManager{}
+	mockHubManager2.On("List", ctx).Return([]*eventhub.HubEntity{{Name: "test-namespace.test-name-2"}}, nil)
+
+	// Create A Mock EventHub Cache With The Namespaces
+	mockCache := &MockCache{}
+	mockCache.On("GetNamespaces").Return([]*eventhubcache.Namespace{
+		{Name: "TestNamespace1", HubManager: mockHubManager1},
+		{Name: "TestNamespace2", HubManager: mockHubManager2},
+	})
+
+	// Create A New EventHub AdminClient With Mock Cache To Test
+	adminClient := &EventHubAdminClient{logger: logtesting.TestLogger(t).Desugar(), cache: mockCache}
+
+	// Perform The Test
+	topicDetails, err := adminClient.ListManagedTopics(ctx)
+
+	// Verify The Results
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]sarama.TopicDetail{
+		"test-namespace.test-name-1": {
+			NumPartitions:     partitionCount,
+			ReplicationFactor: -1,
+			ConfigEntries:     map[string]*string{constants.TopicDetailConfigRetentionMs: &retentionMillis},
+		},
+		"test-namespace.test-name-2": {
+			ReplicationFactor: -1,
+			ConfigEntries:     map[string]*string{},
+		},
+	}, topicDetails)
+	mockHubManager1.AssertExpectations(t)
+	mockHubManager2.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// Test The EventHub AdminClient ListManagedTopics() Functionality - Error Path
+func TestEventHubAdminClientListManagedTopicsError(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+
+	// Create A Mock HubManager Which Fails To List The EventHubs
+	mockHubManager := &MockHubManager{}
+	mockHubManager.On("List", ctx).Return([]*eventhub.HubEntity{}, fmt.Errorf("test list error"))
+
+	// Create A Mock EventHub Cache With The Namespace
+	mockCache := &MockCache{}
+	mockCache.On("GetNamespaces").Return([]*eventhubcache.Namespace{{Name: "TestNamespace", HubManager: mockHubManager}})
+
+	// Create A New EventHub AdminClient With Mock Cache To Test
+	adminClient := &EventHubAdminClient{logger: logtesting.TestLogger(t).Desugar(), cache: mockCache}
+
+	// Verify The List Failure Is Returned
+	topicDetails, err := adminClient.ListManagedTopics(ctx)
+	assert.NotNil(t, err)
+	assert.Nil(t, topicDetails)
+
+	// Verify A Namespace Without A HubManager Is Rejected
+	invalidCache := &MockCache{}
+	invalidCache.On("GetNamespaces").Return([]*eventhubcache.Namespace{{Name: "TestNamespace"}})
+	adminClient.cache = invalidCache
+	topicDetails, err = adminClient.ListManagedTopics(ctx)
+	assert.NotNil(t, err)
+	assert.Nil(t, topicDetails)
+}
+
 // Test The EventHub AdminClient CreateTopic() Functionality - No Namespace Path
 func TestEventHubAdminClientCreateTopicNoNamespace(t *testing.T) {
 
@@ -514,6 +685,116 @@ func TestEventHubAdminClientDeleteTopicError(t *testing.T) {
 	mockCache.AssertExpectations(t)
 }
 
+// Test The EventHub AdminClient Recovers From A Rotated Connection String Without Being Recreated
+func TestEventHubAdminClientRotatedConnectionString(t *testing.T) {
+
+	// Test Data
+	k8sNamespace := "TestK8SNamespace"
+	topicName := "TestTopicName"
+	stalePassword := "TestStalePassword"
+	rotatedPassword := "TestRotatedPassword"
+	topicRetentionMillisString := strconv.FormatInt(int64(constants.MillisPerDay), 10)
+	topicDetail := &sarama.TopicDetail{
+		NumPartitions: 4,
+		ConfigEntries: map[string]*string{constants.TopicDetailConfigRetentionMs: &topicRetentionMillisString},
+	}
+	authError := fmt.Errorf("error code: %d, Details: Unauthorized", constants.EventHubErrorCodeUnauthorized)
+
+	// Create A Kafka Secret With The (Soon To Be) Stale Connection String
+	kafkaSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "TestKafkaSecretName",
+			Namespace: k8sNamespace,
+			Labels:    map[string]string{constants.KafkaSecretLabel: "true"},
+		},
+		Data: map[string][]byte{
+			constants.KafkaSecretKeyBrokers:   []byte("TestBrokers"),
+			constants.KafkaSecretKeyUsername:  []byte("TestUsername"),
+			constants.KafkaSecretKeyPassword:  []byte(stalePassword),
+			constants.KafkaSecretKeyNamespace: []byte("TestEventHubNamespace"),
+		},
+	}
+	fakeK8sClient := fake.NewSimpleClientset(kafkaSecret)
+	ctx := logging.WithLogger(context.TODO(), logtesting.TestLogger(t))
+	ctx = context.WithValue(ctx, injectionclient.Key{}, fakeK8sClient)
+
+	// Create Mock HubManagers Which Reject The Stale Connection String & Accept The Rotated One
+	staleHubManager := &MockHubManager{}
+	staleHubManager.On("List", mock.Anything).Return([]*eventhub.HubEntity{}, nil)
+	staleHubManager.On("Put", ctx, topicName, mock.Anything).Return(nil, authError).Once()
+	rotatedHubManager := &MockHubManager{}
+	rotatedHubManager.On("Put", ctx, topicName, mock.Anything).Return(nil, nil).Once()
+	rotatedHubManager.On("Delete", ctx, topicName).Return(nil).Once()
+
+	// Replace The NewHubManagerFromConnectionString Wrapper To Provide Mock Implementation & Defer Reset
+	newHubManagerFromConnectionStringWrapperPlaceholder := eventhubcache.NewHubManagerFromConnectionStringWrapper
+	eventhubcache.NewHubManagerFromConnectionStringWrapper = func(connectionString string) (eventhubcache.HubManagerInterface, error) {
+		if connectionString == rotatedPassword {
+			return rotatedHubManager, nil
+		}
+		return staleHubManager, nil
+	}
+	defer func() {
+		eventhubcache.NewHubManagerFromConnectionStringWrapper = newHubManagerFromConnectionStringWrapperPlaceholder
+	}()
+
+	// Create The EventHub AdminClient (With A Real Cache) Using The Original Connection String
+	adminClient, err := NewEventHubAdminClient(ctx, k8sNamespace)
+	assert.Nil(t, err)
+	assert.NotNil(t, adminClient)
+
+	// Rotate The Connection String In The Kafka Secret
+	kafkaSecret.Data[constants.KafkaSecretKeyPassword] = []byte(rotatedPassword)
+	_, err = fakeK8sClient.CoreV1().Secrets(k8sNamespace).Update(ctx, kafkaSecret, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+
+	// Perform The Test - Operations Should Transparently Recover Using The Rotated Connection String
+	createTopicError := adminClient.CreateTopic(ctx, topicName, topicDetail)
+	deleteTopicError := adminClient.DeleteTopic(ctx, topicName)
+
+	// Verify The Results
+	assert.NotNil(t, createTopicError)
+	assert.Equal(t, sarama.ErrNoError, createTopicError.Err)
+	assert.NotNil(t, deleteTopicError)
+	assert.Equal(t, sarama.ErrNoError, deleteTopicError.Err)
+	staleHubManager.AssertExpectations(t)
+	rotatedHubManager.AssertExpectations(t)
+}
+
+// Test The EventHub AdminClient DeleteTopic() Functionality - Auth Failure With Failed Namespace Refresh
+func TestEventHubAdminClientDeleteTopicAuthFailureRefreshError(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName := "TestTopicName"
+	authError := fmt.Errorf("error code: %d, Details: Unauthorized", constants.EventHubErrorCodeUnauthorized)
+
+	// Create A Mock HubManager Which Fails Authentication
+	mockHubManager := &MockHubManager{}
+	mockHubManager.On("Delete", ctx, topicName).Return(authError).Once()
+
+	// Create A Namespace With The Mock HubManager
+	namespace := &eventhubcache.Namespace{HubManager: mockHubManager}
+
+	// Create A Mock EventHub Cache Which Fails To Refresh The Namespace
+	mockCache := &MockCache{}
+	mockCache.On("GetNamespace", topicName).Return(namespace)
+	mockCache.On("RefreshNamespace", ctx, namespace).Return(fmt.Errorf("expected test error"))
+
+	// Create A New EventHub AdminClient With Mock Cache To Test
+	adminClient := &EventHubAdminClient{logger: logtesting.TestLogger(t).Desugar(), cache: mockCache}
+
+	// Perform The Test
+	resultTopicError := adminClient.DeleteTopic(ctx, topicName)
+
+	// Verify The Results (Original Auth Error Returned)
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrUnknown, resultTopicError.Err)
+	assert.Equal(t, authError.Error(), *resultTopicError.ErrMsg)
+	mockHubManager.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
 // Test The EventHub AdminClient GetKafkaSecretName() Functionality
 func TestEventHubAdminClientGetKafkaSecretName(t *testing.T) {
 
@@ -594,6 +875,16 @@ func (m *MockCache) GetNamespace(eventhub string) *eventhubcache.Namespace {
 	}
 }
 
+func (m *MockCache) RefreshNamespace(ctx context.Context, namespace *eventhubcache.Namespace) error {
+	args := m.Called(ctx, namespace)
+	return args.Error(0)
+}
+
+func (m *MockCache) GetNamespaces() []*eventhubcache.Namespace {
+	args := m.Called()
+	return args.Get(0).([]*eventhubcache.Namespace)
+}
+
 func (m *MockCache) GetLeastPopulatedNamespace() *eventhubcache.Namespace {
 	args := m.Called()
 	response := args.Get(0)
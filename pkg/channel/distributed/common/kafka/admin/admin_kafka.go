@@ -18,6 +18,7 @@ package admin
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"strings"
@@ -28,6 +29,7 @@ import (
 	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
 	kafkasarama "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/logging"
 )
@@ -46,9 +48,15 @@ type KafkaAdminClient struct {
 	namespace    string
 	kafkaSecret  string
 	clientId     string
+	saramaConfig *sarama.Config
 	clusterAdmin sarama.ClusterAdmin
 }
 
+// The Sarama ClusterAdmin's (Unexported) Access To The Controller Broker - Used For Bulk Topic Creation
+type kafkaControllerProvider interface {
+	Controller() (*sarama.Broker, error)
+}
+
 // Create A New Kafka AdminClient Based On The Kafka Secret In The Specified K8S Namespace
 func NewKafkaAdminClient(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (AdminClientInterface, error) {
 
@@ -65,6 +73,15 @@ func NewKafkaAdminClient(ctx context.Context, saramaConfig *sarama.Config, clien
 		return nil, err
 	}
 
+	// Restrict To The Explicitly Selected Kafka Secret (If Any) Which Must Exist
+	if kafkaSecretName := KafkaSecretNameFromContext(ctx); len(kafkaSecretName) > 0 {
+		kafkaSecrets = filterKafkaSecrets(ctx, kafkaSecrets)
+		if len(kafkaSecrets.Items) <= 0 {
+			logger.Error("Selected Kafka Secret Not Found", zap.String("Secret", kafkaSecretName))
+			return nil, fmt.Errorf("kafka secret '%s' not found", kafkaSecretName)
+		}
+	}
+
 	// Currently Only Support One Kafka Secret - Invalid AdminClient For All Other Cases!
 	var kafkaSecret corev1.Secret
 	if len(kafkaSecrets.Items) != 1 {
@@ -85,9 +102,34 @@ func NewKafkaAdminClient(ctx context.Context, saramaConfig *sarama.Config, clien
 	brokers := strings.Split(string(kafkaSecret.Data[constants.KafkaSecretKeyBrokers]), ",")
 	username := string(kafkaSecret.Data[constants.KafkaSecretKeyUsername])
 	password := string(kafkaSecret.Data[constants.KafkaSecretKeyPassword])
+	saslMechanism := string(kafkaSecret.Data[constants.KafkaSecretKeySaslMechanism])
+	userCert := string(kafkaSecret.Data[constants.KafkaSecretKeyUserCert])
+	userKey := string(kafkaSecret.Data[constants.KafkaSecretKeyUserKey])
+	tlsEnable := string(kafkaSecret.Data[constants.KafkaSecretKeyTLSEnable])
+
+	// Copy The Sarama Config So That Secret Specific Settings (TLS Enable, etc.) Don't Leak Into Other Clients
+	secretSaramaConfig := *saramaConfig
+	saramaConfig = &secretSaramaConfig
 
 	// Update The Sarama ClusterAdmin Configuration With Our Values
 	kafkasarama.UpdateSaramaConfig(saramaConfig, clientId, username, password)
+	err = kafkasarama.UpdateSaramaConfigSaslMechanism(saramaConfig, saslMechanism)
+	if err != nil {
+		logger.Error("Invalid Kafka Secret SASL Mechanism", zap.String("Secret", kafkaSecret.Name), zap.Error(err))
+		return nil, err
+	}
+	tlsCertificate, err := kafkasarama.ParseTLSCertificate(userCert, userKey)
+	if err != nil {
+		logger.Error("Invalid Kafka Secret TLS Client Certificate", zap.String("Secret", kafkaSecret.Name), zap.Error(err))
+		return nil, err
+	} else if tlsCertificate != nil {
+		kafkasarama.UpdateSaramaConfigTLSCertificates(saramaConfig, []tls.Certificate{*tlsCertificate})
+	}
+	err = kafkasarama.UpdateSaramaConfigTLSEnable(saramaConfig, tlsEnable)
+	if err != nil {
+		logger.Error("Invalid Kafka Secret TLS Enable", zap.String("Secret", kafkaSecret.Name), zap.Error(err))
+		return nil, err
+	}
 
 	// Create A New Sarama ClusterAdmin
 	clusterAdmin, err := NewClusterAdminWrapper(brokers, saramaConfig)
@@ -102,6 +144,7 @@ func NewKafkaAdminClient(ctx context.Context, saramaConfig *sarama.Config, clien
 		namespace:    namespace,
 		kafkaSecret:  kafkaSecret.Name,
 		clientId:     clientId,
+		saramaConfig: saramaConfig,
 		clusterAdmin: clusterAdmin,
 	}
 
@@ -126,6 +169,75 @@ func (k KafkaAdminClient) CreateTopic(_ context.Context, topicName string, topic
 	}
 }
 
+//
+// Create Multiple Topics In A Single CreateTopics Request To The Kafka Controller
+//
+// The Sarama ClusterAdmin only creates one topic per request, so the bulk request is instead sent directly to the
+// controller broker and the per-topic results returned keyed by topic name (allowing partial failures to be acted
+// upon individually).  ClusterAdmin implementations which do not expose the controller broker fall back to creating
+// the topics one at a time.
+//
+func (k KafkaAdminClient) CreateTopics(ctx context.Context, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+
+	// Verify The ClusterAdmin
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To Create Topics Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return newTopicErrorMap(topicDetails, adminutil.NewUnknownTopicError("unable to create topics due to invalid ClusterAdmin - check Kafka authorization secrets"))
+	}
+
+	// Fall Back To Individual Topic Creation If The Controller Broker Is Not Accessible
+	controllerProvider, ok := k.clusterAdmin.(kafkaControllerProvider)
+	if !ok || k.saramaConfig == nil {
+		k.logger.Debug("Kafka Controller Not Accessible Via ClusterAdmin - Creating Topics Individually")
+		return createTopicsIndividually(ctx, k, topicDetails)
+	}
+
+	// Reject Any Invalid Topics & Build The Bulk CreateTopics Request From The Remainder
+	topicErrors := make(map[string]*sarama.TopicError, len(topicDetails))
+	request := &sarama.CreateTopicsRequest{TopicDetails: make(map[string]*sarama.TopicDetail, len(topicDetails)), Timeout: k.saramaConfig.Admin.Timeout}
+	if k.saramaConfig.Version.IsAtLeast(sarama.V0_11_0_0) {
+		request.Version = 1
+	}
+	if k.saramaConfig.Version.IsAtLeast(sarama.V1_0_0_0) {
+		request.Version = 2
+	}
+	for topicName, topicDetail := range topicDetails {
+		if len(topicName) <= 0 || topicDetail == nil {
+			topicErrors[topicName] = adminutil.NewTopicError(sarama.ErrInvalidRequest, "received empty/nil topic name and / or detail")
+		} else {
+			request.TopicDetails[topicName] = topicDetail
+		}
+	}
+	if len(request.TopicDetails) <= 0 {
+		return topicErrors
+	}
+
+	// Send The Bulk Request To The Controller Broker (Failing All Topics If The Request Itself Fails)
+	controller, err := controllerProvider.Controller()
+	if err != nil {
+		k.logger.Error("Failed To Get Kafka Controller For Bulk Topic Creation", zap.Error(err))
+		return mergeTopicErrors(topicErrors, newTopicErrorMap(request.TopicDetails, adminutil.PromoteErrorToTopicError(err)))
+	}
+	response, err := controller.CreateTopics(request)
+	if err != nil {
+		k.logger.Error("Failed To Send Bulk CreateTopics Request", zap.Int("Topics", len(request.TopicDetails)), zap.Error(err))
+		return mergeTopicErrors(topicErrors, newTopicErrorMap(request.TopicDetails, adminutil.PromoteErrorToTopicError(err)))
+	}
+
+	// Map The Per-Topic Results
+	for topicName := range request.TopicDetails {
+		topicError, ok := response.TopicErrors[topicName]
+		if !ok {
+			topicErrors[topicName] = adminutil.NewUnknownTopicError("no result for topic in CreateTopics response")
+		} else if topicError == nil {
+			topicErrors[topicName] = adminutil.NewTopicError(sarama.ErrNoError, "successfully created topic")
+		} else {
+			topicErrors[topicName] = topicError
+		}
+	}
+	return topicErrors
+}
+
 // Sarama Pass-Through Function For Deleting Topics
 func (k KafkaAdminClient) DeleteTopic(_ context.Context, topicName string) *sarama.TopicError {
 	if k.clusterAdmin == nil {
@@ -137,6 +249,204 @@ func (k KafkaAdminClient) DeleteTopic(_ context.Context, topicName string) *sara
 	}
 }
 
+// Sarama Pass-Through Function For Describing A Topic (Partitions, Replicas, etc.)
+func (k KafkaAdminClient) DescribeTopic(_ context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To Describe Topic Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return nil, adminutil.NewUnknownTopicError("unable to describe topic due to invalid ClusterAdmin - check Kafka authorization secrets")
+	} else {
+		topicMetadata, err := k.clusterAdmin.DescribeTopics([]string{topicName})
+		if err != nil {
+			return nil, adminutil.PromoteErrorToTopicError(err)
+		} else if len(topicMetadata) != 1 || topicMetadata[0] == nil {
+			return nil, adminutil.NewUnknownTopicError(fmt.Sprintf("unexpected metadata returned when describing topic '%s'", topicName))
+		} else if topicMetadata[0].Err != sarama.ErrNoError {
+			return nil, adminutil.NewTopicError(topicMetadata[0].Err, fmt.Sprintf("failed to describe topic '%s'", topicName))
+		}
+		return topicMetadata[0], nil
+	}
+}
+
+// Sarama Pass-Through Function For Increasing A Topic's Partition Count
+func (k KafkaAdminClient) CreatePartitions(_ context.Context, topicName string, count int32) *sarama.TopicError {
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To Create Partitions Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return adminutil.NewUnknownTopicError("unable to create partitions due to invalid ClusterAdmin - check Kafka authorization secrets")
+	} else {
+		err := k.clusterAdmin.CreatePartitions(topicName, count, nil, false)
+		return adminutil.PromoteErrorToTopicError(err)
+	}
+}
+
+// Sarama Pass-Through Function For Describing A Topic's Configuration (Returned As A Simple Name/Value Map)
+func (k KafkaAdminClient) DescribeTopicConfig(_ context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To Describe Topic Config Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return nil, adminutil.NewUnknownTopicError("unable to describe topic config due to invalid ClusterAdmin - check Kafka authorization secrets")
+	} else {
+		configEntries, err := k.clusterAdmin.DescribeConfig(sarama.ConfigResource{Type: sarama.TopicResource, Name: topicName})
+		if err != nil {
+			return nil, adminutil.PromoteErrorToTopicError(err)
+		}
+		topicConfig := make(map[string]string, len(configEntries))
+		for _, configEntry := range configEntries {
+			topicConfig[configEntry.Name] = configEntry.Value
+		}
+		return topicConfig, nil
+	}
+}
+
+//
+// Sarama Pass-Through Function For Altering A Topic's Configuration
+//
+// Note - The Kafka AlterConfigs API is NOT incremental, and any topic-level overrides not included
+//        in the altered ConfigEntries are reverted to the broker defaults.  The specified ConfigEntries
+//        are therefore merged into the Topic's existing topic-level overrides (e.g. min.insync.replicas
+//        set by an operator) so that only the specified entries are changed.
+//
+func (k KafkaAdminClient) AlterTopicConfig(_ context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To Alter Topic Config Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return adminutil.NewUnknownTopicError("unable to alter topic config due to invalid ClusterAdmin - check Kafka authorization secrets")
+	} else {
+		currentConfigEntries, err := k.clusterAdmin.DescribeConfig(sarama.ConfigResource{Type: sarama.TopicResource, Name: topicName})
+		if err != nil {
+			return adminutil.PromoteErrorToTopicError(err)
+		}
+		mergedConfigEntries := make(map[string]*string, len(currentConfigEntries)+len(configEntries))
+		for _, configEntry := range currentConfigEntries {
+			if isTopicConfigOverride(configEntry) {
+				value := configEntry.Value
+				mergedConfigEntries[configEntry.Name] = &value
+			}
+		}
+		for name, value := range configEntries {
+			mergedConfigEntries[name] = value
+		}
+		err = k.clusterAdmin.AlterConfig(sarama.TopicResource, topicName, mergedConfigEntries, false)
+		return adminutil.PromoteErrorToTopicError(err)
+	}
+}
+
+//
+// Determine Whether The Specified (Described) ConfigEntry Is A Topic-Level Override
+//
+// DescribeConfigs v1+ reports the source of each entry, whereas v0 only reports whether it is a default.
+// Sensitive entries are never returned with their value, and so cannot be preserved when altering.
+//
+func isTopicConfigOverride(configEntry sarama.ConfigEntry) bool {
+	if configEntry.Sensitive || configEntry.ReadOnly {
+		return false
+	}
+	if configEntry.Source == sarama.SourceUnknown {
+		return !configEntry.Default
+	}
+	return configEntry.Source == sarama.SourceTopic
+}
+
+// Sarama Pass-Through Function For Describing The Kafka Cluster (Returns The Live Brokers)
+func (k KafkaAdminClient) DescribeCluster(_ context.Context) ([]*sarama.Broker, error) {
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To Describe Cluster Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return nil, fmt.Errorf("unable to describe cluster due to invalid ClusterAdmin - check Kafka authorization secrets")
+	} else {
+		brokers, _, err := k.clusterAdmin.DescribeCluster()
+		return brokers, err
+	}
+}
+
+// Sarama Pass-Through Function For Describing The Specified ConsumerGroups (State & Members)
+func (k KafkaAdminClient) DescribeConsumerGroups(_ context.Context, groupIds []string) ([]*sarama.GroupDescription, error) {
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To Describe ConsumerGroups Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return nil, fmt.Errorf("unable to describe consumer groups due to invalid ClusterAdmin - check Kafka authorization secrets")
+	} else {
+		return k.clusterAdmin.DescribeConsumerGroups(groupIds)
+	}
+}
+
+// Sarama Pass-Through Function For Listing The Committed Offsets Of A ConsumerGroup For The Specified Topic Partitions
+func (k KafkaAdminClient) ListConsumerGroupOffsets(_ context.Context, groupId string, topicPartitions map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To List ConsumerGroup Offsets Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return nil, fmt.Errorf("unable to list consumer group offsets due to invalid ClusterAdmin - check Kafka authorization secrets")
+	} else {
+		return k.clusterAdmin.ListConsumerGroupOffsets(groupId, topicPartitions)
+	}
+}
+
+// Sarama Pass-Through Function For Listing The Topics Managed By The Controller (Those Matching The Topic Name Template)
+func (k KafkaAdminClient) ListManagedTopics(_ context.Context) (map[string]sarama.TopicDetail, error) {
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To List Topics Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return nil, fmt.Errorf("unable to list topics due to invalid ClusterAdmin - check Kafka authorization secrets")
+	} else {
+		topicDetails, err := k.clusterAdmin.ListTopics()
+		if err != nil {
+			return nil, err
+		}
+		managedTopicDetails := make(map[string]sarama.TopicDetail)
+		for topicName, topicDetail := range topicDetails {
+			if commonkafkautil.IsManagedTopicName(topicName) {
+				managedTopicDetails[topicName] = topicDetail
+			}
+		}
+		return managedTopicDetails, nil
+	}
+}
+
+//
+// Sarama Pass-Through Function For Creating An ACL On A Topic
+//
+// The Sarama ClusterAdmin ignores the errors in the ACL creation response, which would otherwise hide the
+// SECURITY_DISABLED error returned by Kafka clusters without an authorizer.  The ACL is therefore listed
+// after creation to verify it actually exists.
+//
+func (k KafkaAdminClient) CreateTopicACL(_ context.Context, topicName string, acl sarama.Acl) *sarama.TopicError {
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To Create Topic ACL Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return adminutil.NewUnknownTopicError("unable to create topic ACL due to invalid ClusterAdmin - check Kafka authorization secrets")
+	} else {
+		resource := sarama.Resource{ResourceType: sarama.AclResourceTopic, ResourceName: topicName, ResourcePatternType: sarama.AclPatternLiteral}
+		err := k.clusterAdmin.CreateACL(resource, acl)
+		if err != nil {
+			return adminutil.PromoteErrorToTopicError(err)
+		}
+		resourceAcls, err := k.clusterAdmin.ListAcls(newTopicAclFilter(topicName, acl))
+		if err != nil {
+			return adminutil.PromoteErrorToTopicError(err)
+		} else if len(resourceAcls) == 0 {
+			k.logger.Error("Topic ACL Not Found After Creation - Kafka Cluster Likely Has No Authorizer", zap.String("Topic", topicName), zap.String("Principal", acl.Principal))
+			return adminutil.NewTopicError(sarama.ErrSecurityDisabled, fmt.Sprintf("unable to verify ACL for principal '%s' on topic '%s' - ensure the Kafka cluster has an authorizer configured", acl.Principal, topicName))
+		}
+		return nil
+	}
+}
+
+// Sarama Pass-Through Function For Deleting An ACL From A Topic
+func (k KafkaAdminClient) DeleteTopicACL(_ context.Context, topicName string, acl sarama.Acl) *sarama.TopicError {
+	if k.clusterAdmin == nil {
+		k.logger.Error("Unable To Delete Topic ACL Due To Invalid ClusterAdmin - Check Kafka Authorization Secret")
+		return adminutil.NewUnknownTopicError("unable to delete topic ACL due to invalid ClusterAdmin - check Kafka authorization secrets")
+	} else {
+		_, err := k.clusterAdmin.DeleteACL(newTopicAclFilter(topicName, acl), false)
+		return adminutil.PromoteErrorToTopicError(err)
+	}
+}
+
+// Create A Sarama AclFilter Matching Exactly The Specified ACL On The Specified Topic
+func newTopicAclFilter(topicName string, acl sarama.Acl) sarama.AclFilter {
+	return sarama.AclFilter{
+		ResourceType:              sarama.AclResourceTopic,
+		ResourceName:              &topicName,
+		ResourcePatternTypeFilter: sarama.AclPatternLiteral,
+		Principal:                 &acl.Principal,
+		Host:                      &acl.Host,
+		Operation:                 acl.Operation,
+		PermissionType:            acl.PermissionType,
+	}
+}
+
 // Sarama Pass-Through Function For Closing ClusterAdmin
 func (k KafkaAdminClient) Close() error {
 	if k.clusterAdmin == nil {
@@ -151,3 +461,11 @@ func (k KafkaAdminClient) Close() error {
 func (k KafkaAdminClient) GetKafkaSecretName(_ string) string {
 	return k.kafkaSecret
 }
+
+// Utility Function For Merging The Specified Per-Topic Results Into The Target (Returning The Target)
+func mergeTopicErrors(target map[string]*sarama.TopicError, source map[string]*sarama.TopicError) map[string]*sarama.TopicError {
+	for topicName, topicError := range source {
+		target[topicName] = topicError
+	}
+	return target
+}
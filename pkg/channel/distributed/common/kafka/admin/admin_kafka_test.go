@@ -99,6 +99,190 @@ Metadata:
 	assert.NotNil(t, adminClient)
 }
 
+// Test The NewKafkaAdminClient() Constructor - Kafka Secret SASL Mechanism Path
+func TestNewKafkaAdminClientSaslMechanism(t *testing.T) {
+
+	// Test Data
+	clientId := "TestClientId"
+	namespace := "TestNamespace"
+
+	// Setup Environment
+	assert.Nil(t, os.Setenv(system.NamespaceEnvKey, commonconstants.KnativeEventingNamespace))
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name          string
+		mechanism     string
+		wantMechanism sarama.SASLMechanism
+		wantErr       bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "SCRAM-SHA-256", mechanism: sarama.SASLTypeSCRAMSHA256, wantMechanism: sarama.SASLTypeSCRAMSHA256},
+		{name: "SCRAM-SHA-512", mechanism: sarama.SASLTypeSCRAMSHA512, wantMechanism: sarama.SASLTypeSCRAMSHA512},
+		{name: "Unknown", mechanism: "UNKNOWN", wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create Test Kafka Secret With The SASL Mechanism
+			kafkaSecret := createKafkaSecret("TestKafkaSecretName", namespace, "TestKafkaSecretBrokers", "TestKafkaSecretUsername", "TestKafkaSecretPassword")
+			kafkaSecret.Data[constants.KafkaSecretKeySaslMechanism] = []byte(testCase.mechanism)
+
+			// Create A Context With Test Logger & K8S Client
+			ctx := logging.WithLogger(context.TODO(), logtesting.TestLogger(t))
+			ctx = context.WithValue(ctx, injectionclient.Key{}, fake.NewSimpleClientset(kafkaSecret))
+
+			// Mock The Sarama ClusterAdmin Creation For Testing
+			newClusterAdminWrapperPlaceholder := NewClusterAdminWrapper
+			NewClusterAdminWrapper = func(brokers []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+				assert.Equal(t, testCase.wantMechanism, config.Net.SASL.Mechanism)
+				assert.NotNil(t, config.Net.SASL.SCRAMClientGeneratorFunc)
+				return &MockClusterAdmin{}, nil
+			}
+			defer func() {
+				NewClusterAdminWrapper = newClusterAdminWrapperPlaceholder
+			}()
+
+			// Perform The Test
+			adminClient, err := NewKafkaAdminClient(ctx, commontesting.GetDefaultSaramaConfig(t), clientId, namespace)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			assert.Equal(t, testCase.wantErr, adminClient == nil)
+		})
+	}
+}
+
+// Test The NewKafkaAdminClient() Constructor - Kafka Secret mTLS Client Certificate Path
+func TestNewKafkaAdminClientTLSCertificate(t *testing.T) {
+
+	// Test Data
+	clientId := "TestClientId"
+	namespace := "TestNamespace"
+	certPem, keyPem := commontesting.GenerateSelfSignedCertificate(t)
+
+	// Setup Environment
+	assert.Nil(t, os.Setenv(system.NamespaceEnvKey, commonconstants.KnativeEventingNamespace))
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name         string
+		userCert     string
+		userKey      string
+		wantCertsLen int
+		wantErr      bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Valid Self-Signed Pair", userCert: certPem, userKey: keyPem, wantCertsLen: 1},
+		{name: "Certificate Without Key", userCert: certPem, wantErr: true},
+		{name: "Key Without Certificate", userKey: keyPem, wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create Test Kafka Secret With The mTLS Client Certificate / Key
+			kafkaSecret := createKafkaSecret("TestKafkaSecretName", namespace, "TestKafkaSecretBrokers", "", "")
+			kafkaSecret.Data[constants.KafkaSecretKeyUserCert] = []byte(testCase.userCert)
+			kafkaSecret.Data[constants.KafkaSecretKeyUserKey] = []byte(testCase.userKey)
+
+			// Create A Context With Test Logger & K8S Client
+			ctx := logging.WithLogger(context.TODO(), logtesting.TestLogger(t))
+			ctx = context.WithValue(ctx, injectionclient.Key{}, fake.NewSimpleClientset(kafkaSecret))
+
+			// Mock The Sarama ClusterAdmin Creation For Testing
+			newClusterAdminWrapperPlaceholder := NewClusterAdminWrapper
+			NewClusterAdminWrapper = func(brokers []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+				assert.NotNil(t, config.Net.TLS.Config)
+				assert.Len(t, config.Net.TLS.Config.Certificates, testCase.wantCertsLen)
+				return &MockClusterAdmin{}, nil
+			}
+			defer func() {
+				NewClusterAdminWrapper = newClusterAdminWrapperPlaceholder
+			}()
+
+			// Perform The Test
+			adminClient, err := NewKafkaAdminClient(ctx, commontesting.GetDefaultSaramaConfig(t), clientId, namespace)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			assert.Equal(t, testCase.wantErr, adminClient == nil)
+		})
+	}
+}
+
+// Test The NewKafkaAdminClient() Constructor - Kafka Secret TLS Enable Override Path
+func TestNewKafkaAdminClientTLSEnable(t *testing.T) {
+
+	// Test Data
+	clientId := "TestClientId"
+	namespace := "TestNamespace"
+
+	// Setup Environment
+	assert.Nil(t, os.Setenv(system.NamespaceEnvKey, commonconstants.KnativeEventingNamespace))
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name          string
+		configEnable  bool
+		tlsEnable     string
+		wantTLSEnable bool
+		wantErr       bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Override (Disabled)", configEnable: false, wantTLSEnable: false},
+		{name: "No Override (Enabled)", configEnable: true, wantTLSEnable: true},
+		{name: "Override Enable", configEnable: false, tlsEnable: "true", wantTLSEnable: true},
+		{name: "Override Disable", configEnable: true, tlsEnable: "false", wantTLSEnable: false},
+		{name: "Invalid Override", configEnable: true, tlsEnable: "maybe", wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create Test Kafka Secret With The TLS Enable Override
+			kafkaSecret := createKafkaSecret("TestKafkaSecretName", namespace, "TestKafkaSecretBrokers", "", "")
+			kafkaSecret.Data[constants.KafkaSecretKeyTLSEnable] = []byte(testCase.tlsEnable)
+
+			// Create A Context With Test Logger & K8S Client
+			ctx := logging.WithLogger(context.TODO(), logtesting.TestLogger(t))
+			ctx = context.WithValue(ctx, injectionclient.Key{}, fake.NewSimpleClientset(kafkaSecret))
+
+			// Mock The Sarama ClusterAdmin Creation For Testing
+			newClusterAdminWrapperPlaceholder := NewClusterAdminWrapper
+			NewClusterAdminWrapper = func(brokers []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+				assert.Equal(t, testCase.wantTLSEnable, config.Net.TLS.Enable)
+				return &MockClusterAdmin{}, nil
+			}
+			defer func() {
+				NewClusterAdminWrapper = newClusterAdminWrapperPlaceholder
+			}()
+
+			// Create The Shared Sarama Config
+			saramaConfig := commontesting.GetDefaultSaramaConfig(t)
+			saramaConfig.Net.TLS.Enable = testCase.configEnable
+
+			// Perform The Test
+			adminClient, err := NewKafkaAdminClient(ctx, saramaConfig, clientId, namespace)
+
+			// Verify The Results (Including That The Shared Sarama Config Was Not Modified)
+			assert.Equal(t, testCase.wantErr, err != nil)
+			assert.Equal(t, testCase.wantErr, adminClient == nil)
+			assert.Equal(t, testCase.configEnable, saramaConfig.Net.TLS.Enable)
+		})
+	}
+}
+
 // Test The NewKafkaAdminClient() Constructor - No Kafka Secrets Path
 func TestNewKafkaAdminClientNoSecrets(t *testing.T) {
 
@@ -118,6 +302,41 @@ func TestNewKafkaAdminClientNoSecrets(t *testing.T) {
 	assert.NotNil(t, adminClient)
 }
 
+// Test The NewKafkaAdminClient() Constructor - Explicitly Selected Kafka Secret Path
+func TestNewKafkaAdminClientSelectedSecret(t *testing.T) {
+
+	// Test Data
+	clientId := "TestClientId"
+	namespace := "TestNamespace"
+	kafkaSecret1 := createKafkaSecret("TestKafkaSecretName1", namespace, "TestKafkaSecretBrokers1", "TestKafkaSecretUsername", "TestKafkaSecretPassword")
+	kafkaSecret2 := createKafkaSecret("TestKafkaSecretName2", namespace, "TestKafkaSecretBrokers2", "TestKafkaSecretUsername", "TestKafkaSecretPassword")
+
+	// Create A Context With Test Logger & K8S Client (Multiple Kafka Secrets)
+	ctx := logging.WithLogger(context.TODO(), logtesting.TestLogger(t))
+	ctx = context.WithValue(ctx, injectionclient.Key{}, fake.NewSimpleClientset(kafkaSecret1, kafkaSecret2))
+
+	// Mock The Sarama ClusterAdmin Creation For Testing (Verifying The Selected Secret's Brokers)
+	newClusterAdminWrapperPlaceholder := NewClusterAdminWrapper
+	NewClusterAdminWrapper = func(brokers []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+		assert.Equal(t, []string{"TestKafkaSecretBrokers2"}, brokers)
+		return &MockClusterAdmin{}, nil
+	}
+	defer func() {
+		NewClusterAdminWrapper = newClusterAdminWrapperPlaceholder
+	}()
+
+	// Perform The Test With The Selected Secret
+	adminClient, err := NewKafkaAdminClient(WithKafkaSecretName(ctx, kafkaSecret2.Name), commontesting.GetDefaultSaramaConfig(t), clientId, namespace)
+	assert.Nil(t, err)
+	assert.NotNil(t, adminClient)
+	assert.Equal(t, kafkaSecret2.Name, adminClient.GetKafkaSecretName("TestTopicName"))
+
+	// Perform The Test With A Selected Secret Which Does Not Exist
+	adminClient, err = NewKafkaAdminClient(WithKafkaSecretName(ctx, "TestKafkaSecretName3"), commontesting.GetDefaultSaramaConfig(t), clientId, namespace)
+	assert.NotNil(t, err)
+	assert.Nil(t, adminClient)
+}
+
 // Test The Kafka AdminClient CreateTopic() Functionality
 func TestKafkaAdminClientCreateTopic(t *testing.T) {
 
@@ -165,6 +384,375 @@ func TestKafkaAdminClientCreateTopic(t *testing.T) {
 	mockClusterAdmin.AssertExpectations(t)
 }
 
+// Test The Kafka AdminClient DescribeTopic() Functionality
+func TestKafkaAdminClientDescribeTopic(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName := "TestTopicName"
+	topicMetadata := &sarama.TopicMetadata{
+		Err:        sarama.ErrNoError,
+		Name:       topicName,
+		Partitions: []*sarama.PartitionMetadata{{ID: 0, Replicas: []int32{1, 2}}},
+	}
+	unknownTopicMetadata := &sarama.TopicMetadata{Err: sarama.ErrUnknownTopicOrPartition, Name: topicName}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name         string
+		metadata     []*sarama.TopicMetadata
+		wantMetadata *sarama.TopicMetadata
+		wantErr      sarama.KError
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Success", metadata: []*sarama.TopicMetadata{topicMetadata}, wantMetadata: topicMetadata, wantErr: sarama.ErrNoError},
+		{name: "Unknown Topic", metadata: []*sarama.TopicMetadata{unknownTopicMetadata}, wantErr: sarama.ErrUnknownTopicOrPartition},
+		{name: "Empty Metadata", metadata: []*sarama.TopicMetadata{}, wantErr: sarama.ErrUnknown},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockClusterAdmin := &MockClusterAdmin{}
+			mockClusterAdmin.On("DescribeTopics", []string{topicName}).Return(testCase.metadata, nil)
+			adminClient := &KafkaAdminClient{logger: logtesting.TestLogger(t).Desugar(), clusterAdmin: mockClusterAdmin}
+			resultMetadata, resultTopicError := adminClient.DescribeTopic(ctx, topicName)
+			assert.Equal(t, testCase.wantMetadata, resultMetadata)
+			if testCase.wantErr == sarama.ErrNoError {
+				assert.Nil(t, resultTopicError)
+			} else {
+				assert.NotNil(t, resultTopicError)
+				assert.Equal(t, testCase.wantErr, resultTopicError.Err)
+			}
+			mockClusterAdmin.AssertExpectations(t)
+		})
+	}
+
+	// Verify The Invalid ClusterAdmin Case
+	adminClient := &KafkaAdminClient{logger: logtesting.TestLogger(t).Desugar()}
+	resultMetadata, resultTopicError := adminClient.DescribeTopic(ctx, topicName)
+	assert.Nil(t, resultMetadata)
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrUnknown, resultTopicError.Err)
+}
+
+// Test The Kafka AdminClient CreatePartitions() Functionality
+func TestKafkaAdminClientCreatePartitions(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName := "TestTopicName"
+	count := int32(8)
+
+	// Create A Mock Sarama ClusterAdmin To Test Against
+	mockClusterAdmin := &MockClusterAdmin{}
+	mockClusterAdmin.On("CreatePartitions", topicName, count).Return(nil)
+
+	// Create A New Kafka AdminClient To Test
+	adminClient := &KafkaAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		clusterAdmin: mockClusterAdmin,
+	}
+
+	// Perform The Test
+	resultTopicError := adminClient.CreatePartitions(ctx, topicName, count)
+
+	// Verify The Results
+	assert.Nil(t, resultTopicError)
+	mockClusterAdmin.AssertExpectations(t)
+
+	// Verify The Invalid ClusterAdmin Case
+	adminClient.clusterAdmin = nil
+	resultTopicError = adminClient.CreatePartitions(ctx, topicName, count)
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrUnknown, resultTopicError.Err)
+}
+
+// Test The Kafka AdminClient DescribeTopicConfig() Functionality
+func TestKafkaAdminClientDescribeTopicConfig(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName := "TestTopicName"
+	configResource := sarama.ConfigResource{Type: sarama.TopicResource, Name: topicName}
+	configEntries := []sarama.ConfigEntry{
+		{Name: constants.TopicDetailConfigRetentionMs, Value: "604800000"},
+		{Name: constants.TopicDetailConfigCleanupPolicy, Value: "delete"},
+	}
+
+	// Create A Mock Sarama ClusterAdmin To Test Against
+	mockClusterAdmin := &MockClusterAdmin{}
+	mockClusterAdmin.On("DescribeConfig", configResource).Return(configEntries, nil)
+
+	// Create A New Kafka AdminClient To Test
+	adminClient := &KafkaAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		clusterAdmin: mockClusterAdmin,
+	}
+
+	// Perform The Test
+	topicConfig, resultTopicError := adminClient.DescribeTopicConfig(ctx, topicName)
+
+	// Verify The Results
+	assert.Nil(t, resultTopicError)
+	assert.Equal(t, map[string]string{constants.TopicDetailConfigRetentionMs: "604800000", constants.TopicDetailConfigCleanupPolicy: "delete"}, topicConfig)
+	mockClusterAdmin.AssertExpectations(t)
+
+	// Verify The Invalid ClusterAdmin Case
+	adminClient.clusterAdmin = nil
+	topicConfig, resultTopicError = adminClient.DescribeTopicConfig(ctx, topicName)
+	assert.Nil(t, topicConfig)
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrUnknown, resultTopicError.Err)
+}
+
+// Test The Kafka AdminClient DescribeCluster() Functionality
+func TestKafkaAdminClientDescribeCluster(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	brokers := []*sarama.Broker{sarama.NewBroker("broker1:9092"), sarama.NewBroker("broker2:9092")}
+
+	// Create A Mock Sarama ClusterAdmin To Test Against
+	mockClusterAdmin := &MockClusterAdmin{}
+	mockClusterAdmin.On("DescribeCluster").Return(brokers, 1, nil)
+
+	// Create A New Kafka AdminClient To Test
+	adminClient := &KafkaAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		clusterAdmin: mockClusterAdmin,
+	}
+
+	// Perform The Test
+	resultBrokers, err := adminClient.DescribeCluster(ctx)
+
+	// Verify The Results
+	assert.Nil(t, err)
+	assert.Equal(t, brokers, resultBrokers)
+	mockClusterAdmin.AssertExpectations(t)
+
+	// Verify The Invalid ClusterAdmin Case
+	adminClient.clusterAdmin = nil
+	resultBrokers, err = adminClient.DescribeCluster(ctx)
+	assert.Nil(t, resultBrokers)
+	assert.NotNil(t, err)
+}
+
+// Test The Kafka AdminClient ListManagedTopics() Functionality
+func TestKafkaAdminClientListManagedTopics(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	managedTopicDetail := sarama.TopicDetail{NumPartitions: 4, ReplicationFactor: 3}
+	topicDetails := map[string]sarama.TopicDetail{
+		"test-namespace.test-name": managedTopicDetail,
+		"__consumer_offsets":       {NumPartitions: 50, ReplicationFactor: 3},
+		"UnmanagedTopic":           {NumPartitions: 1, ReplicationFactor: 1},
+	}
+
+	// Create A Mock Sarama ClusterAdmin To Test Against
+	mockClusterAdmin := &MockClusterAdmin{}
+	mockClusterAdmin.On("ListTopics").Return(topicDetails, nil)
+
+	// Create A New Kafka AdminClient To Test
+	adminClient := &KafkaAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		clusterAdmin: mockClusterAdmin,
+	}
+
+	// Perform The Test
+	managedTopicDetails, err := adminClient.ListManagedTopics(ctx)
+
+	// Verify Only The Managed Topics Are Returned
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]sarama.TopicDetail{"test-namespace.test-name": managedTopicDetail}, managedTopicDetails)
+	mockClusterAdmin.AssertExpectations(t)
+
+	// Verify The Invalid ClusterAdmin Case
+	adminClient.clusterAdmin = nil
+	managedTopicDetails, err = adminClient.ListManagedTopics(ctx)
+	assert.Nil(t, managedTopicDetails)
+	assert.NotNil(t, err)
+}
+
+// Test The Kafka AdminClient DescribeConsumerGroups() & ListConsumerGroupOffsets() Functionality
+func TestKafkaAdminClientConsumerGroups(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	groupIds := []string{"TestGroupId1", "TestGroupId2"}
+	topicPartitions := map[string][]int32{"TestTopicName": {0, 1}}
+	groupDescriptions := []*sarama.GroupDescription{
+		{GroupId: "TestGroupId1", State: "Stable"},
+		{GroupId: "TestGroupId2", State: "Empty"},
+	}
+	offsetFetchResponse := &sarama.OffsetFetchResponse{}
+	offsetFetchResponse.AddBlock("TestTopicName", 0, &sarama.OffsetFetchResponseBlock{Offset: 10})
+
+	// Create A Mock Sarama ClusterAdmin To Test Against
+	mockClusterAdmin := &MockClusterAdmin{}
+	mockClusterAdmin.On("DescribeConsumerGroups", groupIds).Return(groupDescriptions, nil)
+	mockClusterAdmin.On("ListConsumerGroupOffsets", "TestGroupId1", topicPartitions).Return(offsetFetchResponse, nil)
+
+	// Create A New Kafka AdminClient To Test
+	adminClient := &KafkaAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		clusterAdmin: mockClusterAdmin,
+	}
+
+	// Perform The Tests & Verify The Sarama Results Are Passed Through
+	resultGroupDescriptions, err := adminClient.DescribeConsumerGroups(ctx, groupIds)
+	assert.Nil(t, err)
+	assert.Equal(t, groupDescriptions, resultGroupDescriptions)
+	resultOffsetFetchResponse, err := adminClient.ListConsumerGroupOffsets(ctx, "TestGroupId1", topicPartitions)
+	assert.Nil(t, err)
+	assert.Equal(t, offsetFetchResponse, resultOffsetFetchResponse)
+	mockClusterAdmin.AssertExpectations(t)
+
+	// Verify The Invalid ClusterAdmin Case
+	adminClient.clusterAdmin = nil
+	resultGroupDescriptions, err = adminClient.DescribeConsumerGroups(ctx, groupIds)
+	assert.Nil(t, resultGroupDescriptions)
+	assert.NotNil(t, err)
+	resultOffsetFetchResponse, err = adminClient.ListConsumerGroupOffsets(ctx, "TestGroupId1", topicPartitions)
+	assert.Nil(t, resultOffsetFetchResponse)
+	assert.NotNil(t, err)
+}
+
+// Test The Kafka AdminClient CreateTopicACL() & DeleteTopicACL() Functionality
+func TestKafkaAdminClientTopicACLs(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName := "TestTopicName"
+	acl := sarama.Acl{Principal: "User:dispatcher", Host: "*", Operation: sarama.AclOperationRead, PermissionType: sarama.AclPermissionAllow}
+	resource := sarama.Resource{ResourceType: sarama.AclResourceTopic, ResourceName: topicName, ResourcePatternType: sarama.AclPatternLiteral}
+	filter := newTopicAclFilter(topicName, acl)
+	resourceAcls := []sarama.ResourceAcls{{Resource: resource, Acls: []*sarama.Acl{&acl}}}
+
+	// Create A Mock Sarama ClusterAdmin To Test Against
+	mockClusterAdmin := &MockClusterAdmin{}
+	mockClusterAdmin.On("CreateACL", resource, acl).Return(nil)
+	mockClusterAdmin.On("ListAcls", filter).Return(resourceAcls, nil).Once()
+	mockClusterAdmin.On("DeleteACL", filter, false).Return([]sarama.MatchingAcl{}, nil)
+
+	// Create A New Kafka AdminClient To Test
+	adminClient := &KafkaAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		clusterAdmin: mockClusterAdmin,
+	}
+
+	// Verify The ACL Is Created & Verified
+	assert.Nil(t, adminClient.CreateTopicACL(ctx, topicName, acl))
+
+	// Verify An ACL Which Is Not Found After Creation (No Authorizer) Is Reported As SECURITY_DISABLED
+	mockClusterAdmin.On("ListAcls", filter).Return([]sarama.ResourceAcls{}, nil).Once()
+	topicError := adminClient.CreateTopicACL(ctx, topicName, acl)
+	assert.NotNil(t, topicError)
+	assert.Equal(t, sarama.ErrSecurityDisabled, topicError.Err)
+
+	// Verify The ACL Is Deleted
+	assert.Nil(t, adminClient.DeleteTopicACL(ctx, topicName, acl))
+	mockClusterAdmin.AssertExpectations(t)
+
+	// Verify The Invalid ClusterAdmin Case
+	adminClient.clusterAdmin = nil
+	topicError = adminClient.CreateTopicACL(ctx, topicName, acl)
+	assert.NotNil(t, topicError)
+	assert.Equal(t, sarama.ErrUnknown, topicError.Err)
+	topicError = adminClient.DeleteTopicACL(ctx, topicName, acl)
+	assert.NotNil(t, topicError)
+	assert.Equal(t, sarama.ErrUnknown, topicError.Err)
+}
+
+// Test The Kafka AdminClient AlterTopicConfig() Functionality
+func TestKafkaAdminClientAlterTopicConfig(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName := "TestTopicName"
+	configResource := sarama.ConfigResource{Type: sarama.TopicResource, Name: topicName}
+	cleanupPolicy := "compact"
+	configEntries := map[string]*string{constants.TopicDetailConfigCleanupPolicy: &cleanupPolicy}
+
+	// Create A Mock Sarama ClusterAdmin To Test Against
+	mockClusterAdmin := &MockClusterAdmin{}
+	mockClusterAdmin.On("DescribeConfig", configResource).Return([]sarama.ConfigEntry{}, nil)
+	mockClusterAdmin.On("AlterConfig", sarama.TopicResource, topicName, configEntries).Return(nil)
+
+	// Create A New Kafka AdminClient To Test
+	adminClient := &KafkaAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		clusterAdmin: mockClusterAdmin,
+	}
+
+	// Perform The Test
+	resultTopicError := adminClient.AlterTopicConfig(ctx, topicName, configEntries)
+
+	// Verify The Results
+	assert.Nil(t, resultTopicError)
+	mockClusterAdmin.AssertExpectations(t)
+
+	// Verify The Invalid ClusterAdmin Case
+	adminClient.clusterAdmin = nil
+	resultTopicError = adminClient.AlterTopicConfig(ctx, topicName, configEntries)
+	assert.NotNil(t, resultTopicError)
+	assert.Equal(t, sarama.ErrUnknown, resultTopicError.Err)
+}
+
+// Test The Kafka AdminClient AlterTopicConfig() Preserves Unrelated Topic-Level Overrides
+func TestKafkaAdminClientAlterTopicConfigPreservesOverrides(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName := "TestTopicName"
+	configResource := sarama.ConfigResource{Type: sarama.TopicResource, Name: topicName}
+	currentConfigEntries := []sarama.ConfigEntry{
+		{Name: constants.TopicDetailConfigRetentionMs, Value: "604800000", Source: sarama.SourceTopic},
+		{Name: "min.insync.replicas", Value: "2", Source: sarama.SourceTopic},
+		{Name: "segment.bytes", Value: "1048576", Default: false},
+		{Name: "max.message.bytes", Value: "1048588", Source: sarama.SourceDefault, Default: true},
+		{Name: "unclean.leader.election.enable", Value: "false", Source: sarama.SourceStaticBroker},
+		{Name: "message.format.version", Value: "2.7-IV2", Default: true},
+		{Name: "sasl.jaas.config", Value: "", Source: sarama.SourceTopic, Sensitive: true},
+	}
+	retentionMillis := "86400000"
+	configEntries := map[string]*string{constants.TopicDetailConfigRetentionMs: &retentionMillis}
+	minInsyncReplicas := "2"
+	segmentBytes := "1048576"
+	expectedConfigEntries := map[string]*string{
+		constants.TopicDetailConfigRetentionMs: &retentionMillis,
+		"min.insync.replicas":                  &minInsyncReplicas,
+		"segment.bytes":                        &segmentBytes,
+	}
+
+	// Create A Mock Sarama ClusterAdmin To Test Against
+	mockClusterAdmin := &MockClusterAdmin{}
+	mockClusterAdmin.On("DescribeConfig", configResource).Return(currentConfigEntries, nil)
+	mockClusterAdmin.On("AlterConfig", sarama.TopicResource, topicName, expectedConfigEntries).Return(nil)
+
+	// Create A New Kafka AdminClient To Test
+	adminClient := &KafkaAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		clusterAdmin: mockClusterAdmin,
+	}
+
+	// Perform The Test & Verify Only The Desired Entry Changed (Unrelated Overrides Preserved, Defaults Not Pinned)
+	resultTopicError := adminClient.AlterTopicConfig(ctx, topicName, configEntries)
+	assert.Nil(t, resultTopicError)
+	mockClusterAdmin.AssertExpectations(t)
+
+	// Verify A Failed Describe Is Returned Without Altering The Topic
+	mockClusterAdmin = &MockClusterAdmin{}
+	mockClusterAdmin.On("DescribeConfig", configResource).Return([]sarama.ConfigEntry{}, sarama.ErrUnknownTopicOrPartition)
+	adminClient.clusterAdmin = mockClusterAdmin
+	resultTopicError = adminClient.AlterTopicConfig(ctx, topicName, configEntries)
+	assert.NotNil(t, resultTopicError)
+	mockClusterAdmin.AssertNotCalled(t, "AlterConfig", sarama.TopicResource, topicName, configEntries)
+}
+
 // Test The Kafka AdminClient CreateTopic() Without ClusterAdmin Functionality
 func TestKafkaAdminClientCreateTopicInvalidAdminClient(t *testing.T) {
 
@@ -200,6 +788,108 @@ func TestKafkaAdminClientCreateTopicInvalidAdminClient(t *testing.T) {
 	assert.Equal(t, errMsg, *resultTopicError.ErrMsg)
 }
 
+// Test The Kafka AdminClient CreateTopics() Functionality - Single Bulk Request To The Controller Broker
+func TestKafkaAdminClientCreateTopics(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName1 := "TestTopicName1"
+	topicName2 := "TestTopicName2"
+	unauthorizedTopicName := "_TestUnauthorizedTopicName" // MockCreateTopicsResponse Fails "_" Prefixed Topics
+	topicDetail := &sarama.TopicDetail{NumPartitions: 4, ReplicationFactor: 1}
+
+	// Create A Mock Kafka Broker Which Is Also The Controller
+	mockBroker := sarama.NewMockBroker(t, 1)
+	defer mockBroker.Close()
+	mockBroker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetController(mockBroker.BrokerID()).
+			SetBroker(mockBroker.Addr(), mockBroker.BrokerID()),
+		"CreateTopicsRequest": sarama.NewMockCreateTopicsResponse(t),
+	})
+
+	// Create A Real Sarama ClusterAdmin Against The Mock Broker
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Version = sarama.V1_0_0_0
+	clusterAdmin, err := sarama.NewClusterAdmin([]string{mockBroker.Addr()}, saramaConfig)
+	assert.Nil(t, err)
+	defer func() { _ = clusterAdmin.Close() }()
+
+	// Create A New Kafka AdminClient To Test
+	adminClient := &KafkaAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		saramaConfig: saramaConfig,
+		clusterAdmin: clusterAdmin,
+	}
+
+	// Perform The Test
+	topicErrors := adminClient.CreateTopics(ctx, map[string]*sarama.TopicDetail{
+		topicName1:            topicDetail,
+		topicName2:            topicDetail,
+		unauthorizedTopicName: topicDetail,
+		"":                    topicDetail,
+	})
+
+	// Verify The Results (Including The Partial Failures)
+	assert.Len(t, topicErrors, 4)
+	assert.Equal(t, sarama.ErrNoError, topicErrors[topicName1].Err)
+	assert.Equal(t, sarama.ErrNoError, topicErrors[topicName2].Err)
+	assert.Equal(t, sarama.ErrTopicAuthorizationFailed, topicErrors[unauthorizedTopicName].Err)
+	assert.Equal(t, sarama.ErrInvalidRequest, topicErrors[""].Err)
+	createTopicsRequestCount := 0
+	for _, requestResponse := range mockBroker.History() {
+		if _, ok := requestResponse.Request.(*sarama.CreateTopicsRequest); ok {
+			createTopicsRequestCount++
+		}
+	}
+	assert.Equal(t, 1, createTopicsRequestCount)
+}
+
+// Test The Kafka AdminClient CreateTopics() Functionality - Fallback To Individual Topic Creation
+func TestKafkaAdminClientCreateTopicsIndividually(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	topicName1 := "TestTopicName1"
+	topicName2 := "TestTopicName2"
+	topicDetail := &sarama.TopicDetail{NumPartitions: 4}
+
+	// Create A Mock Sarama ClusterAdmin (Which Does Not Expose The Controller Broker) To Test Against
+	mockClusterAdmin := &MockClusterAdmin{}
+	mockClusterAdmin.On("CreateTopic", topicName1, topicDetail).Return(&sarama.TopicError{Err: sarama.ErrNoError})
+	mockClusterAdmin.On("CreateTopic", topicName2, topicDetail).Return(&sarama.TopicError{Err: sarama.ErrTopicAlreadyExists})
+
+	// Create A New Kafka AdminClient To Test
+	adminClient := &KafkaAdminClient{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		saramaConfig: sarama.NewConfig(),
+		clusterAdmin: mockClusterAdmin,
+	}
+
+	// Perform The Test
+	topicErrors := adminClient.CreateTopics(ctx, map[string]*sarama.TopicDetail{topicName1: topicDetail, topicName2: topicDetail})
+
+	// Verify The Results
+	assert.Len(t, topicErrors, 2)
+	assert.Equal(t, sarama.ErrNoError, topicErrors[topicName1].Err)
+	assert.Equal(t, sarama.ErrTopicAlreadyExists, topicErrors[topicName2].Err)
+	mockClusterAdmin.AssertExpectations(t)
+}
+
+// Test The Kafka AdminClient CreateTopics() Functionality With An Invalid ClusterAdmin
+func TestKafkaAdminClientCreateTopicsInvalidAdminClient(t *testing.T) {
+
+	// Create A New Kafka AdminClient To Test (No ClusterAdmin)
+	adminClient := &KafkaAdminClient{logger: logtesting.TestLogger(t).Desugar()}
+
+	// Perform The Test
+	topicErrors := adminClient.CreateTopics(context.TODO(), map[string]*sarama.TopicDetail{"TestTopicName": {NumPartitions: 1}})
+
+	// Verify The Results
+	assert.Len(t, topicErrors, 1)
+	assert.Equal(t, sarama.ErrUnknown, topicErrors["TestTopicName"].Err)
+}
+
 // Test The Kafka AdminClient DeleteTopic() Functionality
 func TestKafkaAdminClientDeleteTopic(t *testing.T) {
 
@@ -376,11 +1066,13 @@ func (m *MockClusterAdmin) CreateTopic(topic string, detail *sarama.TopicDetail,
 }
 
 func (m *MockClusterAdmin) ListTopics() (map[string]sarama.TopicDetail, error) {
-	panic("implement me")
+	args := m.Called()
+	return args.Get(0).(map[string]sarama.TopicDetail), args.Error(1)
 }
 
 func (m *MockClusterAdmin) DescribeTopics(topics []string) (metadata []*sarama.TopicMetadata, err error) {
-	panic("implement me")
+	args := m.Called(topics)
+	return args.Get(0).([]*sarama.TopicMetadata), args.Error(1)
 }
 
 func (m *MockClusterAdmin) DeleteTopic(topic string) error {
@@ -389,7 +1081,8 @@ func (m *MockClusterAdmin) DeleteTopic(topic string) error {
 }
 
 func (m *MockClusterAdmin) CreatePartitions(topic string, count int32, assignment [][]int32, validateOnly bool) error {
-	panic("implement me")
+	args := m.Called(topic, count)
+	return args.Error(0)
 }
 
 func (m *MockClusterAdmin) AlterPartitionReassignments(topic string, assignment [][]int32) error {
@@ -405,23 +1098,28 @@ func (m *MockClusterAdmin) DeleteRecords(topic string, partitionOffsets map[int3
 }
 
 func (m *MockClusterAdmin) DescribeConfig(resource sarama.ConfigResource) ([]sarama.ConfigEntry, error) {
-	panic("implement me")
+	args := m.Called(resource)
+	return args.Get(0).([]sarama.ConfigEntry), args.Error(1)
 }
 
 func (m *MockClusterAdmin) AlterConfig(resourceType sarama.ConfigResourceType, name string, entries map[string]*string, validateOnly bool) error {
-	panic("implement me")
+	args := m.Called(resourceType, name, entries)
+	return args.Error(0)
 }
 
 func (m *MockClusterAdmin) CreateACL(resource sarama.Resource, acl sarama.Acl) error {
-	panic("implement me")
+	args := m.Called(resource, acl)
+	return args.Error(0)
 }
 
 func (m *MockClusterAdmin) ListAcls(filter sarama.AclFilter) ([]sarama.ResourceAcls, error) {
-	panic("implement me")
+	args := m.Called(filter)
+	return args.Get(0).([]sarama.ResourceAcls), args.Error(1)
 }
 
 func (m *MockClusterAdmin) DeleteACL(filter sarama.AclFilter, validateOnly bool) ([]sarama.MatchingAcl, error) {
-	panic("implement me")
+	args := m.Called(filter, validateOnly)
+	return args.Get(0).([]sarama.MatchingAcl), args.Error(1)
 }
 
 func (m *MockClusterAdmin) ListConsumerGroups() (map[string]string, error) {
@@ -429,11 +1127,13 @@ func (m *MockClusterAdmin) ListConsumerGroups() (map[string]string, error) {
 }
 
 func (m *MockClusterAdmin) DescribeConsumerGroups(groups []string) ([]*sarama.GroupDescription, error) {
-	panic("implement me")
+	args := m.Called(groups)
+	return args.Get(0).([]*sarama.GroupDescription), args.Error(1)
 }
 
 func (m *MockClusterAdmin) ListConsumerGroupOffsets(group string, topicPartitions map[string][]int32) (*sarama.OffsetFetchResponse, error) {
-	panic("implement me")
+	args := m.Called(group, topicPartitions)
+	return args.Get(0).(*sarama.OffsetFetchResponse), args.Error(1)
 }
 
 func (m *MockClusterAdmin) DeleteConsumerGroup(group string) error {
@@ -441,7 +1141,8 @@ func (m *MockClusterAdmin) DeleteConsumerGroup(group string) error {
 }
 
 func (m *MockClusterAdmin) DescribeCluster() (brokers []*sarama.Broker, controllerID int32, err error) {
-	panic("implement me")
+	args := m.Called()
+	return args.Get(0).([]*sarama.Broker), int32(args.Int(1)), args.Error(2)
 }
 
 func (m *MockClusterAdmin) Close() error {
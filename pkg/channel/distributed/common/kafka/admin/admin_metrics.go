@@ -0,0 +1,277 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/metrics"
+)
+
+//
+// Instrumented AdminClient Implementation
+//
+// Wraps another AdminClientInterface implementation and records the latency and error count
+// of each operation (as well as the creation / "connect" of the AdminClient itself) via the
+// Knative / OpenCensus metrics stack, tagged by operation and AdminClient type.
+//
+
+const (
+
+	// Metric Labels
+	LabelOperation       = "operation"
+	LabelAdminClientType = "admin_client_type"
+
+	// AdminClient Operations
+	OperationConnect             = "connect"
+	OperationCreateTopic         = "create_topic"
+	OperationCreateTopics        = "create_topics"
+	OperationDeleteTopic         = "delete_topic"
+	OperationDescribeTopic       = "describe_topic"
+	OperationCreatePartitions    = "create_partitions"
+	OperationDescribeTopicConfig = "describe_topic_config"
+	OperationAlterTopicConfig    = "alter_topic_config"
+	OperationDescribeCluster     = "describe_cluster"
+	OperationListManagedTopics   = "list_managed_topics"
+	OperationDescribeGroups      = "describe_consumer_groups"
+	OperationListGroupOffsets    = "list_consumer_group_offsets"
+	OperationCreateTopicACL      = "create_topic_acl"
+	OperationDeleteTopicACL      = "delete_topic_acl"
+	OperationClose               = "close"
+	OperationGetKafkaSecretName  = "get_kafka_secret_name"
+)
+
+var (
+	// Distribution Of AdminClient Operation Latencies
+	adminClientLatencyMs = stats.Float64(
+		"admin_client_latency", // The METRICS_DOMAIN will be prepended to the name.
+		"AdminClient Operation Latency",
+		stats.UnitMilliseconds,
+	)
+
+	// Counter For The Number Of Failed AdminClient Operations
+	adminClientErrorCount = stats.Int64(
+		"admin_client_error_count", // The METRICS_DOMAIN will be prepended to the name.
+		"AdminClient Operation Error Count",
+		stats.UnitDimensionless,
+	)
+
+	// Tag Keys For The AdminClient Metrics
+	operationKey       = tag.MustNewKey(LabelOperation)
+	adminClientTypeKey = tag.MustNewKey(LabelAdminClientType)
+)
+
+// Register the OpenCensus View Structures
+func init() {
+
+	// Create Views To See Our Metrics
+	err := view.Register(
+		&view.View{
+			Description: adminClientLatencyMs.Description(),
+			Measure:     adminClientLatencyMs,
+			Aggregation: view.Distribution(metrics.Buckets125(1, 100000)...), // 1, 2, 5, 10, ... 100000ms
+			TagKeys:     []tag.Key{operationKey, adminClientTypeKey},
+		},
+		&view.View{
+			Description: adminClientErrorCount.Description(),
+			Measure:     adminClientErrorCount,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{operationKey, adminClientTypeKey},
+		},
+	)
+	if err != nil {
+		log.Printf("failed to register opencensus views, %v", err)
+	}
+}
+
+// Ensure The InstrumentedAdminClient Struct Implements The AdminClientInterface
+var _ AdminClientInterface = &InstrumentedAdminClient{}
+
+// Instrumented AdminClient Definition
+type InstrumentedAdminClient struct {
+	adminClient     AdminClientInterface
+	adminClientType AdminClientType
+}
+
+// Create A New InstrumentedAdminClient Wrapping The Specified AdminClient
+func NewInstrumentedAdminClient(adminClient AdminClientInterface, adminClientType AdminClientType) *InstrumentedAdminClient {
+	return &InstrumentedAdminClient{
+		adminClient:     adminClient,
+		adminClientType: adminClientType,
+	}
+}
+
+// Instrumented Pass-Through Function For Creating Topics
+func (c *InstrumentedAdminClient) CreateTopic(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+	startTime := time.Now()
+	topicError := c.adminClient.CreateTopic(ctx, topicName, topicDetail)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationCreateTopic, startTime, isTopicError(topicError))
+	return topicError
+}
+
+// Instrumented Pass-Through Function For Creating Multiple Topics (Any Per-Topic Failure Is Recorded As An Error)
+func (c *InstrumentedAdminClient) CreateTopics(ctx context.Context, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+	startTime := time.Now()
+	topicErrors := c.adminClient.CreateTopics(ctx, topicDetails)
+	failed := false
+	for _, topicError := range topicErrors {
+		failed = failed || isTopicError(topicError)
+	}
+	recordAdminClientOperation(ctx, c.adminClientType, OperationCreateTopics, startTime, failed)
+	return topicErrors
+}
+
+// Instrumented Pass-Through Function For Deleting Topics
+func (c *InstrumentedAdminClient) DeleteTopic(ctx context.Context, topicName string) *sarama.TopicError {
+	startTime := time.Now()
+	topicError := c.adminClient.DeleteTopic(ctx, topicName)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationDeleteTopic, startTime, isTopicError(topicError))
+	return topicError
+}
+
+// Instrumented Pass-Through Function For Describing Topics
+func (c *InstrumentedAdminClient) DescribeTopic(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+	startTime := time.Now()
+	topicMetadata, topicError := c.adminClient.DescribeTopic(ctx, topicName)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationDescribeTopic, startTime, isTopicError(topicError))
+	return topicMetadata, topicError
+}
+
+// Instrumented Pass-Through Function For Creating Partitions
+func (c *InstrumentedAdminClient) CreatePartitions(ctx context.Context, topicName string, count int32) *sarama.TopicError {
+	startTime := time.Now()
+	topicError := c.adminClient.CreatePartitions(ctx, topicName, count)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationCreatePartitions, startTime, isTopicError(topicError))
+	return topicError
+}
+
+// Instrumented Pass-Through Function For Describing Topic Configuration
+func (c *InstrumentedAdminClient) DescribeTopicConfig(ctx context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+	startTime := time.Now()
+	topicConfig, topicError := c.adminClient.DescribeTopicConfig(ctx, topicName)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationDescribeTopicConfig, startTime, isTopicError(topicError))
+	return topicConfig, topicError
+}
+
+// Instrumented Pass-Through Function For Altering Topic Configuration
+func (c *InstrumentedAdminClient) AlterTopicConfig(ctx context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+	startTime := time.Now()
+	topicError := c.adminClient.AlterTopicConfig(ctx, topicName, configEntries)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationAlterTopicConfig, startTime, isTopicError(topicError))
+	return topicError
+}
+
+// Instrumented Pass-Through Function For Describing The Kafka Cluster
+func (c *InstrumentedAdminClient) DescribeCluster(ctx context.Context) ([]*sarama.Broker, error) {
+	startTime := time.Now()
+	brokers, err := c.adminClient.DescribeCluster(ctx)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationDescribeCluster, startTime, err != nil)
+	return brokers, err
+}
+
+// Instrumented Pass-Through Function For Listing The Topics Managed By The Controller
+func (c *InstrumentedAdminClient) ListManagedTopics(ctx context.Context) (map[string]sarama.TopicDetail, error) {
+	startTime := time.Now()
+	topicDetails, err := c.adminClient.ListManagedTopics(ctx)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationListManagedTopics, startTime, err != nil)
+	return topicDetails, err
+}
+
+// Instrumented Pass-Through Function For Describing ConsumerGroups
+func (c *InstrumentedAdminClient) DescribeConsumerGroups(ctx context.Context, groupIds []string) ([]*sarama.GroupDescription, error) {
+	startTime := time.Now()
+	groupDescriptions, err := c.adminClient.DescribeConsumerGroups(ctx, groupIds)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationDescribeGroups, startTime, err != nil)
+	return groupDescriptions, err
+}
+
+// Instrumented Pass-Through Function For Listing ConsumerGroup Offsets
+func (c *InstrumentedAdminClient) ListConsumerGroupOffsets(ctx context.Context, groupId string, topicPartitions map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	startTime := time.Now()
+	offsetFetchResponse, err := c.adminClient.ListConsumerGroupOffsets(ctx, groupId, topicPartitions)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationListGroupOffsets, startTime, err != nil)
+	return offsetFetchResponse, err
+}
+
+// Instrumented Pass-Through Function For Creating Topic ACLs
+func (c *InstrumentedAdminClient) CreateTopicACL(ctx context.Context, topicName string, acl sarama.Acl) *sarama.TopicError {
+	startTime := time.Now()
+	topicError := c.adminClient.CreateTopicACL(ctx, topicName, acl)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationCreateTopicACL, startTime, isTopicError(topicError))
+	return topicError
+}
+
+// Instrumented Pass-Through Function For Deleting Topic ACLs
+func (c *InstrumentedAdminClient) DeleteTopicACL(ctx context.Context, topicName string, acl sarama.Acl) *sarama.TopicError {
+	startTime := time.Now()
+	topicError := c.adminClient.DeleteTopicACL(ctx, topicName, acl)
+	recordAdminClientOperation(ctx, c.adminClientType, OperationDeleteTopicACL, startTime, isTopicError(topicError))
+	return topicError
+}
+
+// Instrumented Pass-Through Function For Closing The AdminClient
+func (c *InstrumentedAdminClient) Close() error {
+	startTime := time.Now()
+	err := c.adminClient.Close()
+	recordAdminClientOperation(context.Background(), c.adminClientType, OperationClose, startTime, err != nil)
+	return err
+}
+
+// Instrumented Pass-Through Function For Getting The K8S Secret With Kafka Credentials For The Specified Topic Name (Empty Is A Failure)
+func (c *InstrumentedAdminClient) GetKafkaSecretName(topicName string) string {
+	startTime := time.Now()
+	kafkaSecretName := c.adminClient.GetKafkaSecretName(topicName)
+	recordAdminClientOperation(context.Background(), c.adminClientType, OperationGetKafkaSecretName, startTime, len(kafkaSecretName) <= 0)
+	return kafkaSecretName
+}
+
+// Metrics Record Function Variable To Facilitate Unit Testing
+var recordWrapper = metrics.Record
+
+// Record The Latency (And Possible Failure) Of An AdminClient Operation Which Started At The Specified Time
+func recordAdminClientOperation(ctx context.Context, adminClientType AdminClientType, operation string, startTime time.Time, failed bool) {
+
+	// Create A New OpenCensus Tag / Context For The Operation & AdminClient Type
+	tagCtx, err := tag.New(ctx,
+		tag.Insert(operationKey, operation),
+		tag.Insert(adminClientTypeKey, adminClientType.String()),
+	)
+	if err != nil {
+		logging.FromContext(ctx).Desugar().Error("Failed To Create New OpenCensus Tags For AdminClient Operation", zap.String("Operation", operation), zap.Error(err))
+		return
+	}
+
+	// Record The Latency & Error Metrics
+	latencyMs := float64(time.Since(startTime)) / float64(time.Millisecond)
+	recordWrapper(tagCtx, adminClientLatencyMs.M(latencyMs))
+	if failed {
+		recordWrapper(tagCtx, adminClientErrorCount.M(1))
+	}
+}
+
+// Utility Function For Determining Whether A TopicError Represents An Actual Failure
+func isTopicError(topicError *sarama.TopicError) bool {
+	return topicError != nil && topicError.Err != sarama.ErrNoError
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
+	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
+)
+
+// Recorded Metric Measurement (Name, Operation & AdminClient Type Tags)
+type recordedMeasurement struct {
+	name            string
+	operation       string
+	adminClientType string
+}
+
+// Replace The recordWrapper With One Capturing The Measurements & Return A Reference To Them
+func stubRecordWrapper(t *testing.T) *[]recordedMeasurement {
+	measurements := make([]recordedMeasurement, 0)
+	recordWrapper = func(ctx context.Context, measurement stats.Measurement, _ ...stats.Options) {
+		tagMap := tag.FromContext(ctx)
+		assert.NotNil(t, tagMap)
+		operation, _ := tagMap.Value(operationKey)
+		adminClientType, _ := tagMap.Value(adminClientTypeKey)
+		measurements = append(measurements, recordedMeasurement{
+			name:            measurement.Measure().Name(),
+			operation:       operation,
+			adminClientType: adminClientType,
+		})
+	}
+	return &measurements
+}
+
+// Reference To The Original recordWrapper
+var recordWrapperRef = recordWrapper
+
+// Restore The Original recordWrapper
+func restoreRecordWrapper() {
+	recordWrapper = recordWrapperRef
+}
+
+// Test The AdminClientType String() Functionality
+func TestAdminClientTypeString(t *testing.T) {
+	assert.Equal(t, "kafka", Kafka.String())
+	assert.Equal(t, "eventhub", EventHub.String())
+	assert.Equal(t, "custom", Custom.String())
+	assert.Equal(t, "confluent", Confluent.String())
+	assert.Equal(t, "unknown", Unknown.String())
+	assert.Equal(t, "unknown", AdminClientType(99).String())
+}
+
+// Test The InstrumentedAdminClient Records Latency & Error Metrics For Each Operation
+func TestInstrumentedAdminClient(t *testing.T) {
+
+	// Test Data
+	topicName := "TestTopicName"
+	topicError := adminutil.NewTopicError(sarama.ErrInvalidConfig, "invalid config")
+	noError := adminutil.NewTopicError(sarama.ErrNoError, "no error")
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name       string
+		topicError *sarama.TopicError
+		failed     bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Success", topicError: nil, failed: false},
+		{name: "No Error", topicError: noError, failed: false},
+		{name: "Topic Error", topicError: topicError, failed: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			measurements := stubRecordWrapper(t)
+			defer restoreRecordWrapper()
+
+			mockAdminClient := &MockPooledAdminClient{kafkaSecret: "kafka-secret-1", topicError: testCase.topicError}
+			adminClient := NewInstrumentedAdminClient(mockAdminClient, EventHub)
+
+			assert.Equal(t, testCase.topicError, adminClient.CreateTopic(context.TODO(), topicName, &sarama.TopicDetail{}))
+			assert.Equal(t, map[string]*sarama.TopicError{topicName: testCase.topicError}, adminClient.CreateTopics(context.TODO(), map[string]*sarama.TopicDetail{topicName: {}}))
+			assert.Equal(t, testCase.topicError, adminClient.DeleteTopic(context.TODO(), topicName))
+			_, describeTopicError := adminClient.DescribeTopic(context.TODO(), topicName)
+			assert.Equal(t, testCase.topicError, describeTopicError)
+			assert.Equal(t, testCase.topicError, adminClient.CreatePartitions(context.TODO(), topicName, 4))
+			_, describeTopicConfigError := adminClient.DescribeTopicConfig(context.TODO(), topicName)
+			assert.Equal(t, testCase.topicError, describeTopicConfigError)
+			assert.Equal(t, testCase.topicError, adminClient.AlterTopicConfig(context.TODO(), topicName, map[string]*string{}))
+			_, describeClusterError := adminClient.DescribeCluster(context.TODO())
+			assert.Equal(t, testCase.failed, describeClusterError != nil)
+			_, listManagedTopicsError := adminClient.ListManagedTopics(context.TODO())
+			assert.Equal(t, testCase.failed, listManagedTopicsError != nil)
+			_, describeGroupsError := adminClient.DescribeConsumerGroups(context.TODO(), []string{"TestGroupId"})
+			assert.Equal(t, testCase.failed, describeGroupsError != nil)
+			_, listGroupOffsetsError := adminClient.ListConsumerGroupOffsets(context.TODO(), "TestGroupId", nil)
+			assert.Equal(t, testCase.failed, listGroupOffsetsError != nil)
+			assert.Equal(t, testCase.topicError, adminClient.CreateTopicACL(context.TODO(), topicName, sarama.Acl{}))
+			assert.Equal(t, testCase.topicError, adminClient.DeleteTopicACL(context.TODO(), topicName, sarama.Acl{}))
+
+			// Verify A Latency (And Possibly An Error) Measurement Was Recorded For Each TopicError Operation
+			operations := []string{
+				OperationCreateTopic,
+				OperationCreateTopics,
+				OperationDeleteTopic,
+				OperationDescribeTopic,
+				OperationCreatePartitions,
+				OperationDescribeTopicConfig,
+				OperationAlterTopicConfig,
+				OperationDescribeCluster,
+				OperationListManagedTopics,
+				OperationDescribeGroups,
+				OperationListGroupOffsets,
+				OperationCreateTopicACL,
+				OperationDeleteTopicACL,
+			}
+			expectedMeasurements := make([]recordedMeasurement, 0)
+			for _, operation := range operations {
+				expectedMeasurements = append(expectedMeasurements, recordedMeasurement{name: adminClientLatencyMs.Name(), operation: operation, adminClientType: "eventhub"})
+				if testCase.failed {
+					expectedMeasurements = append(expectedMeasurements, recordedMeasurement{name: adminClientErrorCount.Name(), operation: operation, adminClientType: "eventhub"})
+				}
+			}
+			assert.Equal(t, expectedMeasurements, *measurements)
+		})
+	}
+}
+
+// Test The InstrumentedAdminClient Records Metrics For The GetKafkaSecretName() & Close() Operations
+func TestInstrumentedAdminClientSecretNameAndClose(t *testing.T) {
+
+	measurements := stubRecordWrapper(t)
+	defer restoreRecordWrapper()
+
+	mockAdminClient := &MockPooledAdminClient{kafkaSecret: "kafka-secret-1"}
+	adminClient := NewInstrumentedAdminClient(mockAdminClient, Kafka)
+	assert.Equal(t, "kafka-secret-1", adminClient.GetKafkaSecretName("TestTopicName"))
+	mockAdminClient.kafkaSecret = ""
+	assert.Equal(t, "", adminClient.GetKafkaSecretName("TestTopicName"))
+	assert.Nil(t, adminClient.Close())
+	assert.True(t, mockAdminClient.closed)
+
+	assert.Equal(t, []recordedMeasurement{
+		{name: adminClientLatencyMs.Name(), operation: OperationGetKafkaSecretName, adminClientType: "kafka"},
+		{name: adminClientLatencyMs.Name(), operation: OperationGetKafkaSecretName, adminClientType: "kafka"},
+		{name: adminClientErrorCount.Name(), operation: OperationGetKafkaSecretName, adminClientType: "kafka"},
+		{name: adminClientLatencyMs.Name(), operation: OperationClose, adminClientType: "kafka"},
+	}, *measurements)
+}
+
+// Test The CreateAdminClient() Records Metrics For The "connect" Operation
+func TestCreateAdminClientConnectMetrics(t *testing.T) {
+
+	measurements := stubRecordWrapper(t)
+	defer restoreRecordWrapper()
+
+	// Successful Creation
+	stubKafkaAdminClientWrapper(t)
+	adminClient, err := CreateAdminClient(context.TODO(), commontesting.GetDefaultSaramaConfig(t), "TestClientId", Kafka)
+	restoreKafkaAdminClientWrapper()
+	assert.Nil(t, err)
+	assert.NotNil(t, adminClient)
+
+	// Failed Creation
+	NewKafkaAdminClientWrapper = func(context.Context, *sarama.Config, string, string) (AdminClientInterface, error) {
+		return nil, errors.New("test connect error")
+	}
+	adminClient, err = CreateAdminClient(context.TODO(), commontesting.GetDefaultSaramaConfig(t), "TestClientId", Kafka)
+	restoreKafkaAdminClientWrapper()
+	assert.NotNil(t, err)
+	assert.Nil(t, adminClient)
+
+	assert.Equal(t, []recordedMeasurement{
+		{name: adminClientLatencyMs.Name(), operation: OperationConnect, adminClientType: "kafka"},
+		{name: adminClientLatencyMs.Name(), operation: OperationConnect, adminClientType: "kafka"},
+		{name: adminClientErrorCount.Name(), operation: OperationConnect, adminClientType: "kafka"},
+	}, *measurements)
+}
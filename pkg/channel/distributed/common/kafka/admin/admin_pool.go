@@ -0,0 +1,407 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
+	"knative.dev/eventing-kafka/pkg/common/constants"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+)
+
+//
+// Pooled AdminClient Implementation
+//
+// Recreating the AdminClient for every reconciliation avoids the Sarama ClusterAdmin "broken-pipe"
+// failures seen after periods of inactivity, but results in a new TCP connection / TLS handshake
+// for every KafkaChannel reconciliation.  The AdminClientPool instead keeps a small set of "warm"
+// AdminClients, keyed by the Kafka Secret(s) they were created from, which are closed once they
+// have been idle for longer than the configured timeout.  The PooledAdminClients handed out by the
+// pool transparently recreate their underlying AdminClient when a connection failure is detected.
+//
+
+// Ensure The PooledAdminClient Struct Implements The AdminClientInterface
+var _ AdminClientInterface = &PooledAdminClient{}
+
+// AdminClient Pool Definition
+type AdminClientPool struct {
+	logger          *zap.Logger
+	clientId        string
+	adminClientType AdminClientType
+	idleTimeout     time.Duration
+	clients         map[string]*PooledAdminClient
+	mutex           sync.Mutex
+}
+
+// Pooled AdminClient Definition
+type PooledAdminClient struct {
+	logger       *zap.Logger
+	ctx          context.Context
+	saramaConfig *sarama.Config
+	key          string
+	version      string
+	adminClient  AdminClientInterface
+	pool         *AdminClientPool
+	lastUsed     time.Time
+}
+
+// Create A New AdminClientPool Of The Specified Type & Idle Timeout
+func NewAdminClientPool(logger *zap.Logger, clientId string, adminClientType AdminClientType, idleTimeout time.Duration) *AdminClientPool {
+	return &AdminClientPool{
+		logger:          logger,
+		clientId:        clientId,
+		adminClientType: adminClientType,
+		idleTimeout:     idleTimeout,
+		clients:         make(map[string]*PooledAdminClient),
+	}
+}
+
+// Time Function Variable To Facilitate Unit Testing
+var nowWrapper = time.Now
+
+//
+// Get A PooledAdminClient For The (Selected) Kafka Secret(s) Currently In The Knative Eventing Namespace
+//
+// An existing AdminClient will be reused if the Kafka Secret(s) have not changed since it was created,
+// otherwise a new one will be created via CreateAdminClient() and added to the pool.  Any other pooled
+// AdminClients which have been idle longer than the idle timeout are closed and removed from the pool.
+//
+func (p *AdminClientPool) Get(ctx context.Context, saramaConfig *sarama.Config) (AdminClientInterface, error) {
+
+	// Thread Safe ;)
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Close Any AdminClients Which Have Been Idle Too Long
+	p.evictIdleClients()
+
+	// Determine The Pool Key & Version From The Current Kafka Secrets
+	key, version, err := kafkaSecretsKey(ctx)
+	if err != nil {
+		p.logger.Error("Failed To Determine Kafka Secrets For AdminClient Pool", zap.Error(err))
+		return nil, err
+	}
+
+	// Reuse The Existing Pooled AdminClient If The Kafka Secret(s) Are Unchanged
+	pooledAdminClient, ok := p.clients[key]
+	if ok && pooledAdminClient.version == version {
+		p.logger.Debug("Reusing Pooled Kafka AdminClient", zap.String("KafkaSecrets", key))
+		pooledAdminClient.ctx = ctx
+		pooledAdminClient.saramaConfig = saramaConfig
+		pooledAdminClient.lastUsed = nowWrapper()
+		return pooledAdminClient, nil
+	} else if ok {
+		p.logger.Info("Kafka Secret(s) Changed - Replacing Pooled Kafka AdminClient", zap.String("KafkaSecrets", key))
+		pooledAdminClient.closeAdminClient()
+		delete(p.clients, key)
+	}
+
+	// Create A New AdminClient For The Kafka Secret(s)
+	adminClient, err := CreateAdminClient(ctx, saramaConfig, p.clientId, p.adminClientType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Track The New PooledAdminClient In The Pool & Return
+	pooledAdminClient = &PooledAdminClient{
+		logger:       p.logger.With(zap.String("KafkaSecrets", key)),
+		ctx:          ctx,
+		saramaConfig: saramaConfig,
+		key:          key,
+		version:      version,
+		adminClient:  adminClient,
+		pool:         p,
+		lastUsed:     nowWrapper(),
+	}
+	p.clients[key] = pooledAdminClient
+	p.logger.Info("Added New Kafka AdminClient To Pool", zap.String("KafkaSecrets", key))
+	return pooledAdminClient, nil
+}
+
+// Close All The AdminClients In The Pool
+func (p *AdminClientPool) Close() error {
+
+	// Thread Safe ;)
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Close & Remove All Pooled AdminClients
+	for key, pooledAdminClient := range p.clients {
+		pooledAdminClient.closeAdminClient()
+		delete(p.clients, key)
+	}
+	return nil
+}
+
+// Close & Remove Any AdminClients That Have Been Idle Longer Than The Idle Timeout (Caller Must Hold Lock!)
+func (p *AdminClientPool) evictIdleClients() {
+	now := nowWrapper()
+	for key, pooledAdminClient := range p.clients {
+		if now.Sub(pooledAdminClient.lastUsed) > p.idleTimeout {
+			p.logger.Info("Closing Idle Pooled Kafka AdminClient", zap.String("KafkaSecrets", key))
+			pooledAdminClient.closeAdminClient()
+			delete(p.clients, key)
+		}
+	}
+}
+
+// Pooled Pass-Through Function For Creating Topics (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) CreateTopic(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+	topicError := c.adminClient.CreateTopic(ctx, topicName, topicDetail)
+	if isConnectionTopicError(topicError) && c.reconnect() {
+		topicError = c.adminClient.CreateTopic(ctx, topicName, topicDetail)
+	}
+	return topicError
+}
+
+// Pooled Pass-Through Function For Creating Multiple Topics (Reconnecting & Retrying The Failed Topics Once On Connection Failures)
+func (c *PooledAdminClient) CreateTopics(ctx context.Context, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+	topicErrors := c.adminClient.CreateTopics(ctx, topicDetails)
+	retryTopicDetails := make(map[string]*sarama.TopicDetail)
+	for topicName, topicError := range topicErrors {
+		if isConnectionTopicError(topicError) {
+			retryTopicDetails[topicName] = topicDetails[topicName]
+		}
+	}
+	if len(retryTopicDetails) > 0 && c.reconnect() {
+		for topicName, topicError := range c.adminClient.CreateTopics(ctx, retryTopicDetails) {
+			topicErrors[topicName] = topicError
+		}
+	}
+	return topicErrors
+}
+
+// Pooled Pass-Through Function For Deleting Topics (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) DeleteTopic(ctx context.Context, topicName string) *sarama.TopicError {
+	topicError := c.adminClient.DeleteTopic(ctx, topicName)
+	if isConnectionTopicError(topicError) && c.reconnect() {
+		topicError = c.adminClient.DeleteTopic(ctx, topicName)
+	}
+	return topicError
+}
+
+// Pooled Pass-Through Function For Describing Topics (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) DescribeTopic(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+	topicMetadata, topicError := c.adminClient.DescribeTopic(ctx, topicName)
+	if isConnectionTopicError(topicError) && c.reconnect() {
+		topicMetadata, topicError = c.adminClient.DescribeTopic(ctx, topicName)
+	}
+	return topicMetadata, topicError
+}
+
+// Pooled Pass-Through Function For Creating Partitions (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) CreatePartitions(ctx context.Context, topicName string, count int32) *sarama.TopicError {
+	topicError := c.adminClient.CreatePartitions(ctx, topicName, count)
+	if isConnectionTopicError(topicError) && c.reconnect() {
+		topicError = c.adminClient.CreatePartitions(ctx, topicName, count)
+	}
+	return topicError
+}
+
+// Pooled Pass-Through Function For Describing Topic Configuration (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) DescribeTopicConfig(ctx context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+	topicConfig, topicError := c.adminClient.DescribeTopicConfig(ctx, topicName)
+	if isConnectionTopicError(topicError) && c.reconnect() {
+		topicConfig, topicError = c.adminClient.DescribeTopicConfig(ctx, topicName)
+	}
+	return topicConfig, topicError
+}
+
+// Pooled Pass-Through Function For Altering Topic Configuration (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) AlterTopicConfig(ctx context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+	topicError := c.adminClient.AlterTopicConfig(ctx, topicName, configEntries)
+	if isConnectionTopicError(topicError) && c.reconnect() {
+		topicError = c.adminClient.AlterTopicConfig(ctx, topicName, configEntries)
+	}
+	return topicError
+}
+
+// Pooled Pass-Through Function For Describing The Kafka Cluster (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) DescribeCluster(ctx context.Context) ([]*sarama.Broker, error) {
+	brokers, err := c.adminClient.DescribeCluster(ctx)
+	if isConnectionError(err) && c.reconnect() {
+		brokers, err = c.adminClient.DescribeCluster(ctx)
+	}
+	return brokers, err
+}
+
+// Pooled Pass-Through Function For Listing The Topics Managed By The Controller (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) ListManagedTopics(ctx context.Context) (map[string]sarama.TopicDetail, error) {
+	topicDetails, err := c.adminClient.ListManagedTopics(ctx)
+	if isConnectionError(err) && c.reconnect() {
+		topicDetails, err = c.adminClient.ListManagedTopics(ctx)
+	}
+	return topicDetails, err
+}
+
+// Pooled Pass-Through Function For Describing ConsumerGroups (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) DescribeConsumerGroups(ctx context.Context, groupIds []string) ([]*sarama.GroupDescription, error) {
+	groupDescriptions, err := c.adminClient.DescribeConsumerGroups(ctx, groupIds)
+	if isConnectionError(err) && c.reconnect() {
+		groupDescriptions, err = c.adminClient.DescribeConsumerGroups(ctx, groupIds)
+	}
+	return groupDescriptions, err
+}
+
+// Pooled Pass-Through Function For Listing ConsumerGroup Offsets (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) ListConsumerGroupOffsets(ctx context.Context, groupId string, topicPartitions map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	offsetFetchResponse, err := c.adminClient.ListConsumerGroupOffsets(ctx, groupId, topicPartitions)
+	if isConnectionError(err) && c.reconnect() {
+		offsetFetchResponse, err = c.adminClient.ListConsumerGroupOffsets(ctx, groupId, topicPartitions)
+	}
+	return offsetFetchResponse, err
+}
+
+// Pooled Pass-Through Function For Creating Topic ACLs (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) CreateTopicACL(ctx context.Context, topicName string, acl sarama.Acl) *sarama.TopicError {
+	topicError := c.adminClient.CreateTopicACL(ctx, topicName, acl)
+	if isConnectionTopicError(topicError) && c.reconnect() {
+		topicError = c.adminClient.CreateTopicACL(ctx, topicName, acl)
+	}
+	return topicError
+}
+
+// Pooled Pass-Through Function For Deleting Topic ACLs (Reconnecting Once On Connection Failures)
+func (c *PooledAdminClient) DeleteTopicACL(ctx context.Context, topicName string, acl sarama.Acl) *sarama.TopicError {
+	topicError := c.adminClient.DeleteTopicACL(ctx, topicName, acl)
+	if isConnectionTopicError(topicError) && c.reconnect() {
+		topicError = c.adminClient.DeleteTopicACL(ctx, topicName, acl)
+	}
+	return topicError
+}
+
+// Pooled Function For "Closing" The AdminClient - Simply Returns It To The Pool For Reuse
+func (c *PooledAdminClient) Close() error {
+	c.pool.mutex.Lock()
+	defer c.pool.mutex.Unlock()
+	c.lastUsed = nowWrapper()
+	return nil
+}
+
+// Get The K8S Secret With Kafka Credentials For The Specified Topic Name
+func (c *PooledAdminClient) GetKafkaSecretName(topicName string) string {
+	return c.adminClient.GetKafkaSecretName(topicName)
+}
+
+// Replace The Underlying AdminClient With A Newly Created One, Returning Success
+func (c *PooledAdminClient) reconnect() bool {
+	c.logger.Warn("Detected Kafka AdminClient Connection Failure - Reconnecting")
+	adminClient, err := CreateAdminClient(c.ctx, c.saramaConfig, c.pool.clientId, c.pool.adminClientType)
+	if err != nil {
+		c.logger.Error("Failed To Reconnect Kafka AdminClient", zap.Error(err))
+		return false
+	}
+	c.closeAdminClient()
+	c.adminClient = adminClient
+	return true
+}
+
+// Actually Close The Underlying AdminClient
+func (c *PooledAdminClient) closeAdminClient() {
+	if c.adminClient != nil {
+		err := c.adminClient.Close()
+		if err != nil {
+			c.logger.Warn("Failed To Close Pooled Kafka AdminClient", zap.Error(err))
+		}
+	}
+}
+
+// Determine The AdminClientPool Key (Names) & Version (ResourceVersions) Of The Current (Selected) Kafka Secrets
+func kafkaSecretsKey(ctx context.Context) (string, string, error) {
+
+	// Get A List Of The Kafka Secrets
+	kafkaSecrets, err := adminutil.GetKafkaSecrets(ctx, kubeclient.Get(ctx), constants.KnativeEventingNamespace)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Restrict To The Explicitly Selected Kafka Secret (If Any) Which Must Exist
+	secrets := filterKafkaSecrets(ctx, kafkaSecrets).Items
+	if kafkaSecretName := KafkaSecretNameFromContext(ctx); len(kafkaSecretName) > 0 && len(secrets) <= 0 {
+		return "", "", fmt.Errorf("kafka secret '%s' not found", kafkaSecretName)
+	}
+
+	// Sort The Kafka Secrets By Name For A Stable Key
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].Name < secrets[j].Name })
+
+	// Build The Key & Version From The Kafka Secrets
+	names := make([]string, len(secrets))
+	versions := make([]string, len(secrets))
+	for index, secret := range secrets {
+		names[index] = secret.Name
+		versions[index] = secret.ResourceVersion
+	}
+	return strings.Join(names, ","), strings.Join(versions, ","), nil
+}
+
+// The Errors Indicating A Dead AdminClient Connection (Rather Than A Failed Kafka Operation)
+var connectionErrors = []error{
+	io.EOF,
+	io.ErrUnexpectedEOF,
+	syscall.EPIPE,
+	syscall.ECONNRESET,
+	sarama.ErrOutOfBrokers,
+	sarama.ErrNotConnected,
+	sarama.ErrClosedClient,
+}
+
+// Utility Function For Identifying Connection Failures (Including Any Network Error) In Errors
+func isConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, connectionError := range connectionErrors {
+		if errors.Is(err, connectionError) {
+			return true
+		}
+	}
+	var netError net.Error
+	var topicError *sarama.TopicError
+	return errors.As(err, &netError) || (errors.As(err, &topicError) && isConnectionTopicError(topicError))
+}
+
+//
+// Utility Function For Identifying Connection Failures In TopicErrors
+//
+// Connection errors are promoted to ErrUnknown TopicErrors by the KafkaAdminClient, which retain only the
+// message of the original error, so the message must be exactly that of one of the connectionErrors (or end
+// with it, as when wrapped by a net.OpError such as "write tcp ...: broken pipe").  Messages which merely
+// contain such text (e.g. "eof" within a broker's error message) are not connection errors.
+//
+func isConnectionTopicError(topicError *sarama.TopicError) bool {
+	if topicError == nil || topicError.ErrMsg == nil || topicError.Err == sarama.ErrNoError {
+		return false
+	}
+	for _, connectionError := range connectionErrors {
+		if *topicError.ErrMsg == connectionError.Error() || strings.HasSuffix(*topicError.ErrMsg, ": "+connectionError.Error()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,448 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
+	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	injectionclient "knative.dev/pkg/client/injection/kube/client"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The AdminClientPool Reuses AdminClients For Unchanged Kafka Secrets
+func TestAdminClientPoolGetReuse(t *testing.T) {
+
+	// Test Data
+	ctx := createPoolTestContext("1")
+	saramaConfig := commontesting.GetDefaultSaramaConfig(t)
+	createCount := stubKafkaAdminClientWrapper(t)
+	defer restoreKafkaAdminClientWrapper()
+
+	// Perform The Test
+	pool := NewAdminClientPool(logtesting.TestLogger(t).Desugar(), "TestClientId", Kafka, time.Minute)
+	adminClient1, err := pool.Get(ctx, saramaConfig)
+	assert.Nil(t, err)
+	assert.Nil(t, adminClient1.Close())
+	adminClient2, err := pool.Get(ctx, saramaConfig)
+	assert.Nil(t, err)
+
+	// Verify The Results
+	assert.NotNil(t, adminClient1)
+	assert.Same(t, adminClient1, adminClient2)
+	assert.Equal(t, 1, *createCount)
+	assert.False(t, adminClient1.(*PooledAdminClient).adminClient.(*InstrumentedAdminClient).adminClient.(*MockPooledAdminClient).closed)
+	assert.Equal(t, "kafka-secret-1", adminClient2.GetKafkaSecretName("TestTopic"))
+}
+
+// Test The AdminClientPool Replaces AdminClients When The Kafka Secrets Change
+func TestAdminClientPoolGetSecretChanged(t *testing.T) {
+
+	// Test Data
+	saramaConfig := commontesting.GetDefaultSaramaConfig(t)
+	createCount := stubKafkaAdminClientWrapper(t)
+	defer restoreKafkaAdminClientWrapper()
+
+	// Perform The Test
+	pool := NewAdminClientPool(logtesting.TestLogger(t).Desugar(), "TestClientId", Kafka, time.Minute)
+	adminClient1, err := pool.Get(createPoolTestContext("1"), saramaConfig)
+	assert.Nil(t, err)
+	mockAdminClient1 := adminClient1.(*PooledAdminClient).adminClient.(*InstrumentedAdminClient).adminClient.(*MockPooledAdminClient)
+	adminClient2, err := pool.Get(createPoolTestContext("2"), saramaConfig)
+	assert.Nil(t, err)
+
+	// Verify The Results
+	assert.NotSame(t, adminClient1, adminClient2)
+	assert.Equal(t, 2, *createCount)
+	assert.True(t, mockAdminClient1.closed)
+	assert.Len(t, pool.clients, 1)
+}
+
+// Test The AdminClientPool Evicts Idle AdminClients
+func TestAdminClientPoolGetIdleEviction(t *testing.T) {
+
+	// Test Data
+	ctx := createPoolTestContext("1")
+	saramaConfig := commontesting.GetDefaultSaramaConfig(t)
+	createCount := stubKafkaAdminClientWrapper(t)
+	defer restoreKafkaAdminClientWrapper()
+
+	// Replace The Time Function To Control Idleness & Defer Reset
+	now := time.Now()
+	nowWrapperRef := nowWrapper
+	nowWrapper = func() time.Time { return now }
+	defer func() { nowWrapper = nowWrapperRef }()
+
+	// Perform The Test
+	pool := NewAdminClientPool(logtesting.TestLogger(t).Desugar(), "TestClientId", Kafka, time.Minute)
+	adminClient1, err := pool.Get(ctx, saramaConfig)
+	assert.Nil(t, err)
+	mockAdminClient1 := adminClient1.(*PooledAdminClient).adminClient.(*InstrumentedAdminClient).adminClient.(*MockPooledAdminClient)
+	now = now.Add(2 * time.Minute)
+	adminClient2, err := pool.Get(ctx, saramaConfig)
+	assert.Nil(t, err)
+
+	// Verify The Results
+	assert.NotSame(t, adminClient1, adminClient2)
+	assert.Equal(t, 2, *createCount)
+	assert.True(t, mockAdminClient1.closed)
+}
+
+// Test The AdminClientPool Get() Error Handling
+func TestAdminClientPoolGetError(t *testing.T) {
+
+	// Replace the NewKafkaAdminClientWrapper To Return An Error & Defer Reset
+	NewKafkaAdminClientWrapperRef := NewKafkaAdminClientWrapper
+	NewKafkaAdminClientWrapper = func(context.Context, *sarama.Config, string, string) (AdminClientInterface, error) {
+		return nil, errors.New("test error")
+	}
+	defer func() { NewKafkaAdminClientWrapper = NewKafkaAdminClientWrapperRef }()
+
+	// Perform The Test
+	pool := NewAdminClientPool(logtesting.TestLogger(t).Desugar(), "TestClientId", Kafka, time.Minute)
+	adminClient, err := pool.Get(createPoolTestContext("1"), commontesting.GetDefaultSaramaConfig(t))
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Nil(t, adminClient)
+	assert.Len(t, pool.clients, 0)
+}
+
+// Test The AdminClientPool Keys AdminClients By The Explicitly Selected Kafka Secret
+func TestAdminClientPoolGetSelectedSecret(t *testing.T) {
+
+	// Test Data
+	kafkaSecret1 := createKafkaSecret("kafka-secret-1", commonconstants.KnativeEventingNamespace, "TestBrokers", "TestUsername", "TestPassword")
+	kafkaSecret2 := createKafkaSecret("kafka-secret-2", commonconstants.KnativeEventingNamespace, "TestBrokers", "TestUsername", "TestPassword")
+	ctx := context.WithValue(context.TODO(), injectionclient.Key{}, fake.NewSimpleClientset(kafkaSecret1, kafkaSecret2))
+	saramaConfig := commontesting.GetDefaultSaramaConfig(t)
+	createCount := stubKafkaAdminClientWrapper(t)
+	defer restoreKafkaAdminClientWrapper()
+
+	// Perform The Test
+	pool := NewAdminClientPool(logtesting.TestLogger(t).Desugar(), "TestClientId", Kafka, time.Minute)
+	_, err := pool.Get(ctx, saramaConfig)
+	assert.Nil(t, err)
+	_, err = pool.Get(WithKafkaSecretName(ctx, kafkaSecret2.Name), saramaConfig)
+	assert.Nil(t, err)
+	_, err = pool.Get(WithKafkaSecretName(ctx, kafkaSecret2.Name), saramaConfig)
+	assert.Nil(t, err)
+	adminClient, err := pool.Get(WithKafkaSecretName(ctx, "kafka-secret-3"), saramaConfig)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Nil(t, adminClient)
+	assert.Equal(t, 2, *createCount)
+	assert.Len(t, pool.clients, 2)
+	assert.Contains(t, pool.clients, "kafka-secret-1,kafka-secret-2")
+	assert.Contains(t, pool.clients, "kafka-secret-2")
+}
+
+// Test Returning A PooledAdminClient Concurrently With Idle Eviction (Verified Via The Race Detector)
+func TestPooledAdminClientCloseConcurrentEviction(t *testing.T) {
+
+	// Test Data
+	stubKafkaAdminClientWrapper(t)
+	defer restoreKafkaAdminClientWrapper()
+
+	// Perform The Test - Close() Updates The Last Used Time While Get() Evicts Idle AdminClients
+	pool := NewAdminClientPool(logtesting.TestLogger(t).Desugar(), "TestClientId", Kafka, time.Minute)
+	adminClient, err := pool.Get(createPoolTestContext("1"), commontesting.GetDefaultSaramaConfig(t))
+	assert.Nil(t, err)
+	waitGroup := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(2)
+		go func() {
+			defer waitGroup.Done()
+			assert.Nil(t, adminClient.Close())
+		}()
+		go func() {
+			defer waitGroup.Done()
+			_, err := pool.Get(createPoolTestContext("2"), commontesting.GetDefaultSaramaConfig(t))
+			assert.Nil(t, err)
+		}()
+	}
+	waitGroup.Wait()
+}
+
+// Test The AdminClientPool Close() Functionality
+func TestAdminClientPoolClose(t *testing.T) {
+
+	// Test Data
+	stubKafkaAdminClientWrapper(t)
+	defer restoreKafkaAdminClientWrapper()
+
+	// Perform The Test
+	pool := NewAdminClientPool(logtesting.TestLogger(t).Desugar(), "TestClientId", Kafka, time.Minute)
+	adminClient, err := pool.Get(createPoolTestContext("1"), commontesting.GetDefaultSaramaConfig(t))
+	assert.Nil(t, err)
+	mockAdminClient := adminClient.(*PooledAdminClient).adminClient.(*InstrumentedAdminClient).adminClient.(*MockPooledAdminClient)
+	err = pool.Close()
+
+	// Verify The Results
+	assert.Nil(t, err)
+	assert.True(t, mockAdminClient.closed)
+	assert.Len(t, pool.clients, 0)
+}
+
+// Test The PooledAdminClient Reconnects On Connection Failures
+func TestPooledAdminClientReconnect(t *testing.T) {
+
+	// Test Data
+	topicName := "TestTopicName"
+	brokenPipeError := adminutil.NewUnknownTopicError("write tcp 10.0.0.1:1234->10.0.0.2:9092: write: broken pipe")
+	invalidConfigError := adminutil.NewTopicError(sarama.ErrInvalidConfig, "invalid config")
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name          string
+		firstError    *sarama.TopicError
+		expectedCount int
+		expectedError *sarama.TopicError
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Success", firstError: nil, expectedCount: 1, expectedError: nil},
+		{name: "Broken Pipe", firstError: brokenPipeError, expectedCount: 2, expectedError: nil},
+		{name: "Not Connected", firstError: adminutil.NewUnknownTopicError(sarama.ErrNotConnected.Error()), expectedCount: 2, expectedError: nil},
+		{name: "Non Connection Error", firstError: invalidConfigError, expectedCount: 1, expectedError: invalidConfigError},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			createCount := stubKafkaAdminClientWrapper(t)
+			defer restoreKafkaAdminClientWrapper()
+
+			pool := NewAdminClientPool(logtesting.TestLogger(t).Desugar(), "TestClientId", Kafka, time.Minute)
+			adminClient, err := pool.Get(createPoolTestContext("1"), commontesting.GetDefaultSaramaConfig(t))
+			assert.Nil(t, err)
+			firstMockAdminClient := adminClient.(*PooledAdminClient).adminClient.(*InstrumentedAdminClient).adminClient.(*MockPooledAdminClient)
+			firstMockAdminClient.topicError = testCase.firstError
+
+			createTopicError := adminClient.CreateTopic(context.TODO(), topicName, &sarama.TopicDetail{})
+			assert.Equal(t, testCase.expectedError, createTopicError)
+			assert.Equal(t, testCase.expectedCount, *createCount)
+			assert.Equal(t, testCase.expectedCount > 1, firstMockAdminClient.closed)
+
+			firstMockAdminClient.topicError = nil
+			deleteTopicError := adminClient.DeleteTopic(context.TODO(), topicName)
+			assert.Nil(t, deleteTopicError)
+		})
+	}
+}
+
+// Test The PooledAdminClient Reconnects & Retries The Failed Topics On CreateTopics() Connection Failures
+func TestPooledAdminClientCreateTopicsReconnect(t *testing.T) {
+
+	createCount := stubKafkaAdminClientWrapper(t)
+	defer restoreKafkaAdminClientWrapper()
+
+	pool := NewAdminClientPool(logtesting.TestLogger(t).Desugar(), "TestClientId", Kafka, time.Minute)
+	adminClient, err := pool.Get(createPoolTestContext("1"), commontesting.GetDefaultSaramaConfig(t))
+	assert.Nil(t, err)
+	firstMockAdminClient := adminClient.(*PooledAdminClient).adminClient.(*InstrumentedAdminClient).adminClient.(*MockPooledAdminClient)
+	firstMockAdminClient.topicError = adminutil.NewUnknownTopicError(sarama.ErrNotConnected.Error())
+
+	topicErrors := adminClient.CreateTopics(context.TODO(), map[string]*sarama.TopicDetail{"TestTopicName1": {}, "TestTopicName2": {}})
+	assert.Equal(t, map[string]*sarama.TopicError{"TestTopicName1": nil, "TestTopicName2": nil}, topicErrors)
+	assert.Equal(t, 2, *createCount)
+	assert.True(t, firstMockAdminClient.closed)
+}
+
+// Test The PooledAdminClient Reconnects On DescribeCluster() Connection Failures
+func TestPooledAdminClientDescribeClusterReconnect(t *testing.T) {
+
+	createCount := stubKafkaAdminClientWrapper(t)
+	defer restoreKafkaAdminClientWrapper()
+
+	pool := NewAdminClientPool(logtesting.TestLogger(t).Desugar(), "TestClientId", Kafka, time.Minute)
+	adminClient, err := pool.Get(createPoolTestContext("1"), commontesting.GetDefaultSaramaConfig(t))
+	assert.Nil(t, err)
+	firstMockAdminClient := adminClient.(*PooledAdminClient).adminClient.(*InstrumentedAdminClient).adminClient.(*MockPooledAdminClient)
+	firstMockAdminClient.topicError = adminutil.NewUnknownTopicError("read: connection reset by peer")
+
+	brokers, err := adminClient.DescribeCluster(context.TODO())
+	assert.Nil(t, err)
+	assert.NotNil(t, brokers)
+	assert.Equal(t, 2, *createCount)
+	assert.True(t, firstMockAdminClient.closed)
+}
+
+// Test The isConnectionError() Functionality
+func TestIsConnectionError(t *testing.T) {
+	assert.False(t, isConnectionError(nil))
+	assert.False(t, isConnectionError(errors.New("EOF")))
+	assert.False(t, isConnectionError(sarama.ErrUnknown))
+	assert.True(t, isConnectionError(io.EOF))
+	assert.True(t, isConnectionError(fmt.Errorf("failed to describe cluster: %w", io.ErrUnexpectedEOF)))
+	assert.True(t, isConnectionError(&net.OpError{Op: "write", Net: "tcp", Err: syscall.EPIPE}))
+	assert.True(t, isConnectionError(&net.DNSError{Err: "no such host", Name: "kafka"}))
+	assert.True(t, isConnectionError(sarama.ErrOutOfBrokers))
+	assert.True(t, isConnectionError(sarama.ErrNotConnected))
+	assert.True(t, isConnectionError(sarama.ErrClosedClient))
+}
+
+// Test The isConnectionTopicError() Functionality
+func TestIsConnectionTopicError(t *testing.T) {
+	assert.False(t, isConnectionTopicError(nil))
+	assert.False(t, isConnectionTopicError(&sarama.TopicError{Err: sarama.ErrUnknown}))
+	assert.False(t, isConnectionTopicError(adminutil.NewTopicError(sarama.ErrNoError, "EOF")))
+	assert.False(t, isConnectionTopicError(adminutil.NewTopicError(sarama.ErrTopicAlreadyExists, "topic already exists")))
+	assert.False(t, isConnectionTopicError(adminutil.NewUnknownTopicError("invalid config value for segment.bytes - expected eof")))
+	assert.False(t, isConnectionTopicError(adminutil.NewUnknownTopicError("Unexpected EOF in topic name")))
+	assert.True(t, isConnectionTopicError(adminutil.NewUnknownTopicError((&net.OpError{Op: "write", Net: "tcp", Err: syscall.EPIPE}).Error())))
+	assert.True(t, isConnectionTopicError(adminutil.NewUnknownTopicError("read: connection reset by peer")))
+	assert.True(t, isConnectionTopicError(adminutil.NewUnknownTopicError("EOF")))
+	assert.True(t, isConnectionTopicError(adminutil.NewUnknownTopicError(sarama.ErrOutOfBrokers.Error())))
+	assert.True(t, isConnectionTopicError(adminutil.NewUnknownTopicError(sarama.ErrClosedClient.Error())))
+}
+
+//
+// Utilities
+//
+
+// Create A Context With A Fake K8S Client Containing A Kafka Secret Of The Specified ResourceVersion
+func createPoolTestContext(resourceVersion string) context.Context {
+	kafkaSecret := createKafkaSecret("kafka-secret-1", commonconstants.KnativeEventingNamespace, "TestBrokers", "TestUsername", "TestPassword")
+	kafkaSecret.ResourceVersion = resourceVersion
+	return context.WithValue(context.TODO(), injectionclient.Key{}, fake.NewSimpleClientset(kafkaSecret))
+}
+
+// Reference To The Original NewKafkaAdminClientWrapper
+var newKafkaAdminClientWrapperRef = NewKafkaAdminClientWrapper
+
+// Replace The NewKafkaAdminClientWrapper With One Returning MockPooledAdminClients & Counting Creations
+func stubKafkaAdminClientWrapper(t *testing.T) *int {
+	createCount := 0
+	NewKafkaAdminClientWrapper = func(_ context.Context, _ *sarama.Config, clientId string, namespace string) (AdminClientInterface, error) {
+		assert.Equal(t, "TestClientId", clientId)
+		assert.Equal(t, commonconstants.KnativeEventingNamespace, namespace)
+		createCount++
+		return &MockPooledAdminClient{kafkaSecret: "kafka-secret-1"}, nil
+	}
+	return &createCount
+}
+
+// Restore The Original NewKafkaAdminClientWrapper
+func restoreKafkaAdminClientWrapper() {
+	NewKafkaAdminClientWrapper = newKafkaAdminClientWrapperRef
+}
+
+//
+// Mock Pooled AdminClient
+//
+
+var _ AdminClientInterface = &MockPooledAdminClient{}
+
+type MockPooledAdminClient struct {
+	kafkaSecret string
+	topicError  *sarama.TopicError
+	closed      bool
+}
+
+func (c *MockPooledAdminClient) GetKafkaSecretName(string) string {
+	return c.kafkaSecret
+}
+
+func (c *MockPooledAdminClient) CreateTopic(context.Context, string, *sarama.TopicDetail) *sarama.TopicError {
+	return c.topicError
+}
+
+func (c *MockPooledAdminClient) CreateTopics(_ context.Context, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+	topicErrors := make(map[string]*sarama.TopicError, len(topicDetails))
+	for topicName := range topicDetails {
+		topicErrors[topicName] = c.topicError
+	}
+	return topicErrors
+}
+
+func (c *MockPooledAdminClient) DeleteTopic(context.Context, string) *sarama.TopicError {
+	return c.topicError
+}
+
+func (c *MockPooledAdminClient) DescribeTopic(context.Context, string) (*sarama.TopicMetadata, *sarama.TopicError) {
+	return nil, c.topicError
+}
+
+func (c *MockPooledAdminClient) CreatePartitions(context.Context, string, int32) *sarama.TopicError {
+	return c.topicError
+}
+
+func (c *MockPooledAdminClient) DescribeTopicConfig(context.Context, string) (map[string]string, *sarama.TopicError) {
+	return map[string]string{}, c.topicError
+}
+
+func (c *MockPooledAdminClient) AlterTopicConfig(context.Context, string, map[string]*string) *sarama.TopicError {
+	return c.topicError
+}
+
+func (c *MockPooledAdminClient) DescribeCluster(context.Context) ([]*sarama.Broker, error) {
+	if isTopicError(c.topicError) {
+		return nil, c.topicError
+	}
+	return []*sarama.Broker{}, nil
+}
+
+func (c *MockPooledAdminClient) ListManagedTopics(context.Context) (map[string]sarama.TopicDetail, error) {
+	if isTopicError(c.topicError) {
+		return nil, c.topicError
+	}
+	return map[string]sarama.TopicDetail{}, nil
+}
+
+func (c *MockPooledAdminClient) DescribeConsumerGroups(context.Context, []string) ([]*sarama.GroupDescription, error) {
+	if isTopicError(c.topicError) {
+		return nil, c.topicError
+	}
+	return []*sarama.GroupDescription{}, nil
+}
+
+func (c *MockPooledAdminClient) ListConsumerGroupOffsets(context.Context, string, map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	if isTopicError(c.topicError) {
+		return nil, c.topicError
+	}
+	return &sarama.OffsetFetchResponse{}, nil
+}
+
+func (c *MockPooledAdminClient) CreateTopicACL(context.Context, string, sarama.Acl) *sarama.TopicError {
+	return c.topicError
+}
+
+func (c *MockPooledAdminClient) DeleteTopicACL(context.Context, string, sarama.Acl) *sarama.TopicError {
+	return c.topicError
+}
+
+func (c *MockPooledAdminClient) Close() error {
+	c.closed = true
+	return nil
+}
@@ -22,10 +22,33 @@ import (
 
 	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
 	"knative.dev/eventing-kafka/pkg/common/constants"
 )
 
+// Test The Explicit Kafka Secret Selection Context Functionality
+func TestWithKafkaSecretName(t *testing.T) {
+
+	// Test Data
+	kafkaSecrets := &corev1.SecretList{Items: []corev1.Secret{
+		{ObjectMeta: metav1.ObjectMeta{Name: "kafka-secret-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "kafka-secret-2"}},
+	}}
+
+	// Verify Implicit Selection (No Or Empty Secret Name)
+	assert.Equal(t, "", KafkaSecretNameFromContext(context.TODO()))
+	assert.Equal(t, context.TODO(), WithKafkaSecretName(context.TODO(), ""))
+	assert.Equal(t, kafkaSecrets, filterKafkaSecrets(context.TODO(), kafkaSecrets))
+
+	// Verify Explicit Selection
+	ctx := WithKafkaSecretName(context.TODO(), "kafka-secret-2")
+	assert.Equal(t, "kafka-secret-2", KafkaSecretNameFromContext(ctx))
+	assert.Equal(t, []corev1.Secret{kafkaSecrets.Items[1]}, filterKafkaSecrets(ctx, kafkaSecrets).Items)
+	assert.Len(t, filterKafkaSecrets(WithKafkaSecretName(context.TODO(), "kafka-secret-3"), kafkaSecrets).Items, 0)
+}
+
 // Mock AdminClient Reference
 var mockAdminClient AdminClientInterface
 
@@ -55,7 +78,7 @@ func TestCreateAdminClientKafka(t *testing.T) {
 	// Verify The Results
 	assert.Nil(t, err)
 	assert.NotNil(t, adminClient)
-	assert.Equal(t, mockAdminClient, adminClient)
+	assert.Equal(t, NewInstrumentedAdminClient(mockAdminClient, adminClientType), adminClient)
 }
 
 // Test The CreateAdminClient() EventHub Functionality
@@ -83,7 +106,7 @@ func TestCreateAdminClientEventHub(t *testing.T) {
 	// Verify The Results
 	assert.Nil(t, err)
 	assert.NotNil(t, adminClient)
-	assert.Equal(t, mockAdminClient, adminClient)
+	assert.Equal(t, NewInstrumentedAdminClient(mockAdminClient, adminClientType), adminClient)
 }
 
 // Test The CreateAdminClient Custom Functionality
@@ -111,7 +134,34 @@ func TestCreateAdminClientCustom(t *testing.T) {
 	// Verify The Results
 	assert.Nil(t, err)
 	assert.NotNil(t, adminClient)
-	assert.Equal(t, mockAdminClient, adminClient)
+	assert.Equal(t, NewInstrumentedAdminClient(mockAdminClient, adminClientType), adminClient)
+}
+
+// Test The CreateAdminClient Confluent Functionality
+func TestCreateAdminClientConfluent(t *testing.T) {
+
+	// Test Data
+	ctx := context.TODO()
+	clientId := "TestClientId"
+	adminClientType := Confluent
+	mockAdminClient = &MockAdminClient{}
+
+	// Replace the NewConfluentAdminClientWrapper To Provide Mock AdminClient & Defer Reset
+	NewConfluentAdminClientWrapperRef := NewConfluentAdminClientWrapper
+	NewConfluentAdminClientWrapper = func(ctxArg context.Context, namespaceArg string) (AdminClientInterface, error) {
+		assert.Equal(t, ctx, ctxArg)
+		assert.Equal(t, constants.KnativeEventingNamespace, namespaceArg)
+		return mockAdminClient, nil
+	}
+	defer func() { NewConfluentAdminClientWrapper = NewConfluentAdminClientWrapperRef }()
+
+	// Perform The Test
+	adminClient, err := CreateAdminClient(ctx, commontesting.GetDefaultSaramaConfig(t), clientId, adminClientType)
+
+	// Verify The Results
+	assert.Nil(t, err)
+	assert.NotNil(t, adminClient)
+	assert.Equal(t, NewInstrumentedAdminClient(mockAdminClient, adminClientType), adminClient)
 }
 
 // Test The CreateAdminClient Custom Functionality
@@ -149,10 +199,54 @@ func (c MockAdminClient) CreateTopic(context.Context, string, *sarama.TopicDetai
 	return nil
 }
 
+func (c MockAdminClient) CreateTopics(context.Context, map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+	return map[string]*sarama.TopicError{}
+}
+
 func (c MockAdminClient) DeleteTopic(context.Context, string) *sarama.TopicError {
 	return nil
 }
 
+func (c MockAdminClient) DescribeTopic(context.Context, string) (*sarama.TopicMetadata, *sarama.TopicError) {
+	return nil, nil
+}
+
+func (c MockAdminClient) CreatePartitions(context.Context, string, int32) *sarama.TopicError {
+	return nil
+}
+
+func (c MockAdminClient) DescribeTopicConfig(context.Context, string) (map[string]string, *sarama.TopicError) {
+	return map[string]string{}, nil
+}
+
+func (c MockAdminClient) AlterTopicConfig(context.Context, string, map[string]*string) *sarama.TopicError {
+	return nil
+}
+
+func (c MockAdminClient) DescribeCluster(context.Context) ([]*sarama.Broker, error) {
+	return []*sarama.Broker{}, nil
+}
+
+func (c MockAdminClient) ListManagedTopics(context.Context) (map[string]sarama.TopicDetail, error) {
+	return map[string]sarama.TopicDetail{}, nil
+}
+
+func (c MockAdminClient) DescribeConsumerGroups(context.Context, []string) ([]*sarama.GroupDescription, error) {
+	return []*sarama.GroupDescription{}, nil
+}
+
+func (c MockAdminClient) ListConsumerGroupOffsets(context.Context, string, map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	return &sarama.OffsetFetchResponse{}, nil
+}
+
+func (c MockAdminClient) CreateTopicACL(context.Context, string, sarama.Acl) *sarama.TopicError {
+	return nil
+}
+
+func (c MockAdminClient) DeleteTopicACL(context.Context, string, sarama.Acl) *sarama.TopicError {
+	return nil
+}
+
 func (c MockAdminClient) Close() error {
 	return nil
 }
@@ -20,9 +20,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
@@ -42,6 +44,8 @@ type CacheInterface interface {
 	RemoveEventHub(ctx context.Context, eventhub string)
 	GetNamespace(eventhub string) *Namespace
 	GetLeastPopulatedNamespace() *Namespace
+	GetNamespaces() []*Namespace
+	RefreshNamespace(ctx context.Context, namespace *Namespace) error
 }
 
 // Verify The Cache Struct Implements The Interface
@@ -153,6 +157,18 @@ func (c *Cache) GetNamespace(eventhub string) *Namespace {
 	return c.eventhubMap[eventhub]
 }
 
+// Get All Of The Namespaces In The Cache (Sorted By Name)
+func (c *Cache) GetNamespaces() []*Namespace {
+	namespaces := make([]*Namespace, 0, len(c.namespaceMap))
+	for _, namespace := range c.namespaceMap {
+		if namespace != nil {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Name < namespaces[j].Name })
+	return namespaces
+}
+
 // Get The Namespace With The Least Number Of EventHubs
 func (c *Cache) GetLeastPopulatedNamespace() *Namespace {
 
@@ -198,6 +214,47 @@ func (c *Cache) GetLeastPopulatedNamespace() *Namespace {
 	return leastPopulatedNamespace
 }
 
+//
+// Refresh The Specified Namespace's Credentials & HubManager From Its Kafka Secret
+//
+// EventHub connection strings are rotated by updating the Kafka Secret, which would otherwise leave the cached
+// HubManager with stale credentials until the process was restarted.  The Namespace is updated in place so that
+// the EventHub mappings and counts in the cache are preserved.
+//
+func (c *Cache) RefreshNamespace(ctx context.Context, namespace *Namespace) error {
+
+	// Validate The Namespace
+	if namespace == nil {
+		return errors.New("unable to refresh nil namespace")
+	}
+
+	// Reload The Namespace's Kafka Secret From K8S
+	kafkaSecret, err := c.k8sClient.CoreV1().Secrets(c.k8sNamespace).Get(ctx, namespace.Secret, metav1.GetOptions{})
+	if err != nil {
+		c.logger.Error("Failed To Reload Kafka Secret For Namespace", zap.String("Namespace", namespace.Name), zap.String("Secret", namespace.Secret), zap.Error(err))
+		return err
+	}
+	if !c.validateKafkaSecret(kafkaSecret) {
+		return fmt.Errorf("invalid Kafka Secret '%s' found for namespace '%s'", namespace.Secret, namespace.Name)
+	}
+
+	// Create A Namespace With A New HubManager From The Reloaded Kafka Secret
+	refreshedNamespace, err := NewNamespaceFromKafkaSecret(c.logger, kafkaSecret)
+	if err != nil {
+		c.logger.Error("Failed To Refresh Namespace From Kafka Secret", zap.String("Namespace", namespace.Name), zap.Error(err))
+		return err
+	}
+
+	// Update The Cached Namespace's Credentials & HubManager In Place
+	namespace.Username = refreshedNamespace.Username
+	namespace.Password = refreshedNamespace.Password
+	namespace.HubManager = refreshedNamespace.HubManager
+	c.logger.Info("Refreshed EventHub Namespace From Kafka Secret", zap.String("Namespace", namespace.Name), zap.String("Secret", namespace.Secret))
+
+	// Return Success
+	return nil
+}
+
 // Utility Function For Validating Kafka Secret
 func (c *Cache) validateKafkaSecret(secret *corev1.Secret) bool {
 
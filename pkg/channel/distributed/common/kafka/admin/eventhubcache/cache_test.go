@@ -281,6 +281,58 @@ func TestGetNamespace(t *testing.T) {
 	assert.Equal(t, namespaceSecret2, namespace.Secret)
 }
 
+// Test The Cache's RefreshNamespace() Functionality With A Rotated Connection String
+func TestRefreshNamespace(t *testing.T) {
+
+	// Test Data
+	k8sNamespace := "TestK8SNamespace"
+	kafkaSecretName := "TestKafkaSecretName"
+	eventHubNamespaceName := "TestEventHubNamespace"
+	stalePassword := "TestStalePassword"
+	rotatedPassword := "TestRotatedPassword"
+	kafkaSecret := createKafkaSecret(kafkaSecretName, k8sNamespace, "TestBrokers", "TestUsername", rotatedPassword, eventHubNamespaceName)
+
+	// Create Mock HubManagers For The Stale & Rotated Connection Strings
+	staleHubManager := &MockHubManager{}
+	rotatedHubManager := &MockHubManager{ListHubEntities: []*eventhub.HubEntity{createEventHubEntity("TestHubEntityName")}}
+
+	// Replace The NewHubManagerFromConnectionString Wrapper To Provide Mock Implementation & Defer Reset
+	newHubManagerFromConnectionStringWrapperPlaceholder := NewHubManagerFromConnectionStringWrapper
+	NewHubManagerFromConnectionStringWrapper = func(connectionString string) (managerInterface HubManagerInterface, e error) {
+		if connectionString == rotatedPassword {
+			return rotatedHubManager, nil
+		}
+		return nil, fmt.Errorf("unexpected test connectionString '%s'", connectionString)
+	}
+	defer func() { NewHubManagerFromConnectionStringWrapper = newHubManagerFromConnectionStringWrapperPlaceholder }()
+
+	// Create A Cache To Test With A Namespace Using The Stale Connection String
+	cache := &Cache{
+		logger:       logtesting.TestLogger(t).Desugar(),
+		k8sClient:    fake.NewSimpleClientset(kafkaSecret),
+		k8sNamespace: k8sNamespace,
+		namespaceMap: make(map[string]*Namespace),
+		eventhubMap:  make(map[string]*Namespace),
+	}
+	namespace := &Namespace{Name: eventHubNamespaceName, Password: stalePassword, Secret: kafkaSecretName, HubManager: staleHubManager, Count: 1}
+	cache.namespaceMap[namespace.Name] = namespace
+	cache.eventhubMap["TestHubEntityName"] = namespace
+
+	// Perform The Test
+	err := cache.RefreshNamespace(context.TODO(), namespace)
+
+	// Verify The Namespace Was Updated In Place (Preserving The Cache Mappings & Count)
+	assert.Nil(t, err)
+	assert.Equal(t, rotatedPassword, namespace.Password)
+	assert.Equal(t, rotatedHubManager, namespace.HubManager)
+	assert.Equal(t, 1, namespace.Count)
+	assert.Same(t, namespace, cache.GetNamespace("TestHubEntityName"))
+
+	// Verify Refresh Failures For A Nil Namespace & Missing Kafka Secret
+	assert.NotNil(t, cache.RefreshNamespace(context.TODO(), nil))
+	assert.NotNil(t, cache.RefreshNamespace(context.TODO(), &Namespace{Name: eventHubNamespaceName, Secret: "MissingKafkaSecretName"}))
+}
+
 // Test The Cache's GetLeastPopulatedNamespace() Functionality
 func TestGetLeastPopulatedNamespace(t *testing.T) {
 
@@ -332,6 +384,43 @@ func TestGetLeastPopulatedNamespace(t *testing.T) {
 	assert.Equal(t, namespaceCount3, namespace.Count)
 }
 
+// Test The Cache's GetNamespaces() Functionality
+func TestGetNamespaces(t *testing.T) {
+
+	// Create A Mock HubManager
+	mockHubManager := &MockHubManager{}
+
+	// Replace The NewHubManagerFromConnectionString Wrapper To Provide Mock Implementation & Defer Reset
+	newHubManagerFromConnectionStringWrapperPlaceholder := NewHubManagerFromConnectionStringWrapper
+	NewHubManagerFromConnectionStringWrapper = func(connectionString string) (managerInterface HubManagerInterface, e error) {
+		return mockHubManager, nil
+	}
+	defer func() { NewHubManagerFromConnectionStringWrapper = newHubManagerFromConnectionStringWrapperPlaceholder }()
+
+	// Create A Test Logger
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Create The Cache's Namespace Map (Including An Invalid Nil Namespace)
+	namespaceMap := make(map[string]*Namespace)
+	namespaceMap["TestNamespaceName2"], _ = createTestNamespaceWithCount(logger, "TestNamespaceName2", 2)
+	namespaceMap["TestNamespaceName1"], _ = createTestNamespaceWithCount(logger, "TestNamespaceName1", 1)
+	namespaceMap["TestNamespaceName3"] = nil
+
+	// Create A Cache To Test
+	cache := &Cache{
+		logger:       logger,
+		namespaceMap: namespaceMap,
+	}
+
+	// Perform The Test
+	namespaces := cache.GetNamespaces()
+
+	// Verify Results
+	assert.Len(t, namespaces, 2)
+	assert.Equal(t, "TestNamespaceName1", namespaces[0].Name)
+	assert.Equal(t, "TestNamespaceName2", namespaces[1].Name)
+}
+
 //
 // Utilities
 //
@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/Shopify/sarama"
+)
+
+// Sentinel Admin Errors Allowing Callers To Identify Common Failures Via errors.Is() (See WrapTopicError())
+var (
+	ErrTopicExists   = errors.New("kafka topic already exists")
+	ErrUnknownTopic  = errors.New("unknown kafka topic")
+	ErrAuthorization = errors.New("kafka authorization failed")
+	ErrTimeout       = errors.New("kafka request timed out")
+)
+
+// Kafka Error Codes Mapped To The Sentinel Admin Errors
+var sentinelKErrors = map[sarama.KError]error{
+	sarama.ErrTopicAlreadyExists:         ErrTopicExists,
+	sarama.ErrUnknownTopicOrPartition:    ErrUnknownTopic,
+	sarama.ErrTopicAuthorizationFailed:   ErrAuthorization,
+	sarama.ErrClusterAuthorizationFailed: ErrAuthorization,
+	sarama.ErrGroupAuthorizationFailed:   ErrAuthorization,
+	sarama.ErrSASLAuthenticationFailed:   ErrAuthorization,
+	sarama.ErrRequestTimedOut:            ErrTimeout,
+}
+
+// Lower-Cased Messages Of Errors Which The AdminClients Promote To ErrUnknown TopicErrors, Mapped To Their Sentinel Admin Errors
+var promotedErrorMessages = []struct {
+	message  string
+	sentinel error
+}{
+	{message: "already exists", sentinel: ErrTopicExists},
+	{message: "i/o timeout", sentinel: ErrTimeout},
+	{message: strings.ToLower(context.DeadlineExceeded.Error()), sentinel: ErrTimeout},
+}
+
+//
+// AdminError Wraps A Sarama TopicError Returned By An AdminClient
+//
+// The wrapped TopicError is available via errors.As(), while errors.Is() matches both the Kafka error code
+// (e.g. sarama.ErrTopicAlreadyExists) and the corresponding sentinel admin error (e.g. ErrTopicExists).
+//
+type AdminError struct {
+	TopicError *sarama.TopicError
+	sentinel   error
+}
+
+// Return The Wrapped TopicError's Message
+func (e *AdminError) Error() string {
+	return e.TopicError.Error()
+}
+
+// Return The Wrapped TopicError
+func (e *AdminError) Unwrap() error {
+	return e.TopicError
+}
+
+// Determine Whether The Target Is The Sentinel Admin Error Or Kafka Error Code Of The Wrapped TopicError
+func (e *AdminError) Is(target error) bool {
+	return (e.sentinel != nil && target == e.sentinel) || target == error(e.TopicError.Err)
+}
+
+// Wrap The Specified TopicError As An AdminError (Nil For Nil / ErrNoError TopicErrors, Which Represent Success)
+func WrapTopicError(topicError *sarama.TopicError) error {
+	if topicError == nil || topicError.Err == sarama.ErrNoError {
+		return nil
+	}
+	sentinel := sentinelKErrors[topicError.Err]
+	if sentinel == nil && topicError.Err == sarama.ErrUnknown && topicError.ErrMsg != nil {
+		errMsg := strings.ToLower(*topicError.ErrMsg)
+		for _, promotedErrorMessage := range promotedErrorMessages {
+			if strings.Contains(errMsg, promotedErrorMessage.message) {
+				sentinel = promotedErrorMessage.sentinel
+				break
+			}
+		}
+	}
+	return &AdminError{TopicError: topicError, sentinel: sentinel}
+}
+
+// KafkaErrorClass Describes Whether A Kafka Error Is Expected To Resolve Itself On Retry
+type KafkaErrorClass int
+
+const (
+	KafkaErrorUnclassified KafkaErrorClass = iota // Unknown Errors - Retry With The Default Backoff
+	KafkaErrorTransient                           // Connection / Timeout / Leadership Errors - Retry Later
+	KafkaErrorPermanent                           // Invalid Configuration / Authorization Errors - Do Not Retry
+)
+
+// Kafka Error Codes Which Are Expected To Resolve Themselves (Broker Restarts, Leader Elections, etc...)
+var transientKErrors = map[sarama.KError]bool{
+	sarama.ErrLeaderNotAvailable:           true,
+	sarama.ErrNotLeaderForPartition:        true,
+	sarama.ErrRequestTimedOut:              true,
+	sarama.ErrBrokerNotAvailable:           true,
+	sarama.ErrReplicaNotAvailable:          true,
+	sarama.ErrNetworkException:             true,
+	sarama.ErrNotController:                true,
+	sarama.ErrNotEnoughReplicas:            true,
+	sarama.ErrNotEnoughReplicasAfterAppend: true,
+	sarama.ErrKafkaStorageError:            true,
+	sarama.ErrReassignmentInProgress:       true,
+	sarama.ErrPreferredLeaderNotAvailable:  true,
+}
+
+// Kafka Error Codes Which Will Not Resolve Themselves Without A Configuration Change
+var permanentKErrors = map[sarama.KError]bool{
+	sarama.ErrInvalidTopic:               true,
+	sarama.ErrInvalidPartitions:          true,
+	sarama.ErrInvalidReplicationFactor:   true,
+	sarama.ErrInvalidReplicaAssignment:   true,
+	sarama.ErrInvalidConfig:              true,
+	sarama.ErrInvalidRequest:             true,
+	sarama.ErrPolicyViolation:            true,
+	sarama.ErrTopicAuthorizationFailed:   true,
+	sarama.ErrClusterAuthorizationFailed: true,
+	sarama.ErrUnsupportedVersion:         true,
+	sarama.ErrUnsupportedSASLMechanism:   true,
+	sarama.ErrIllegalSASLState:           true,
+	sarama.ErrSASLAuthenticationFailed:   true,
+	sarama.ErrSecurityDisabled:           true,
+	sarama.ErrTopicDeletionDisabled:      true,
+}
+
+// Lower-Cased Messages Of Connection Failures Which The AdminClients Promote To ErrUnknown TopicErrors
+var transientErrorMessages = []string{
+	"broken pipe",
+	"connection refused",
+	"connection reset",
+	"i/o timeout",
+	"eof",
+	strings.ToLower(sarama.ErrOutOfBrokers.Error()),
+	strings.ToLower(sarama.ErrNotConnected.Error()),
+	strings.ToLower(sarama.ErrClosedClient.Error()),
+	strings.ToLower(sarama.ErrControllerNotAvailable.Error()),
+	strings.ToLower(context.DeadlineExceeded.Error()),
+}
+
+//
+// Classify The Specified Error (Typically A Sarama TopicError) As Transient, Permanent Or Unclassified
+//
+// Typed Kafka error codes are classified directly.  Connection failures (which the Sarama ClusterAdmin does
+// not expose as typed errors, and which are promoted to ErrUnknown TopicErrors) are identified by inspecting
+// the error message, while anything else is left unclassified.
+//
+func ClassifyKafkaError(err error) KafkaErrorClass {
+	if err == nil {
+		return KafkaErrorUnclassified
+	}
+
+	// Classify Sentinel Admin Errors
+	if errors.Is(err, ErrAuthorization) {
+		return KafkaErrorPermanent
+	} else if errors.Is(err, ErrTimeout) {
+		return KafkaErrorTransient
+	}
+
+	// Extract The Kafka Error Code & Message From TopicErrors / KErrors
+	kError := sarama.ErrUnknown
+	var errMsg string
+	var topicError *sarama.TopicError
+	if errors.As(err, &topicError) {
+		if topicError == nil {
+			return KafkaErrorUnclassified
+		}
+		kError = topicError.Err
+		if topicError.ErrMsg != nil {
+			errMsg = *topicError.ErrMsg
+		}
+	} else {
+		_ = errors.As(err, &kError)
+		errMsg = err.Error()
+	}
+
+	// Classify Typed Kafka Error Codes
+	if transientKErrors[kError] {
+		return KafkaErrorTransient
+	} else if permanentKErrors[kError] {
+		return KafkaErrorPermanent
+	} else if kError != sarama.ErrUnknown {
+		return KafkaErrorUnclassified
+	}
+
+	// Classify Untyped Connection / Timeout Errors
+	var netError net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netError) && netError.Timeout()) {
+		return KafkaErrorTransient
+	}
+	errMsg = strings.ToLower(errMsg)
+	for _, transientErrorMessage := range transientErrorMessages {
+		if strings.Contains(errMsg, transientErrorMessage) {
+			return KafkaErrorTransient
+		}
+	}
+	return KafkaErrorUnclassified
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test The ClassifyKafkaError() Functionality
+func TestClassifyKafkaError(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name          string
+		err           error
+		expectedClass KafkaErrorClass
+	}
+
+	// Create The TestCases
+	var nilTopicError *sarama.TopicError
+	testCases := []TestCase{
+		{name: "Nil Error", err: nil, expectedClass: KafkaErrorUnclassified},
+		{name: "Nil TopicError", err: nilTopicError, expectedClass: KafkaErrorUnclassified},
+		{name: "Transient TopicError", err: NewTopicError(sarama.ErrRequestTimedOut, "timed out"), expectedClass: KafkaErrorTransient},
+		{name: "Transient Leader TopicError", err: NewTopicError(sarama.ErrLeaderNotAvailable, "no leader"), expectedClass: KafkaErrorTransient},
+		{name: "Permanent TopicError", err: NewTopicError(sarama.ErrInvalidReplicationFactor, "too many replicas"), expectedClass: KafkaErrorPermanent},
+		{name: "Permanent Authorization TopicError", err: NewTopicError(sarama.ErrTopicAuthorizationFailed, "denied"), expectedClass: KafkaErrorPermanent},
+		{name: "Unclassified TopicError", err: NewTopicError(sarama.ErrUnknownTopicOrPartition, "not found"), expectedClass: KafkaErrorUnclassified},
+		{name: "Promoted Out Of Brokers Error", err: PromoteErrorToTopicError(sarama.ErrOutOfBrokers), expectedClass: KafkaErrorTransient},
+		{name: "Promoted Broken Pipe Error", err: NewUnknownTopicError("write tcp 10.0.0.1:9092: write: broken pipe"), expectedClass: KafkaErrorTransient},
+		{name: "Promoted Unknown Error", err: NewUnknownTopicError("something unexpected"), expectedClass: KafkaErrorUnclassified},
+		{name: "Transient KError", err: sarama.ErrBrokerNotAvailable, expectedClass: KafkaErrorTransient},
+		{name: "Permanent KError", err: sarama.ErrInvalidConfig, expectedClass: KafkaErrorPermanent},
+		{name: "Wrapped Permanent KError", err: fmt.Errorf("wrapped: %w", sarama.ErrPolicyViolation), expectedClass: KafkaErrorPermanent},
+		{name: "Deadline Exceeded", err: context.DeadlineExceeded, expectedClass: KafkaErrorTransient},
+		{name: "Network Timeout", err: &net.OpError{Op: "dial", Net: "tcp", Err: &timeoutError{}}, expectedClass: KafkaErrorTransient},
+		{name: "Connection Refused", err: errors.New("dial tcp 10.0.0.1:9092: connect: connection refused"), expectedClass: KafkaErrorTransient},
+		{name: "Other Error", err: errors.New("something unexpected"), expectedClass: KafkaErrorUnclassified},
+		{name: "Wrapped Group Authorization TopicError", err: WrapTopicError(NewTopicError(sarama.ErrGroupAuthorizationFailed, "denied")), expectedClass: KafkaErrorPermanent},
+		{name: "Wrapped Promoted Timeout Error", err: WrapTopicError(NewUnknownTopicError("context deadline exceeded")), expectedClass: KafkaErrorTransient},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedClass, ClassifyKafkaError(testCase.err))
+		})
+	}
+}
+
+// Test The WrapTopicError() Functionality
+func TestWrapTopicError(t *testing.T) {
+
+	// Verify Nil & ErrNoError TopicErrors (Success) Are Not Wrapped
+	assert.Nil(t, WrapTopicError(nil))
+	assert.Nil(t, WrapTopicError(NewTopicError(sarama.ErrNoError, "success")))
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name             string
+		topicError       *sarama.TopicError
+		expectedSentinel error
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Topic Exists", topicError: NewTopicError(sarama.ErrTopicAlreadyExists, "exists"), expectedSentinel: ErrTopicExists},
+		{name: "Unknown Topic", topicError: NewTopicError(sarama.ErrUnknownTopicOrPartition, "not found"), expectedSentinel: ErrUnknownTopic},
+		{name: "Topic Authorization", topicError: NewTopicError(sarama.ErrTopicAuthorizationFailed, "denied"), expectedSentinel: ErrAuthorization},
+		{name: "SASL Authentication", topicError: NewTopicError(sarama.ErrSASLAuthenticationFailed, "denied"), expectedSentinel: ErrAuthorization},
+		{name: "Request Timed Out", topicError: NewTopicError(sarama.ErrRequestTimedOut, "timed out"), expectedSentinel: ErrTimeout},
+		{name: "Promoted Topic Exists", topicError: NewUnknownTopicError("Topic 'TestTopic' already exists."), expectedSentinel: ErrTopicExists},
+		{name: "Promoted I/O Timeout", topicError: NewUnknownTopicError("read tcp 10.0.0.1:9092: i/o timeout"), expectedSentinel: ErrTimeout},
+		{name: "Other", topicError: NewTopicError(sarama.ErrInvalidConfig, "invalid"), expectedSentinel: nil},
+	}
+
+	// Run The TestCases
+	sentinels := []error{ErrTopicExists, ErrUnknownTopic, ErrAuthorization, ErrTimeout}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := WrapTopicError(testCase.topicError)
+			assert.Equal(t, testCase.topicError.Error(), err.Error())
+			assert.True(t, errors.Is(err, testCase.topicError.Err))
+			for _, sentinel := range sentinels {
+				assert.Equal(t, sentinel == testCase.expectedSentinel, errors.Is(fmt.Errorf("wrapped: %w", err), sentinel), sentinel.Error())
+			}
+			var topicError *sarama.TopicError
+			assert.True(t, errors.As(err, &topicError))
+			assert.Equal(t, testCase.topicError, topicError)
+		})
+	}
+}
+
+// Test net.Error Implementation Which Always Times Out
+type timeoutError struct{}
+
+func (e *timeoutError) Error() string   { return "test timeout" }
+func (e *timeoutError) Timeout() bool   { return true }
+func (e *timeoutError) Temporary() bool { return true }
@@ -29,25 +29,101 @@ const (
 	KafkaSecretLabel = "eventing-kafka.knative.dev/kafka-secret"
 
 	// Kafka Secret Keys
-	KafkaSecretKeyBrokers   = "brokers"
-	KafkaSecretKeyNamespace = "namespace"
-	KafkaSecretKeyUsername  = "username"
-	KafkaSecretKeyPassword  = "password"
+	KafkaSecretKeyBrokers       = "brokers"
+	KafkaSecretKeyNamespace     = "namespace"
+	KafkaSecretKeyUsername      = "username"
+	KafkaSecretKeyPassword      = "password"
+	KafkaSecretKeySaslMechanism = "sasl.mechanism"
+	KafkaSecretKeyUserCert      = "user.crt"
+	KafkaSecretKeyUserKey       = "user.key"
+	KafkaSecretKeyTLSEnable     = "tls.enable"    // Optional Override Of The ConfigMap's Net.TLS.Enable ("true" / "false")
+	KafkaSecretKeyRestEndpoint  = "rest.endpoint" // Confluent Kafka REST Endpoint (Confluent AdminClient Only)
+	KafkaSecretKeyClusterId     = "cluster.id"    // Confluent Kafka Cluster ID (Confluent AdminClient Only)
 
 	// Kafka Admin/Consumer/Producer Config Values
 	ConfigNetSaslVersion = sarama.SASLHandshakeV1 // Latest version, seems to work with EventHubs as well.
 
+	// Kafka Client ID Roles (Distinguish The Client IDs Of The Consumers & Producers Of The Same KafkaChannel)
+	ClientIdRoleDispatcher = "dispatcher"
+	ClientIdRoleProducer   = "producer"
+
 	// Kafka Topic Config Keys
-	TopicDetailConfigRetentionMs = "retention.ms"
+	TopicDetailConfigRetentionMs   = "retention.ms"
+	TopicDetailConfigCleanupPolicy = "cleanup.policy"
+
+	// Kafka Topic Config Values
+	TopicDetailConfigCleanupPolicyCompact = "compact"
+
+	// KafkaChannel Consumer Config Override Annotations (Prefix + Key)
+	ConsumerConfigAnnotationPrefix    = "kafka.eventing.knative.dev/consumer."
+	ConsumerConfigFetchMax            = "fetch.max"
+	ConsumerConfigSessionTimeoutMs    = "session.timeout.ms"
+	ConsumerConfigHeartbeatIntervalMs = "heartbeat.interval.ms"
+
+	// KafkaChannel Subscriber Concurrency Annotation (JSON Map Of Subscription UID To Worker Count) & Limit
+	SubscriberConcurrencyAnnotation = "kafka.eventing.knative.dev/subscriber-concurrency"
+	MaxSubscriberConcurrency        = 64
+
+	// KafkaChannel Subscriber Ordering Annotation (JSON Map Of Subscription UID To Ordering Mode), Modes & Unordered Default Concurrency
+	SubscriberOrderingAnnotation          = "kafka.eventing.knative.dev/subscriber-ordering"
+	SubscriberOrderingOrdered             = "ordered"
+	SubscriberOrderingUnordered           = "unordered"
+	DefaultUnorderedSubscriberConcurrency = 8
+
+	// KafkaChannel Subscriber Dead Letter Topic Annotation (JSON Map Of Subscription UID To Kafka Topic Name)
+	SubscriberDeadLetterTopicAnnotation = "kafka.eventing.knative.dev/subscriber-dead-letter-topic"
+
+	// KafkaChannel Subscriber Initial Offset Annotation (JSON Map Of Subscription UID To Initial Offset Policy) & Policies
+	SubscriberInitialOffsetAnnotation = "kafka.eventing.knative.dev/subscriber-initial-offset"
+	SubscriberInitialOffsetEarliest   = "earliest"
+	SubscriberInitialOffsetLatest     = "latest"
+
+	// KafkaChannel Subscriber Filter Annotation (JSON Map Of Subscription UID To Trigger-Style Attribute Filter)
+	SubscriberFilterAnnotation = "kafka.eventing.knative.dev/subscriber-filter"
+
+	// KafkaChannel Subscriber Shadow Annotation (JSON Map Of Subscription UID To Shadow Destination & Percent) & The Resolved Status Annotation (Maintained By The Controller)
+	SubscriberShadowAnnotation         = "kafka.eventing.knative.dev/subscriber-shadow"
+	SubscriberShadowResolvedAnnotation = "kafka.eventing.knative.dev/subscriber-shadow-resolved"
+
+	// KafkaChannel Replay Annotations (Requested RFC3339 Timestamp & The Last Applied Timestamp Recorded By The Controller)
+	ReplayFromTimestampAnnotation    = "kafka.eventing.knative.dev/replay-from-timestamp"
+	ReplayAppliedTimestampAnnotation = "kafka.eventing.knative.dev/replay-applied-timestamp"
+	ReplayOffsetMetadataPrefix       = "replay:"
+
+	// KafkaChannel Max Message Bytes Annotation (Kafka Topic max.message.bytes & Receiver Per-Channel Event Size Limit)
+	MaxMessageBytesAnnotation = "kafka.eventing.knative.dev/max-message-bytes"
+
+	// KafkaChannel Content Mode Annotation (CloudEvent Encoding Of The Kafka Messages Produced By The Receiver) & Values
+	ContentModeAnnotation = "kafka.eventing.knative.dev/content-mode"
+	ContentModeBinary     = "binary"     // CloudEvent Attributes As "ce_" Kafka Headers & The Data As The Raw Kafka Value
+	ContentModeStructured = "structured" // Entire CloudEvent As A JSON Envelope In The Kafka Value
+
+	// KafkaChannel Required Acks Annotation (Receiver Producer RequiredAcks - NoResponse, WaitForLocal or WaitForAll)
+	RequiredAcksAnnotation = "kafka.eventing.knative.dev/required-acks"
+
+	// KafkaChannel CloudEvent Extension Propagation Annotations (Comma-Separated Extension Names Allowed / Denied By The Receiver & Dispatcher)
+	ExtensionsAllowAnnotation = "kafka.eventing.knative.dev/extensions-allow"
+	ExtensionsDenyAnnotation  = "kafka.eventing.knative.dev/extensions-deny"
 
 	// EventHub Error Codes
 	EventHubErrorCodeUnknown       = -2
 	EventHubErrorCodeParseFailure  = -1
+	EventHubErrorCodeUnauthorized  = 401
 	EventHubErrorCodeCapacityLimit = 403
 	EventHubErrorCodeConflict      = 409
 
 	// EventHub Constraints
 	MaxEventHubNamespaces = 100
+	MinEventHubPartitions = 1
+	MaxEventHubPartitions = 32 // Standard Tier Limit
+
+	// Confluent Kafka REST (v3) API Error Codes
+	ConfluentErrorCodeUnknown            = -2
+	ConfluentErrorCodeParseFailure       = -1
+	ConfluentErrorCodeTopicAlreadyExists = 40002
+
+	// Confluent Kafka REST (v3) API Request Timeout
+	ConfluentRestTimeoutMillis = 30000
 
 	// KafkaChannel Constants
 	KafkaChannelServiceNameSuffix = "kn-channel" // Specific Value For Use With Knative e2e Tests!
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+//
+// Extract & Validate The Per-Subscription Concurrency From The Specified (KafkaChannel) Annotations
+//
+// The SubscriberConcurrency annotation is a JSON map of Subscription UID to the number of worker goroutines
+// (1 - MaxSubscriberConcurrency) used to process that Subscription's messages.  Malformed JSON, empty UIDs,
+// and out-of-range worker counts are all rejected with an error.  An empty map is returned if there is none.
+//
+func SubscriberConcurrency(annotations map[string]string) (map[string]int, error) {
+
+	// Parse The (Optional) SubscriberConcurrency Annotation
+	concurrency := make(map[string]int)
+	annotation := strings.TrimSpace(annotations[constants.SubscriberConcurrencyAnnotation])
+	if len(annotation) > 0 {
+		err := json.Unmarshal([]byte(annotation), &concurrency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subscriber concurrency '%s': expected a json map of subscription uid to worker count but found '%s'", constants.SubscriberConcurrencyAnnotation, annotation)
+		}
+	}
+
+	// Validate The Parsed Concurrency
+	err := ValidateSubscriberConcurrency(concurrency)
+	if err != nil {
+		return nil, err
+	}
+	return concurrency, nil
+}
+
+// Validate The Specified Per-Subscription Concurrency (As Returned By SubscriberConcurrency)
+func ValidateSubscriberConcurrency(concurrency map[string]int) error {
+	for uid, workers := range concurrency {
+		if len(strings.TrimSpace(uid)) == 0 {
+			return fmt.Errorf("invalid subscriber concurrency '%s': subscription uid must not be empty", constants.SubscriberConcurrencyAnnotation)
+		}
+		if workers < 1 || workers > constants.MaxSubscriberConcurrency {
+			return fmt.Errorf("invalid subscriber concurrency '%s' for subscription '%s': expected a worker count between 1 and %d but found %d",
+				constants.SubscriberConcurrencyAnnotation, uid, constants.MaxSubscriberConcurrency, workers)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+// Test The SubscriberConcurrency() Functionality
+func TestSubscriberConcurrency(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		annotations map[string]string
+		expected    map[string]int
+		expectErr   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Annotations", annotations: nil, expected: map[string]int{}},
+		{name: "Empty Annotation", annotations: map[string]string{constants.SubscriberConcurrencyAnnotation: " "}, expected: map[string]int{}},
+		{name: "Valid Annotation", annotations: map[string]string{constants.SubscriberConcurrencyAnnotation: `{"uid-1": 4, "uid-2": 1}`}, expected: map[string]int{"uid-1": 4, "uid-2": 1}},
+		{name: "Maximum Concurrency", annotations: map[string]string{constants.SubscriberConcurrencyAnnotation: `{"uid-1": 64}`}, expected: map[string]int{"uid-1": constants.MaxSubscriberConcurrency}},
+		{name: "Malformed JSON", annotations: map[string]string{constants.SubscriberConcurrencyAnnotation: "4"}, expectErr: true},
+		{name: "Non-Integer Concurrency", annotations: map[string]string{constants.SubscriberConcurrencyAnnotation: `{"uid-1": "four"}`}, expectErr: true},
+		{name: "Zero Concurrency", annotations: map[string]string{constants.SubscriberConcurrencyAnnotation: `{"uid-1": 0}`}, expectErr: true},
+		{name: "Excessive Concurrency", annotations: map[string]string{constants.SubscriberConcurrencyAnnotation: `{"uid-1": 65}`}, expectErr: true},
+		{name: "Empty Subscription UID", annotations: map[string]string{constants.SubscriberConcurrencyAnnotation: `{"": 2}`}, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			concurrency, err := SubscriberConcurrency(testCase.annotations)
+			if testCase.expectErr {
+				assert.NotNil(t, err)
+				assert.Nil(t, concurrency)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.expected, concurrency)
+			}
+		})
+	}
+}
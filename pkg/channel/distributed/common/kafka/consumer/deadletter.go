@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+)
+
+//
+// Extract & Validate The Per-Subscription Dead Letter Topics From The Specified (KafkaChannel) Annotations
+//
+// The SubscriberDeadLetterTopic annotation is a JSON map of Subscription UID to the name of a Kafka topic to which
+// events which could not be delivered are produced, instead of being sent to an HTTP dead letter sink.  Malformed
+// JSON, empty UIDs, and illegal Kafka topic names are all rejected with an error.  An empty map is returned if
+// there is none.
+//
+func SubscriberDeadLetterTopics(annotations map[string]string) (map[string]string, error) {
+
+	// Parse The (Optional) SubscriberDeadLetterTopic Annotation
+	deadLetterTopics := make(map[string]string)
+	annotation := strings.TrimSpace(annotations[constants.SubscriberDeadLetterTopicAnnotation])
+	if len(annotation) > 0 {
+		err := json.Unmarshal([]byte(annotation), &deadLetterTopics)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subscriber dead letter topic '%s': expected a json map of subscription uid to kafka topic name but found '%s'", constants.SubscriberDeadLetterTopicAnnotation, annotation)
+		}
+	}
+
+	// Validate The Parsed Dead Letter Topics
+	err := ValidateSubscriberDeadLetterTopics(deadLetterTopics)
+	if err != nil {
+		return nil, err
+	}
+	return deadLetterTopics, nil
+}
+
+// Validate The Specified Per-Subscription Dead Letter Topics (As Returned By SubscriberDeadLetterTopics)
+func ValidateSubscriberDeadLetterTopics(deadLetterTopics map[string]string) error {
+	for uid, topicName := range deadLetterTopics {
+		if len(strings.TrimSpace(uid)) == 0 {
+			return fmt.Errorf("invalid subscriber dead letter topic '%s': subscription uid must not be empty", constants.SubscriberDeadLetterTopicAnnotation)
+		}
+		if !util.IsValidTopicName(topicName) {
+			return fmt.Errorf("invalid subscriber dead letter topic '%s' for subscription '%s': '%s' is not a legal kafka topic name",
+				constants.SubscriberDeadLetterTopicAnnotation, uid, topicName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+// Test The SubscriberDeadLetterTopics() Functionality
+func TestSubscriberDeadLetterTopics(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		annotations map[string]string
+		expected    map[string]string
+		expectErr   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Annotations", annotations: nil, expected: map[string]string{}},
+		{name: "Empty Annotation", annotations: map[string]string{constants.SubscriberDeadLetterTopicAnnotation: " "}, expected: map[string]string{}},
+		{name: "Valid Annotation", annotations: map[string]string{constants.SubscriberDeadLetterTopicAnnotation: `{"uid-1": "dead-letter", "uid-2": "team.dlq_2"}`}, expected: map[string]string{"uid-1": "dead-letter", "uid-2": "team.dlq_2"}},
+		{name: "Malformed JSON", annotations: map[string]string{constants.SubscriberDeadLetterTopicAnnotation: "dead-letter"}, expectErr: true},
+		{name: "Non-String Topic", annotations: map[string]string{constants.SubscriberDeadLetterTopicAnnotation: `{"uid-1": 1}`}, expectErr: true},
+		{name: "Empty Topic", annotations: map[string]string{constants.SubscriberDeadLetterTopicAnnotation: `{"uid-1": ""}`}, expectErr: true},
+		{name: "Illegal Topic", annotations: map[string]string{constants.SubscriberDeadLetterTopicAnnotation: `{"uid-1": "dead/letter"}`}, expectErr: true},
+		{name: "Empty Subscription UID", annotations: map[string]string{constants.SubscriberDeadLetterTopicAnnotation: `{"": "dead-letter"}`}, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			deadLetterTopics, err := SubscriberDeadLetterTopics(testCase.annotations)
+			if testCase.expectErr {
+				assert.NotNil(t, err)
+				assert.Nil(t, deadLetterTopics)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.expected, deadLetterTopics)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+)
+
+// CloudEvent Attribute Names Consist Of Lower-Case ASCII Letters & Digits (CloudEvents Spec v1.0)
+var attributeNameRegExp = regexp.MustCompile("^[a-z0-9]+$")
+
+//
+// Extract & Validate The Per-Subscription Filters From The Specified (KafkaChannel) Annotations
+//
+// The SubscriberFilter annotation is a JSON map of Subscription UID to a Trigger-style filter (exact match on
+// CloudEvent attributes, with an empty value matching any value).  Malformed JSON, unsupported filter fields
+// (e.g. CESQL expressions), empty UIDs, and invalid attribute names are all rejected with an error.  An empty
+// map is returned if there is none.
+//
+func SubscriberFilters(annotations map[string]string) (map[string]eventingv1.TriggerFilter, error) {
+
+	// Parse The (Optional) SubscriberFilter Annotation (Rejecting Unknown Fields Rather Than Silently Ignoring Them)
+	filters := make(map[string]eventingv1.TriggerFilter)
+	annotation := strings.TrimSpace(annotations[constants.SubscriberFilterAnnotation])
+	if len(annotation) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader([]byte(annotation)))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(&filters)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subscriber filter '%s': expected a json map of subscription uid to attribute filter but found '%s' (%v)", constants.SubscriberFilterAnnotation, annotation, err)
+		}
+	}
+
+	// Validate The Parsed Filters
+	err := ValidateSubscriberFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+// Validate The Specified Per-Subscription Filters (As Returned By SubscriberFilters)
+func ValidateSubscriberFilters(filters map[string]eventingv1.TriggerFilter) error {
+	for uid, filter := range filters {
+		if len(strings.TrimSpace(uid)) == 0 {
+			return fmt.Errorf("invalid subscriber filter '%s': subscription uid must not be empty", constants.SubscriberFilterAnnotation)
+		}
+		for attribute := range filter.Attributes {
+			if !attributeNameRegExp.MatchString(attribute) {
+				return fmt.Errorf("invalid subscriber filter '%s' for subscription '%s': attribute name '%s' must consist of lower-case letters and digits",
+					constants.SubscriberFilterAnnotation, uid, attribute)
+			}
+		}
+	}
+	return nil
+}
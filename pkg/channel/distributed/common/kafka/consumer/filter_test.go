@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+)
+
+// Test The SubscriberFilters() Functionality
+func TestSubscriberFilters(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		annotations map[string]string
+		expected    map[string]eventingv1.TriggerFilter
+		expectErr   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Annotations", annotations: nil, expected: map[string]eventingv1.TriggerFilter{}},
+		{name: "Empty Annotation", annotations: map[string]string{constants.SubscriberFilterAnnotation: " "}, expected: map[string]eventingv1.TriggerFilter{}},
+		{
+			name:        "Valid Annotation",
+			annotations: map[string]string{constants.SubscriberFilterAnnotation: `{"uid-1": {"attributes": {"type": "dev.knative.foo", "source": ""}}, "uid-2": {}}`},
+			expected: map[string]eventingv1.TriggerFilter{
+				"uid-1": {Attributes: eventingv1.TriggerFilterAttributes{"type": "dev.knative.foo", "source": ""}},
+				"uid-2": {},
+			},
+		},
+		{name: "Malformed JSON", annotations: map[string]string{constants.SubscriberFilterAnnotation: `{"uid-1": "type=foo"}`}, expectErr: true},
+		{name: "Unsupported CESQL Filter", annotations: map[string]string{constants.SubscriberFilterAnnotation: `{"uid-1": {"sql": "type = 'foo'"}}`}, expectErr: true},
+		{name: "Non-String Attribute Value", annotations: map[string]string{constants.SubscriberFilterAnnotation: `{"uid-1": {"attributes": {"type": 1}}}`}, expectErr: true},
+		{name: "Invalid Attribute Name", annotations: map[string]string{constants.SubscriberFilterAnnotation: `{"uid-1": {"attributes": {"Content-Type": "foo"}}}`}, expectErr: true},
+		{name: "Empty Subscription UID", annotations: map[string]string{constants.SubscriberFilterAnnotation: `{"": {"attributes": {"type": "foo"}}}`}, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			filters, err := SubscriberFilters(testCase.annotations)
+			if testCase.expectErr {
+				assert.NotNil(t, err)
+				assert.Nil(t, filters)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.expected, filters)
+			}
+		})
+	}
+}
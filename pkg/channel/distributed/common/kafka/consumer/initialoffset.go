@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+//
+// Extract & Validate The Per-Subscription Initial Offset Policy From The Specified (KafkaChannel) Annotations
+//
+// The SubscriberInitialOffset annotation is a JSON map of Subscription UID to an initial offset policy of either
+// "earliest" (consume all events retained in the Kafka topic) or "latest" (consume only events produced after the
+// subscription's ConsumerGroup is created).  The policy only applies while the ConsumerGroup has no committed
+// offsets, and therefore has no effect once a subscription has started consuming.  An empty map is returned if
+// there is none.
+//
+func SubscriberInitialOffsets(annotations map[string]string) (map[string]string, error) {
+
+	// Parse The (Optional) SubscriberInitialOffset Annotation
+	initialOffsets := make(map[string]string)
+	annotation := strings.TrimSpace(annotations[constants.SubscriberInitialOffsetAnnotation])
+	if len(annotation) > 0 {
+		err := json.Unmarshal([]byte(annotation), &initialOffsets)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subscriber initial offset '%s': expected a json map of subscription uid to initial offset policy but found '%s'", constants.SubscriberInitialOffsetAnnotation, annotation)
+		}
+	}
+
+	// Validate The Parsed Initial Offsets
+	err := ValidateSubscriberInitialOffsets(initialOffsets)
+	if err != nil {
+		return nil, err
+	}
+	return initialOffsets, nil
+}
+
+// Validate The Specified Per-Subscription Initial Offset Policies (As Returned By SubscriberInitialOffsets)
+func ValidateSubscriberInitialOffsets(initialOffsets map[string]string) error {
+	for uid, policy := range initialOffsets {
+		if len(strings.TrimSpace(uid)) == 0 {
+			return fmt.Errorf("invalid subscriber initial offset '%s': subscription uid must not be empty", constants.SubscriberInitialOffsetAnnotation)
+		}
+		if policy != constants.SubscriberInitialOffsetEarliest && policy != constants.SubscriberInitialOffsetLatest {
+			return fmt.Errorf("invalid subscriber initial offset '%s' for subscription '%s': expected '%s' or '%s' but found '%s'",
+				constants.SubscriberInitialOffsetAnnotation, uid, constants.SubscriberInitialOffsetEarliest, constants.SubscriberInitialOffsetLatest, policy)
+		}
+	}
+	return nil
+}
+
+// Get A Copy Of The Specified Sarama Config With Consumer.Offsets.Initial Set Per The Initial Offset Policy (Unknown / Empty Returns The Original)
+func InitialOffsetConfig(config *sarama.Config, policy string) *sarama.Config {
+	var initialOffset int64
+	switch policy {
+	case constants.SubscriberInitialOffsetEarliest:
+		initialOffset = sarama.OffsetOldest
+	case constants.SubscriberInitialOffsetLatest:
+		initialOffset = sarama.OffsetNewest
+	default:
+		return config
+	}
+	initialOffsetConfig := *config
+	initialOffsetConfig.Consumer.Offsets.Initial = initialOffset
+	return &initialOffsetConfig
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+// Test The SubscriberInitialOffsets() Functionality
+func TestSubscriberInitialOffsets(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		annotations map[string]string
+		expected    map[string]string
+		expectErr   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Annotations", annotations: nil, expected: map[string]string{}},
+		{name: "Empty Annotation", annotations: map[string]string{constants.SubscriberInitialOffsetAnnotation: " "}, expected: map[string]string{}},
+		{name: "Valid Annotation", annotations: map[string]string{constants.SubscriberInitialOffsetAnnotation: `{"uid-1": "earliest", "uid-2": "latest"}`}, expected: map[string]string{"uid-1": "earliest", "uid-2": "latest"}},
+		{name: "Malformed JSON", annotations: map[string]string{constants.SubscriberInitialOffsetAnnotation: "latest"}, expectErr: true},
+		{name: "Non-String Policy", annotations: map[string]string{constants.SubscriberInitialOffsetAnnotation: `{"uid-1": -1}`}, expectErr: true},
+		{name: "Unknown Policy", annotations: map[string]string{constants.SubscriberInitialOffsetAnnotation: `{"uid-1": "newest"}`}, expectErr: true},
+		{name: "Empty Subscription UID", annotations: map[string]string{constants.SubscriberInitialOffsetAnnotation: `{"": "latest"}`}, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			initialOffsets, err := SubscriberInitialOffsets(testCase.annotations)
+			if testCase.expectErr {
+				assert.NotNil(t, err)
+				assert.Nil(t, initialOffsets)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.expected, initialOffsets)
+			}
+		})
+	}
+}
+
+// Test The InitialOffsetConfig() Functionality
+func TestInitialOffsetConfig(t *testing.T) {
+
+	// Create A Sarama Config With An Oldest (Earliest) Initial Offset
+	config := sarama.NewConfig()
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	// Verify The Policies Map To Copies Of The Config With The Corresponding Initial Offset
+	earliestConfig := InitialOffsetConfig(config, constants.SubscriberInitialOffsetEarliest)
+	assert.NotSame(t, config, earliestConfig)
+	assert.Equal(t, sarama.OffsetOldest, earliestConfig.Consumer.Offsets.Initial)
+	latestConfig := InitialOffsetConfig(config, constants.SubscriberInitialOffsetLatest)
+	assert.NotSame(t, config, latestConfig)
+	assert.Equal(t, sarama.OffsetNewest, latestConfig.Consumer.Offsets.Initial)
+
+	// Verify No Policy Returns The Original (Unmodified) Config
+	assert.Same(t, config, InitialOffsetConfig(config, ""))
+	assert.Equal(t, sarama.OffsetOldest, config.Consumer.Offsets.Initial)
+}
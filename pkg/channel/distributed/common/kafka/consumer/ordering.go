@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+//
+// Extract & Validate The Per-Subscription Ordering Mode From The Specified (KafkaChannel) Annotations
+//
+// The SubscriberOrdering annotation is a JSON map of Subscription UID to an ordering mode of either "ordered"
+// (strictly sequential delivery, blocking the partition on a failed event) or "unordered" (concurrent delivery,
+// continuing past failed events).  Since "ordered" delivery is strictly sequential it is rejected in combination
+// with a SubscriberConcurrency of more than one worker.  An empty map is returned if there is none.
+//
+func SubscriberOrdering(annotations map[string]string) (map[string]string, error) {
+
+	// Parse The (Optional) SubscriberOrdering Annotation
+	ordering := make(map[string]string)
+	annotation := strings.TrimSpace(annotations[constants.SubscriberOrderingAnnotation])
+	if len(annotation) > 0 {
+		err := json.Unmarshal([]byte(annotation), &ordering)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subscriber ordering '%s': expected a json map of subscription uid to ordering mode but found '%s'", constants.SubscriberOrderingAnnotation, annotation)
+		}
+	}
+
+	// Validate The Parsed Ordering
+	err := ValidateSubscriberOrdering(ordering)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject Ordered Subscriptions Which Are Also Configured With Concurrent Workers (Malformed Concurrency Is Reported Separately)
+	concurrency, _ := SubscriberConcurrency(annotations)
+	for uid, mode := range ordering {
+		if mode == constants.SubscriberOrderingOrdered && concurrency[uid] > 1 {
+			return nil, fmt.Errorf("invalid subscriber ordering '%s' for subscription '%s': '%s' delivery is sequential and conflicts with the '%s' of %d workers",
+				constants.SubscriberOrderingAnnotation, uid, constants.SubscriberOrderingOrdered, constants.SubscriberConcurrencyAnnotation, concurrency[uid])
+		}
+	}
+	return ordering, nil
+}
+
+// Validate The Specified Per-Subscription Ordering (As Returned By SubscriberOrdering)
+func ValidateSubscriberOrdering(ordering map[string]string) error {
+	for uid, mode := range ordering {
+		if len(strings.TrimSpace(uid)) == 0 {
+			return fmt.Errorf("invalid subscriber ordering '%s': subscription uid must not be empty", constants.SubscriberOrderingAnnotation)
+		}
+		if mode != constants.SubscriberOrderingOrdered && mode != constants.SubscriberOrderingUnordered {
+			return fmt.Errorf("invalid subscriber ordering '%s' for subscription '%s': expected '%s' or '%s' but found '%s'",
+				constants.SubscriberOrderingAnnotation, uid, constants.SubscriberOrderingOrdered, constants.SubscriberOrderingUnordered, mode)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+// Test The SubscriberOrdering() Functionality
+func TestSubscriberOrdering(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		annotations map[string]string
+		expected    map[string]string
+		expectErr   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Annotations", annotations: nil, expected: map[string]string{}},
+		{name: "Empty Annotation", annotations: map[string]string{constants.SubscriberOrderingAnnotation: " "}, expected: map[string]string{}},
+		{name: "Valid Annotation", annotations: map[string]string{constants.SubscriberOrderingAnnotation: `{"uid-1": "ordered", "uid-2": "unordered"}`}, expected: map[string]string{"uid-1": "ordered", "uid-2": "unordered"}},
+		{name: "Ordered With Single Worker", annotations: map[string]string{constants.SubscriberOrderingAnnotation: `{"uid-1": "ordered"}`, constants.SubscriberConcurrencyAnnotation: `{"uid-1": 1}`}, expected: map[string]string{"uid-1": "ordered"}},
+		{name: "Unordered With Concurrency", annotations: map[string]string{constants.SubscriberOrderingAnnotation: `{"uid-1": "unordered"}`, constants.SubscriberConcurrencyAnnotation: `{"uid-1": 4}`}, expected: map[string]string{"uid-1": "unordered"}},
+		{name: "Ordered With Concurrency", annotations: map[string]string{constants.SubscriberOrderingAnnotation: `{"uid-1": "ordered"}`, constants.SubscriberConcurrencyAnnotation: `{"uid-1": 4}`}, expectErr: true},
+		{name: "Malformed JSON", annotations: map[string]string{constants.SubscriberOrderingAnnotation: "ordered"}, expectErr: true},
+		{name: "Non-String Mode", annotations: map[string]string{constants.SubscriberOrderingAnnotation: `{"uid-1": 1}`}, expectErr: true},
+		{name: "Unknown Mode", annotations: map[string]string{constants.SubscriberOrderingAnnotation: `{"uid-1": "Ordered"}`}, expectErr: true},
+		{name: "Empty Subscription UID", annotations: map[string]string{constants.SubscriberOrderingAnnotation: `{"": "ordered"}`}, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ordering, err := SubscriberOrdering(testCase.annotations)
+			if testCase.expectErr {
+				assert.NotNil(t, err)
+				assert.Nil(t, ordering)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.expected, ordering)
+			}
+		})
+	}
+}
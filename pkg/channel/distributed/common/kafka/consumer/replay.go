@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+// Parse & Validate A Requested Replay Timestamp (RFC3339 & Not In The Future)
+func ParseReplayTimestamp(value string) (time.Time, error) {
+	timestamp, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid replay timestamp '%s': expected an RFC3339 timestamp but found '%s'", constants.ReplayFromTimestampAnnotation, value)
+	}
+	if timestamp.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("invalid replay timestamp '%s': '%s' is in the future", constants.ReplayFromTimestampAnnotation, value)
+	}
+	return timestamp, nil
+}
+
+//
+// Determine The Effective Replay Timestamp From The Specified (KafkaChannel) Annotations
+//
+// A requested replay (the ReplayFromTimestamp annotation) takes precedence over the previously applied replay
+// (the ReplayAppliedTimestamp annotation), which the controller replaces it with once the request has been
+// handed to the Dispatcher.  The zero time is returned if neither is present.
+//
+func ReplayTimestamp(annotations map[string]string) (time.Time, error) {
+	if requested, ok := annotations[constants.ReplayFromTimestampAnnotation]; ok {
+		return ParseReplayTimestamp(requested)
+	}
+	if applied, ok := annotations[constants.ReplayAppliedTimestampAnnotation]; ok {
+		timestamp, err := time.Parse(time.RFC3339, applied)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid applied replay timestamp '%s': expected an RFC3339 timestamp but found '%s'", constants.ReplayAppliedTimestampAnnotation, applied)
+		}
+		return timestamp, nil
+	}
+	return time.Time{}, nil
+}
+
+// Format A Replay Timestamp In Its Canonical Form (Used To Compare Requested & Applied Replays)
+func FormatReplayTimestamp(timestamp time.Time) string {
+	return timestamp.UTC().Format(time.RFC3339Nano)
+}
+
+// The Metadata Committed With A ConsumerGroup's Offsets Once It Has Replayed From The Specified Timestamp
+func ReplayOffsetMetadata(timestamp time.Time) string {
+	return constants.ReplayOffsetMetadataPrefix + FormatReplayTimestamp(timestamp)
+}
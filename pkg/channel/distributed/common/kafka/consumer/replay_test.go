@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+// Test The ReplayTimestamp() Functionality
+func TestReplayTimestamp(t *testing.T) {
+
+	// Test Data
+	requested := time.Date(2020, 11, 12, 13, 14, 15, 0, time.UTC)
+	applied := time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+	future := time.Now().Add(time.Hour).Format(time.RFC3339)
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		annotations map[string]string
+		expected    time.Time
+		expectErr   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Annotations", annotations: nil, expected: time.Time{}},
+		{name: "Requested", annotations: map[string]string{constants.ReplayFromTimestampAnnotation: "2020-11-12T13:14:15Z"}, expected: requested},
+		{name: "Requested With Offset", annotations: map[string]string{constants.ReplayFromTimestampAnnotation: " 2020-11-12T14:14:15+01:00 "}, expected: requested},
+		{name: "Applied", annotations: map[string]string{constants.ReplayAppliedTimestampAnnotation: "2020-11-01T00:00:00Z"}, expected: applied},
+		{
+			name: "Requested Takes Precedence Over Applied",
+			annotations: map[string]string{
+				constants.ReplayFromTimestampAnnotation:    "2020-11-12T13:14:15Z",
+				constants.ReplayAppliedTimestampAnnotation: "2020-11-01T00:00:00Z",
+			},
+			expected: requested,
+		},
+		{name: "Malformed Requested", annotations: map[string]string{constants.ReplayFromTimestampAnnotation: "yesterday"}, expectErr: true},
+		{name: "Future Requested", annotations: map[string]string{constants.ReplayFromTimestampAnnotation: future}, expectErr: true},
+		{name: "Malformed Applied", annotations: map[string]string{constants.ReplayAppliedTimestampAnnotation: "yesterday"}, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			timestamp, err := ReplayTimestamp(testCase.annotations)
+			if testCase.expectErr {
+				assert.NotNil(t, err)
+				assert.True(t, timestamp.IsZero())
+			} else {
+				assert.Nil(t, err)
+				assert.True(t, testCase.expected.Equal(timestamp), "expected %v but found %v", testCase.expected, timestamp)
+			}
+		})
+	}
+}
+
+// Test The Replay Timestamp Formatting Functionality
+func TestReplayOffsetMetadata(t *testing.T) {
+	timestamp := time.Date(2020, 11, 12, 14, 14, 15, 500, time.FixedZone("CET", 3600))
+	assert.Equal(t, "2020-11-12T13:14:15.0000005Z", FormatReplayTimestamp(timestamp))
+	assert.Equal(t, "replay:2020-11-12T13:14:15.0000005Z", ReplayOffsetMetadata(timestamp))
+}
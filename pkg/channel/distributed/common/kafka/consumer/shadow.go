@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// SubscriberShadow Is The Destination To Which A Sample Percentage Of A Subscription's Events Is Mirrored
+type SubscriberShadow struct {
+	duckv1.Destination
+	Percent int32 `json:"percent"`
+}
+
+// ResolvedSubscriberShadow Is A SubscriberShadow Whose Destination Has Been Resolved (By The Controller) Into A URI
+type ResolvedSubscriberShadow struct {
+	URI     *apis.URL `json:"uri"`
+	Percent int32     `json:"percent"`
+}
+
+//
+// Extract & Validate The Per-Subscription Shadows From The Specified (KafkaChannel) Annotations
+//
+// The SubscriberShadow annotation is a JSON map of Subscription UID to a shadow Destination (a "ref" to an
+// Addressable and/or a "uri") and the "percent" (1 - 100) of the Subscription's events to be mirrored to it.
+// Malformed JSON, empty UIDs, invalid Destinations and out of range percentages are all rejected with an
+// error.  An empty map is returned if there is none.
+//
+func SubscriberShadows(annotations map[string]string) (map[string]SubscriberShadow, error) {
+
+	// Parse The (Optional) SubscriberShadow Annotation
+	shadows := make(map[string]SubscriberShadow)
+	annotation := strings.TrimSpace(annotations[constants.SubscriberShadowAnnotation])
+	if len(annotation) > 0 {
+		err := json.Unmarshal([]byte(annotation), &shadows)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subscriber shadow '%s': expected a json map of subscription uid to shadow destination & percent but found '%s'", constants.SubscriberShadowAnnotation, annotation)
+		}
+	}
+
+	// Validate The Parsed Shadows
+	for uid, shadow := range shadows {
+		if len(strings.TrimSpace(uid)) == 0 {
+			return nil, fmt.Errorf("invalid subscriber shadow '%s': subscription uid must not be empty", constants.SubscriberShadowAnnotation)
+		}
+		if fieldErr := shadow.Destination.Validate(context.TODO()); fieldErr != nil {
+			return nil, fmt.Errorf("invalid subscriber shadow '%s' for subscription '%s': %v", constants.SubscriberShadowAnnotation, uid, fieldErr)
+		}
+		err := validateShadowPercent(uid, shadow.Percent)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return shadows, nil
+}
+
+//
+// Extract The Resolved Per-Subscription Shadows From The Specified (KafkaChannel Status) Annotations
+//
+// The SubscriberShadowResolved annotation is maintained by the controller as a JSON map of Subscription UID to
+// the absolute shadow URI and percent.  Malformed JSON, empty UIDs, non-absolute URIs and out of range
+// percentages are all rejected with an error.  An empty map is returned if there is none.
+//
+func ResolvedSubscriberShadows(annotations map[string]string) (map[string]ResolvedSubscriberShadow, error) {
+
+	// Parse The (Optional) SubscriberShadowResolved Annotation
+	shadows := make(map[string]ResolvedSubscriberShadow)
+	annotation := strings.TrimSpace(annotations[constants.SubscriberShadowResolvedAnnotation])
+	if len(annotation) > 0 {
+		err := json.Unmarshal([]byte(annotation), &shadows)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resolved subscriber shadow '%s': expected a json map of subscription uid to shadow uri & percent but found '%s'", constants.SubscriberShadowResolvedAnnotation, annotation)
+		}
+	}
+
+	// Validate The Parsed Shadows
+	for uid, shadow := range shadows {
+		if len(strings.TrimSpace(uid)) == 0 {
+			return nil, fmt.Errorf("invalid resolved subscriber shadow '%s': subscription uid must not be empty", constants.SubscriberShadowResolvedAnnotation)
+		}
+		if shadow.URI == nil || !shadow.URI.URL().IsAbs() || len(shadow.URI.Host) == 0 {
+			return nil, fmt.Errorf("invalid resolved subscriber shadow '%s' for subscription '%s': uri '%s' is not absolute", constants.SubscriberShadowResolvedAnnotation, uid, shadow.URI.String())
+		}
+		err := validateShadowPercent(uid, shadow.Percent)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return shadows, nil
+}
+
+// Validate The Specified Subscription's Shadow Percent Is Within The Range 1 - 100
+func validateShadowPercent(uid string, percent int32) error {
+	if percent < 1 || percent > 100 {
+		return fmt.Errorf("invalid subscriber shadow '%s' for subscription '%s': percent %d must be between 1 and 100", constants.SubscriberShadowAnnotation, uid, percent)
+	}
+	return nil
+}
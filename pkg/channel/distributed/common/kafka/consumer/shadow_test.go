@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// Test The SubscriberShadows() Functionality
+func TestSubscriberShadows(t *testing.T) {
+
+	// Test Data
+	shadowURI, _ := apis.ParseURL("http://shadow.example.com/path")
+	shadowRef := &duckv1.KReference{APIVersion: "serving.knative.dev/v1", Kind: "Service", Name: "shadow", Namespace: "shadow-namespace"}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		annotations map[string]string
+		expected    map[string]SubscriberShadow
+		expectErr   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Annotations", annotations: nil, expected: map[string]SubscriberShadow{}},
+		{name: "Empty Annotation", annotations: map[string]string{constants.SubscriberShadowAnnotation: " "}, expected: map[string]SubscriberShadow{}},
+		{
+			name:        "Valid Annotation",
+			annotations: map[string]string{constants.SubscriberShadowAnnotation: `{"uid-1": {"uri": "http://shadow.example.com/path", "percent": 10}, "uid-2": {"ref": {"apiVersion": "serving.knative.dev/v1", "kind": "Service", "name": "shadow", "namespace": "shadow-namespace"}, "percent": 100}}`},
+			expected: map[string]SubscriberShadow{
+				"uid-1": {Destination: duckv1.Destination{URI: shadowURI}, Percent: 10},
+				"uid-2": {Destination: duckv1.Destination{Ref: shadowRef}, Percent: 100},
+			},
+		},
+		{name: "Malformed JSON", annotations: map[string]string{constants.SubscriberShadowAnnotation: "http://shadow.example.com"}, expectErr: true},
+		{name: "Missing Destination", annotations: map[string]string{constants.SubscriberShadowAnnotation: `{"uid-1": {"percent": 10}}`}, expectErr: true},
+		{name: "Relative URI", annotations: map[string]string{constants.SubscriberShadowAnnotation: `{"uid-1": {"uri": "/path", "percent": 10}}`}, expectErr: true},
+		{name: "Missing Percent", annotations: map[string]string{constants.SubscriberShadowAnnotation: `{"uid-1": {"uri": "http://shadow.example.com"}}`}, expectErr: true},
+		{name: "Percent Too Large", annotations: map[string]string{constants.SubscriberShadowAnnotation: `{"uid-1": {"uri": "http://shadow.example.com", "percent": 101}}`}, expectErr: true},
+		{name: "Empty Subscription UID", annotations: map[string]string{constants.SubscriberShadowAnnotation: `{"": {"uri": "http://shadow.example.com", "percent": 10}}`}, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			shadows, err := SubscriberShadows(testCase.annotations)
+			if testCase.expectErr {
+				assert.NotNil(t, err)
+				assert.Nil(t, shadows)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.expected, shadows)
+			}
+		})
+	}
+}
+
+// Test The ResolvedSubscriberShadows() Functionality
+func TestResolvedSubscriberShadows(t *testing.T) {
+
+	// Test Data
+	shadowURI, _ := apis.ParseURL("http://shadow.shadow-namespace.svc.cluster.local/")
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		annotations map[string]string
+		expected    map[string]ResolvedSubscriberShadow
+		expectErr   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Annotations", annotations: nil, expected: map[string]ResolvedSubscriberShadow{}},
+		{name: "Valid Annotation", annotations: map[string]string{constants.SubscriberShadowResolvedAnnotation: `{"uid-1": {"uri": "http://shadow.shadow-namespace.svc.cluster.local/", "percent": 25}}`}, expected: map[string]ResolvedSubscriberShadow{"uid-1": {URI: shadowURI, Percent: 25}}},
+		{name: "Malformed JSON", annotations: map[string]string{constants.SubscriberShadowResolvedAnnotation: "[]"}, expectErr: true},
+		{name: "Missing URI", annotations: map[string]string{constants.SubscriberShadowResolvedAnnotation: `{"uid-1": {"percent": 25}}`}, expectErr: true},
+		{name: "Relative URI", annotations: map[string]string{constants.SubscriberShadowResolvedAnnotation: `{"uid-1": {"uri": "/path", "percent": 25}}`}, expectErr: true},
+		{name: "Zero Percent", annotations: map[string]string{constants.SubscriberShadowResolvedAnnotation: `{"uid-1": {"uri": "http://shadow.example.com", "percent": 0}}`}, expectErr: true},
+		{name: "Empty Subscription UID", annotations: map[string]string{constants.SubscriberShadowResolvedAnnotation: `{" ": {"uri": "http://shadow.example.com", "percent": 25}}`}, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			shadows, err := ResolvedSubscriberShadows(testCase.annotations)
+			if testCase.expectErr {
+				assert.NotNil(t, err)
+				assert.Nil(t, shadows)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.expected, shadows)
+			}
+		})
+	}
+}
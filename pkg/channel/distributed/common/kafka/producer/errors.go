@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package producer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/Shopify/sarama"
+)
+
+//
+// Kafka Produce Errors
+//
+// Classifies the errors returned when producing to Kafka (e.g. NotEnoughReplicas) into a small set of
+// error types (suitable for tagging metrics) along with the HTTP status code which should be returned
+// to the sender of the event.  Transient broker-side failures are mapped to 503 Service Unavailable so
+// that senders know the event may be retried, rather than the generic 500 Internal Server Error.
+//
+
+// Produce Error Types
+const (
+	ProduceErrorTypeMessageTooLarge    = "message_too_large"
+	ProduceErrorTypeNotEnoughReplicas  = "not_enough_replicas"
+	ProduceErrorTypeLeaderNotAvailable = "leader_not_available"
+	ProduceErrorTypeUnknownTopic       = "unknown_topic_or_partition"
+	ProduceErrorTypeTimeout            = "timeout"
+	ProduceErrorTypeBrokerNotAvailable = "broker_not_available"
+	ProduceErrorTypeAuthorization      = "authorization_failed"
+	ProduceErrorTypeInvalidMessage     = "invalid_message"
+	ProduceErrorTypeUnknown            = "unknown"
+)
+
+// The Known Produce Errors By Type & HTTP Status Code (Anything Else Is Unknown / 500)
+var produceErrorClassifications = []struct {
+	errorType  string
+	statusCode int
+	errs       []error
+}{
+	{ProduceErrorTypeMessageTooLarge, http.StatusRequestEntityTooLarge, []error{sarama.ErrMessageSizeTooLarge}},
+	{ProduceErrorTypeNotEnoughReplicas, http.StatusServiceUnavailable, []error{sarama.ErrNotEnoughReplicas, sarama.ErrNotEnoughReplicasAfterAppend}},
+	{ProduceErrorTypeLeaderNotAvailable, http.StatusServiceUnavailable, []error{sarama.ErrLeaderNotAvailable, sarama.ErrNotLeaderForPartition}},
+	{ProduceErrorTypeUnknownTopic, http.StatusServiceUnavailable, []error{sarama.ErrUnknownTopicOrPartition}},
+	{ProduceErrorTypeTimeout, http.StatusServiceUnavailable, []error{sarama.ErrRequestTimedOut, context.DeadlineExceeded}},
+	{ProduceErrorTypeBrokerNotAvailable, http.StatusServiceUnavailable, []error{sarama.ErrBrokerNotAvailable, sarama.ErrNetworkException, sarama.ErrOutOfBrokers, sarama.ErrNotConnected, sarama.ErrShuttingDown}},
+	{ProduceErrorTypeAuthorization, http.StatusInternalServerError, []error{sarama.ErrTopicAuthorizationFailed, sarama.ErrClusterAuthorizationFailed}},
+	{ProduceErrorTypeInvalidMessage, http.StatusBadRequest, []error{sarama.ErrInvalidMessage}},
+}
+
+// ProduceError Wraps The Underlying Kafka / Sarama Produce Error With Its Type & HTTP Status Code
+type ProduceError struct {
+	ErrorType  string
+	StatusCode int
+	Err        error
+}
+
+// Create A New ProduceError Classifying The Specified Error (Which Remains Available Via errors.Is / errors.As)
+func NewProduceError(err error) *ProduceError {
+	for _, classification := range produceErrorClassifications {
+		for _, classifiedErr := range classification.errs {
+			if errors.Is(err, classifiedErr) {
+				return &ProduceError{ErrorType: classification.errorType, StatusCode: classification.statusCode, Err: err}
+			}
+		}
+	}
+	return &ProduceError{ErrorType: ProduceErrorTypeUnknown, StatusCode: http.StatusInternalServerError, Err: err}
+}
+
+// Error Implements The error Interface By Returning The Underlying Error's Message
+func (e *ProduceError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap Returns The Underlying Error (Supports errors.Is Checks Against The Sarama Errors)
+func (e *ProduceError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test The NewProduceError() Functionality
+func TestNewProduceError(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name               string
+		err                error
+		expectedErrorType  string
+		expectedStatusCode int
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Message Too Large", err: sarama.ErrMessageSizeTooLarge, expectedErrorType: ProduceErrorTypeMessageTooLarge, expectedStatusCode: http.StatusRequestEntityTooLarge},
+		{name: "Wrapped Message Too Large", err: fmt.Errorf("wrapped: %w", sarama.ErrMessageSizeTooLarge), expectedErrorType: ProduceErrorTypeMessageTooLarge, expectedStatusCode: http.StatusRequestEntityTooLarge},
+		{name: "Not Enough Replicas", err: sarama.ErrNotEnoughReplicas, expectedErrorType: ProduceErrorTypeNotEnoughReplicas, expectedStatusCode: http.StatusServiceUnavailable},
+		{name: "Not Enough Replicas After Append", err: sarama.ErrNotEnoughReplicasAfterAppend, expectedErrorType: ProduceErrorTypeNotEnoughReplicas, expectedStatusCode: http.StatusServiceUnavailable},
+		{name: "Not Leader For Partition", err: sarama.ErrNotLeaderForPartition, expectedErrorType: ProduceErrorTypeLeaderNotAvailable, expectedStatusCode: http.StatusServiceUnavailable},
+		{name: "Unknown Topic", err: sarama.ErrUnknownTopicOrPartition, expectedErrorType: ProduceErrorTypeUnknownTopic, expectedStatusCode: http.StatusServiceUnavailable},
+		{name: "Request Timed Out", err: sarama.ErrRequestTimedOut, expectedErrorType: ProduceErrorTypeTimeout, expectedStatusCode: http.StatusServiceUnavailable},
+		{name: "Deadline Exceeded", err: context.DeadlineExceeded, expectedErrorType: ProduceErrorTypeTimeout, expectedStatusCode: http.StatusServiceUnavailable},
+		{name: "Out Of Brokers", err: sarama.ErrOutOfBrokers, expectedErrorType: ProduceErrorTypeBrokerNotAvailable, expectedStatusCode: http.StatusServiceUnavailable},
+		{name: "Topic Authorization Failed", err: sarama.ErrTopicAuthorizationFailed, expectedErrorType: ProduceErrorTypeAuthorization, expectedStatusCode: http.StatusInternalServerError},
+		{name: "Invalid Message", err: sarama.ErrInvalidMessage, expectedErrorType: ProduceErrorTypeInvalidMessage, expectedStatusCode: http.StatusBadRequest},
+		{name: "Unknown", err: errors.New("test error"), expectedErrorType: ProduceErrorTypeUnknown, expectedStatusCode: http.StatusInternalServerError},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			produceError := NewProduceError(testCase.err)
+			assert.NotNil(t, produceError)
+			assert.Equal(t, testCase.expectedErrorType, produceError.ErrorType)
+			assert.Equal(t, testCase.expectedStatusCode, produceError.StatusCode)
+			assert.Equal(t, testCase.err.Error(), produceError.Error())
+			assert.True(t, errors.Is(produceError, testCase.err))
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+)
+
+//
+// Kafka Broker Discovery Via DNS SRV Records
+//
+// By default the Kafka brokers are the static list from the Kafka Secret.  When a DNS SRV record name is
+// configured the brokers are instead resolved from its targets (host:port), and periodically re-resolved
+// so that new brokers are picked up.  Failed (or empty) resolutions never replace the brokers, so that the
+// last known good list (initially the static list) continues to be used until the SRV record recovers.
+//
+
+// The Maximum Time Allowed For A Single DNS SRV Lookup
+const srvLookupTimeout = 5 * time.Second
+
+// SRVResolver Is The Subset Of The net.Resolver Used To Look Up The Broker SRV Record (Facilitates Unit Testing)
+type SRVResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// SRV Resolver Wrapper To Facilitate Unit Testing
+var srvResolverWrapper SRVResolver = net.DefaultResolver
+
+// BrokerResolver Tracks The Current (Last Known Good) Kafka Brokers
+type BrokerResolver struct {
+	logger    *zap.Logger
+	resolver  SRVResolver
+	srvRecord string
+	interval  time.Duration
+	brokers   []string
+	mutex     *sync.RWMutex
+}
+
+// Create A New BrokerResolver, Initially Resolving Any Configured SRV Record (Falling Back To The Static Brokers)
+func NewBrokerResolver(logger *zap.Logger, config commonconfig.EKBrokerDiscoveryConfig, staticBrokers []string) *BrokerResolver {
+
+	// Default The Refresh Interval If Not Specified
+	refreshIntervalSeconds := config.RefreshIntervalSeconds
+	if refreshIntervalSeconds <= 0 {
+		refreshIntervalSeconds = commonconfig.BrokerDiscoveryRefreshIntervalSecondsDefault
+	}
+
+	// Create The BrokerResolver With The Static Brokers
+	resolver := &BrokerResolver{
+		logger:    logger,
+		resolver:  srvResolverWrapper,
+		srvRecord: strings.TrimSpace(config.SrvRecord),
+		interval:  time.Duration(refreshIntervalSeconds) * time.Second,
+		brokers:   staticBrokers,
+		mutex:     &sync.RWMutex{},
+	}
+
+	// Perform The Initial Resolution (If Enabled)
+	if resolver.Enabled() {
+		logger.Info("Resolving Kafka Brokers From DNS SRV Record", zap.String("SrvRecord", resolver.srvRecord), zap.Duration("RefreshInterval", resolver.interval))
+		resolver.Refresh(context.Background())
+	}
+	return resolver
+}
+
+// Determine Whether The Brokers Are Resolved From A DNS SRV Record
+func (r *BrokerResolver) Enabled() bool {
+	return len(r.srvRecord) > 0
+}
+
+// Get (A Copy Of) The Current Kafka Brokers
+func (r *BrokerResolver) Brokers() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return append([]string{}, r.brokers...)
+}
+
+// Re-Resolve The Brokers From The SRV Record (Retaining The Last Known Good Brokers On Failure) & Return Whether They Changed
+func (r *BrokerResolver) Refresh(ctx context.Context) bool {
+
+	// Nothing To Refresh Without An SRV Record
+	if !r.Enabled() {
+		return false
+	}
+
+	// Look Up The SRV Record (The Name Is Queried Directly As The Service & Proto Are Empty)
+	lookupCtx, cancel := context.WithTimeout(ctx, srvLookupTimeout)
+	defer cancel()
+	_, records, err := r.resolver.LookupSRV(lookupCtx, "", "", r.srvRecord)
+	if err != nil || len(records) == 0 {
+		r.logger.Warn("Failed To Resolve Kafka Brokers From DNS SRV Record - Using Last Known Good Brokers",
+			zap.String("SrvRecord", r.srvRecord), zap.Strings("Brokers", r.Brokers()), zap.Int("Records", len(records)), zap.Error(err))
+		return false
+	}
+
+	// Convert The SRV Targets Into A Sorted Broker List (SRV Ordering Is Randomized By Weight)
+	brokers := make([]string, 0, len(records))
+	for _, record := range records {
+		brokers = append(brokers, net.JoinHostPort(strings.TrimSuffix(record.Target, "."), strconv.Itoa(int(record.Port))))
+	}
+	sort.Strings(brokers)
+
+	// Update The Brokers If They Changed
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if reflect.DeepEqual(brokers, r.brokers) {
+		return false
+	}
+	r.logger.Info("Resolved Kafka Brokers From DNS SRV Record", zap.String("SrvRecord", r.srvRecord), zap.Strings("Previous", r.brokers), zap.Strings("Current", brokers))
+	r.brokers = brokers
+	return true
+}
+
+// Periodically Re-Resolve The Brokers Until The Context Is Done, Calling The Handler Whenever They Change (Blocking)
+func (r *BrokerResolver) Run(ctx context.Context, brokersChanged func(brokers []string)) {
+
+	// Nothing To Run Without An SRV Record
+	if !r.Enabled() {
+		return
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if r.Refresh(ctx) {
+				brokersChanged(r.Brokers())
+			}
+		}
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test Data
+const testSrvRecord = "_kafka._tcp.kafka.example.com"
+
+var testStaticBrokers = []string{"static-broker:9092"}
+
+// Mock SRVResolver Returning The Configured Records / Error
+type mockSRVResolver struct {
+	mutex   sync.Mutex
+	records []*net.SRV
+	err     error
+	lookups int
+}
+
+func (m *mockSRVResolver) LookupSRV(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.lookups++
+	if service != "" || proto != "" || name != testSrvRecord {
+		return "", nil, errors.New("unexpected srv lookup")
+	}
+	return name, m.records, m.err
+}
+
+func (m *mockSRVResolver) set(records []*net.SRV, err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.records = records
+	m.err = err
+}
+
+func (m *mockSRVResolver) lookupCount() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.lookups
+}
+
+// Replace The srvResolverWrapper With The Specified Mock
+func stubSRVResolverWrapper(resolver SRVResolver) {
+	srvResolverWrapper = resolver
+}
+
+// Restore The Original srvResolverWrapper
+func restoreSRVResolverWrapper() {
+	srvResolverWrapper = net.DefaultResolver
+}
+
+// Test The BrokerResolver With No SRV Record Uses The Static Brokers
+func TestBrokerResolverDisabled(t *testing.T) {
+	mockResolver := &mockSRVResolver{}
+	stubSRVResolverWrapper(mockResolver)
+	defer restoreSRVResolverWrapper()
+
+	resolver := NewBrokerResolver(logtesting.TestLogger(t).Desugar(), commonconfig.EKBrokerDiscoveryConfig{SrvRecord: "  "}, testStaticBrokers)
+	assert.False(t, resolver.Enabled())
+	assert.Equal(t, testStaticBrokers, resolver.Brokers())
+	assert.False(t, resolver.Refresh(context.TODO()))
+	resolver.Run(context.TODO(), func([]string) { t.Fatal("unexpected brokers change") }) // Returns Immediately
+	assert.Equal(t, 0, mockResolver.lookupCount())
+}
+
+// Test The BrokerResolver Resolves, Re-Resolves & Falls Back To The Last Known Good Brokers
+func TestBrokerResolverRefresh(t *testing.T) {
+
+	// Initial Resolution Failure Falls Back To The Static Brokers
+	mockResolver := &mockSRVResolver{err: errors.New("test dns failure")}
+	stubSRVResolverWrapper(mockResolver)
+	defer restoreSRVResolverWrapper()
+	resolver := NewBrokerResolver(logtesting.TestLogger(t).Desugar(), commonconfig.EKBrokerDiscoveryConfig{SrvRecord: testSrvRecord}, testStaticBrokers)
+	assert.True(t, resolver.Enabled())
+	assert.Equal(t, commonconfig.BrokerDiscoveryRefreshIntervalSecondsDefault*time.Second, resolver.interval)
+	assert.Equal(t, testStaticBrokers, resolver.Brokers())
+	assert.Equal(t, 1, mockResolver.lookupCount())
+
+	// Successful Resolution Replaces The Brokers (Sorted, Without The Trailing Dot)
+	mockResolver.set([]*net.SRV{
+		{Target: "kafka-1.kafka.example.com.", Port: 9093},
+		{Target: "kafka-0.kafka.example.com.", Port: 9093},
+	}, nil)
+	assert.True(t, resolver.Refresh(context.TODO()))
+	assert.Equal(t, []string{"kafka-0.kafka.example.com:9093", "kafka-1.kafka.example.com:9093"}, resolver.Brokers())
+
+	// Unchanged Resolution (In A Different Order) Is Not A Change
+	mockResolver.set([]*net.SRV{
+		{Target: "kafka-0.kafka.example.com.", Port: 9093},
+		{Target: "kafka-1.kafka.example.com.", Port: 9093},
+	}, nil)
+	assert.False(t, resolver.Refresh(context.TODO()))
+
+	// Failed & Empty Resolutions Retain The Last Known Good Brokers
+	mockResolver.set(nil, errors.New("test dns failure"))
+	assert.False(t, resolver.Refresh(context.TODO()))
+	mockResolver.set([]*net.SRV{}, nil)
+	assert.False(t, resolver.Refresh(context.TODO()))
+	assert.Equal(t, []string{"kafka-0.kafka.example.com:9093", "kafka-1.kafka.example.com:9093"}, resolver.Brokers())
+
+	// Verify The Returned Brokers Are A Copy
+	brokers := resolver.Brokers()
+	brokers[0] = "modified"
+	assert.Equal(t, "kafka-0.kafka.example.com:9093", resolver.Brokers()[0])
+}
+
+// Test The BrokerResolver Run() Periodically Re-Resolves & Reports Changed Brokers
+func TestBrokerResolverRun(t *testing.T) {
+
+	// Create A BrokerResolver With A Successful Initial Resolution
+	mockResolver := &mockSRVResolver{records: []*net.SRV{{Target: "kafka-0.kafka.example.com.", Port: 9093}}}
+	stubSRVResolverWrapper(mockResolver)
+	defer restoreSRVResolverWrapper()
+	resolver := NewBrokerResolver(logtesting.TestLogger(t).Desugar(), commonconfig.EKBrokerDiscoveryConfig{SrvRecord: testSrvRecord, RefreshIntervalSeconds: 30}, testStaticBrokers)
+	assert.Equal(t, 30*time.Second, resolver.interval)
+	assert.Equal(t, []string{"kafka-0.kafka.example.com:9093"}, resolver.Brokers())
+	resolver.interval = 5 * time.Millisecond
+
+	// Add A Broker To The SRV Record & Run Until The Change Is Reported
+	mockResolver.set([]*net.SRV{{Target: "kafka-0.kafka.example.com.", Port: 9093}, {Target: "kafka-1.kafka.example.com.", Port: 9093}}, nil)
+	ctx, cancel := context.WithCancel(context.TODO())
+	changedBrokers := make(chan []string, 1)
+	done := make(chan struct{})
+	go func() {
+		resolver.Run(ctx, func(brokers []string) { changedBrokers <- brokers })
+		close(done)
+	}()
+	select {
+	case brokers := <-changedBrokers:
+		assert.Equal(t, []string{"kafka-0.kafka.example.com:9093", "kafka-1.kafka.example.com:9093"}, brokers)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for brokers change")
+	}
+
+	// Verify Run() Returns When The Context Is Done
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Run() to return")
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+//
+// Extract & Validate The Per-Channel Consumer Config Overrides From The Specified Annotations
+//
+// Annotations of the form "kafka.eventing.knative.dev/consumer.<key>" are returned keyed by <key>.
+// Unknown keys, non-numeric / non-positive values, and a heartbeat interval which is not less than
+// the session timeout are all rejected with an error.  The overrides are validated against the specified
+// (ConfigMap-derived) Sarama config used by the Dispatcher (or the Sarama defaults if nil), which itself
+// is left unchanged, so that the session timeout & heartbeat interval are compared with the values they
+// will actually be combined with.  An empty map is returned if there are none.
+//
+func ConsumerConfigOverrides(annotations map[string]string, config *sarama.Config) (map[string]string, error) {
+
+	// Extract The Consumer Config Override Annotations
+	overrides := make(map[string]string)
+	for annotation, value := range annotations {
+		if strings.HasPrefix(annotation, constants.ConsumerConfigAnnotationPrefix) {
+			overrides[strings.TrimPrefix(annotation, constants.ConsumerConfigAnnotationPrefix)] = strings.TrimSpace(value)
+		}
+	}
+
+	// Validate The Overrides By Applying Them To A Copy Of The Sarama Config
+	if config == nil {
+		config = sarama.NewConfig()
+	}
+	validationConfig := *config
+	err := UpdateSaramaConfigConsumerOverrides(&validationConfig, overrides)
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+//
+// Apply The Specified Per-Channel Consumer Config Overrides (As Returned By ConsumerConfigOverrides) To The Sarama Config
+//
+// The overrides are applied atomically, so that the Sarama config is left unchanged if any are invalid.
+//
+func UpdateSaramaConfigConsumerOverrides(config *sarama.Config, overrides map[string]string) error {
+
+	// Parse & Apply Each Of The Overrides To A Copy Of The Sarama Config
+	updatedConfig := *config
+	for key, value := range overrides {
+		number, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || number <= 0 {
+			return fmt.Errorf("invalid consumer config override '%s%s': expected a positive integer but found '%s'", constants.ConsumerConfigAnnotationPrefix, key, value)
+		}
+		switch key {
+		case constants.ConsumerConfigFetchMax:
+			updatedConfig.Consumer.Fetch.Max = int32(number)
+		case constants.ConsumerConfigSessionTimeoutMs:
+			updatedConfig.Consumer.Group.Session.Timeout = time.Duration(number) * time.Millisecond
+		case constants.ConsumerConfigHeartbeatIntervalMs:
+			updatedConfig.Consumer.Group.Heartbeat.Interval = time.Duration(number) * time.Millisecond
+		default:
+			return fmt.Errorf("unsupported consumer config override '%s%s': expected one of %s, %s, or %s", constants.ConsumerConfigAnnotationPrefix, key,
+				constants.ConsumerConfigFetchMax, constants.ConsumerConfigSessionTimeoutMs, constants.ConsumerConfigHeartbeatIntervalMs)
+		}
+	}
+
+	// The Heartbeat Interval Must Be Less Than The Session Timeout
+	if updatedConfig.Consumer.Group.Heartbeat.Interval >= updatedConfig.Consumer.Group.Session.Timeout {
+		return fmt.Errorf("invalid consumer config overrides: heartbeat interval (%v) must be less than the session timeout (%v)",
+			updatedConfig.Consumer.Group.Heartbeat.Interval, updatedConfig.Consumer.Group.Session.Timeout)
+	}
+	*config = updatedConfig
+	return nil
+}
+
+//
+// Apply The ConfigMap's Consumer Tunables (KafkaSource Parity) To The Sarama Config
+//
+// The maxPollRecords is the number of messages Sarama fetches ahead & buffers (ChannelBufferSize), while the
+// heartbeatInterval & maxProcessingTime map directly onto the equivalent Consumer fields.  Unspecified tunables
+// retain the existing (Sarama default) values.  Negative record counts, unparseable / non-positive durations and a
+// resulting heartbeat interval which is not less than the session timeout are all rejected with an error.
+//
+func UpdateSaramaConfigConsumer(config *sarama.Config, consumer commonconfig.EKConsumerConfig) error {
+
+	// Parse & Apply Each Of The Specified Tunables
+	if consumer.MaxPollRecords < 0 {
+		return fmt.Errorf("maxPollRecords must not be negative but found %d", consumer.MaxPollRecords)
+	} else if consumer.MaxPollRecords > 0 {
+		config.ChannelBufferSize = consumer.MaxPollRecords
+	}
+	if len(consumer.HeartbeatInterval) > 0 {
+		heartbeatInterval, err := parseConsumerDuration("heartbeatInterval", consumer.HeartbeatInterval)
+		if err != nil {
+			return err
+		}
+		config.Consumer.Group.Heartbeat.Interval = heartbeatInterval
+	}
+	if len(consumer.MaxProcessingTime) > 0 {
+		maxProcessingTime, err := parseConsumerDuration("maxProcessingTime", consumer.MaxProcessingTime)
+		if err != nil {
+			return err
+		}
+		config.Consumer.MaxProcessingTime = maxProcessingTime
+	}
+
+	// The Heartbeat Interval Must Be Less Than The Session Timeout
+	if config.Consumer.Group.Heartbeat.Interval >= config.Consumer.Group.Session.Timeout {
+		return fmt.Errorf("heartbeatInterval (%v) must be less than the Sarama Consumer.Group.Session.Timeout (%v)",
+			config.Consumer.Group.Heartbeat.Interval, config.Consumer.Group.Session.Timeout)
+	}
+	return nil
+}
+
+//
+// Validate The Sarama Config's Consumer Fetch Settings
+//
+// The Consumer.Fetch.Min (bytes the broker waits to accumulate before answering a fetch), Consumer.Fetch.Default
+// (bytes requested per partition in each fetch) and Consumer.MaxWaitTime (the longest the broker waits for the
+// Consumer.Fetch.Min bytes) together determine the latency / throughput tradeoff of the ConsumerGroups.  The
+// sizes must be positive with Consumer.Fetch.Min no larger than Consumer.Fetch.Default, and Consumer.MaxWaitTime
+// must be a positive duration.
+//
+func ValidateSaramaConfigConsumerFetch(config *sarama.Config) error {
+	if config.Consumer.Fetch.Min <= 0 {
+		return fmt.Errorf("the Sarama Consumer.Fetch.Min (%d) must be positive", config.Consumer.Fetch.Min)
+	}
+	if config.Consumer.Fetch.Default <= 0 {
+		return fmt.Errorf("the Sarama Consumer.Fetch.Default (%d) must be positive", config.Consumer.Fetch.Default)
+	}
+	if config.Consumer.Fetch.Min > config.Consumer.Fetch.Default {
+		return fmt.Errorf("the Sarama Consumer.Fetch.Min (%d) must not be greater than Consumer.Fetch.Default (%d)", config.Consumer.Fetch.Min, config.Consumer.Fetch.Default)
+	}
+	if config.Consumer.MaxWaitTime <= 0 {
+		return fmt.Errorf("the Sarama Consumer.MaxWaitTime (%v) must be a positive duration", config.Consumer.MaxWaitTime)
+	}
+	return nil
+}
+
+// Parse A Single Positive Consumer Duration (e.g. "3s")
+func parseConsumerDuration(name string, value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s '%s' - expected a duration such as '3s': %v", name, value, err)
+	} else if duration <= 0 {
+		return 0, fmt.Errorf("invalid %s '%s' - must be a positive duration", name, value)
+	}
+	return duration, nil
+}
+
+// Utility Function For Setting The Kafka Rack ID In The Sarama Config (Returns False If The Kafka Version Does Not Support It)
+func UpdateSaramaConfigRackId(config *sarama.Config, rackId string) bool {
+	config.RackID = rackId
+	return len(rackId) <= 0 || config.Version.IsAtLeast(sarama.V2_3_0_0)
+}
+
+//
+// Apply The Dispatcher Offset Commit Strategy & Interval To The Sarama Config
+//
+// The "auto" strategy (the default) leaves Sarama committing the marked offsets in the background every interval.
+// The "manual-after-ack" strategy disables Sarama's automatic commits, as the Dispatcher instead commits them
+// itself once the messages have been acknowledged.  Any interval is still set (Sarama ignores it when automatic
+// commits are disabled) so that changing it is detected as a consumer configuration change.
+//
+func UpdateSaramaConfigOffsetCommit(config *sarama.Config, offsetCommit commonconfig.EKOffsetCommitConfig) {
+	config.Consumer.Offsets.AutoCommit.Enable = offsetCommit.Strategy != commonconfig.OffsetCommitStrategyManualAfterAck
+	if offsetCommit.IntervalMillis > 0 {
+		config.Consumer.Offsets.AutoCommit.Interval = time.Duration(offsetCommit.IntervalMillis) * time.Millisecond
+	}
+}
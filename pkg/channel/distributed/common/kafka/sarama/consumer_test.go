@@ -0,0 +1,240 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+// Test The ConsumerConfigOverrides() Functionality
+func TestConsumerConfigOverrides(t *testing.T) {
+
+	// Test Data
+	fetchMax := constants.ConsumerConfigAnnotationPrefix + constants.ConsumerConfigFetchMax
+	sessionTimeout := constants.ConsumerConfigAnnotationPrefix + constants.ConsumerConfigSessionTimeoutMs
+	heartbeatInterval := constants.ConsumerConfigAnnotationPrefix + constants.ConsumerConfigHeartbeatIntervalMs
+
+	// ConfigMap-Derived Sarama Config With A Longer Heartbeat Interval Than The Sarama Default
+	configMapConfig := sarama.NewConfig()
+	configMapConfig.Consumer.Group.Heartbeat.Interval = 6 * time.Second
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name          string
+		annotations   map[string]string
+		config        *sarama.Config
+		wantOverrides map[string]string
+		wantErr       bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:          "Nil Annotations",
+			wantOverrides: map[string]string{},
+		},
+		{
+			name:          "Unrelated Annotations",
+			annotations:   map[string]string{"foo": "bar"},
+			wantOverrides: map[string]string{},
+		},
+		{
+			name:          "Valid Overrides",
+			annotations:   map[string]string{"foo": "bar", fetchMax: " 1048576 ", sessionTimeout: "30000", heartbeatInterval: "5000"},
+			wantOverrides: map[string]string{constants.ConsumerConfigFetchMax: "1048576", constants.ConsumerConfigSessionTimeoutMs: "30000", constants.ConsumerConfigHeartbeatIntervalMs: "5000"},
+		},
+		{
+			name:        "Non-Numeric Value",
+			annotations: map[string]string{fetchMax: "lots"},
+			wantErr:     true,
+		},
+		{
+			name:        "Non-Positive Value",
+			annotations: map[string]string{sessionTimeout: "0"},
+			wantErr:     true,
+		},
+		{
+			name:        "Unknown Key",
+			annotations: map[string]string{constants.ConsumerConfigAnnotationPrefix + "unknown": "1"},
+			wantErr:     true,
+		},
+		{
+			name:        "Heartbeat Not Less Than Session Timeout",
+			annotations: map[string]string{sessionTimeout: "2000"},
+			wantErr:     true,
+		},
+		{
+			name:          "Valid Against ConfigMap Config",
+			annotations:   map[string]string{sessionTimeout: "8000"},
+			config:        configMapConfig,
+			wantOverrides: map[string]string{constants.ConsumerConfigSessionTimeoutMs: "8000"},
+		},
+		{
+			name:        "Session Timeout Not Greater Than ConfigMap Heartbeat",
+			annotations: map[string]string{sessionTimeout: "5000"},
+			config:      configMapConfig,
+			wantErr:     true,
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			overrides, err := ConsumerConfigOverrides(testCase.annotations, testCase.config)
+			assert.Equal(t, testCase.wantErr, err != nil)
+			assert.Equal(t, testCase.wantOverrides, overrides)
+			assert.Equal(t, 6*time.Second, configMapConfig.Consumer.Group.Heartbeat.Interval)
+			assert.Equal(t, sarama.NewConfig().Consumer.Group.Session.Timeout, configMapConfig.Consumer.Group.Session.Timeout)
+		})
+	}
+}
+
+// Test The UpdateSaramaConfigConsumerOverrides() Functionality
+func TestUpdateSaramaConfigConsumerOverrides(t *testing.T) {
+
+	// Verify No Overrides Leaves The Config Untouched
+	config := sarama.NewConfig()
+	assert.Nil(t, UpdateSaramaConfigConsumerOverrides(config, nil))
+	assert.True(t, ConfigEqual(sarama.NewConfig(), config))
+
+	// Verify The Overrides Are Applied
+	overrides := map[string]string{
+		constants.ConsumerConfigFetchMax:            "1048576",
+		constants.ConsumerConfigSessionTimeoutMs:    "30000",
+		constants.ConsumerConfigHeartbeatIntervalMs: "5000",
+	}
+	assert.Nil(t, UpdateSaramaConfigConsumerOverrides(config, overrides))
+	assert.Equal(t, int32(1048576), config.Consumer.Fetch.Max)
+	assert.Equal(t, 30*time.Second, config.Consumer.Group.Session.Timeout)
+	assert.Equal(t, 5*time.Second, config.Consumer.Group.Heartbeat.Interval)
+	assert.Nil(t, config.Validate())
+
+	// Verify Out Of Range Values Are Rejected
+	assert.NotNil(t, UpdateSaramaConfigConsumerOverrides(config, map[string]string{constants.ConsumerConfigFetchMax: "99999999999"}))
+
+	// Verify Invalid Overrides Leave The Config Untouched (Not Partially Applied)
+	assert.NotNil(t, UpdateSaramaConfigConsumerOverrides(config, map[string]string{
+		constants.ConsumerConfigFetchMax:         "2048",
+		constants.ConsumerConfigSessionTimeoutMs: "1000",
+	}))
+	assert.Equal(t, int32(1048576), config.Consumer.Fetch.Max)
+	assert.Equal(t, 30*time.Second, config.Consumer.Group.Session.Timeout)
+}
+
+// Test The UpdateSaramaConfigConsumer() Functionality
+func TestUpdateSaramaConfigConsumer(t *testing.T) {
+
+	// Verify No Tunables Leaves The Config Untouched
+	config := sarama.NewConfig()
+	assert.Nil(t, UpdateSaramaConfigConsumer(config, commonconfig.EKConsumerConfig{}))
+	assert.True(t, ConfigEqual(sarama.NewConfig(), config))
+
+	// Verify The Tunables Are Applied
+	assert.Nil(t, UpdateSaramaConfigConsumer(config, commonconfig.EKConsumerConfig{MaxPollRecords: 500, HeartbeatInterval: "1500ms", MaxProcessingTime: "2s"}))
+	assert.Equal(t, 500, config.ChannelBufferSize)
+	assert.Equal(t, 1500*time.Millisecond, config.Consumer.Group.Heartbeat.Interval)
+	assert.Equal(t, 2*time.Second, config.Consumer.MaxProcessingTime)
+	assert.Nil(t, config.Validate())
+
+	// Verify Invalid Tunables & Combinations Are Rejected
+	assert.NotNil(t, UpdateSaramaConfigConsumer(sarama.NewConfig(), commonconfig.EKConsumerConfig{MaxPollRecords: -1}))
+	assert.NotNil(t, UpdateSaramaConfigConsumer(sarama.NewConfig(), commonconfig.EKConsumerConfig{HeartbeatInterval: "3"}))
+	assert.NotNil(t, UpdateSaramaConfigConsumer(sarama.NewConfig(), commonconfig.EKConsumerConfig{MaxProcessingTime: "0s"}))
+	assert.NotNil(t, UpdateSaramaConfigConsumer(sarama.NewConfig(), commonconfig.EKConsumerConfig{HeartbeatInterval: "1m"}))
+}
+
+// Test The ValidateSaramaConfigConsumerFetch() Functionality
+func TestValidateSaramaConfigConsumerFetch(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		fetchMin    int32
+		fetchDef    int32
+		maxWaitTime time.Duration
+		wantErr     bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Sarama Defaults", fetchMin: 1, fetchDef: 1024 * 1024, maxWaitTime: 250 * time.Millisecond},
+		{name: "Min Equals Default", fetchMin: 65536, fetchDef: 65536, maxWaitTime: 500 * time.Millisecond},
+		{name: "Zero Min", fetchMin: 0, fetchDef: 1024 * 1024, maxWaitTime: 250 * time.Millisecond, wantErr: true},
+		{name: "Negative Default", fetchMin: 1, fetchDef: -1, maxWaitTime: 250 * time.Millisecond, wantErr: true},
+		{name: "Min Greater Than Default", fetchMin: 2048, fetchDef: 1024, maxWaitTime: 250 * time.Millisecond, wantErr: true},
+		{name: "Zero MaxWaitTime", fetchMin: 1, fetchDef: 1024 * 1024, maxWaitTime: 0, wantErr: true},
+		{name: "Negative MaxWaitTime", fetchMin: 1, fetchDef: 1024 * 1024, maxWaitTime: -time.Second, wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := sarama.NewConfig()
+			config.Consumer.Fetch.Min = testCase.fetchMin
+			config.Consumer.Fetch.Default = testCase.fetchDef
+			config.Consumer.MaxWaitTime = testCase.maxWaitTime
+			err := ValidateSaramaConfigConsumerFetch(config)
+			assert.Equal(t, testCase.wantErr, err != nil)
+		})
+	}
+}
+
+// Test The UpdateSaramaConfigRackId() Functionality
+func TestUpdateSaramaConfigRackId(t *testing.T) {
+
+	// Verify The Rack ID Is Set & Supported With A Sufficient Kafka Version
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_3_0_0
+	assert.True(t, UpdateSaramaConfigRackId(config, "us-east-1a"))
+	assert.Equal(t, "us-east-1a", config.RackID)
+
+	// Verify The Rack ID Is Set But Not Supported With An Older Kafka Version
+	config.Version = sarama.V2_0_0_0
+	assert.False(t, UpdateSaramaConfigRackId(config, "us-east-1b"))
+	assert.Equal(t, "us-east-1b", config.RackID)
+
+	// Verify An Empty Rack ID Is Always Supported
+	assert.True(t, UpdateSaramaConfigRackId(config, ""))
+	assert.Equal(t, "", config.RackID)
+}
+
+// Test The UpdateSaramaConfigOffsetCommit() Functionality
+func TestUpdateSaramaConfigOffsetCommit(t *testing.T) {
+
+	// Verify The Default (Auto) Strategy Leaves Sarama's Automatic Commits Unchanged
+	config := sarama.NewConfig()
+	UpdateSaramaConfigOffsetCommit(config, commonconfig.EKOffsetCommitConfig{})
+	assert.True(t, config.Consumer.Offsets.AutoCommit.Enable)
+	assert.Equal(t, time.Second, config.Consumer.Offsets.AutoCommit.Interval)
+
+	// Verify The Auto Strategy With An Interval
+	UpdateSaramaConfigOffsetCommit(config, commonconfig.EKOffsetCommitConfig{Strategy: commonconfig.OffsetCommitStrategyAuto, IntervalMillis: 5000})
+	assert.True(t, config.Consumer.Offsets.AutoCommit.Enable)
+	assert.Equal(t, 5*time.Second, config.Consumer.Offsets.AutoCommit.Interval)
+
+	// Verify The Manual-After-Ack Strategy Disables Sarama's Automatic Commits
+	UpdateSaramaConfigOffsetCommit(config, commonconfig.EKOffsetCommitConfig{Strategy: commonconfig.OffsetCommitStrategyManualAfterAck, IntervalMillis: 250})
+	assert.False(t, config.Consumer.Offsets.AutoCommit.Enable)
+	assert.Equal(t, 250*time.Millisecond, config.Consumer.Offsets.AutoCommit.Interval)
+	assert.Nil(t, config.Validate())
+}
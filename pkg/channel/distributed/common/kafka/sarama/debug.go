@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+// The Value Replacing Sensitive (Non-Empty) Sarama Config Values
+const RedactedValue = "********"
+
+// The Lowercase Substrings Identifying Sensitive Sarama Config Field Names (Passwords, Keys, Tokens, etc...)
+var sensitiveFieldNames = []string{"password", "secret", "token", "privatekey"}
+
+// The Reflected Type Of The Sarama Config's TLS Config (Summarized Rather Than Walked)
+var tlsConfigType = reflect.TypeOf(&tls.Config{})
+
+//
+// Get A Redacted, JSON Serializable View Of The Specified Sarama Config
+//
+// The view mirrors the structure (and Go field names) of the sarama.Config, with sensitive values (passwords,
+// tokens, etc...) masked, the TLS config summarized (so that no certificates or private keys are exposed), and
+// interface implementations reduced to their type name.  Functions and channels are omitted.
+//
+func RedactedSaramaConfig(config *sarama.Config) map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+	view, _ := redactValue(reflect.ValueOf(*config)).(map[string]interface{})
+	return view
+}
+
+// Get The Redacted View Of The Specified (Reflected) Sarama Config Value
+func redactValue(value reflect.Value) interface{} {
+
+	// Summarize The TLS Config
+	if value.Type() == tlsConfigType {
+		return redactTLSConfig(value.Interface().(*tls.Config))
+	}
+
+	// Use The String Representation Of Values Which Provide One (Durations, KafkaVersion, CompressionCodec, etc...)
+	if value.Kind() != reflect.Ptr && value.Kind() != reflect.Interface && value.CanInterface() {
+		if stringer, ok := value.Interface().(fmt.Stringer); ok {
+			return stringer.String()
+		}
+	}
+
+	// Walk The Value Based On Its Kind
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+		return redactValue(value.Elem())
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return fmt.Sprintf("%T", value.Interface()) // Implementations (e.g. TokenProviders) May Hold Credentials
+	case reflect.Struct:
+		view := make(map[string]interface{})
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if len(field.PkgPath) > 0 || !isSerializable(field.Type) {
+				continue // Unexported, Function Or Channel Field
+			}
+			if isSensitiveFieldName(field.Name) {
+				view[field.Name] = redactSensitiveValue(value.Field(i))
+			} else {
+				view[field.Name] = redactValue(value.Field(i))
+			}
+		}
+		return view
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return nil
+		}
+		items := make([]interface{}, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			items[i] = redactValue(value.Index(i))
+		}
+		return items
+	case reflect.Map:
+		if value.IsNil() {
+			return nil
+		}
+		view := make(map[string]interface{}, value.Len())
+		for _, key := range value.MapKeys() {
+			view[fmt.Sprint(key.Interface())] = redactValue(value.MapIndex(key))
+		}
+		return view
+	default:
+		return value.Interface()
+	}
+}
+
+// Summarize The Specified TLS Config (Omitting The Certificates, Private Keys & Root CAs Themselves)
+func redactTLSConfig(tlsConfig *tls.Config) interface{} {
+	if tlsConfig == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"Certificates":       len(tlsConfig.Certificates),
+		"RootCAs":            tlsConfig.RootCAs != nil,
+		"ServerName":         tlsConfig.ServerName,
+		"InsecureSkipVerify": tlsConfig.InsecureSkipVerify,
+		"MinVersion":         tlsConfig.MinVersion,
+		"MaxVersion":         tlsConfig.MaxVersion,
+	}
+}
+
+// Mask The Specified Sensitive Value (Preserving Whether It Is Set)
+func redactSensitiveValue(value reflect.Value) interface{} {
+	if value.IsZero() {
+		return ""
+	}
+	return RedactedValue
+}
+
+// Determine Whether The Specified Sarama Config Field Name Identifies A Sensitive Value
+func isSensitiveFieldName(name string) bool {
+	lowerName := strings.ToLower(name)
+	for _, sensitiveFieldName := range sensitiveFieldNames {
+		if strings.Contains(lowerName, sensitiveFieldName) {
+			return true
+		}
+	}
+	return false
+}
+
+// Determine Whether Values Of The Specified Type Can Be Included In The Redacted View
+func isSerializable(fieldType reflect.Type) bool {
+	switch fieldType.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return false
+	default:
+		return true
+	}
+}
+
+//
+// Create An HTTP Request Handler Dumping The Redacted Effective Sarama Config As JSON
+//
+// The specified function is called for each request so that the current Sarama config is always returned, even
+// after it has been replaced due to ConfigMap changes.  A 503 (Service Unavailable) is returned if there is none.
+//
+func NewSaramaConfigDebugHandler(logger *zap.Logger, saramaConfig func() *sarama.Config) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			responseWriter.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		config := saramaConfig()
+		if config == nil {
+			responseWriter.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := json.MarshalIndent(RedactedSaramaConfig(config), "", "  ")
+		if err != nil {
+			logger.Error("Failed To Marshal Redacted Sarama Config", zap.Error(err))
+			responseWriter.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		responseWriter.Header().Set("Content-Type", "application/json")
+		responseWriter.WriteHeader(http.StatusOK)
+		_, err = responseWriter.Write(body)
+		if err != nil {
+			logger.Error("Failed To Write Sarama Config Debug Response", zap.Error(err))
+		}
+	}
+}
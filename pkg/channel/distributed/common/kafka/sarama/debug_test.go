@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The RedactedSaramaConfig() Functionality
+func TestRedactedSaramaConfig(t *testing.T) {
+
+	// Test Data
+	testUsername := "TestUsername"
+	testPassword := "TestSecretPassword"
+	testGSSAPIPassword := "TestSecretGSSAPIPassword"
+	certPem, keyPem := commontesting.GenerateSelfSignedCertificate(t)
+	certificate, err := ParseTLSCertificate(certPem, keyPem)
+	assert.Nil(t, err)
+
+	// Create A Sarama Config With Credentials, Client Certificates & Root CAs
+	config := sarama.NewConfig()
+	config.ClientID = "TestClientId"
+	config.Version = sarama.V2_3_0_0
+	config.Consumer.Offsets.AutoCommit.Interval = 7 * time.Second
+	config.Net.SASL.Enable = true
+	config.Net.SASL.User = testUsername
+	config.Net.SASL.Password = testPassword
+	config.Net.SASL.GSSAPI.Password = testGSSAPIPassword
+	config.Net.TLS.Enable = true
+	config.Net.TLS.Config = &tls.Config{Certificates: []tls.Certificate{*certificate}, RootCAs: x509.NewCertPool(), MinVersion: tls.VersionTLS12}
+
+	// Perform The Test
+	view := RedactedSaramaConfig(config)
+	body, err := json.Marshal(view)
+
+	// Verify The Secrets Are Masked & No Credentials Or Keys Are Present Anywhere In The Serialized View
+	assert.Nil(t, err)
+	assert.NotContains(t, string(body), testPassword)
+	assert.NotContains(t, string(body), testGSSAPIPassword)
+	assert.NotContains(t, string(body), "PRIVATE KEY")
+	sasl := view["Net"].(map[string]interface{})["SASL"].(map[string]interface{})
+	assert.Equal(t, RedactedValue, sasl["Password"])
+	assert.Equal(t, RedactedValue, sasl["GSSAPI"].(map[string]interface{})["Password"])
+	assert.Equal(t, testUsername, sasl["User"])
+	assert.Equal(t, "", sasl["SCRAMAuthzID"])
+	assert.NotContains(t, sasl, "SCRAMClientGeneratorFunc")
+
+	// Verify The TLS Config Is Summarized
+	tlsView := view["Net"].(map[string]interface{})["TLS"].(map[string]interface{})
+	assert.Equal(t, true, tlsView["Enable"])
+	assert.Equal(t, map[string]interface{}{
+		"Certificates":       1,
+		"RootCAs":            true,
+		"ServerName":         "",
+		"InsecureSkipVerify": false,
+		"MinVersion":         uint16(tls.VersionTLS12),
+		"MaxVersion":         uint16(0),
+	}, tlsView["Config"])
+
+	// Verify The Non-Sensitive Values Are Present
+	assert.Equal(t, "TestClientId", view["ClientID"])
+	assert.Equal(t, "2.3.0", view["Version"])
+	assert.Equal(t, "7s", view["Consumer"].(map[string]interface{})["Offsets"].(map[string]interface{})["AutoCommit"].(map[string]interface{})["Interval"])
+
+	// Verify A Nil Config
+	assert.Nil(t, RedactedSaramaConfig(nil))
+}
+
+// Test The NewSaramaConfigDebugHandler() Functionality
+func TestNewSaramaConfigDebugHandler(t *testing.T) {
+
+	// Test Data
+	config := sarama.NewConfig()
+	config.Net.SASL.Password = "TestSecretPassword"
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name           string
+		method         string
+		config         *sarama.Config
+		expectedStatus int
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Get Config", method: http.MethodGet, config: config, expectedStatus: http.StatusOK},
+		{name: "No Config", method: http.MethodGet, expectedStatus: http.StatusServiceUnavailable},
+		{name: "Invalid Method", method: http.MethodPost, config: config, expectedStatus: http.StatusMethodNotAllowed},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Perform The Test
+			handler := NewSaramaConfigDebugHandler(logtesting.TestLogger(t).Desugar(), func() *sarama.Config { return testCase.config })
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(testCase.method, "/debug/sarama-config", nil))
+
+			// Verify The Results
+			assert.Equal(t, testCase.expectedStatus, recorder.Code)
+			if testCase.expectedStatus == http.StatusOK {
+				assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+				assert.NotContains(t, recorder.Body.String(), "TestSecretPassword")
+				view := make(map[string]interface{})
+				assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &view))
+				assert.Equal(t, RedactedValue, view["Net"].(map[string]interface{})["SASL"].(map[string]interface{})["Password"])
+			}
+		})
+	}
+}
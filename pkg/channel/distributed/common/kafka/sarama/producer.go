@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Shopify/sarama"
+)
+
+// The Supported Producer RequiredAcks Names (Matching The Sarama Constants)
+const (
+	RequiredAcksNoResponse   = "NoResponse"
+	RequiredAcksWaitForLocal = "WaitForLocal"
+	RequiredAcksWaitForAll   = "WaitForAll"
+)
+
+// Parse The Specified (Case-Insensitive) Producer RequiredAcks Name Into The Corresponding Sarama RequiredAcks
+func ParseRequiredAcks(requiredAcks string) (sarama.RequiredAcks, error) {
+	switch strings.ToLower(strings.TrimSpace(requiredAcks)) {
+	case strings.ToLower(RequiredAcksNoResponse):
+		return sarama.NoResponse, nil
+	case strings.ToLower(RequiredAcksWaitForLocal):
+		return sarama.WaitForLocal, nil
+	case strings.ToLower(RequiredAcksWaitForAll):
+		return sarama.WaitForAll, nil
+	default:
+		return sarama.WaitForLocal, fmt.Errorf("invalid required acks '%s' (must be one of %s, %s or %s)", requiredAcks, RequiredAcksNoResponse, RequiredAcksWaitForLocal, RequiredAcksWaitForAll)
+	}
+}
+
+//
+// Update The Sarama Config's Producer RequiredAcks (If Specified)
+//
+// The eventing-kafka requiredAcks setting takes precedence over any Producer.RequiredAcks in the sarama
+// section of the ConfigMap.  Since an idempotent Producer forces WaitForAll, explicitly requesting weaker
+// acknowledgement alongside idempotence is a conflicting configuration and is rejected with an error.
+//
+func UpdateSaramaConfigRequiredAcks(config *sarama.Config, requiredAcks string, idempotent bool) error {
+
+	// Nothing To Do If RequiredAcks Is Not Specified
+	if len(strings.TrimSpace(requiredAcks)) <= 0 {
+		return nil
+	}
+
+	// Parse & Validate The RequiredAcks
+	acks, err := ParseRequiredAcks(requiredAcks)
+	if err != nil {
+		return err
+	}
+	if idempotent && acks != sarama.WaitForAll {
+		return fmt.Errorf("required acks '%s' conflicts with the idempotent producer (which requires %s)", requiredAcks, RequiredAcksWaitForAll)
+	}
+
+	// Update The Producer's RequiredAcks
+	config.Producer.RequiredAcks = acks
+	return nil
+}
+
+//
+// Update The Sarama Config's Producer For Idempotence (If Enabled)
+//
+// Sarama rejects an idempotent Producer unless RequiredAcks is WaitForAll and Net.MaxOpenRequests is 1,
+// so those dependent settings are forced here regardless of the ConfigMap values (trading throughput for
+// the absence of duplicates on retry).  A Kafka Version older than 0.11.0.0 or a Producer.Retry.Max of 0
+// cannot be reconciled with idempotence and are rejected with an error instead.
+//
+func UpdateSaramaConfigIdempotentProducer(config *sarama.Config, enable bool) error {
+
+	// Nothing To Do If Idempotence Is Not Enabled
+	if !enable {
+		return nil
+	}
+
+	// Validate The Settings Which Cannot Be Forced
+	if !config.Version.IsAtLeast(sarama.V0_11_0_0) {
+		return fmt.Errorf("idempotent producer requires a Kafka Version of at least %s but found %s", sarama.V0_11_0_0, config.Version)
+	}
+	if config.Producer.Retry.Max <= 0 {
+		return fmt.Errorf("idempotent producer requires Producer.Retry.Max of at least 1 but found %d", config.Producer.Retry.Max)
+	}
+
+	// Enable Idempotence & Force The Dependent Settings
+	config.Producer.Idempotent = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Net.MaxOpenRequests = 1
+	return nil
+}
+
+//
+// Keyed Partitioner - Hash Partitions Keyed Messages & Round-Robins Messages Without A Key
+//
+// Sarama's default HashPartitioner assigns messages without a key to a random partition.  This
+// Partitioner instead spreads such messages evenly via round-robin, while messages with a key are
+// still hashed so that all messages sharing a key (e.g. about the same entity) land on the same
+// partition and therefore preserve their relative order.
+//
+type keyedPartitioner struct {
+	hashPartitioner       sarama.Partitioner
+	roundRobinPartitioner sarama.Partitioner
+}
+
+// Verify The keyedPartitioner Implements The Sarama Partitioner Interface
+var _ sarama.Partitioner = &keyedPartitioner{}
+
+// NewKeyedPartitioner Is A sarama.PartitionerConstructor Suitable For Use As The Config.Producer.Partitioner
+func NewKeyedPartitioner(topic string) sarama.Partitioner {
+	return &keyedPartitioner{
+		hashPartitioner:       sarama.NewHashPartitioner(topic),
+		roundRobinPartitioner: sarama.NewRoundRobinPartitioner(topic),
+	}
+}
+
+// Partition The Specified Message (Hash If Keyed, Otherwise Round-Robin)
+func (p *keyedPartitioner) Partition(message *sarama.ProducerMessage, numPartitions int32) (int32, error) {
+	if message.Key == nil {
+		return p.roundRobinPartitioner.Partition(message, numPartitions)
+	}
+	return p.hashPartitioner.Partition(message, numPartitions)
+}
+
+// Keyed Messages Must Always Be Hashed To The Same Partition
+func (p *keyedPartitioner) RequiresConsistency() bool {
+	return true
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+// Test The UpdateSaramaConfigIdempotentProducer() Functionality
+func TestUpdateSaramaConfigIdempotentProducer(t *testing.T) {
+
+	// Utility Function For Creating A Default Sarama Config With Our Default Kafka Version
+	newConfig := func() *sarama.Config {
+		config := sarama.NewConfig()
+		config.Version = constants.ConfigKafkaVersionDefault
+		config.Producer.Return.Successes = true
+		return config
+	}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name           string
+		enable         bool
+		version        sarama.KafkaVersion
+		noRetries      bool
+		wantIdempotent bool
+		wantErr        bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Disabled", enable: false, wantIdempotent: false},
+		{name: "Enabled", enable: true, wantIdempotent: true},
+		{name: "Enabled With Old Kafka Version", enable: true, version: sarama.V0_10_2_0, wantErr: true},
+		{name: "Enabled Without Retries", enable: true, noRetries: true, wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The Sarama Config With Inconsistent Producer Settings
+			config := newConfig()
+			config.Producer.RequiredAcks = sarama.WaitForLocal
+			config.Net.MaxOpenRequests = 5
+			if testCase.version != (sarama.KafkaVersion{}) {
+				config.Version = testCase.version
+			}
+			if testCase.noRetries {
+				config.Producer.Retry.Max = 0
+			}
+
+			// Perform The Test
+			err := UpdateSaramaConfigIdempotentProducer(config, testCase.enable)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			assert.Equal(t, testCase.wantIdempotent, config.Producer.Idempotent)
+			if testCase.wantIdempotent {
+				assert.Equal(t, sarama.WaitForAll, config.Producer.RequiredAcks)
+				assert.Equal(t, 1, config.Net.MaxOpenRequests)
+				assert.Nil(t, config.Validate())
+			} else {
+				assert.Equal(t, sarama.WaitForLocal, config.Producer.RequiredAcks)
+				assert.Equal(t, 5, config.Net.MaxOpenRequests)
+			}
+		})
+	}
+}
+
+// Test The ParseRequiredAcks() Functionality
+func TestParseRequiredAcks(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name         string
+		requiredAcks string
+		wantAcks     sarama.RequiredAcks
+		wantErr      bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "NoResponse", requiredAcks: "NoResponse", wantAcks: sarama.NoResponse},
+		{name: "WaitForLocal", requiredAcks: "WaitForLocal", wantAcks: sarama.WaitForLocal},
+		{name: "WaitForAll", requiredAcks: "WaitForAll", wantAcks: sarama.WaitForAll},
+		{name: "Case Insensitive & Trimmed", requiredAcks: " waitforall ", wantAcks: sarama.WaitForAll},
+		{name: "Numeric", requiredAcks: "-1", wantErr: true},
+		{name: "Unknown", requiredAcks: "all", wantErr: true},
+		{name: "Empty", requiredAcks: "", wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			acks, err := ParseRequiredAcks(testCase.requiredAcks)
+			assert.Equal(t, testCase.wantErr, err != nil)
+			if !testCase.wantErr {
+				assert.Equal(t, testCase.wantAcks, acks)
+			}
+		})
+	}
+}
+
+// Test The UpdateSaramaConfigRequiredAcks() Functionality
+func TestUpdateSaramaConfigRequiredAcks(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name         string
+		requiredAcks string
+		idempotent   bool
+		wantAcks     sarama.RequiredAcks
+		wantErr      bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Unspecified", requiredAcks: "", wantAcks: sarama.WaitForLocal},
+		{name: "NoResponse", requiredAcks: "NoResponse", wantAcks: sarama.NoResponse},
+		{name: "WaitForAll", requiredAcks: "WaitForAll", wantAcks: sarama.WaitForAll},
+		{name: "Invalid", requiredAcks: "invalid", wantAcks: sarama.WaitForLocal, wantErr: true},
+		{name: "Idempotent Unspecified", requiredAcks: "", idempotent: true, wantAcks: sarama.WaitForLocal},
+		{name: "Idempotent WaitForAll", requiredAcks: "WaitForAll", idempotent: true, wantAcks: sarama.WaitForAll},
+		{name: "Idempotent WaitForLocal Conflict", requiredAcks: "WaitForLocal", idempotent: true, wantAcks: sarama.WaitForLocal, wantErr: true},
+		{name: "Idempotent NoResponse Conflict", requiredAcks: "NoResponse", idempotent: true, wantAcks: sarama.WaitForLocal, wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := sarama.NewConfig()
+			err := UpdateSaramaConfigRequiredAcks(config, testCase.requiredAcks, testCase.idempotent)
+			assert.Equal(t, testCase.wantErr, err != nil)
+			assert.Equal(t, testCase.wantAcks, config.Producer.RequiredAcks)
+		})
+	}
+}
+
+// Test The NewKeyedPartitioner() Functionality
+func TestKeyedPartitioner(t *testing.T) {
+
+	// Test Data
+	numPartitions := int32(4)
+	partitioner := NewKeyedPartitioner("TestTopic")
+	assert.True(t, partitioner.RequiresConsistency())
+
+	// Verify Messages Sharing A Key Are Always Assigned The Same Partition
+	for _, key := range []string{"entity-1", "entity-2", "entity-3"} {
+		expectedPartition, err := partitioner.Partition(&sarama.ProducerMessage{Key: sarama.StringEncoder(key)}, numPartitions)
+		assert.Nil(t, err)
+		for i := 0; i < 10; i++ {
+			partition, err := partitioner.Partition(&sarama.ProducerMessage{Key: sarama.StringEncoder(key)}, numPartitions)
+			assert.Nil(t, err)
+			assert.Equal(t, expectedPartition, partition)
+		}
+	}
+
+	// Verify Messages Without A Key Are Assigned Partitions In Round-Robin Order
+	for i := int32(0); i < 2*numPartitions; i++ {
+		partition, err := partitioner.Partition(&sarama.ProducerMessage{}, numPartitions)
+		assert.Nil(t, err)
+		assert.Equal(t, i%numPartitions, partition)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/system"
+)
+
+//
+// Root CAs From The (Optional) Root CA ConfigMap
+//
+// Rather than embedding the CA PEMs inline in the Sarama settings (RootPEMs), the EventingKafkaConfig may
+// reference a ConfigMap key holding them, so that CA rotation can be managed centrally.  The Root CAs are
+// tracked here at the package level (as they are loaded once at startup and then updated by a ConfigMap
+// watcher) and are applied by MergeSaramaSettings(), taking precedence over any inline RootPEMs.
+//
+var (
+	rootCAConfigMapKey   = commonconfig.RootCAConfigMapKeyDefault
+	rootCAConfigMapPEM   string
+	rootCAConfigMapPool  *x509.CertPool
+	rootCAConfigMapMutex = &sync.RWMutex{}
+)
+
+// Load The Root CA ConfigMap Referenced By The EventingKafkaConfig (If Any) Into The Package Root CAs
+// The Provided Context Must Have A Kubernetes Client Associated With It
+func LoadRootCAConfigMap(ctx context.Context, ekConfig *commonconfig.EventingKafkaConfig) error {
+
+	// Nothing To Load If No Root CA ConfigMap Is Referenced
+	if ekConfig == nil || len(ekConfig.Kafka.RootCAConfigMap.Name) <= 0 {
+		return nil
+	}
+
+	// Track The Referenced Key (Defaulted If Not Specified) For Subsequent Updates
+	key := ekConfig.Kafka.RootCAConfigMap.Key
+	if len(key) <= 0 {
+		key = commonconfig.RootCAConfigMapKeyDefault
+	}
+	rootCAConfigMapMutex.Lock()
+	rootCAConfigMapKey = key
+	rootCAConfigMapMutex.Unlock()
+
+	// Get The Root CA ConfigMap & Update The Package Root CAs From It
+	configMap, err := kubeclient.Get(ctx).CoreV1().ConfigMaps(system.Namespace()).Get(ctx, ekConfig.Kafka.RootCAConfigMap.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get root CA configmap %s: %s", ekConfig.Kafka.RootCAConfigMap.Name, err)
+	}
+	_, err = UpdateRootCAConfigMap(configMap)
+	return err
+}
+
+// Update The Package Root CAs From The Specified Root CA ConfigMap & Return Whether They Changed
+func UpdateRootCAConfigMap(configMap *corev1.ConfigMap) (bool, error) {
+
+	// Validate The ConfigMap
+	if configMap == nil {
+		return false, fmt.Errorf("attempted to update root CAs from nil configmap")
+	}
+
+	// Get The CA PEM(s) From The Tracked Key
+	rootCAConfigMapMutex.RLock()
+	key := rootCAConfigMapKey
+	rootCAConfigMapMutex.RUnlock()
+	pem := strings.TrimSpace(configMap.Data[key])
+	if len(pem) <= 0 {
+		return false, fmt.Errorf("root CA configmap %s does not contain key %s", configMap.Name, key)
+	}
+
+	// Parse The CA PEM(s) Into A New CertPool
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM([]byte(pem)) {
+		return false, fmt.Errorf("failed to parse root certificate PEM from configmap %s key %s", configMap.Name, key)
+	}
+
+	// Update The Package Root CAs (Only If Changed So That Unchanged Resyncs Are Ignored)
+	rootCAConfigMapMutex.Lock()
+	defer rootCAConfigMapMutex.Unlock()
+	if pem == rootCAConfigMapPEM {
+		return false, nil
+	}
+	rootCAConfigMapPEM = pem
+	rootCAConfigMapPool = certPool
+	return true, nil
+}
+
+// Get The Root CAs Loaded From The Root CA ConfigMap (nil If None)
+func RootCAConfigMapPool() *x509.CertPool {
+	rootCAConfigMapMutex.RLock()
+	defer rootCAConfigMapMutex.RUnlock()
+	return rootCAConfigMapPool
+}
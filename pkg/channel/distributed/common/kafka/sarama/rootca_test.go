@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	injectionclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/system"
+)
+
+// Test Constants
+const (
+	RootCAConfigMapName = "test-root-ca"
+	RootCAConfigMapKey  = "test-ca.pem"
+)
+
+// Test The UpdateRootCAConfigMap() Functionality
+func TestUpdateRootCAConfigMap(t *testing.T) {
+	defer resetRootCAConfigMap()
+
+	// Generate Some Self-Signed CA PEMs
+	caPem, _ := commontesting.GenerateSelfSignedCertificate(t)
+	otherCaPem, _ := commontesting.GenerateSelfSignedCertificate(t)
+
+	// Verify Invalid ConfigMaps Are Rejected Without Updating The Root CAs
+	_, err := UpdateRootCAConfigMap(nil)
+	assert.NotNil(t, err)
+	_, err = UpdateRootCAConfigMap(newRootCAConfigMap("other-key", caPem))
+	assert.NotNil(t, err)
+	_, err = UpdateRootCAConfigMap(newRootCAConfigMap(commonconfig.RootCAConfigMapKeyDefault, "invalid"))
+	assert.NotNil(t, err)
+	assert.Nil(t, RootCAConfigMapPool())
+
+	// Verify A Valid ConfigMap Updates The Root CAs
+	changed, err := UpdateRootCAConfigMap(newRootCAConfigMap(commonconfig.RootCAConfigMapKeyDefault, caPem))
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	rootCAs := RootCAConfigMapPool()
+	assert.NotNil(t, rootCAs)
+	assert.Len(t, rootCAs.Subjects(), 1)
+
+	// Verify An Unchanged ConfigMap Does Not Update The Root CAs
+	changed, err = UpdateRootCAConfigMap(newRootCAConfigMap(commonconfig.RootCAConfigMapKeyDefault, caPem))
+	assert.Nil(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, rootCAs, RootCAConfigMapPool())
+
+	// Verify A Rotated CA Updates The Root CAs
+	changed, err = UpdateRootCAConfigMap(newRootCAConfigMap(commonconfig.RootCAConfigMapKeyDefault, caPem+"\n"+otherCaPem))
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.Len(t, RootCAConfigMapPool().Subjects(), 2)
+}
+
+// Test The LoadRootCAConfigMap() Functionality
+func TestLoadRootCAConfigMap(t *testing.T) {
+	defer resetRootCAConfigMap()
+
+	// Setup Environment
+	assert.Nil(t, os.Setenv(system.NamespaceEnvKey, commonconstants.KnativeEventingNamespace))
+
+	// Test Data
+	caPem, _ := commontesting.GenerateSelfSignedCertificate(t)
+	ekConfig := &commonconfig.EventingKafkaConfig{}
+	ekConfig.Kafka.RootCAConfigMap = commonconfig.EKRootCAConfigMapConfig{Name: RootCAConfigMapName, Key: RootCAConfigMapKey}
+
+	// Verify Nothing Is Loaded If The Root CA ConfigMap Is Not Referenced
+	ctx := context.WithValue(context.TODO(), injectionclient.Key{}, fake.NewSimpleClientset())
+	assert.Nil(t, LoadRootCAConfigMap(ctx, nil))
+	assert.Nil(t, LoadRootCAConfigMap(ctx, &commonconfig.EventingKafkaConfig{}))
+	assert.Nil(t, RootCAConfigMapPool())
+
+	// Verify An Error If The Referenced Root CA ConfigMap Does Not Exist
+	assert.NotNil(t, LoadRootCAConfigMap(ctx, ekConfig))
+	assert.Nil(t, RootCAConfigMapPool())
+
+	// Verify The Root CAs Are Loaded From The Referenced Key Of The Root CA ConfigMap
+	ctx = context.WithValue(context.TODO(), injectionclient.Key{}, fake.NewSimpleClientset(newRootCAConfigMap(RootCAConfigMapKey, caPem)))
+	assert.Nil(t, LoadRootCAConfigMap(ctx, ekConfig))
+	assert.NotNil(t, RootCAConfigMapPool())
+}
+
+// Test The MergeSaramaSettings() Precedence Of The Root CA ConfigMap Over Inline RootPEMs
+func TestMergeSaramaSettingsRootCAConfigMap(t *testing.T) {
+	defer resetRootCAConfigMap()
+
+	// Verify The Inline RootPEMs Are Used Without A Root CA ConfigMap
+	configMap := commontesting.GetTestSaramaConfigMap(EKDefaultSaramaConfigWithRootCert, commontesting.TestEKConfig)
+	config, err := MergeSaramaSettings(nil, configMap)
+	assert.Nil(t, err)
+	inlineRootCAs := config.Net.TLS.Config.RootCAs
+	assert.NotNil(t, inlineRootCAs)
+
+	// Verify The Root CA ConfigMap Takes Precedence Over The Inline RootPEMs
+	caPem, _ := commontesting.GenerateSelfSignedCertificate(t)
+	_, err = UpdateRootCAConfigMap(newRootCAConfigMap(commonconfig.RootCAConfigMapKeyDefault, caPem))
+	assert.Nil(t, err)
+	config, err = MergeSaramaSettings(nil, configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, RootCAConfigMapPool(), config.Net.TLS.Config.RootCAs)
+	assert.NotEqual(t, inlineRootCAs.Subjects(), config.Net.TLS.Config.RootCAs.Subjects())
+
+	// Verify The Root CA ConfigMap Is Used Without Any Inline RootPEMs
+	config, err = MergeSaramaSettings(nil, commontesting.GetTestSaramaConfigMap(commontesting.NewSaramaConfig, commontesting.TestEKConfig))
+	assert.Nil(t, err)
+	assert.Equal(t, RootCAConfigMapPool(), config.Net.TLS.Config.RootCAs)
+}
+
+// Utility Function For Creating A Root CA ConfigMap With The Specified Key / PEM
+func newRootCAConfigMap(key string, pem string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RootCAConfigMapName,
+			Namespace: commonconstants.KnativeEventingNamespace,
+		},
+		Data: map[string]string{key: pem},
+	}
+}
+
+// Utility Function For Resetting The Package Root CAs Between Tests
+func resetRootCAConfigMap() {
+	rootCAConfigMapMutex.Lock()
+	defer rootCAConfigMapMutex.Unlock()
+	rootCAConfigMapKey = commonconfig.RootCAConfigMapKeyDefault
+	rootCAConfigMapPEM = ""
+	rootCAConfigMapPool = nil
+}
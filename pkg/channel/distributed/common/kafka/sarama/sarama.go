@@ -18,6 +18,7 @@ package sarama
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -25,23 +26,34 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/ghodss/yaml"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/logging"
 	"knative.dev/pkg/system"
 )
 
 // Regular Expression To Find All Certificates In Net.TLS.Config.RootPEMs Field
 var regexRootPEMs = regexp.MustCompile(`(?s)\s*RootPEMs:.*-----END CERTIFICATE-----`)
 
+// Regular Expression To Find The Net.DialTimeout, Net.ReadTimeout & Net.WriteTimeout Lines (Names Unique To Net)
+var regexNetTimeouts = regexp.MustCompile(`(?im)^[ \t]*(Dial|Read|Write)Timeout:.*(\n|$)`)
+
+// Regular Expression To Find The Consumer.MaxWaitTime In Sarama YAML
+var regexConsumerMaxWaitTime = regexp.MustCompile(`(?im)^[ \t]*MaxWaitTime:.*(\n|$)`)
+
 // Utility Function For Enabling Sarama Logging (Debugging)
 func EnableSaramaLogging(enable bool) {
 	if enable {
@@ -69,6 +81,24 @@ func UpdateSaramaConfig(config *sarama.Config, clientId string, username string,
 }
 
 //
+// Extract The Sarama Overrides (Unparsed Kafka Version, TLS & Consumer Settings) From The EventingKafka Section Of The ConfigMap
+//
+// The Version, TLS ServerName & Consumer durations are trimmed of surrounding whitespace, and are empty if not specified
+// (meaning the default is used).  The TLS InsecureSkipVerify is false unless explicitly enabled.
+//
+func extractEventingKafkaOverrides(configMap *corev1.ConfigMap) (commonconfig.EKKafkaConfig, error) {
+	eventingKafkaConfig := &commonconfig.EventingKafkaConfig{}
+	err := yaml.Unmarshal([]byte(configMap.Data[commonconfig.EventingKafkaSettingsConfigKey]), eventingKafkaConfig)
+	if err != nil {
+		return commonconfig.EKKafkaConfig{}, fmt.Errorf("failed to extract kafka.version / kafka.tls from eventing-kafka config: %v", err)
+	}
+	eventingKafkaConfig.Kafka.Version = strings.TrimSpace(eventingKafkaConfig.Kafka.Version)
+	eventingKafkaConfig.Kafka.TLS.ServerName = strings.TrimSpace(eventingKafkaConfig.Kafka.TLS.ServerName)
+	eventingKafkaConfig.Kafka.Consumer.HeartbeatInterval = strings.TrimSpace(eventingKafkaConfig.Kafka.Consumer.HeartbeatInterval)
+	eventingKafkaConfig.Kafka.Consumer.MaxProcessingTime = strings.TrimSpace(eventingKafkaConfig.Kafka.Consumer.MaxProcessingTime)
+	return eventingKafkaConfig.Kafka, nil
+}
+
 // Extract (Parse & Remove) Top Level Kafka Version From Specified Sarama Confirm YAML String
 //
 // The Sarama.Config struct contains a top-level 'Version' field of type sarama.KafkaVersion.
@@ -201,6 +231,178 @@ func extractRootCerts(saramaConfigYamlString string) (string, *x509.CertPool, er
 	return string(updatedSaramaConfigYamlBytes), certPool, nil
 }
 
+//
+// Extract (Parse) The Producer Level CompressionType From Specified Sarama Config YAML String
+//
+// The Sarama.Config struct contains a Producer.Compression field of type sarama.CompressionCodec
+// which is an int8 and is therefore not very user-friendly to configure.  Therefore, we support a
+// custom 'CompressionType' string (one of none, gzip, snappy, lz4 or zstd) which is parsed here
+// into the associated sarama.CompressionCodec.  The field is unknown to the Sarama.Config struct
+// and is ignored when unmarshalling, so it need not be removed from the YAML string.  In the case
+// where the user has NOT specified a CompressionType we will return false for the "found" value.
+//
+func extractCompressionType(saramaConfigYamlString string) (sarama.CompressionCodec, bool, error) {
+
+	// Define Inline Struct To Marshall The Producer Level CompressionType Into
+	type saramaConfigShell struct {
+		Producer struct {
+			CompressionType string
+		}
+	}
+
+	// Unmarshal The Sarama Config Into The Shell
+	shell := &saramaConfigShell{}
+	err := yaml.Unmarshal([]byte(saramaConfigYamlString), shell)
+	if err != nil {
+		return sarama.CompressionNone, false, err
+	}
+
+	// Return Not Found If Not Specified
+	if len(shell.Producer.CompressionType) <= 0 {
+		return sarama.CompressionNone, false, nil
+	}
+
+	// Map The CompressionType String To The Associated Sarama.CompressionCodec
+	switch strings.ToLower(strings.TrimSpace(shell.Producer.CompressionType)) {
+	case "none":
+		return sarama.CompressionNone, true, nil
+	case "gzip":
+		return sarama.CompressionGZIP, true, nil
+	case "snappy":
+		return sarama.CompressionSnappy, true, nil
+	case "lz4":
+		return sarama.CompressionLZ4, true, nil
+	case "zstd":
+		return sarama.CompressionZSTD, true, nil
+	default:
+		return sarama.CompressionNone, false, fmt.Errorf("unknown compression type '%s' (must be one of none, gzip, snappy, lz4 or zstd)", shell.Producer.CompressionType)
+	}
+}
+
+// The Net Level Timeouts Parsed From The Sarama Config YAML String (Zero When Not Specified)
+type netTimeouts struct {
+	dialTimeout  time.Duration
+	readTimeout  time.Duration
+	writeTimeout time.Duration
+}
+
+//
+// Extract (Parse & Remove) The Net Level Dial / Read / Write Timeouts From Specified Sarama Config YAML String
+//
+// The Sarama.Config struct contains Net.DialTimeout, Net.ReadTimeout and Net.WriteTimeout fields of type
+// time.Duration which can only be unmarshalled from a number of nanoseconds.  Therefore, we additionally
+// support the user providing a Go duration string (e.g. "45s") which is parsed here and removed from the
+// YAML string (along with any numeric values, which continue to be supported) so that it can be applied
+// after unmarshalling.  Each specified timeout must be a positive duration, and any timeouts which are
+// NOT specified are returned as zero so that the existing (Sarama default) values are retained.
+//
+func extractNetTimeouts(saramaConfigYamlString string) (string, netTimeouts, error) {
+
+	// Define Inline Struct To Marshall The Net Level Timeouts Into (Either Strings Or Numbers)
+	type saramaConfigShell struct {
+		Net struct {
+			DialTimeout  interface{}
+			ReadTimeout  interface{}
+			WriteTimeout interface{}
+		}
+	}
+
+	// Unmarshal The Sarama Config Into The Shell
+	shell := &saramaConfigShell{}
+	err := yaml.Unmarshal([]byte(saramaConfigYamlString), shell)
+	if err != nil {
+		return saramaConfigYamlString, netTimeouts{}, err
+	}
+
+	// Exit Early If No Timeouts Were Specified
+	if shell.Net.DialTimeout == nil && shell.Net.ReadTimeout == nil && shell.Net.WriteTimeout == nil {
+		return saramaConfigYamlString, netTimeouts{}, nil
+	}
+
+	// Parse Each Of The Specified Timeouts
+	timeouts := netTimeouts{}
+	if timeouts.dialTimeout, err = parseSaramaDuration("Net.DialTimeout", shell.Net.DialTimeout); err != nil {
+		return saramaConfigYamlString, netTimeouts{}, err
+	}
+	if timeouts.readTimeout, err = parseSaramaDuration("Net.ReadTimeout", shell.Net.ReadTimeout); err != nil {
+		return saramaConfigYamlString, netTimeouts{}, err
+	}
+	if timeouts.writeTimeout, err = parseSaramaDuration("Net.WriteTimeout", shell.Net.WriteTimeout); err != nil {
+		return saramaConfigYamlString, netTimeouts{}, err
+	}
+
+	// Remove The Timeouts From The Sarama YAML String
+	updatedSaramaConfigYamlBytes := regexNetTimeouts.ReplaceAll([]byte(saramaConfigYamlString), []byte{})
+	return string(updatedSaramaConfigYamlBytes), timeouts, nil
+}
+
+//
+// Extract (Parse & Remove) The Consumer.MaxWaitTime From Specified Sarama Config YAML String
+//
+// As with the Net level timeouts, the Consumer.MaxWaitTime (how long the broker waits for Consumer.Fetch.Min
+// bytes to accumulate before answering a fetch) may be specified as either a Go duration string (e.g. "250ms")
+// or a number of nanoseconds.  It must be a positive duration, and zero is returned if it is NOT specified so
+// that the existing (Sarama default) value is retained.
+//
+func extractConsumerMaxWaitTime(saramaConfigYamlString string) (string, time.Duration, error) {
+
+	// Define Inline Struct To Marshall The Consumer.MaxWaitTime Into (Either A String Or A Number)
+	type saramaConfigShell struct {
+		Consumer struct {
+			MaxWaitTime interface{}
+		}
+	}
+
+	// Unmarshal The Sarama Config Into The Shell
+	shell := &saramaConfigShell{}
+	err := yaml.Unmarshal([]byte(saramaConfigYamlString), shell)
+	if err != nil {
+		return saramaConfigYamlString, 0, err
+	}
+
+	// Exit Early If No MaxWaitTime Was Specified
+	if shell.Consumer.MaxWaitTime == nil {
+		return saramaConfigYamlString, 0, nil
+	}
+
+	// Parse The Specified MaxWaitTime
+	maxWaitTime, err := parseSaramaDuration("Consumer.MaxWaitTime", shell.Consumer.MaxWaitTime)
+	if err != nil {
+		return saramaConfigYamlString, 0, err
+	}
+
+	// Remove The MaxWaitTime From The Sarama YAML String
+	updatedSaramaConfigYamlBytes := regexConsumerMaxWaitTime.ReplaceAll([]byte(saramaConfigYamlString), []byte{})
+	return string(updatedSaramaConfigYamlBytes), maxWaitTime, nil
+}
+
+// Parse A Single Sarama Duration From Either A Duration String Or A Number Of Nanoseconds (Zero If Not Specified)
+func parseSaramaDuration(name string, value interface{}) (time.Duration, error) {
+
+	// Convert The Value Into A Duration Based On Its Unmarshalled Type
+	var timeout time.Duration
+	switch typedValue := value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		timeout = time.Duration(typedValue)
+	case string:
+		parsedTimeout, err := time.ParseDuration(strings.TrimSpace(typedValue))
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s '%s' - expected a duration such as '30s': %v", name, typedValue, err)
+		}
+		timeout = parsedTimeout
+	default:
+		return 0, fmt.Errorf("invalid %s '%v' - expected a duration such as '30s'", name, value)
+	}
+
+	// Only Positive Timeouts Are Valid
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid %s '%v' - must be a positive duration", name, value)
+	}
+	return timeout, nil
+}
+
 // ConfigEqual is a convenience function to determine if two given sarama.Config structs are identical aside
 // from unserializable fields (e.g. function pointers).  To ignore parts of the sarama.Config struct, pass
 // them in as the "ignore" parameter.
@@ -218,6 +420,7 @@ func ConfigEqual(config1, config2 *sarama.Config, ignore ...interface{}) bool {
 	ignoreTypeList := append([]interface{}{
 		config1.Consumer.Group.Rebalance.Strategy,
 		config1.MetricRegistry,
+		config1.Net.SASL.SCRAMClientGeneratorFunc,
 		config1.Producer.Partitioner},
 		ignore...)
 	ignoredTypes := cmpopts.IgnoreTypes(ignoreTypeList...)
@@ -231,8 +434,13 @@ func ConfigEqual(config1, config2 *sarama.Config, ignore ...interface{}) bool {
 
 	ignoredUnexported := cmpopts.IgnoreUnexported(config1.Version, x509.CertPool{}, tls.Config{})
 
+	// The mTLS client certificates come from the Kafka Secret rather than the ConfigMap, and contain private
+	// keys with unexported fields, so they are always ignored.
+
+	ignoredCertificates := cmpopts.IgnoreFields(tls.Config{}, "Certificates")
+
 	// Compare the two sarama config structs, ignoring types and unexported fields as specified
-	return cmp.Equal(config1, config2, ignoredTypes, ignoredUnexported)
+	return cmp.Equal(config1, config2, ignoredTypes, ignoredUnexported, ignoredCertificates)
 }
 
 // Extract The Sarama-Specific Settings From A ConfigMap And Merge Them With Existing Settings
@@ -271,6 +479,24 @@ func MergeSaramaSettings(config *sarama.Config, configMap *corev1.ConfigMap) (*s
 		return nil, fmt.Errorf("failed to extract RootPEMs from Sarama Config YAML: err=%s : config=%+v", err, saramaSettingsYamlString)
 	}
 
+	// Extract Any Producer.CompressionType & Map To A Sarama.CompressionCodec
+	compressionCodec, compressionFound, err := extractCompressionType(saramaSettingsYamlString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract Producer.CompressionType from Sarama Config YAML: err=%s : config=%+v", err, saramaSettingsYamlString)
+	}
+
+	// Extract (Remove) Any Net.DialTimeout / ReadTimeout / WriteTimeout Durations
+	saramaSettingsYamlString, timeouts, err := extractNetTimeouts(saramaSettingsYamlString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract Net timeouts from Sarama Config YAML: err=%s : config=%+v", err, saramaSettingsYamlString)
+	}
+
+	// Extract (Remove) Any Consumer.MaxWaitTime Duration
+	saramaSettingsYamlString, maxWaitTime, err := extractConsumerMaxWaitTime(saramaSettingsYamlString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract Consumer.MaxWaitTime from Sarama Config YAML: err=%s : config=%+v", err, saramaSettingsYamlString)
+	}
+
 	// Unmarshall The Sarama Config Yaml Into The Provided Sarama.Config Object
 	err = yaml.Unmarshal([]byte(saramaSettingsYamlString), &config)
 	if err != nil {
@@ -280,11 +506,75 @@ func MergeSaramaSettings(config *sarama.Config, configMap *corev1.ConfigMap) (*s
 	// Override The Custom Parsed KafkaVersion
 	config.Version = kafkaVersion
 
+	// Override Any Custom Parsed Net Timeouts (Unspecified Timeouts Retain The Existing / Sarama Default Values)
+	if timeouts.dialTimeout > 0 {
+		config.Net.DialTimeout = timeouts.dialTimeout
+	}
+	if timeouts.readTimeout > 0 {
+		config.Net.ReadTimeout = timeouts.readTimeout
+	}
+	if timeouts.writeTimeout > 0 {
+		config.Net.WriteTimeout = timeouts.writeTimeout
+	}
+
+	// Override Any Custom Parsed Consumer.MaxWaitTime (Unspecified Retains The Existing / Sarama Default Value)
+	if maxWaitTime > 0 {
+		config.Consumer.MaxWaitTime = maxWaitTime
+	}
+
+	// Override The KafkaVersion With Any Specified In The EventingKafka Section (Takes Precedence Over The Sarama Version)
+	eventingKafkaOverrides, err := extractEventingKafkaOverrides(configMap)
+	if err != nil {
+		return nil, err
+	} else if len(eventingKafkaOverrides.Version) > 0 {
+		config.Version, err = sarama.ParseKafkaVersion(eventingKafkaOverrides.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kafka.version '%s' in eventing-kafka config - expected a Kafka version such as '2.6.0': %v", eventingKafkaOverrides.Version, err)
+		}
+	}
+
+	// Override Any Custom Parsed Producer.CompressionType (ZSTD Requires Kafka 2.1.0 Or Later)
+	if compressionFound {
+		if compressionCodec == sarama.CompressionZSTD && !config.Version.IsAtLeast(sarama.V2_1_0_0) {
+			return nil, fmt.Errorf("zstd compression requires a Kafka Version of at least %s but found %s", sarama.V2_1_0_0, config.Version)
+		}
+		config.Producer.Compression = compressionCodec
+	}
+
 	// Override Any Custom Parsed TLS.Config.RootCAs
 	if certPool != nil && len(certPool.Subjects()) > 0 {
 		config.Net.TLS.Config = &tls.Config{RootCAs: certPool}
 	}
 
+	// Override Any RootCAs With Those From The Root CA ConfigMap (Takes Precedence Over Inline RootPEMs)
+	UpdateSaramaConfigRootCAs(config, RootCAConfigMapPool())
+
+	// Override The TLS ServerName With Any Specified In The EventingKafka Section (e.g. For Brokers Behind A TLS Proxy)
+	UpdateSaramaConfigTLSServerName(config, eventingKafkaOverrides.TLS.ServerName)
+
+	// Disable TLS Verification Only If Explicitly Requested In The EventingKafka Section (Never Implied By Other Settings)
+	UpdateSaramaConfigTLSInsecureSkipVerify(config, eventingKafkaOverrides.TLS.InsecureSkipVerify)
+
+	// Override The Consumer Tunables With Any Specified In The EventingKafka Section (Validating The Resulting Combination)
+	err = UpdateSaramaConfigConsumer(config, eventingKafkaOverrides.Consumer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kafka.consumer in eventing-kafka config: %v", err)
+	}
+
+	// Validate The Consumer Fetch Sizes & MaxWaitTime (The Latency / Throughput Tradeoff Of Each Fetch)
+	err = ValidateSaramaConfigConsumerFetch(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Consumer fetch settings in Sarama Config YAML: %v", err)
+	}
+
+	// Validate Any Specified SASL Mechanism & Configure The Associated SCRAM Client
+	if len(config.Net.SASL.Mechanism) > 0 {
+		err = UpdateSaramaConfigSaslMechanism(config, string(config.Net.SASL.Mechanism))
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure SASL mechanism from Sarama Config YAML: %s", err)
+		}
+	}
+
 	// Return Success
 	return config, nil
 }
@@ -306,8 +596,21 @@ func LoadSettings(ctx context.Context) (*sarama.Config, *commonconfig.EventingKa
 		return nil, nil, err
 	}
 
+	// Load The Root CAs From The Root CA ConfigMap (If Referenced) Prior To Merging The Sarama Settings
+	err = LoadRootCAConfigMap(ctx, eventingKafkaConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	if RootCAConfigMapPool() != nil && regexRootPEMs.MatchString(configMap.Data[testing.SaramaSettingsConfigKey]) {
+		logging.FromContext(ctx).Warnw("Root CAs From The Root CA ConfigMap Take Precedence Over The Inline RootPEMs",
+			zap.String("ConfigMap", eventingKafkaConfig.Kafka.RootCAConfigMap.Name))
+	}
+
 	// Merge The Sarama Settings In The ConfigMap Into A New Base Sarama Config
 	saramaConfig, err := MergeSaramaSettings(nil, configMap)
+	if err == nil {
+		LogInsecureSkipVerifyWarning(logging.FromContext(ctx), saramaConfig)
+	}
 
 	return saramaConfig, eventingKafkaConfig, err
 }
@@ -325,5 +628,147 @@ func LoadEventingKafkaSettings(configMap *corev1.ConfigMap) (*commonconfig.Event
 		return nil, fmt.Errorf("ConfigMap's eventing-kafka value could not be converted to an EventingKafkaConfig struct: %s : %v", err, configMap.Data[commonconfig.EventingKafkaSettingsConfigKey])
 	}
 
+	// Validate The Kafka Topic Name Template (Parsed Once At Startup By The Components Which Use It)
+	if eventingKafkaConfig == nil {
+		return nil, nil
+	}
+	_, err = util.ParseTopicNameTemplate(eventingKafkaConfig.Kafka.TopicNameTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains an invalid topic name template: %v", err)
+	}
+
+	// Validate The Kafka ConsumerGroup Name Template (Parsed Once At Startup By The Components Which Use It)
+	_, err = util.ParseConsumerGroupIdTemplate(eventingKafkaConfig.Kafka.ConsumerGroupNameTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains an invalid consumer group name template: %v", err)
+	}
+
+	// Validate The Kafka Client ID Template (Parsed Once At Startup By The Components Which Use It)
+	_, err = util.ParseClientIdTemplate(eventingKafkaConfig.Kafka.ClientIdTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains an invalid client id template: %v", err)
+	}
+
+	// Validate The Receiver & Dispatcher Pod Scheduling Controls (NodeSelector, Tolerations & Affinity)
+	err = commonconfig.ValidateSchedulingConfig("receiver", eventingKafkaConfig.Receiver.EKKubernetesConfig)
+	if err == nil {
+		err = commonconfig.ValidateSchedulingConfig("dispatcher", eventingKafkaConfig.Dispatcher.EKKubernetesConfig)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains invalid scheduling controls: %v", err)
+	}
+
+	// Validate The Dispatcher's Additional Volumes & VolumeMounts
+	err = commonconfig.ValidateVolumesConfig("dispatcher", eventingKafkaConfig.Dispatcher.Volumes, eventingKafkaConfig.Dispatcher.VolumeMounts)
+	if err != nil {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains invalid volumes: %v", err)
+	}
+
+	// Validate The Dispatcher's Additional Env Vars
+	err = commonconfig.ValidateEnvConfig("dispatcher", eventingKafkaConfig.Dispatcher.Env)
+	if err != nil {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains invalid env vars: %v", err)
+	}
+
+	// Validate The Transient Kafka Error Requeue Delay & Jitter
+	transientErrorRequeue := eventingKafkaConfig.Kafka.TransientErrorRequeue
+	if transientErrorRequeue.DelayMillis < 0 || transientErrorRequeue.JitterFactor < 0 {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains a negative transient error requeue delay (%d) or jitter factor (%v)", transientErrorRequeue.DelayMillis, transientErrorRequeue.JitterFactor)
+	}
+
+	// Validate The Weighted Replica Assignment Broker IDs & Weights (At Least One Broker Must Be Eligible)
+	brokerWeights := eventingKafkaConfig.Kafka.Topic.BrokerWeights
+	if len(brokerWeights) > 0 {
+		eligibleBrokers := 0
+		for brokerId, weight := range brokerWeights {
+			if brokerId < 0 || weight < 0 {
+				return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains a negative topic broker weight (%d) or broker ID (%d)", weight, brokerId)
+			}
+			if weight > 0 {
+				eligibleBrokers++
+			}
+		}
+		if eligibleBrokers == 0 {
+			return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains topic broker weights without any positive weight")
+		}
+	}
+
+	// Validate The Kafka Topic Finalization Timeout
+	if eventingKafkaConfig.Kafka.TopicFinalization.TimeoutMillis < 0 {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains a negative topic finalization timeout (%d)", eventingKafkaConfig.Kafka.TopicFinalization.TimeoutMillis)
+	}
+
+	// Validate The Dispatcher Offset Commit Strategy & Interval
+	offsetCommit := eventingKafkaConfig.Dispatcher.OffsetCommit
+	switch offsetCommit.Strategy {
+	case "", commonconfig.OffsetCommitStrategyAuto, commonconfig.OffsetCommitStrategyManualAfterAck:
+	default:
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains an invalid offset commit strategy '%s' - expected '%s' or '%s'", offsetCommit.Strategy, commonconfig.OffsetCommitStrategyAuto, commonconfig.OffsetCommitStrategyManualAfterAck)
+	}
+	if offsetCommit.IntervalMillis < 0 {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains a negative offset commit interval (%d)", offsetCommit.IntervalMillis)
+	}
+	switch offsetCommit.Store {
+	case "", commonconfig.OffsetStoreKafka, commonconfig.OffsetStoreConfigMap:
+	default:
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains an invalid offset store '%s' - expected '%s' or '%s'", offsetCommit.Store, commonconfig.OffsetStoreKafka, commonconfig.OffsetStoreConfigMap)
+	}
+
+	// Validate The Dispatcher Max In-Flight Delivery Limits
+	maxInFlight := eventingKafkaConfig.Dispatcher.MaxInFlight
+	if maxInFlight.Dispatcher < 0 || maxInFlight.Subscription < 0 {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains a negative dispatcher (%d) or subscription (%d) max in-flight delivery limit", maxInFlight.Dispatcher, maxInFlight.Subscription)
+	}
+
+	// Validate The Default CloudEvent Extension Allow / Deny Lists
+	_, err = util.NewExtensionFilter(eventingKafkaConfig.Kafka.Extensions.Allow, eventingKafkaConfig.Kafka.Extensions.Deny)
+	if err != nil {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains invalid extensions: %v", err)
+	}
+
+	// Validate The Broker Discovery Refresh Interval
+	if eventingKafkaConfig.Kafka.BrokerDiscovery.RefreshIntervalSeconds < 0 {
+		return nil, fmt.Errorf("ConfigMap's eventing-kafka value contains a negative broker discovery refresh interval (%d)", eventingKafkaConfig.Kafka.BrokerDiscovery.RefreshIntervalSeconds)
+	}
+
 	return eventingKafkaConfig, nil
 }
+
+// Compute A Stable Hash Of The Sarama Settings In The Specified ConfigMap
+//
+// The Sarama YAML is normalized to JSON (sorted keys, no comments or formatting) prior to hashing so that
+// only meaningful changes produce a new hash.  The eventing-kafka section (e.g. EnableSaramaLogging) is
+// intentionally excluded as it does not affect the Sarama configuration used by the dispatchers, other than
+// any kafka.version / kafka.tls settings which override the Sarama Version / TLS Config.
+func SaramaSettingsHash(configMap *corev1.ConfigMap) (string, error) {
+	if configMap == nil || configMap.Data == nil {
+		return "", fmt.Errorf("attempted to hash sarama settings from empty configmap")
+	}
+	saramaSettingsJson, err := yaml.YAMLToJSON([]byte(configMap.Data[testing.SaramaSettingsConfigKey]))
+	if err != nil {
+		return "", fmt.Errorf("failed to normalize Sarama Config YAML: %v", err)
+	}
+	eventingKafkaOverrides, err := extractEventingKafkaOverrides(configMap)
+	if err != nil {
+		return "", err
+	}
+	if len(eventingKafkaOverrides.Version) > 0 {
+		saramaSettingsJson = append(saramaSettingsJson, []byte("\nkafka.version="+eventingKafkaOverrides.Version)...)
+	}
+	if len(eventingKafkaOverrides.TLS.ServerName) > 0 {
+		saramaSettingsJson = append(saramaSettingsJson, []byte("\nkafka.tls.serverName="+eventingKafkaOverrides.TLS.ServerName)...)
+	}
+	if eventingKafkaOverrides.TLS.InsecureSkipVerify {
+		saramaSettingsJson = append(saramaSettingsJson, []byte("\nkafka.tls.insecureSkipVerify=true")...)
+	}
+	if eventingKafkaOverrides.Consumer.MaxPollRecords != 0 {
+		saramaSettingsJson = append(saramaSettingsJson, []byte(fmt.Sprintf("\nkafka.consumer.maxPollRecords=%d", eventingKafkaOverrides.Consumer.MaxPollRecords))...)
+	}
+	if len(eventingKafkaOverrides.Consumer.HeartbeatInterval) > 0 {
+		saramaSettingsJson = append(saramaSettingsJson, []byte("\nkafka.consumer.heartbeatInterval="+eventingKafkaOverrides.Consumer.HeartbeatInterval)...)
+	}
+	if len(eventingKafkaOverrides.Consumer.MaxProcessingTime) > 0 {
+		saramaSettingsJson = append(saramaSettingsJson, []byte("\nkafka.consumer.maxProcessingTime="+eventingKafkaOverrides.Consumer.MaxProcessingTime)...)
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(saramaSettingsJson)), nil
+}
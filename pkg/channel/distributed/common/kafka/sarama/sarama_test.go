@@ -29,12 +29,18 @@ import (
 
 	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/client-go/kubernetes/fake"
 	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
 	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
 	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
 	injectionclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/logging"
+	logtesting "knative.dev/pkg/logging/testing"
 	"knative.dev/pkg/system"
 )
 
@@ -291,6 +297,19 @@ func TestMergeSaramaSettings(t *testing.T) {
 	config, err = MergeSaramaSettings(config, configMap)
 	assert.Nil(t, err)
 	assert.True(t, config.Net.TLS.Config.InsecureSkipVerify)
+
+	// Verify that a SCRAM SASL Mechanism configures the SCRAM client
+	configMap = commontesting.GetTestSaramaConfigMap(strings.Replace(commontesting.NewSaramaConfig, "Mechanism: PLAIN", "Mechanism: SCRAM-SHA-512", 1), commontesting.TestEKConfig)
+	config, err = MergeSaramaSettings(nil, configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512), config.Net.SASL.Mechanism)
+	assert.NotNil(t, config.Net.SASL.SCRAMClientGeneratorFunc)
+
+	// Verify error when an unknown SASL Mechanism is provided
+	configMap = commontesting.GetTestSaramaConfigMap(strings.Replace(commontesting.NewSaramaConfig, "Mechanism: PLAIN", "Mechanism: UNKNOWN", 1), commontesting.TestEKConfig)
+	config, err = MergeSaramaSettings(nil, configMap)
+	assert.NotNil(t, err)
+	assert.Nil(t, config)
 }
 
 // Verify that comparisons of sarama config structs function as expected
@@ -331,6 +350,12 @@ func TestSaramaConfigEqual(t *testing.T) {
 	config2.RackID = "New Rack ID"
 	assert.True(t, ConfigEqual(config1, config2))
 
+	// The SCRAM client generator functions are not comparable, so only the SASL Mechanism itself should matter
+	assert.Nil(t, UpdateSaramaConfigSaslMechanism(config1, sarama.SASLTypeSCRAMSHA512))
+	assert.False(t, ConfigEqual(config1, config2))
+	assert.Nil(t, UpdateSaramaConfigSaslMechanism(config2, sarama.SASLTypeSCRAMSHA512))
+	assert.True(t, ConfigEqual(config1, config2))
+
 	// Change a boolean flag in the TLS.Config struct (which is not Sarama-specific) and make sure the compare function
 	// works with those sub-structs as well.
 	config1.Net.TLS.Config = &tls.Config{}
@@ -377,6 +402,178 @@ func TestLoadEventingKafkaSettings(t *testing.T) {
 	assert.Nil(t, eventingKafkaConfig)
 	assert.NotNil(t, err)
 
+	// Verify that an invalid topic name template returns an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  topicNameTemplate: \"{{.Namespace}}/{{.Name}}\""
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that a valid topic name template is loaded
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  topicNameTemplate: \"knative-{{.Namespace}}-{{.Name}}\""
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, "knative-{{.Namespace}}-{{.Name}}", eventingKafkaConfig.Kafka.TopicNameTemplate)
+
+	// Verify that a consumer group name template which is not unique per subscription returns an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  consumerGroupNameTemplate: \"prod.{{.Namespace}}.{{.Name}}\""
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that a valid consumer group name template is loaded
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  consumerGroupNameTemplate: \"prod.{{.SubscriberUID}}\""
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, "prod.{{.SubscriberUID}}", eventingKafkaConfig.Kafka.ConsumerGroupNameTemplate)
+
+	// Verify that a client id template which doesn't distinguish roles is rejected
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  clientIdTemplate: \"prod-{{.Namespace}}-{{.Name}}\""
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that a valid client id template is loaded
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  clientIdTemplate: \"prod.{{.Namespace}}.{{.Name}}.{{.Role}}\""
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, "prod.{{.Namespace}}.{{.Name}}.{{.Role}}", eventingKafkaConfig.Kafka.ClientIdTemplate)
+
+	// Verify that valid dispatcher scheduling controls are loaded
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  nodeSelector:\n    pool: kafka\n  tolerations:\n  - key: dedicated\n    operator: Equal\n    value: kafka\n    effect: NoSchedule"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"pool": "kafka"}, eventingKafkaConfig.Dispatcher.NodeSelector)
+	assert.Len(t, eventingKafkaConfig.Dispatcher.Tolerations, 1)
+
+	// Verify that invalid dispatcher scheduling controls return an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  tolerations:\n  - key: dedicated\n    operator: Exists\n    value: kafka"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that valid dispatcher volumes & volume mounts are loaded
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  volumes:\n  - name: kafka-certs\n    secret:\n      secretName: kafka-certs\n  volumeMounts:\n  - name: kafka-certs\n    mountPath: /etc/kafka/certs\n    readOnly: true"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Len(t, eventingKafkaConfig.Dispatcher.Volumes, 1)
+	assert.Equal(t, "kafka-certs", eventingKafkaConfig.Dispatcher.Volumes[0].Secret.SecretName)
+	assert.Equal(t, "/etc/kafka/certs", eventingKafkaConfig.Dispatcher.VolumeMounts[0].MountPath)
+
+	// Verify that a dispatcher volume mount of an unknown volume returns an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  volumeMounts:\n  - name: kafka-certs\n    mountPath: /etc/kafka/certs"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that valid dispatcher env vars are loaded
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  env:\n  - name: HTTP_PROXY\n    value: http://proxy:3128\n  - name: TRACING_TOKEN\n    valueFrom:\n      secretKeyRef:\n        name: tracing\n        key: token"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Len(t, eventingKafkaConfig.Dispatcher.Env, 2)
+	assert.Equal(t, "http://proxy:3128", eventingKafkaConfig.Dispatcher.Env[0].Value)
+	assert.Equal(t, "tracing", eventingKafkaConfig.Dispatcher.Env[1].ValueFrom.SecretKeyRef.Name)
+
+	// Verify that an invalid dispatcher env var name returns an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  env:\n  - name: 1HTTP=PROXY\n    value: http://proxy:3128"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that a valid transient error requeue delay is loaded
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  transientErrorRequeue:\n    delayMillis: 30000\n    jitterFactor: 0.5"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(30000), eventingKafkaConfig.Kafka.TransientErrorRequeue.DelayMillis)
+	assert.Equal(t, 0.5, eventingKafkaConfig.Kafka.TransientErrorRequeue.JitterFactor)
+
+	// Verify that a negative transient error requeue delay returns an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  transientErrorRequeue:\n    delayMillis: -1"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that a valid topic finalization timeout is loaded
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  topicFinalization:\n    timeoutMillis: 60000\n    allowOrphan: true"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(60000), eventingKafkaConfig.Kafka.TopicFinalization.TimeoutMillis)
+	assert.True(t, eventingKafkaConfig.Kafka.TopicFinalization.AllowOrphan)
+
+	// Verify that a negative topic finalization timeout returns an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  topicFinalization:\n    timeoutMillis: -1"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that valid topic broker weights are loaded & negative or all-zero weights return an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  topic:\n    brokerWeights:\n      1: 3\n      2: 1\n      3: 0"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, map[int32]int32{1: 3, 2: 1, 3: 0}, eventingKafkaConfig.Kafka.Topic.BrokerWeights)
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  topic:\n    brokerWeights:\n      1: -1"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  topic:\n    brokerWeights:\n      1: 0\n      2: 0"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that a valid offset commit strategy, interval & store is loaded
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  offsetCommit:\n    strategy: manual-after-ack\n    intervalMillis: 500\n    store: configmap"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, commonconfig.OffsetCommitStrategyManualAfterAck, eventingKafkaConfig.Dispatcher.OffsetCommit.Strategy)
+	assert.Equal(t, int64(500), eventingKafkaConfig.Dispatcher.OffsetCommit.IntervalMillis)
+	assert.Equal(t, commonconfig.OffsetStoreConfigMap, eventingKafkaConfig.Dispatcher.OffsetCommit.Store)
+
+	// Verify that an unknown offset commit strategy or store, or a negative interval returns an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  offsetCommit:\n    store: redis"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  offsetCommit:\n    strategy: manual"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  offsetCommit:\n    intervalMillis: -1"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that valid max in-flight delivery limits are loaded & negative limits return an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  maxInFlight:\n    dispatcher: 100\n    subscription: 10"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(100), eventingKafkaConfig.Dispatcher.MaxInFlight.Dispatcher)
+	assert.Equal(t, int32(10), eventingKafkaConfig.Dispatcher.MaxInFlight.Subscription)
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "dispatcher:\n  maxInFlight:\n    subscription: -1"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that valid default extension allow / deny lists are loaded & denying a context attribute returns an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  extensions:\n    allow:\n    - traceparent\n    - partitionkey\n    deny:\n    - internal"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"traceparent", "partitionkey"}, eventingKafkaConfig.Kafka.Extensions.Allow)
+	assert.Equal(t, []string{"internal"}, eventingKafkaConfig.Kafka.Extensions.Deny)
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  extensions:\n    deny:\n    - source"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
+	// Verify that a broker discovery SRV record is loaded & a negative refresh interval returns an error
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  brokerDiscovery:\n    srvRecord: _kafka._tcp.kafka.example.com\n    refreshIntervalSeconds: 30"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, "_kafka._tcp.kafka.example.com", eventingKafkaConfig.Kafka.BrokerDiscovery.SrvRecord)
+	assert.Equal(t, int64(30), eventingKafkaConfig.Kafka.BrokerDiscovery.RefreshIntervalSeconds)
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = "kafka:\n  brokerDiscovery:\n    refreshIntervalSeconds: -1"
+	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
+	assert.Nil(t, eventingKafkaConfig)
+	assert.NotNil(t, err)
+
 	// Verify that a configmap with no data section returns an error
 	configMap.Data = nil
 	eventingKafkaConfig, err = LoadEventingKafkaSettings(configMap)
@@ -422,6 +619,57 @@ func TestLoadSettings(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+// Test The LoadSettings() Functionality Only Disables TLS Verification (With A Warning) When Explicitly Requested
+func TestLoadSettingsInsecureSkipVerify(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name         string
+		kafkaConfig  string
+		expectedSkip bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Unspecified", kafkaConfig: "", expectedSkip: false},
+		{name: "Explicitly Disabled", kafkaConfig: "kafka:\n  tls:\n    insecureSkipVerify: false\n", expectedSkip: false},
+		{name: "Not Implied By ServerName", kafkaConfig: "kafka:\n  tls:\n    serverName: kafka-proxy.example.com\n", expectedSkip: false},
+		{name: "Explicitly Enabled", kafkaConfig: "kafka:\n  tls:\n    insecureSkipVerify: true\n", expectedSkip: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Context With A Logger Capturing The Warnings
+			warnings := make([]string, 0)
+			logger := logtesting.TestLogger(t).Desugar().WithOptions(zap.Hooks(func(entry zapcore.Entry) error {
+				if entry.Level == zapcore.WarnLevel {
+					warnings = append(warnings, entry.Message)
+				}
+				return nil
+			}))
+			ctx := getTestSaramaContext(t, commontesting.OldSaramaConfig, commontesting.TestEKConfig+testCase.kafkaConfig)
+			ctx = logging.WithLogger(ctx, logger.Sugar())
+
+			// Perform The Test
+			saramaConfig, eventingKafkaConfig, err := LoadSettings(ctx)
+
+			// Verify The Results
+			assert.Nil(t, err)
+			assert.NotNil(t, eventingKafkaConfig)
+			assert.Equal(t, testCase.expectedSkip, eventingKafkaConfig.Kafka.TLS.InsecureSkipVerify)
+			assert.Equal(t, testCase.expectedSkip, InsecureSkipVerify(saramaConfig))
+			if testCase.expectedSkip {
+				assert.Len(t, warnings, 1)
+				assert.Contains(t, warnings[0], "InsecureSkipVerify")
+			} else {
+				assert.Empty(t, warnings)
+			}
+		})
+	}
+}
+
 func verifyTestEKConfigSettings(t *testing.T, saramaConfig *sarama.Config, eventingKafkaConfig *commonconfig.EventingKafkaConfig) {
 	// Quick checks to make sure the loaded configs aren't complete junk
 	assert.Equal(t, commontesting.OldUsername, saramaConfig.Net.SASL.User)
@@ -469,3 +717,450 @@ func TestExtractRootCerts(t *testing.T) {
 	assert.Nil(t, certPool)
 	assert.Nil(t, err)
 }
+
+// Test The MergeSaramaSettings() Mapping Of Producer.CompressionType
+func TestMergeSaramaSettingsCompressionType(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only            bool
+		name            string
+		version         string
+		compressionType string
+		wantCodec       sarama.CompressionCodec
+		wantErr         bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Unspecified", version: "2.3.0", wantCodec: sarama.CompressionNone},
+		{name: "None", version: "2.3.0", compressionType: "none", wantCodec: sarama.CompressionNone},
+		{name: "GZIP", version: "2.3.0", compressionType: "gzip", wantCodec: sarama.CompressionGZIP},
+		{name: "Snappy", version: "2.3.0", compressionType: "snappy", wantCodec: sarama.CompressionSnappy},
+		{name: "LZ4", version: "2.3.0", compressionType: "lz4", wantCodec: sarama.CompressionLZ4},
+		{name: "ZSTD", version: "2.3.0", compressionType: "zstd", wantCodec: sarama.CompressionZSTD},
+		{name: "Mixed Case", version: "2.3.0", compressionType: "Snappy", wantCodec: sarama.CompressionSnappy},
+		{name: "ZSTD With Old Kafka Version", version: "2.0.0", compressionType: "zstd", wantErr: true},
+		{name: "Unknown", version: "2.3.0", compressionType: "brotli", wantErr: true},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The Sarama Config YAML With The TestCase's CompressionType
+			saramaConfigYaml := "Version: " + testCase.version + "\nClientID: " + commontesting.NewClientId + "\n"
+			if len(testCase.compressionType) > 0 {
+				saramaConfigYaml += "Producer:\n  CompressionType: " + testCase.compressionType + "\n"
+			}
+			configMap := commontesting.GetTestSaramaConfigMap(saramaConfigYaml, commontesting.TestEKConfig)
+
+			// Perform The Test
+			config, err := MergeSaramaSettings(nil, configMap)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			if testCase.wantErr {
+				assert.Nil(t, config)
+			} else {
+				assert.NotNil(t, config)
+				assert.Equal(t, testCase.wantCodec, config.Producer.Compression)
+				assert.Nil(t, config.Validate())
+			}
+		})
+	}
+}
+
+// Test The MergeSaramaSettings() Mapping Of Net.DialTimeout, Net.ReadTimeout & Net.WriteTimeout
+func TestMergeSaramaSettingsNetTimeouts(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only             bool
+		name             string
+		netYaml          string
+		wantDialTimeout  time.Duration
+		wantReadTimeout  time.Duration
+		wantWriteTimeout time.Duration
+		wantErr          bool
+	}
+
+	// The Sarama Default Timeouts
+	defaults := sarama.NewConfig().Net
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Unspecified", wantDialTimeout: defaults.DialTimeout, wantReadTimeout: defaults.ReadTimeout, wantWriteTimeout: defaults.WriteTimeout},
+		{name: "Durations", netYaml: "  DialTimeout: 45s\n  ReadTimeout: 1m30s\n  WriteTimeout: 2m\n", wantDialTimeout: 45 * time.Second, wantReadTimeout: 90 * time.Second, wantWriteTimeout: 2 * time.Minute},
+		{name: "Nanoseconds", netYaml: "  DialTimeout: 45000000000\n", wantDialTimeout: 45 * time.Second, wantReadTimeout: defaults.ReadTimeout, wantWriteTimeout: defaults.WriteTimeout},
+		{name: "Lower Case Keys", netYaml: "  readTimeout: \"5s\"\n", wantDialTimeout: defaults.DialTimeout, wantReadTimeout: 5 * time.Second, wantWriteTimeout: defaults.WriteTimeout},
+		{name: "With Other Net Settings", netYaml: "  KeepAlive: 30000000000\n  WriteTimeout: 10s\n  MaxOpenRequests: 1\n", wantDialTimeout: defaults.DialTimeout, wantReadTimeout: defaults.ReadTimeout, wantWriteTimeout: 10 * time.Second},
+		{name: "Invalid Duration", netYaml: "  DialTimeout: 45 seconds\n", wantErr: true},
+		{name: "Zero Duration", netYaml: "  ReadTimeout: 0s\n", wantErr: true},
+		{name: "Negative Duration", netYaml: "  WriteTimeout: -10s\n", wantErr: true},
+		{name: "Negative Nanoseconds", netYaml: "  DialTimeout: -1\n", wantErr: true},
+		{name: "Invalid Type", netYaml: "  DialTimeout: true\n", wantErr: true},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The Sarama Config YAML With The TestCase's Net Settings
+			saramaConfigYaml := "Version: 2.3.0\nClientID: " + commontesting.NewClientId + "\n"
+			if len(testCase.netYaml) > 0 {
+				saramaConfigYaml += "Net:\n" + testCase.netYaml
+			}
+			configMap := commontesting.GetTestSaramaConfigMap(saramaConfigYaml, commontesting.TestEKConfig)
+
+			// Perform The Test
+			config, err := MergeSaramaSettings(nil, configMap)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			if testCase.wantErr {
+				assert.Nil(t, config)
+			} else {
+				assert.NotNil(t, config)
+				assert.Equal(t, testCase.wantDialTimeout, config.Net.DialTimeout)
+				assert.Equal(t, testCase.wantReadTimeout, config.Net.ReadTimeout)
+				assert.Equal(t, testCase.wantWriteTimeout, config.Net.WriteTimeout)
+				assert.Equal(t, commontesting.NewClientId, config.ClientID)
+				assert.Nil(t, config.Validate())
+			}
+		})
+	}
+}
+
+// Test The MergeSaramaSettings() Functionality With A kafka.version In The EventingKafka Config
+func TestMergeSaramaSettingsEventingKafkaVersion(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name          string
+		saramaVersion string
+		kafkaVersion  string
+		wantVersion   sarama.KafkaVersion
+		wantErr       bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Unspecified", wantVersion: constants.ConfigKafkaVersionDefault},
+		{name: "Unspecified With Sarama Version", saramaVersion: "2.3.0", wantVersion: sarama.V2_3_0_0},
+		{name: "Older Version", kafkaVersion: "0.11.0.2", wantVersion: sarama.V0_11_0_2},
+		{name: "Newer Version", kafkaVersion: "2.6.0", wantVersion: sarama.V2_6_0_0},
+		{name: "Quoted Version", kafkaVersion: `"2.4.0"`, wantVersion: sarama.V2_4_0_0},
+		{name: "Overrides Sarama Version", saramaVersion: "2.3.0", kafkaVersion: "2.0.0", wantVersion: sarama.V2_0_0_0},
+		{name: "Invalid Version", kafkaVersion: "two.six", wantErr: true},
+		{name: "Incomplete Version", kafkaVersion: "2", wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The ConfigMap With The TestCase's Sarama & EventingKafka Versions
+			saramaConfigYaml := "ClientID: " + commontesting.NewClientId + "\n"
+			if len(testCase.saramaVersion) > 0 {
+				saramaConfigYaml += "Version: " + testCase.saramaVersion + "\n"
+			}
+			ekConfigYaml := commontesting.TestEKConfig
+			if len(testCase.kafkaVersion) > 0 {
+				ekConfigYaml += "kafka:\n  version: " + testCase.kafkaVersion + "\n"
+			}
+			configMap := commontesting.GetTestSaramaConfigMap(saramaConfigYaml, ekConfigYaml)
+
+			// Perform The Test
+			config, err := MergeSaramaSettings(nil, configMap)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			if testCase.wantErr {
+				assert.Nil(t, config)
+				assert.Contains(t, err.Error(), "invalid kafka.version")
+			} else {
+				assert.NotNil(t, config)
+				assert.Equal(t, testCase.wantVersion, config.Version)
+			}
+		})
+	}
+}
+
+// Test The MergeSaramaSettings() Functionality With A kafka.tls.serverName In The EventingKafka Config
+func TestMergeSaramaSettingsTLSServerName(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name           string
+		saramaConfig   string
+		serverName     string
+		wantServerName string
+		wantTLSConfig  bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Unspecified", wantTLSConfig: false},
+		{name: "Empty", serverName: `""`, wantTLSConfig: false},
+		{name: "Whitespace", serverName: `"  "`, wantTLSConfig: false},
+		{name: "Specified", serverName: "kafka-proxy.example.com", wantServerName: "kafka-proxy.example.com", wantTLSConfig: true},
+		{name: "Trimmed", serverName: `" kafka-proxy.example.com "`, wantServerName: "kafka-proxy.example.com", wantTLSConfig: true},
+		{name: "With RootPEMs", saramaConfig: EKDefaultSaramaConfigWithRootCert, serverName: "kafka-proxy.example.com", wantServerName: "kafka-proxy.example.com", wantTLSConfig: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The ConfigMap With The TestCase's Sarama Config & EventingKafka TLS ServerName
+			saramaConfigYaml := testCase.saramaConfig
+			if len(saramaConfigYaml) <= 0 {
+				saramaConfigYaml = "ClientID: " + commontesting.NewClientId + "\n"
+			}
+			ekConfigYaml := commontesting.TestEKConfig
+			if len(testCase.serverName) > 0 {
+				ekConfigYaml += "kafka:\n  tls:\n    serverName: " + testCase.serverName + "\n"
+			}
+			configMap := commontesting.GetTestSaramaConfigMap(saramaConfigYaml, ekConfigYaml)
+
+			// Perform The Test
+			config, err := MergeSaramaSettings(nil, configMap)
+
+			// Verify The Results
+			assert.Nil(t, err)
+			assert.NotNil(t, config)
+			if testCase.wantTLSConfig {
+				assert.NotNil(t, config.Net.TLS.Config)
+				assert.Equal(t, testCase.wantServerName, config.Net.TLS.Config.ServerName)
+			} else if config.Net.TLS.Config != nil {
+				assert.Empty(t, config.Net.TLS.Config.ServerName)
+			}
+			if testCase.saramaConfig == EKDefaultSaramaConfigWithRootCert {
+				assert.NotNil(t, config.Net.TLS.Config.RootCAs)
+			}
+		})
+	}
+}
+
+// Test The MergeSaramaSettings() Functionality With A kafka.consumer In The EventingKafka Config
+func TestMergeSaramaSettingsConsumer(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name                  string
+		saramaConfig          string
+		consumerConfig        string
+		wantChannelBufferSize int
+		wantHeartbeat         time.Duration
+		wantMaxProcessingTime time.Duration
+		wantErr               bool
+	}
+
+	// Create The TestCases
+	defaults := sarama.NewConfig()
+	testCases := []TestCase{
+		{name: "Unspecified", wantChannelBufferSize: defaults.ChannelBufferSize, wantHeartbeat: defaults.Consumer.Group.Heartbeat.Interval, wantMaxProcessingTime: defaults.Consumer.MaxProcessingTime},
+		{name: "Specified", consumerConfig: "maxPollRecords: 500\n    heartbeatInterval: 2s\n    maxProcessingTime: 250ms", wantChannelBufferSize: 500, wantHeartbeat: 2 * time.Second, wantMaxProcessingTime: 250 * time.Millisecond},
+		{name: "Overrides Sarama Section", saramaConfig: "Consumer:\n  MaxProcessingTime: 1000000000\n", consumerConfig: "maxProcessingTime: 5s", wantChannelBufferSize: defaults.ChannelBufferSize, wantHeartbeat: defaults.Consumer.Group.Heartbeat.Interval, wantMaxProcessingTime: 5 * time.Second},
+		{name: "Heartbeat Within Larger Session Timeout", saramaConfig: "Consumer:\n  Group:\n    Session:\n      Timeout: 30000000000\n", consumerConfig: "heartbeatInterval: 15s", wantChannelBufferSize: defaults.ChannelBufferSize, wantHeartbeat: 15 * time.Second, wantMaxProcessingTime: defaults.Consumer.MaxProcessingTime},
+		{name: "Heartbeat Not Less Than Session Timeout", consumerConfig: "heartbeatInterval: 10s", wantErr: true},
+		{name: "Invalid Heartbeat", consumerConfig: "heartbeatInterval: often", wantErr: true},
+		{name: "Negative Max Processing Time", consumerConfig: "maxProcessingTime: -1s", wantErr: true},
+		{name: "Negative Max Poll Records", consumerConfig: "maxPollRecords: -1", wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The ConfigMap With The TestCase's Sarama Config & EventingKafka Consumer Config
+			saramaConfigYaml := "ClientID: " + commontesting.NewClientId + "\n" + testCase.saramaConfig
+			ekConfigYaml := commontesting.TestEKConfig
+			if len(testCase.consumerConfig) > 0 {
+				ekConfigYaml += "kafka:\n  consumer:\n    " + testCase.consumerConfig + "\n"
+			}
+			configMap := commontesting.GetTestSaramaConfigMap(saramaConfigYaml, ekConfigYaml)
+
+			// Perform The Test
+			config, err := MergeSaramaSettings(nil, configMap)
+
+			// Verify The Results
+			if testCase.wantErr {
+				assert.NotNil(t, err)
+				assert.Nil(t, config)
+				return
+			}
+			assert.Nil(t, err)
+			assert.NotNil(t, config)
+			assert.Equal(t, testCase.wantChannelBufferSize, config.ChannelBufferSize)
+			assert.Equal(t, testCase.wantHeartbeat, config.Consumer.Group.Heartbeat.Interval)
+			assert.Equal(t, testCase.wantMaxProcessingTime, config.Consumer.MaxProcessingTime)
+		})
+	}
+}
+
+// Test The Sarama Settings Hash Is Stable & Only Changes With The Sarama Settings
+func TestSaramaSettingsHash(t *testing.T) {
+
+	// Hash The Test ConfigMap
+	configMap := commontesting.GetTestSaramaConfigMap(commontesting.OldSaramaConfig, commontesting.TestEKConfig)
+	hash, err := SaramaSettingsHash(configMap)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, hash)
+
+	// Verify The Hash Is Stable Across Calls
+	stableHash, err := SaramaSettingsHash(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, stableHash)
+
+	// Verify Formatting Differences In The Sarama Settings Do Not Change The Hash
+	reorderedConfigMap := commontesting.GetTestSaramaConfigMap("ClientID: a\nVersion: 2.3.0\n", commontesting.TestEKConfig)
+	reorderedHash, err := SaramaSettingsHash(reorderedConfigMap)
+	assert.Nil(t, err)
+	originalConfigMap := commontesting.GetTestSaramaConfigMap("# Comment\nVersion:   2.3.0\nClientID: a", commontesting.TestEKConfig)
+	originalHash, err := SaramaSettingsHash(originalConfigMap)
+	assert.Nil(t, err)
+	assert.Equal(t, originalHash, reorderedHash)
+
+	// Verify Changes To The Eventing-Kafka Section (e.g. EnableSaramaLogging) Do Not Change The Hash
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = commontesting.TestEKConfig + "kafka:\n  enableSaramaLogging: true\n"
+	ekHash, err := SaramaSettingsHash(configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, ekHash)
+
+	// Verify A kafka.version In The Eventing-Kafka Section Does Change The Hash
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = commontesting.TestEKConfig + "kafka:\n  version: 2.6.0\n"
+	versionHash, err := SaramaSettingsHash(configMap)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, versionHash)
+
+	// Verify A kafka.tls.serverName In The Eventing-Kafka Section Does Change The Hash
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = commontesting.TestEKConfig + "kafka:\n  tls:\n    serverName: kafka-proxy.example.com\n"
+	serverNameHash, err := SaramaSettingsHash(configMap)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, serverNameHash)
+	assert.NotEqual(t, versionHash, serverNameHash)
+
+	// Verify A kafka.tls.insecureSkipVerify In The Eventing-Kafka Section Does Change The Hash
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = commontesting.TestEKConfig + "kafka:\n  tls:\n    insecureSkipVerify: true\n"
+	insecureHash, err := SaramaSettingsHash(configMap)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, insecureHash)
+
+	// Verify A kafka.consumer In The Eventing-Kafka Section Does Change The Hash
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = commontesting.TestEKConfig + "kafka:\n  consumer:\n    heartbeatInterval: 2s\n"
+	consumerHash, err := SaramaSettingsHash(configMap)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, consumerHash)
+
+	// Verify Changes To The Sarama Settings (e.g. Consumer Tuning) Do Change The Hash
+	tunedConfigMap := commontesting.GetTestSaramaConfigMap(commontesting.OldSaramaConfig+"Consumer:\n  Fetch:\n    Max: 1048576", commontesting.TestEKConfig)
+	tunedHash, err := SaramaSettingsHash(tunedConfigMap)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, tunedHash)
+
+	// Verify Invalid YAML & Empty ConfigMaps Return An Error
+	configMap.Data[commontesting.SaramaSettingsConfigKey] = "\tinvalidYAML"
+	_, err = SaramaSettingsHash(configMap)
+	assert.NotNil(t, err)
+	_, err = SaramaSettingsHash(&corev1.ConfigMap{})
+	assert.NotNil(t, err)
+	_, err = SaramaSettingsHash(nil)
+	assert.NotNil(t, err)
+}
+
+// Test The Merging Of The Consumer Fetch Sizes & MaxWaitTime (Latency / Throughput Tuning)
+func TestMergeSaramaSettingsConsumerFetch(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only            bool
+		name            string
+		consumerYaml    string
+		wantFetchMin    int32
+		wantFetchDef    int32
+		wantMaxWaitTime time.Duration
+		wantErr         bool
+	}
+
+	// The Sarama Default Consumer Settings
+	defaults := sarama.NewConfig().Consumer
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Unspecified", wantFetchMin: defaults.Fetch.Min, wantFetchDef: defaults.Fetch.Default, wantMaxWaitTime: defaults.MaxWaitTime},
+		{name: "Low Latency", consumerYaml: "  Fetch:\n    Min: 1\n    Default: 65536\n  MaxWaitTime: 50ms\n", wantFetchMin: 1, wantFetchDef: 65536, wantMaxWaitTime: 50 * time.Millisecond},
+		{name: "High Throughput", consumerYaml: "  Fetch:\n    Min: 65536\n    Default: 1048576\n  MaxWaitTime: 500ms\n", wantFetchMin: 65536, wantFetchDef: 1048576, wantMaxWaitTime: 500 * time.Millisecond},
+		{name: "Nanoseconds", consumerYaml: "  MaxWaitTime: 100000000\n", wantFetchMin: defaults.Fetch.Min, wantFetchDef: defaults.Fetch.Default, wantMaxWaitTime: 100 * time.Millisecond},
+		{name: "Lower Case Keys", consumerYaml: "  fetch:\n    min: 1024\n  maxWaitTime: \"1s\"\n", wantFetchMin: 1024, wantFetchDef: defaults.Fetch.Default, wantMaxWaitTime: time.Second},
+		{name: "With Other Consumer Settings", consumerYaml: "  Offsets:\n    AutoCommit:\n      Interval: 5000000000\n  MaxWaitTime: 300ms\n", wantFetchMin: defaults.Fetch.Min, wantFetchDef: defaults.Fetch.Default, wantMaxWaitTime: 300 * time.Millisecond},
+		{name: "Min Greater Than Default", consumerYaml: "  Fetch:\n    Min: 2097152\n", wantErr: true},
+		{name: "Zero Min", consumerYaml: "  Fetch:\n    Min: 0\n", wantErr: true},
+		{name: "Invalid MaxWaitTime", consumerYaml: "  MaxWaitTime: 250 millis\n", wantErr: true},
+		{name: "Zero MaxWaitTime", consumerYaml: "  MaxWaitTime: 0s\n", wantErr: true},
+		{name: "Negative MaxWaitTime", consumerYaml: "  MaxWaitTime: -250ms\n", wantErr: true},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The Sarama Config YAML With The TestCase's Consumer Settings
+			saramaConfigYaml := "Version: 2.3.0\nClientID: " + commontesting.NewClientId + "\n"
+			if len(testCase.consumerYaml) > 0 {
+				saramaConfigYaml += "Consumer:\n" + testCase.consumerYaml
+			}
+			configMap := commontesting.GetTestSaramaConfigMap(saramaConfigYaml, commontesting.TestEKConfig)
+
+			// Perform The Test
+			config, err := MergeSaramaSettings(nil, configMap)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			if testCase.wantErr {
+				assert.Nil(t, config)
+			} else {
+				assert.NotNil(t, config)
+				assert.Equal(t, testCase.wantFetchMin, config.Consumer.Fetch.Min)
+				assert.Equal(t, testCase.wantFetchDef, config.Consumer.Fetch.Default)
+				assert.Equal(t, testCase.wantMaxWaitTime, config.Consumer.MaxWaitTime)
+				assert.Equal(t, commontesting.NewClientId, config.ClientID)
+				assert.Nil(t, config.Validate())
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"github.com/xdg/scram"
+)
+
+// SCRAM Hash Generator Functions
+var (
+	SHA256 scram.HashGeneratorFcn = func() hash.Hash { return sha256.New() }
+	SHA512 scram.HashGeneratorFcn = func() hash.Hash { return sha512.New() }
+)
+
+// Ensure The XDGSCRAMClient Struct Implements The Sarama SCRAMClient Interface
+var _ sarama.SCRAMClient = &XDGSCRAMClient{}
+
+//
+// XDGSCRAMClient Implements The Sarama SCRAMClient Interface Using The xdg SCRAM Library
+//
+// Sarama only provides the SCRAM authentication handshake, leaving the actual SCRAM conversation
+// to be provided by the caller via the SCRAMClientGeneratorFunc (as demonstrated in the Sarama
+// examples).
+//
+type XDGSCRAMClient struct {
+	*scram.Client
+	*scram.ClientConversation
+	scram.HashGeneratorFcn
+}
+
+// Begin The SCRAM Conversation With The Specified Credentials
+func (x *XDGSCRAMClient) Begin(userName, password, authzID string) (err error) {
+	x.Client, err = x.HashGeneratorFcn.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	x.ClientConversation = x.Client.NewConversation()
+	return nil
+}
+
+// Perform The Next Step Of The SCRAM Conversation
+func (x *XDGSCRAMClient) Step(challenge string) (response string, err error) {
+	response, err = x.ClientConversation.Step(challenge)
+	return
+}
+
+// Determine Whether The SCRAM Conversation Is Complete
+func (x *XDGSCRAMClient) Done() bool {
+	return x.ClientConversation.Done()
+}
+
+//
+// Update The Sarama Config's SASL Mechanism (PLAIN, SCRAM-SHA-256, SCRAM-SHA-512)
+//
+// An empty mechanism leaves any mechanism already in the Sarama Config in place, which itself
+// defaults to PLAIN. The SCRAM mechanisms additionally require a SCRAMClientGeneratorFunc which
+// is configured here.  Any other mechanism is rejected with an error.
+//
+func UpdateSaramaConfigSaslMechanism(config *sarama.Config, mechanism string) error {
+
+	// Default To The Existing Mechanism (Or PLAIN If None)
+	mechanism = strings.TrimSpace(mechanism)
+	if len(mechanism) <= 0 {
+		mechanism = string(config.Net.SASL.Mechanism)
+	}
+	if len(mechanism) <= 0 {
+		mechanism = sarama.SASLTypePlaintext
+	}
+
+	// Configure The Sarama SASL Mechanism & SCRAM Client Generator
+	switch mechanism {
+	case sarama.SASLTypePlaintext:
+		config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		config.Net.SASL.SCRAMClientGeneratorFunc = nil
+	case sarama.SASLTypeSCRAMSHA256:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient { return &XDGSCRAMClient{HashGeneratorFcn: SHA256} }
+	case sarama.SASLTypeSCRAMSHA512:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient { return &XDGSCRAMClient{HashGeneratorFcn: SHA512} }
+	default:
+		return fmt.Errorf("unsupported SASL mechanism '%s' - expected one of %s, %s, or %s", mechanism, sarama.SASLTypePlaintext, sarama.SASLTypeSCRAMSHA256, sarama.SASLTypeSCRAMSHA512)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test The XDGSCRAMClient Conversation
+func TestXDGSCRAMClient(t *testing.T) {
+
+	// Create A New SCRAM-SHA-512 Client & Begin The Conversation
+	client := &XDGSCRAMClient{HashGeneratorFcn: SHA512}
+	err := client.Begin("TestUsername", "TestPassword", "")
+	assert.Nil(t, err)
+	assert.False(t, client.Done())
+
+	// Verify The Initial Client-First Message
+	response, err := client.Step("")
+	assert.Nil(t, err)
+	assert.Contains(t, response, "n=TestUsername")
+	assert.False(t, client.Done())
+
+	// Verify An Invalid Server-First Message Is Rejected
+	_, err = client.Step("invalid-challenge")
+	assert.NotNil(t, err)
+}
+
+// Test The UpdateSaramaConfigSaslMechanism() Functionality
+func TestUpdateSaramaConfigSaslMechanism(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name          string
+		existing      sarama.SASLMechanism
+		mechanism     string
+		wantMechanism sarama.SASLMechanism
+		wantScram     bool
+		wantErr       bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Absent Defaults To PLAIN", mechanism: "", wantMechanism: sarama.SASLTypePlaintext},
+		{name: "Absent Keeps Existing", existing: sarama.SASLTypeSCRAMSHA256, mechanism: "", wantMechanism: sarama.SASLTypeSCRAMSHA256, wantScram: true},
+		{name: "PLAIN", existing: sarama.SASLTypeSCRAMSHA512, mechanism: sarama.SASLTypePlaintext, wantMechanism: sarama.SASLTypePlaintext},
+		{name: "SCRAM-SHA-256", mechanism: sarama.SASLTypeSCRAMSHA256, wantMechanism: sarama.SASLTypeSCRAMSHA256, wantScram: true},
+		{name: "SCRAM-SHA-512", mechanism: " " + sarama.SASLTypeSCRAMSHA512 + "\n", wantMechanism: sarama.SASLTypeSCRAMSHA512, wantScram: true},
+		{name: "Unknown", mechanism: "GSSAPI", wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := sarama.NewConfig()
+			config.Net.SASL.Mechanism = testCase.existing
+			err := UpdateSaramaConfigSaslMechanism(config, testCase.mechanism)
+			if testCase.wantErr {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), testCase.mechanism)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.wantMechanism, config.Net.SASL.Mechanism)
+				assert.Equal(t, testCase.wantScram, config.Net.SASL.SCRAMClientGeneratorFunc != nil)
+				if testCase.wantScram {
+					assert.NotNil(t, config.Net.SASL.SCRAMClientGeneratorFunc())
+				}
+			}
+		})
+	}
+}
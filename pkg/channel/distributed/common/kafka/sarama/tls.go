@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+//
+// Parse The Client Certificate & Key PEMs (user.crt / user.key) Into A TLS Certificate For mTLS
+//
+// Both the certificate and the key are optional, but must be provided together.  In the case where
+// neither has been provided a nil Certificate is returned to indicate that mTLS is not in use.
+//
+func ParseTLSCertificate(certPem string, keyPem string) (*tls.Certificate, error) {
+
+	// Trim Any Surrounding Whitespace From The PEMs
+	certPem = strings.TrimSpace(certPem)
+	keyPem = strings.TrimSpace(keyPem)
+
+	// Validate The Certificate & Key Are Either Both Present Or Both Absent
+	if len(certPem) <= 0 && len(keyPem) <= 0 {
+		return nil, nil
+	} else if len(certPem) <= 0 {
+		return nil, fmt.Errorf("client key provided without a client certificate - both are required for mTLS")
+	} else if len(keyPem) <= 0 {
+		return nil, fmt.Errorf("client certificate provided without a client key - both are required for mTLS")
+	}
+
+	// Parse The Certificate & Key Into A TLS Certificate
+	certificate, err := tls.X509KeyPair([]byte(certPem), []byte(keyPem))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse client certificate / key pair: %s", err)
+	}
+	return &certificate, nil
+}
+
+// Utility Function For Setting The mTLS Client Certificates In The Sarama Config (Preserving Any RootCAs)
+func UpdateSaramaConfigTLSCertificates(config *sarama.Config, certificates []tls.Certificate) {
+	if len(certificates) <= 0 {
+		return
+	}
+	if config.Net.TLS.Config == nil {
+		config.Net.TLS.Config = &tls.Config{}
+	} else {
+		config.Net.TLS.Config = config.Net.TLS.Config.Clone()
+	}
+	config.Net.TLS.Config.Certificates = certificates
+}
+
+// Utility Function For Overriding Whether TLS Is Enabled In The Sarama Config (Empty Leaves The ConfigMap's Setting)
+func UpdateSaramaConfigTLSEnable(config *sarama.Config, tlsEnable string) error {
+	tlsEnable = strings.TrimSpace(tlsEnable)
+	if len(tlsEnable) <= 0 {
+		return nil
+	}
+	enable, err := strconv.ParseBool(tlsEnable)
+	if err != nil {
+		return fmt.Errorf("invalid TLS enable value '%s' - expected 'true' or 'false'", tlsEnable)
+	}
+	config.Net.TLS.Enable = enable
+	return nil
+}
+
+// Utility Function For Setting The RootCAs In The Sarama Config (Cloning Any Existing TLS Config So That It Is Not Shared)
+func UpdateSaramaConfigRootCAs(config *sarama.Config, certPool *x509.CertPool) {
+	if certPool == nil {
+		return
+	}
+	if config.Net.TLS.Config == nil {
+		config.Net.TLS.Config = &tls.Config{}
+	} else {
+		config.Net.TLS.Config = config.Net.TLS.Config.Clone()
+	}
+	config.Net.TLS.Config.RootCAs = certPool
+}
+
+// Utility Function For Setting The TLS ServerName In The Sarama Config (Empty Leaves The Default Of The Broker Hostname)
+func UpdateSaramaConfigTLSServerName(config *sarama.Config, serverName string) {
+	if len(serverName) <= 0 {
+		return
+	}
+	if config.Net.TLS.Config == nil {
+		config.Net.TLS.Config = &tls.Config{}
+	} else {
+		config.Net.TLS.Config = config.Net.TLS.Config.Clone()
+	}
+	config.Net.TLS.Config.ServerName = serverName
+}
+
+// Utility Function For Disabling TLS Verification In The Sarama Config (False Leaves Any Existing Setting Untouched)
+func UpdateSaramaConfigTLSInsecureSkipVerify(config *sarama.Config, insecureSkipVerify bool) {
+	if !insecureSkipVerify {
+		return
+	}
+	if config.Net.TLS.Config == nil {
+		config.Net.TLS.Config = &tls.Config{}
+	} else {
+		config.Net.TLS.Config = config.Net.TLS.Config.Clone()
+	}
+	config.Net.TLS.Config.InsecureSkipVerify = true
+}
+
+// Utility Function For Determining Whether TLS Verification Of The Kafka Brokers Is Disabled In The Sarama Config
+func InsecureSkipVerify(config *sarama.Config) bool {
+	return config != nil && config.Net.TLS.Config != nil && config.Net.TLS.Config.InsecureSkipVerify
+}
+
+// Log A Prominent Warning If TLS Verification Of The Kafka Brokers Is Disabled (Returning Whether It Was Logged)
+func LogInsecureSkipVerifyWarning(logger *zap.SugaredLogger, config *sarama.Config) bool {
+	if !InsecureSkipVerify(config) {
+		return false
+	}
+	logger.Warnw("!!! WARNING !!! TLS Certificate Verification Of The Kafka Brokers Is DISABLED (InsecureSkipVerify) - "+
+		"Connections Are Vulnerable To Man-In-The-Middle Attacks & Must Not Be Used In Production!",
+		zap.Bool("InsecureSkipVerify", true))
+	return true
+}
+
+// Utility Function For Getting The mTLS Client Certificates From The Sarama Config (If Any)
+func TLSCertificates(config *sarama.Config) []tls.Certificate {
+	if config == nil || config.Net.TLS.Config == nil {
+		return nil
+	}
+	return config.Net.TLS.Config.Certificates
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sarama
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The ParseTLSCertificate() Functionality
+func TestParseTLSCertificate(t *testing.T) {
+
+	// Generate A Self-Signed Certificate & Key Pair
+	certPem, keyPem := commontesting.GenerateSelfSignedCertificate(t)
+	otherCertPem, _ := commontesting.GenerateSelfSignedCertificate(t)
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name     string
+		certPem  string
+		keyPem   string
+		wantCert bool
+		wantErr  bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Neither Provided", certPem: "", keyPem: ""},
+		{name: "Valid Self-Signed Pair", certPem: certPem, keyPem: keyPem, wantCert: true},
+		{name: "Certificate Only", certPem: certPem, keyPem: "", wantErr: true},
+		{name: "Key Only", certPem: "", keyPem: keyPem, wantErr: true},
+		{name: "Mismatched Pair", certPem: otherCertPem, keyPem: keyPem, wantErr: true},
+		{name: "Invalid PEM", certPem: "invalid", keyPem: "invalid", wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			certificate, err := ParseTLSCertificate(testCase.certPem, testCase.keyPem)
+			assert.Equal(t, testCase.wantErr, err != nil)
+			assert.Equal(t, testCase.wantCert, certificate != nil)
+			if testCase.wantCert {
+				leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+				assert.Nil(t, err)
+				assert.Equal(t, "eventing-kafka-test", leaf.Subject.CommonName)
+			}
+		})
+	}
+}
+
+// Test The UpdateSaramaConfigTLSCertificates() & TLSCertificates() Functionality
+func TestUpdateSaramaConfigTLSCertificates(t *testing.T) {
+
+	// Generate A Self-Signed Certificate & Key Pair
+	certPem, keyPem := commontesting.GenerateSelfSignedCertificate(t)
+	certificate, err := ParseTLSCertificate(certPem, keyPem)
+	assert.Nil(t, err)
+
+	// Verify No Certificates Leaves The Config Untouched
+	config := sarama.NewConfig()
+	UpdateSaramaConfigTLSCertificates(config, nil)
+	assert.Nil(t, config.Net.TLS.Config)
+	assert.Nil(t, TLSCertificates(config))
+	assert.Nil(t, TLSCertificates(nil))
+
+	// Verify Certificates Are Set On A New TLS Config
+	UpdateSaramaConfigTLSCertificates(config, []tls.Certificate{*certificate})
+	assert.NotNil(t, config.Net.TLS.Config)
+	assert.Len(t, TLSCertificates(config), 1)
+
+	// Verify Any Existing RootCAs Are Preserved
+	rootCAs := x509.NewCertPool()
+	config = sarama.NewConfig()
+	config.Net.TLS.Config = &tls.Config{RootCAs: rootCAs}
+	UpdateSaramaConfigTLSCertificates(config, []tls.Certificate{*certificate})
+	assert.Equal(t, rootCAs, config.Net.TLS.Config.RootCAs)
+	assert.Len(t, config.Net.TLS.Config.Certificates, 1)
+
+	// Verify An Existing TLS Config Is Cloned (Not Modified)
+	existingTLSConfig := &tls.Config{RootCAs: rootCAs}
+	config = sarama.NewConfig()
+	config.Net.TLS.Config = existingTLSConfig
+	UpdateSaramaConfigTLSCertificates(config, []tls.Certificate{*certificate})
+	assert.Empty(t, existingTLSConfig.Certificates)
+	assert.Len(t, config.Net.TLS.Config.Certificates, 1)
+
+	// Verify ConfigEqual() Ignores The Client Certificates
+	otherConfig := sarama.NewConfig()
+	otherConfig.Net.TLS.Config = &tls.Config{RootCAs: rootCAs}
+	assert.True(t, ConfigEqual(config, otherConfig))
+}
+
+// Test The UpdateSaramaConfigRootCAs() Functionality
+func TestUpdateSaramaConfigRootCAs(t *testing.T) {
+
+	// Test Data
+	rootCAs := x509.NewCertPool()
+
+	// Verify No RootCAs Leaves The Config Untouched
+	config := sarama.NewConfig()
+	UpdateSaramaConfigRootCAs(config, nil)
+	assert.Nil(t, config.Net.TLS.Config)
+
+	// Verify RootCAs Are Set On A New TLS Config
+	UpdateSaramaConfigRootCAs(config, rootCAs)
+	assert.NotNil(t, config.Net.TLS.Config)
+	assert.Equal(t, rootCAs, config.Net.TLS.Config.RootCAs)
+
+	// Verify An Existing TLS Config Is Cloned (Not Modified) & Its Other Settings Preserved
+	existingTLSConfig := &tls.Config{InsecureSkipVerify: true}
+	config = sarama.NewConfig()
+	config.Net.TLS.Config = existingTLSConfig
+	UpdateSaramaConfigRootCAs(config, rootCAs)
+	assert.Nil(t, existingTLSConfig.RootCAs)
+	assert.Equal(t, rootCAs, config.Net.TLS.Config.RootCAs)
+	assert.True(t, config.Net.TLS.Config.InsecureSkipVerify)
+}
+
+// Test The UpdateSaramaConfigTLSServerName() Functionality
+func TestUpdateSaramaConfigTLSServerName(t *testing.T) {
+
+	// Verify An Empty ServerName Leaves The Config Untouched
+	config := sarama.NewConfig()
+	UpdateSaramaConfigTLSServerName(config, "")
+	assert.Nil(t, config.Net.TLS.Config)
+
+	// Verify The ServerName Is Set On A New TLS Config
+	UpdateSaramaConfigTLSServerName(config, "kafka-proxy.example.com")
+	assert.NotNil(t, config.Net.TLS.Config)
+	assert.Equal(t, "kafka-proxy.example.com", config.Net.TLS.Config.ServerName)
+
+	// Verify An Existing TLS Config Is Cloned (Not Modified) & Its Other Settings Preserved
+	rootCAs := x509.NewCertPool()
+	existingTLSConfig := &tls.Config{RootCAs: rootCAs}
+	config = sarama.NewConfig()
+	config.Net.TLS.Config = existingTLSConfig
+	UpdateSaramaConfigTLSServerName(config, "kafka-proxy.example.com")
+	assert.Empty(t, existingTLSConfig.ServerName)
+	assert.Equal(t, "kafka-proxy.example.com", config.Net.TLS.Config.ServerName)
+	assert.Equal(t, rootCAs, config.Net.TLS.Config.RootCAs)
+}
+
+// Test The UpdateSaramaConfigTLSEnable() Functionality
+func TestUpdateSaramaConfigTLSEnable(t *testing.T) {
+
+	// Verify An Empty Value Leaves The Config Untouched
+	config := sarama.NewConfig()
+	config.Net.TLS.Enable = true
+	assert.Nil(t, UpdateSaramaConfigTLSEnable(config, ""))
+	assert.True(t, config.Net.TLS.Enable)
+
+	// Verify TLS Can Be Disabled & Re-Enabled
+	assert.Nil(t, UpdateSaramaConfigTLSEnable(config, "false"))
+	assert.False(t, config.Net.TLS.Enable)
+	assert.Nil(t, UpdateSaramaConfigTLSEnable(config, " true "))
+	assert.True(t, config.Net.TLS.Enable)
+
+	// Verify An Invalid Value Returns An Error & Leaves The Config Untouched
+	assert.NotNil(t, UpdateSaramaConfigTLSEnable(config, "maybe"))
+	assert.True(t, config.Net.TLS.Enable)
+}
+
+// Test The UpdateSaramaConfigTLSInsecureSkipVerify() & InsecureSkipVerify() Functionality
+func TestUpdateSaramaConfigTLSInsecureSkipVerify(t *testing.T) {
+
+	// Verify False Leaves The Config Untouched (Verification Enabled)
+	config := sarama.NewConfig()
+	UpdateSaramaConfigTLSInsecureSkipVerify(config, false)
+	assert.Nil(t, config.Net.TLS.Config)
+	assert.False(t, InsecureSkipVerify(config))
+
+	// Verify True Disables Verification On A New TLS Config
+	UpdateSaramaConfigTLSInsecureSkipVerify(config, true)
+	assert.NotNil(t, config.Net.TLS.Config)
+	assert.True(t, InsecureSkipVerify(config))
+
+	// Verify An Existing TLS Config Is Cloned (Not Modified) & Its Other Settings Preserved
+	existingTLSConfig := &tls.Config{ServerName: "kafka-proxy.example.com"}
+	config = sarama.NewConfig()
+	config.Net.TLS.Config = existingTLSConfig
+	UpdateSaramaConfigTLSInsecureSkipVerify(config, true)
+	assert.False(t, existingTLSConfig.InsecureSkipVerify)
+	assert.True(t, InsecureSkipVerify(config))
+	assert.Equal(t, "kafka-proxy.example.com", config.Net.TLS.Config.ServerName)
+
+	// Verify A Nil Config Is Treated As Verified
+	assert.False(t, InsecureSkipVerify(nil))
+}
+
+// Test The LogInsecureSkipVerifyWarning() Functionality
+func TestLogInsecureSkipVerifyWarning(t *testing.T) {
+
+	// Create A Logger Capturing The Warnings
+	warnings := make([]string, 0)
+	logger := logtesting.TestLogger(t).Desugar().WithOptions(zap.Hooks(func(entry zapcore.Entry) error {
+		if entry.Level == zapcore.WarnLevel {
+			warnings = append(warnings, entry.Message)
+		}
+		return nil
+	})).Sugar()
+
+	// Verify No Warning Is Logged When TLS Verification Is Enabled
+	config := sarama.NewConfig()
+	assert.False(t, LogInsecureSkipVerifyWarning(logger, config))
+	config.Net.TLS.Config = &tls.Config{}
+	assert.False(t, LogInsecureSkipVerifyWarning(logger, config))
+	assert.Empty(t, warnings)
+
+	// Verify The Warning Is Logged When TLS Verification Is Disabled
+	config.Net.TLS.Config.InsecureSkipVerify = true
+	assert.True(t, LogInsecureSkipVerifyWarning(logger, config))
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "InsecureSkipVerify")
+}
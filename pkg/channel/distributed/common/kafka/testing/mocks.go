@@ -49,8 +49,12 @@ func NewMockConsumerGroup(t *testing.T) *MockConsumerGroup {
 }
 
 func (m *MockConsumerGroup) Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
-	<-m.consumeChan                      // Block To Simulate Real Execution
-	return sarama.ErrClosedConsumerGroup // Return ConsumerGroup Closed "Error" For Clean Shutdown
+	select {
+	case <-m.consumeChan: // Block To Simulate Real Execution
+		return sarama.ErrClosedConsumerGroup // Return ConsumerGroup Closed "Error" For Clean Shutdown
+	case <-ctx.Done(): // Session Ended (Drained) Before The ConsumerGroup Was Closed
+		return nil
+	}
 }
 
 func (m *MockConsumerGroup) Errors() <-chan error {
@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+// CloudEvent Extension Names Consist Of Lower-Case ASCII Letters & Digits (CloudEvents Spec v1.0)
+var extensionNameRegExp = regexp.MustCompile("^[a-z0-9]+$")
+
+// The CloudEvent Context Attributes (Of All Spec Versions) Which Are Never Filtered
+var contextAttributeNames = map[string]bool{
+	"specversion":         true,
+	"id":                  true,
+	"source":              true,
+	"type":                true,
+	"subject":             true,
+	"time":                true,
+	"datacontenttype":     true,
+	"dataschema":          true,
+	"schemaurl":           true,
+	"datacontentencoding": true,
+}
+
+// ExtensionFilter Limits The CloudEvent Extension Attributes Propagated Through A KafkaChannel
+type ExtensionFilter struct {
+	Allow []string `json:"allow,omitempty"` // Only these extensions are propagated (if non-empty)
+	Deny  []string `json:"deny,omitempty"`  // These extensions are never propagated (applied after Allow)
+}
+
+//
+// Create A Validated ExtensionFilter From The Specified Allow & Deny Lists
+//
+// Extension names must consist of lower-case letters and digits, and the standard CloudEvent context
+// attributes (id, source, type, etc.) may not be denied since they are never stripped.  A nil filter is
+// returned (propagating all extensions) if both lists are empty.
+//
+func NewExtensionFilter(allow []string, deny []string) (*ExtensionFilter, error) {
+	for _, name := range allow {
+		if !extensionNameRegExp.MatchString(name) {
+			return nil, fmt.Errorf("invalid extension allow list: name '%s' must consist of lower-case letters and digits", name)
+		}
+	}
+	for _, name := range deny {
+		if !extensionNameRegExp.MatchString(name) {
+			return nil, fmt.Errorf("invalid extension deny list: name '%s' must consist of lower-case letters and digits", name)
+		}
+		if contextAttributeNames[name] {
+			return nil, fmt.Errorf("invalid extension deny list: '%s' is a cloudevent context attribute which is never stripped", name)
+		}
+	}
+	if len(allow) == 0 && len(deny) == 0 {
+		return nil, nil
+	}
+	return &ExtensionFilter{Allow: allow, Deny: deny}, nil
+}
+
+//
+// Get The ExtensionFilter For The Specified (KafkaChannel) Annotations & Default Allow / Deny Lists
+//
+// The ExtensionsAllow & ExtensionsDeny annotations are comma-separated lists of extension names which each
+// replace the corresponding default list when present (an empty annotation therefore clears the default).
+//
+func ExtensionFilterFor(annotations map[string]string, defaultAllow []string, defaultDeny []string) (*ExtensionFilter, error) {
+	allow := defaultAllow
+	if annotation, ok := annotations[constants.ExtensionsAllowAnnotation]; ok {
+		allow = ParseExtensionNames(annotation)
+	}
+	deny := defaultDeny
+	if annotation, ok := annotations[constants.ExtensionsDenyAnnotation]; ok {
+		deny = ParseExtensionNames(annotation)
+	}
+	return NewExtensionFilter(allow, deny)
+}
+
+// Parse The Specified Comma-Separated List Of (Lower-Cased) Extension Names, Ignoring Empty Entries
+func ParseExtensionNames(value string) []string {
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if len(name) > 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Determine Whether The Specified Attribute Is Propagated (Context Attributes Always Are, As Is Everything With A Nil Filter)
+func (f *ExtensionFilter) Allows(name string) bool {
+	if f == nil || contextAttributeNames[name] {
+		return true
+	}
+	if len(f.Allow) > 0 && !containsString(f.Allow, name) {
+		return false
+	}
+	return !containsString(f.Deny, name)
+}
+
+// Remove The Extensions Which Are Not Allowed From The Specified CloudEvent & Return Their Names
+func (f *ExtensionFilter) Apply(event *cloudevents.Event) []string {
+	var removed []string
+	if f == nil || event == nil {
+		return removed
+	}
+	for name := range event.Extensions() {
+		if !f.Allows(name) {
+			removed = append(removed, name)
+		}
+	}
+	for _, name := range removed {
+		event.SetExtension(name, nil)
+	}
+	return removed
+}
+
+// Determine Whether The Specified String Slice Contains The Specified String
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
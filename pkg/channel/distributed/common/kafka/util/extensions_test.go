@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+)
+
+// Test The NewExtensionFilter() Functionality
+func TestNewExtensionFilter(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		allow       []string
+		deny        []string
+		expectNil   bool
+		expectError bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Empty Lists", expectNil: true},
+		{name: "Allow List", allow: []string{"traceparent", "partitionkey"}},
+		{name: "Deny List", deny: []string{"internal"}},
+		{name: "Allow Context Attribute", allow: []string{"subject", "traceparent"}},
+		{name: "Deny Context Attribute", deny: []string{"id"}, expectNil: true, expectError: true},
+		{name: "Invalid Allow Name", allow: []string{"Trace-Parent"}, expectNil: true, expectError: true},
+		{name: "Invalid Deny Name", deny: []string{"in_ternal"}, expectNil: true, expectError: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			filter, err := NewExtensionFilter(testCase.allow, testCase.deny)
+			assert.Equal(t, testCase.expectError, err != nil)
+			assert.Equal(t, testCase.expectNil, filter == nil)
+		})
+	}
+}
+
+// Test The ExtensionFilterFor() Functionality
+func TestExtensionFilterFor(t *testing.T) {
+
+	// No Annotations Uses The Defaults
+	filter, err := ExtensionFilterFor(nil, []string{"traceparent"}, []string{"internal"})
+	assert.Nil(t, err)
+	assert.Equal(t, &ExtensionFilter{Allow: []string{"traceparent"}, Deny: []string{"internal"}}, filter)
+
+	// Annotations Replace The Corresponding Default List
+	annotations := map[string]string{constants.ExtensionsAllowAnnotation: " TraceParent , , partitionkey "}
+	filter, err = ExtensionFilterFor(annotations, []string{"other"}, []string{"internal"})
+	assert.Nil(t, err)
+	assert.Equal(t, &ExtensionFilter{Allow: []string{"traceparent", "partitionkey"}, Deny: []string{"internal"}}, filter)
+
+	// Empty Annotations Clear The Defaults
+	annotations = map[string]string{constants.ExtensionsAllowAnnotation: "", constants.ExtensionsDenyAnnotation: ""}
+	filter, err = ExtensionFilterFor(annotations, []string{"traceparent"}, []string{"internal"})
+	assert.Nil(t, err)
+	assert.Nil(t, filter)
+
+	// Invalid Annotations Return An Error
+	annotations = map[string]string{constants.ExtensionsDenyAnnotation: "type"}
+	filter, err = ExtensionFilterFor(annotations, nil, nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, filter)
+}
+
+// Test The ExtensionFilter Apply() Functionality In Allow & Deny Modes
+func TestExtensionFilterApply(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name               string
+		filter             *ExtensionFilter
+		expectedExtensions []string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Nil Filter", filter: nil, expectedExtensions: []string{"traceparent", "partitionkey", "internal"}},
+		{name: "Allow Mode", filter: &ExtensionFilter{Allow: []string{"traceparent", "id"}}, expectedExtensions: []string{"traceparent"}},
+		{name: "Deny Mode", filter: &ExtensionFilter{Deny: []string{"internal"}}, expectedExtensions: []string{"traceparent", "partitionkey"}},
+		{name: "Allow & Deny Mode", filter: &ExtensionFilter{Allow: []string{"traceparent", "internal"}, Deny: []string{"internal"}}, expectedExtensions: []string{"traceparent"}},
+		{name: "Deny Context Attributes", filter: &ExtensionFilter{Deny: []string{"id", "source", "type", "subject", "time"}}, expectedExtensions: []string{"traceparent", "partitionkey", "internal"}},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A CloudEvent With All The Extensions
+			event := cloudevents.NewEvent()
+			event.SetID("TestId")
+			event.SetSource("TestSource")
+			event.SetType("TestType")
+			event.SetSubject("TestSubject")
+			event.SetExtension("traceparent", "TestTraceParent")
+			event.SetExtension("partitionkey", "TestPartitionKey")
+			event.SetExtension("internal", "TestInternal")
+
+			// Perform The Test & Verify The Remaining Extensions
+			testCase.filter.Apply(&event)
+			assert.Len(t, event.Extensions(), len(testCase.expectedExtensions))
+			for _, name := range testCase.expectedExtensions {
+				assert.Contains(t, event.Extensions(), name)
+			}
+
+			// Verify The Context Attributes Are Never Stripped
+			assert.Equal(t, "TestId", event.ID())
+			assert.Equal(t, "TestSource", event.Source())
+			assert.Equal(t, "TestType", event.Type())
+			assert.Equal(t, "TestSubject", event.Subject())
+			assert.Nil(t, event.Validate())
+		})
+	}
+}
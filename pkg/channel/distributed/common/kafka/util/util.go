@@ -17,15 +17,181 @@ limitations under the License.
 package util
 
 import (
+	"bytes"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"text/template"
 
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
 )
 
-// Get The Formatted Kafka Topic Name From The Specified Components
+// The Default Kafka Topic Name Template (Results In "<namespace>.<name>")
+const DefaultTopicNameTemplate = "{{.Namespace}}.{{.Name}}"
+
+// Sample Data Used To Validate A Topic Name Template
+var sampleTopicNameData = TopicNameData{Namespace: "sample-namespace", Name: "sample-name"}
+
+// Valid Kafka Topic Names (Legal Characters & Maximum Length Per Kafka)
+var validTopicNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`)
+
+// The Parsed Topic Name Template Used By TopicName() (Set Once At Startup Via SetTopicNameTemplate())
+var topicNameTemplate = template.Must(ParseTopicNameTemplate(DefaultTopicNameTemplate))
+var topicNameTemplateMutex = &sync.RWMutex{}
+
+// The Default Kafka ConsumerGroup ID Template (Results In "kafka.<subscriber-uid>")
+const DefaultConsumerGroupIdTemplate = "kafka.{{.SubscriberUID}}"
+
+// Sample Data Used To Validate A ConsumerGroup ID Template (Two Subscribers Of The Same Channel To Verify Uniqueness)
+var sampleConsumerGroupIdData = []ConsumerGroupIdData{
+	{Namespace: "sample-namespace", Name: "sample-name", SubscriberUID: "00000000-0000-0000-0000-000000000001"},
+	{Namespace: "sample-namespace", Name: "sample-name", SubscriberUID: "00000000-0000-0000-0000-000000000002"},
+}
+
+// Valid Kafka ConsumerGroup IDs (Restricted To The Same Legal Characters As Topic Names)
+var validConsumerGroupIdRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,255}$`)
+
+// The Parsed ConsumerGroup ID Template Used By ConsumerGroupId() (Set Once At Startup Via SetConsumerGroupIdTemplate())
+var consumerGroupIdTemplate = template.Must(ParseConsumerGroupIdTemplate(DefaultConsumerGroupIdTemplate))
+var consumerGroupIdTemplateMutex = &sync.RWMutex{}
+
+// The Default Kafka Client ID Template (Results In "knative-<namespace>-<name>-<role>")
+const DefaultClientIdTemplate = "knative-{{.Namespace}}-{{.Name}}-{{.Role}}"
+
+// Sample Data Used To Validate A Client ID Template (Both Roles Of Two Channels To Verify Uniqueness)
+var sampleClientIdData = []ClientIdData{
+	{Namespace: "sample-namespace", Name: "sample-name-1", Role: constants.ClientIdRoleDispatcher},
+	{Namespace: "sample-namespace", Name: "sample-name-1", Role: constants.ClientIdRoleProducer},
+	{Namespace: "sample-namespace", Name: "sample-name-2", Role: constants.ClientIdRoleDispatcher},
+	{Namespace: "sample-namespace", Name: "sample-name-2", Role: constants.ClientIdRoleProducer},
+}
+
+// Valid Kafka Client IDs (The Legal Characters Per Sarama's Config Validation)
+var validClientIdRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,255}$`)
+
+// The Parsed Client ID Template Used By ClientId() (Set Once At Startup Via SetClientIdTemplate())
+var clientIdTemplate = template.Must(ParseClientIdTemplate(DefaultClientIdTemplate))
+var clientIdTemplateMutex = &sync.RWMutex{}
+
+// Placeholder Components & Name Pattern Used To Match Topic Names Against The Topic Name Template
+const (
+	namespacePlaceholder = "\x00namespace\x00"
+	namePlaceholder      = "\x00name\x00"
+	k8sNamePattern       = `[a-z0-9]([-a-z0-9.]*[a-z0-9])?`
+)
+
+// The Data Available To A Topic Name Template
+type TopicNameData struct {
+	Namespace string
+	Name      string
+}
+
+// The Data Available To A ConsumerGroup ID Template
+type ConsumerGroupIdData struct {
+	Namespace     string
+	Name          string
+	SubscriberUID string
+}
+
+// The Data Available To A Client ID Template
+type ClientIdData struct {
+	Namespace string
+	Name      string
+	Role      string
+}
+
+// Parse & Validate The Specified Topic Name Template (Empty Results In The Default Template)
+func ParseTopicNameTemplate(text string) (*template.Template, error) {
+
+	// Use The Default Template If None Specified
+	if len(strings.TrimSpace(text)) == 0 {
+		text = DefaultTopicNameTemplate
+	}
+
+	// Parse The Template
+	topicTemplate, err := template.New("topicName").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid topic name template '%s': %v", text, err)
+	}
+
+	// Render The Template With Sample Data To Verify It Executes & Produces A Legal Kafka Topic Name
+	sampleTopicName, err := executeTopicNameTemplate(topicTemplate, sampleTopicNameData.Namespace, sampleTopicNameData.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid topic name template '%s': %v", text, err)
+	}
+	if !validTopicNameRegex.MatchString(sampleTopicName) {
+		return nil, fmt.Errorf("invalid topic name template '%s': rendered topic name '%s' is not a legal Kafka topic name", text, sampleTopicName)
+	}
+
+	// Return The Validated Template
+	return topicTemplate, nil
+}
+
+// Parse, Validate & Set The Topic Name Template To Be Used By TopicName() (Empty Results In The Default Template)
+func SetTopicNameTemplate(text string) error {
+	topicTemplate, err := ParseTopicNameTemplate(text)
+	if err != nil {
+		return err
+	}
+	topicNameTemplateMutex.Lock()
+	topicNameTemplate = topicTemplate
+	topicNameTemplateMutex.Unlock()
+	return nil
+}
+
+// Get The Formatted Kafka Topic Name From The Specified Components (Rendered Via The Current Topic Name Template)
 func TopicName(namespace string, name string) string {
-	return fmt.Sprintf("%s.%s", namespace, name)
+	topicNameTemplateMutex.RLock()
+	topicTemplate := topicNameTemplate
+	topicNameTemplateMutex.RUnlock()
+	topicName, err := executeTopicNameTemplate(topicTemplate, namespace, name)
+	if err != nil {
+		// Should Not Be Possible With A Validated Template - Fall Back To The Default Format
+		return fmt.Sprintf("%s.%s", namespace, name)
+	}
+	return topicName
+}
+
+//
+// Determine Whether The Specified Topic Name Was Produced By The Current Topic Name Template
+//
+// The template is rendered with placeholder components which are then replaced by patterns matching any valid
+// K8S Namespace / KafkaChannel name, so that the topics managed by the controller can be distinguished from any
+// other topics in the Kafka cluster.
+//
+func IsManagedTopicName(topicName string) bool {
+	topicNameTemplateMutex.RLock()
+	topicTemplate := topicNameTemplate
+	topicNameTemplateMutex.RUnlock()
+	placeholderTopicName, err := executeTopicNameTemplate(topicTemplate, namespacePlaceholder, namePlaceholder)
+	if err != nil {
+		return false
+	}
+	pattern := regexp.QuoteMeta(placeholderTopicName)
+	pattern = strings.ReplaceAll(pattern, namespacePlaceholder, k8sNamePattern)
+	pattern = strings.ReplaceAll(pattern, namePlaceholder, k8sNamePattern)
+	managedTopicNameRegex, err := regexp.Compile("^" + pattern + "$")
+	if err != nil {
+		return false
+	}
+	return managedTopicNameRegex.MatchString(topicName)
+}
+
+// Determine Whether The Specified Topic Name Is A Legal Kafka Topic Name
+func IsValidTopicName(topicName string) bool {
+	return validTopicNameRegex.MatchString(topicName)
+}
+
+// Render The Specified Topic Name Template With The Specified Components
+func executeTopicNameTemplate(topicTemplate *template.Template, namespace string, name string) (string, error) {
+	buffer := &bytes.Buffer{}
+	err := topicTemplate.Execute(buffer, TopicNameData{Namespace: namespace, Name: name})
+	if err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
 }
 
 // Append The KafkaChannel Service Name Suffix To The Specified String
@@ -37,3 +203,165 @@ func AppendKafkaChannelServiceNameSuffix(channelName string) string {
 func TrimKafkaChannelServiceNameSuffix(serviceName string) string {
 	return strings.TrimSuffix(serviceName, "-"+constants.KafkaChannelServiceNameSuffix)
 }
+
+//
+// Parse & Validate The Specified ConsumerGroup ID Template (Empty Results In The Default Template)
+//
+// In addition to producing legal ConsumerGroup IDs, the template must produce a distinct ID for each Subscriber
+// (by referencing the SubscriberUID) so that Subscribers never share (and therefore split) a ConsumerGroup.
+//
+func ParseConsumerGroupIdTemplate(text string) (*template.Template, error) {
+
+	// Use The Default Template If None Specified
+	if len(strings.TrimSpace(text)) == 0 {
+		text = DefaultConsumerGroupIdTemplate
+	}
+
+	// Parse The Template
+	groupIdTemplate, err := template.New("consumerGroupId").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid consumer group name template '%s': %v", text, err)
+	}
+
+	// Render The Template With Sample Data To Verify It Executes & Produces Legal, Unique ConsumerGroup IDs
+	sampleGroupIds := make(map[string]bool, len(sampleConsumerGroupIdData))
+	for _, sampleData := range sampleConsumerGroupIdData {
+		sampleGroupId, err := executeConsumerGroupIdTemplate(groupIdTemplate, sampleData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid consumer group name template '%s': %v", text, err)
+		}
+		if !validConsumerGroupIdRegex.MatchString(sampleGroupId) {
+			return nil, fmt.Errorf("invalid consumer group name template '%s': rendered consumer group name '%s' is not a legal Kafka consumer group name", text, sampleGroupId)
+		}
+		sampleGroupIds[sampleGroupId] = true
+	}
+	if len(sampleGroupIds) != len(sampleConsumerGroupIdData) {
+		return nil, fmt.Errorf("invalid consumer group name template '%s': consumer group names must be unique per subscription (reference {{.SubscriberUID}})", text)
+	}
+
+	// Return The Validated Template
+	return groupIdTemplate, nil
+}
+
+// Parse, Validate & Set The ConsumerGroup ID Template To Be Used By ConsumerGroupId() (Empty Results In The Default Template)
+func SetConsumerGroupIdTemplate(text string) error {
+	groupIdTemplate, err := ParseConsumerGroupIdTemplate(text)
+	if err != nil {
+		return err
+	}
+	consumerGroupIdTemplateMutex.Lock()
+	consumerGroupIdTemplate = groupIdTemplate
+	consumerGroupIdTemplateMutex.Unlock()
+	return nil
+}
+
+//
+// Parse & Validate The Specified Client ID Template (Empty Results In The Default Template)
+//
+// In addition to producing legal client IDs, the template must produce a distinct ID for each KafkaChannel and
+// role (by referencing the Namespace, Name & Role) so that broker-side quotas and request logs can be attributed
+// to the individual consumers & producers of each KafkaChannel.
+//
+func ParseClientIdTemplate(text string) (*template.Template, error) {
+
+	// Use The Default Template If None Specified
+	if len(strings.TrimSpace(text)) == 0 {
+		text = DefaultClientIdTemplate
+	}
+
+	// Parse The Template
+	idTemplate, err := template.New("clientId").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client id template '%s': %v", text, err)
+	}
+
+	// Render The Template With Sample Data To Verify It Executes & Produces Legal, Unique Client IDs
+	sampleClientIds := make(map[string]bool, len(sampleClientIdData))
+	for _, sampleData := range sampleClientIdData {
+		sampleClientId, err := executeClientIdTemplate(idTemplate, sampleData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client id template '%s': %v", text, err)
+		}
+		if !validClientIdRegex.MatchString(sampleClientId) {
+			return nil, fmt.Errorf("invalid client id template '%s': rendered client id '%s' is not a legal Kafka client id", text, sampleClientId)
+		}
+		sampleClientIds[sampleClientId] = true
+	}
+	if len(sampleClientIds) != len(sampleClientIdData) {
+		return nil, fmt.Errorf("invalid client id template '%s': client ids must be unique per channel and role (reference {{.Name}} and {{.Role}})", text)
+	}
+
+	// Return The Validated Template
+	return idTemplate, nil
+}
+
+// Parse, Validate & Set The Client ID Template To Be Used By ClientId() (Empty Results In The Default Template)
+func SetClientIdTemplate(text string) error {
+	idTemplate, err := ParseClientIdTemplate(text)
+	if err != nil {
+		return err
+	}
+	clientIdTemplateMutex.Lock()
+	clientIdTemplate = idTemplate
+	clientIdTemplateMutex.Unlock()
+	return nil
+}
+
+// Get The Kafka Client ID Used By The Specified Role (Consumer / Producer) For The Specified KafkaChannel
+func ClientId(namespace string, name string, role string) string {
+	clientIdTemplateMutex.RLock()
+	idTemplate := clientIdTemplate
+	clientIdTemplateMutex.RUnlock()
+	clientId, err := executeClientIdTemplate(idTemplate, ClientIdData{Namespace: namespace, Name: name, Role: role})
+	if err != nil || !validClientIdRegex.MatchString(clientId) {
+		// Should Not Be Possible With A Validated Template & Kubernetes Names - Fall Back To The Default Format
+		return fmt.Sprintf("knative-%s-%s-%s", namespace, name, role)
+	}
+	return clientId
+}
+
+// Render The Specified Client ID Template With The Specified Data
+func executeClientIdTemplate(idTemplate *template.Template, data ClientIdData) (string, error) {
+	buffer := &bytes.Buffer{}
+	err := idTemplate.Execute(buffer, data)
+	if err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// Parse The Specified KafkaChannel Max Message Bytes Annotation Value (Empty Results In Zero, Meaning Unspecified)
+func ParseMaxMessageBytes(value string) (int32, error) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return 0, nil
+	}
+	maxMessageBytes, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || maxMessageBytes <= 0 {
+		return 0, fmt.Errorf("invalid max message bytes '%s': must be a positive integer", value)
+	}
+	return int32(maxMessageBytes), nil
+}
+
+// Get The Kafka ConsumerGroup ID Used By The Dispatcher For The Specified KafkaChannel & Subscriber UID
+func ConsumerGroupId(namespace string, name string, subscriberUid string) string {
+	consumerGroupIdTemplateMutex.RLock()
+	groupIdTemplate := consumerGroupIdTemplate
+	consumerGroupIdTemplateMutex.RUnlock()
+	groupId, err := executeConsumerGroupIdTemplate(groupIdTemplate, ConsumerGroupIdData{Namespace: namespace, Name: name, SubscriberUID: subscriberUid})
+	if err != nil {
+		// Should Not Be Possible With A Validated Template - Fall Back To The Default Format
+		return fmt.Sprintf("kafka.%s", subscriberUid)
+	}
+	return groupId
+}
+
+// Render The Specified ConsumerGroup ID Template With The Specified Data
+func executeConsumerGroupIdTemplate(groupIdTemplate *template.Template, data ConsumerGroupIdData) (string, error) {
+	buffer := &bytes.Buffer{}
+	err := groupIdTemplate.Execute(buffer, data)
+	if err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
@@ -18,6 +18,7 @@ package util
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,6 +40,91 @@ func TestTopicName(t *testing.T) {
 	assert.Equal(t, expectedTopicName, actualTopicName)
 }
 
+// Test The TopicName() Functionality With A Custom Topic Name Template
+func TestTopicNameCustomTemplate(t *testing.T) {
+
+	// Restore The Default Template When Done
+	defer func() { assert.Nil(t, SetTopicNameTemplate("")) }()
+
+	// Set A Custom Template & Verify The Rendered Topic Name
+	assert.Nil(t, SetTopicNameTemplate("knative-{{.Namespace}}-{{.Name}}"))
+	assert.Equal(t, "knative-TestNamespace-TestName", TopicName("TestNamespace", "TestName"))
+
+	// Verify An Invalid Template Is Rejected & Leaves The Current Template In Place
+	assert.NotNil(t, SetTopicNameTemplate("{{.Namespace"))
+	assert.Equal(t, "knative-TestNamespace-TestName", TopicName("TestNamespace", "TestName"))
+
+	// Reset To The Default Template
+	assert.Nil(t, SetTopicNameTemplate(""))
+	assert.Equal(t, "TestNamespace.TestName", TopicName("TestNamespace", "TestName"))
+}
+
+// Test The IsManagedTopicName() Functionality
+func TestIsManagedTopicName(t *testing.T) {
+
+	// Restore The Default Template When Done
+	defer func() { assert.Nil(t, SetTopicNameTemplate("")) }()
+
+	// Verify Topic Names Against The Default Template
+	assert.True(t, IsManagedTopicName("test-namespace.test-name"))
+	assert.True(t, IsManagedTopicName("test-namespace.test.name"))
+	assert.False(t, IsManagedTopicName("__consumer_offsets"))
+	assert.False(t, IsManagedTopicName("unmanaged-topic"))
+	assert.False(t, IsManagedTopicName("Test-Namespace.Test-Name"))
+
+	// Verify Topic Names Against A Custom Template
+	assert.Nil(t, SetTopicNameTemplate("knative.{{.Namespace}}_{{.Name}}"))
+	assert.True(t, IsManagedTopicName("knative.test-namespace_test-name"))
+	assert.False(t, IsManagedTopicName("knativeXtest-namespace_test-name"))
+	assert.False(t, IsManagedTopicName("test-namespace.test-name"))
+}
+
+// Test The IsValidTopicName() Functionality
+func TestIsValidTopicName(t *testing.T) {
+	assert.True(t, IsValidTopicName("test-namespace.test-name"))
+	assert.True(t, IsValidTopicName("Dead_Letter-Topic.1"))
+	assert.False(t, IsValidTopicName(""))
+	assert.False(t, IsValidTopicName("dead/letter"))
+	assert.False(t, IsValidTopicName(strings.Repeat("a", 250)))
+}
+
+// Test The ParseTopicNameTemplate() Functionality
+func TestParseTopicNameTemplate(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name     string
+		template string
+		valid    bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Empty (Default)", template: "", valid: true},
+		{name: "Default", template: DefaultTopicNameTemplate, valid: true},
+		{name: "Custom", template: "prefix_{{.Name}}-{{.Namespace}}", valid: true},
+		{name: "Static", template: "static-topic", valid: true},
+		{name: "Unparseable", template: "{{.Namespace}", valid: false},
+		{name: "Unknown Field", template: "{{.Namespace}}.{{.Unknown}}", valid: false},
+		{name: "Illegal Characters", template: "{{.Namespace}}/{{.Name}}", valid: false},
+		{name: "Empty Rendering", template: "{{if false}}{{.Name}}{{end}}", valid: false},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			topicTemplate, err := ParseTopicNameTemplate(testCase.template)
+			if testCase.valid {
+				assert.Nil(t, err)
+				assert.NotNil(t, topicTemplate)
+			} else {
+				assert.NotNil(t, err)
+				assert.Nil(t, topicTemplate)
+			}
+		})
+	}
+}
+
 // Test The AppendChannelServiceNameSuffix() Functionality
 func TestAppendChannelServiceNameSuffix(t *testing.T) {
 
@@ -67,3 +153,129 @@ func TestTrimKafkaChannelServiceNameSuffix(t *testing.T) {
 	expectedResult := channelName
 	assert.Equal(t, expectedResult, actualResult)
 }
+
+// Test The ParseMaxMessageBytes() Functionality
+func TestParseMaxMessageBytes(t *testing.T) {
+	for value, expected := range map[string]int32{"": 0, " 2097152 ": 2097152} {
+		maxMessageBytes, err := ParseMaxMessageBytes(value)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, maxMessageBytes)
+	}
+	for _, value := range []string{"0", "-1", "1MB", "4294967296"} {
+		maxMessageBytes, err := ParseMaxMessageBytes(value)
+		assert.NotNil(t, err)
+		assert.Equal(t, int32(0), maxMessageBytes)
+	}
+}
+
+// Test The ConsumerGroupId() Functionality
+func TestConsumerGroupId(t *testing.T) {
+
+	// Restore The Default Template When Done
+	defer func() { assert.Nil(t, SetConsumerGroupIdTemplate("")) }()
+
+	// Verify The Default Template
+	assert.Equal(t, "kafka.TestSubscriberUid", ConsumerGroupId("TestNamespace", "TestName", "TestSubscriberUid"))
+
+	// Set A Custom Template & Verify The Rendered ConsumerGroup ID
+	assert.Nil(t, SetConsumerGroupIdTemplate("prod-cluster1.{{.Namespace}}.{{.Name}}.{{.SubscriberUID}}"))
+	assert.Equal(t, "prod-cluster1.TestNamespace.TestName.TestSubscriberUid", ConsumerGroupId("TestNamespace", "TestName", "TestSubscriberUid"))
+
+	// Verify An Invalid Template Is Rejected & Leaves The Current Template In Place
+	assert.NotNil(t, SetConsumerGroupIdTemplate("prod-cluster1.{{.Namespace}}"))
+	assert.Equal(t, "prod-cluster1.TestNamespace.TestName.TestSubscriberUid", ConsumerGroupId("TestNamespace", "TestName", "TestSubscriberUid"))
+}
+
+// Test The ParseConsumerGroupIdTemplate() Functionality
+func TestParseConsumerGroupIdTemplate(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name     string
+		template string
+		valid    bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Empty (Default)", template: "", valid: true},
+		{name: "Default", template: DefaultConsumerGroupIdTemplate, valid: true},
+		{name: "Custom", template: "dev_{{.Namespace}}-{{.Name}}-{{.SubscriberUID}}", valid: true},
+		{name: "Static", template: "static-group", valid: false},
+		{name: "Channel Only", template: "{{.Namespace}}.{{.Name}}", valid: false},
+		{name: "Unparseable", template: "{{.SubscriberUID}", valid: false},
+		{name: "Unknown Field", template: "{{.SubscriberUID}}.{{.Unknown}}", valid: false},
+		{name: "Illegal Characters", template: "{{.Namespace}}/{{.SubscriberUID}}", valid: false},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			groupIdTemplate, err := ParseConsumerGroupIdTemplate(testCase.template)
+			if testCase.valid {
+				assert.Nil(t, err)
+				assert.NotNil(t, groupIdTemplate)
+			} else {
+				assert.NotNil(t, err)
+				assert.Nil(t, groupIdTemplate)
+			}
+		})
+	}
+}
+
+// Test The ClientId() Functionality
+func TestClientId(t *testing.T) {
+
+	// Restore The Default Template When Done
+	defer func() { assert.Nil(t, SetClientIdTemplate("")) }()
+
+	// Verify The Default Template
+	assert.Equal(t, "knative-TestNamespace-TestName-dispatcher", ClientId("TestNamespace", "TestName", constants.ClientIdRoleDispatcher))
+	assert.Equal(t, "knative-TestNamespace-TestName-producer", ClientId("TestNamespace", "TestName", constants.ClientIdRoleProducer))
+
+	// Set A Custom Template & Verify The Rendered Client ID
+	assert.Nil(t, SetClientIdTemplate("prod-cluster1.{{.Namespace}}.{{.Name}}.{{.Role}}"))
+	assert.Equal(t, "prod-cluster1.TestNamespace.TestName.producer", ClientId("TestNamespace", "TestName", constants.ClientIdRoleProducer))
+
+	// Verify An Invalid Template Is Rejected & Leaves The Current Template In Place
+	assert.NotNil(t, SetClientIdTemplate("prod-cluster1.{{.Namespace}}.{{.Name}}"))
+	assert.Equal(t, "prod-cluster1.TestNamespace.TestName.producer", ClientId("TestNamespace", "TestName", constants.ClientIdRoleProducer))
+}
+
+// Test The ParseClientIdTemplate() Functionality
+func TestParseClientIdTemplate(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name     string
+		template string
+		valid    bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Empty (Default)", template: "", valid: true},
+		{name: "Default", template: DefaultClientIdTemplate, valid: true},
+		{name: "Custom", template: "dev_{{.Namespace}}.{{.Name}}.{{.Role}}", valid: true},
+		{name: "Static", template: "static-client", valid: false},
+		{name: "Channel Only", template: "{{.Namespace}}.{{.Name}}", valid: false},
+		{name: "Role Only", template: "{{.Namespace}}.{{.Role}}", valid: false},
+		{name: "Unparseable", template: "{{.Role}", valid: false},
+		{name: "Unknown Field", template: "{{.Name}}.{{.Role}}.{{.Unknown}}", valid: false},
+		{name: "Illegal Characters", template: "{{.Namespace}}/{{.Name}}/{{.Role}}", valid: false},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			clientIdTemplate, err := ParseClientIdTemplate(testCase.template)
+			if testCase.valid {
+				assert.Nil(t, err)
+				assert.NotNil(t, clientIdTemplate)
+			} else {
+				assert.NotNil(t, err)
+				assert.Nil(t, clientIdTemplate)
+			}
+		})
+	}
+}
@@ -33,6 +33,12 @@ const (
 	// LabelTopic is the label for the immutable name of the topic.
 	LabelTopic = "topic"
 
+	// LabelChannel is the label for the namespace/name of the KafkaChannel.
+	LabelChannel = "channel"
+
+	// LabelErrorType is the label for the type of a failed produce (e.g. not_enough_replicas).
+	LabelErrorType = "error_type"
+
 	// Sarama Metrics
 	RecordSendRateForTopicPrefix = "record-send-rate-for-topic-"
 )
@@ -45,24 +51,41 @@ var (
 		stats.UnitDimensionless,
 	)
 
+	// Counter For The Number Of Events Which Failed To Be Produced To A KafkaChannel's Kafka Topic
+	produceErrorCount = stats.Int64(
+		"kafka_channel_produce_errors", // The METRICS_DOMAIN will be prepended to the name.
+		"KafkaChannel Produce Error Count",
+		stats.UnitDimensionless,
+	)
+
 	// Create the tag keys that will be used to add tags to our measurements in order to validate
 	// that they conform to the restrictions described in go.opencensus.io/tag/validate.go.
 	// Currently those restrictions are...
 	//   - Length between 1 and 255 inclusive
 	//   - Characters are printable US-ASCII
-	topic = tag.MustNewKey(LabelTopic)
+	topic     = tag.MustNewKey(LabelTopic)
+	channel   = tag.MustNewKey(LabelChannel)
+	errorType = tag.MustNewKey(LabelErrorType)
 )
 
 // Register the OpenCensus View Structures
 func init() {
 
-	// Create A View To See Our Metric
-	err := view.Register(&view.View{
-		Description: producedMessageCount.Description(),
-		Measure:     producedMessageCount,
-		Aggregation: view.LastValue(),
-		TagKeys:     []tag.Key{topic},
-	})
+	// Create Views To See Our Metrics
+	err := view.Register(
+		&view.View{
+			Description: producedMessageCount.Description(),
+			Measure:     producedMessageCount,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{topic},
+		},
+		&view.View{
+			Description: produceErrorCount.Description(),
+			Measure:     produceErrorCount,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{channel, errorType},
+		},
+	)
 	if err != nil {
 		log.Printf("failed to register opencensus views, %v", err)
 	}
@@ -71,6 +94,7 @@ func init() {
 // StatsReporter defines the interface for sending ingress metrics.
 type StatsReporter interface {
 	Report(map[string]map[string]interface{})
+	ReportProduceError(channelName string, produceErrorType string)
 }
 
 // Verify StatsReporter Implements StatsReporter Interface
@@ -139,3 +163,21 @@ func (r *Reporter) Report(stats map[string]map[string]interface{}) {
 		}
 	}
 }
+
+// Report A Failed Produce To The Specified KafkaChannel ("namespace/name") Of The Specified Produce Error Type
+func (r *Reporter) ReportProduceError(channelName string, produceErrorType string) {
+
+	// Create A New OpenCensus Tag / Context For The KafkaChannel & Error Type
+	ctx, err := tag.New(
+		context.Background(),
+		tag.Insert(channel, channelName),
+		tag.Insert(errorType, produceErrorType),
+	)
+	if err != nil {
+		r.logger.Error("Failed To Create New OpenCensus Tags For Produce Error", zap.String("Channel", channelName), zap.String("ErrorType", produceErrorType))
+		return
+	}
+
+	// Record The Produce Error Count Metric
+	metrics.Record(ctx, produceErrorCount.M(1))
+}
@@ -50,6 +50,8 @@ func TestMetricsServer_Report(t *testing.T) {
 	metricsDomain := "eventing-kafka"
 	topicName := "test-topic-name"
 	msgCount := 13579
+	channelName := "test-namespace/test-channel"
+	produceErrorType := "not_enough_replicas"
 
 	// Initialize The Environment For The Test
 	assert.Nil(t, os.Setenv(system.NamespaceEnvKey, constants.KnativeEventingNamespace))
@@ -91,6 +93,8 @@ func TestMetricsServer_Report(t *testing.T) {
 
 	// Perform The Test
 	statsReporter.Report(stats)
+	statsReporter.ReportProduceError(channelName, produceErrorType)
+	statsReporter.ReportProduceError(channelName, produceErrorType)
 
 	// Verify The Results By Querying Metrics Endpoint And Parsing Results
 	resp, err := commontesting.RetryGet(fmt.Sprintf("http://localhost:%v/metrics", metricsPort), 100*time.Millisecond, 20, 404)
@@ -101,6 +105,7 @@ func TestMetricsServer_Report(t *testing.T) {
 	assert.Nil(t, err)
 	bodyStrings := strings.Split(string(body), "\n")
 	assert.True(t, verifyMetric(bodyStrings, "eventing_kafka_produced_msg_count", topicName, strconv.Itoa(msgCount)))
+	assert.True(t, verifyProduceErrorMetric(bodyStrings, "eventing_kafka_kafka_channel_produce_errors", channelName, produceErrorType, "2"))
 }
 
 // Utility Function For Creating Sample Test Metrics  (Representative Data From Sarama Metrics Trace - With Custom Test Data)
@@ -144,6 +149,19 @@ func verifyMetric(body []string, name string, topicName string, expectedValue st
 	return false
 }
 
+// Verifies that the metrics response string slice contains the desired produce error count
+func verifyProduceErrorMetric(body []string, name string, channelName string, produceErrorType string, expectedValue string) bool {
+	for _, line := range body {
+		if isMatch(line, fmt.Sprintf(`^%s`, name)) &&
+			isMatch(line, fmt.Sprintf(`channel="%s"`, channelName)) &&
+			isMatch(line, fmt.Sprintf(`error_type="%s"`, produceErrorType)) &&
+			isMatch(line, fmt.Sprintf(` %s$`, expectedValue)) {
+			return true
+		}
+	}
+	return false
+}
+
 // Simple regex match that treats errors as false, for testing only
 func isMatch(source string, regex string) bool {
 	match, err := regexp.MatchString(regex, source)
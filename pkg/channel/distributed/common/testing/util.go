@@ -17,6 +17,13 @@ limitations under the License.
 package testing
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"net/http"
 	"testing"
 	"time"
@@ -64,6 +71,37 @@ func GetDefaultSaramaConfig(t *testing.T) *sarama.Config {
 	return config
 }
 
+// Generate A Self-Signed Client Certificate & Key (PEM Encoded) For mTLS Testing
+func GenerateSelfSignedCertificate(t *testing.T) (string, string) {
+
+	// Generate A New Private Key
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	// Create A Self-Signed Client Certificate Template
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "eventing-kafka-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	// Self-Sign The Certificate
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	assert.Nil(t, err)
+
+	// Marshal The Private Key
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	assert.Nil(t, err)
+
+	// PEM Encode & Return The Certificate & Key
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})
+	return string(certPem), string(keyPem)
+}
+
 // Retries an HTTP GET request a specified number of times before giving up.
 // The retry is triggered if the GET response is either and error or the "retryAgain" value.  Passing in -1
 // will retry only on errors (as -1 is not a possible HTTP response).
@@ -39,7 +39,7 @@ func VerifyConfiguration(configuration *config.EventingKafkaConfig) error {
 	// Verify & Lowercase The Kafka AdminType
 	lowercaseKafkaAdminType := strings.ToLower(configuration.Kafka.AdminType)
 	switch lowercaseKafkaAdminType {
-	case constants.KafkaAdminTypeValueKafka, constants.KafkaAdminTypeValueAzure, constants.KafkaAdminTypeValueCustom:
+	case constants.KafkaAdminTypeValueKafka, constants.KafkaAdminTypeValueAzure, constants.KafkaAdminTypeValueCustom, constants.KafkaAdminTypeValueConfluent:
 		configuration.Kafka.AdminType = lowercaseKafkaAdminType
 	default:
 		return ControllerConfigurationError("Invalid / Unknown Kafka Admin Type: " + configuration.Kafka.AdminType)
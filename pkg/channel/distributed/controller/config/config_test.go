@@ -164,6 +164,10 @@ func TestVerifyConfiguration(t *testing.T) {
 	testCase.expectedError = ControllerConfigurationError("Receiver.Replicas must be > 0")
 	testCases = append(testCases, testCase)
 
+	testCase = getValidTestCase("Valid Config - Confluent Kafka.Provider")
+	testCase.kafkaAdminType = "confluent"
+	testCases = append(testCases, testCase)
+
 	testCase = getValidTestCase("Invalid Config - Kafka.Provider")
 	testCase.kafkaAdminType = "invalidadmintype"
 	testCase.expectedError = ControllerConfigurationError("Invalid / Unknown Kafka Admin Type: invalidadmintype")
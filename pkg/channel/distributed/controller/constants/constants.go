@@ -19,13 +19,17 @@ package constants
 const (
 
 	// Kafka Admin Type Types
-	KafkaAdminTypeValueKafka  = "kafka"
-	KafkaAdminTypeValueAzure  = "azure"
-	KafkaAdminTypeValueCustom = "custom"
+	KafkaAdminTypeValueKafka     = "kafka"
+	KafkaAdminTypeValueAzure     = "azure"
+	KafkaAdminTypeValueCustom    = "custom"
+	KafkaAdminTypeValueConfluent = "confluent"
 
 	// The Controller's Component Name (Needs To Be DNS Safe!)
 	ControllerComponentName = "eventing-kafka-channel-controller"
 
+	// The KafkaChannel Controller's Event Source Component (Matches The Generated Reconciler's Default Agent Name)
+	KafkaChannelControllerAgentName = "kafkachannel-controller"
+
 	// Knative Duck Versions
 	SubscribableDuckVersionAnnotationV1 = "v1"
 
@@ -35,6 +39,8 @@ const (
 	DeploymentKind          = "Deployment"
 	KnativeSubscriptionKind = "Subscription"
 	KafkaChannelKind        = "KafkaChannel"
+	ScaledObjectKind        = "ScaledObject"
+	PodDisruptionBudgetKind = "PodDisruptionBudget"
 
 	// HTTP Port
 	HttpPortName = "http"
@@ -48,16 +54,29 @@ const (
 	HttpContainerPortNumber = 8080
 
 	// Kafka Secret Data Keys
-	KafkaSecretDataKeyBrokers  = "brokers"
-	KafkaSecretDataKeyUsername = "username"
-	KafkaSecretDataKeyPassword = "password"
+	KafkaSecretDataKeyBrokers       = "brokers"
+	KafkaSecretDataKeyUsername      = "username"
+	KafkaSecretDataKeyPassword      = "password"
+	KafkaSecretDataKeySaslMechanism = "sasl.mechanism"
+	KafkaSecretDataKeyUserCert      = "user.crt"
+	KafkaSecretDataKeyUserKey       = "user.key"
+	KafkaSecretDataKeyTLSEnable     = "tls.enable"
 
 	// Prometheus MetricsPort
 	MetricsPortName = "metrics"
 
+	// KafkaChannel ConnectionReady Condition Reasons
+	KafkaConnectedReason          = "KafkaConnected"
+	KafkaConnectionFailedReason   = "KafkaConnectionFailed"
+	KafkaCircuitBreakerOpenReason = "KafkaCircuitBreakerOpen"
+
 	// Reconciliation Error Messages
-	ReconciliationFailedError = "reconciliation failed"
-	FinalizationFailedError   = "finalization failed"
+	ReconciliationFailedError        = "reconciliation failed"
+	FinalizationFailedError          = "finalization failed"
+	KafkaAdminClientUnavailableError = "kafka admin client unavailable"
+	KafkaCircuitBreakerOpenError     = "kafka circuit breaker open"
+	ReconciliationPausedError        = "reconciliation paused for maintenance"
+	KafkaTopicDryRunRetainedError    = "kafka topic retained in dry-run mode"
 
 	// Eventing-Kafka Finalizers Prefix
 	EventingKafkaFinalizerPrefix = "eventing-kafka/"
@@ -77,6 +96,55 @@ const (
 	KafkaSecretLabel            = "kafkasecret"             // Secret Label - Indicates The Kafka Secret Of The KafkaChannel
 	KafkaTopicLabel             = "kafkaTopic"              // Topic Label - Indicates The Kafka Topic Of The KnativeChannel
 
+	// Annotations
+	DryRunAnnotation      = "kafka.eventing.knative.dev/dry-run"      // DryRun Annotation - Enables (But Never Disables) DryRun For A KafkaChannel
+	RetainTopicAnnotation = "kafka.eventing.knative.dev/retain-topic" // RetainTopic Annotation - Preserves The Kafka Topic When A KafkaChannel Is Deleted
+	KafkaSecretAnnotation = "kafka.eventing.knative.dev/kafka-secret" // KafkaSecret Annotation - Explicitly Selects The Kafka Secret (Cluster) For A KafkaChannel
+	ConfigHashAnnotation  = "kafka.eventing.knative.dev/config-hash"  // ConfigHash Annotation - Hash Of The Sarama Settings On The Dispatcher Pod Template (Triggers Rolling Restarts)
+
+	// DisableTopicAutoCreate Annotation - Overrides The ConfigMap DisableTopicAutoCreate Setting For A KafkaChannel (Pre-Created Topics)
+	DisableTopicAutoCreateAnnotation = "kafka.eventing.knative.dev/disable-topic-auto-create"
+
+	// MigrateFromConsolidated Annotation - Allows Taking Over A KafkaChannel Previously Managed By The "Consolidated" Implementation
+	MigrateFromConsolidatedAnnotation = "kafka.eventing.knative.dev/migrate-from-consolidated"
+
+	// Additional Metadata Annotations - Track The Keys Of The ConfigMap-Provided Labels / Annotations On Generated Resources (For Pruning)
+	AdditionalLabelsAnnotation      = "kafka.eventing.knative.dev/additional-labels"
+	AdditionalAnnotationsAnnotation = "kafka.eventing.knative.dev/additional-annotations"
+
+	// Additional Env Annotation - Tracks The Names Of The ConfigMap-Provided Env Vars On The Dispatcher Pod Template (For Pruning)
+	AdditionalEnvAnnotation = "kafka.eventing.knative.dev/additional-env"
+
+	// Dispatcher Resource Override Annotations - Override The ConfigMap Dispatcher Resources For A KafkaChannel
+	DispatcherCpuRequestAnnotation    = "kafka.eventing.knative.dev/dispatcher.cpu.request"
+	DispatcherCpuLimitAnnotation      = "kafka.eventing.knative.dev/dispatcher.cpu.limit"
+	DispatcherMemoryRequestAnnotation = "kafka.eventing.knative.dev/dispatcher.memory.request"
+	DispatcherMemoryLimitAnnotation   = "kafka.eventing.knative.dev/dispatcher.memory.limit"
+
+	// TLS InsecureSkipVerify Status Annotation - Records On The KafkaChannel Status That TLS Verification Of The Kafka Brokers Is Disabled (Auditing)
+	TLSInsecureSkipVerifyAnnotation = "kafka.eventing.knative.dev/tls-insecure-skip-verify"
+
+	// Topic Config Clamped Status Annotation - Records On The KafkaChannel Status The Topic Config Values Clamped By The Brokers (JSON Map Of Name To Desired & Effective Values)
+	TopicConfigClampedAnnotation = "kafka.eventing.knative.dev/topic-config-clamped"
+
+	// Topic ReplicationFactor Mismatch Status Annotation - Records On The KafkaChannel Status The Current & Desired ReplicationFactor Of Its Topic (JSON)
+	TopicReplicationFactorMismatchAnnotation = "kafka.eventing.knative.dev/topic-replication-factor-mismatch"
+
+	// Topic Owner Status Annotation - Records On The KafkaChannel Status The Owner (UID, Namespace & Name) Of Its Kafka Topic (JSON)
+	TopicOwnerAnnotation = "kafka.eventing.knative.dev/topic-owner"
+
+	// Dispatcher Replicas Annotation - Overrides The ConfigMap Dispatcher Replicas For A KafkaChannel (Clamped To The Topic's Partitions)
+	DispatcherReplicasAnnotation = "kafka.eventing.knative.dev/dispatcher.replicas"
+
+	// Reconcile Paused Annotation - Set On The Eventing-Kafka ConfigMap To Pause All KafkaChannel Reconciliation (Fallback For The "reconcile-paused" Data Key)
+	ReconcilePausedAnnotation = "kafka.eventing.knative.dev/reconcile-paused"
+
+	// Paused Annotation - Pauses Consumption Of A KafkaChannel (Dispatcher Scaled To Zero) While Retaining Its Topic & Deployment
+	PausedAnnotation = "kafka.eventing.knative.dev/paused"
+
+	// Paused Condition Reason
+	PausedReason = "DispatcherPaused"
+
 	// Prometheus ServiceMonitor Selector Labels / Values
 	K8sAppChannelSelectorLabel    = "k8s-app"
 	K8sAppChannelSelectorValue    = "eventing-kafka-channels"
@@ -84,7 +152,23 @@ const (
 	K8sAppDispatcherSelectorValue = "eventing-kafka-dispatchers"
 
 	// Kafka Topic Configuration
-	KafkaTopicConfigRetentionMs = "retention.ms"
+	KafkaTopicConfigRetentionMs     = "retention.ms"
+	KafkaTopicConfigCleanupPolicy   = "cleanup.policy"
+	KafkaTopicConfigMaxMessageBytes = "max.message.bytes"
+
+	// Kafka Topic Bootstrap (Maximum Number Of Topics Per Bulk CreateTopics Request On Startup)
+	KafkaTopicBootstrapBatchSize = 100
+
+	// Kafka Topic ACLs (Granted To The Dispatcher & Receiver Principals From Any Host)
+	KafkaTopicACLHost = "*"
+
+	// Kafka Topic Audit (Structured Log Schema)
+	KafkaTopicAuditLogMessage    = "Kafka Topic Audit"
+	KafkaTopicAuditActionCreate  = "Create"
+	KafkaTopicAuditActionDelete  = "Delete"
+	KafkaTopicAuditActionOrphan  = "Orphan"
+	KafkaTopicAuditResultSuccess = "Success"
+	KafkaTopicAuditResultFailure = "Failure"
 
 	// Health Configuration
 	HealthPort                = 8082
@@ -96,4 +180,35 @@ const (
 	DispatcherLivenessPeriod  = 5
 	DispatcherReadinessDelay  = 10
 	DispatcherReadinessPeriod = 5
+
+	// Dispatcher Startup Probe Configuration (Defaults Allow Up To 5 Minutes To Start Before Liveness Applies)
+	DispatcherStartupPeriod           = 10
+	DispatcherStartupFailureThreshold = 30
+
+	// Dispatcher Shutdown Configuration
+	DispatcherDrainTimeoutSeconds          = 30 // Default Time Allowed For In-Flight Deliveries To Complete On Shutdown
+	DispatcherTerminationGracePeriodBuffer = 10 // Additional Time Allowed For Committing Offsets & Leaving The ConsumerGroups
+
+	// Dispatcher Metrics Configuration
+	DispatcherConsumerLagIntervalSeconds = 30 // Default Interval Between Consumer Lag Metric Updates
+
+	// Dispatcher KEDA ScaledObject Configuration
+	KedaKafkaTriggerType          = "kafka"
+	DispatcherKedaMinReplicaCount = 1 // Default Minimum (Never Scale To Zero So The Dispatcher Deployment Remains Ready)
+
+	// Dispatcher PodDisruptionBudget Configuration
+	DispatcherPodDisruptionBudgetMinAvailable = 1 // Default MinAvailable (Keep At Least One Dispatcher Replica Consuming During Evictions)
+
+	// Kafka Connection Circuit Breaker Configuration
+	CircuitBreakerFailureThreshold   = 5     // Default Consecutive Connection Failures Before Opening The Circuit
+	CircuitBreakerOpenDurationMillis = 30000 // Default Time The Circuit Remains Open Before A Half-Open Probe
+
+	// KafkaChannel Reconciliation Short-Circuit Configuration
+	ReconcileShortCircuitDeepReconcileIntervalSeconds = 600 // Default Maximum Time Between Full Reconciliations Of An Unchanged KafkaChannel
+
+	// Global Reconciliation Pause Configuration
+	ReconcilePausedRequeueDelaySeconds = 300 // Delay Before Re-Checking A KafkaChannel Whose Reconciliation Was Paused
+
+	// Subscriber ConsumerGroup Status Configuration
+	SubscriberConsumerGroupStatusIntervalSeconds = 30 // Minimum Interval Between Kafka Queries For A KafkaChannel's ConsumerGroup Status
 )
@@ -17,6 +17,9 @@ limitations under the License.
 package env
 
 import (
+	"fmt"
+	"strings"
+
 	"go.uber.org/zap"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
 )
@@ -29,6 +32,11 @@ const (
 
 	// Receiver Configuration
 	ReceiverImageEnvVarKey = "RECEIVER_IMAGE"
+
+	// Debug Configuration
+	DebugPortEnvVarKey = "DEBUG_PORT"
+	DefaultDebugPort   = "0"
+	MaxDebugPort       = 65535
 )
 
 // Environment Structure
@@ -38,6 +46,7 @@ type Environment struct {
 	ServiceAccount string // Required
 	MetricsPort    int    // Required
 	MetricsDomain  string // Required
+	DebugPort      int    // Optional (Zero Disables The Debug Server)
 
 	// Dispatcher Configuration
 	DispatcherImage string // Required
@@ -73,6 +82,15 @@ func GetEnvironment(logger *zap.Logger) (*Environment, error) {
 		return nil, err
 	}
 
+	// Get The Optional Debug Port Config Value (Must Be A Valid Port Or Zero)
+	debugPort, err := env.GetOptionalConfigInt64(logger, DebugPortEnvVarKey, DefaultDebugPort, "DebugPort")
+	if err != nil {
+		return nil, err
+	} else if debugPort < 0 || debugPort > MaxDebugPort {
+		return nil, fmt.Errorf("invalid (out of range) value '%d' for environment variable '%s'", debugPort, DebugPortEnvVarKey)
+	}
+	environment.DebugPort = int(debugPort)
+
 	//
 	// Dispatcher Configuration
 	//
@@ -82,6 +100,11 @@ func GetEnvironment(logger *zap.Logger) (*Environment, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = ValidateImage(DispatcherImageEnvVarKey, environment.DispatcherImage)
+	if err != nil {
+		logger.Error("Invalid Dispatcher Image", zap.Error(err))
+		return nil, err
+	}
 
 	//
 	// Receiver Configuration
@@ -92,6 +115,11 @@ func GetEnvironment(logger *zap.Logger) (*Environment, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = ValidateImage(ReceiverImageEnvVarKey, environment.ReceiverImage)
+	if err != nil {
+		logger.Error("Invalid Receiver Image", zap.Error(err))
+		return nil, err
+	}
 
 	// Log The ControllerConfig Loaded From Environment Variables
 	logger.Info("Environment Variables", zap.Any("Environment", environment))
@@ -99,3 +127,14 @@ func GetEnvironment(logger *zap.Logger) (*Environment, error) {
 	// Return The Populated ControllerConfig
 	return environment, nil
 }
+
+// Validate The Specified Container Image Name (Must Be Non-Blank & Contain No Whitespace)
+func ValidateImage(envVarKey string, image string) error {
+	if len(strings.TrimSpace(image)) == 0 {
+		return fmt.Errorf("missing container image for environment variable '%s'", envVarKey)
+	}
+	if strings.ContainsAny(image, " \t\r\n") {
+		return fmt.Errorf("invalid container image '%s' for environment variable '%s'", image, envVarKey)
+	}
+	return nil
+}
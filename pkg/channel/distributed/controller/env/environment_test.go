@@ -47,6 +47,7 @@ type TestCase struct {
 	serviceAccount        string
 	metricsPort           string
 	metricsDomain         string
+	debugPort             string
 	defaultKafkaConsumers string
 	dispatcherImage       string
 	channelImage          string
@@ -84,6 +85,20 @@ func TestGetEnvironment(t *testing.T) {
 	testCase.expectedError = getInvalidIntEnvironmentVariableError(testCase.metricsPort, env.MetricsPortEnvVarKey)
 	testCases = append(testCases, testCase)
 
+	testCase = getValidTestCase("Valid Config - DebugPort")
+	testCase.debugPort = "8082"
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - DebugPort")
+	testCase.debugPort = "NAN"
+	testCase.expectedError = fmt.Errorf("invalid (non int64) value '%s' for environment variable '%s'", testCase.debugPort, DebugPortEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - Out Of Range DebugPort")
+	testCase.debugPort = "65536"
+	testCase.expectedError = fmt.Errorf("invalid (out of range) value '%s' for environment variable '%s'", testCase.debugPort, DebugPortEnvVarKey)
+	testCases = append(testCases, testCase)
+
 	testCase = getValidTestCase("Missing Required Config - DispatcherImage")
 	testCase.dispatcherImage = ""
 	testCase.expectedError = getMissingRequiredEnvironmentVariableError(DispatcherImageEnvVarKey)
@@ -94,6 +109,21 @@ func TestGetEnvironment(t *testing.T) {
 	testCase.expectedError = getMissingRequiredEnvironmentVariableError(ReceiverImageEnvVarKey)
 	testCases = append(testCases, testCase)
 
+	testCase = getValidTestCase("Invalid Config - Blank DispatcherImage")
+	testCase.dispatcherImage = "   "
+	testCase.expectedError = fmt.Errorf("missing container image for environment variable '%s'", DispatcherImageEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - DispatcherImage With Whitespace")
+	testCase.dispatcherImage = "Test Dispatcher Image"
+	testCase.expectedError = fmt.Errorf("invalid container image '%s' for environment variable '%s'", testCase.dispatcherImage, DispatcherImageEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - Blank ReceiverImage")
+	testCase.channelImage = "   "
+	testCase.expectedError = fmt.Errorf("missing container image for environment variable '%s'", ReceiverImageEnvVarKey)
+	testCases = append(testCases, testCase)
+
 	// Loop Over All The TestCases
 	for _, testCase := range testCases {
 
@@ -102,6 +132,7 @@ func TestGetEnvironment(t *testing.T) {
 		assertSetenv(t, env.ServiceAccountEnvVarKey, testCase.serviceAccount)
 		assertSetenv(t, env.MetricsDomainEnvVarKey, testCase.metricsDomain)
 		assertSetenvNonempty(t, env.MetricsPortEnvVarKey, testCase.metricsPort)
+		assertSetenvNonempty(t, DebugPortEnvVarKey, testCase.debugPort)
 		assertSetenv(t, DispatcherImageEnvVarKey, testCase.dispatcherImage)
 		assertSetenv(t, ReceiverImageEnvVarKey, testCase.channelImage)
 
@@ -115,6 +146,11 @@ func TestGetEnvironment(t *testing.T) {
 			assert.NotNil(t, environment)
 			assert.Equal(t, testCase.serviceAccount, environment.ServiceAccount)
 			assert.Equal(t, testCase.metricsPort, strconv.Itoa(environment.MetricsPort))
+			if len(testCase.debugPort) > 0 {
+				assert.Equal(t, testCase.debugPort, strconv.Itoa(environment.DebugPort))
+			} else {
+				assert.Equal(t, 0, environment.DebugPort)
+			}
 			assert.Equal(t, testCase.channelImage, environment.ReceiverImage)
 			assert.Equal(t, testCase.dispatcherImage, environment.DispatcherImage)
 
@@ -140,6 +176,15 @@ func getValidTestCase(name string) TestCase {
 	}
 }
 
+// Test The ValidateImage() Functionality
+func TestValidateImage(t *testing.T) {
+	assert.Nil(t, ValidateImage(DispatcherImageEnvVarKey, dispatcherImage))
+	assert.Nil(t, ValidateImage(DispatcherImageEnvVarKey, "gcr.io/knative-releases/dispatcher@sha256:abc123"))
+	assert.NotNil(t, ValidateImage(DispatcherImageEnvVarKey, ""))
+	assert.NotNil(t, ValidateImage(DispatcherImageEnvVarKey, " \t "))
+	assert.NotNil(t, ValidateImage(DispatcherImageEnvVarKey, "dispatcher image"))
+}
+
 // Get The Expected Error Message For A Missing Required Environment Variable
 func getMissingRequiredEnvironmentVariableError(envVarKey string) error {
 	return fmt.Errorf("missing required environment variable '%s'", envVarKey)
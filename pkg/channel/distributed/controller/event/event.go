@@ -32,6 +32,10 @@ const (
 	// KafkaChannel Service (In User Namespace)
 	KafkaChannelServiceReconciliationFailed
 
+	// Migration From The "Consolidated" KafkaChannel Implementation
+	KafkaChannelMigrated
+	KafkaChannelMigrationFailed
+
 	// Channel Updates (Finalizers, Status)
 	ChannelUpdateFailed
 	ChannelStatusReconciliationFailed
@@ -42,16 +46,63 @@ const (
 
 	// Kafka Topic Reconciliation
 	KafkaTopicReconciliationFailed
+	KafkaTopicDryRun
+	KafkaTopicNotReady
+	TopicRetained
+	KafkaTopicConfigUpdated
+	KafkaTopicConfigClamped
+	KafkaTopicReplicationFactorMismatch
+	TopicPartitionsIncreased
+	KafkaTopicFinalizationTimedOut
+	KafkaTopicOwnershipMismatch
+
+	// Kafka Topic Lifecycle Audit (Distinct From The General Reconciliation Events For Filtering)
+	KafkaTopicAuditCreated
+	KafkaTopicAuditCreationFailed
+	KafkaTopicAuditDeleted
+	KafkaTopicAuditDeletionFailed
+	KafkaTopicAuditOrphaned
 
 	// Dispatcher (Kafka Consumer) Reconciliation
 	DispatcherServiceReconciliationFailed
 	DispatcherDeploymentReconciliationFailed
 	DispatcherServiceFinalizationFailed
 	DispatcherDeploymentFinalizationFailed
+	DispatcherConsumerConfigInvalid
+	DispatcherSubscriberConcurrencyInvalid
+	DispatcherSubscriberOrderingInvalid
+	DispatcherSubscriberDeadLetterTopicInvalid
+	DispatcherSubscriberInitialOffsetInvalid
+	DispatcherSubscriberFilterInvalid
+	DispatcherSubscriberShadowInvalid
+	DispatcherReplayTimestampInvalid
+	DispatcherExtensionFilterInvalid
+	DispatcherResourcesInvalid
+	DispatcherReplicasInvalid
+	DispatcherVolumesInvalid
+	DispatcherEnvInvalid
+	DispatcherReplicasClamped
+	DispatcherPaused
+	DispatcherResumed
+	DispatcherImageInvalid
+	DispatcherScaledObjectReconciliationFailed
+	DispatcherScaledObjectFinalizationFailed
+	DispatcherPodDisruptionBudgetReconciliationFailed
+	DispatcherPodDisruptionBudgetFinalizationFailed
+
+	// Channel-Level Dead Letter Sink Resolution
+	DeadLetterSinkResolutionFailed
+
+	// Subscriber Shadow Resolution
+	SubscriberShadowResolutionFailed
 
 	// Kafka Secret Reconciliation
 	KafkaSecretReconciled
 	KafkaSecretFinalized
+	KafkaSecretNotFound
+
+	// Eventing-Kafka ConfigMap Changes
+	SaramaSettingsInvalid
 )
 
 // CoreV1 EventType String Value
@@ -80,8 +131,40 @@ func (et CoreV1EventType) String() string {
 		eventTypeString = "ReceiverDeploymentReconciliationFailed"
 	case ChannelStatusReconciliationFailed:
 		eventTypeString = "ChannelStatusReconciliationFailed"
+	case KafkaChannelMigrated:
+		eventTypeString = "KafkaChannelMigrated"
+	case KafkaChannelMigrationFailed:
+		eventTypeString = "KafkaChannelMigrationFailed"
 	case KafkaTopicReconciliationFailed:
 		eventTypeString = "KafkaTopicReconciliationFailed"
+	case KafkaTopicDryRun:
+		eventTypeString = "KafkaTopicDryRun"
+	case KafkaTopicNotReady:
+		eventTypeString = "KafkaTopicNotReady"
+	case TopicRetained:
+		eventTypeString = "TopicRetained"
+	case KafkaTopicConfigUpdated:
+		eventTypeString = "KafkaTopicConfigUpdated"
+	case KafkaTopicConfigClamped:
+		eventTypeString = "KafkaTopicConfigClamped"
+	case KafkaTopicReplicationFactorMismatch:
+		eventTypeString = "KafkaTopicReplicationFactorMismatch"
+	case TopicPartitionsIncreased:
+		eventTypeString = "TopicPartitionsIncreased"
+	case KafkaTopicFinalizationTimedOut:
+		eventTypeString = "KafkaTopicFinalizationTimedOut"
+	case KafkaTopicOwnershipMismatch:
+		eventTypeString = "KafkaTopicOwnershipMismatch"
+	case KafkaTopicAuditCreated:
+		eventTypeString = "KafkaTopicAuditCreated"
+	case KafkaTopicAuditCreationFailed:
+		eventTypeString = "KafkaTopicAuditCreationFailed"
+	case KafkaTopicAuditDeleted:
+		eventTypeString = "KafkaTopicAuditDeleted"
+	case KafkaTopicAuditDeletionFailed:
+		eventTypeString = "KafkaTopicAuditDeletionFailed"
+	case KafkaTopicAuditOrphaned:
+		eventTypeString = "KafkaTopicAuditOrphaned"
 	case DispatcherServiceReconciliationFailed:
 		eventTypeString = "DispatcherServiceReconciliationFailed"
 	case DispatcherDeploymentReconciliationFailed:
@@ -90,10 +173,60 @@ func (et CoreV1EventType) String() string {
 		eventTypeString = "DispatcherServiceFinalizationFailed"
 	case DispatcherDeploymentFinalizationFailed:
 		eventTypeString = "DispatcherDeploymentFinalizationFailed"
+	case DispatcherConsumerConfigInvalid:
+		eventTypeString = "DispatcherConsumerConfigInvalid"
+	case DispatcherSubscriberConcurrencyInvalid:
+		eventTypeString = "DispatcherSubscriberConcurrencyInvalid"
+	case DispatcherSubscriberOrderingInvalid:
+		eventTypeString = "DispatcherSubscriberOrderingInvalid"
+	case DispatcherSubscriberDeadLetterTopicInvalid:
+		eventTypeString = "DispatcherSubscriberDeadLetterTopicInvalid"
+	case DispatcherSubscriberInitialOffsetInvalid:
+		eventTypeString = "DispatcherSubscriberInitialOffsetInvalid"
+	case DispatcherSubscriberFilterInvalid:
+		eventTypeString = "DispatcherSubscriberFilterInvalid"
+	case DispatcherSubscriberShadowInvalid:
+		eventTypeString = "DispatcherSubscriberShadowInvalid"
+	case DispatcherReplayTimestampInvalid:
+		eventTypeString = "DispatcherReplayTimestampInvalid"
+	case DispatcherExtensionFilterInvalid:
+		eventTypeString = "DispatcherExtensionFilterInvalid"
+	case DispatcherResourcesInvalid:
+		eventTypeString = "DispatcherResourcesInvalid"
+	case DispatcherReplicasInvalid:
+		eventTypeString = "DispatcherReplicasInvalid"
+	case DispatcherVolumesInvalid:
+		eventTypeString = "DispatcherVolumesInvalid"
+	case DispatcherEnvInvalid:
+		eventTypeString = "DispatcherEnvInvalid"
+	case DispatcherReplicasClamped:
+		eventTypeString = "DispatcherReplicasClamped"
+	case DispatcherPaused:
+		eventTypeString = "DispatcherPaused"
+	case DispatcherResumed:
+		eventTypeString = "DispatcherResumed"
+	case DispatcherImageInvalid:
+		eventTypeString = "DispatcherImageInvalid"
+	case DispatcherScaledObjectReconciliationFailed:
+		eventTypeString = "DispatcherScaledObjectReconciliationFailed"
+	case DispatcherScaledObjectFinalizationFailed:
+		eventTypeString = "DispatcherScaledObjectFinalizationFailed"
+	case DispatcherPodDisruptionBudgetReconciliationFailed:
+		eventTypeString = "DispatcherPodDisruptionBudgetReconciliationFailed"
+	case DispatcherPodDisruptionBudgetFinalizationFailed:
+		eventTypeString = "DispatcherPodDisruptionBudgetFinalizationFailed"
+	case DeadLetterSinkResolutionFailed:
+		eventTypeString = "DeadLetterSinkResolutionFailed"
+	case SubscriberShadowResolutionFailed:
+		eventTypeString = "SubscriberShadowResolutionFailed"
 	case KafkaSecretReconciled:
 		eventTypeString = "KafkaSecretReconciled"
 	case KafkaSecretFinalized:
 		eventTypeString = "KafkaSecretFinalized"
+	case KafkaSecretNotFound:
+		eventTypeString = "KafkaSecretNotFound"
+	case SaramaSettingsInvalid:
+		eventTypeString = "SaramaSettingsInvalid"
 	}
 
 	// Return The EventType String Value
@@ -31,13 +31,54 @@ func TestEventTypes(t *testing.T) {
 	performEventTypeStringTest(t, ReceiverServiceReconciliationFailed, "ReceiverServiceReconciliationFailed")
 	performEventTypeStringTest(t, ReceiverServiceReconciliationFailed, "ReceiverServiceReconciliationFailed")
 	performEventTypeStringTest(t, ReceiverDeploymentReconciliationFailed, "ReceiverDeploymentReconciliationFailed")
+	performEventTypeStringTest(t, KafkaChannelMigrated, "KafkaChannelMigrated")
+	performEventTypeStringTest(t, KafkaChannelMigrationFailed, "KafkaChannelMigrationFailed")
 	performEventTypeStringTest(t, KafkaTopicReconciliationFailed, "KafkaTopicReconciliationFailed")
+	performEventTypeStringTest(t, KafkaTopicDryRun, "KafkaTopicDryRun")
+	performEventTypeStringTest(t, KafkaTopicNotReady, "KafkaTopicNotReady")
+	performEventTypeStringTest(t, TopicRetained, "TopicRetained")
+	performEventTypeStringTest(t, KafkaTopicConfigUpdated, "KafkaTopicConfigUpdated")
+	performEventTypeStringTest(t, KafkaTopicConfigClamped, "KafkaTopicConfigClamped")
+	performEventTypeStringTest(t, KafkaTopicReplicationFactorMismatch, "KafkaTopicReplicationFactorMismatch")
+	performEventTypeStringTest(t, TopicPartitionsIncreased, "TopicPartitionsIncreased")
+	performEventTypeStringTest(t, KafkaTopicFinalizationTimedOut, "KafkaTopicFinalizationTimedOut")
+	performEventTypeStringTest(t, KafkaTopicOwnershipMismatch, "KafkaTopicOwnershipMismatch")
+	performEventTypeStringTest(t, KafkaTopicAuditCreated, "KafkaTopicAuditCreated")
+	performEventTypeStringTest(t, KafkaTopicAuditCreationFailed, "KafkaTopicAuditCreationFailed")
+	performEventTypeStringTest(t, KafkaTopicAuditDeleted, "KafkaTopicAuditDeleted")
+	performEventTypeStringTest(t, KafkaTopicAuditDeletionFailed, "KafkaTopicAuditDeletionFailed")
+	performEventTypeStringTest(t, KafkaTopicAuditOrphaned, "KafkaTopicAuditOrphaned")
 	performEventTypeStringTest(t, DispatcherServiceReconciliationFailed, "DispatcherServiceReconciliationFailed")
 	performEventTypeStringTest(t, DispatcherDeploymentReconciliationFailed, "DispatcherDeploymentReconciliationFailed")
 	performEventTypeStringTest(t, DispatcherServiceFinalizationFailed, "DispatcherServiceFinalizationFailed")
 	performEventTypeStringTest(t, DispatcherDeploymentFinalizationFailed, "DispatcherDeploymentFinalizationFailed")
+	performEventTypeStringTest(t, DispatcherConsumerConfigInvalid, "DispatcherConsumerConfigInvalid")
+	performEventTypeStringTest(t, DispatcherSubscriberConcurrencyInvalid, "DispatcherSubscriberConcurrencyInvalid")
+	performEventTypeStringTest(t, DispatcherSubscriberOrderingInvalid, "DispatcherSubscriberOrderingInvalid")
+	performEventTypeStringTest(t, DispatcherSubscriberDeadLetterTopicInvalid, "DispatcherSubscriberDeadLetterTopicInvalid")
+	performEventTypeStringTest(t, DispatcherSubscriberInitialOffsetInvalid, "DispatcherSubscriberInitialOffsetInvalid")
+	performEventTypeStringTest(t, DispatcherVolumesInvalid, "DispatcherVolumesInvalid")
+	performEventTypeStringTest(t, DispatcherEnvInvalid, "DispatcherEnvInvalid")
+	performEventTypeStringTest(t, DispatcherSubscriberFilterInvalid, "DispatcherSubscriberFilterInvalid")
+	performEventTypeStringTest(t, DispatcherReplayTimestampInvalid, "DispatcherReplayTimestampInvalid")
+	performEventTypeStringTest(t, DispatcherExtensionFilterInvalid, "DispatcherExtensionFilterInvalid")
+	performEventTypeStringTest(t, DispatcherSubscriberShadowInvalid, "DispatcherSubscriberShadowInvalid")
+	performEventTypeStringTest(t, DispatcherResourcesInvalid, "DispatcherResourcesInvalid")
+	performEventTypeStringTest(t, DispatcherReplicasInvalid, "DispatcherReplicasInvalid")
+	performEventTypeStringTest(t, DispatcherReplicasClamped, "DispatcherReplicasClamped")
+	performEventTypeStringTest(t, DispatcherPaused, "DispatcherPaused")
+	performEventTypeStringTest(t, DispatcherResumed, "DispatcherResumed")
+	performEventTypeStringTest(t, DispatcherImageInvalid, "DispatcherImageInvalid")
+	performEventTypeStringTest(t, DispatcherScaledObjectReconciliationFailed, "DispatcherScaledObjectReconciliationFailed")
+	performEventTypeStringTest(t, DispatcherScaledObjectFinalizationFailed, "DispatcherScaledObjectFinalizationFailed")
+	performEventTypeStringTest(t, DispatcherPodDisruptionBudgetReconciliationFailed, "DispatcherPodDisruptionBudgetReconciliationFailed")
+	performEventTypeStringTest(t, DispatcherPodDisruptionBudgetFinalizationFailed, "DispatcherPodDisruptionBudgetFinalizationFailed")
+	performEventTypeStringTest(t, DeadLetterSinkResolutionFailed, "DeadLetterSinkResolutionFailed")
+	performEventTypeStringTest(t, SubscriberShadowResolutionFailed, "SubscriberShadowResolutionFailed")
 	performEventTypeStringTest(t, KafkaSecretReconciled, "KafkaSecretReconciled")
 	performEventTypeStringTest(t, KafkaSecretFinalized, "KafkaSecretFinalized")
+	performEventTypeStringTest(t, KafkaSecretNotFound, "KafkaSecretNotFound")
+	performEventTypeStringTest(t, SaramaSettingsInvalid, "SaramaSettingsInvalid")
 }
 
 // Perform A Single Instance Of The CoreV1 EventType String Test
@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+)
+
+//
+// Bootstrap The Kafka Topics Of All Existing KafkaChannels
+//
+// On startup every KafkaChannel is enqueued for reconciliation, each of which creates its Kafka Topic with a separate
+// round-trip to the Kafka cluster.  With many KafkaChannels this can take a considerable amount of time, so instead
+// (when enabled) the Topics of all existing KafkaChannels are ensured up-front with as few bulk CreateTopics requests
+// as possible (one per batch per Kafka Secret).  The subsequent per-KafkaChannel reconciliation then finds the Topics
+// already exist and simply verifies them as usual.  Each Topic creation is audited and counted in the Topic operation
+// metrics, and the ownership of each created Topic is recorded in its KafkaChannel's status (when enabled), exactly as
+// when created by that reconciliation.  Failures are only logged, as that reconciliation will retry them.
+//
+// Returns the number of Topics which were successfully created or already existed.
+//
+func (r *Reconciler) bootstrapKafkaTopics(ctx context.Context) int {
+
+	// No Kafka AdminClients Are Created While Reconciliation Is Paused For Maintenance
+	if r.isReconcilePaused() {
+		r.logger.Info("KafkaChannel Reconciliation Paused - Skipping Kafka Topic Bootstrap")
+		return 0
+	}
+
+	r.logger.Info("Bootstrapping Kafka Topics Of Existing KafkaChannels")
+
+	// Get All The KafkaChannels From The Informer Cache
+	channels, err := r.kafkachannelLister.List(labels.Everything())
+	if err != nil {
+		r.logger.Error("Failed To List KafkaChannels - Skipping Kafka Topic Bootstrap", zap.Error(err))
+		return 0
+	}
+
+	// Group The TopicDetails Of The Relevant KafkaChannels By Their Explicitly Selected Kafka Secret (If Any)
+	topicDetailsBySecret := make(map[string]map[string]*sarama.TopicDetail)
+	channelsByTopic := make(map[string]*kafkav1beta1.KafkaChannel)
+	for _, channel := range channels {
+		logger := util.ChannelLogger(r.logger, channel)
+		if channel.DeletionTimestamp != nil || util.DryRun(channel, r.config, logger) || util.DisableTopicAutoCreate(channel, r.config, logger) {
+			continue
+		}
+		topicDetail := newTopicDetail(
+			util.NumPartitions(channel, r.config, logger),
+			util.ReplicationFactor(channel, r.config, logger),
+			util.RetentionMillis(channel, r.config, logger),
+			util.CleanupPolicy(channel, logger),
+			util.MaxMessageBytes(channel, logger))
+		if err := r.assignTopicReplicas(topicDetail); err != nil {
+			logger.Warn("Failed To Assign Topic Replicas - Skipping Kafka Topic Bootstrap", zap.Error(err))
+			continue
+		}
+		kafkaSecretName := util.KafkaSecretName(channel)
+		if topicDetailsBySecret[kafkaSecretName] == nil {
+			topicDetailsBySecret[kafkaSecretName] = make(map[string]*sarama.TopicDetail)
+		}
+		topicDetailsBySecret[kafkaSecretName][util.TopicName(channel)] = topicDetail
+		channelsByTopic[util.TopicName(channel)] = channel
+	}
+
+	// Ensure The Topics For Each Kafka Secret
+	ensuredCount := 0
+	for kafkaSecretName, topicDetails := range topicDetailsBySecret {
+		ensuredCount += r.bootstrapKafkaSecretTopics(ctx, kafkaSecretName, topicDetails, channelsByTopic)
+	}
+
+	r.logger.Info("Finished Bootstrapping Kafka Topics", zap.Int("Ensured", ensuredCount))
+	return ensuredCount
+}
+
+// Ensure The Specified Topics Of A Single Kafka Secret In Batches (Returning The Number Successfully Ensured)
+func (r *Reconciler) bootstrapKafkaSecretTopics(ctx context.Context, kafkaSecretName string, topicDetails map[string]*sarama.TopicDetail, channelsByTopic map[string]*kafkav1beta1.KafkaChannel) int {
+
+	// Add The K8S ClientSet & Any Explicitly Selected Kafka Secret To The Context
+	ctx = context.WithValue(ctx, kubeclient.Key{}, r.kubeClientset)
+	ctx = kafkaadmin.WithKafkaSecretName(ctx, kafkaSecretName)
+
+	// Don't let another goroutine clear out the admin client while we're using it in this one
+	r.adminMutex.Lock()
+	defer r.adminMutex.Unlock()
+
+	// Create A Kafka AdminClient For The Kafka Secret
+	err := r.SetKafkaAdminClient(ctx, nil)
+	defer r.ClearKafkaAdminClient()
+	if err != nil {
+		r.logger.Error("Failed To Bootstrap Kafka Topics - No Kafka AdminClient", zap.String("KafkaSecret", kafkaSecretName), zap.Error(err))
+		return 0
+	}
+
+	// Sort The Topic Names So That The Batches Are Deterministic
+	topicNames := make([]string, 0, len(topicDetails))
+	for topicName := range topicDetails {
+		topicNames = append(topicNames, topicName)
+	}
+	sort.Strings(topicNames)
+
+	// Create The Topics In Batches & Process The Per-Topic Results
+	ensuredCount := 0
+	for start := 0; start < len(topicNames); start += constants.KafkaTopicBootstrapBatchSize {
+		end := start + constants.KafkaTopicBootstrapBatchSize
+		if end > len(topicNames) {
+			end = len(topicNames)
+		}
+		batch := make(map[string]*sarama.TopicDetail, end-start)
+		for _, topicName := range topicNames[start:end] {
+			batch[topicName] = topicDetails[topicName]
+		}
+		for topicName, topicError := range r.adminClient.CreateTopics(ctx, batch) {
+			if r.bootstrapKafkaTopicResult(ctx, channelsByTopic[topicName], topicName, topicError) {
+				ensuredCount++
+			}
+		}
+	}
+	return ensuredCount
+}
+
+// Audit, Count & Record The Ownership Of A Single Bootstrapped Topic (Returning Whether It Was Successfully Ensured)
+func (r *Reconciler) bootstrapKafkaTopicResult(ctx context.Context, channel *kafkav1beta1.KafkaChannel, topicName string, topicError *sarama.TopicError) bool {
+
+	// Get Channel Specific Logger & Add Topic Name
+	logger := util.ChannelLogger(r.logger, channel).With(zap.String("TopicName", topicName))
+	numPartitions := util.NumPartitions(channel, r.config, logger)
+	replicationFactor := util.ReplicationFactor(channel, r.config, logger)
+
+	// Process The TopicError Result (Including Success ;)
+	err := adminutil.WrapTopicError(topicError)
+	switch {
+	case err == nil:
+		logger.Info("Successfully Bootstrapped New Kafka Topic")
+		recordTopicOperation(ctx, TopicOperationCreate, TopicResultSuccess)
+		r.auditKafkaTopicCreation(ctx, logger, channel, topicName, numPartitions, replicationFactor, nil)
+		r.bootstrapTopicOwner(ctx, logger, channel, topicName)
+		return true
+	case errors.Is(err, adminutil.ErrTopicExists):
+		recordTopicOperation(ctx, TopicOperationCreate, TopicResultExists)
+		return true
+	default:
+		logger.Warn("Failed To Bootstrap Kafka Topic - Deferring To Reconciliation", zap.Error(err))
+		recordTopicOperation(ctx, TopicOperationCreate, TopicResultError)
+		r.auditKafkaTopicCreation(ctx, logger, channel, topicName, numPartitions, replicationFactor, err)
+		return false
+	}
+}
+
+//
+// Record The Ownership Of A Bootstrapped Topic In Its KafkaChannel's Status (When Ownership Is Enabled)
+//
+// Unlike reconciliation, the bootstrap has no status update of its own, so the latest KafkaChannel is updated
+// directly (retrying on conflict, since it is likely being reconciled concurrently).  Without this record the
+// subsequent reconciliation would find the Topic already exists, and the KafkaChannel would never delete it.
+//
+func (r *Reconciler) bootstrapTopicOwner(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string) {
+	if !r.topicOwnershipEnabled() {
+		return
+	}
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestChannel, err := r.kafkaClientSet.MessagingV1beta1().KafkaChannels(channel.Namespace).Get(ctx, channel.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		} else if latestChannel.UID != channel.UID {
+			return nil // The KafkaChannel Was Recreated In The Meantime & Does Not Own The Topic
+		}
+		r.recordTopicOwner(latestChannel, topicName, true)
+		_, err = r.kafkaClientSet.MessagingV1beta1().KafkaChannels(channel.Namespace).UpdateStatus(ctx, latestChannel, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		logger.Error("Failed To Record Ownership Of Bootstrapped Kafka Topic", zap.Error(err))
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	fakekafkaclientset "knative.dev/eventing-kafka/pkg/client/clientset/versioned/fake"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The Reconciler's bootstrapKafkaTopics() Functionality
+func TestBootstrapKafkaTopics(t *testing.T) {
+
+	// Test Data - Enough Channels To Require Multiple Batches, Plus Ones Which Should Be Skipped / Fail
+	objects := make([]runtime.Object, 0)
+	for i := 0; i < constants.KafkaTopicBootstrapBatchSize+1; i++ {
+		channel := controllertesting.NewKafkaChannel()
+		channel.Name = fmt.Sprintf("kafkachannel-%d", i)
+		objects = append(objects, channel)
+	}
+	deletedChannel := controllertesting.NewKafkaChannel(controllertesting.WithDeletionTimestamp)
+	deletedChannel.Name = "deleted-kafkachannel"
+	dryRunChannel := newBootstrapKafkaChannel("dryrun-kafkachannel", constants.DryRunAnnotation, "true")
+	precreatedChannel := newBootstrapKafkaChannel("precreated-kafkachannel", constants.DisableTopicAutoCreateAnnotation, "true")
+	failedChannel := newBootstrapKafkaChannel("failed-kafkachannel", constants.KafkaSecretAnnotation, "other-kafka-secret")
+	objects = append(objects, deletedChannel, dryRunChannel, precreatedChannel, failedChannel)
+
+	// Create A Mock AdminClient Which Tracks The Bulk Requests & Fails The Selected Secret's Topic
+	var batches []map[string]*sarama.TopicDetail
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockCreateTopicsFunc: func(ctx context.Context, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+			batches = append(batches, topicDetails)
+			topicErrors := make(map[string]*sarama.TopicError, len(topicDetails))
+			for topicName := range topicDetails {
+				if kafkaadmin.KafkaSecretNameFromContext(ctx) == "other-kafka-secret" {
+					topicErrors[topicName] = adminutil.NewTopicError(sarama.ErrInvalidReplicationFactor, "invalid replication factor")
+				} else {
+					topicErrors[topicName] = adminutil.NewTopicError(sarama.ErrTopicAlreadyExists, "topic already exists")
+				}
+			}
+			return topicErrors
+		},
+	}
+
+	// Mock The Creation Of Kafka ClusterAdmin
+	newKafkaAdminClientWrapperPlaceholder := kafkaadmin.NewKafkaAdminClientWrapper
+	kafkaadmin.NewKafkaAdminClientWrapper = func(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (kafkaadmin.AdminClientInterface, error) {
+		return mockAdminClient, nil
+	}
+	defer func() {
+		kafkaadmin.NewKafkaAdminClientWrapper = newKafkaAdminClientWrapperPlaceholder
+	}()
+
+	// Create A Reconciler To Test
+	listers := controllertesting.NewListers(objects)
+	reconciler := &Reconciler{
+		logger:             logtesting.TestLogger(t).Desugar(),
+		kubeClientset:      fake.NewSimpleClientset(controllertesting.NewKafkaSecret(controllertesting.WithKafkaSecretLabel)),
+		config:             controllertesting.NewConfig(),
+		adminClientType:    kafkaadmin.Kafka,
+		adminMutex:         &sync.Mutex{},
+		kafkachannelLister: listers.GetKafkaChannelLister(),
+	}
+
+	// Perform The Test
+	ensuredCount := reconciler.bootstrapKafkaTopics(controller.WithEventRecorder(context.TODO(), record.NewFakeRecorder(10)))
+
+	// Verify The Results
+	assert.Equal(t, constants.KafkaTopicBootstrapBatchSize+1, ensuredCount)
+	assert.True(t, mockAdminClient.CreateTopicsBatchCalled())
+	assert.False(t, mockAdminClient.CreateTopicsCalled())
+	assert.Len(t, batches, 3)
+	topicNames := make(map[string]bool)
+	for _, batch := range batches {
+		assert.LessOrEqual(t, len(batch), constants.KafkaTopicBootstrapBatchSize)
+		for topicName := range batch {
+			topicNames[topicName] = true
+		}
+	}
+	assert.Len(t, topicNames, constants.KafkaTopicBootstrapBatchSize+2)
+	assert.True(t, topicNames[util.TopicName(failedChannel)])
+	assert.False(t, topicNames[util.TopicName(deletedChannel)])
+	assert.False(t, topicNames[util.TopicName(dryRunChannel)])
+	assert.False(t, topicNames[util.TopicName(precreatedChannel)])
+	assert.Nil(t, reconciler.adminClient)
+}
+
+// Test The Reconciler's bootstrapKafkaTopics() Audits & Records Ownership So That A Bootstrapped Topic Is Deleted On Finalization
+func TestBootstrapKafkaTopicsOwnership(t *testing.T) {
+
+	// Test Data - A KafkaChannel Whose Topic Is Created By The Bootstrap
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithFinalizer, controllertesting.WithInitializedConditions)
+	channel.UID = kafkaChannelUID
+
+	// Create A Mock AdminClient Which Creates The Bulk Requested Topics
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockCreateTopicsFunc: func(ctx context.Context, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+			topicErrors := make(map[string]*sarama.TopicError, len(topicDetails))
+			for topicName := range topicDetails {
+				topicErrors[topicName] = &sarama.TopicError{Err: sarama.ErrNoError}
+			}
+			return topicErrors
+		},
+	}
+
+	// Mock The Creation Of Kafka ClusterAdmin
+	newKafkaAdminClientWrapperPlaceholder := kafkaadmin.NewKafkaAdminClientWrapper
+	kafkaadmin.NewKafkaAdminClientWrapper = func(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (kafkaadmin.AdminClientInterface, error) {
+		return mockAdminClient, nil
+	}
+	defer func() {
+		kafkaadmin.NewKafkaAdminClientWrapper = newKafkaAdminClientWrapperPlaceholder
+	}()
+
+	// Create A Reconciler To Test With Topic Ownership Enabled
+	listers := controllertesting.NewListers([]runtime.Object{channel})
+	kafkaClientSet := fakekafkaclientset.NewSimpleClientset(channel)
+	config := controllertesting.NewConfig()
+	config.Kafka.TopicOwnership.Enabled = true
+	reconciler := &Reconciler{
+		logger:             logtesting.TestLogger(t).Desugar(),
+		kubeClientset:      fake.NewSimpleClientset(controllertesting.NewKafkaSecret(controllertesting.WithKafkaSecretLabel)),
+		kafkaClientSet:     kafkaClientSet,
+		config:             config,
+		adminClientType:    kafkaadmin.Kafka,
+		adminMutex:         &sync.Mutex{},
+		kafkachannelLister: listers.GetKafkaChannelLister(),
+	}
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+
+	// Perform The Bootstrap & Verify The Topic Creation Was Audited & Its Ownership Recorded
+	assert.Equal(t, 1, reconciler.bootstrapKafkaTopics(ctx))
+	assert.Contains(t, <-recorder.Events, event.KafkaTopicAuditCreated.String())
+	bootstrappedChannel, err := kafkaClientSet.MessagingV1beta1().KafkaChannels(channel.Namespace).Get(ctx, channel.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	owner, recorded := getTopicOwner(bootstrappedChannel)
+	assert.True(t, recorded)
+	assert.Equal(t, channel.UID, owner.UID)
+
+	// Reconcile & Finalize The Bootstrapped KafkaChannel (Now Finding The Topic Already Exists) & Verify The Topic Is Deleted
+	mockAdminClient.MockCreateTopicFunc = func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+		return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+	}
+	reconciler.adminClient = mockAdminClient
+	assert.Nil(t, reconciler.reconcileKafkaTopic(ctx, bootstrappedChannel))
+	bootstrappedChannel.DeletionTimestamp = controllertesting.NewKafkaChannel(controllertesting.WithDeletionTimestamp).DeletionTimestamp
+	assert.Nil(t, reconciler.finalizeKafkaTopic(ctx, bootstrappedChannel))
+	assert.True(t, mockAdminClient.DeleteTopicsCalled())
+}
+
+// Utility Function For Creating A Named KafkaChannel With The Specified Annotation
+func newBootstrapKafkaChannel(name string, annotationKey string, annotationValue string) *kafkav1beta1.KafkaChannel {
+	channel := controllertesting.NewKafkaChannel()
+	channel.Name = name
+	channel.Annotations = map[string]string{annotationKey: annotationValue}
+	return channel
+}
@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
 	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
@@ -39,7 +40,7 @@ import (
 func (r *Reconciler) reconcileChannel(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
 
 	// Get Channel Specific Logger
-	logger := util.ChannelLogger(r.logger, channel)
+	logger := util.ReconcileLogger(ctx, channel)
 
 	// Reconcile The KafkaChannel's Service
 	err := r.reconcileKafkaChannelService(ctx, logger, channel)
@@ -68,11 +69,11 @@ func (r *Reconciler) reconcileKafkaChannelService(ctx context.Context, logger *z
 	service, err := r.getKafkaChannelService(channel)
 	if service == nil || err != nil {
 
-		// If The Service Was Not Found - Then Create A New One For The Channel
+		// If The Service Was Not Found (e.g. Deleted By An Operator) - Then Create A New One For The Channel
 		if errors.IsNotFound(err) {
 			logger.Info("KafkaChannel Service Not Found - Creating New One")
 			service = r.newKafkaChannelService(channel)
-			service, err = r.kubeClientset.CoreV1().Services(service.Namespace).Create(ctx, service, metav1.CreateOptions{})
+			service, err = r.createService(ctx, service)
 			if err != nil {
 				logger.Error("Failed To Create KafkaChannel Service", zap.Error(err))
 				channel.Status.MarkChannelServiceFailed(event.KafkaChannelServiceReconciliationFailed.String(), "Failed To Create KafkaChannel Service: %v", err)
@@ -90,6 +91,12 @@ func (r *Reconciler) reconcileKafkaChannelService(ctx context.Context, logger *z
 
 		// Verify KafkaChannel Service Is Not Terminating
 		if service.DeletionTimestamp.IsZero() {
+			service, err = r.updateKafkaChannelServiceMetadata(ctx, logger, channel, service)
+			if err != nil {
+				logger.Error("Failed To Update KafkaChannel Service Metadata", zap.Error(err))
+				channel.Status.MarkChannelServiceFailed(event.KafkaChannelServiceReconciliationFailed.String(), "Failed To Update KafkaChannel Service: %v", err)
+				return err
+			}
 			logger.Info("Successfully Verified KafkaChannel Service")
 			// Continue To Update Channel Status
 		} else {
@@ -109,6 +116,21 @@ func (r *Reconciler) reconcileKafkaChannelService(ctx context.Context, logger *z
 	return nil
 }
 
+// Update The KafkaChannel Service's Additional Labels & Annotations If They Differ From Those Configured
+func (r *Reconciler) updateKafkaChannelServiceMetadata(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, service *corev1.Service) (*corev1.Service, error) {
+	desiredService := r.newKafkaChannelService(channel)
+	updatedService := service.DeepCopy()
+	if !util.ConvergeAdditionalMetadata(&updatedService.ObjectMeta, &desiredService.ObjectMeta) {
+		return service, nil
+	}
+	updatedService, err := r.kubeClientset.CoreV1().Services(updatedService.Namespace).Update(ctx, updatedService, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Successfully Updated KafkaChannel Service Metadata")
+	return updatedService, nil
+}
+
 // Get The KafkaChannel Service Associated With The Specified Channel
 func (r *Reconciler) getKafkaChannelService(channel *kafkav1beta1.KafkaChannel) (*corev1.Service, error) {
 
@@ -132,8 +154,8 @@ func (r *Reconciler) newKafkaChannelService(channel *kafkav1beta1.KafkaChannel)
 	deploymentName := util.ReceiverDnsSafeName(r.kafkaSecretName(channel))
 	serviceAddress := network.GetServiceHostname(deploymentName, commonconstants.KnativeEventingNamespace)
 
-	// Create & Return The Service Model
-	return &corev1.Service{
+	// Create The Service Model
+	service := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: corev1.SchemeGroupVersion.String(),
 			Kind:       constants.ServiceKind,
@@ -156,13 +178,37 @@ func (r *Reconciler) newKafkaChannelService(channel *kafkav1beta1.KafkaChannel)
 			ExternalName: serviceAddress,
 		},
 	}
+
+	// Merge Any Additional Labels & Annotations From The ConfigMap & Return The Service Model
+	util.AddAdditionalMetadata(&service.ObjectMeta, r.additionalMetadata())
+	return service
+}
+
+// Create The Specified Service, Tolerating A Stale Lister Which Has Not Yet Observed A Previously Created Instance
+func (r *Reconciler) createService(ctx context.Context, service *corev1.Service) (*corev1.Service, error) {
+	createdService, err := r.kubeClientset.CoreV1().Services(service.Namespace).Create(ctx, service, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		return r.kubeClientset.CoreV1().Services(service.Namespace).Get(ctx, service.Name, metav1.GetOptions{})
+	}
+	return createdService, err
 }
 
 //
 // Utility Functions (Uses AdminClient)
 //
 
-// Get The Kafka Auth Secret Corresponding To The Specified KafkaChannel
+// Get The Additional Labels & Annotations For Generated Deployments / Services (None If Not Configured)
+func (r *Reconciler) additionalMetadata() commonconfig.EKMetadataConfig {
+	if r.config == nil {
+		return commonconfig.EKMetadataConfig{}
+	}
+	return r.config.Metadata
+}
+
+// Get The Kafka Auth Secret Corresponding To The Specified KafkaChannel (Explicit Selection Takes Precedence)
 func (r *Reconciler) kafkaSecretName(channel *kafkav1beta1.KafkaChannel) string {
-	return r.adminClient.GetKafkaSecretName(util.TopicName(channel))
+	if kafkaSecretName := util.KafkaSecretName(channel); len(kafkaSecretName) > 0 {
+		return kafkaSecretName
+	}
+	return r.adminClientKafkaSecretName(util.TopicName(channel))
 }
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The Repair Of A KafkaChannel Service Which Was Deleted Out-Of-Band
+func TestReconcileKafkaChannelServiceDeleted(t *testing.T) {
+
+	// Initialize The Reconciler With An Initially Empty Cluster
+	ctx := context.TODO()
+	kubeClientset := fake.NewSimpleClientset()
+	listers := controllertesting.NewListers(nil)
+	r := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		kubeClientset: kubeClientset,
+		environment:   controllertesting.NewEnvironment(),
+		config:        controllertesting.NewConfig(),
+		serviceLister: listers.GetServiceLister(),
+	}
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	serviceName := kafkautil.AppendKafkaChannelServiceNameSuffix(channel.Name)
+
+	// Reconcile The Initial KafkaChannel Service
+	assert.Nil(t, r.reconcileKafkaChannelService(ctx, r.logger, channel))
+	originalService, err := kubeClientset.CoreV1().Services(channel.Namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.NotNil(t, originalService)
+
+	// Delete The KafkaChannel Service (As An Operator Might Do Accidentally)
+	assert.Nil(t, kubeClientset.CoreV1().Services(channel.Namespace).Delete(ctx, serviceName, metav1.DeleteOptions{}))
+
+	// Reconcile Again & Verify The KafkaChannel Service Was Recreated Identically (Owner Reference Intact)
+	channel = controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	assert.Nil(t, r.reconcileKafkaChannelService(ctx, r.logger, channel))
+	recreatedService, err := kubeClientset.CoreV1().Services(channel.Namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.NotNil(t, recreatedService)
+	assert.Equal(t, originalService.Spec, recreatedService.Spec)
+	assert.Equal(t, originalService.Labels, recreatedService.Labels)
+	assert.Equal(t, originalService.OwnerReferences, recreatedService.OwnerReferences)
+	assert.Equal(t, []metav1.OwnerReference{util.NewChannelOwnerReference(channel)}, recreatedService.OwnerReferences)
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionChannelServiceReady).IsTrue())
+	assert.NotNil(t, channel.Status.Address)
+}
+
+// Test The Reconciliation Of A KafkaChannel Service Which The (Stale) Lister Has Not Yet Observed
+func TestReconcileKafkaChannelServiceStaleLister(t *testing.T) {
+
+	// Initialize The Reconciler With An Existing Service Unknown To The Lister
+	ctx := context.TODO()
+	service := controllertesting.NewKafkaChannelService()
+	listers := controllertesting.NewListers([]runtime.Object{})
+	r := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		kubeClientset: fake.NewSimpleClientset(service),
+		environment:   controllertesting.NewEnvironment(),
+		config:        controllertesting.NewConfig(),
+		serviceLister: listers.GetServiceLister(),
+	}
+
+	// Perform The Test
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	err := r.reconcileKafkaChannelService(ctx, r.logger, channel)
+
+	// Verify The AlreadyExists Conflict Was Tolerated
+	assert.Nil(t, err)
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionChannelServiceReady).IsTrue())
+}
+
+// Test The Reconciliation Of A KafkaChannel Service When The Lister Fails With An Error Other Than NotFound
+func TestReconcileKafkaChannelServiceListerError(t *testing.T) {
+
+	// Initialize The Reconciler With A Failing Service Lister
+	ctx := context.TODO()
+	kubeClientset := fake.NewSimpleClientset()
+	r := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		kubeClientset: kubeClientset,
+		environment:   controllertesting.NewEnvironment(),
+		config:        controllertesting.NewConfig(),
+		serviceLister: &failingServiceLister{err: errors.New(controllertesting.ErrorString)},
+	}
+
+	// Perform The Test
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	err := r.reconcileKafkaChannelService(ctx, r.logger, channel)
+
+	// Verify The Error Was Returned Without Creating A Service & The Condition Was Marked Failed
+	assert.NotNil(t, err)
+	condition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionChannelServiceReady)
+	assert.True(t, condition.IsFalse())
+	assert.Equal(t, event.KafkaChannelServiceReconciliationFailed.String(), condition.Reason)
+	services, err := kubeClientset.CoreV1().Services(channel.Namespace).List(ctx, metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, services.Items, 0)
+}
+
+// Test The Repair Of A Dispatcher Service Which Was Deleted Out-Of-Band
+func TestReconcileDispatcherServiceDeleted(t *testing.T) {
+
+	// Initialize The Reconciler With An Existing Dispatcher Service
+	ctx := context.TODO()
+	kubeClientset := fake.NewSimpleClientset()
+	listers := controllertesting.NewListers(nil)
+	r := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		kubeClientset: kubeClientset,
+		environment:   controllertesting.NewEnvironment(),
+		config:        controllertesting.NewConfig(),
+		serviceLister: listers.GetServiceLister(),
+	}
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	serviceName := util.DispatcherDnsSafeName(channel)
+	assert.Nil(t, r.reconcileDispatcherService(ctx, r.logger, channel))
+	originalService, err := kubeClientset.CoreV1().Services(commonconstants.KnativeEventingNamespace).Get(ctx, serviceName, metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	// Delete The Dispatcher Service & Reconcile Again
+	assert.Nil(t, kubeClientset.CoreV1().Services(commonconstants.KnativeEventingNamespace).Delete(ctx, serviceName, metav1.DeleteOptions{}))
+	assert.Nil(t, r.reconcileDispatcherService(ctx, r.logger, channel))
+
+	// Verify The Dispatcher Service Was Recreated Identically (Finalizer Intact)
+	recreatedService, err := kubeClientset.CoreV1().Services(commonconstants.KnativeEventingNamespace).Get(ctx, serviceName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, originalService.Spec, recreatedService.Spec)
+	assert.Equal(t, originalService.Finalizers, recreatedService.Finalizers)
+}
+
+// Mock ServiceLister Which Always Fails
+type failingServiceLister struct {
+	err error
+}
+
+func (l *failingServiceLister) List(_ labels.Selector) ([]*corev1.Service, error) {
+	return nil, l.err
+}
+
+func (l *failingServiceLister) Services(_ string) corev1listers.ServiceNamespaceLister {
+	return l
+}
+
+func (l *failingServiceLister) Get(_ string) (*corev1.Service, error) {
+	return nil, l.err
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+)
+
+//
+// Kafka Connection Circuit Breaker
+//
+// Every KafkaChannel reconciliation creates a Kafka AdminClient, which against an unreachable cluster blocks
+// for the full Sarama dial / metadata timeouts before failing.  The circuit breaker tracks the consecutive
+// AdminClient connection failures per Kafka Secret (i.e. per cluster), and once the failure threshold is
+// reached it "opens" and short-circuits the reconciliation of the channels on that cluster.  After the open
+// duration has elapsed a single "half-open" probe reconciliation is allowed to attempt a connection, which
+// closes the circuit on success or re-opens it on failure.
+//
+
+// Circuit Breaker States
+type circuitBreakerState string
+
+const (
+	circuitBreakerClosed   circuitBreakerState = "Closed"
+	circuitBreakerOpen     circuitBreakerState = "Open"
+	circuitBreakerHalfOpen circuitBreakerState = "HalfOpen"
+)
+
+// Wrapper Around time.Now() For Mocking In Unit Tests
+var nowWrapper = time.Now
+
+// The Circuit State Of A Single Kafka Secret
+type circuit struct {
+	state               circuitBreakerState
+	consecutiveFailures int
+	openedTime          time.Time
+}
+
+// A Per-Kafka-Secret Circuit Breaker (A nil circuitBreaker Is Disabled And Always Allows Connections)
+type circuitBreaker struct {
+	logger           *zap.Logger
+	failureThreshold int
+	openDuration     time.Duration
+	mutex            sync.Mutex
+	circuits         map[string]*circuit
+}
+
+// Create A New circuitBreaker From The Specified Configuration (nil If The Circuit Breaker Is Disabled)
+func newCircuitBreaker(logger *zap.Logger, circuitBreakerConfig config.EKCircuitBreakerConfig) *circuitBreaker {
+	if !circuitBreakerConfig.Enabled {
+		return nil
+	}
+	failureThreshold := circuitBreakerConfig.FailureThreshold
+	if failureThreshold <= 0 {
+		failureThreshold = constants.CircuitBreakerFailureThreshold
+	}
+	openDurationMillis := circuitBreakerConfig.OpenDurationMillis
+	if openDurationMillis <= 0 {
+		openDurationMillis = constants.CircuitBreakerOpenDurationMillis
+	}
+	return &circuitBreaker{
+		logger:           logger,
+		failureThreshold: failureThreshold,
+		openDuration:     time.Duration(openDurationMillis) * time.Millisecond,
+		circuits:         make(map[string]*circuit),
+	}
+}
+
+// Determine Whether A Connection To The Cluster Of The Specified Kafka Secret Should Be Attempted
+func (c *circuitBreaker) allow(kafkaSecretName string) bool {
+	if c == nil {
+		return true
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	circuit, ok := c.circuits[kafkaSecretName]
+	if !ok {
+		return true
+	}
+	switch circuit.state {
+	case circuitBreakerOpen:
+		if nowWrapper().Sub(circuit.openedTime) < c.openDuration {
+			return false
+		}
+		c.logger.Info("Kafka Circuit Breaker Half-Open - Probing Connection", zap.String("KafkaSecret", kafkaSecretName))
+		circuit.state = circuitBreakerHalfOpen
+		return true
+	case circuitBreakerHalfOpen:
+		return false // Only The Single Probe Is Allowed While Half-Open
+	default:
+		return true
+	}
+}
+
+// Record The Outcome (nil error On Success) Of A Connection To The Cluster Of The Specified Kafka Secret
+func (c *circuitBreaker) record(kafkaSecretName string, err error) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	currentCircuit, ok := c.circuits[kafkaSecretName]
+	if !ok {
+		currentCircuit = &circuit{state: circuitBreakerClosed}
+		c.circuits[kafkaSecretName] = currentCircuit
+	}
+	if err == nil {
+		if currentCircuit.state != circuitBreakerClosed {
+			c.logger.Info("Kafka Circuit Breaker Closed - Connection Succeeded", zap.String("KafkaSecret", kafkaSecretName))
+		}
+		currentCircuit.state = circuitBreakerClosed
+		currentCircuit.consecutiveFailures = 0
+		return
+	}
+	currentCircuit.consecutiveFailures++
+	if currentCircuit.state == circuitBreakerHalfOpen || currentCircuit.consecutiveFailures >= c.failureThreshold {
+		if currentCircuit.state != circuitBreakerOpen {
+			c.logger.Warn("Kafka Circuit Breaker Open - Short-Circuiting Reconciliation",
+				zap.String("KafkaSecret", kafkaSecretName),
+				zap.Int("ConsecutiveFailures", currentCircuit.consecutiveFailures),
+				zap.Duration("OpenDuration", c.openDuration))
+		}
+		currentCircuit.state = circuitBreakerOpen
+		currentCircuit.openedTime = nowWrapper()
+	}
+}
+
+// Get The Current State Of The Circuit For The Specified Kafka Secret
+func (c *circuitBreaker) state(kafkaSecretName string) circuitBreakerState {
+	if c == nil {
+		return circuitBreakerClosed
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if circuit, ok := c.circuits[kafkaSecretName]; ok {
+		return circuit.state
+	}
+	return circuitBreakerClosed
+}
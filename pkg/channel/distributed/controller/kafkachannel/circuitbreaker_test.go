@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The newCircuitBreaker() Functionality
+func TestNewCircuitBreaker(t *testing.T) {
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Disabled Circuit Breakers Are nil (And Always Allow Connections)
+	disabled := newCircuitBreaker(logger, config.EKCircuitBreakerConfig{})
+	assert.Nil(t, disabled)
+	disabled.record("", errors.New("test error"))
+	assert.True(t, disabled.allow(""))
+	assert.Equal(t, circuitBreakerClosed, disabled.state(""))
+
+	// Enabled Circuit Breakers Use The Defaults When Not Specified
+	defaulted := newCircuitBreaker(logger, config.EKCircuitBreakerConfig{Enabled: true})
+	assert.NotNil(t, defaulted)
+	assert.Equal(t, constants.CircuitBreakerFailureThreshold, defaulted.failureThreshold)
+	assert.Equal(t, constants.CircuitBreakerOpenDurationMillis*time.Millisecond, defaulted.openDuration)
+
+	// Enabled Circuit Breakers Use The Specified Configuration
+	configured := newCircuitBreaker(logger, config.EKCircuitBreakerConfig{Enabled: true, FailureThreshold: 2, OpenDurationMillis: 1000})
+	assert.NotNil(t, configured)
+	assert.Equal(t, 2, configured.failureThreshold)
+	assert.Equal(t, time.Second, configured.openDuration)
+}
+
+// Test The circuitBreaker's Closed / Open / Half-Open State Transitions
+func TestCircuitBreakerTransitions(t *testing.T) {
+
+	// Mock The Current Time
+	now := time.Now()
+	nowWrapperPlaceholder := nowWrapper
+	nowWrapper = func() time.Time { return now }
+	defer func() { nowWrapper = nowWrapperPlaceholder }()
+
+	// Create A Circuit Breaker To Test
+	logger := logtesting.TestLogger(t).Desugar()
+	breaker := newCircuitBreaker(logger, config.EKCircuitBreakerConfig{Enabled: true, FailureThreshold: 3, OpenDurationMillis: 10000})
+	secretName := "test-secret"
+	otherSecretName := "other-secret"
+	testErr := errors.New("test error")
+
+	// Closed - Failures Below The Threshold Remain Closed
+	assert.True(t, breaker.allow(secretName))
+	breaker.record(secretName, testErr)
+	breaker.record(secretName, testErr)
+	assert.Equal(t, circuitBreakerClosed, breaker.state(secretName))
+	assert.True(t, breaker.allow(secretName))
+
+	// Closed - A Success Resets The Consecutive Failures
+	breaker.record(secretName, nil)
+	breaker.record(secretName, testErr)
+	breaker.record(secretName, testErr)
+	assert.Equal(t, circuitBreakerClosed, breaker.state(secretName))
+
+	// Open - Reaching The Threshold Opens The Circuit (Only For That Kafka Secret)
+	breaker.record(secretName, testErr)
+	assert.Equal(t, circuitBreakerOpen, breaker.state(secretName))
+	assert.False(t, breaker.allow(secretName))
+	assert.True(t, breaker.allow(otherSecretName))
+	assert.Equal(t, circuitBreakerClosed, breaker.state(otherSecretName))
+
+	// Open - Remains Open Until The Open Duration Has Elapsed
+	now = now.Add(9 * time.Second)
+	assert.False(t, breaker.allow(secretName))
+
+	// Half-Open - A Single Probe Is Allowed After The Open Duration
+	now = now.Add(time.Second)
+	assert.True(t, breaker.allow(secretName))
+	assert.Equal(t, circuitBreakerHalfOpen, breaker.state(secretName))
+	assert.False(t, breaker.allow(secretName))
+
+	// Open - A Failed Probe Re-Opens The Circuit For Another Open Duration
+	breaker.record(secretName, testErr)
+	assert.Equal(t, circuitBreakerOpen, breaker.state(secretName))
+	assert.False(t, breaker.allow(secretName))
+	now = now.Add(10 * time.Second)
+	assert.True(t, breaker.allow(secretName))
+	assert.Equal(t, circuitBreakerHalfOpen, breaker.state(secretName))
+
+	// Closed - A Successful Probe Closes The Circuit
+	breaker.record(secretName, nil)
+	assert.Equal(t, circuitBreakerClosed, breaker.state(secretName))
+	assert.True(t, breaker.allow(secretName))
+	assert.True(t, breaker.allow(secretName))
+}
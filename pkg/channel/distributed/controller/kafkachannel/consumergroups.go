@@ -0,0 +1,254 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+)
+
+//
+// Subscriber ConsumerGroup Status
+//
+// The dispatcher reports whether each subscriber is ready to receive events in Status.Subscribers, while the
+// controller summarizes the Kafka side of each subscription (ConsumerGroup ID, state, members and committed
+// offsets) in Status.SubscriberConsumerGroups so that both are visible via "kubectl describe kafkachannel".
+// Only this KafkaChannel's ConsumerGroups are described (along with their committed offsets for its topic),
+// and the results are cached so that Kafka is queried at most once per interval for each KafkaChannel.
+//
+
+// Kafka ConsumerGroup States (As Reported By DescribeConsumerGroups)
+const (
+	consumerGroupStateStable = "Stable"
+	consumerGroupStateEmpty  = "Empty"
+	consumerGroupStateDead   = "Dead"
+)
+
+// The Cached ConsumerGroup Status Of A Single KafkaChannel
+type consumerGroupStatusCacheEntry struct {
+	groupIds []string                                     // The ConsumerGroup IDs Described (Subscription Changes Invalidate The Entry)
+	statuses []kafkav1beta1.SubscriberConsumerGroupStatus // nil If The ConsumerGroups Could Not Be Described
+	expiry   time.Time
+}
+
+// A Per-KafkaChannel Cache Of The Subscriber ConsumerGroup Status (Shared Across Reconciliations)
+type consumerGroupStatusCache struct {
+	interval time.Duration
+	mutex    sync.Mutex
+	entries  map[types.UID]*consumerGroupStatusCacheEntry
+}
+
+// Create A New consumerGroupStatusCache With The Default Refresh Interval
+func newConsumerGroupStatusCache() *consumerGroupStatusCache {
+	return &consumerGroupStatusCache{
+		interval: constants.SubscriberConsumerGroupStatusIntervalSeconds * time.Second,
+		entries:  make(map[types.UID]*consumerGroupStatusCacheEntry),
+	}
+}
+
+// Get The Unexpired Cache Entry Of The Specified KafkaChannel For The Specified ConsumerGroup IDs (nil If None)
+func (c *consumerGroupStatusCache) get(channelUid types.UID, groupIds []string) *consumerGroupStatusCacheEntry {
+	if c == nil {
+		return nil
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry := c.entries[channelUid]
+	if entry == nil || !nowWrapper().Before(entry.expiry) || !stringSlicesEqual(entry.groupIds, groupIds) {
+		return nil
+	}
+	return entry
+}
+
+// Cache The Specified ConsumerGroup Status Of The Specified KafkaChannel Until The Refresh Interval Has Elapsed
+func (c *consumerGroupStatusCache) put(channelUid types.UID, groupIds []string, statuses []kafkav1beta1.SubscriberConsumerGroupStatus) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries[channelUid] = &consumerGroupStatusCacheEntry{groupIds: groupIds, statuses: statuses, expiry: nowWrapper().Add(c.interval)}
+}
+
+// Remove Any Cached ConsumerGroup Status Of The Specified KafkaChannel
+func (c *consumerGroupStatusCache) remove(channelUid types.UID) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.entries, channelUid)
+}
+
+//
+// Reconcile The KafkaChannel's Subscriber ConsumerGroup Status
+//
+// This is purely informational, and so failures to describe the ConsumerGroups (including AdminClient types
+// which do not support it) are logged and leave the previous status unchanged rather than failing the
+// reconciliation.  The failure is also cached so that an unreachable or unsupported Kafka is not re-queried
+// on every reconciliation.
+//
+func (r *Reconciler) reconcileSubscriberConsumerGroups(ctx context.Context, channel *kafkav1beta1.KafkaChannel) {
+
+	// Get Channel Specific Logger
+	logger := util.ReconcileLogger(ctx, channel)
+
+	// Nothing To Describe If There Are No Subscribers
+	if len(channel.Spec.Subscribers) == 0 {
+		channel.Status.SubscriberConsumerGroups = nil
+		r.consumerGroupStatusCache.remove(channel.UID)
+		return
+	}
+
+	// Determine The ConsumerGroup ID Of Each Subscriber (As Formatted By The Dispatcher)
+	groupIds := make([]string, len(channel.Spec.Subscribers))
+	for index, subscriber := range channel.Spec.Subscribers {
+		groupIds[index] = commonkafkautil.ConsumerGroupId(channel.Namespace, channel.Name, string(subscriber.UID))
+	}
+
+	// Use The Cached Status If It Is Still Current
+	if entry := r.consumerGroupStatusCache.get(channel.UID, groupIds); entry != nil {
+		if entry.statuses != nil {
+			channel.Status.SubscriberConsumerGroups = entry.statuses
+		}
+		return
+	}
+
+	// Otherwise Describe The ConsumerGroups & Cache The Results (Even On Failure)
+	statuses, err := r.describeSubscriberConsumerGroups(ctx, channel, groupIds)
+	r.consumerGroupStatusCache.put(channel.UID, groupIds, statuses)
+	if err != nil {
+		logger.Warn("Failed To Describe Subscriber ConsumerGroups - Leaving Status Unchanged", zap.Error(err))
+		return
+	}
+	channel.Status.SubscriberConsumerGroups = statuses
+}
+
+// Describe The Specified ConsumerGroups (One Per Subscriber, In Order) & Summarize Their Committed Offsets
+func (r *Reconciler) describeSubscriberConsumerGroups(ctx context.Context, channel *kafkav1beta1.KafkaChannel, groupIds []string) ([]kafkav1beta1.SubscriberConsumerGroupStatus, error) {
+
+	// Verify The AdminClient Is Available
+	if r.adminClient == nil {
+		return nil, fmt.Errorf("no kafka admin client available")
+	}
+
+	// Describe All Of The KafkaChannel's ConsumerGroups In A Single Request
+	groupDescriptions, err := r.adminClient.DescribeConsumerGroups(ctx, groupIds)
+	if err != nil {
+		return nil, err
+	}
+	groupDescriptionMap := make(map[string]*sarama.GroupDescription, len(groupDescriptions))
+	for _, groupDescription := range groupDescriptions {
+		if groupDescription != nil {
+			groupDescriptionMap[groupDescription.GroupId] = groupDescription
+		}
+	}
+
+	// The KafkaChannel's Topic Partitions For Which To List The Committed Offsets
+	topicName := util.TopicName(channel)
+	partitions := make([]int32, channel.Spec.NumPartitions)
+	for partition := range partitions {
+		partitions[partition] = int32(partition)
+	}
+	topicPartitions := map[string][]int32{topicName: partitions}
+
+	// Summarize Each Subscriber's ConsumerGroup
+	statuses := make([]kafkav1beta1.SubscriberConsumerGroupStatus, len(groupIds))
+	for index, groupId := range groupIds {
+		status := kafkav1beta1.SubscriberConsumerGroupStatus{
+			UID:           channel.Spec.Subscribers[index].UID,
+			ConsumerGroup: groupId,
+			Ready:         corev1.ConditionUnknown,
+		}
+		groupDescription := groupDescriptionMap[groupId]
+		if groupDescription == nil {
+			status.Message = "ConsumerGroup Not Described By Kafka"
+		} else if groupDescription.Err != sarama.ErrNoError {
+			status.Message = groupDescription.Err.Error()
+		} else {
+			status.State = groupDescription.State
+			status.Members = int32(len(groupDescription.Members))
+			status.Ready = consumerGroupReady(groupDescription.State, status.Members)
+			if groupDescription.State != consumerGroupStateDead {
+				status.CommittedPartitions, status.CommittedOffsets, err = r.summarizeCommittedOffsets(ctx, groupId, topicPartitions)
+				if err != nil {
+					status.Message = fmt.Sprintf("Failed To List Committed Offsets: %v", err)
+				}
+			}
+		}
+		statuses[index] = status
+	}
+
+	// Return The Subscriber ConsumerGroup Statuses
+	return statuses, nil
+}
+
+// Summarize The Committed Offsets Of The Specified ConsumerGroup (Number Of Committed Partitions & Sum Of Offsets)
+func (r *Reconciler) summarizeCommittedOffsets(ctx context.Context, groupId string, topicPartitions map[string][]int32) (int32, int64, error) {
+	offsetFetchResponse, err := r.adminClient.ListConsumerGroupOffsets(ctx, groupId, topicPartitions)
+	if err != nil {
+		return 0, 0, err
+	}
+	committedPartitions := int32(0)
+	committedOffsets := int64(0)
+	for topicName, partitions := range topicPartitions {
+		for _, partition := range partitions {
+			block := offsetFetchResponse.GetBlock(topicName, partition)
+			if block != nil && block.Err == sarama.ErrNoError && block.Offset >= 0 {
+				committedPartitions++
+				committedOffsets += block.Offset
+			}
+		}
+	}
+	return committedPartitions, committedOffsets, nil
+}
+
+// Get The Readiness Of A ConsumerGroup From Its Kafka State & Number Of Members
+func consumerGroupReady(state string, members int32) corev1.ConditionStatus {
+	switch {
+	case state == consumerGroupStateStable && members > 0:
+		return corev1.ConditionTrue
+	case state == consumerGroupStateEmpty || state == consumerGroupStateDead || members == 0:
+		return corev1.ConditionFalse
+	default:
+		return corev1.ConditionUnknown
+	}
+}
+
+// Utility Function For Comparing Two String Slices (Order Sensitive)
+func stringSlicesEqual(slice1 []string, slice2 []string) bool {
+	if len(slice1) != len(slice2) {
+		return false
+	}
+	for index := range slice1 {
+		if slice1[index] != slice2[index] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The reconcileSubscriberConsumerGroups() Functionality
+func TestReconcileSubscriberConsumerGroups(t *testing.T) {
+
+	// Mock The Current Time
+	now := time.Now()
+	nowWrapperPlaceholder := nowWrapper
+	nowWrapper = func() time.Time { return now }
+	defer func() { nowWrapper = nowWrapperPlaceholder }()
+
+	// Test Data
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithSubscribers)
+	channel.UID = "test-channel-uid"
+	topicName := util.TopicName(channel)
+	groupId1 := commonkafkautil.ConsumerGroupId(channel.Namespace, channel.Name, controllertesting.SubscriberUid1)
+	groupId2 := commonkafkautil.ConsumerGroupId(channel.Namespace, channel.Name, controllertesting.SubscriberUid2)
+	offsetFetchResponse := &sarama.OffsetFetchResponse{}
+	offsetFetchResponse.AddBlock(topicName, 0, &sarama.OffsetFetchResponseBlock{Offset: 10})
+	offsetFetchResponse.AddBlock(topicName, 1, &sarama.OffsetFetchResponseBlock{Offset: 5})
+	offsetFetchResponse.AddBlock(topicName, 2, &sarama.OffsetFetchResponseBlock{Offset: -1})
+
+	// Create A Mock AdminClient Describing The Subscriber ConsumerGroups
+	describeCount := 0
+	var describeErr error
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockDescribeGroupsFunc: func(_ context.Context, groupIds []string) ([]*sarama.GroupDescription, error) {
+			describeCount++
+			assert.Equal(t, []string{groupId1, groupId2}, groupIds)
+			if describeErr != nil {
+				return nil, describeErr
+			}
+			return []*sarama.GroupDescription{
+				{GroupId: groupId1, State: "Stable", Members: map[string]*sarama.GroupMemberDescription{"member-1": {}, "member-2": {}}},
+				{GroupId: groupId2, State: "Empty"},
+			}, nil
+		},
+		MockListGroupOffsetsFunc: func(_ context.Context, groupId string, topicPartitions map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+			assert.Len(t, topicPartitions[topicName], int(channel.Spec.NumPartitions))
+			if groupId == groupId1 {
+				return offsetFetchResponse, nil
+			}
+			return &sarama.OffsetFetchResponse{}, nil
+		},
+	}
+
+	// Create A Reconciler To Test
+	r := &Reconciler{
+		logger:                   logtesting.TestLogger(t).Desugar(),
+		adminClient:              mockAdminClient,
+		consumerGroupStatusCache: newConsumerGroupStatusCache(),
+	}
+
+	// Verify The ConsumerGroups Are Described & Summarized In Subscriber Order
+	expectedStatuses := []kafkav1beta1.SubscriberConsumerGroupStatus{
+		{UID: controllertesting.SubscriberUid1, ConsumerGroup: groupId1, State: "Stable", Ready: corev1.ConditionTrue, Members: 2, CommittedPartitions: 2, CommittedOffsets: 15},
+		{UID: controllertesting.SubscriberUid2, ConsumerGroup: groupId2, State: "Empty", Ready: corev1.ConditionFalse},
+	}
+	r.reconcileSubscriberConsumerGroups(context.TODO(), channel)
+	assert.Equal(t, expectedStatuses, channel.Status.SubscriberConsumerGroups)
+	assert.Equal(t, 1, describeCount)
+
+	// Verify Subsequent Reconciliations Within The Interval Use The Cached Status
+	channel.Status.SubscriberConsumerGroups = nil
+	r.reconcileSubscriberConsumerGroups(context.TODO(), channel)
+	assert.Equal(t, expectedStatuses, channel.Status.SubscriberConsumerGroups)
+	assert.Equal(t, 1, describeCount)
+
+	// Verify Failures After The Interval Leave The Status Unchanged & Are Also Cached
+	now = now.Add(r.consumerGroupStatusCache.interval)
+	describeErr = errors.New("test describe error")
+	r.reconcileSubscriberConsumerGroups(context.TODO(), channel)
+	assert.Equal(t, expectedStatuses, channel.Status.SubscriberConsumerGroups)
+	assert.Equal(t, 2, describeCount)
+	r.reconcileSubscriberConsumerGroups(context.TODO(), channel)
+	assert.Equal(t, 2, describeCount)
+
+	// Verify Subscription Changes Invalidate The Cached Status
+	channel.Spec.Subscribers = channel.Spec.Subscribers[:1]
+	mockAdminClient.MockDescribeGroupsFunc = func(_ context.Context, groupIds []string) ([]*sarama.GroupDescription, error) {
+		describeCount++
+		assert.Equal(t, []string{groupId1}, groupIds)
+		return []*sarama.GroupDescription{{GroupId: groupId1, Err: sarama.ErrGroupAuthorizationFailed}}, nil
+	}
+	r.reconcileSubscriberConsumerGroups(context.TODO(), channel)
+	assert.Equal(t, 3, describeCount)
+	assert.Equal(t, []kafkav1beta1.SubscriberConsumerGroupStatus{
+		{UID: controllertesting.SubscriberUid1, ConsumerGroup: groupId1, Ready: corev1.ConditionUnknown, Message: sarama.ErrGroupAuthorizationFailed.Error()},
+	}, channel.Status.SubscriberConsumerGroups)
+
+	// Verify The Status & Cache Are Cleared Without Subscribers
+	channel.Spec.Subscribers = nil
+	r.reconcileSubscriberConsumerGroups(context.TODO(), channel)
+	assert.Nil(t, channel.Status.SubscriberConsumerGroups)
+	assert.Empty(t, r.consumerGroupStatusCache.entries)
+}
+
+// Test The consumerGroupReady() Functionality
+func TestConsumerGroupReady(t *testing.T) {
+	assert.Equal(t, corev1.ConditionTrue, consumerGroupReady("Stable", 1))
+	assert.Equal(t, corev1.ConditionFalse, consumerGroupReady("Stable", 0))
+	assert.Equal(t, corev1.ConditionFalse, consumerGroupReady("Empty", 0))
+	assert.Equal(t, corev1.ConditionFalse, consumerGroupReady("Dead", 0))
+	assert.Equal(t, corev1.ConditionUnknown, consumerGroupReady("PreparingRebalance", 2))
+	assert.Equal(t, corev1.ConditionUnknown, consumerGroupReady("CompletingRebalance", 1))
+}
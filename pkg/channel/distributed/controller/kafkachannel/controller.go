@@ -19,25 +19,41 @@ package kafkachannel
 import (
 	"context"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	kafkachannelv1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
 	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	commonk8s "knative.dev/eventing-kafka/pkg/channel/distributed/common/k8s"
 	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/env"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/kafkasecretinformer"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
 	kafkaclientsetinjection "knative.dev/eventing-kafka/pkg/client/injection/client"
 	"knative.dev/eventing-kafka/pkg/client/injection/informers/messaging/v1beta1/kafkachannel"
 	kafkachannelreconciler "knative.dev/eventing-kafka/pkg/client/injection/reconciler/messaging/v1beta1/kafkachannel"
+	kafkalisters "knative.dev/eventing-kafka/pkg/client/listers/messaging/v1beta1"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
 	"knative.dev/pkg/client/injection/kube/informers/core/v1/service"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection/clients/dynamicclient"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/resolver"
+	"knative.dev/pkg/system"
 )
 
 // Track The Reconciler For Shutdown() Usage
@@ -53,6 +69,7 @@ func NewController(ctx context.Context, _ configmap.Watcher) *controller.Impl {
 	kafkachannelInformer := kafkachannel.Get(ctx)
 	deploymentInformer := deployment.Get(ctx)
 	serviceInformer := service.Get(ctx)
+	kafkaSecretInformer := kafkasecretinformer.Get(ctx)
 
 	// Load The Environment Variables
 	environment, err := env.GetEnvironment(logger)
@@ -69,47 +86,108 @@ func NewController(ctx context.Context, _ configmap.Watcher) *controller.Impl {
 	// Enable Sarama Logging If Specified In ConfigMap
 	sarama.EnableSaramaLogging(configuration.Kafka.EnableSaramaLogging)
 
+	// Hash The Initial Sarama Settings (Annotated On Dispatcher Deployments So That Changes Trigger Rolling Restarts)
+	saramaConfigHash := ""
+	configMap, err := kubeclient.Get(ctx).CoreV1().ConfigMaps(system.Namespace()).Get(ctx, commonconfig.SettingsConfigMapName, metav1.GetOptions{})
+	if err == nil {
+		saramaConfigHash, err = sarama.SaramaSettingsHash(configMap)
+	}
+	if err != nil {
+		logger.Warn("Failed To Hash Sarama Settings - Dispatchers Will Not Be Restarted On Changes", zap.Error(err))
+	}
+
+	// Set The Kafka Topic Name Template (Shared By Topic Creation & Finalization)
+	err = commonkafkautil.SetTopicNameTemplate(configuration.Kafka.TopicNameTemplate)
+	if err != nil {
+		logger.Fatal("Invalid Kafka Topic Name Template - Terminating!", zap.Error(err))
+	}
+
+	// Set The Kafka ConsumerGroup Name Template (Shared With The Dispatchers For KEDA ScaledObject Triggers)
+	err = commonkafkautil.SetConsumerGroupIdTemplate(configuration.Kafka.ConsumerGroupNameTemplate)
+	if err != nil {
+		logger.Fatal("Invalid Kafka ConsumerGroup Name Template - Terminating!", zap.Error(err))
+	}
+
 	// Determine The Kafka AdminClient Type (Assume Kafka Unless Otherwise Specified)
-	var kafkaAdminClientType kafkaadmin.AdminClientType
-	switch configuration.Kafka.AdminType {
-	case constants.KafkaAdminTypeValueKafka:
-		kafkaAdminClientType = kafkaadmin.Kafka
-	case constants.KafkaAdminTypeValueAzure:
-		kafkaAdminClientType = kafkaadmin.EventHub
-	case constants.KafkaAdminTypeValueCustom:
-		kafkaAdminClientType = kafkaadmin.Custom
-	default:
-		logger.Warn("Encountered Unexpected Kafka AdminType - Defaulting To 'kafka'", zap.String("AdminType", configuration.Kafka.AdminType))
-		kafkaAdminClientType = kafkaadmin.Kafka
+	kafkaAdminClientType := util.AdminClientType(configuration, logger)
+
+	// Create A Pool Of Kafka AdminClients If An Idle Timeout Is Specified (Otherwise Recreate Per Reconciliation)
+	var kafkaAdminClientPool *kafkaadmin.AdminClientPool
+	if configuration.Kafka.AdminClientIdleTimeoutMillis > 0 {
+		idleTimeout := time.Duration(configuration.Kafka.AdminClientIdleTimeoutMillis) * time.Millisecond
+		logger.Info("Enabling Kafka AdminClient Pooling", zap.Duration("IdleTimeout", idleTimeout))
+		kafkaAdminClientPool = kafkaadmin.NewAdminClientPool(logger, constants.ControllerComponentName, kafkaAdminClientType, idleTimeout)
+	}
+
+	// Share One Event Recorder Between The Generated Reconciler, The Leader-Only Work (e.g. Kafka Topic Bootstrap Audits) & The ConfigMap Observer
+	if controller.GetEventRecorder(ctx) == nil {
+		ctx = controller.WithEventRecorder(ctx, newEventRecorder(ctx, logger))
 	}
 
 	// Create A KafkaChannel Reconciler & Track As Package Variable
 	rec = &Reconciler{
-		logger:               logger,
-		kubeClientset:        kubeclient.Get(ctx),
-		environment:          environment,
-		config:               configuration,
-		saramaConfig:         saramaConfig,
-		kafkaClientSet:       kafkaclientsetinjection.Get(ctx),
-		kafkachannelLister:   kafkachannelInformer.Lister(),
-		kafkachannelInformer: kafkachannelInformer.Informer(),
-		deploymentLister:     deploymentInformer.Lister(),
-		serviceLister:        serviceInformer.Lister(),
-		adminClientType:      kafkaAdminClientType,
-		adminClient:          nil,
-		adminMutex:           &sync.Mutex{},
-		configObserver:       rec.configMapObserver, // Maintains a reference so that the ConfigWatcher can call it
+		logger:                   logger,
+		kubeClientset:            kubeclient.Get(ctx),
+		dynamicClient:            dynamicclient.Get(ctx),
+		environment:              environment,
+		config:                   configuration,
+		saramaConfig:             saramaConfig,
+		saramaConfigHash:         saramaConfigHash,
+		kafkaClientSet:           kafkaclientsetinjection.Get(ctx),
+		kafkachannelLister:       kafkachannelInformer.Lister(),
+		kafkachannelInformer:     kafkachannelInformer.Informer(),
+		deploymentLister:         deploymentInformer.Lister(),
+		serviceLister:            serviceInformer.Lister(),
+		adminClientType:          kafkaAdminClientType,
+		adminClient:              nil,
+		adminClientPool:          kafkaAdminClientPool,
+		adminMutex:               &sync.Mutex{},
+		configObserver:           rec.configMapObserver, // Maintains a reference so that the ConfigWatcher can call it
+		concurrentReconciliation: true,
+		circuitBreaker:           newCircuitBreaker(logger, configuration.Kafka.CircuitBreaker),
+		consumerGroupStatusCache: newConsumerGroupStatusCache(),
+		reconcileShortCircuit:    newReconcileShortCircuit(logger, configuration.Kafka.ReconcileShortCircuit),
+		eventRecorder:            controller.GetEventRecorder(ctx),
 	}
 
+	// Start The (Optional) Debug Server Exposing The Redacted Effective Sarama Config
+	debugServer = startDebugServer(logger, environment.DebugPort, rec.effectiveSaramaConfig)
+
 	// Watch The Settings ConfigMap For Changes
 	err = commonconfig.InitializeConfigWatcher(ctx, logger.Sugar(), rec.configMapObserver)
 	if err != nil {
 		logger.Fatal("Failed To Initialize ConfigMap Watcher", zap.Error(err))
 	}
 
+	// Watch The Root CA ConfigMap (If Referenced) For CA Rotation
+	err = commonconfig.InitializeRootCAConfigWatcher(ctx, logger.Sugar(), configuration, rec.rootCAConfigMapObserver)
+	if err != nil {
+		logger.Fatal("Failed To Initialize Root CA ConfigMap Watcher", zap.Error(err))
+	}
+
 	// Create A New KafkaChannel Controller Impl With The Reconciler
 	controllerImpl := kafkachannelreconciler.NewImpl(ctx, rec)
 
+	// Only Perform Leader-Only Work (Topic Bootstrap, Pooled AdminClients) While Leader (Standby Until Promoted)
+	logger.Info("Starting KafkaChannel Controller - Awaiting Leader Election", zap.Bool("IsLeader", false))
+	recordLeadership(ctx, false)
+	controllerImpl.Reconciler = newLeaderAwareReconciler(controllerImpl.Reconciler, func() { rec.promoted(ctx) }, func() { rec.demoted(ctx) })
+
+	// Enqueue A KafkaChannel For A Full Reconciliation (Bypassing Any Reconciliation Short-Circuit)
+	enqueueFullReconcile := func(key types.NamespacedName) {
+		rec.reconcileShortCircuit.forget(key)
+		controllerImpl.EnqueueKey(key)
+	}
+
+	// Create A URIResolver For The Channel-Level Dead Letter Sink (Re-Enqueues KafkaChannels When The Sink Changes)
+	rec.uriResolver = resolver.NewURIResolver(ctx, enqueueFullReconcile)
+
+	// Re-Enqueue All KafkaChannels When The Sarama Settings Change (Rolls Their Dispatcher Deployments)
+	rec.resyncChannels = func() { controllerImpl.GlobalResync(rec.kafkachannelInformer) }
+
+	// Requeue KafkaChannels After A Configurable Delay When Their Kafka Topic Fails With A Transient Kafka Error
+	rec.enqueueKeyAfter = controllerImpl.EnqueueKeyAfter
+
 	//
 	// Configure The Informers' EventHandlers
 	//
@@ -130,11 +208,30 @@ func NewController(ctx context.Context, _ configmap.Watcher) *controller.Impl {
 		FilterFunc: FilterKafkaChannelOwnerByReferenceOrLabel(),
 		Handler:    controller.HandleAll(controllerImpl.EnqueueLabelOfNamespaceScopedResource(constants.KafkaChannelNamespaceLabel, constants.KafkaChannelNameLabel)),
 	})
+	kafkaSecretInformer.Informer().AddEventHandler(
+		HandleKafkaSecretChanges(EnqueueKafkaChannelsOfKafkaSecret(logger, rec.kafkachannelLister, enqueueFullReconcile)),
+	)
 
 	// Return The KafkaChannel Controller Impl
 	return controllerImpl
 }
 
+// Create An Event Recorder Equivalent To The One Which The Generated Reconciler Would Otherwise Create For Itself
+func newEventRecorder(ctx context.Context, logger *zap.Logger) record.EventRecorder {
+	eventBroadcaster := record.NewBroadcaster()
+	watches := []watch.Interface{
+		eventBroadcaster.StartLogging(logger.Named("event-broadcaster").Sugar().Infof),
+		eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeclient.Get(ctx).CoreV1().Events("")}),
+	}
+	go func() {
+		<-ctx.Done()
+		for _, w := range watches {
+			w.Stop()
+		}
+	}()
+	return eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: constants.KafkaChannelControllerAgentName})
+}
+
 //
 // FilterWithKafkaChannelLabels - Custom Filter For Common K8S Components "Owned" By KafkaChannels
 //
@@ -167,7 +264,75 @@ func FilterKafkaChannelOwnerByReferenceOrLabel() func(obj interface{}) bool {
 	}
 }
 
+//
+// HandleKafkaSecretChanges - EventHandler For Kafka Secrets
+//
+// Invokes the specified handler whenever a Kafka Secret is added, deleted, or actually changed.  Periodic
+// resync "updates" (where the ResourceVersion is unchanged) are ignored so that the KafkaChannels are not
+// needlessly re-enqueued, since they are already resynced by their own informer.
+//
+func HandleKafkaSecretChanges(handler func(obj interface{})) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: handler,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldSecret, oldOk := oldObj.(*corev1.Secret)
+			newSecret, newOk := newObj.(*corev1.Secret)
+			if oldOk && newOk && oldSecret.ResourceVersion == newSecret.ResourceVersion {
+				return
+			}
+			handler(newObj)
+		},
+		DeleteFunc: handler,
+	}
+}
+
+//
+// EnqueueKafkaChannelsOfKafkaSecret - Maps Kafka Secret Changes To The Dependent KafkaChannels
+//
+// A KafkaChannel depends upon a Kafka Secret if it explicitly selects that Kafka Secret via annotation,
+// or if it uses the implicit selection and was either last reconciled against that Kafka Secret (as
+// indicated by its Kafka Secret label) or has never been successfully associated with any Kafka Secret.
+// This allows KafkaChannels to self-heal promptly when their Kafka Secret is (re)created, rather than
+// waiting for the next resync period.
+//
+func EnqueueKafkaChannelsOfKafkaSecret(logger *zap.Logger, lister kafkalisters.KafkaChannelLister, enqueueKey func(types.NamespacedName)) func(obj interface{}) {
+	return func(obj interface{}) {
+
+		// Unwrap Any Deleted Tombstone & Validate The Object
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		secret, ok := obj.(metav1.Object)
+		if !ok {
+			return
+		}
+		secretName := secret.GetName()
+		safeSecretName := commonk8s.TruncateLabelValue(secretName)
+
+		// List All The KafkaChannels
+		channels, err := lister.List(labels.Everything())
+		if err != nil {
+			logger.Error("Failed To List KafkaChannels For Kafka Secret", zap.String("KafkaSecret", secretName), zap.Error(err))
+			return
+		}
+
+		// Enqueue Those KafkaChannels Which Depend Upon The Kafka Secret
+		for _, channel := range channels {
+			selectedSecretName := util.KafkaSecretName(channel)
+			labelledSecretName := channel.Labels[constants.KafkaSecretLabel]
+			if selectedSecretName == secretName ||
+				(len(selectedSecretName) <= 0 && (len(labelledSecretName) <= 0 || labelledSecretName == safeSecretName)) {
+				enqueueKey(types.NamespacedName{Namespace: channel.Namespace, Name: channel.Name})
+			}
+		}
+	}
+}
+
 // Graceful Shutdown Hook
 func Shutdown() {
 	rec.ClearKafkaAdminClient()
+	if rec.adminClientPool != nil {
+		_ = rec.adminClientPool.Close()
+	}
+	stopDebugServer(rec.logger, debugServer)
 }
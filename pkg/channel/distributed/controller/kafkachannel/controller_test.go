@@ -25,20 +25,26 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 	kafkachannelv1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
 	commonenv "knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
 	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
 	controllerenv "knative.dev/eventing-kafka/pkg/channel/distributed/controller/env"
+	_ "knative.dev/eventing-kafka/pkg/channel/distributed/controller/kafkasecretinformer/fake" // Knative Fake Informer Injection
 	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
 	fakeKafkaClient "knative.dev/eventing-kafka/pkg/client/injection/client/fake"
 	_ "knative.dev/eventing-kafka/pkg/client/injection/informers/messaging/v1beta1/kafkachannel/fake" // Knative Fake Informer Injection
 	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	_ "knative.dev/pkg/client/injection/ducks/duck/v1/addressable/fake" // Knative Fake Duck Informer Injection
 	"knative.dev/pkg/client/injection/kube/client/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment/fake" // Knative Fake Informer Injection
 	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/service/fake"    // Knative Fake Informer Injection
 	"knative.dev/pkg/injection"
+	_ "knative.dev/pkg/injection/clients/dynamicclient/fake" // Knative Fake Dynamic Client Injection
 	"knative.dev/pkg/logging"
 	logtesting "knative.dev/pkg/logging/testing"
 	"knative.dev/pkg/system"
@@ -73,6 +79,7 @@ func TestNewController(t *testing.T) {
 	assert.NotNil(t, controller)
 	assert.Equal(t, "knative.dev-eventing-kafka-pkg-channel-distributed-controller-kafkachannel.Reconciler", controller.Name)
 	assert.NotNil(t, controller.Reconciler)
+	assert.IsType(t, &leaderAwareReconciler{}, controller.Reconciler)
 }
 
 // Test The FilterKafkaChannelOwnerByReferenceOrLabel() Functionality
@@ -210,6 +217,68 @@ func populateEnvironmentVariables(t *testing.T) {
 }
 
 // Utility Function For Creating A K8S Service With Specified ObjectMeta
+// Test The HandleKafkaSecretChanges() Functionality
+func TestHandleKafkaSecretChanges(t *testing.T) {
+
+	// Track The Handled Objects
+	var handled []interface{}
+	handler := HandleKafkaSecretChanges(func(obj interface{}) { handled = append(handled, obj) })
+
+	// Test Data
+	oldSecret := controllertesting.NewKafkaSecret()
+	oldSecret.ResourceVersion = "1"
+	newSecret := oldSecret.DeepCopy()
+	newSecret.ResourceVersion = "2"
+
+	// Perform The Test (Add, Resync, Update, Delete)
+	handler.OnAdd(oldSecret)
+	handler.OnUpdate(oldSecret, oldSecret)
+	handler.OnUpdate(oldSecret, newSecret)
+	handler.OnDelete(newSecret)
+
+	// Verify The Resync Was Ignored
+	assert.Equal(t, []interface{}{oldSecret, newSecret, newSecret}, handled)
+}
+
+// Test The EnqueueKafkaChannelsOfKafkaSecret() Functionality
+func TestEnqueueKafkaChannelsOfKafkaSecret(t *testing.T) {
+
+	// Test Data
+	kafkaSecretName := controllertesting.KafkaSecretName
+	newChannel := func(name string, annotations map[string]string, labels map[string]string) *kafkachannelv1beta1.KafkaChannel {
+		channel := controllertesting.NewKafkaChannel()
+		channel.Name = name
+		channel.Annotations = annotations
+		channel.Labels = labels
+		return channel
+	}
+	objects := []runtime.Object{
+		newChannel("explicit-match", map[string]string{constants.KafkaSecretAnnotation: kafkaSecretName}, nil),
+		newChannel("explicit-other", map[string]string{constants.KafkaSecretAnnotation: "other-secret"}, map[string]string{constants.KafkaSecretLabel: kafkaSecretName}),
+		newChannel("implicit-match", nil, map[string]string{constants.KafkaSecretLabel: kafkaSecretName}),
+		newChannel("implicit-other", nil, map[string]string{constants.KafkaSecretLabel: "other-secret"}),
+		newChannel("implicit-unassociated", nil, nil),
+	}
+	listers := controllertesting.NewListers(objects)
+
+	// Track The Enqueued Keys
+	var enqueued []string
+	enqueueKey := func(key types.NamespacedName) { enqueued = append(enqueued, key.Name) }
+	enqueue := EnqueueKafkaChannelsOfKafkaSecret(logtesting.TestLogger(t).Desugar(), listers.GetKafkaChannelLister(), enqueueKey)
+
+	// Perform The Test With Both A Secret & A Deleted Tombstone
+	enqueue(controllertesting.NewKafkaSecret())
+	assert.ElementsMatch(t, []string{"explicit-match", "implicit-match", "implicit-unassociated"}, enqueued)
+	enqueued = nil
+	enqueue(cache.DeletedFinalStateUnknown{Key: kafkaSecretName, Obj: controllertesting.NewKafkaSecret()})
+	assert.ElementsMatch(t, []string{"explicit-match", "implicit-match", "implicit-unassociated"}, enqueued)
+
+	// Verify Invalid Objects Are Ignored
+	enqueued = nil
+	enqueue("invalid")
+	assert.Empty(t, enqueued)
+}
+
 func createMetaV1Object(objectMeta metav1.ObjectMeta) metav1.Object {
 	service := corev1.Service{ObjectMeta: objectMeta}
 	return service.GetObjectMeta()
@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/health"
+	kafkasarama "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+)
+
+// Track The Debug Server For Shutdown() Usage
+var debugServer *http.Server
+
+// Get The Effective Sarama Config (Defaults Merged With The ConfigMap) Used By The Reconciler's AdminClients
+func (r *Reconciler) effectiveSaramaConfig() *sarama.Config {
+	if r == nil {
+		return nil
+	}
+	return r.saramaConfig
+}
+
+// Create The Debug Server's Request Multiplexer (Serving The Redacted Effective Sarama Config)
+func newDebugServeMux(logger *zap.Logger, saramaConfig func() *sarama.Config) *http.ServeMux {
+	serveMux := http.NewServeMux()
+	serveMux.HandleFunc(health.SaramaConfigDebugPath, kafkasarama.NewSaramaConfigDebugHandler(logger, saramaConfig))
+	return serveMux
+}
+
+// Start The Debug Server On The Specified Port (A Port Of Zero Disables The Debug Server & Returns Nil)
+func startDebugServer(logger *zap.Logger, port int, saramaConfig func() *sarama.Config) *http.Server {
+	if port <= 0 {
+		return nil
+	}
+	server := &http.Server{Addr: ":" + strconv.Itoa(port), Handler: newDebugServeMux(logger, saramaConfig)}
+	go func() {
+		logger.Info("Starting Debug HTTP Server", zap.Int("Port", port))
+		err := server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			logger.Error("Debug HTTP Server Failed", zap.Error(err))
+		}
+	}()
+	return server
+}
+
+// Stop The Specified Debug Server (If Started)
+func stopDebugServer(logger *zap.Logger, server *http.Server) {
+	if server == nil {
+		return
+	}
+	err := server.Shutdown(context.TODO())
+	if err != nil {
+		logger.Error("Failed To Shutdown Debug HTTP Server", zap.Error(err))
+	}
+}
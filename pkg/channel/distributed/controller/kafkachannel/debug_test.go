@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/health"
+	kafkasarama "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The Debug Server's Redacted Effective Sarama Config Endpoint
+func TestDebugServeMux(t *testing.T) {
+
+	// Create A Reconciler With A Sarama Config Containing Credentials
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.ClientID = "TestClientId"
+	saramaConfig.Net.SASL.Password = "TestSecretPassword"
+	r := &Reconciler{saramaConfig: saramaConfig}
+
+	// Perform The Test
+	serveMux := newDebugServeMux(logtesting.TestLogger(t).Desugar(), r.effectiveSaramaConfig)
+	recorder := httptest.NewRecorder()
+	serveMux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, health.SaramaConfigDebugPath, nil))
+
+	// Verify The Results (Current Config Returned With The Password Masked)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "TestSecretPassword")
+	view := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &view))
+	assert.Equal(t, "TestClientId", view["ClientID"])
+	assert.Equal(t, kafkasarama.RedactedValue, view["Net"].(map[string]interface{})["SASL"].(map[string]interface{})["Password"])
+
+	// Verify A Nil Reconciler Has No Config
+	var nilReconciler *Reconciler
+	assert.Nil(t, nilReconciler.effectiveSaramaConfig())
+}
+
+// Test The Debug Server Is Only Started For A Non-Zero Port
+func TestStartDebugServer(t *testing.T) {
+	logger := logtesting.TestLogger(t).Desugar()
+	assert.Nil(t, startDebugServer(logger, 0, func() *sarama.Config { return nil }))
+	stopDebugServer(logger, nil)
+}
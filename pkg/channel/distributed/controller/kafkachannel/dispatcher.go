@@ -18,19 +18,29 @@ package kafkachannel
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
 	commonenv "knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/health"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
+	kafkasarama "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/env"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
 	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
@@ -53,7 +63,140 @@ import (
 func (r *Reconciler) reconcileDispatcher(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
 
 	// Get Channel Specific Logger
-	logger := util.ChannelLogger(r.logger, channel)
+	logger := util.ReconcileLogger(ctx, channel)
+
+	// Validate The Dispatcher Image (Rather Than Creating A Deployment Which Can Never Start)
+	err := env.ValidateImage(env.DispatcherImageEnvVarKey, r.environment.DispatcherImage)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherImageInvalid.String(), "Invalid Dispatcher Image: %v", err)
+		logger.Error("Invalid Dispatcher Image", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherImageInvalid.String(), "Invalid Dispatcher Image: %v", err)
+		return err
+	}
+
+	// Validate The Per-Channel Consumer Config Override Annotations (Rejecting Malformed Values)
+	_, err = kafkasarama.ConsumerConfigOverrides(channel.Annotations, r.saramaConfig)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherConsumerConfigInvalid.String(), "Invalid Dispatcher Consumer Config Override: %v", err)
+		logger.Error("Invalid Dispatcher Consumer Config Override Annotations", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherConsumerConfigInvalid.String(), "Invalid Dispatcher Consumer Config Override: %v", err)
+		return err
+	}
+
+	// Validate The Per-Subscription Concurrency Annotation (Rejecting Malformed / Out-Of-Range Worker Counts)
+	_, err = consumer.SubscriberConcurrency(channel.Annotations)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherSubscriberConcurrencyInvalid.String(), "Invalid Dispatcher Subscriber Concurrency: %v", err)
+		logger.Error("Invalid Dispatcher Subscriber Concurrency Annotation", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherSubscriberConcurrencyInvalid.String(), "Invalid Dispatcher Subscriber Concurrency: %v", err)
+		return err
+	}
+
+	// Validate The Per-Subscription Ordering Annotation (Rejecting Unknown Modes & Ordered Subscriptions With Concurrency)
+	_, err = consumer.SubscriberOrdering(channel.Annotations)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherSubscriberOrderingInvalid.String(), "Invalid Dispatcher Subscriber Ordering: %v", err)
+		logger.Error("Invalid Dispatcher Subscriber Ordering Annotation", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherSubscriberOrderingInvalid.String(), "Invalid Dispatcher Subscriber Ordering: %v", err)
+		return err
+	}
+
+	// Validate The Per-Subscription Dead Letter Topic Annotation (Rejecting Illegal Topic Names & The KafkaChannel's Own Topic)
+	_, err = r.subscriberDeadLetterTopics(channel)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherSubscriberDeadLetterTopicInvalid.String(), "Invalid Dispatcher Subscriber Dead Letter Topic: %v", err)
+		logger.Error("Invalid Dispatcher Subscriber Dead Letter Topic Annotation", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherSubscriberDeadLetterTopicInvalid.String(), "Invalid Dispatcher Subscriber Dead Letter Topic: %v", err)
+		return err
+	}
+
+	// Validate The Per-Subscription Initial Offset Annotation (Rejecting Policies Other Than "earliest" / "latest")
+	_, err = consumer.SubscriberInitialOffsets(channel.Annotations)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherSubscriberInitialOffsetInvalid.String(), "Invalid Dispatcher Subscriber Initial Offset: %v", err)
+		logger.Error("Invalid Dispatcher Subscriber Initial Offset Annotation", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherSubscriberInitialOffsetInvalid.String(), "Invalid Dispatcher Subscriber Initial Offset: %v", err)
+		return err
+	}
+
+	// Validate The Per-Subscription Filter Annotation (Rejecting Rather Than Dropping All Events For Malformed Filters)
+	_, err = consumer.SubscriberFilters(channel.Annotations)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherSubscriberFilterInvalid.String(), "Invalid Dispatcher Subscriber Filter: %v", err)
+		logger.Error("Invalid Dispatcher Subscriber Filter Annotation", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherSubscriberFilterInvalid.String(), "Invalid Dispatcher Subscriber Filter: %v", err)
+		return err
+	}
+
+	// Validate The Per-Subscription Shadow Annotation (Rejecting Invalid Destinations & Sample Percentages)
+	_, err = consumer.SubscriberShadows(channel.Annotations)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherSubscriberShadowInvalid.String(), "Invalid Dispatcher Subscriber Shadow: %v", err)
+		logger.Error("Invalid Dispatcher Subscriber Shadow Annotation", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherSubscriberShadowInvalid.String(), "Invalid Dispatcher Subscriber Shadow: %v", err)
+		return err
+	}
+
+	// Validate Any Requested Replay Timestamp (Rejecting Malformed Or Future Timestamps)
+	_, err = consumer.ReplayTimestamp(channel.Annotations)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherReplayTimestampInvalid.String(), "Invalid Dispatcher Replay Timestamp: %v", err)
+		logger.Error("Invalid Dispatcher Replay Timestamp Annotation", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherReplayTimestampInvalid.String(), "Invalid Dispatcher Replay Timestamp: %v", err)
+		return err
+	}
+
+	// Validate The CloudEvent Extension Allow / Deny Annotations (Rejecting Invalid Names & Context Attributes)
+	_, err = r.extensionFilter(channel)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherExtensionFilterInvalid.String(), "Invalid Dispatcher Extension Filter: %v", err)
+		logger.Error("Invalid Dispatcher Extension Filter Annotations", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherExtensionFilterInvalid.String(), "Invalid Dispatcher Extension Filter: %v", err)
+		return err
+	}
+
+	// Validate The Per-Channel Resource Override Annotations (Rejecting Malformed Quantities)
+	_, err = r.dispatcherResources(channel)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherResourcesInvalid.String(), "Invalid Dispatcher Resources: %v", err)
+		logger.Error("Invalid Dispatcher Resource Override Annotations", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherResourcesInvalid.String(), "Invalid Dispatcher Resources: %v", err)
+		return err
+	}
+
+	// Validate The Additional Dispatcher Volumes & VolumeMounts (Rather Than Creating A Deployment Which Can Never Start)
+	if r.config != nil {
+		err = commonconfig.ValidateVolumesConfig("dispatcher", r.config.Dispatcher.Volumes, r.config.Dispatcher.VolumeMounts)
+		if err != nil {
+			controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherVolumesInvalid.String(), "Invalid Dispatcher Volumes: %v", err)
+			logger.Error("Invalid Dispatcher Volumes", zap.Error(err))
+			channel.Status.MarkDispatcherFailed(event.DispatcherVolumesInvalid.String(), "Invalid Dispatcher Volumes: %v", err)
+			return err
+		}
+	}
+
+	// Validate The Additional Dispatcher Env Vars (Rather Than Creating A Deployment Which Will Be Rejected)
+	if r.config != nil {
+		err = commonconfig.ValidateEnvConfig("dispatcher", r.config.Dispatcher.Env)
+		if err != nil {
+			controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherEnvInvalid.String(), "Invalid Dispatcher Env: %v", err)
+			logger.Error("Invalid Dispatcher Env", zap.Error(err))
+			channel.Status.MarkDispatcherFailed(event.DispatcherEnvInvalid.String(), "Invalid Dispatcher Env: %v", err)
+			return err
+		}
+	}
+
+	// Validate The Per-Channel Replicas Annotation (Warning If It Exceeds The Topic's Partitions And Was Clamped)
+	replicas, clamped, err := r.dispatcherReplicas(channel)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherReplicasInvalid.String(), "Invalid Dispatcher Replicas: %v", err)
+		logger.Error("Invalid Dispatcher Replicas Annotation", zap.Error(err))
+		channel.Status.MarkDispatcherFailed(event.DispatcherReplicasInvalid.String(), "Invalid Dispatcher Replicas: %v", err)
+		return err
+	} else if clamped {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherReplicasClamped.String(), "Requested Dispatcher Replicas '%s' Exceed The Topic's Partitions - Using %d", channel.Annotations[constants.DispatcherReplicasAnnotation], replicas)
+		logger.Warn("Requested Dispatcher Replicas Exceed The Topic's Partitions - Clamping", zap.String("Requested", channel.Annotations[constants.DispatcherReplicasAnnotation]), zap.Int32("Replicas", replicas))
+	}
 
 	// Reconcile The Dispatcher's Service (For Prometheus Only)
 	serviceErr := r.reconcileDispatcherService(ctx, logger, channel)
@@ -73,8 +216,25 @@ func (r *Reconciler) reconcileDispatcher(ctx context.Context, channel *kafkav1be
 		logger.Info("Successfully Reconciled Dispatcher Deployment")
 	}
 
+	// Reflect Whether Consumption Is Paused (Dispatcher Scaled To Zero) In The Channel's Status & Events
+	r.reconcileDispatcherPaused(ctx, logger, channel)
+
+	// Reconcile The Dispatcher's KEDA ScaledObject (Removed If Disabled)
+	scaledObjectErr := r.reconcileDispatcherScaledObject(ctx, logger, channel)
+	if scaledObjectErr != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherScaledObjectReconciliationFailed.String(), "Failed To Reconcile Dispatcher ScaledObject: %v", scaledObjectErr)
+		logger.Error("Failed To Reconcile Dispatcher ScaledObject", zap.Error(scaledObjectErr))
+	}
+
+	// Reconcile The Dispatcher's PodDisruptionBudget (Removed If Disabled)
+	podDisruptionBudgetErr := r.reconcileDispatcherPodDisruptionBudget(ctx, logger, channel)
+	if podDisruptionBudgetErr != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherPodDisruptionBudgetReconciliationFailed.String(), "Failed To Reconcile Dispatcher PodDisruptionBudget: %v", podDisruptionBudgetErr)
+		logger.Error("Failed To Reconcile Dispatcher PodDisruptionBudget", zap.Error(podDisruptionBudgetErr))
+	}
+
 	// Return Results
-	if serviceErr != nil || deploymentErr != nil {
+	if serviceErr != nil || deploymentErr != nil || scaledObjectErr != nil || podDisruptionBudgetErr != nil {
 		return fmt.Errorf("failed to reconcile dispatcher resources")
 	} else {
 		return nil
@@ -85,7 +245,7 @@ func (r *Reconciler) reconcileDispatcher(ctx context.Context, channel *kafkav1be
 func (r *Reconciler) finalizeDispatcher(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
 
 	// Get Channel Specific Logger
-	logger := util.ChannelLogger(r.logger, channel)
+	logger := util.ReconcileLogger(ctx, channel)
 
 	// Finalize The Dispatcher's Service
 	serviceErr := r.finalizeDispatcherService(ctx, logger, channel)
@@ -105,8 +265,22 @@ func (r *Reconciler) finalizeDispatcher(ctx context.Context, channel *kafkav1bet
 		logger.Info("Successfully Finalized Dispatcher Deployment")
 	}
 
+	// Finalize The Dispatcher's KEDA ScaledObject
+	scaledObjectErr := r.finalizeDispatcherScaledObject(ctx, logger, channel)
+	if scaledObjectErr != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherScaledObjectFinalizationFailed.String(), "Failed To Finalize Dispatcher ScaledObject: %v", scaledObjectErr)
+		logger.Error("Failed To Finalize Dispatcher ScaledObject", zap.Error(scaledObjectErr))
+	}
+
+	// Finalize The Dispatcher's PodDisruptionBudget
+	podDisruptionBudgetErr := r.finalizeDispatcherPodDisruptionBudget(ctx, logger, channel)
+	if podDisruptionBudgetErr != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DispatcherPodDisruptionBudgetFinalizationFailed.String(), "Failed To Finalize Dispatcher PodDisruptionBudget: %v", podDisruptionBudgetErr)
+		logger.Error("Failed To Finalize Dispatcher PodDisruptionBudget", zap.Error(podDisruptionBudgetErr))
+	}
+
 	// Return Results
-	if serviceErr != nil || deploymentErr != nil {
+	if serviceErr != nil || deploymentErr != nil || scaledObjectErr != nil || podDisruptionBudgetErr != nil {
 		return fmt.Errorf("failed to finalize dispatcher resources")
 	} else {
 		return nil
@@ -124,11 +298,10 @@ func (r *Reconciler) reconcileDispatcherService(ctx context.Context, logger *zap
 	service, err := r.getDispatcherService(channel)
 	if service == nil || err != nil {
 
-		// If The Service Was Not Found - Then Create A New One For The Channel
+		// If The Service Was Not Found (e.g. Deleted By An Operator) - Then Create A New One For The Channel
 		if errors.IsNotFound(err) {
 			logger.Info("Dispatcher Service Not Found - Creating New One")
-			service := r.newDispatcherService(channel)
-			_, err = r.kubeClientset.CoreV1().Services(service.Namespace).Create(ctx, service, metav1.CreateOptions{})
+			_, err = r.createService(ctx, r.newDispatcherService(channel))
 			if err != nil {
 				logger.Error("Failed To Create Dispatcher Service", zap.Error(err))
 				return err
@@ -142,8 +315,18 @@ func (r *Reconciler) reconcileDispatcherService(ctx context.Context, logger *zap
 		}
 	} else {
 
-		// Log Deletion Timestamp & Finalizer State
+		// Log Deletion Timestamp & Finalizer State (Converging The Additional Metadata Of Non-Deleted Services)
 		if service.DeletionTimestamp.IsZero() {
+			desiredService := r.newDispatcherService(channel)
+			updatedService := service.DeepCopy()
+			if util.ConvergeAdditionalMetadata(&updatedService.ObjectMeta, &desiredService.ObjectMeta) {
+				_, err = r.kubeClientset.CoreV1().Services(updatedService.Namespace).Update(ctx, updatedService, metav1.UpdateOptions{})
+				if err != nil {
+					logger.Error("Failed To Update Dispatcher Service Metadata", zap.Error(err))
+					return err
+				}
+				logger.Info("Successfully Updated Dispatcher Service Metadata")
+			}
 			logger.Info("Successfully Verified Dispatcher Service")
 		} else {
 			if util.HasFinalizer(r.finalizerName(), &service.ObjectMeta) {
@@ -219,8 +402,8 @@ func (r *Reconciler) newDispatcherService(channel *kafkav1beta1.KafkaChannel) *c
 	// Get The Dispatcher Service Name For The Channel
 	serviceName := util.DispatcherDnsSafeName(channel)
 
-	// Create & Return The Service Model
-	return &corev1.Service{
+	// Create The Service Model
+	service := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: corev1.SchemeGroupVersion.String(),
 			Kind:       constants.ServiceKind,
@@ -251,6 +434,10 @@ func (r *Reconciler) newDispatcherService(channel *kafkav1beta1.KafkaChannel) *c
 			},
 		},
 	}
+
+	// Merge Any Additional Labels & Annotations From The ConfigMap & Return The Service Model
+	util.AddAdditionalMetadata(&service.ObjectMeta, r.additionalMetadata())
+	return service
 }
 
 //
@@ -294,8 +481,14 @@ func (r *Reconciler) reconcileDispatcherDeployment(ctx context.Context, logger *
 		}
 	} else {
 
-		// Log Deletion Timestamp & Finalizer State
+		// Log Deletion Timestamp & Finalizer State (Updating The Resources Of Non-Deleted Deployments If Changed)
 		if deployment.DeletionTimestamp.IsZero() {
+			deployment, err = r.updateDispatcherDeployment(ctx, logger, channel, deployment)
+			if err != nil {
+				logger.Error("Failed To Update Dispatcher Deployment", zap.Error(err))
+				channel.Status.MarkDispatcherFailed(event.DispatcherDeploymentReconciliationFailed.String(), "Failed To Update Dispatcher Deployment: %v", err)
+				return err
+			}
 			logger.Info("Successfully Verified Dispatcher Deployment")
 		} else {
 			if util.HasFinalizer(r.finalizerName(), &deployment.ObjectMeta) {
@@ -353,6 +546,129 @@ func (r *Reconciler) finalizeDispatcherDeployment(ctx context.Context, logger *z
 	}
 }
 
+// Update The Dispatcher Deployment's Additional Metadata, Scheduling, Volumes, Env, Replicas, Startup Probe, Container Resources & Sarama ConfigHash If They Differ From Those Desired (Annotations / Config Changed)
+func (r *Reconciler) updateDispatcherDeployment(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+
+	// Get The Desired Dispatcher Container Resources
+	resources, err := r.dispatcherResources(channel)
+	if err != nil {
+		return deployment, err
+	}
+
+	// Get The Desired Dispatcher Deployment (For Its Additional Labels & Annotations And Scheduling Controls)
+	desiredDeployment, err := r.newDispatcherDeployment(logger, channel)
+	if err != nil {
+		return deployment, err
+	}
+
+	// Clone The Deployment So As Not To Perturb Original & Converge The Additional Metadata
+	existingDeployment := deployment
+	deployment = deployment.DeepCopy()
+	metadataChanged := util.ConvergeAdditionalMetadata(&deployment.ObjectMeta, &desiredDeployment.ObjectMeta)
+	metadataChanged = util.ConvergeAdditionalMetadata(&deployment.Spec.Template.ObjectMeta, &desiredDeployment.Spec.Template.ObjectMeta) || metadataChanged
+
+	// Converge The Scheduling Controls (Changes To The Pod Template Trigger A Rolling Restart)
+	schedulingChanged := util.ConvergeScheduling(&deployment.Spec.Template.Spec, &desiredDeployment.Spec.Template.Spec)
+
+	// Converge The Additional Volumes & VolumeMounts (Changes To The Pod Template Trigger A Rolling Restart)
+	volumesChanged := util.ConvergeVolumes(&deployment.Spec.Template.Spec, &desiredDeployment.Spec.Template.Spec)
+
+	// Converge The Additional Env Vars (Pruning Those No Longer Configured, Changes To The Pod Template Trigger A Rolling Restart)
+	envChanged := util.ConvergeEnv(&deployment.Spec.Template, &desiredDeployment.Spec.Template)
+
+	// Converge The Replicas (Unless KEDA Is Scaling The Dispatcher And It Is Not Paused)
+	replicasChanged := false
+	if (!r.dispatcherKedaEnabled() || util.Paused(channel, logger)) && desiredDeployment.Spec.Replicas != nil && (deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != *desiredDeployment.Spec.Replicas) {
+		deployment.Spec.Replicas = desiredDeployment.Spec.Replicas
+		replicasChanged = true
+	}
+
+	// Converge The Subscriber Concurrency, Ordering, Dead Letter Topics, Initial Offsets, Filters, Extension Filter & Consumer Config Overrides (Subscriptions, And Therefore Their UIDs, Come & Go After Creation)
+	concurrencyChanged, orderingChanged, deadLetterTopicsChanged, initialOffsetsChanged, filtersChanged, replayChanged, extensionFilterChanged, consumerConfigChanged := false, false, false, false, false, false, false, false
+	if len(deployment.Spec.Template.Spec.Containers) > 0 && len(desiredDeployment.Spec.Template.Spec.Containers) > 0 {
+		concurrencyChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.KafkaSubscriberConcurrencyEnvVarKey)
+		orderingChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.KafkaSubscriberOrderingEnvVarKey)
+		deadLetterTopicsChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.KafkaSubscriberDeadLetterTopicsEnvVarKey)
+		initialOffsetsChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.KafkaSubscriberInitialOffsetsEnvVarKey)
+		filtersChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.KafkaSubscriberFiltersEnvVarKey)
+		replayChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.KafkaReplayFromTimestampEnvVarKey)
+		extensionFilterChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.KafkaExtensionFilterEnvVarKey)
+		consumerConfigChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.KafkaConsumerConfigOverridesEnvVarKey)
+	}
+
+	// Converge The Startup Probe (Comparing Only The Configurable Timings As K8S Defaults The Remaining Fields)
+	startupProbeChanged := false
+	if len(deployment.Spec.Template.Spec.Containers) > 0 && len(desiredDeployment.Spec.Template.Spec.Containers) > 0 {
+		existingProbe := deployment.Spec.Template.Spec.Containers[0].StartupProbe
+		desiredProbe := desiredDeployment.Spec.Template.Spec.Containers[0].StartupProbe
+		if existingProbe == nil || existingProbe.PeriodSeconds != desiredProbe.PeriodSeconds || existingProbe.FailureThreshold != desiredProbe.FailureThreshold {
+			deployment.Spec.Template.Spec.Containers[0].StartupProbe = desiredProbe
+			startupProbeChanged = true
+		}
+	}
+
+	// Converge The Kafka Readiness Interval & The Readiness Probe Period Which Matches It (Comparing Only The Period As K8S Defaults The Remaining Fields)
+	readinessChanged := false
+	if len(deployment.Spec.Template.Spec.Containers) > 0 && len(desiredDeployment.Spec.Template.Spec.Containers) > 0 {
+		readinessChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.KafkaReadinessIntervalEnvVarKey)
+		existingProbe := deployment.Spec.Template.Spec.Containers[0].ReadinessProbe
+		desiredProbe := desiredDeployment.Spec.Template.Spec.Containers[0].ReadinessProbe
+		if existingProbe == nil || existingProbe.PeriodSeconds != desiredProbe.PeriodSeconds {
+			deployment.Spec.Template.Spec.Containers[0].ReadinessProbe = desiredProbe
+			readinessChanged = true
+		}
+	}
+
+	// Converge The Consumer Lag Interval
+	consumerLagIntervalChanged := false
+	if len(deployment.Spec.Template.Spec.Containers) > 0 && len(desiredDeployment.Spec.Template.Spec.Containers) > 0 {
+		consumerLagIntervalChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.ConsumerLagIntervalEnvVarKey)
+	}
+
+	// Converge The Kafka Rack ID (Static) Or The Node Name (Downward API) From Which It Is Derived
+	rackIdChanged := false
+	if len(deployment.Spec.Template.Spec.Containers) > 0 && len(desiredDeployment.Spec.Template.Spec.Containers) > 0 {
+		rackIdChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.KafkaRackIdEnvVarKey)
+		rackIdChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.NodeNameEnvVarKey) || rackIdChanged
+	}
+
+	// Converge The Drain Timeout & The Termination Grace Period Which Accommodates It
+	drainTimeoutChanged := false
+	if len(deployment.Spec.Template.Spec.Containers) > 0 && len(desiredDeployment.Spec.Template.Spec.Containers) > 0 {
+		drainTimeoutChanged = util.ConvergeEnvVar(&deployment.Spec.Template.Spec.Containers[0], &desiredDeployment.Spec.Template.Spec.Containers[0], commonenv.DrainTimeoutEnvVarKey)
+	}
+	if !equality.Semantic.DeepEqual(deployment.Spec.Template.Spec.TerminationGracePeriodSeconds, desiredDeployment.Spec.Template.Spec.TerminationGracePeriodSeconds) {
+		deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = desiredDeployment.Spec.Template.Spec.TerminationGracePeriodSeconds
+		drainTimeoutChanged = true
+	}
+
+	// Determine Whether The Resources And/Or Sarama ConfigHash Have Changed
+	resourcesChanged := len(deployment.Spec.Template.Spec.Containers) > 0 && !equality.Semantic.DeepEqual(deployment.Spec.Template.Spec.Containers[0].Resources, resources)
+	configHashChanged := len(r.saramaConfigHash) > 0 && deployment.Spec.Template.Annotations[constants.ConfigHashAnnotation] != r.saramaConfigHash
+
+	// Nothing To Do If The Metadata, Scheduling, Volumes, Env, Replicas, Startup Probe, Readiness, Consumer Lag Interval, Rack ID, Drain Timeout, Concurrency, Ordering, Dead Letter Topics, Filters, Replay, Extension Filter, Consumer Config, Resources & ConfigHash Are Unchanged
+	if !metadataChanged && !schedulingChanged && !volumesChanged && !envChanged && !replicasChanged && !startupProbeChanged && !readinessChanged && !consumerLagIntervalChanged && !rackIdChanged && !drainTimeoutChanged && !concurrencyChanged && !orderingChanged && !deadLetterTopicsChanged && !initialOffsetsChanged && !filtersChanged && !replayChanged && !extensionFilterChanged && !consumerConfigChanged && !resourcesChanged && !configHashChanged {
+		return existingDeployment, nil
+	}
+
+	// Update The Resources / ConfigHash (Triggering A Rolling Restart)
+	if resourcesChanged {
+		deployment.Spec.Template.Spec.Containers[0].Resources = resources
+	}
+	if configHashChanged {
+		if deployment.Spec.Template.Annotations == nil {
+			deployment.Spec.Template.Annotations = map[string]string{}
+		}
+		deployment.Spec.Template.Annotations[constants.ConfigHashAnnotation] = r.saramaConfigHash
+	}
+	deployment, err = r.kubeClientset.AppsV1().Deployments(deployment.Namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Successfully Updated Dispatcher Deployment", zap.Bool("MetadataChanged", metadataChanged), zap.Bool("SchedulingChanged", schedulingChanged), zap.Bool("VolumesChanged", volumesChanged), zap.Bool("EnvChanged", envChanged), zap.Bool("ReplicasChanged", replicasChanged), zap.Bool("StartupProbeChanged", startupProbeChanged), zap.Bool("ReadinessChanged", readinessChanged), zap.Bool("ConsumerLagIntervalChanged", consumerLagIntervalChanged), zap.Bool("RackIdChanged", rackIdChanged), zap.Bool("DrainTimeoutChanged", drainTimeoutChanged), zap.Bool("ConcurrencyChanged", concurrencyChanged), zap.Bool("OrderingChanged", orderingChanged), zap.Bool("DeadLetterTopicsChanged", deadLetterTopicsChanged), zap.Bool("InitialOffsetsChanged", initialOffsetsChanged), zap.Bool("FiltersChanged", filtersChanged), zap.Bool("ReplayChanged", replayChanged), zap.Bool("ExtensionFilterChanged", extensionFilterChanged), zap.Bool("ConsumerConfigChanged", consumerConfigChanged), zap.Bool("ResourcesChanged", resourcesChanged), zap.Bool("ConfigHashChanged", configHashChanged))
+	return deployment, nil
+}
+
 // Get The Dispatcher Deployment Associated With The Specified Channel
 func (r *Reconciler) getDispatcherDeployment(channel *kafkav1beta1.KafkaChannel) (*appsv1.Deployment, error) {
 
@@ -372,8 +688,15 @@ func (r *Reconciler) newDispatcherDeployment(logger *zap.Logger, channel *kafkav
 	// Get The Dispatcher Deployment Name For The Channel
 	deploymentName := util.DispatcherDnsSafeName(channel)
 
-	// Replicas Int Value For De-Referencing
-	replicas := int32(r.config.Dispatcher.Replicas)
+	// Get The Dispatcher Replicas (Per-Channel Annotation Overriding Config, Or Zero While Paused)
+	replicas, _, err := r.dispatcherReplicas(channel)
+	if err != nil {
+		logger.Error("Failed To Create Dispatcher Deployment Replicas", zap.Error(err))
+		return nil, err
+	}
+	if util.Paused(channel, logger) {
+		replicas = 0
+	}
 
 	// Create The Dispatcher Container Environment Variables
 	envVars, err := r.dispatcherDeploymentEnvVars(channel)
@@ -382,6 +705,19 @@ func (r *Reconciler) newDispatcherDeployment(logger *zap.Logger, channel *kafkav
 		return nil, err
 	}
 
+	// Get The Dispatcher Container Resources (Per-Channel Annotations Overriding Config)
+	resources, err := r.dispatcherResources(channel)
+	if err != nil {
+		logger.Error("Failed To Create Dispatcher Deployment Resources", zap.Error(err))
+		return nil, err
+	}
+
+	// Annotate The Pod Template With The Sarama ConfigHash (Changes Trigger A Rolling Restart Of The Dispatcher)
+	var podAnnotations map[string]string
+	if len(r.saramaConfigHash) > 0 {
+		podAnnotations = map[string]string{constants.ConfigHashAnnotation: r.saramaConfigHash}
+	}
+
 	// Create The Dispatcher's Deployment
 	deployment := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
@@ -413,9 +749,11 @@ func (r *Reconciler) newDispatcherDeployment(logger *zap.Logger, channel *kafkav
 					Labels: map[string]string{
 						constants.AppLabel: deploymentName, // Matched By Deployment Selector Above
 					},
+					Annotations: podAnnotations,
 				},
 				Spec: corev1.PodSpec{
-					ServiceAccountName: r.environment.ServiceAccount,
+					ServiceAccountName:            r.environment.ServiceAccount,
+					TerminationGracePeriodSeconds: pointer.Int64Ptr(int64(r.dispatcherDrainTimeout() + constants.DispatcherTerminationGracePeriodBuffer)),
 					Containers: []corev1.Container{
 						{
 							Name: deploymentName,
@@ -433,25 +771,17 @@ func (r *Reconciler) newDispatcherDeployment(logger *zap.Logger, channel *kafkav
 								Handler: corev1.Handler{
 									HTTPGet: &corev1.HTTPGetAction{
 										Port: intstr.FromInt(constants.HealthPort),
-										Path: health.ReadinessPath,
+										Path: health.KafkaReadinessPath,
 									},
 								},
 								InitialDelaySeconds: constants.DispatcherReadinessDelay,
-								PeriodSeconds:       constants.DispatcherReadinessPeriod,
+								PeriodSeconds:       r.dispatcherReadinessInterval(),
 							},
+							StartupProbe:    r.dispatcherStartupProbe(),
 							Image:           r.environment.DispatcherImage,
 							Env:             envVars,
 							ImagePullPolicy: corev1.PullIfNotPresent,
-							Resources: corev1.ResourceRequirements{
-								Limits: corev1.ResourceList{
-									corev1.ResourceMemory: r.config.Dispatcher.MemoryLimit,
-									corev1.ResourceCPU:    r.config.Dispatcher.CpuLimit,
-								},
-								Requests: corev1.ResourceList{
-									corev1.ResourceMemory: r.config.Dispatcher.MemoryRequest,
-									corev1.ResourceCPU:    r.config.Dispatcher.CpuRequest,
-								},
-							},
+							Resources:       resources,
 						},
 					},
 				},
@@ -459,6 +789,19 @@ func (r *Reconciler) newDispatcherDeployment(logger *zap.Logger, channel *kafkav
 		},
 	}
 
+	// Merge Any Additional Labels & Annotations From The ConfigMap Into The Deployment & Pod Template
+	util.AddAdditionalMetadata(&deployment.ObjectMeta, r.additionalMetadata())
+	util.AddAdditionalMetadata(&deployment.Spec.Template.ObjectMeta, r.additionalMetadata())
+
+	// Apply The Dispatcher Scheduling Controls (NodeSelector, Tolerations & Affinity) From The ConfigMap
+	util.AddScheduling(&deployment.Spec.Template.Spec, r.config.Dispatcher.EKKubernetesConfig)
+
+	// Apply Any Additional Dispatcher Volumes & VolumeMounts (e.g. Certificate Files) From The ConfigMap
+	util.AddVolumes(&deployment.Spec.Template.Spec, r.config.Dispatcher.Volumes, r.config.Dispatcher.VolumeMounts)
+
+	// Merge Any Additional Dispatcher Env Vars (e.g. Proxy Settings) From The ConfigMap (Controller-Managed Env Vars Take Precedence)
+	util.AddEnv(&deployment.Spec.Template, r.config.Dispatcher.Env)
+
 	// Return The Dispatcher's Deployment
 	return deployment, nil
 }
@@ -515,10 +858,156 @@ func (r *Reconciler) dispatcherDeploymentEnvVars(channel *kafkav1beta1.KafkaChan
 			Name:  commonenv.KafkaTopicEnvVarKey,
 			Value: topicName,
 		},
+		{
+			Name:  commonenv.KafkaReadinessIntervalEnvVarKey,
+			Value: strconv.Itoa(int(r.dispatcherReadinessInterval())),
+		},
+		{
+			Name:  commonenv.DrainTimeoutEnvVarKey,
+			Value: strconv.Itoa(int(r.dispatcherDrainTimeout())),
+		},
+		{
+			Name:  commonenv.ConsumerLagIntervalEnvVarKey,
+			Value: strconv.Itoa(int(r.dispatcherConsumerLagInterval())),
+		},
+	}
+
+	// Append The Kafka Rack ID (Static) Or The Node Name (Downward API, For Deriving The Rack ID From The Node's Zone)
+	if r.config != nil && len(r.config.Dispatcher.RackId) > 0 {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  commonenv.KafkaRackIdEnvVarKey,
+			Value: r.config.Dispatcher.RackId,
+		})
+	} else if r.config != nil && r.config.Dispatcher.RackIdFromNodeZone {
+		envVars = append(envVars, corev1.EnvVar{
+			Name: commonenv.NodeNameEnvVarKey,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					APIVersion: "v1", // Explicit K8S Default So That Convergence Does Not Detect Perpetual Drift
+					FieldPath:  "spec.nodeName",
+				},
+			},
+		})
+	}
+
+	// Append Any Per-Channel Consumer Config Overrides As A JSON Encoded Env Var
+	consumerConfigOverrides, err := kafkasarama.ConsumerConfigOverrides(channel.Annotations, r.saramaConfig)
+	if err != nil {
+		return nil, err
+	} else if len(consumerConfigOverrides) > 0 {
+		consumerConfigOverridesJson, err := json.Marshal(consumerConfigOverrides)
+		if err != nil {
+			return nil, err
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  commonenv.KafkaConsumerConfigOverridesEnvVarKey,
+			Value: string(consumerConfigOverridesJson),
+		})
+	}
+
+	// Append Any Per-Subscription Concurrency As A JSON Encoded Env Var
+	subscriberConcurrency, err := consumer.SubscriberConcurrency(channel.Annotations)
+	if err != nil {
+		return nil, err
+	} else if len(subscriberConcurrency) > 0 {
+		subscriberConcurrencyJson, err := json.Marshal(subscriberConcurrency)
+		if err != nil {
+			return nil, err
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  commonenv.KafkaSubscriberConcurrencyEnvVarKey,
+			Value: string(subscriberConcurrencyJson),
+		})
+	}
+
+	// Append Any Per-Subscription Ordering As A JSON Encoded Env Var
+	subscriberOrdering, err := consumer.SubscriberOrdering(channel.Annotations)
+	if err != nil {
+		return nil, err
+	} else if len(subscriberOrdering) > 0 {
+		subscriberOrderingJson, err := json.Marshal(subscriberOrdering)
+		if err != nil {
+			return nil, err
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  commonenv.KafkaSubscriberOrderingEnvVarKey,
+			Value: string(subscriberOrderingJson),
+		})
+	}
+
+	// Append Any Per-Subscription Dead Letter Topics As A JSON Encoded Env Var
+	subscriberDeadLetterTopics, err := r.subscriberDeadLetterTopics(channel)
+	if err != nil {
+		return nil, err
+	} else if len(subscriberDeadLetterTopics) > 0 {
+		subscriberDeadLetterTopicsJson, err := json.Marshal(subscriberDeadLetterTopics)
+		if err != nil {
+			return nil, err
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  commonenv.KafkaSubscriberDeadLetterTopicsEnvVarKey,
+			Value: string(subscriberDeadLetterTopicsJson),
+		})
+	}
+
+	// Append Any Per-Subscription Initial Offset Policies As A JSON Encoded Env Var
+	subscriberInitialOffsets, err := consumer.SubscriberInitialOffsets(channel.Annotations)
+	if err != nil {
+		return nil, err
+	} else if len(subscriberInitialOffsets) > 0 {
+		subscriberInitialOffsetsJson, err := json.Marshal(subscriberInitialOffsets)
+		if err != nil {
+			return nil, err
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  commonenv.KafkaSubscriberInitialOffsetsEnvVarKey,
+			Value: string(subscriberInitialOffsetsJson),
+		})
+	}
+
+	// Append Any Per-Subscription Filters As A JSON Encoded Env Var
+	subscriberFilters, err := consumer.SubscriberFilters(channel.Annotations)
+	if err != nil {
+		return nil, err
+	} else if len(subscriberFilters) > 0 {
+		subscriberFiltersJson, err := json.Marshal(subscriberFilters)
+		if err != nil {
+			return nil, err
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  commonenv.KafkaSubscriberFiltersEnvVarKey,
+			Value: string(subscriberFiltersJson),
+		})
+	}
+
+	// Append Any CloudEvent Extension Allow / Deny Lists (Annotated Or From Config) As A JSON Encoded Env Var
+	extensionFilter, err := r.extensionFilter(channel)
+	if err != nil {
+		return nil, err
+	} else if extensionFilter != nil {
+		extensionFilterJson, err := json.Marshal(extensionFilter)
+		if err != nil {
+			return nil, err
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  commonenv.KafkaExtensionFilterEnvVarKey,
+			Value: string(extensionFilterJson),
+		})
+	}
+
+	// Append Any Requested (Or Previously Applied) Replay Timestamp In Its Canonical Form
+	replayTimestamp, err := consumer.ReplayTimestamp(channel.Annotations)
+	if err != nil {
+		return nil, err
+	} else if !replayTimestamp.IsZero() {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  commonenv.KafkaReplayFromTimestampEnvVarKey,
+			Value: consumer.FormatReplayTimestamp(replayTimestamp),
+		})
 	}
 
-	// Get The Kafka Secret From The Kafka Admin Client
-	kafkaSecret := r.adminClient.GetKafkaSecretName(topicName)
+	// Get The Kafka Secret (Explicitly Selected Or From The Kafka Admin Client)
+	kafkaSecret := r.kafkaSecretName(channel)
 
 	// If The Kafka Secret Env Var Is Specified Then Append Relevant Env Vars
 	if len(kafkaSecret) <= 0 {
@@ -560,12 +1049,219 @@ func (r *Reconciler) dispatcherDeploymentEnvVars(channel *kafkav1beta1.KafkaChan
 				},
 			},
 		})
+
+		// Append The Kafka SASL Mechanism As Env Var (Optional - Defaults To PLAIN)
+		envVars = append(envVars, corev1.EnvVar{
+			Name: commonenv.KafkaSaslMechanismEnvVarKey,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: kafkaSecret},
+					Key:                  constants.KafkaSecretDataKeySaslMechanism,
+					Optional:             pointer.BoolPtr(true),
+				},
+			},
+		})
+
+		// Append The Kafka TLS Client Certificate As Env Var (Optional - mTLS)
+		envVars = append(envVars, corev1.EnvVar{
+			Name: commonenv.KafkaTLSCertEnvVarKey,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: kafkaSecret},
+					Key:                  constants.KafkaSecretDataKeyUserCert,
+					Optional:             pointer.BoolPtr(true),
+				},
+			},
+		})
+
+		// Append The Kafka TLS Client Key As Env Var (Optional - mTLS)
+		envVars = append(envVars, corev1.EnvVar{
+			Name: commonenv.KafkaTLSKeyEnvVarKey,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: kafkaSecret},
+					Key:                  constants.KafkaSecretDataKeyUserKey,
+					Optional:             pointer.BoolPtr(true),
+				},
+			},
+		})
+
+		// Append The Kafka TLS Enable Override As Env Var (Optional)
+		envVars = append(envVars, corev1.EnvVar{
+			Name: commonenv.KafkaTLSEnableEnvVarKey,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: kafkaSecret},
+					Key:                  constants.KafkaSecretDataKeyTLSEnable,
+					Optional:             pointer.BoolPtr(true),
+				},
+			},
+		})
 	}
 
 	// Return The Dispatcher Deployment EnvVars Array
 	return envVars, nil
 }
 
+// Get The CloudEvent ExtensionFilter Of The Specified Channel (Its Annotations Overriding The Default Allow / Deny Lists From Config)
+func (r *Reconciler) extensionFilter(channel *kafkav1beta1.KafkaChannel) (*kafkautil.ExtensionFilter, error) {
+	var defaultExtensions commonconfig.EKExtensionsConfig
+	if r.config != nil {
+		defaultExtensions = r.config.Kafka.Extensions
+	}
+	return kafkautil.ExtensionFilterFor(channel.Annotations, defaultExtensions.Allow, defaultExtensions.Deny)
+}
+
+// Get The Dispatcher Container's Resources From Config, Overridden By Any Per-Channel Annotations
+func (r *Reconciler) dispatcherResources(channel *kafkav1beta1.KafkaChannel) (corev1.ResourceRequirements, error) {
+
+	// Start With The Global Dispatcher Resources From Config
+	resources := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: r.config.Dispatcher.MemoryLimit,
+			corev1.ResourceCPU:    r.config.Dispatcher.CpuLimit,
+		},
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: r.config.Dispatcher.MemoryRequest,
+			corev1.ResourceCPU:    r.config.Dispatcher.CpuRequest,
+		},
+	}
+
+	// Override With Any Per-Channel Resource Annotations
+	overrides := []struct {
+		annotation   string
+		resourceList corev1.ResourceList
+		resourceName corev1.ResourceName
+	}{
+		{annotation: constants.DispatcherCpuRequestAnnotation, resourceList: resources.Requests, resourceName: corev1.ResourceCPU},
+		{annotation: constants.DispatcherCpuLimitAnnotation, resourceList: resources.Limits, resourceName: corev1.ResourceCPU},
+		{annotation: constants.DispatcherMemoryRequestAnnotation, resourceList: resources.Requests, resourceName: corev1.ResourceMemory},
+		{annotation: constants.DispatcherMemoryLimitAnnotation, resourceList: resources.Limits, resourceName: corev1.ResourceMemory},
+	}
+	for _, override := range overrides {
+		if value, ok := channel.Annotations[override.annotation]; ok {
+			quantity, err := resource.ParseQuantity(strings.TrimSpace(value))
+			if err != nil {
+				return resources, fmt.Errorf("invalid dispatcher resource override '%s': %v", override.annotation, err)
+			}
+			override.resourceList[override.resourceName] = quantity
+		}
+	}
+
+	// Verify The Resulting Requests Do Not Exceed The Limits (Which K8S Would Reject)
+	for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		request := resources.Requests[resourceName]
+		limit := resources.Limits[resourceName]
+		if !limit.IsZero() && request.Cmp(limit) > 0 {
+			return resources, fmt.Errorf("invalid dispatcher resources: %s request '%s' exceeds limit '%s'", resourceName, request.String(), limit.String())
+		}
+	}
+
+	// Return The Dispatcher Resources
+	return resources, nil
+}
+
+//
+// Reconcile The Paused Condition Of The Specified Channel
+//
+// Pausing scales the Dispatcher Deployment to zero (see newDispatcherDeployment()), so that its Kafka
+// ConsumerGroups are left cleanly, while the Kafka Topic and Deployment are retained.  The ConsumerGroup
+// IDs are unchanged by pausing, so on resumption the Dispatcher rejoins them and continues from the last
+// committed offsets (events produced while paused are retained in the Topic and delivered once resumed).
+//
+func (r *Reconciler) reconcileDispatcherPaused(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel) {
+	paused := util.Paused(channel, logger)
+	wasPaused := channel.Status.IsPaused()
+	if paused {
+		channel.Status.MarkPaused(constants.PausedReason, "Consumption Paused Via The %s Annotation", constants.PausedAnnotation)
+		if !wasPaused {
+			controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeNormal, event.DispatcherPaused.String(), "Paused Dispatcher Consumption (Scaled To Zero)")
+			logger.Info("Paused Dispatcher Consumption")
+		}
+	} else {
+		channel.Status.MarkNotPaused()
+		if wasPaused {
+			controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeNormal, event.DispatcherResumed.String(), "Resumed Dispatcher Consumption From The Last Committed Offsets")
+			logger.Info("Resumed Dispatcher Consumption")
+		}
+	}
+}
+
+// Get The Dispatcher Replicas (Per-Channel Annotation Overriding Config)
+//
+// Each partition of the KafkaChannel's Topic is consumed by a single member of each ConsumerGroup, so replicas
+// beyond the number of partitions would sit idle.  An annotation requesting more replicas is therefore clamped
+// to the partition count, which is indicated by the returned boolean.  The ConfigMap default is used as-is.
+//
+func (r *Reconciler) dispatcherReplicas(channel *kafkav1beta1.KafkaChannel) (int32, bool, error) {
+	replicas := int32(r.config.Dispatcher.Replicas)
+	value, ok := channel.Annotations[constants.DispatcherReplicasAnnotation]
+	if !ok {
+		return replicas, false, nil
+	}
+	requested, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32)
+	if err != nil || requested < 1 {
+		return replicas, false, fmt.Errorf("invalid dispatcher replicas '%s': expected a positive integer but found '%s'", constants.DispatcherReplicasAnnotation, value)
+	}
+	if partitions := util.NumPartitions(channel, r.config, r.logger); partitions > 0 && int32(requested) > partitions {
+		return partitions, true, nil
+	}
+	return int32(requested), false, nil
+}
+
+// Get The Dispatcher's Kafka Readiness Check Interval (Also Used As The Readiness Probe Period) From Config Or Default
+func (r *Reconciler) dispatcherReadinessInterval() int32 {
+	if r.config != nil && r.config.Dispatcher.ReadinessIntervalSeconds > 0 {
+		return r.config.Dispatcher.ReadinessIntervalSeconds
+	}
+	return constants.DispatcherReadinessPeriod
+}
+
+//
+// Create The Dispatcher's Startup Probe (Timings From Config Or Default)
+//
+// The liveness endpoint only succeeds once the Dispatcher has finished starting (informers synced and
+// ConsumerGroups created), which can take a while against a busy cluster.  The startup probe targets the
+// same endpoint, with a generous failure threshold, so that the liveness probe does not apply (and kill
+// the Dispatcher) until then.
+//
+func (r *Reconciler) dispatcherStartupProbe() *corev1.Probe {
+	periodSeconds := int32(constants.DispatcherStartupPeriod)
+	failureThreshold := int32(constants.DispatcherStartupFailureThreshold)
+	if r.config != nil && r.config.Dispatcher.StartupProbe.PeriodSeconds > 0 {
+		periodSeconds = r.config.Dispatcher.StartupProbe.PeriodSeconds
+	}
+	if r.config != nil && r.config.Dispatcher.StartupProbe.FailureThreshold > 0 {
+		failureThreshold = r.config.Dispatcher.StartupProbe.FailureThreshold
+	}
+	return &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Port: intstr.FromInt(constants.HealthPort),
+				Path: health.LivenessPath,
+			},
+		},
+		PeriodSeconds:    periodSeconds,
+		FailureThreshold: failureThreshold,
+	}
+}
+
+// Get The Dispatcher's Shutdown Drain Timeout From Config Or Default
+func (r *Reconciler) dispatcherDrainTimeout() int32 {
+	if r.config != nil && r.config.Dispatcher.DrainTimeoutSeconds > 0 {
+		return r.config.Dispatcher.DrainTimeoutSeconds
+	}
+	return constants.DispatcherDrainTimeoutSeconds
+}
+
+// Get The Dispatcher's Consumer Lag Metric Polling Interval From Config Or Default
+func (r *Reconciler) dispatcherConsumerLagInterval() int32 {
+	if r.config != nil && r.config.Dispatcher.ConsumerLagIntervalSeconds > 0 {
+		return r.config.Dispatcher.ConsumerLagIntervalSeconds
+	}
+	return constants.DispatcherConsumerLagIntervalSeconds
+}
+
 // Utility Function To Get The Finalizer Name For K8S Resources (Service, Deployment, etc.)
 func (r *Reconciler) finalizerName() string {
 	return util.KubernetesResourceFinalizerName(constants.KafkaChannelFinalizerSuffix)
@@ -0,0 +1,1443 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	commonenv "knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/health"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/env"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The Dispatcher Reconciliation Of Invalid Consumer Config Override Annotations
+func TestReconcileDispatcherInvalidConsumerConfig(t *testing.T) {
+
+	// Create A KafkaChannel With An Invalid Consumer Config Override Annotation
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{kafkaconstants.ConsumerConfigAnnotationPrefix + kafkaconstants.ConsumerConfigFetchMax: "invalid"}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherConsumerConfigInvalid.String())
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady).IsFalse())
+}
+
+// Test The Dispatcher Reconciliation Of An Invalid Subscriber Concurrency Annotation
+func TestReconcileDispatcherInvalidSubscriberConcurrency(t *testing.T) {
+
+	// Create A KafkaChannel With An Out-Of-Range Subscriber Concurrency Annotation
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{kafkaconstants.SubscriberConcurrencyAnnotation: `{"subscription-uid":0}`}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherSubscriberConcurrencyInvalid.String())
+	dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	assert.True(t, dispatcherCondition.IsFalse())
+	assert.Equal(t, event.DispatcherSubscriberConcurrencyInvalid.String(), dispatcherCondition.Reason)
+}
+
+// Test The Dispatcher Reconciliation Of An Invalid Subscriber Ordering Annotation
+func TestReconcileDispatcherInvalidSubscriberOrdering(t *testing.T) {
+
+	// Create A KafkaChannel With An Ordered Subscriber Which Is Also Configured With Concurrent Workers
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{
+		kafkaconstants.SubscriberOrderingAnnotation:    `{"subscription-uid":"ordered"}`,
+		kafkaconstants.SubscriberConcurrencyAnnotation: `{"subscription-uid":4}`,
+	}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherSubscriberOrderingInvalid.String())
+	dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	assert.True(t, dispatcherCondition.IsFalse())
+	assert.Equal(t, event.DispatcherSubscriberOrderingInvalid.String(), dispatcherCondition.Reason)
+}
+
+// Test The Dispatcher Reconciliation Of An Invalid Subscriber Dead Letter Topic Annotation
+func TestReconcileDispatcherInvalidSubscriberDeadLetterTopic(t *testing.T) {
+
+	// Define The TestCases (An Illegal Topic Name & The KafkaChannel's Own Topic)
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	for _, deadLetterTopic := range []string{"dead/letter", util.TopicName(channel)} {
+		t.Run(deadLetterTopic, func(t *testing.T) {
+
+			// Create A KafkaChannel With The Invalid Dead Letter Topic
+			channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+			channel.Annotations = map[string]string{kafkaconstants.SubscriberDeadLetterTopicAnnotation: `{"subscription-uid":"` + deadLetterTopic + `"}`}
+
+			// Initialize The Reconciler
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), environment: controllertesting.NewEnvironment()}
+
+			// Perform The Test
+			err := r.reconcileDispatcher(ctx, channel)
+
+			// Verify The Results
+			assert.NotNil(t, err)
+			assert.Contains(t, <-recorder.Events, event.DispatcherSubscriberDeadLetterTopicInvalid.String())
+			dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+			assert.True(t, dispatcherCondition.IsFalse())
+			assert.Equal(t, event.DispatcherSubscriberDeadLetterTopicInvalid.String(), dispatcherCondition.Reason)
+		})
+	}
+}
+
+// Test The Dispatcher Reconciliation Of An Invalid Subscriber Initial Offset Annotation
+func TestReconcileDispatcherInvalidSubscriberInitialOffset(t *testing.T) {
+
+	// Create A KafkaChannel With An Unknown Initial Offset Policy
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{kafkaconstants.SubscriberInitialOffsetAnnotation: `{"subscription-uid":"newest"}`}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherSubscriberInitialOffsetInvalid.String())
+	dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	assert.True(t, dispatcherCondition.IsFalse())
+	assert.Equal(t, event.DispatcherSubscriberInitialOffsetInvalid.String(), dispatcherCondition.Reason)
+}
+
+// Test The Dispatcher Reconciliation Of An Invalid Subscriber Filter Annotation
+func TestReconcileDispatcherInvalidSubscriberFilter(t *testing.T) {
+
+	// Create A KafkaChannel With An (Unsupported) CESQL Subscriber Filter Annotation
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{kafkaconstants.SubscriberFilterAnnotation: `{"subscription-uid":{"sql":"type = 'foo'"}}`}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherSubscriberFilterInvalid.String())
+	dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	assert.True(t, dispatcherCondition.IsFalse())
+	assert.Equal(t, event.DispatcherSubscriberFilterInvalid.String(), dispatcherCondition.Reason)
+}
+
+// Test The Dispatcher Reconciliation Of An Invalid Extension Filter Annotation
+func TestReconcileDispatcherInvalidExtensionFilter(t *testing.T) {
+
+	// Create A KafkaChannel With An Extension Deny Annotation Listing A Context Attribute
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{kafkaconstants.ExtensionsDenyAnnotation: "id"}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherExtensionFilterInvalid.String())
+	dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	assert.True(t, dispatcherCondition.IsFalse())
+	assert.Equal(t, event.DispatcherExtensionFilterInvalid.String(), dispatcherCondition.Reason)
+}
+
+// Test The Dispatcher Reconciliation Of An Invalid Subscriber Shadow Annotation
+func TestReconcileDispatcherInvalidSubscriberShadow(t *testing.T) {
+
+	// Create A KafkaChannel With A Subscriber Shadow Annotation Whose Sample Percentage Is Out Of Range
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{kafkaconstants.SubscriberShadowAnnotation: `{"uid1":{"uri":"http://shadow.example.com","percent":150}}`}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherSubscriberShadowInvalid.String())
+	dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	assert.True(t, dispatcherCondition.IsFalse())
+	assert.Equal(t, event.DispatcherSubscriberShadowInvalid.String(), dispatcherCondition.Reason)
+}
+
+// Test The Dispatcher Reconciliation Of An Invalid Replay Timestamp Annotation
+func TestReconcileDispatcherInvalidReplayTimestamp(t *testing.T) {
+
+	// Create A KafkaChannel With A Malformed Replay Timestamp Annotation
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{kafkaconstants.ReplayFromTimestampAnnotation: "yesterday"}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherReplayTimestampInvalid.String())
+	dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	assert.True(t, dispatcherCondition.IsFalse())
+	assert.Equal(t, event.DispatcherReplayTimestampInvalid.String(), dispatcherCondition.Reason)
+}
+
+// Test The Dispatcher Reconciliation With An Empty Dispatcher Image
+func TestReconcileDispatcherEmptyImage(t *testing.T) {
+
+	// Create A KafkaChannel
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+
+	// Initialize The Reconciler With An Environment Lacking The Dispatcher Image
+	environment := controllertesting.NewEnvironment()
+	environment.DispatcherImage = ""
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), environment: environment}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results (No Dispatcher Deployment Is Attempted Without A KubeClientset)
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherImageInvalid.String())
+	dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	assert.True(t, dispatcherCondition.IsFalse())
+	assert.Equal(t, event.DispatcherImageInvalid.String(), dispatcherCondition.Reason)
+	assert.Contains(t, dispatcherCondition.Message, env.DispatcherImageEnvVarKey)
+}
+
+// Test The Dispatcher Deployment Env Vars Include The Consumer Config Overrides
+func TestDispatcherDeploymentEnvVarsConsumerConfig(t *testing.T) {
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+	}
+
+	// Verify No Env Var Without Any Consumer Config Override Annotations
+	envVars, err := r.dispatcherDeploymentEnvVars(controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(envVars, commonenv.KafkaConsumerConfigOverridesEnvVarKey))
+
+	// Verify The JSON Encoded Env Var With Consumer Config Override Annotations
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{
+		kafkaconstants.ConsumerConfigAnnotationPrefix + kafkaconstants.ConsumerConfigFetchMax:         "1048576",
+		kafkaconstants.ConsumerConfigAnnotationPrefix + kafkaconstants.ConsumerConfigSessionTimeoutMs: "30000",
+	}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.Nil(t, err)
+	envVar := findEnvVar(envVars, commonenv.KafkaConsumerConfigOverridesEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, `{"fetch.max":"1048576","session.timeout.ms":"30000"}`, envVar.Value)
+
+	// Verify Invalid Consumer Config Override Annotations Are Rejected
+	channel.Annotations[kafkaconstants.ConsumerConfigAnnotationPrefix+"unknown"] = "1"
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.NotNil(t, err)
+	assert.Nil(t, envVars)
+
+	// Verify Overrides Are Validated Against The ConfigMap-Derived Sarama Config (Session Timeout Not Above Its Heartbeat)
+	r.saramaConfig = sarama.NewConfig()
+	r.saramaConfig.Consumer.Group.Heartbeat.Interval = 6 * time.Second
+	channel.Annotations = map[string]string{kafkaconstants.ConsumerConfigAnnotationPrefix + kafkaconstants.ConsumerConfigSessionTimeoutMs: "5000"}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.NotNil(t, err)
+	assert.Nil(t, envVars)
+	assert.Equal(t, 6*time.Second, r.saramaConfig.Consumer.Group.Heartbeat.Interval)
+}
+
+// Test The Update Of An Existing Dispatcher Deployment's Consumer Config Overrides
+func TestUpdateDispatcherDeploymentConsumerConfig(t *testing.T) {
+
+	// Create A KafkaChannel With A Consumer Config Override & An Existing Deployment Without Any
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{kafkaconstants.ConsumerConfigAnnotationPrefix + kafkaconstants.ConsumerConfigFetchMax: "1048576"}
+	deployment := controllertesting.NewKafkaChannelDispatcherDeployment()
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		config:        controllertesting.NewConfig(),
+		adminClient:   &controllertesting.MockAdminClient{},
+		environment:   controllertesting.NewEnvironment(),
+		kubeClientset: fake.NewSimpleClientset(deployment),
+	}
+
+	// Verify The Consumer Config Overrides Env Var Is Added Without Perturbing The Original
+	addedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, deployment)
+	assert.Nil(t, err)
+	envVar := findEnvVar(addedDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.KafkaConsumerConfigOverridesEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, `{"fetch.max":"1048576"}`, envVar.Value)
+	assert.Nil(t, findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.KafkaConsumerConfigOverridesEnvVarKey))
+
+	// Verify A Subsequent Update Is A No-Op Once Converged
+	convergedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, addedDeployment)
+	assert.Nil(t, err)
+	assert.Same(t, addedDeployment, convergedDeployment)
+
+	// Verify A Changed Override Is Updated
+	channel.Annotations[kafkaconstants.ConsumerConfigAnnotationPrefix+kafkaconstants.ConsumerConfigFetchMax] = "2097152"
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, addedDeployment)
+	assert.Nil(t, err)
+	envVar = findEnvVar(updatedDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.KafkaConsumerConfigOverridesEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, `{"fetch.max":"2097152"}`, envVar.Value)
+
+	// Verify The Env Var Is Removed Along With The Overrides
+	channel.Annotations = nil
+	removedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, updatedDeployment)
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(removedDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.KafkaConsumerConfigOverridesEnvVarKey))
+}
+
+// Test The Dispatcher Deployment Env Vars Include The Subscriber Concurrency
+func TestDispatcherDeploymentEnvVarsSubscriberConcurrency(t *testing.T) {
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+	}
+
+	// Verify No Env Var Without A Subscriber Concurrency Annotation
+	envVars, err := r.dispatcherDeploymentEnvVars(controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(envVars, commonenv.KafkaSubscriberConcurrencyEnvVarKey))
+
+	// Verify The JSON Encoded Env Var With A Subscriber Concurrency Annotation
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{kafkaconstants.SubscriberConcurrencyAnnotation: `{"uid-b":8, "uid-a":1}`}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.Nil(t, err)
+	envVar := findEnvVar(envVars, commonenv.KafkaSubscriberConcurrencyEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, `{"uid-a":1,"uid-b":8}`, envVar.Value)
+
+	// Verify Invalid Subscriber Concurrency Annotations Are Rejected
+	channel.Annotations[kafkaconstants.SubscriberConcurrencyAnnotation] = `{"uid-a":65}`
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.NotNil(t, err)
+	assert.Nil(t, envVars)
+}
+
+// Test The Dispatcher Deployment Env Vars Include The Subscriber Ordering
+func TestDispatcherDeploymentEnvVarsSubscriberOrdering(t *testing.T) {
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+	}
+
+	// Verify No Env Var Without A Subscriber Ordering Annotation
+	envVars, err := r.dispatcherDeploymentEnvVars(controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(envVars, commonenv.KafkaSubscriberOrderingEnvVarKey))
+
+	// Verify The JSON Encoded Env Var With A Subscriber Ordering Annotation
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{kafkaconstants.SubscriberOrderingAnnotation: `{"uid-b":"unordered", "uid-a":"ordered"}`}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.Nil(t, err)
+	envVar := findEnvVar(envVars, commonenv.KafkaSubscriberOrderingEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, `{"uid-a":"ordered","uid-b":"unordered"}`, envVar.Value)
+
+	// Verify Invalid Subscriber Ordering Annotations Are Rejected
+	channel.Annotations[kafkaconstants.SubscriberOrderingAnnotation] = `{"uid-a":"sometimes"}`
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.NotNil(t, err)
+	assert.Nil(t, envVars)
+}
+
+// Test The Dispatcher Deployment Env Vars Include The Subscriber Dead Letter Topics
+func TestDispatcherDeploymentEnvVarsSubscriberDeadLetterTopics(t *testing.T) {
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+	}
+
+	// Verify No Env Var Without A Subscriber Dead Letter Topic Annotation
+	envVars, err := r.dispatcherDeploymentEnvVars(controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(envVars, commonenv.KafkaSubscriberDeadLetterTopicsEnvVarKey))
+
+	// Verify The JSON Encoded Env Var With A Subscriber Dead Letter Topic Annotation
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{kafkaconstants.SubscriberDeadLetterTopicAnnotation: `{"uid-b":"dlq-b", "uid-a":"dlq-a"}`}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.Nil(t, err)
+	envVar := findEnvVar(envVars, commonenv.KafkaSubscriberDeadLetterTopicsEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, `{"uid-a":"dlq-a","uid-b":"dlq-b"}`, envVar.Value)
+
+	// Verify Invalid Subscriber Dead Letter Topic Annotations Are Rejected
+	channel.Annotations[kafkaconstants.SubscriberDeadLetterTopicAnnotation] = `{"uid-a":""}`
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.NotNil(t, err)
+	assert.Nil(t, envVars)
+}
+
+// Test The Dispatcher Deployment Env Vars Include The Subscriber Initial Offsets
+func TestDispatcherDeploymentEnvVarsSubscriberInitialOffsets(t *testing.T) {
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+	}
+
+	// Verify No Env Var Without A Subscriber Initial Offset Annotation
+	envVars, err := r.dispatcherDeploymentEnvVars(controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(envVars, commonenv.KafkaSubscriberInitialOffsetsEnvVarKey))
+
+	// Verify The JSON Encoded Env Var With A Subscriber Initial Offset Annotation
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{kafkaconstants.SubscriberInitialOffsetAnnotation: `{"uid-b":"latest", "uid-a":"earliest"}`}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.Nil(t, err)
+	envVar := findEnvVar(envVars, commonenv.KafkaSubscriberInitialOffsetsEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, `{"uid-a":"earliest","uid-b":"latest"}`, envVar.Value)
+
+	// Verify Invalid Subscriber Initial Offset Annotations Are Rejected
+	channel.Annotations[kafkaconstants.SubscriberInitialOffsetAnnotation] = `{"uid-a":"Latest"}`
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.NotNil(t, err)
+	assert.Nil(t, envVars)
+}
+
+// Test The Dispatcher Deployment Env Vars Include The Subscriber Filters
+func TestDispatcherDeploymentEnvVarsSubscriberFilters(t *testing.T) {
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+	}
+
+	// Verify No Env Var Without A Subscriber Filter Annotation
+	envVars, err := r.dispatcherDeploymentEnvVars(controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(envVars, commonenv.KafkaSubscriberFiltersEnvVarKey))
+
+	// Verify The JSON Encoded Env Var With A Subscriber Filter Annotation
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{kafkaconstants.SubscriberFilterAnnotation: `{"uid-a": {"attributes": {"type": "foo", "source": ""}}}`}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.Nil(t, err)
+	envVar := findEnvVar(envVars, commonenv.KafkaSubscriberFiltersEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, `{"uid-a":{"attributes":{"source":"","type":"foo"}}}`, envVar.Value)
+
+	// Verify Invalid Subscriber Filter Annotations Are Rejected
+	channel.Annotations[kafkaconstants.SubscriberFilterAnnotation] = `{"uid-a": {"attributes": {"Type": "foo"}}}`
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.NotNil(t, err)
+	assert.Nil(t, envVars)
+}
+
+// Test The Dispatcher Deployment Env Vars Include The CloudEvent Extension Filter
+func TestDispatcherDeploymentEnvVarsExtensionFilter(t *testing.T) {
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+	}
+
+	// Verify No Env Var Without Extension Annotations Or Config
+	envVars, err := r.dispatcherDeploymentEnvVars(controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(envVars, commonenv.KafkaExtensionFilterEnvVarKey))
+
+	// Verify The JSON Encoded Env Var With The Default Lists From Config
+	r.config = &config.EventingKafkaConfig{Kafka: config.EKKafkaConfig{Extensions: config.EKExtensionsConfig{Allow: []string{"traceparent"}, Deny: []string{"internal"}}}}
+	envVars, err = r.dispatcherDeploymentEnvVars(controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	envVar := findEnvVar(envVars, commonenv.KafkaExtensionFilterEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, `{"allow":["traceparent"],"deny":["internal"]}`, envVar.Value)
+
+	// Verify The Annotations Override The Default Lists From Config
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{kafkaconstants.ExtensionsAllowAnnotation: "", kafkaconstants.ExtensionsDenyAnnotation: "custom, other"}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.Nil(t, err)
+	envVar = findEnvVar(envVars, commonenv.KafkaExtensionFilterEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, `{"deny":["custom","other"]}`, envVar.Value)
+
+	// Verify Denying A Context Attribute Is Rejected
+	channel.Annotations[kafkaconstants.ExtensionsDenyAnnotation] = "source"
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.NotNil(t, err)
+	assert.Nil(t, envVars)
+}
+
+// Test The Dispatcher Deployment Env Vars Include The Replay Timestamp
+func TestDispatcherDeploymentEnvVarsReplayTimestamp(t *testing.T) {
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+	}
+
+	// Verify No Env Var Without A Replay Annotation
+	envVars, err := r.dispatcherDeploymentEnvVars(controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(envVars, commonenv.KafkaReplayFromTimestampEnvVarKey))
+
+	// Verify The Canonical Env Var With A Requested Replay Annotation
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{kafkaconstants.ReplayFromTimestampAnnotation: "2020-11-12T14:14:15+01:00"}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.Nil(t, err)
+	envVar := findEnvVar(envVars, commonenv.KafkaReplayFromTimestampEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, "2020-11-12T13:14:15Z", envVar.Value)
+
+	// Verify The Env Var Is Unchanged Once The Request Has Been Recorded As Applied
+	channel.Annotations = map[string]string{kafkaconstants.ReplayAppliedTimestampAnnotation: "2020-11-12T13:14:15Z"}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.Nil(t, err)
+	assert.Equal(t, envVar, findEnvVar(envVars, commonenv.KafkaReplayFromTimestampEnvVarKey))
+
+	// Verify Future Replay Timestamps Are Rejected
+	channel.Annotations[kafkaconstants.ReplayFromTimestampAnnotation] = "2999-01-01T00:00:00Z"
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.NotNil(t, err)
+	assert.Nil(t, envVars)
+}
+
+// Test The Dispatcher Deployment's Kafka Readiness Probe & Interval Env Var
+func TestDispatcherDeploymentKafkaReadiness(t *testing.T) {
+
+	// Initialize The Reconciler With The Default Config
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Verify The Default Readiness Probe Targets The Kafka Readiness Endpoint
+	deployment, err := r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, health.KafkaReadinessPath, container.ReadinessProbe.HTTPGet.Path)
+	assert.Equal(t, int32(constants.DispatcherReadinessPeriod), container.ReadinessProbe.PeriodSeconds)
+	envVar := findEnvVar(container.Env, commonenv.KafkaReadinessIntervalEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, strconv.Itoa(constants.DispatcherReadinessPeriod), envVar.Value)
+
+	// Verify A Configured Readiness Interval Is Used For Both The Probe & The Env Var
+	r.config.Dispatcher.ReadinessIntervalSeconds = 20
+	deployment, err = r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	container = deployment.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, int32(20), container.ReadinessProbe.PeriodSeconds)
+	envVar = findEnvVar(container.Env, commonenv.KafkaReadinessIntervalEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, "20", envVar.Value)
+
+	// Verify An Existing Deployment With The Previous Readiness Interval Is Updated & Then Converged
+	r.config.Dispatcher.ReadinessIntervalSeconds = 0
+	existingDeployment, err := r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	r.kubeClientset = fake.NewSimpleClientset(existingDeployment)
+	r.config.Dispatcher.ReadinessIntervalSeconds = 20
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, controllertesting.NewKafkaChannel(), existingDeployment)
+	assert.Nil(t, err)
+	container = updatedDeployment.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, int32(20), container.ReadinessProbe.PeriodSeconds)
+	envVar = findEnvVar(container.Env, commonenv.KafkaReadinessIntervalEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, "20", envVar.Value)
+	convergedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, controllertesting.NewKafkaChannel(), updatedDeployment)
+	assert.Nil(t, err)
+	assert.Same(t, updatedDeployment, convergedDeployment)
+}
+
+// Test The Dispatcher Deployment's Termination Grace Period & Drain Timeout Env Var
+func TestDispatcherDeploymentDrainTimeout(t *testing.T) {
+
+	// Initialize The Reconciler With The Default Config
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Verify The Default Drain Timeout
+	deployment, err := r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(constants.DispatcherDrainTimeoutSeconds+constants.DispatcherTerminationGracePeriodBuffer), *deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	envVar := findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.DrainTimeoutEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, strconv.Itoa(constants.DispatcherDrainTimeoutSeconds), envVar.Value)
+
+	// Verify A Configured Drain Timeout Is Used For Both The Grace Period & The Env Var
+	r.config.Dispatcher.DrainTimeoutSeconds = 120
+	deployment, err = r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(120+constants.DispatcherTerminationGracePeriodBuffer), *deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	envVar = findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.DrainTimeoutEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, "120", envVar.Value)
+
+	// Verify An Existing Deployment With The Previous Drain Timeout Is Updated & Then Converged
+	r.config.Dispatcher.DrainTimeoutSeconds = 0
+	existingDeployment, err := r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	r.kubeClientset = fake.NewSimpleClientset(existingDeployment)
+	r.config.Dispatcher.DrainTimeoutSeconds = 120
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, controllertesting.NewKafkaChannel(), existingDeployment)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(120+constants.DispatcherTerminationGracePeriodBuffer), *updatedDeployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	envVar = findEnvVar(updatedDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.DrainTimeoutEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, "120", envVar.Value)
+	convergedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, controllertesting.NewKafkaChannel(), updatedDeployment)
+	assert.Nil(t, err)
+	assert.Same(t, updatedDeployment, convergedDeployment)
+}
+
+// Test The Dispatcher Deployment Kafka EnvVars Reference The Explicitly Selected Kafka Secret
+func TestDispatcherDeploymentEnvVarsSelectedKafkaSecret(t *testing.T) {
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+	}
+
+	// Verify The AdminClient's Kafka Secret Is Used Without A Selection
+	envVars, err := r.dispatcherDeploymentEnvVars(controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	envVar := findEnvVar(envVars, commonenv.KafkaBrokerEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, controllertesting.KafkaSecretName, envVar.ValueFrom.SecretKeyRef.Name)
+
+	// Verify The Selected Kafka Secret Is Used For All Kafka EnvVars
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{constants.KafkaSecretAnnotation: "kafkasecret-name-2"}
+	envVars, err = r.dispatcherDeploymentEnvVars(channel)
+	assert.Nil(t, err)
+	for _, key := range []string{commonenv.KafkaBrokerEnvVarKey, commonenv.KafkaUsernameEnvVarKey, commonenv.KafkaPasswordEnvVarKey} {
+		envVar = findEnvVar(envVars, key)
+		assert.NotNil(t, envVar)
+		assert.Equal(t, "kafkasecret-name-2", envVar.ValueFrom.SecretKeyRef.Name)
+	}
+}
+
+// Test The Dispatcher Deployment Kafka Rack ID / Node Name EnvVars
+func TestDispatcherDeploymentRackId(t *testing.T) {
+
+	// Initialize The Reconciler With The Default Config
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Verify Neither EnvVar Is Present By Default
+	deployment, err := r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.KafkaRackIdEnvVarKey))
+	assert.Nil(t, findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.NodeNameEnvVarKey))
+
+	// Verify The Node Name Is Injected Via The Downward API When Deriving The Rack ID From The Node's Zone
+	r.config.Dispatcher.RackIdFromNodeZone = true
+	deployment, err = r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.KafkaRackIdEnvVarKey))
+	envVar := findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.NodeNameEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, "spec.nodeName", envVar.ValueFrom.FieldRef.FieldPath)
+
+	// Verify A Static Rack ID Takes Precedence
+	r.config.Dispatcher.RackId = "TestRackId"
+	deployment, err = r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.NodeNameEnvVarKey))
+	envVar = findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.KafkaRackIdEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, "TestRackId", envVar.Value)
+
+	// Verify An Existing Deployment Deriving The Rack ID From The Node's Zone Is Updated To The Static Rack ID
+	r.config.Dispatcher.RackId = ""
+	existingDeployment, err := r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	r.kubeClientset = fake.NewSimpleClientset(existingDeployment)
+	r.config.Dispatcher.RackId = "TestRackId"
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, controllertesting.NewKafkaChannel(), existingDeployment)
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(updatedDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.NodeNameEnvVarKey))
+	envVar = findEnvVar(updatedDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.KafkaRackIdEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, "TestRackId", envVar.Value)
+
+	// Verify A Subsequent Update Is A No-Op Once Converged & That Disabling Both Removes The Rack ID
+	convergedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, controllertesting.NewKafkaChannel(), updatedDeployment)
+	assert.Nil(t, err)
+	assert.Same(t, updatedDeployment, convergedDeployment)
+	r.config.Dispatcher.RackId = ""
+	r.config.Dispatcher.RackIdFromNodeZone = false
+	removedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, controllertesting.NewKafkaChannel(), updatedDeployment)
+	assert.Nil(t, err)
+	assert.Nil(t, findEnvVar(removedDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.KafkaRackIdEnvVarKey))
+	assert.Nil(t, findEnvVar(removedDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.NodeNameEnvVarKey))
+}
+
+// Test The Dispatcher Deployment's Startup Probe
+func TestDispatcherDeploymentStartupProbe(t *testing.T) {
+
+	// Initialize The Reconciler With The Default Config
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Verify The Startup Probe Targets The Liveness Endpoint With The Default Timings
+	deployment, err := r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	startupProbe := deployment.Spec.Template.Spec.Containers[0].StartupProbe
+	assert.NotNil(t, startupProbe)
+	assert.Equal(t, health.LivenessPath, startupProbe.HTTPGet.Path)
+	assert.Equal(t, int32(constants.DispatcherStartupPeriod), startupProbe.PeriodSeconds)
+	assert.Equal(t, int32(constants.DispatcherStartupFailureThreshold), startupProbe.FailureThreshold)
+
+	// Verify Configured Timings Are Used
+	r.config.Dispatcher.StartupProbe.PeriodSeconds = 15
+	r.config.Dispatcher.StartupProbe.FailureThreshold = 60
+	deployment, err = r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	startupProbe = deployment.Spec.Template.Spec.Containers[0].StartupProbe
+	assert.NotNil(t, startupProbe)
+	assert.Equal(t, int32(15), startupProbe.PeriodSeconds)
+	assert.Equal(t, int32(60), startupProbe.FailureThreshold)
+
+	// Verify An Existing Deployment Without The Configured Timings Is Updated
+	existingDeployment := controllertesting.NewKafkaChannelDispatcherDeployment()
+	r.kubeClientset = fake.NewSimpleClientset(existingDeployment)
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, controllertesting.NewKafkaChannel(), existingDeployment)
+	assert.Nil(t, err)
+	assert.Equal(t, startupProbe, updatedDeployment.Spec.Template.Spec.Containers[0].StartupProbe)
+}
+
+// Test The Dispatcher Deployment's Consumer Lag Interval Env Var
+func TestDispatcherDeploymentConsumerLagInterval(t *testing.T) {
+
+	// Initialize The Reconciler With The Default Config
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: &controllertesting.MockAdminClient{},
+		environment: controllertesting.NewEnvironment(),
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Verify The Default Consumer Lag Interval
+	deployment, err := r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	envVar := findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.ConsumerLagIntervalEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, strconv.Itoa(constants.DispatcherConsumerLagIntervalSeconds), envVar.Value)
+
+	// Verify A Configured Consumer Lag Interval Is Used
+	r.config.Dispatcher.ConsumerLagIntervalSeconds = 15
+	deployment, err = r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	envVar = findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.ConsumerLagIntervalEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, "15", envVar.Value)
+
+	// Verify An Existing Deployment With The Previous Consumer Lag Interval Is Updated & Then Converged
+	r.config.Dispatcher.ConsumerLagIntervalSeconds = 0
+	existingDeployment, err := r.newDispatcherDeployment(r.logger, controllertesting.NewKafkaChannel())
+	assert.Nil(t, err)
+	r.kubeClientset = fake.NewSimpleClientset(existingDeployment)
+	r.config.Dispatcher.ConsumerLagIntervalSeconds = 15
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, controllertesting.NewKafkaChannel(), existingDeployment)
+	assert.Nil(t, err)
+	envVar = findEnvVar(updatedDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.ConsumerLagIntervalEnvVarKey)
+	assert.NotNil(t, envVar)
+	assert.Equal(t, "15", envVar.Value)
+	convergedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, controllertesting.NewKafkaChannel(), updatedDeployment)
+	assert.Nil(t, err)
+	assert.Same(t, updatedDeployment, convergedDeployment)
+}
+
+// Test The Dispatcher Reconciliation Of Invalid Resource Override Annotations
+func TestReconcileDispatcherInvalidResources(t *testing.T) {
+
+	// Create A KafkaChannel With An Invalid Resource Override Annotation
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{constants.DispatcherMemoryLimitAnnotation: "invalid"}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), config: controllertesting.NewConfig(), environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherResourcesInvalid.String())
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady).IsFalse())
+}
+
+// Test The Dispatcher Container Resources With Per-Channel Resource Override Annotations
+func TestDispatcherResources(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only          bool
+		name          string
+		annotations   map[string]string
+		wantRequests  corev1.ResourceList
+		wantLimits    corev1.ResourceList
+		wantErrSubstr string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name: "No Annotations",
+			wantRequests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(controllertesting.DispatcherCpuRequest),
+				corev1.ResourceMemory: resource.MustParse(controllertesting.DispatcherMemoryRequest),
+			},
+			wantLimits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(controllertesting.DispatcherCpuLimit),
+				corev1.ResourceMemory: resource.MustParse(controllertesting.DispatcherMemoryLimit),
+			},
+		},
+		{
+			name: "All Annotations",
+			annotations: map[string]string{
+				constants.DispatcherCpuRequestAnnotation:    "1",
+				constants.DispatcherCpuLimitAnnotation:      " 2 ",
+				constants.DispatcherMemoryRequestAnnotation: "256Mi",
+				constants.DispatcherMemoryLimitAnnotation:   "1Gi",
+			},
+			wantRequests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("256Mi"),
+			},
+			wantLimits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		},
+		{
+			name:          "Invalid Quantity",
+			annotations:   map[string]string{constants.DispatcherCpuLimitAnnotation: "lots"},
+			wantErrSubstr: constants.DispatcherCpuLimitAnnotation,
+		},
+		{
+			name:          "Request Exceeds Limit",
+			annotations:   map[string]string{constants.DispatcherMemoryRequestAnnotation: "1Gi"},
+			wantErrSubstr: "memory request '1Gi' exceeds limit '50Mi'",
+		},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Initialize The Reconciler & KafkaChannel
+			r := &Reconciler{
+				logger: logtesting.TestLogger(t).Desugar(),
+				config: controllertesting.NewConfig(),
+			}
+			channel := controllertesting.NewKafkaChannel()
+			channel.Annotations = testCase.annotations
+
+			// Perform The Test
+			resources, err := r.dispatcherResources(channel)
+
+			// Verify The Results
+			if len(testCase.wantErrSubstr) > 0 {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), testCase.wantErrSubstr)
+			} else {
+				assert.Nil(t, err)
+				assert.True(t, equality.Semantic.DeepEqual(testCase.wantRequests, resources.Requests))
+				assert.True(t, equality.Semantic.DeepEqual(testCase.wantLimits, resources.Limits))
+			}
+		})
+	}
+}
+
+// Test The Dispatcher Deployment Update Converges The Additional Labels & Annotations
+func TestUpdateDispatcherDeploymentAdditionalMetadata(t *testing.T) {
+
+	// Create A KafkaChannel & An Existing Deployment With A Stale (Previously Configured) Additional Label
+	channel := controllertesting.NewKafkaChannel()
+	deployment := controllertesting.NewKafkaChannelDispatcherDeployment()
+	deployment.Labels["stale-label"] = "stale"
+	deployment.Annotations = map[string]string{constants.AdditionalLabelsAnnotation: "stale-label"}
+
+	// Initialize The Reconciler With Additional Labels & Annotations Configured
+	configuration := controllertesting.NewConfig()
+	configuration.Metadata = config.EKMetadataConfig{
+		Labels:      map[string]string{"team": "eventing"},
+		Annotations: map[string]string{"owner": "platform"},
+	}
+	r := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		config:        configuration,
+		adminClient:   &controllertesting.MockAdminClient{},
+		environment:   controllertesting.NewEnvironment(),
+		kubeClientset: fake.NewSimpleClientset(deployment),
+	}
+
+	// Perform The Test
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, deployment)
+
+	// Verify The Results (Configured Metadata Added, Stale Label Pruned, Original Deployment Unperturbed)
+	assert.Nil(t, err)
+	assert.NotNil(t, updatedDeployment)
+	assert.Equal(t, "eventing", updatedDeployment.Labels["team"])
+	assert.Equal(t, "platform", updatedDeployment.Annotations["owner"])
+	assert.NotContains(t, updatedDeployment.Labels, "stale-label")
+	assert.Equal(t, "team", updatedDeployment.Annotations[constants.AdditionalLabelsAnnotation])
+	assert.Equal(t, "eventing", updatedDeployment.Spec.Template.Labels["team"])
+	assert.Equal(t, "platform", updatedDeployment.Spec.Template.Annotations["owner"])
+	assert.Equal(t, "stale", deployment.Labels["stale-label"])
+}
+
+func TestUpdateDispatcherDeploymentScheduling(t *testing.T) {
+
+	// Create A KafkaChannel & An Existing Deployment Without Any Scheduling Controls
+	channel := controllertesting.NewKafkaChannel()
+	deployment := controllertesting.NewKafkaChannelDispatcherDeployment()
+
+	// Initialize The Reconciler With Dispatcher Scheduling Controls Configured
+	configuration := controllertesting.NewConfig()
+	configuration.Dispatcher.NodeSelector = map[string]string{"pool": "kafka"}
+	configuration.Dispatcher.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "kafka", Effect: corev1.TaintEffectNoSchedule}}
+	configuration.Dispatcher.Affinity = &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gpu", Operator: corev1.NodeSelectorOpDoesNotExist}},
+				}},
+			},
+		},
+	}
+	r := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		config:        configuration,
+		adminClient:   &controllertesting.MockAdminClient{},
+		environment:   controllertesting.NewEnvironment(),
+		kubeClientset: fake.NewSimpleClientset(deployment),
+	}
+
+	// Verify A New Dispatcher Deployment Carries The Scheduling Controls
+	newDeployment, err := r.newDispatcherDeployment(r.logger, channel)
+	assert.Nil(t, err)
+	assert.Equal(t, configuration.Dispatcher.NodeSelector, newDeployment.Spec.Template.Spec.NodeSelector)
+	assert.Equal(t, configuration.Dispatcher.Tolerations, newDeployment.Spec.Template.Spec.Tolerations)
+	assert.Equal(t, configuration.Dispatcher.Affinity, newDeployment.Spec.Template.Spec.Affinity)
+
+	// Verify The Existing Deployment's Pod Template Is Updated (Rolling The Dispatcher) Without Perturbing The Original
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, deployment)
+	assert.Nil(t, err)
+	assert.NotNil(t, updatedDeployment)
+	assert.Equal(t, configuration.Dispatcher.NodeSelector, updatedDeployment.Spec.Template.Spec.NodeSelector)
+	assert.Equal(t, configuration.Dispatcher.Tolerations, updatedDeployment.Spec.Template.Spec.Tolerations)
+	assert.Equal(t, configuration.Dispatcher.Affinity, updatedDeployment.Spec.Template.Spec.Affinity)
+	assert.Nil(t, deployment.Spec.Template.Spec.NodeSelector)
+
+	// Verify A Subsequent Update Is A No-Op Once Converged
+	convergedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, updatedDeployment)
+	assert.Nil(t, err)
+	assert.Same(t, updatedDeployment, convergedDeployment)
+}
+
+// Test The Dispatcher Reconciliation Of Invalid Additional Volumes
+func TestReconcileDispatcherInvalidVolumes(t *testing.T) {
+
+	// Create A KafkaChannel
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+
+	// Initialize The Reconciler With A VolumeMount Referencing An Unknown Volume
+	configuration := controllertesting.NewConfig()
+	configuration.Dispatcher.VolumeMounts = []corev1.VolumeMount{{Name: "unknown", MountPath: "/etc/kafka/certs"}}
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), config: configuration, environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherVolumesInvalid.String())
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady).IsFalse())
+}
+
+// Test The Dispatcher Deployment's Additional Volumes & VolumeMounts Being Added, Updated & Removed
+func TestUpdateDispatcherDeploymentVolumes(t *testing.T) {
+
+	// Create A KafkaChannel & An Existing Deployment Without Any Additional Volumes
+	channel := controllertesting.NewKafkaChannel()
+	deployment := controllertesting.NewKafkaChannelDispatcherDeployment()
+
+	// Initialize The Reconciler With A Certificate Secret Volume & VolumeMount Configured
+	configuration := controllertesting.NewConfig()
+	configuration.Dispatcher.Volumes = []corev1.Volume{{Name: "kafka-certs", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "kafka-certs"}}}}
+	configuration.Dispatcher.VolumeMounts = []corev1.VolumeMount{{Name: "kafka-certs", MountPath: "/etc/kafka/certs", ReadOnly: true}}
+	r := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		config:        configuration,
+		adminClient:   &controllertesting.MockAdminClient{},
+		environment:   controllertesting.NewEnvironment(),
+		kubeClientset: fake.NewSimpleClientset(deployment),
+	}
+
+	// Verify A New Dispatcher Deployment Carries The Volumes & VolumeMounts
+	newDeployment, err := r.newDispatcherDeployment(r.logger, channel)
+	assert.Nil(t, err)
+	assert.Equal(t, configuration.Dispatcher.Volumes, newDeployment.Spec.Template.Spec.Volumes)
+	assert.Equal(t, configuration.Dispatcher.VolumeMounts, newDeployment.Spec.Template.Spec.Containers[0].VolumeMounts)
+
+	// Verify Added Volumes Are Applied To The Existing Deployment (Rolling The Dispatcher) Without Perturbing The Original
+	addedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, deployment)
+	assert.Nil(t, err)
+	assert.NotSame(t, deployment, addedDeployment)
+	assert.Equal(t, configuration.Dispatcher.Volumes, addedDeployment.Spec.Template.Spec.Volumes)
+	assert.Equal(t, configuration.Dispatcher.VolumeMounts, addedDeployment.Spec.Template.Spec.Containers[0].VolumeMounts)
+	assert.Nil(t, deployment.Spec.Template.Spec.Volumes)
+
+	// Verify A Subsequent Update Is A No-Op Once Converged
+	convergedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, addedDeployment)
+	assert.Nil(t, err)
+	assert.Same(t, addedDeployment, convergedDeployment)
+
+	// Verify An Updated VolumeMount Is Applied
+	configuration.Dispatcher.VolumeMounts = []corev1.VolumeMount{{Name: "kafka-certs", MountPath: "/etc/kafka/tls", ReadOnly: true}}
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, addedDeployment)
+	assert.Nil(t, err)
+	assert.NotSame(t, addedDeployment, updatedDeployment)
+	assert.Equal(t, configuration.Dispatcher.VolumeMounts, updatedDeployment.Spec.Template.Spec.Containers[0].VolumeMounts)
+
+	// Verify Removed Volumes & VolumeMounts Are Pruned
+	configuration.Dispatcher.Volumes = nil
+	configuration.Dispatcher.VolumeMounts = nil
+	removedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, updatedDeployment)
+	assert.Nil(t, err)
+	assert.NotSame(t, updatedDeployment, removedDeployment)
+	assert.Nil(t, removedDeployment.Spec.Template.Spec.Volumes)
+	assert.Nil(t, removedDeployment.Spec.Template.Spec.Containers[0].VolumeMounts)
+}
+
+// Test The Dispatcher Reconciliation Of Invalid Additional Env Vars
+func TestReconcileDispatcherInvalidEnv(t *testing.T) {
+
+	// Create A KafkaChannel
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+
+	// Initialize The Reconciler With An Env Var Specifying Both A Value & A Secret Reference
+	configuration := controllertesting.NewConfig()
+	configuration.Dispatcher.Env = []corev1.EnvVar{{Name: "TRACING_TOKEN", Value: "token", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "token"}}}}
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), config: configuration, environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherEnvInvalid.String())
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady).IsFalse())
+}
+
+// Test The Dispatcher Deployment's Additional Env Vars Being Added, Updated & Removed
+func TestUpdateDispatcherDeploymentEnv(t *testing.T) {
+
+	// Create A KafkaChannel & An Existing Deployment Without Any Additional Env Vars
+	channel := controllertesting.NewKafkaChannel()
+	deployment := controllertesting.NewKafkaChannelDispatcherDeployment()
+	managedEnvVar := *findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, commonenv.MetricsPortEnvVarKey)
+
+	// Initialize The Reconciler With A Proxy Env Var, A Secret Reference & A Conflicting Controller-Managed Env Var Configured
+	proxyEnvVar := corev1.EnvVar{Name: "HTTP_PROXY", Value: "http://proxy:3128"}
+	tokenEnvVar := corev1.EnvVar{Name: "TRACING_TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tracing"}, Key: "token"}}}
+	configuration := controllertesting.NewConfig()
+	configuration.Dispatcher.Env = []corev1.EnvVar{proxyEnvVar, tokenEnvVar, {Name: commonenv.MetricsPortEnvVarKey, Value: "9999"}}
+	r := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		config:        configuration,
+		adminClient:   &controllertesting.MockAdminClient{},
+		environment:   controllertesting.NewEnvironment(),
+		kubeClientset: fake.NewSimpleClientset(deployment),
+	}
+
+	// Verify A New Dispatcher Deployment Carries The Env Vars Without Clobbering The Controller-Managed Env Var
+	newDeployment, err := r.newDispatcherDeployment(r.logger, channel)
+	assert.Nil(t, err)
+	assert.Equal(t, &proxyEnvVar, findEnvVar(newDeployment.Spec.Template.Spec.Containers[0].Env, "HTTP_PROXY"))
+	assert.Equal(t, &tokenEnvVar, findEnvVar(newDeployment.Spec.Template.Spec.Containers[0].Env, "TRACING_TOKEN"))
+	assert.Equal(t, &managedEnvVar, findEnvVar(newDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.MetricsPortEnvVarKey))
+
+	// Verify Added Env Vars Are Applied To The Existing Deployment (Rolling The Dispatcher) Without Perturbing The Original
+	addedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, deployment)
+	assert.Nil(t, err)
+	assert.NotSame(t, deployment, addedDeployment)
+	assert.Equal(t, &proxyEnvVar, findEnvVar(addedDeployment.Spec.Template.Spec.Containers[0].Env, "HTTP_PROXY"))
+	assert.Equal(t, &tokenEnvVar, findEnvVar(addedDeployment.Spec.Template.Spec.Containers[0].Env, "TRACING_TOKEN"))
+	assert.Equal(t, &managedEnvVar, findEnvVar(addedDeployment.Spec.Template.Spec.Containers[0].Env, commonenv.MetricsPortEnvVarKey))
+	assert.Nil(t, findEnvVar(deployment.Spec.Template.Spec.Containers[0].Env, "HTTP_PROXY"))
+
+	// Verify A Subsequent Update Is A No-Op Once Converged
+	convergedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, addedDeployment)
+	assert.Nil(t, err)
+	assert.Same(t, addedDeployment, convergedDeployment)
+
+	// Verify An Updated Env Var Is Applied & A Removed Env Var Is Pruned
+	configuration.Dispatcher.Env = []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:8080"}}
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, addedDeployment)
+	assert.Nil(t, err)
+	assert.NotSame(t, addedDeployment, updatedDeployment)
+	assert.Equal(t, "http://proxy:8080", findEnvVar(updatedDeployment.Spec.Template.Spec.Containers[0].Env, "HTTP_PROXY").Value)
+	assert.Nil(t, findEnvVar(updatedDeployment.Spec.Template.Spec.Containers[0].Env, "TRACING_TOKEN"))
+
+	// Verify All Env Vars Are Pruned (Leaving The Controller-Managed Env Vars) Once None Are Configured
+	configuration.Dispatcher.Env = nil
+	removedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, updatedDeployment)
+	assert.Nil(t, err)
+	assert.NotSame(t, updatedDeployment, removedDeployment)
+	assert.Equal(t, deployment.Spec.Template.Spec.Containers[0].Env, removedDeployment.Spec.Template.Spec.Containers[0].Env)
+	assert.NotContains(t, removedDeployment.Spec.Template.Annotations, constants.AdditionalEnvAnnotation)
+}
+
+// Test The Dispatcher Replicas (Per-Channel Annotation Overriding Config & Clamped To The Topic's Partitions)
+func TestDispatcherReplicas(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name            string
+		annotation      string
+		numPartitions   int32
+		expectedReplica int32
+		expectedClamped bool
+		expectErr       bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Config Default", expectedReplica: controllertesting.DispatcherReplicas},
+		{name: "Annotation Override", annotation: "3", expectedReplica: 3},
+		{name: "Annotation Equal To Partitions", annotation: "123", expectedReplica: controllertesting.NumPartitions},
+		{name: "Annotation Clamped To Partitions", annotation: "200", expectedReplica: controllertesting.NumPartitions, expectedClamped: true},
+		{name: "Annotation Clamped To Default Partitions", annotation: "8", numPartitions: -1, expectedReplica: controllertesting.DefaultNumPartitions, expectedClamped: true},
+		{name: "Zero Replicas", annotation: "0", expectErr: true},
+		{name: "Non-Numeric Replicas", annotation: "many", expectErr: true},
+	}
+
+	// Initialize The Reconciler
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), config: controllertesting.NewConfig()}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			channel := controllertesting.NewKafkaChannel()
+			if testCase.numPartitions != 0 {
+				channel.Spec.NumPartitions = testCase.numPartitions
+			}
+			if len(testCase.annotation) > 0 {
+				channel.Annotations = map[string]string{constants.DispatcherReplicasAnnotation: testCase.annotation}
+			}
+			replicas, clamped, err := r.dispatcherReplicas(channel)
+			assert.Equal(t, testCase.expectErr, err != nil)
+			if !testCase.expectErr {
+				assert.Equal(t, testCase.expectedReplica, replicas)
+				assert.Equal(t, testCase.expectedClamped, clamped)
+			}
+		})
+	}
+}
+
+// Test The Dispatcher Reconciliation Warns When The Requested Replicas Are Clamped To The Topic's Partitions
+func TestReconcileDispatcherReplicasClamped(t *testing.T) {
+
+	// Create A KafkaChannel Requesting More Dispatcher Replicas Than It Has Partitions
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{constants.DispatcherReplicasAnnotation: "200"}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	kubeClientset := fake.NewSimpleClientset()
+	listers := controllertesting.NewListers(nil)
+	r := &Reconciler{
+		logger:           logtesting.TestLogger(t).Desugar(),
+		config:           controllertesting.NewConfig(),
+		adminClient:      &controllertesting.MockAdminClient{},
+		environment:      controllertesting.NewEnvironment(),
+		kubeClientset:    kubeClientset,
+		serviceLister:    listers.GetServiceLister(),
+		deploymentLister: listers.GetDeploymentLister(),
+	}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Clamp Warning Event & The Dispatcher Deployment's Clamped Replicas
+	assert.Nil(t, err)
+	clampEvent := <-recorder.Events
+	assert.Contains(t, clampEvent, corev1.EventTypeWarning)
+	assert.Contains(t, clampEvent, event.DispatcherReplicasClamped.String())
+	deployment, err := kubeClientset.AppsV1().Deployments(commonconstants.KnativeEventingNamespace).Get(ctx, util.DispatcherDnsSafeName(channel), metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(controllertesting.NumPartitions), *deployment.Spec.Replicas)
+}
+
+// Test The Dispatcher Reconciliation Of An Invalid Replicas Annotation
+func TestReconcileDispatcherInvalidReplicas(t *testing.T) {
+
+	// Create A KafkaChannel With A Malformed Dispatcher Replicas Annotation
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{constants.DispatcherReplicasAnnotation: "-1"}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), config: controllertesting.NewConfig(), environment: controllertesting.NewEnvironment()}
+
+	// Perform The Test
+	err := r.reconcileDispatcher(ctx, channel)
+
+	// Verify The Results
+	assert.NotNil(t, err)
+	assert.Contains(t, <-recorder.Events, event.DispatcherReplicasInvalid.String())
+	dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	assert.True(t, dispatcherCondition.IsFalse())
+	assert.Equal(t, event.DispatcherReplicasInvalid.String(), dispatcherCondition.Reason)
+}
+
+// Test Updating The Dispatcher Deployment's Replicas When The Annotation Changes
+func TestUpdateDispatcherDeploymentReplicas(t *testing.T) {
+
+	// Create A KafkaChannel Requesting Additional Replicas & An Existing Deployment With The Default Replicas
+	channel := controllertesting.NewKafkaChannel()
+	channel.Annotations = map[string]string{constants.DispatcherReplicasAnnotation: "3"}
+	deployment := controllertesting.NewKafkaChannelDispatcherDeployment()
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		config:        controllertesting.NewConfig(),
+		adminClient:   &controllertesting.MockAdminClient{},
+		environment:   controllertesting.NewEnvironment(),
+		kubeClientset: fake.NewSimpleClientset(deployment),
+	}
+
+	// Verify The Existing Deployment's Replicas Are Updated Without Perturbing The Original
+	updatedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, deployment)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), *updatedDeployment.Spec.Replicas)
+	assert.Equal(t, int32(controllertesting.DispatcherReplicas), *deployment.Spec.Replicas)
+
+	// Verify A Subsequent Update Is A No-Op Once Converged
+	convergedDeployment, err := r.updateDispatcherDeployment(context.TODO(), r.logger, channel, updatedDeployment)
+	assert.Nil(t, err)
+	assert.Same(t, updatedDeployment, convergedDeployment)
+}
+
+//
+// Test Pausing & Resuming The Dispatcher Via The Paused Annotation
+//
+// Pausing scales the Dispatcher Deployment to zero (leaving its ConsumerGroups) while retaining the Deployment,
+// and marks the Paused condition without affecting readiness (also when KEDA is scaling the Dispatcher, whose
+// ScaledObject is removed while paused).  Resuming restores the desired replicas (or leaves them to the recreated
+// KEDA ScaledObject) and clears the Paused condition.  The ConsumerGroup IDs are unaffected, so the resumed Dispatcher
+// continues from the last committed offsets.  Paused & Resumed events are only emitted on the transitions.
+//
+func TestReconcileDispatcherPausedAndResumed(t *testing.T) {
+
+	// Create A Paused KafkaChannel
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{constants.PausedAnnotation: "true"}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	kubeClientset := fake.NewSimpleClientset()
+	listers := controllertesting.NewListers(nil)
+	r := &Reconciler{
+		logger:           logtesting.TestLogger(t).Desugar(),
+		config:           controllertesting.NewConfig(),
+		adminClient:      &controllertesting.MockAdminClient{},
+		environment:      controllertesting.NewEnvironment(),
+		kubeClientset:    kubeClientset,
+		serviceLister:    listers.GetServiceLister(),
+		deploymentLister: listers.GetDeploymentLister(),
+	}
+
+	// Verify Pausing Creates The Dispatcher Deployment Scaled To Zero & Marks The Paused Condition
+	assert.Nil(t, r.reconcileDispatcher(ctx, channel))
+	assert.Contains(t, <-recorder.Events, event.DispatcherPaused.String())
+	assert.True(t, channel.Status.IsPaused())
+	deployment, err := kubeClientset.AppsV1().Deployments(commonconstants.KnativeEventingNamespace).Get(ctx, util.DispatcherDnsSafeName(channel), metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), *deployment.Spec.Replicas)
+
+	// Verify Remaining Paused Is Idempotent (No Further Events)
+	r.reconcileDispatcherPaused(ctx, r.logger, channel)
+	assert.True(t, channel.Status.IsPaused())
+	assert.Len(t, recorder.Events, 0)
+
+	// Verify Resuming (Removing The Annotation) Restores The Replicas Even When KEDA Is Enabled
+	delete(channel.Annotations, constants.PausedAnnotation)
+	r.config.Dispatcher.Keda.Enabled = true
+	r.dynamicClient = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	resumedDeployment, err := r.updateDispatcherDeployment(ctx, r.logger, channel, deployment)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), *resumedDeployment.Spec.Replicas) // KEDA Owns The Replicas Once Resumed
+
+	// Verify Resuming Without KEDA Restores The Desired Replicas & Clears The Paused Condition
+	r.config.Dispatcher.Keda.Enabled = false
+	resumedDeployment, err = r.updateDispatcherDeployment(ctx, r.logger, channel, deployment)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(controllertesting.DispatcherReplicas), *resumedDeployment.Spec.Replicas)
+	r.reconcileDispatcherPaused(ctx, r.logger, channel)
+	assert.False(t, channel.Status.IsPaused())
+	assert.Contains(t, <-recorder.Events, event.DispatcherResumed.String())
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionReady).IsUnknown())
+
+	// Verify Pausing An Existing KEDA-Scaled Deployment Scales It To Zero
+	channel.Annotations[constants.PausedAnnotation] = "true"
+	r.config.Dispatcher.Keda.Enabled = true
+	pausedDeployment, err := r.updateDispatcherDeployment(ctx, r.logger, channel, resumedDeployment)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), *pausedDeployment.Spec.Replicas)
+}
+
+// Utility Function For Finding The Named EnvVar
+func findEnvVar(envVars []corev1.EnvVar, name string) *corev1.EnvVar {
+	for index := range envVars {
+		if envVars[index].Name == name {
+			return &envVars[index]
+		}
+	}
+	return nil
+}
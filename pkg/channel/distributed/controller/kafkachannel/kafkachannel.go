@@ -18,22 +18,30 @@ package kafkachannel
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
 	commonk8s "knative.dev/eventing-kafka/pkg/channel/distributed/common/k8s"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
 	"knative.dev/eventing/pkg/apis/messaging"
+	"knative.dev/pkg/controller"
 )
 
 // Reconcile The KafkaChannel Itself - After Channel Reconciliation (Add MetaData)
 func (r *Reconciler) reconcileKafkaChannel(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
 
 	// Get Channel Specific Logger
-	logger := util.ChannelLogger(r.logger, channel)
+	logger := util.ReconcileLogger(ctx, channel)
 
 	// Reconcile The KafkaChannel's MetaData
 	err := r.reconcileMetaData(ctx, channel)
@@ -46,9 +54,135 @@ func (r *Reconciler) reconcileKafkaChannel(ctx context.Context, channel *kafkav1
 	}
 }
 
+//
+// Reconcile The KafkaChannel's Channel-Level Dead Letter Sink
+//
+// The Spec.Delivery.DeadLetterSink (if any) is resolved to a URI and stored in the KafkaChannel's Status, from
+// where the Dispatcher uses it for any Subscribers which do not specify their own dead letter sink.  Failure to
+// resolve the sink is surfaced via the DeadLetterSinkResolved condition (which does not affect readiness).
+//
+func (r *Reconciler) reconcileDeadLetterSink(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
+
+	// Get Channel Specific Logger
+	logger := util.ReconcileLogger(ctx, channel)
+
+	// Nothing To Resolve If No Channel-Level Dead Letter Sink Is Configured
+	if channel.Spec.Delivery == nil || channel.Spec.Delivery.DeadLetterSink == nil {
+		channel.Status.MarkDeadLetterSinkNotConfigured()
+		return nil
+	}
+
+	// Resolve The Dead Letter Sink Destination Into A URI (Tracking Any Referenced Addressable)
+	deadLetterSinkURI, err := r.uriResolver.URIFromDestinationV1(ctx, *channel.Spec.Delivery.DeadLetterSink, channel)
+	if err != nil {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.DeadLetterSinkResolutionFailed.String(), "Failed To Resolve Dead Letter Sink: %v", err)
+		logger.Error("Failed To Resolve Dead Letter Sink", zap.Error(err))
+		channel.Status.MarkDeadLetterSinkResolvedFailed(event.DeadLetterSinkResolutionFailed.String(), "Failed To Resolve Dead Letter Sink: %v", err)
+		return err
+	}
+
+	// Track The Resolved Dead Letter Sink URI In The KafkaChannel's Status
+	logger.Info("Successfully Resolved Dead Letter Sink", zap.String("URI", deadLetterSinkURI.String()))
+	channel.Status.MarkDeadLetterSinkResolved(deadLetterSinkURI)
+	return nil
+}
+
+//
+// Reconcile The KafkaChannel's Per-Subscription Shadow Destinations
+//
+// Each shadow Destination in the SubscriberShadow annotation is resolved into a URI (tracking any referenced
+// Addressable) and the results are written to the KafkaChannel's Status as a JSON map of Subscription UID to
+// URI & percent, from which the Dispatcher mirrors events.  Shadows which fail to resolve are omitted (so that
+// they are simply not mirrored) and reported via an Event, but never affect delivery to the primary Subscriber.
+//
+func (r *Reconciler) reconcileSubscriberShadows(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
+
+	// Get Channel Specific Logger
+	logger := util.ReconcileLogger(ctx, channel)
+
+	// Get The (Already Validated) Subscriber Shadows
+	shadows, err := consumer.SubscriberShadows(channel.Annotations)
+	if err != nil {
+		return err
+	}
+
+	// Resolve Each Shadow Destination Into A URI
+	var resolutionErr error
+	resolvedShadows := make(map[string]consumer.ResolvedSubscriberShadow, len(shadows))
+	for uid, shadow := range shadows {
+		shadowURI, err := r.uriResolver.URIFromDestinationV1(ctx, shadow.Destination, channel)
+		if err != nil {
+			controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.SubscriberShadowResolutionFailed.String(), "Failed To Resolve Subscriber Shadow For Subscription %s: %v", uid, err)
+			logger.Error("Failed To Resolve Subscriber Shadow", zap.String("Subscription", uid), zap.Error(err))
+			resolutionErr = err
+			continue
+		}
+		resolvedShadows[uid] = consumer.ResolvedSubscriberShadow{URI: shadowURI, Percent: shadow.Percent}
+	}
+
+	// Track The Resolved Subscriber Shadows In The KafkaChannel's Status
+	if len(resolvedShadows) > 0 {
+		resolvedShadowsJson, err := json.Marshal(resolvedShadows)
+		if err != nil {
+			logger.Error("Failed To Marshal Resolved Subscriber Shadows", zap.Error(err))
+			return err
+		}
+		if channel.Status.Annotations == nil {
+			channel.Status.Annotations = make(map[string]string)
+		}
+		channel.Status.Annotations[kafkaconstants.SubscriberShadowResolvedAnnotation] = string(resolvedShadowsJson)
+	} else {
+		delete(channel.Status.Annotations, kafkaconstants.SubscriberShadowResolvedAnnotation)
+	}
+	return resolutionErr
+}
+
+//
+// Reconcile The KafkaChannel's Explicit Kafka Secret Selection
+//
+// A KafkaChannel may explicitly select one of several Kafka Secrets (one per Kafka cluster) via annotation, in
+// which case the AdminClient, Receiver and Dispatcher all use that Kafka Secret instead of the implicit selection.
+// References to a Kafka Secret which does not exist (or is not labelled as a Kafka Secret) are rejected via the
+// ConfigurationReady condition rather than silently falling back to a different Kafka cluster.
+//
+func (r *Reconciler) reconcileKafkaSecretSelection(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
+
+	// Nothing To Verify If The KafkaChannel Uses The Implicit Kafka Secret Selection
+	kafkaSecretName := util.KafkaSecretName(channel)
+	if len(kafkaSecretName) <= 0 {
+		return nil
+	}
+
+	// Get Channel Specific Logger
+	logger := util.ReconcileLogger(ctx, channel).With(zap.String("KafkaSecret", kafkaSecretName))
+
+	// Look For The Selected Kafka Secret Among The Labelled Kafka Secrets
+	kafkaSecrets, err := adminutil.GetKafkaSecrets(ctx, r.kubeClientset, commonconstants.KnativeEventingNamespace)
+	if err != nil {
+		logger.Error("Failed To Get Kafka Secrets", zap.Error(err))
+		channel.Status.MarkConfigFailed(event.KafkaSecretNotFound.String(), "Failed To Get Kafka Secrets: %v", err)
+		return err
+	}
+	for _, kafkaSecret := range kafkaSecrets.Items {
+		if kafkaSecret.Name == kafkaSecretName {
+			logger.Debug("Successfully Verified Selected Kafka Secret")
+			return nil
+		}
+	}
+
+	// The Selected Kafka Secret Does Not Exist - Reject The KafkaChannel
+	controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaSecretNotFound.String(), "Selected Kafka Secret %s Not Found", kafkaSecretName)
+	logger.Error("Selected Kafka Secret Not Found")
+	channel.Status.MarkConfigFailed(event.KafkaSecretNotFound.String(), "Selected Kafka Secret %s Not Found", kafkaSecretName)
+	return fmt.Errorf("selected kafka secret '%s' not found", kafkaSecretName)
+}
+
 // Reconcile The KafkaChannels MetaData (Annotations, Labels, etc...)
 func (r *Reconciler) reconcileMetaData(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
 
+	// Get Channel Specific Logger
+	logger := util.ReconcileLogger(ctx, channel)
+
 	// Update The MetaData (Annotations, Labels, etc...)
 	annotationsModified := r.reconcileAnnotations(channel)
 	labelsModified := r.reconcileLabels(channel)
@@ -59,16 +193,16 @@ func (r *Reconciler) reconcileMetaData(ctx context.Context, channel *kafkav1beta
 		// Then Persist Changes To Kubernetes
 		_, err := r.kafkaClientSet.MessagingV1beta1().KafkaChannels(channel.Namespace).Update(ctx, channel, metav1.UpdateOptions{})
 		if err != nil {
-			r.logger.Error("Failed To Update KafkaChannel MetaData", zap.Error(err))
+			logger.Error("Failed To Update KafkaChannel MetaData", zap.Error(err))
 			return err
 		} else {
-			r.logger.Info("Successfully Updated KafkaChannel MetaData")
+			logger.Info("Successfully Updated KafkaChannel MetaData")
 			return nil
 		}
 	} else {
 
 		// Otherwise Nothing To Do
-		r.logger.Info("Successfully Verified KafkaChannel MetaData")
+		logger.Info("Successfully Verified KafkaChannel MetaData")
 		return nil
 	}
 }
@@ -93,6 +227,19 @@ func (r *Reconciler) reconcileAnnotations(channel *kafkav1beta1.KafkaChannel) bo
 		modified = true
 	}
 
+	// Record Any Requested Replay As Applied, Removing The Request So That Each Replay Happens Only Once
+	if requested, ok := annotations[kafkaconstants.ReplayFromTimestampAnnotation]; ok {
+		if timestamp, err := consumer.ParseReplayTimestamp(requested); err == nil {
+			applied := consumer.FormatReplayTimestamp(timestamp)
+			if annotations[kafkaconstants.ReplayAppliedTimestampAnnotation] == applied {
+				r.logger.Warn("Ignoring Repeated Request To Replay From Already Applied Timestamp", zap.String("Timestamp", applied))
+			}
+			annotations[kafkaconstants.ReplayAppliedTimestampAnnotation] = applied
+			delete(annotations, kafkaconstants.ReplayFromTimestampAnnotation)
+			modified = true
+		}
+	}
+
 	// Update The Channel's Annotations
 	if modified {
 		channel.ObjectMeta.Annotations = annotations
@@ -0,0 +1,266 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/eventing/pkg/apis/messaging"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/addressable"
+	"knative.dev/pkg/controller"
+	fakedynamicclient "knative.dev/pkg/injection/clients/dynamicclient/fake"
+	logtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/resolver"
+)
+
+// Test The Reconciliation Of A KafkaChannel's Explicit Kafka Secret Selection
+func TestReconcileKafkaSecretSelection(t *testing.T) {
+
+	// Test Data
+	unlabelledKafkaSecretName := "unlabelled-kafkasecret-name"
+	unlabelledKafkaSecret := controllertesting.NewKafkaSecret()
+	unlabelledKafkaSecret.Name = unlabelledKafkaSecretName
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only            bool
+		name            string
+		kafkaSecretName string
+		wantErr         bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:            "Implicit Selection",
+			kafkaSecretName: "",
+		},
+		{
+			name:            "Selected Kafka Secret Found",
+			kafkaSecretName: controllertesting.KafkaSecretName,
+		},
+		{
+			name:            "Selected Kafka Secret Not Found",
+			kafkaSecretName: controllertesting.MissingKafkaSecretName,
+			wantErr:         true,
+		},
+		{
+			name:            "Selected Kafka Secret Not Labelled",
+			kafkaSecretName: unlabelledKafkaSecretName,
+			wantErr:         true,
+		},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A KafkaChannel Selecting The TestCase's Kafka Secret
+			channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+			if len(testCase.kafkaSecretName) > 0 {
+				channel.Annotations = map[string]string{constants.KafkaSecretAnnotation: testCase.kafkaSecretName}
+			}
+
+			// Initialize The Reconciler
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			r := &Reconciler{
+				logger:        logtesting.TestLogger(t).Desugar(),
+				kubeClientset: fake.NewSimpleClientset(controllertesting.NewKafkaSecret(controllertesting.WithKafkaSecretLabel), unlabelledKafkaSecret),
+			}
+
+			// Perform The Test
+			err := r.reconcileKafkaSecretSelection(ctx, channel)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			configCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionConfigReady)
+			if testCase.wantErr {
+				assert.Equal(t, corev1.ConditionFalse, configCondition.Status)
+				assert.Equal(t, event.KafkaSecretNotFound.String(), configCondition.Reason)
+				assert.Contains(t, <-recorder.Events, event.KafkaSecretNotFound.String())
+			} else {
+				assert.Equal(t, controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions).Status.GetCondition(kafkav1beta1.KafkaChannelConditionConfigReady).Status, configCondition.Status)
+				assert.Len(t, recorder.Events, 0)
+			}
+		})
+	}
+}
+
+// Test The Reconciliation Of A KafkaChannel's Replay Annotations
+func TestReconcileAnnotationsReplay(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name            string
+		requested       string
+		applied         string
+		expectedApplied string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Replay"},
+		{name: "Replay Requested", requested: "2020-11-12T14:14:15+01:00", expectedApplied: "2020-11-12T13:14:15Z"},
+		{name: "New Replay Requested", requested: "2020-11-12T13:14:15Z", applied: "2020-11-01T00:00:00Z", expectedApplied: "2020-11-12T13:14:15Z"},
+		{name: "Repeated Replay Requested", requested: "2020-11-12T13:14:15Z", applied: "2020-11-12T13:14:15Z", expectedApplied: "2020-11-12T13:14:15Z"},
+		{name: "Previous Replay Applied", applied: "2020-11-01T00:00:00Z", expectedApplied: "2020-11-01T00:00:00Z"},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A KafkaChannel With The TestCase's Replay Annotations
+			channel := controllertesting.NewKafkaChannel()
+			channel.Annotations = map[string]string{messaging.SubscribableDuckVersionAnnotation: constants.SubscribableDuckVersionAnnotationV1}
+			if len(testCase.requested) > 0 {
+				channel.Annotations[kafkaconstants.ReplayFromTimestampAnnotation] = testCase.requested
+			}
+			if len(testCase.applied) > 0 {
+				channel.Annotations[kafkaconstants.ReplayAppliedTimestampAnnotation] = testCase.applied
+			}
+
+			// Perform The Test
+			r := &Reconciler{logger: logtesting.TestLogger(t).Desugar()}
+			modified := r.reconcileAnnotations(channel)
+
+			// Verify The Results (The Request Is Always Consumed)
+			assert.Equal(t, len(testCase.requested) > 0, modified)
+			assert.NotContains(t, channel.Annotations, kafkaconstants.ReplayFromTimestampAnnotation)
+			assert.Equal(t, testCase.expectedApplied, channel.Annotations[kafkaconstants.ReplayAppliedTimestampAnnotation])
+		})
+	}
+}
+
+// Test The Reconciliation Of A KafkaChannel's Per-Subscription Shadow Destinations
+func TestReconcileSubscriberShadows(t *testing.T) {
+
+	// Test Data
+	unresolvableShadow := `"uid3":{"ref":{"apiVersion":"messaging.knative.dev/v1","kind":"InMemoryChannel","namespace":"test-namespace","name":"missing"},"percent":25}`
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name              string
+		annotation        string
+		statusAnnotation  string
+		expectedResolved  map[string]string
+		expectedPercents  map[string]int32
+		expectedErr       bool
+		expectedEventType string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name: "No Shadows",
+		},
+		{
+			name:             "No Shadows With Previously Resolved Shadows",
+			statusAnnotation: `{"uid1":{"uri":"http://shadow.example.com","percent":10}}`,
+		},
+		{
+			name:             "URI Shadows",
+			annotation:       `{"uid1":{"uri":"http://shadow1.example.com","percent":10},"uid2":{"uri":"https://shadow2.example.com/path","percent":100}}`,
+			expectedResolved: map[string]string{"uid1": "http://shadow1.example.com", "uid2": "https://shadow2.example.com/path"},
+			expectedPercents: map[string]int32{"uid1": 10, "uid2": 100},
+		},
+		{
+			name:              "Unresolvable Shadow",
+			annotation:        `{"uid1":{"uri":"http://shadow1.example.com","percent":10},` + unresolvableShadow + `}`,
+			expectedResolved:  map[string]string{"uid1": "http://shadow1.example.com"},
+			expectedPercents:  map[string]int32{"uid1": 10},
+			expectedErr:       true,
+			expectedEventType: event.SubscriberShadowResolutionFailed.String(),
+		},
+		{
+			name:        "Invalid Shadow",
+			annotation:  `{"uid1":{"uri":"http://shadow1.example.com","percent":0}}`,
+			expectedErr: true,
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A KafkaChannel With The TestCase's Shadow Annotations
+			channel := controllertesting.NewKafkaChannel()
+			if len(testCase.annotation) > 0 {
+				channel.Annotations = map[string]string{kafkaconstants.SubscriberShadowAnnotation: testCase.annotation}
+			}
+			if len(testCase.statusAnnotation) > 0 {
+				channel.Status.Annotations = map[string]string{kafkaconstants.SubscriberShadowResolvedAnnotation: testCase.statusAnnotation}
+			}
+
+			// Initialize The Reconciler With A URIResolver Backed By A Fake Dynamic Client
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			ctx, _ = fakedynamicclient.With(ctx, runtime.NewScheme())
+			r := &Reconciler{
+				logger:      logtesting.TestLogger(t).Desugar(),
+				uriResolver: resolver.NewURIResolver(addressable.WithDuck(ctx), func(types.NamespacedName) {}),
+			}
+
+			// Perform The Test
+			err := r.reconcileSubscriberShadows(ctx, channel)
+
+			// Verify The Results
+			assert.Equal(t, testCase.expectedErr, err != nil)
+			if len(testCase.expectedEventType) > 0 {
+				assert.Contains(t, <-recorder.Events, testCase.expectedEventType)
+			}
+			if testCase.expectedErr && len(testCase.expectedEventType) == 0 {
+				return // Invalid Shadows Are Rejected Before Resolution
+			}
+			resolvedShadows, err := consumer.ResolvedSubscriberShadows(channel.Status.Annotations)
+			assert.Nil(t, err)
+			if len(testCase.expectedResolved) == 0 {
+				assert.NotContains(t, channel.Status.Annotations, kafkaconstants.SubscriberShadowResolvedAnnotation)
+			}
+			assert.Len(t, resolvedShadows, len(testCase.expectedResolved))
+			for uid, expectedURI := range testCase.expectedResolved {
+				assert.Equal(t, expectedURI, resolvedShadows[uid].URI.String())
+				assert.Equal(t, testCase.expectedPercents[uid], resolvedShadows[uid].Percent)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/reconciler"
+)
+
+//
+// Leader-Aware Controller Reconciler
+//
+// The generated KafkaChannel reconciler only reconciles (and therefore only creates Kafka AdminClients)
+// while this instance is the leader of a bucket.  This wrapper additionally tracks the transitions between
+// leading no buckets and leading at least one bucket, so that the instance-wide leader-only work (the
+// controller_is_leader metric, the Kafka Topic bootstrap, and the AdminClient pool) follows leadership and
+// standby replicas never open connections to the Kafka brokers.
+//
+type leaderAwareReconciler struct {
+	controller.Reconciler
+	leaderAware reconciler.LeaderAware
+	mutex       sync.Mutex
+	buckets     map[string]bool
+	onPromote   func()
+	onDemote    func()
+}
+
+// Verify The leaderAwareReconciler Implements The LeaderAware Interface
+var _ reconciler.LeaderAware = (*leaderAwareReconciler)(nil)
+
+// Wrap The Specified (LeaderAware) Reconciler With The Promotion / Demotion Callbacks
+func newLeaderAwareReconciler(r controller.Reconciler, onPromote func(), onDemote func()) controller.Reconciler {
+	leaderAware, ok := r.(reconciler.LeaderAware)
+	if !ok {
+		return r
+	}
+	return &leaderAwareReconciler{
+		Reconciler:  r,
+		leaderAware: leaderAware,
+		buckets:     make(map[string]bool),
+		onPromote:   onPromote,
+		onDemote:    onDemote,
+	}
+}
+
+// Promote Implements The LeaderAware Interface (Invoking onPromote When Becoming Leader Of The First Bucket)
+func (l *leaderAwareReconciler) Promote(bkt reconciler.Bucket, enq func(reconciler.Bucket, types.NamespacedName)) error {
+	err := l.leaderAware.Promote(bkt, enq)
+	if err != nil {
+		return err
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	wasLeader := len(l.buckets) > 0
+	l.buckets[bkt.Name()] = true
+	if !wasLeader {
+		l.onPromote()
+	}
+	return nil
+}
+
+// Demote Implements The LeaderAware Interface (Invoking onDemote When No Longer Leader Of Any Bucket)
+func (l *leaderAwareReconciler) Demote(bkt reconciler.Bucket) {
+	l.leaderAware.Demote(bkt)
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	wasLeader := len(l.buckets) > 0
+	delete(l.buckets, bkt.Name())
+	if wasLeader && len(l.buckets) <= 0 {
+		l.onDemote()
+	}
+}
+
+// Determine Whether This Controller Instance Is Currently The Leader
+func (r *Reconciler) isLeader() bool {
+	return atomic.LoadInt32(&r.leader) == 1
+}
+
+// Start The Leader-Only Work When This Controller Instance Is Promoted To Leader
+func (r *Reconciler) promoted(ctx context.Context) {
+	r.logger.Info("Promoted To Leader - Reconciling KafkaChannels", zap.Bool("IsLeader", true))
+	atomic.StoreInt32(&r.leader, 1)
+	recordLeadership(ctx, true)
+
+	// Ensure The Kafka Topics Of All Existing KafkaChannels In Bulk Once The Informer Cache Has Synced (If Enabled)
+	if r.config != nil && r.config.Kafka.BootstrapTopics && r.kafkachannelInformer != nil {
+		go func() {
+			if cache.WaitForCacheSync(ctx.Done(), r.kafkachannelInformer.HasSynced) && r.isLeader() {
+				r.bootstrapKafkaTopics(ctx)
+			}
+		}()
+	}
+}
+
+// Stop The Leader-Only Work When This Controller Instance Is Demoted To Standby
+func (r *Reconciler) demoted(ctx context.Context) {
+	r.logger.Info("Demoted To Standby - No Longer Reconciling KafkaChannels", zap.Bool("IsLeader", false))
+	atomic.StoreInt32(&r.leader, 0)
+	recordLeadership(ctx, false)
+
+	// Close Any Pooled AdminClients So That Standby Instances Hold No Kafka Broker Connections
+	if r.adminClientPool != nil {
+		r.adminMutex.Lock()
+		defer r.adminMutex.Unlock()
+		_ = r.adminClientPool.Close()
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/stats"
+	"k8s.io/apimachinery/pkg/types"
+	logtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/reconciler"
+)
+
+// Test LeaderAware controller.Reconciler
+type testLeaderAwareReconciler struct {
+	reconciler.LeaderAwareFuncs
+}
+
+func (r *testLeaderAwareReconciler) Reconcile(_ context.Context, _ string) error {
+	return nil
+}
+
+// Test Bucket With The Specified Name
+type testBucket struct {
+	name string
+}
+
+func (b testBucket) Name() string                    { return b.name }
+func (b testBucket) Has(_ types.NamespacedName) bool { return true }
+
+// Test The leaderAwareReconciler Promotion / Demotion Functionality
+func TestLeaderAwareReconciler(t *testing.T) {
+
+	// Create A Leader-Aware Reconciler Counting The Promotions & Demotions
+	promotions := 0
+	demotions := 0
+	wrapped := &testLeaderAwareReconciler{}
+	r := newLeaderAwareReconciler(wrapped, func() { promotions++ }, func() { demotions++ })
+	leaderAware, ok := r.(reconciler.LeaderAware)
+	assert.True(t, ok)
+	enq := func(reconciler.Bucket, types.NamespacedName) {}
+
+	// Verify Only The First Promotion & Last Demotion Invoke The Callbacks
+	assert.Nil(t, leaderAware.Promote(testBucket{name: "bucket-1"}, enq))
+	assert.Nil(t, leaderAware.Promote(testBucket{name: "bucket-2"}, enq))
+	assert.Equal(t, 1, promotions)
+	assert.True(t, wrapped.IsLeaderFor(types.NamespacedName{Namespace: "namespace", Name: "name"}))
+	leaderAware.Demote(testBucket{name: "bucket-1"})
+	assert.Equal(t, 0, demotions)
+	leaderAware.Demote(testBucket{name: "bucket-2"})
+	assert.Equal(t, 1, demotions)
+	assert.False(t, wrapped.IsLeaderFor(types.NamespacedName{Namespace: "namespace", Name: "name"}))
+
+	// Verify Demoting An Unknown Bucket While Standby Does Not Invoke The Callback
+	leaderAware.Demote(testBucket{name: "bucket-3"})
+	assert.Equal(t, 1, demotions)
+}
+
+// Test The Reconciler's promoted() & demoted() Functionality
+func TestReconcilerPromotedDemoted(t *testing.T) {
+
+	// Replace The recordWrapper With One Capturing The Leadership Measurements (Restoring When Done)
+	leadership := make([]float64, 0)
+	recordWrapperRef := recordWrapper
+	defer func() { recordWrapper = recordWrapperRef }()
+	recordWrapper = func(ctx context.Context, measurement stats.Measurement, _ ...stats.Options) {
+		assert.Equal(t, controllerIsLeader.Name(), measurement.Measure().Name())
+		leadership = append(leadership, measurement.Value())
+	}
+
+	// Create A Reconciler (Initially Standby)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar()}
+	assert.False(t, r.isLeader())
+
+	// Perform The Test & Verify The Results
+	r.promoted(context.TODO())
+	assert.True(t, r.isLeader())
+	r.demoted(context.TODO())
+	assert.False(t, r.isLeader())
+	assert.Equal(t, []float64{1, 0}, leadership)
+}
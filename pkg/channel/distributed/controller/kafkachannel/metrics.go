@@ -0,0 +1,229 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/metrics"
+)
+
+//
+// KafkaChannel Reconciliation Phase Metrics
+//
+// Records the latency of each phase of the KafkaChannel reconciliation via the Knative / OpenCensus
+// metrics stack, tagged by phase and outcome, so that the dominant phases (e.g. Kafka Topic vs
+// Dispatcher Deployment) can be identified and correlated with the AdminClient metrics.  Recording
+// is non-blocking and does not extend the time for which the adminMutex is held.
+//
+// The outcome of each Kafka Topic operation performed while reconciling / finalizing a KafkaChannel is
+// additionally counted, tagged by operation and result, so that the rate at which Topics are created,
+// found to already exist, deleted or fail can be tracked over time (e.g. for SLO dashboards).
+//
+// Each KafkaChannel reconciliation is also counted, tagged by whether it was a full reconciliation (which
+// connects to Kafka) or was short-circuited, so that the reduction in Kafka AdminClient connections from
+// enabling the reconciliation short-circuit can be measured.
+//
+
+const (
+
+	// Metric Labels
+	LabelPhase     = "phase"
+	LabelOutcome   = "outcome"
+	LabelOperation = "operation"
+	LabelResult    = "result"
+	LabelMode      = "mode"
+
+	// Reconciliation Phases
+	ReconcilePhaseConfig           = "config"
+	ReconcilePhaseTopic            = "topic"
+	ReconcilePhaseChannel          = "channel"
+	ReconcilePhaseDispatcher       = "dispatcher"
+	ReconcilePhaseKafkaChannelMeta = "kafkachannel-meta"
+
+	// Reconciliation Phase Outcomes
+	ReconcileOutcomeSuccess = "success"
+	ReconcileOutcomeFailure = "failure"
+
+	// Kafka Topic Operations
+	TopicOperationCreate   = "create"
+	TopicOperationDelete   = "delete"
+	TopicOperationDescribe = "describe"
+	TopicOperationAlter    = "alter"
+
+	// Kafka Topic Operation Results
+	TopicResultSuccess  = "success"
+	TopicResultExists   = "exists"    // Create Of An Already Existing Topic
+	TopicResultNotFound = "not_found" // Delete Of A Topic Which Does Not Exist
+	TopicResultError    = "error"
+
+	// KafkaChannel Reconciliation Modes
+	ReconcileModeFull         = "full"          // Full Reconciliation Including The Kafka AdminClient & Topic
+	ReconcileModeShortCircuit = "short_circuit" // Lightweight Health Check Of An Unchanged & Healthy KafkaChannel
+	ReconcileModePaused       = "paused"        // Reconciliation Skipped While Globally Paused For Maintenance
+)
+
+var (
+	// Distribution Of KafkaChannel Reconciliation Phase Latencies
+	reconcilePhaseLatencyMs = stats.Float64(
+		"kafkachannel_reconcile_phase_latency", // The METRICS_DOMAIN will be prepended to the name.
+		"KafkaChannel Reconciliation Phase Latency",
+		stats.UnitMilliseconds,
+	)
+
+	// Whether This Controller Instance Is Currently The Leader (1) Or A Standby (0)
+	controllerIsLeader = stats.Int64(
+		"controller_is_leader", // The METRICS_DOMAIN will be prepended to the name.
+		"Whether The KafkaChannel Controller Instance Is The Leader",
+		stats.UnitDimensionless,
+	)
+
+	// Counter For The Number Of Kafka Topic Operations
+	topicOperationCount = stats.Int64(
+		"kafka_channel_topic_operations_total", // The METRICS_DOMAIN will be prepended to the name.
+		"KafkaChannel Kafka Topic Operation Count",
+		stats.UnitDimensionless,
+	)
+
+	// Counter For The Number Of KafkaChannel Reconciliations
+	reconcileCount = stats.Int64(
+		"kafka_channel_reconciles_total", // The METRICS_DOMAIN will be prepended to the name.
+		"KafkaChannel Reconciliation Count",
+		stats.UnitDimensionless,
+	)
+
+	// Tag Keys For The Reconciliation Phase, Topic Operation & Reconciliation Mode Metrics
+	phaseKey     = tag.MustNewKey(LabelPhase)
+	outcomeKey   = tag.MustNewKey(LabelOutcome)
+	operationKey = tag.MustNewKey(LabelOperation)
+	resultKey    = tag.MustNewKey(LabelResult)
+	modeKey      = tag.MustNewKey(LabelMode)
+)
+
+// Register the OpenCensus View Structures
+func init() {
+	err := view.Register(
+		&view.View{
+			Description: reconcilePhaseLatencyMs.Description(),
+			Measure:     reconcilePhaseLatencyMs,
+			Aggregation: view.Distribution(metrics.Buckets125(1, 100000)...), // 1, 2, 5, 10, ... 100000ms
+			TagKeys:     []tag.Key{phaseKey, outcomeKey},
+		},
+		&view.View{
+			Description: controllerIsLeader.Description(),
+			Measure:     controllerIsLeader,
+			Aggregation: view.LastValue(),
+		},
+		&view.View{
+			Description: topicOperationCount.Description(),
+			Measure:     topicOperationCount,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{operationKey, resultKey},
+		},
+		&view.View{
+			Description: reconcileCount.Description(),
+			Measure:     reconcileCount,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{modeKey},
+		},
+	)
+	if err != nil {
+		log.Printf("failed to register opencensus views, %v", err)
+	}
+}
+
+// Metrics Record Function Variable To Facilitate Unit Testing
+var recordWrapper = metrics.Record
+
+// Record The Latency & Outcome Of A Reconciliation Phase Which Started At The Specified Time
+func recordReconcilePhase(ctx context.Context, phase string, startTime time.Time, err error) {
+
+	// Determine The Outcome Of The Phase
+	outcome := ReconcileOutcomeSuccess
+	if err != nil {
+		outcome = ReconcileOutcomeFailure
+	}
+
+	// Create A New OpenCensus Tag / Context For The Phase & Outcome
+	tagCtx, tagErr := tag.New(ctx,
+		tag.Insert(phaseKey, phase),
+		tag.Insert(outcomeKey, outcome),
+	)
+	if tagErr != nil {
+		logging.FromContext(ctx).Desugar().Error("Failed To Create New OpenCensus Tags For Reconciliation Phase", zap.String("Phase", phase), zap.Error(tagErr))
+		return
+	}
+
+	// Record The Latency Metric
+	latencyMs := float64(time.Since(startTime)) / float64(time.Millisecond)
+	recordWrapper(tagCtx, reconcilePhaseLatencyMs.M(latencyMs))
+}
+
+// Record Whether This Controller Instance Is Currently The Leader
+func recordLeadership(ctx context.Context, isLeader bool) {
+	var value int64
+	if isLeader {
+		value = 1
+	}
+	recordWrapper(ctx, controllerIsLeader.M(value))
+}
+
+// Record The Result Of A Kafka Topic Operation
+func recordTopicOperation(ctx context.Context, operation string, result string) {
+
+	// Create A New OpenCensus Tag / Context For The Operation & Result
+	tagCtx, tagErr := tag.New(ctx,
+		tag.Insert(operationKey, operation),
+		tag.Insert(resultKey, result),
+	)
+	if tagErr != nil {
+		logging.FromContext(ctx).Desugar().Error("Failed To Create New OpenCensus Tags For Topic Operation", zap.String("Operation", operation), zap.Error(tagErr))
+		return
+	}
+
+	// Record The Count Metric
+	recordWrapper(tagCtx, topicOperationCount.M(1))
+}
+
+// Record A KafkaChannel Reconciliation Of The Specified Mode (Full / Short-Circuit)
+func recordReconcileMode(ctx context.Context, mode string) {
+
+	// Create A New OpenCensus Tag / Context For The Mode
+	tagCtx, tagErr := tag.New(ctx, tag.Insert(modeKey, mode))
+	if tagErr != nil {
+		logging.FromContext(ctx).Desugar().Error("Failed To Create New OpenCensus Tags For Reconciliation Mode", zap.String("Mode", mode), zap.Error(tagErr))
+		return
+	}
+
+	// Record The Count Metric
+	recordWrapper(tagCtx, reconcileCount.M(1))
+}
+
+// Get The Topic Operation Result Corresponding To The Specified Error
+func topicOperationResult(err error) string {
+	if err != nil {
+		return TopicResultError
+	}
+	return TopicResultSuccess
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+)
+
+// Test The recordReconcilePhase() Functionality
+func TestRecordReconcilePhase(t *testing.T) {
+
+	// Replace The recordWrapper With One Capturing The Measurements (Restoring When Done)
+	type recordedMeasurement struct {
+		name    string
+		phase   string
+		outcome string
+	}
+	measurements := make([]recordedMeasurement, 0)
+	recordWrapperRef := recordWrapper
+	defer func() { recordWrapper = recordWrapperRef }()
+	recordWrapper = func(ctx context.Context, measurement stats.Measurement, _ ...stats.Options) {
+		tagMap := tag.FromContext(ctx)
+		assert.NotNil(t, tagMap)
+		phase, _ := tagMap.Value(phaseKey)
+		outcome, _ := tagMap.Value(outcomeKey)
+		assert.GreaterOrEqual(t, measurement.Value(), float64(0))
+		measurements = append(measurements, recordedMeasurement{name: measurement.Measure().Name(), phase: phase, outcome: outcome})
+	}
+
+	// Perform The Test
+	recordReconcilePhase(context.TODO(), ReconcilePhaseTopic, time.Now(), nil)
+	recordReconcilePhase(context.TODO(), ReconcilePhaseDispatcher, time.Now(), errors.New("test error"))
+
+	// Verify The Results
+	assert.Equal(t, []recordedMeasurement{
+		{name: reconcilePhaseLatencyMs.Name(), phase: ReconcilePhaseTopic, outcome: ReconcileOutcomeSuccess},
+		{name: reconcilePhaseLatencyMs.Name(), phase: ReconcilePhaseDispatcher, outcome: ReconcileOutcomeFailure},
+	}, measurements)
+}
+
+// Test The recordTopicOperation() Functionality
+func TestRecordTopicOperation(t *testing.T) {
+
+	// Replace The recordWrapper With One Capturing The Measurements (Restoring When Done)
+	type recordedMeasurement struct {
+		name      string
+		operation string
+		result    string
+		value     float64
+	}
+	measurements := make([]recordedMeasurement, 0)
+	recordWrapperRef := recordWrapper
+	defer func() { recordWrapper = recordWrapperRef }()
+	recordWrapper = func(ctx context.Context, measurement stats.Measurement, _ ...stats.Options) {
+		tagMap := tag.FromContext(ctx)
+		assert.NotNil(t, tagMap)
+		operation, _ := tagMap.Value(operationKey)
+		result, _ := tagMap.Value(resultKey)
+		measurements = append(measurements, recordedMeasurement{name: measurement.Measure().Name(), operation: operation, result: result, value: measurement.Value()})
+	}
+
+	// Perform The Test
+	recordTopicOperation(context.TODO(), TopicOperationCreate, topicOperationResult(nil))
+	recordTopicOperation(context.TODO(), TopicOperationCreate, TopicResultExists)
+	recordTopicOperation(context.TODO(), TopicOperationDelete, topicOperationResult(errors.New("test error")))
+
+	// Verify The Results
+	assert.Equal(t, []recordedMeasurement{
+		{name: topicOperationCount.Name(), operation: TopicOperationCreate, result: TopicResultSuccess, value: 1},
+		{name: topicOperationCount.Name(), operation: TopicOperationCreate, result: TopicResultExists, value: 1},
+		{name: topicOperationCount.Name(), operation: TopicOperationDelete, result: TopicResultError, value: 1},
+	}, measurements)
+}
+
+// Test The recordReconcileMode() Functionality
+func TestRecordReconcileMode(t *testing.T) {
+
+	// Replace The recordWrapper With One Capturing The Measurements (Restoring When Done)
+	type recordedMeasurement struct {
+		name  string
+		mode  string
+		value float64
+	}
+	measurements := make([]recordedMeasurement, 0)
+	recordWrapperRef := recordWrapper
+	defer func() { recordWrapper = recordWrapperRef }()
+	recordWrapper = func(ctx context.Context, measurement stats.Measurement, _ ...stats.Options) {
+		tagMap := tag.FromContext(ctx)
+		assert.NotNil(t, tagMap)
+		mode, _ := tagMap.Value(modeKey)
+		measurements = append(measurements, recordedMeasurement{name: measurement.Measure().Name(), mode: mode, value: measurement.Value()})
+	}
+
+	// Perform The Test
+	recordReconcileMode(context.TODO(), ReconcileModeFull)
+	recordReconcileMode(context.TODO(), ReconcileModeShortCircuit)
+
+	// Verify The Results
+	assert.Equal(t, []recordedMeasurement{
+		{name: reconcileCount.Name(), mode: ReconcileModeFull, value: 1},
+		{name: reconcileCount.Name(), mode: ReconcileModeShortCircuit, value: 1},
+	}, measurements)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	consolidatedresources "knative.dev/eventing-kafka/pkg/channel/consolidated/reconciler/controller/resources"
+	consolidatedutils "knative.dev/eventing-kafka/pkg/channel/consolidated/utils"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	"knative.dev/pkg/controller"
+)
+
+//
+// Consolidated KafkaChannel Migration
+//
+// KafkaChannels previously reconciled by the "consolidated" implementation are identified by their
+// KafkaChannel Service, which points to the consolidated dispatcher instead of a distributed Receiver.
+// Such KafkaChannels are refused (to avoid double-management) unless they carry the MigrateFromConsolidated
+// annotation, in which case the existing Kafka Topic is re-used and the KafkaChannel Service is taken over.
+// Every step is idempotent so that a failed migration is simply resumed by the next reconciliation.
+//
+
+// Reconcile The Migration Of A KafkaChannel Previously Managed By The "Consolidated" Implementation
+func (r *Reconciler) reconcileConsolidatedMigration(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
+
+	// Get Channel Specific Logger
+	logger := util.ReconcileLogger(ctx, channel)
+
+	// Get The Existing KafkaChannel Service (Nothing To Migrate If It Does Not Exist Yet)
+	service, err := r.getKafkaChannelService(channel)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		logger.Error("Failed To Get KafkaChannel Service", zap.Error(err))
+		return err
+	}
+
+	// Nothing To Migrate Unless The KafkaChannel Service Belongs To The Consolidated Implementation
+	if !isConsolidatedChannelService(service) {
+		return nil
+	}
+
+	// Refuse To Double-Manage The KafkaChannel Unless Migration Was Explicitly Requested
+	if !util.MigrateFromConsolidated(channel, logger) {
+		logger.Warn("KafkaChannel Is Managed By The Consolidated Implementation - Skipping Reconciliation")
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaChannelMigrationFailed.String(), "KafkaChannel Is Managed By The Consolidated Implementation - Add The %s Annotation To Migrate", constants.MigrateFromConsolidatedAnnotation)
+		channel.Status.MarkChannelServiceFailed("ChannelManagedByConsolidated", "KafkaChannel Is Managed By The Consolidated Implementation (Annotate With %s To Migrate)", constants.MigrateFromConsolidatedAnnotation)
+		return fmt.Errorf("kafkachannel %s/%s is managed by the consolidated implementation", channel.Namespace, channel.Name)
+	}
+
+	// Verify The Configured Topic Name Re-Uses The Consolidated Kafka Topic
+	topicName := util.TopicName(channel)
+	consolidatedTopicName := consolidatedutils.TopicName(consolidatedutils.KafkaChannelSeparator, channel.Namespace, channel.Name)
+	if topicName != consolidatedTopicName {
+		logger.Error("Kafka Topic Name Does Not Match Consolidated Kafka Topic - Unable To Migrate", zap.String("TopicName", topicName), zap.String("ConsolidatedTopicName", consolidatedTopicName))
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaChannelMigrationFailed.String(), "Kafka Topic %s Does Not Match Consolidated Kafka Topic %s - Configure The Kafka TopicNameTemplate To Migrate", topicName, consolidatedTopicName)
+		channel.Status.MarkTopicFailed("MigrationTopicMismatch", "Kafka Topic %s Does Not Match Consolidated Kafka Topic %s", topicName, consolidatedTopicName)
+		return fmt.Errorf("kafka topic %s does not match consolidated kafka topic %s", topicName, consolidatedTopicName)
+	}
+
+	// Verifying The Consolidated Kafka Topic Requires A Kafka AdminClient
+	if r.adminClient == nil {
+		logger.Error("Failed To Verify Consolidated Kafka Topic - No Kafka AdminClient")
+		channel.Status.MarkTopicFailed("MigrationTopicFailed", "Failed To Verify Consolidated Kafka Topic %s: %s", topicName, constants.KafkaAdminClientUnavailableError)
+		return fmt.Errorf(constants.KafkaAdminClientUnavailableError)
+	}
+
+	// Verify The Consolidated Kafka Topic Exists (AdminClients Which Cannot Describe Topics Return nil Metadata)
+	_, topicError := r.adminClient.DescribeTopic(ctx, topicName)
+	if err := adminutil.WrapTopicError(topicError); err != nil {
+		logger.Error("Failed To Verify Consolidated Kafka Topic - Unable To Migrate", zap.String("TopicName", topicName), zap.Any("TopicError", topicError))
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaChannelMigrationFailed.String(), "Failed To Verify Consolidated Kafka Topic %s: %v", topicName, topicError)
+		channel.Status.MarkTopicFailed("MigrationTopicFailed", "Failed To Verify Consolidated Kafka Topic %s: %v", topicName, topicError)
+		return err
+	}
+
+	// Reset The Conditions Describing The Consolidated Dispatcher Until The Distributed Dispatcher Is Reconciled
+	channel.Status.MarkDispatcherUnknown("MigratingFromConsolidated", "Migrating KafkaChannel From The Consolidated Implementation")
+	channel.Status.MarkServiceUnknown("MigratingFromConsolidated", "Migrating KafkaChannel From The Consolidated Implementation")
+
+	// Take Over The KafkaChannel Service (Re-Pointed From The Consolidated Dispatcher To The Distributed Receiver)
+	desiredService := r.newKafkaChannelService(channel)
+	updatedService := service.DeepCopy()
+	updatedService.Labels = desiredService.Labels
+	updatedService.OwnerReferences = desiredService.OwnerReferences
+	updatedService.Spec = desiredService.Spec
+	_, err = r.kubeClientset.CoreV1().Services(updatedService.Namespace).Update(ctx, updatedService, metav1.UpdateOptions{})
+	if err != nil {
+		logger.Error("Failed To Take Over Consolidated KafkaChannel Service", zap.Error(err))
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaChannelMigrationFailed.String(), "Failed To Take Over Consolidated KafkaChannel Service: %v", err)
+		channel.Status.MarkChannelServiceFailed(event.KafkaChannelMigrationFailed.String(), "Failed To Take Over Consolidated KafkaChannel Service: %v", err)
+		return err
+	}
+
+	// Return Success
+	logger.Info("Successfully Migrated KafkaChannel From The Consolidated Implementation", zap.String("TopicName", topicName))
+	controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeNormal, event.KafkaChannelMigrated.String(), "Migrated KafkaChannel From The Consolidated Implementation (Kafka Topic: %s)", topicName)
+	return nil
+}
+
+// Determine Whether The Specified KafkaChannel Service Was Created By The "Consolidated" Implementation
+func isConsolidatedChannelService(service *corev1.Service) bool {
+	return service.Labels[consolidatedresources.MessagingRoleLabel] == consolidatedresources.MessagingRole &&
+		len(service.Labels[constants.KafkaChannelReceiverLabel]) == 0
+}
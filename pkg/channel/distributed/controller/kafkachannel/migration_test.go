@@ -0,0 +1,207 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The Migration Of KafkaChannels Previously Managed By The "Consolidated" Implementation
+func TestReconcileConsolidatedMigration(t *testing.T) {
+
+	// Test Data
+	consolidatedTopicNameTemplate := "knative-messaging-kafka.{{.Namespace}}.{{.Name}}"
+	errMsg := controllertesting.ErrorString
+	unknownTopicError := &sarama.TopicError{Err: sarama.ErrUnknownTopicOrPartition, ErrMsg: &errMsg}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only              bool
+		name              string
+		service           *corev1.Service
+		annotation        string
+		topicNameTemplate string
+		describeError     *sarama.TopicError
+		wantErr           bool
+		wantMigrated      bool
+		wantCondition     apis.ConditionType
+		wantReason        string
+		wantEvent         string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name: "No KafkaChannel Service",
+		},
+		{
+			name:    "Distributed KafkaChannel Service",
+			service: controllertesting.NewKafkaChannelService(),
+		},
+		{
+			name:          "Consolidated KafkaChannel Service Without Annotation",
+			service:       controllertesting.NewKafkaChannelService(controllertesting.WithConsolidatedChannelService),
+			wantErr:       true,
+			wantCondition: kafkav1beta1.KafkaChannelConditionChannelServiceReady,
+			wantReason:    "ChannelManagedByConsolidated",
+			wantEvent:     "KafkaChannel Is Managed By The Consolidated Implementation",
+		},
+		{
+			name:          "Consolidated KafkaChannel Service With Invalid Annotation",
+			service:       controllertesting.NewKafkaChannelService(controllertesting.WithConsolidatedChannelService),
+			annotation:    "invalid",
+			wantErr:       true,
+			wantCondition: kafkav1beta1.KafkaChannelConditionChannelServiceReady,
+			wantReason:    "ChannelManagedByConsolidated",
+			wantEvent:     "KafkaChannel Is Managed By The Consolidated Implementation",
+		},
+		{
+			name:          "Consolidated KafkaChannel Service With Mismatched Topic Name",
+			service:       controllertesting.NewKafkaChannelService(controllertesting.WithConsolidatedChannelService),
+			annotation:    "true",
+			wantErr:       true,
+			wantCondition: kafkav1beta1.KafkaChannelConditionTopicReady,
+			wantReason:    "MigrationTopicMismatch",
+			wantEvent:     "Does Not Match Consolidated Kafka Topic",
+		},
+		{
+			name:              "Consolidated KafkaChannel Service With Missing Topic",
+			service:           controllertesting.NewKafkaChannelService(controllertesting.WithConsolidatedChannelService),
+			annotation:        "true",
+			topicNameTemplate: consolidatedTopicNameTemplate,
+			describeError:     unknownTopicError,
+			wantErr:           true,
+			wantCondition:     kafkav1beta1.KafkaChannelConditionTopicReady,
+			wantReason:        "MigrationTopicFailed",
+			wantEvent:         "Failed To Verify Consolidated Kafka Topic",
+		},
+		{
+			name:              "Consolidated KafkaChannel Service Migrated",
+			service:           controllertesting.NewKafkaChannelService(controllertesting.WithConsolidatedChannelService),
+			annotation:        "true",
+			topicNameTemplate: consolidatedTopicNameTemplate,
+			wantMigrated:      true,
+			wantCondition:     kafkav1beta1.KafkaChannelConditionDispatcherReady,
+			wantReason:        "MigratingFromConsolidated",
+			wantEvent:         "Migrated KafkaChannel From The Consolidated Implementation (Kafka Topic: knative-messaging-kafka.",
+		},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Set The TestCase's Kafka Topic Name Template (Restoring The Default Afterwards)
+			assert.Nil(t, commonkafkautil.SetTopicNameTemplate(testCase.topicNameTemplate))
+			defer func() { assert.Nil(t, commonkafkautil.SetTopicNameTemplate(commonkafkautil.DefaultTopicNameTemplate)) }()
+
+			// Create A KafkaChannel With The TestCase's MigrateFromConsolidated Annotation
+			channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+			if len(testCase.annotation) > 0 {
+				channel.Annotations = map[string]string{constants.MigrateFromConsolidatedAnnotation: testCase.annotation}
+			}
+
+			// Create A Mock Kafka AdminClient Returning The TestCase's Describe Error
+			mockAdminClient := &controllertesting.MockAdminClient{
+				MockDescribeTopicFunc: func(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+					assert.Equal(t, "knative-messaging-kafka."+controllertesting.KafkaChannelNamespace+"."+controllertesting.KafkaChannelName, topicName)
+					return nil, testCase.describeError
+				},
+			}
+
+			// Initialize The Reconciler With The TestCase's KafkaChannel Service
+			var objects []runtime.Object
+			if testCase.service != nil {
+				objects = append(objects, testCase.service)
+			}
+			listers := controllertesting.NewListers(objects)
+			kubeClientset := fake.NewSimpleClientset(objects...)
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			r := &Reconciler{
+				logger:        logtesting.TestLogger(t).Desugar(),
+				kubeClientset: kubeClientset,
+				adminClient:   mockAdminClient,
+				serviceLister: listers.GetServiceLister(),
+			}
+
+			// Perform The Test
+			err := r.reconcileConsolidatedMigration(ctx, channel)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			if len(testCase.wantCondition) > 0 {
+				condition := channel.Status.GetCondition(testCase.wantCondition)
+				assert.NotNil(t, condition)
+				assert.Equal(t, testCase.wantReason, condition.Reason)
+			}
+			if len(testCase.wantEvent) > 0 {
+				assert.Contains(t, <-recorder.Events, testCase.wantEvent)
+			} else {
+				assert.Len(t, recorder.Events, 0)
+			}
+
+			// Verify The KafkaChannel Service Was Only Taken Over When Migrated
+			if testCase.service != nil {
+				service, err := kubeClientset.CoreV1().Services(testCase.service.Namespace).Get(ctx, testCase.service.Name, metav1.GetOptions{})
+				assert.Nil(t, err)
+				if testCase.wantMigrated {
+					expectedService := controllertesting.NewKafkaChannelService()
+					assert.Equal(t, expectedService.Labels, service.Labels)
+					assert.Equal(t, expectedService.OwnerReferences, service.OwnerReferences)
+					assert.Equal(t, expectedService.Spec, service.Spec)
+				} else {
+					assert.Equal(t, testCase.service.Spec, service.Spec)
+				}
+			}
+		})
+	}
+}
+
+// Test The Identification Of KafkaChannel Services Created By The "Consolidated" Implementation
+func TestIsConsolidatedChannelService(t *testing.T) {
+	assert.False(t, isConsolidatedChannelService(controllertesting.NewKafkaChannelService()))
+	assert.True(t, isConsolidatedChannelService(controllertesting.NewKafkaChannelService(controllertesting.WithConsolidatedChannelService)))
+	assert.False(t, isConsolidatedChannelService(&corev1.Service{}))
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package kafkachannel
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+)
+
+//
+// Dispatcher PodDisruptionBudget (Optional)
+//
+// When enabled in the config-eventing-kafka ConfigMap, a PodDisruptionBudget is maintained alongside each
+// Dispatcher Deployment so that voluntary disruptions (e.g. node drains during cluster upgrades) cannot
+// evict all of a KafkaChannel's Dispatcher replicas simultaneously.  A single replica Dispatcher is never
+// given a PodDisruptionBudget, as it would block such drains entirely.  As with the Deployment, K8S does
+// NOT support cross-namespace OwnerReferences so the PodDisruptionBudget is deleted during finalization.
+//
+
+// Reconcile The Dispatcher PodDisruptionBudget
+func (r *Reconciler) reconcileDispatcherPodDisruptionBudget(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel) error {
+
+	// Remove Any Existing PodDisruptionBudget If Disabled, Or If There Is Only A Single Dispatcher Replica
+	if !r.dispatcherPodDisruptionBudgetEnabled(channel) {
+		return r.finalizeDispatcherPodDisruptionBudget(ctx, logger, channel)
+	}
+
+	// Create The Desired PodDisruptionBudget Model
+	desiredPodDisruptionBudget := r.newDispatcherPodDisruptionBudget(channel)
+	podDisruptionBudgets := r.kubeClientset.PolicyV1beta1().PodDisruptionBudgets(desiredPodDisruptionBudget.Namespace)
+
+	// Attempt To Get The Dispatcher PodDisruptionBudget Associated With The Specified Channel
+	podDisruptionBudget, err := podDisruptionBudgets.Get(ctx, desiredPodDisruptionBudget.Name, metav1.GetOptions{})
+	if podDisruptionBudget == nil || err != nil {
+
+		// If The PodDisruptionBudget Was Not Found - Then Create A New One For The Channel
+		if errors.IsNotFound(err) {
+			logger.Info("Dispatcher PodDisruptionBudget Not Found - Creating New One")
+			_, err = podDisruptionBudgets.Create(ctx, desiredPodDisruptionBudget, metav1.CreateOptions{})
+			if err != nil {
+				logger.Error("Failed To Create Dispatcher PodDisruptionBudget", zap.Error(err))
+				return err
+			} else {
+				logger.Info("Successfully Created Dispatcher PodDisruptionBudget")
+				return nil
+			}
+		} else {
+			logger.Error("Failed To Get Dispatcher PodDisruptionBudget For Reconciliation", zap.Error(err))
+			return err
+		}
+	}
+
+	// Nothing To Do If The Existing PodDisruptionBudget Is Already As Desired
+	if equality.Semantic.DeepEqual(podDisruptionBudget.Spec, desiredPodDisruptionBudget.Spec) &&
+		equality.Semantic.DeepEqual(podDisruptionBudget.Labels, desiredPodDisruptionBudget.Labels) {
+		logger.Info("Successfully Verified Dispatcher PodDisruptionBudget")
+		return nil
+	}
+
+	// Otherwise Update The Existing PodDisruptionBudget (MinAvailable Config Changed)
+	podDisruptionBudget = podDisruptionBudget.DeepCopy()
+	podDisruptionBudget.Labels = desiredPodDisruptionBudget.Labels
+	podDisruptionBudget.Spec = desiredPodDisruptionBudget.Spec
+	_, err = podDisruptionBudgets.Update(ctx, podDisruptionBudget, metav1.UpdateOptions{})
+	if err != nil {
+		logger.Error("Failed To Update Dispatcher PodDisruptionBudget", zap.Error(err))
+		return err
+	} else {
+		logger.Info("Successfully Updated Dispatcher PodDisruptionBudget")
+		return nil
+	}
+}
+
+// Finalize The Dispatcher PodDisruptionBudget (Delete If Present)
+func (r *Reconciler) finalizeDispatcherPodDisruptionBudget(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel) error {
+
+	// Attempt To Get The Dispatcher PodDisruptionBudget Associated With The Specified Channel
+	podDisruptionBudgetName := util.DispatcherDnsSafeName(channel)
+	podDisruptionBudgets := r.kubeClientset.PolicyV1beta1().PodDisruptionBudgets(commonconstants.KnativeEventingNamespace)
+	_, err := podDisruptionBudgets.Get(ctx, podDisruptionBudgetName, metav1.GetOptions{})
+	if err != nil {
+
+		// If The PodDisruptionBudget Was Not Found - Then Nothing To Do
+		if errors.IsNotFound(err) {
+			logger.Debug("Dispatcher PodDisruptionBudget Not Found - Nothing To Finalize")
+			return nil
+		} else {
+			logger.Error("Failed To Get Dispatcher PodDisruptionBudget For Finalization", zap.Error(err))
+			return err
+		}
+	}
+
+	// Delete The Dispatcher PodDisruptionBudget (Tolerating A Concurrent Deletion)
+	err = podDisruptionBudgets.Delete(ctx, podDisruptionBudgetName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error("Failed To Delete Dispatcher PodDisruptionBudget", zap.Error(err))
+		return err
+	}
+
+	// Return Success
+	logger.Info("Successfully Deleted Dispatcher PodDisruptionBudget")
+	return nil
+}
+
+// Create Dispatcher PodDisruptionBudget Model For The Specified Channel
+func (r *Reconciler) newDispatcherPodDisruptionBudget(channel *kafkav1beta1.KafkaChannel) *policyv1beta1.PodDisruptionBudget {
+
+	// Get The Dispatcher Deployment Name For The Channel
+	deploymentName := util.DispatcherDnsSafeName(channel)
+
+	// MinAvailable Value For De-Referencing
+	minAvailable := r.dispatcherPodDisruptionBudgetMinAvailable()
+
+	// Create & Return The Dispatcher's PodDisruptionBudget
+	return &policyv1beta1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: policyv1beta1.SchemeGroupVersion.String(),
+			Kind:       constants.PodDisruptionBudgetKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploymentName,
+			Namespace: commonconstants.KnativeEventingNamespace,
+			Labels: map[string]string{
+				constants.KafkaChannelDispatcherLabel: "true",            // Identifies the PodDisruptionBudget as being a KafkaChannel "Dispatcher"
+				constants.KafkaChannelNameLabel:       channel.Name,      // Identifies the PodDisruptionBudget's Owning KafkaChannel's Name
+				constants.KafkaChannelNamespaceLabel:  channel.Namespace, // Identifies the PodDisruptionBudget's Owning KafkaChannel's Namespace
+			},
+		},
+		Spec: policyv1beta1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					constants.AppLabel: deploymentName, // Matches Dispatcher Deployment Template ObjectMeta Pods
+				},
+			},
+		},
+	}
+}
+
+// Determine Whether The Dispatcher PodDisruptionBudget Feature Is Enabled In Config (And Applicable To The Channel's Replica Count)
+func (r *Reconciler) dispatcherPodDisruptionBudgetEnabled(channel *kafkav1beta1.KafkaChannel) bool {
+	if r.config == nil || !r.config.Dispatcher.PodDisruptionBudget.Enabled {
+		return false
+	}
+	replicas, _, _ := r.dispatcherReplicas(channel)
+	return replicas > 1
+}
+
+// Get The Dispatcher's PodDisruptionBudget MinAvailable From Config Or Default
+func (r *Reconciler) dispatcherPodDisruptionBudgetMinAvailable() intstr.IntOrString {
+	if r.config != nil && r.config.Dispatcher.PodDisruptionBudget.MinAvailable != nil {
+		return *r.config.Dispatcher.PodDisruptionBudget.MinAvailable
+	}
+	return intstr.FromInt(constants.DispatcherPodDisruptionBudgetMinAvailable)
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package kafkachannel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The Dispatcher PodDisruptionBudget Reconciliation
+func TestReconcileDispatcherPodDisruptionBudget(t *testing.T) {
+
+	// Test Data
+	channel := controllertesting.NewKafkaChannel()
+	minAvailable := intstr.FromString("50%")
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only             bool
+		name             string
+		enabled          bool
+		replicas         int
+		minAvailable     *intstr.IntOrString
+		existing         []runtime.Object
+		wantExists       bool
+		wantMinAvailable intstr.IntOrString
+		wantVerbs        []string
+		wantErr          bool
+		failingVerb      string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:      "Disabled - None Existing",
+			replicas:  3,
+			wantVerbs: []string{"get"},
+		},
+		{
+			name:      "Disabled - Existing Deleted",
+			replicas:  3,
+			existing:  []runtime.Object{newTestPodDisruptionBudget(channel, intstr.FromInt(1))},
+			wantVerbs: []string{"get", "delete"},
+		},
+		{
+			name:      "Enabled - Single Replica - Existing Deleted",
+			enabled:   true,
+			replicas:  1,
+			existing:  []runtime.Object{newTestPodDisruptionBudget(channel, intstr.FromInt(1))},
+			wantVerbs: []string{"get", "delete"},
+		},
+		{
+			name:             "Enabled - Created With Default MinAvailable",
+			enabled:          true,
+			replicas:         3,
+			wantExists:       true,
+			wantMinAvailable: intstr.FromInt(constants.DispatcherPodDisruptionBudgetMinAvailable),
+			wantVerbs:        []string{"get", "create"},
+		},
+		{
+			name:             "Enabled - Unchanged",
+			enabled:          true,
+			replicas:         3,
+			minAvailable:     &minAvailable,
+			existing:         []runtime.Object{newTestPodDisruptionBudget(channel, minAvailable)},
+			wantExists:       true,
+			wantMinAvailable: minAvailable,
+			wantVerbs:        []string{"get"},
+		},
+		{
+			name:             "Enabled - Updated",
+			enabled:          true,
+			replicas:         3,
+			minAvailable:     &minAvailable,
+			existing:         []runtime.Object{newTestPodDisruptionBudget(channel, intstr.FromInt(1))},
+			wantExists:       true,
+			wantMinAvailable: minAvailable,
+			wantVerbs:        []string{"get", "update"},
+		},
+		{
+			name:        "Enabled - Create Error",
+			enabled:     true,
+			replicas:    3,
+			wantVerbs:   []string{"get", "create"},
+			wantErr:     true,
+			failingVerb: "create",
+		},
+		{
+			name:             "Disabled - Delete Error",
+			replicas:         3,
+			existing:         []runtime.Object{newTestPodDisruptionBudget(channel, intstr.FromInt(1))},
+			wantExists:       true,
+			wantMinAvailable: intstr.FromInt(1),
+			wantVerbs:        []string{"get", "delete"},
+			wantErr:          true,
+			failingVerb:      "delete",
+		},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Fake K8S Client With The Existing PodDisruptionBudgets
+			kubeClientset := fake.NewSimpleClientset(testCase.existing...)
+			if len(testCase.failingVerb) > 0 {
+				kubeClientset.PrependReactor(testCase.failingVerb, "poddisruptionbudgets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New(controllertesting.ErrorString)
+				})
+			}
+
+			// Initialize The Reconciler
+			r := &Reconciler{
+				logger:        logtesting.TestLogger(t).Desugar(),
+				kubeClientset: kubeClientset,
+				config:        controllertesting.NewConfig(),
+			}
+			r.config.Dispatcher.Replicas = testCase.replicas
+			r.config.Dispatcher.PodDisruptionBudget.Enabled = testCase.enabled
+			r.config.Dispatcher.PodDisruptionBudget.MinAvailable = testCase.minAvailable
+
+			// Perform The Test
+			err := r.reconcileDispatcherPodDisruptionBudget(context.TODO(), r.logger, channel)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			verbs := make([]string, 0)
+			for _, action := range kubeClientset.Actions() {
+				verbs = append(verbs, action.GetVerb())
+			}
+			assert.Equal(t, testCase.wantVerbs, verbs)
+			podDisruptionBudget, err := kubeClientset.PolicyV1beta1().PodDisruptionBudgets(commonconstants.KnativeEventingNamespace).Get(context.TODO(), util.DispatcherDnsSafeName(channel), metav1.GetOptions{})
+			if testCase.wantExists {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.wantMinAvailable, *podDisruptionBudget.Spec.MinAvailable)
+			} else {
+				assert.True(t, apierrors.IsNotFound(err))
+			}
+		})
+	}
+}
+
+// Test The Dispatcher PodDisruptionBudget Finalization
+func TestFinalizeDispatcherPodDisruptionBudget(t *testing.T) {
+
+	// Test Data
+	channel := controllertesting.NewKafkaChannel()
+	kubeClientset := fake.NewSimpleClientset(newTestPodDisruptionBudget(channel, intstr.FromInt(1)))
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), kubeClientset: kubeClientset}
+
+	// Verify The PodDisruptionBudget Is Deleted (And A Subsequent Finalization Is A No-Op)
+	assert.Nil(t, r.finalizeDispatcherPodDisruptionBudget(context.TODO(), r.logger, channel))
+	_, err := kubeClientset.PolicyV1beta1().PodDisruptionBudgets(commonconstants.KnativeEventingNamespace).Get(context.TODO(), util.DispatcherDnsSafeName(channel), metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	assert.Nil(t, r.finalizeDispatcherPodDisruptionBudget(context.TODO(), r.logger, channel))
+}
+
+// Test The Dispatcher PodDisruptionBudget Model
+func TestNewDispatcherPodDisruptionBudget(t *testing.T) {
+	channel := controllertesting.NewKafkaChannel()
+	r := &Reconciler{config: controllertesting.NewConfig()}
+	podDisruptionBudget := r.newDispatcherPodDisruptionBudget(channel)
+	assert.Equal(t, util.DispatcherDnsSafeName(channel), podDisruptionBudget.Name)
+	assert.Equal(t, commonconstants.KnativeEventingNamespace, podDisruptionBudget.Namespace)
+	assert.Equal(t, channel.Name, podDisruptionBudget.Labels[constants.KafkaChannelNameLabel])
+	assert.Equal(t, channel.Namespace, podDisruptionBudget.Labels[constants.KafkaChannelNamespaceLabel])
+	assert.Equal(t, map[string]string{constants.AppLabel: util.DispatcherDnsSafeName(channel)}, podDisruptionBudget.Spec.Selector.MatchLabels)
+	assert.Equal(t, intstr.FromInt(constants.DispatcherPodDisruptionBudgetMinAvailable), *podDisruptionBudget.Spec.MinAvailable)
+}
+
+// Utility Function For Creating A Test Dispatcher PodDisruptionBudget With The Specified MinAvailable
+func newTestPodDisruptionBudget(channel *kafkav1beta1.KafkaChannel, minAvailable intstr.IntOrString) *policyv1beta1.PodDisruptionBudget {
+	r := &Reconciler{config: controllertesting.NewConfig()}
+	r.config.Dispatcher.PodDisruptionBudget.MinAvailable = &minAvailable
+	return r.newDispatcherPodDisruptionBudget(channel)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/pkg/controller"
+)
+
+//
+// Global KafkaChannel Reconciliation Pause (Maintenance)
+//
+// Setting the "reconcile-paused" key of the eventing-kafka ConfigMap's data to "true" pauses the reconciliation
+// of all KafkaChannels without scaling down the controller.  The "kafka.eventing.knative.dev/reconcile-paused"
+// annotation on the ConfigMap is supported as a fallback, but the data key takes precedence when present.  While paused no Kafka AdminClients
+// are created (any pooled AdminClients are closed) and no Kafka Topics are bootstrapped.  Each KafkaChannel is
+// instead requeued after a long delay, and its finalization fails without side effects so that the finalizer
+// is retained (and the finalization performed in full) once resumed.  Removing the setting (or setting it to
+// false) resumes normal operation, immediately re-enqueueing all KafkaChannels.
+//
+
+// Whether The Reconciliation Of All KafkaChannels Is Paused
+func (r *Reconciler) isReconcilePaused() bool {
+	return atomic.LoadInt32(&r.reconcilePaused) == 1
+}
+
+// Update The Global Reconciliation Pause From The Eventing-Kafka ConfigMap's Data (Or Annotation)
+func (r *Reconciler) updateReconcilePaused(configMap *corev1.ConfigMap) {
+
+	// Parse The Data Key, Falling Back To The Annotation (Malformed Values Do Not Pause Reconciliation)
+	paused := false
+	if value, ok := reconcilePausedValue(configMap); ok {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			r.logger.Warn("Invalid Reconcile Paused Setting - Ignoring", zap.String("Value", value), zap.Error(err))
+		}
+		paused = parsed
+	}
+
+	// Handle Any Transition Between Paused & Resumed
+	if paused {
+		if atomic.SwapInt32(&r.reconcilePaused, 1) == 0 {
+			r.logger.Warn("KafkaChannel Reconciliation Paused For Maintenance")
+			if r.adminClientPool != nil {
+				r.adminMutex.Lock()
+				defer r.adminMutex.Unlock()
+				_ = r.adminClientPool.Close()
+			}
+		}
+	} else if atomic.SwapInt32(&r.reconcilePaused, 0) == 1 {
+		r.logger.Info("KafkaChannel Reconciliation Resumed")
+		if r.resyncChannels != nil {
+			r.resyncChannels()
+		}
+	}
+}
+
+// Get The Reconcile Paused Setting From The ConfigMap's Data Key, Falling Back To Its Annotation
+func reconcilePausedValue(configMap *corev1.ConfigMap) (string, bool) {
+	if value, ok := configMap.Data[commonconfig.ReconcilePausedConfigKey]; ok {
+		return value, true
+	}
+	value, ok := configMap.Annotations[constants.ReconcilePausedAnnotation]
+	return value, ok
+}
+
+// Requeue The Specified KafkaChannel For Once Reconciliation Is (Likely) Resumed
+func (r *Reconciler) requeueReconcilePaused(ctx context.Context, channel *kafkav1beta1.KafkaChannel) {
+	recordReconcileMode(ctx, ReconcileModePaused)
+	delay := time.Duration(constants.ReconcilePausedRequeueDelaySeconds) * time.Second
+	r.logger.Info("KafkaChannel Reconciliation Paused - Requeueing", zap.String("Channel", channel.Namespace+"/"+channel.Name), zap.Duration("Delay", delay))
+	if r.enqueueKeyAfter != nil {
+		r.enqueueKeyAfter(types.NamespacedName{Namespace: channel.Namespace, Name: channel.Name}, delay)
+	}
+}
+
+// The Error Returned By A Paused Finalization (Permanent Since It Is Explicitly Requeued, Retaining The Finalizer)
+func reconcilePausedError() error {
+	return controller.NewPermanentError(fmt.Errorf(constants.ReconciliationPausedError))
+}
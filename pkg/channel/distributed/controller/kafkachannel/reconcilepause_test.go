@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The Paused No-Op Reconciliation & Finalization Of KafkaChannels (And Their Resumption)
+func TestReconcilePaused(t *testing.T) {
+
+	// Fail The Test If A Kafka AdminClient Is Created While Paused
+	newKafkaAdminClientWrapperPlaceholder := kafkaadmin.NewKafkaAdminClientWrapper
+	kafkaadmin.NewKafkaAdminClientWrapper = func(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (kafkaadmin.AdminClientInterface, error) {
+		t.Error("Unexpected Kafka AdminClient Creation While Paused")
+		return &controllertesting.MockAdminClient{}, nil
+	}
+	defer func() {
+		kafkaadmin.NewKafkaAdminClientWrapper = newKafkaAdminClientWrapperPlaceholder
+	}()
+
+	// Create A Reconciler To Test Which Records Requeues & Resyncs
+	var requeuedKeys []types.NamespacedName
+	var requeueDelays []time.Duration
+	resyncCount := 0
+	kubeClientset := fake.NewSimpleClientset()
+	r := &Reconciler{
+		logger:          logtesting.TestLogger(t).Desugar(),
+		kubeClientset:   kubeClientset,
+		adminClientType: kafkaadmin.Kafka,
+		environment:     controllertesting.NewEnvironment(),
+		config:          controllertesting.NewConfig(),
+		adminMutex:      &sync.Mutex{},
+		enqueueKeyAfter: func(key types.NamespacedName, delay time.Duration) {
+			requeuedKeys = append(requeuedKeys, key)
+			requeueDelays = append(requeueDelays, delay)
+		},
+		resyncChannels: func() { resyncCount++ },
+	}
+
+	// Pause Reconciliation Via The ConfigMap Data
+	configMap := &corev1.ConfigMap{Data: map[string]string{commonconfig.ReconcilePausedConfigKey: "true"}}
+	r.updateReconcilePaused(configMap)
+	assert.True(t, r.isReconcilePaused())
+
+	// Verify Reconciliation Is A No-Op Which Requeues The KafkaChannel After The Paused Delay
+	channel := controllertesting.NewKafkaChannel()
+	channelKey := types.NamespacedName{Namespace: channel.Namespace, Name: channel.Name}
+	originalChannel := channel.DeepCopy()
+	assert.Nil(t, r.ReconcileKind(context.TODO(), channel))
+	assert.Equal(t, originalChannel, channel)
+	assert.Nil(t, r.adminClient)
+
+	// Verify Finalization Fails Without Side Effects (Retaining The Finalizer) & Is Requeued Rather Than Backed Off
+	deletedChannel := controllertesting.NewKafkaChannel(controllertesting.WithFinalizer, controllertesting.WithDeletionTimestamp)
+	originalDeletedChannel := deletedChannel.DeepCopy()
+	finalizeErr := r.FinalizeKind(context.TODO(), deletedChannel)
+	assert.NotNil(t, finalizeErr)
+	assert.True(t, controller.IsPermanentError(finalizeErr))
+	assert.Contains(t, finalizeErr.Error(), constants.ReconciliationPausedError)
+	assert.Equal(t, originalDeletedChannel, deletedChannel)
+	assert.Nil(t, r.adminClient)
+	assert.Empty(t, kubeClientset.Actions())
+
+	// Verify Both Were Requeued After The Paused Delay
+	assert.Equal(t, []types.NamespacedName{channelKey, channelKey}, requeuedKeys)
+	for _, delay := range requeueDelays {
+		assert.Equal(t, time.Duration(constants.ReconcilePausedRequeueDelaySeconds)*time.Second, delay)
+	}
+
+	// Verify The Kafka Topic Bootstrap Is Skipped
+	assert.Equal(t, 0, r.bootstrapKafkaTopics(context.TODO()))
+
+	// Verify Re-Applying The Pause Does Not Resync
+	r.updateReconcilePaused(configMap)
+	assert.True(t, r.isReconcilePaused())
+	assert.Equal(t, 0, resyncCount)
+
+	// Verify Removing The Setting Resumes Reconciliation & Re-Enqueues All KafkaChannels (Once)
+	r.updateReconcilePaused(&corev1.ConfigMap{})
+	assert.False(t, r.isReconcilePaused())
+	assert.Equal(t, 1, resyncCount)
+	r.updateReconcilePaused(&corev1.ConfigMap{})
+	assert.Equal(t, 1, resyncCount)
+}
+
+// Test The Parsing Of The Reconcile Paused ConfigMap Data (And Fallback Annotation)
+func TestUpdateReconcilePaused(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		data        map[string]string
+		annotations map[string]string
+		wantPaused  bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Data Or Annotations"},
+		{name: "Data True", data: map[string]string{commonconfig.ReconcilePausedConfigKey: "true"}, wantPaused: true},
+		{name: "Data Upper Case True", data: map[string]string{commonconfig.ReconcilePausedConfigKey: "TRUE"}, wantPaused: true},
+		{name: "Data False", data: map[string]string{commonconfig.ReconcilePausedConfigKey: "false"}},
+		{name: "Data Invalid", data: map[string]string{commonconfig.ReconcilePausedConfigKey: "maybe"}},
+		{name: "Annotation True", annotations: map[string]string{constants.ReconcilePausedAnnotation: "true"}, wantPaused: true},
+		{name: "Annotation False", annotations: map[string]string{constants.ReconcilePausedAnnotation: "false"}},
+		{name: "Annotation Invalid", annotations: map[string]string{constants.ReconcilePausedAnnotation: "maybe"}},
+		{name: "Data False Overrides Annotation True", data: map[string]string{commonconfig.ReconcilePausedConfigKey: "false"}, annotations: map[string]string{constants.ReconcilePausedAnnotation: "true"}},
+		{name: "Data True Overrides Annotation False", data: map[string]string{commonconfig.ReconcilePausedConfigKey: "true"}, annotations: map[string]string{constants.ReconcilePausedAnnotation: "false"}, wantPaused: true},
+		{name: "Per-Channel Paused Annotation Ignored", annotations: map[string]string{constants.PausedAnnotation: "true"}},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), adminMutex: &sync.Mutex{}}
+			r.updateReconcilePaused(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: testCase.annotations}, Data: testCase.data})
+			assert.Equal(t, testCase.wantPaused, r.isReconcilePaused())
+		})
+	}
+}
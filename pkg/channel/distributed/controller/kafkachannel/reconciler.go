@@ -19,18 +19,27 @@ package kafkachannel
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	appsv1listers "k8s.io/client-go/listers/apps/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
 	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
 	kafkasarama "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/env"
@@ -39,26 +48,43 @@ import (
 	kafkaclientset "knative.dev/eventing-kafka/pkg/client/clientset/versioned"
 	"knative.dev/eventing-kafka/pkg/client/injection/reconciler/messaging/v1beta1/kafkachannel"
 	kafkalisters "knative.dev/eventing-kafka/pkg/client/listers/messaging/v1beta1"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	"knative.dev/pkg/apis"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/reconciler"
+	"knative.dev/pkg/resolver"
 )
 
 // Reconciler Implements controller.Reconciler for KafkaChannel Resources
 type Reconciler struct {
-	logger               *zap.Logger
-	kubeClientset        kubernetes.Interface
-	kafkaClientSet       kafkaclientset.Interface
-	adminClientType      kafkaadmin.AdminClientType
-	adminClient          kafkaadmin.AdminClientInterface
-	environment          *env.Environment
-	config               *config.EventingKafkaConfig
-	saramaConfig         *sarama.Config
-	kafkachannelLister   kafkalisters.KafkaChannelLister
-	kafkachannelInformer cache.SharedIndexInformer
-	deploymentLister     appsv1listers.DeploymentLister
-	serviceLister        corev1listers.ServiceLister
-	configObserver       func(configMap *corev1.ConfigMap)
-	adminMutex           *sync.Mutex
+	logger                   *zap.Logger
+	kubeClientset            kubernetes.Interface
+	dynamicClient            dynamic.Interface
+	kafkaClientSet           kafkaclientset.Interface
+	adminClientType          kafkaadmin.AdminClientType
+	adminClient              kafkaadmin.AdminClientInterface
+	adminClientPool          *kafkaadmin.AdminClientPool
+	environment              *env.Environment
+	config                   *config.EventingKafkaConfig
+	saramaConfig             *sarama.Config
+	saramaConfigHash         string
+	kafkachannelLister       kafkalisters.KafkaChannelLister
+	kafkachannelInformer     cache.SharedIndexInformer
+	deploymentLister         appsv1listers.DeploymentLister
+	serviceLister            corev1listers.ServiceLister
+	configObserver           func(configMap *corev1.ConfigMap)
+	adminMutex               *sync.Mutex
+	uriResolver              *resolver.URIResolver
+	resyncChannels           func()
+	enqueueKeyAfter          func(key types.NamespacedName, delay time.Duration)
+	concurrentReconciliation bool                      // Reconcile The Channel & Dispatcher Concurrently (Sequential Keeps Table Test Actions Ordered)
+	leader                   int32                     // Whether This Controller Instance Is The Leader (Accessed Atomically, See isLeader())
+	circuitBreaker           *circuitBreaker           // Per-Kafka-Secret Connection Circuit Breaker (nil When Disabled)
+	consumerGroupStatusCache *consumerGroupStatusCache // Per-KafkaChannel Subscriber ConsumerGroup Status (nil Disables Caching)
+	reconcileShortCircuit    *reconcileShortCircuit    // Per-KafkaChannel Last Full Reconciliation (nil When Disabled)
+	reconcilePaused          int32                     // Whether All Reconciliation Is Paused For Maintenance (Accessed Atomically, See isReconcilePaused())
+	eventRecorder            record.EventRecorder      // Records Events Outside Of Reconciliation (e.g. Invalid ConfigMap Changes)
 }
 
 var (
@@ -79,13 +105,79 @@ var (
 // lightweight REST clients so recreating them isn't a big deal and it simplifies the code significantly to
 // not have to support both use cases.
 //
-func (r *Reconciler) SetKafkaAdminClient(ctx context.Context) {
+// If an AdminClientIdleTimeoutMillis is configured, the AdminClient is instead obtained from a pool of warm
+// connections (keyed by Kafka Secret) which transparently reconnects on "broken-pipe" failures.  In this case
+// "clearing" the AdminClient simply returns it to the pool.
+//
+// The outcome is reflected in the specified KafkaChannel's (if any) ConnectionReady condition (along with the brokers
+// from the Kafka Secrets) so that broker reachability is distinguishable from other reconciliation failures.  Any
+// failure is also returned so that the caller can requeue (with the workqueue's exponential backoff) rather than
+// proceeding without an AdminClient.
+//
+func (r *Reconciler) SetKafkaAdminClient(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
 	r.ClearKafkaAdminClient()
+	kafkaSecretName := kafkaadmin.KafkaSecretNameFromContext(ctx)
+	if !r.circuitBreaker.allow(kafkaSecretName) {
+		err := fmt.Errorf(constants.KafkaCircuitBreakerOpenError)
+		r.logger.Warn("Kafka Circuit Breaker Open - Skipping Kafka AdminClient Creation", zap.String("KafkaSecret", kafkaSecretName))
+		if channel != nil {
+			channel.Status.MarkConnectionFailed(constants.KafkaCircuitBreakerOpenReason, "Kafka Circuit Breaker Open For Kafka Brokers %s", r.kafkaBrokers(ctx))
+		}
+		return err
+	}
 	var err error
-	r.adminClient, err = kafkaadmin.CreateAdminClient(ctx, r.saramaConfig, constants.ControllerComponentName, r.adminClientType)
+	if r.adminClientPool != nil {
+		r.adminClient, err = r.adminClientPool.Get(ctx, r.saramaConfig)
+	} else {
+		r.adminClient, err = kafkaadmin.CreateAdminClient(ctx, r.saramaConfig, constants.ControllerComponentName, r.adminClientType)
+	}
+	if err == nil && r.adminClient == nil {
+		err = fmt.Errorf(constants.KafkaAdminClientUnavailableError)
+	}
 	if err != nil {
 		r.logger.Error("Failed To Create Kafka AdminClient", zap.Error(err))
+		r.adminClient = nil
+	}
+	r.circuitBreaker.record(kafkaSecretName, err)
+	if channel != nil {
+		if err != nil {
+			channel.Status.MarkConnectionFailed(constants.KafkaConnectionFailedReason, "Failed To Connect To Kafka Brokers %s: %v", r.kafkaBrokers(ctx), err)
+		} else {
+			channel.Status.MarkConnectionTrue(constants.KafkaConnectedReason, "Connected To Kafka Brokers %s", r.kafkaBrokers(ctx))
+		}
+	}
+	return err
+}
+
+// Get The Kafka Secret Name For The Specified Topic From The Kafka AdminClient (Empty If There Is No AdminClient)
+func (r *Reconciler) adminClientKafkaSecretName(topicName string) string {
+	if r.adminClient == nil {
+		return ""
+	}
+	return r.adminClient.GetKafkaSecretName(topicName)
+}
+
+// Get A Description Of The Kafka Brokers From The Kafka Secrets (For Status Messages Only)
+func (r *Reconciler) kafkaBrokers(ctx context.Context) string {
+	if r.kubeClientset == nil {
+		return "[]"
+	}
+	kafkaSecrets, err := adminutil.GetKafkaSecrets(ctx, r.kubeClientset, commonconstants.KnativeEventingNamespace)
+	if err != nil {
+		r.logger.Warn("Failed To Get Kafka Secrets For Brokers", zap.Error(err))
+		return "[]"
+	}
+	brokers := make([]string, 0, len(kafkaSecrets.Items))
+	selectedKafkaSecretName := kafkaadmin.KafkaSecretNameFromContext(ctx)
+	for _, kafkaSecret := range kafkaSecrets.Items {
+		if len(selectedKafkaSecretName) > 0 && kafkaSecret.Name != selectedKafkaSecretName {
+			continue
+		}
+		if secretBrokers := string(kafkaSecret.Data[constants.KafkaSecretDataKeyBrokers]); len(secretBrokers) > 0 {
+			brokers = append(brokers, secretBrokers)
+		}
 	}
+	return fmt.Sprintf("[%s]", strings.Join(brokers, ","))
 }
 
 // Clear (Close) The Reconciler's Kafka AdminClient
@@ -102,57 +194,110 @@ func (r *Reconciler) ClearKafkaAdminClient() {
 // ReconcileKind Implements The Reconciler Interface & Is Responsible For Performing The Reconciliation (Creation)
 func (r *Reconciler) ReconcileKind(ctx context.Context, channel *kafkav1beta1.KafkaChannel) reconciler.Event {
 
-	r.logger.Debug("<==========  START KAFKA-CHANNEL RECONCILIATION  ==========>")
+	// Setup Logger (With The TraceId Shared By All Logging Of This Reconciliation)
+	logger := util.ReconcileLogger(ctx, channel)
 
-	// Add The K8S ClientSet To The Reconcile Context
+	logger.Debug("<==========  START KAFKA-CHANNEL RECONCILIATION  ==========>")
+
+	// Skip Reconciliation Entirely (No Kafka AdminClient) While Globally Paused For Maintenance
+	if r.isReconcilePaused() {
+		r.requeueReconcilePaused(ctx, channel)
+		return nil
+	}
+
+	// Add The K8S ClientSet & Any Explicitly Selected Kafka Secret To The Reconcile Context
 	ctx = context.WithValue(ctx, kubeclient.Key{}, r.kubeClientset)
+	ctx = kafkaadmin.WithKafkaSecretName(ctx, util.KafkaSecretName(channel))
+
+	// Only Health Check Unchanged & Healthy KafkaChannels Between Deep Reconciliations (If Enabled - No Kafka AdminClient)
+	if r.shortCircuitReconcile(channel) {
+		recordReconcileMode(ctx, ReconcileModeShortCircuit)
+		logger.Debug("KafkaChannel Unchanged & Healthy - Short-Circuiting Reconciliation")
+		return nil
+	}
+	recordReconcileMode(ctx, ReconcileModeFull)
 
 	// Don't let another goroutine clear out the admin client while we're using it in this one
 	r.adminMutex.Lock()
 	defer r.adminMutex.Unlock()
 
-	// Create A New Kafka AdminClient For Each Reconciliation Attempt
-	r.SetKafkaAdminClient(ctx)
+	// Create A New Kafka AdminClient For Each Reconciliation Attempt (Requeue With Backoff If The Brokers Are Unavailable)
+	err := r.SetKafkaAdminClient(ctx, channel)
 	defer r.ClearKafkaAdminClient()
+	if err != nil {
+		logger.Error("Failed To Reconcile KafkaChannel - No Kafka AdminClient", zap.Any("KafkaChannel", channel), zap.Error(err))
+		return fmt.Errorf(constants.ReconciliationFailedError)
+	}
 
 	// Reset The Channel's Status Conditions To Unknown (Addressable, Topic, Service, Deployment, etc...)
 	channel.Status.InitializeConditions()
 
+	// Record Whether TLS Verification Of The Kafka Brokers Is Disabled (For Auditing)
+	r.reconcileInsecureSkipVerifyStatus(channel)
+
 	// Perform The KafkaChannel Reconciliation & Handle Error Response
-	r.logger.Info("Channel Owned By Controller - Reconciling", zap.Any("Channel.Spec", channel.Spec))
-	err := r.reconcile(ctx, channel)
+	logger.Info("Channel Owned By Controller - Reconciling", zap.Any("Channel.Spec", channel.Spec))
+	err = r.reconcile(ctx, channel)
 	if err != nil {
-		r.logger.Error("Failed To Reconcile KafkaChannel", zap.Any("Channel", channel), zap.Error(err))
+		logger.Error("Failed To Reconcile KafkaChannel", zap.Any("KafkaChannel", channel), zap.Error(err))
 		return err
 	}
 
-	// Return Success
-	r.logger.Info("Successfully Reconciled KafkaChannel", zap.Any("Channel", channel))
+	// Return Success (Recording The Full Reconciliation For Any Subsequent Short-Circuit)
+	logger.Info("Successfully Reconciled KafkaChannel", zap.Any("KafkaChannel", channel))
 	channel.Status.ObservedGeneration = channel.Generation
+	r.reconcileShortCircuit.record(types.NamespacedName{Namespace: channel.Namespace, Name: channel.Name}, r.reconcileFingerprint(channel))
 	return reconciler.NewEvent(corev1.EventTypeNormal, event.KafkaChannelReconciled.String(), "KafkaChannel Reconciled Successfully: \"%s/%s\"", channel.Namespace, channel.Name)
 }
 
+// Set (Or Remove) The KafkaChannel Status Annotation Recording That TLS Verification Of The Kafka Brokers Is Disabled
+func (r *Reconciler) reconcileInsecureSkipVerifyStatus(channel *kafkav1beta1.KafkaChannel) {
+	if kafkasarama.InsecureSkipVerify(r.saramaConfig) {
+		if channel.Status.Annotations == nil {
+			channel.Status.Annotations = make(map[string]string)
+		}
+		channel.Status.Annotations[constants.TLSInsecureSkipVerifyAnnotation] = "true"
+	} else {
+		delete(channel.Status.Annotations, constants.TLSInsecureSkipVerifyAnnotation)
+	}
+}
+
 // ReconcileKind Implements The Finalizer Interface & Is Responsible For Performing The Finalization (Topic Deletion)
 func (r *Reconciler) FinalizeKind(ctx context.Context, channel *kafkav1beta1.KafkaChannel) reconciler.Event {
 
-	r.logger.Debug("<==========  START KAFKA-CHANNEL FINALIZATION  ==========>")
+	// Setup Logger (With The TraceId Shared By All Logging Of This Finalization)
+	logger := util.ReconcileLogger(ctx, channel)
 
-	// Setup Logger
-	logger := util.ChannelLogger(r.logger, channel)
+	logger.Debug("<==========  START KAFKA-CHANNEL FINALIZATION  ==========>")
 
-	// Add The K8S ClientSet To The Reconcile Context
+	// Fail Without Side Effects While Globally Paused For Maintenance (Retaining The Finalizer Until Resumed)
+	if r.isReconcilePaused() {
+		r.requeueReconcilePaused(ctx, channel)
+		return reconcilePausedError()
+	}
+
+	// Add The K8S ClientSet & Any Explicitly Selected Kafka Secret To The Reconcile Context
 	ctx = context.WithValue(ctx, kubeclient.Key{}, r.kubeClientset)
+	ctx = kafkaadmin.WithKafkaSecretName(ctx, util.KafkaSecretName(channel))
 
 	// Don't let another goroutine clear out the admin client while we're using it in this one
 	r.adminMutex.Lock()
 	defer r.adminMutex.Unlock()
 
-	// Create A New Kafka AdminClient For Each Reconciliation Attempt
-	r.SetKafkaAdminClient(ctx)
+	// Create A New Kafka AdminClient For Each Reconciliation Attempt (No ConnectionReady Condition On Deleted Channels)
+	err := r.SetKafkaAdminClient(ctx, nil)
 	defer r.ClearKafkaAdminClient()
+	if err != nil {
+		logger.Error("Failed To Finalize KafkaChannel - No Kafka AdminClient", zap.Error(err))
+		return fmt.Errorf(constants.FinalizationFailedError)
+	}
+
+	// Forget Any Cached Subscriber ConsumerGroup Status & Full Reconciliation
+	r.consumerGroupStatusCache.remove(channel.UID)
+	r.reconcileShortCircuit.forget(types.NamespacedName{Namespace: channel.Namespace, Name: channel.Name})
 
 	// Finalize The Dispatcher (Manual Finalization Due To Cross-Namespace Ownership)
-	err := r.finalizeDispatcher(ctx, channel)
+	err = r.finalizeDispatcher(ctx, channel)
 	if err != nil {
 		logger.Info("Failed To Finalize KafkaChannel", zap.Error(err))
 		return fmt.Errorf(constants.FinalizationFailedError)
@@ -176,12 +321,28 @@ func (r *Reconciler) reconcile(ctx context.Context, channel *kafkav1beta1.KafkaC
 	// NOTE - The sequential order of reconciliation must be "Topic" then "Channel / Dispatcher" in order for the
 	//        EventHub Cache to know the dynamically determined EventHub Namespace / Kafka Secret selected for the topic.
 
-	// Reconcile The KafkaChannel's Kafka Topic
-	err := r.reconcileKafkaTopic(ctx, channel)
+	// Verify Any Explicitly Selected Kafka Secret Exists Before Using It
+	configStartTime := time.Now()
+	err := r.reconcileKafkaSecretSelection(ctx, channel)
+	recordReconcilePhase(ctx, ReconcilePhaseConfig, configStartTime, err)
 	if err != nil {
 		return fmt.Errorf(constants.ReconciliationFailedError)
 	}
 
+	// Refuse KafkaChannels Managed By The "Consolidated" Implementation Unless Migration Was Requested
+	err = r.reconcileConsolidatedMigration(ctx, channel)
+	if err != nil {
+		return fmt.Errorf(constants.ReconciliationFailedError)
+	}
+
+	// Reconcile The KafkaChannel's Kafka Topic (Requeueing According To The Kind Of Kafka Error)
+	topicStartTime := time.Now()
+	err = r.reconcileKafkaTopic(ctx, channel)
+	recordReconcilePhase(ctx, ReconcilePhaseTopic, topicStartTime, err)
+	if err != nil {
+		return r.kafkaTopicReconciliationError(channel, err)
+	}
+
 	//
 	// This implementation is based on the "consolidated" KafkaChannel, and thus we're using
 	// their Status tracking even though it does not align with our architecture.  We get our
@@ -189,22 +350,35 @@ func (r *Reconciler) reconcile(ctx context.Context, channel *kafkav1beta1.KafkaC
 	// instead check the Kafka Secret associated with the KafkaChannel here.
 	//
 
-	if len(r.adminClient.GetKafkaSecretName(util.TopicName(channel))) > 0 {
+	if len(r.adminClientKafkaSecretName(util.TopicName(channel))) > 0 {
 		channel.Status.MarkConfigTrue()
+	} else if previousKafkaSecretName := channel.Labels[constants.KafkaSecretLabel]; len(previousKafkaSecretName) > 0 {
+		channel.Status.MarkConfigFailed(event.KafkaSecretNotFound.String(), "Kafka Secret %s No Longer Exists For KafkaChannel", previousKafkaSecretName)
+		return fmt.Errorf(constants.ReconciliationFailedError)
 	} else {
 		channel.Status.MarkConfigFailed(event.KafkaSecretReconciled.String(), "No Kafka Secret For KafkaChannel")
 		return fmt.Errorf(constants.ReconciliationFailedError)
 	}
 
-	// Reconcile The KafkaChannel's Channel & Dispatcher Deployment/Service
-	channelError := r.reconcileChannel(ctx, channel)
-	dispatcherError := r.reconcileDispatcher(ctx, channel)
-	if channelError != nil || dispatcherError != nil {
+	// Reconcile The KafkaChannel's Channel & Dispatcher Deployment/Service (Concurrently - They Are Independent)
+	channelAndDispatcherError := r.reconcileChannelAndDispatcher(ctx, channel)
+
+	// Reconcile The KafkaChannel's Channel-Level Dead Letter Sink (Used By The Dispatcher)
+	deadLetterSinkError := r.reconcileDeadLetterSink(ctx, channel)
+
+	// Reconcile The KafkaChannel's Per-Subscription Shadow Destinations (Used By The Dispatcher)
+	subscriberShadowsError := r.reconcileSubscriberShadows(ctx, channel)
+	if channelAndDispatcherError != nil || deadLetterSinkError != nil || subscriberShadowsError != nil {
 		return fmt.Errorf(constants.ReconciliationFailedError)
 	}
 
+	// Summarize The KafkaChannel's Subscriber ConsumerGroups (Informational Only - Never Fails The Reconciliation)
+	r.reconcileSubscriberConsumerGroups(ctx, channel)
+
 	// Reconcile The KafkaChannel Itself (MetaData, etc...)
+	kafkaChannelStartTime := time.Now()
 	err = r.reconcileKafkaChannel(ctx, channel)
+	recordReconcilePhase(ctx, ReconcilePhaseKafkaChannelMeta, kafkaChannelStartTime, err)
 	if err != nil {
 		return fmt.Errorf(constants.ReconciliationFailedError)
 	}
@@ -213,18 +387,122 @@ func (r *Reconciler) reconcile(ctx context.Context, channel *kafkav1beta1.KafkaC
 	return nil
 }
 
+//
+// Get The Reconciliation Error (And Requeue Behavior) For The Specified Kafka Topic Reconciliation Failure
+//
+// Transient Kafka errors (connection / timeout) are requeued after the configured (jittered) delay, rather than
+// the controller's default rate-limited backoff, to avoid a thundering herd of reconnects when a broker restarts.
+// Since they are explicitly requeued, and permanent Kafka errors (invalid configuration / authorization) will not
+// succeed until the KafkaChannel or Kafka cluster changes, both are returned as knative PermanentErrors so that
+// the workqueue does not (also) requeue them.  Anything else is left to the default backoff.
+//
+func (r *Reconciler) kafkaTopicReconciliationError(channel *kafkav1beta1.KafkaChannel, err error) error {
+	reconciliationError := fmt.Errorf(constants.ReconciliationFailedError)
+	switch adminutil.ClassifyKafkaError(err) {
+	case adminutil.KafkaErrorPermanent:
+		r.logger.Warn("Permanent Kafka Topic Error - Not Requeueing", zap.Error(err))
+		return controller.NewPermanentError(reconciliationError)
+	case adminutil.KafkaErrorTransient:
+		if delay := r.transientErrorRequeueDelay(); delay > 0 && r.enqueueKeyAfter != nil {
+			r.logger.Info("Transient Kafka Topic Error - Requeueing", zap.Duration("Delay", delay), zap.Error(err))
+			r.enqueueKeyAfter(types.NamespacedName{Namespace: channel.Namespace, Name: channel.Name}, delay)
+			return controller.NewPermanentError(reconciliationError)
+		}
+	}
+	return reconciliationError
+}
+
+// Get The (Jittered) Delay Before Requeueing A Transient Kafka Error (Zero Uses The Default Backoff)
+func (r *Reconciler) transientErrorRequeueDelay() time.Duration {
+	if r.config == nil || r.config.Kafka.TransientErrorRequeue.DelayMillis <= 0 {
+		return 0
+	}
+	delay := time.Duration(r.config.Kafka.TransientErrorRequeue.DelayMillis) * time.Millisecond
+	if jitterFactor := r.config.Kafka.TransientErrorRequeue.JitterFactor; jitterFactor > 0 {
+		delay = wait.Jitter(delay, jitterFactor)
+	}
+	return delay
+}
+
+//
+// Reconcile The KafkaChannel's Channel & Dispatcher Concurrently, Returning The Aggregated Errors
+//
+// The Channel and Dispatcher resources are independent of each other, but both are only reconciled after
+// the Kafka Topic (see the EventHub Cache note in reconcile()).  Each is reconciled against its own copy
+// of the KafkaChannel so that their Status updates do not race, and the conditions they own are then
+// merged back in the original "Channel then Dispatcher" order.  Both complete before returning, so they
+// remain within the scope of the adminMutex held by ReconcileKind().
+//
+func (r *Reconciler) reconcileChannelAndDispatcher(ctx context.Context, channel *kafkav1beta1.KafkaChannel) error {
+
+	// Reconcile Each Against Its Own Copy Of The KafkaChannel
+	channelCopy := channel.DeepCopy()
+	dispatcherCopy := channel.DeepCopy()
+	var channelErr, dispatcherErr error
+	reconcileChannel := func() error {
+		startTime := time.Now()
+		channelErr = r.reconcileChannel(ctx, channelCopy)
+		recordReconcilePhase(ctx, ReconcilePhaseChannel, startTime, channelErr)
+		return channelErr
+	}
+	reconcileDispatcher := func() error {
+		startTime := time.Now()
+		dispatcherErr = r.reconcileDispatcher(ctx, dispatcherCopy)
+		recordReconcilePhase(ctx, ReconcilePhaseDispatcher, startTime, dispatcherErr)
+		return dispatcherErr
+	}
+	if r.concurrentReconciliation {
+		group := errgroup.Group{}
+		group.Go(reconcileChannel)
+		group.Go(reconcileDispatcher)
+		_ = group.Wait() // Errors Are Aggregated Below Rather Than Returning Only The First
+	} else {
+		_ = reconcileChannel()
+		_ = reconcileDispatcher()
+	}
+
+	// Merge The Channel (Service & Address) And Dispatcher Status Back Into The KafkaChannel
+	channel.Status.Address = channelCopy.Status.Address
+	mergeStatusConditions(&channel.Status, &channelCopy.Status, kafkav1beta1.KafkaChannelConditionChannelServiceReady, kafkav1beta1.KafkaChannelConditionAddressable)
+	mergeStatusConditions(&channel.Status, &dispatcherCopy.Status, kafkav1beta1.KafkaChannelConditionDispatcherReady)
+
+	// Return The Aggregated Errors (Nil If Both Succeeded)
+	return utilerrors.NewAggregate([]error{channelErr, dispatcherErr})
+}
+
+// Merge The Specified Conditions From The Source Status Into The Target Status (Recomputing Its Readiness)
+func mergeStatusConditions(target *kafkav1beta1.KafkaChannelStatus, source *kafkav1beta1.KafkaChannelStatus, conditionTypes ...apis.ConditionType) {
+	conditionManager := target.GetConditionSet().Manage(target)
+	for _, conditionType := range conditionTypes {
+		condition := source.GetCondition(conditionType)
+		if condition == nil {
+			continue
+		}
+		switch condition.Status {
+		case corev1.ConditionTrue:
+			conditionManager.MarkTrue(conditionType)
+		case corev1.ConditionFalse:
+			conditionManager.MarkFalse(conditionType, condition.Reason, "%s", condition.Message)
+		default:
+			conditionManager.MarkUnknown(conditionType, condition.Reason, "%s", condition.Message)
+		}
+	}
+}
+
 // configMapObserver is the callback function that handles changes to our ConfigMap
 func (r *Reconciler) configMapObserver(configMap *corev1.ConfigMap) {
-	if configMap == nil {
-		r.logger.Warn("Nil ConfigMap passed to configMapObserver; ignoring")
+	if r == nil {
+		// This typically happens during startup (There is no Reconciler logger to use, so ignore silently)
 		return
 	}
-	if r == nil {
-		// This typically happens during startup
-		r.logger.Debug("Reconciler is nil during call to configMapObserver; ignoring changes")
+	if configMap == nil {
+		r.logger.Warn("Nil ConfigMap passed to configMapObserver; ignoring")
 		return
 	}
 
+	// Pause / Resume The Reconciliation Of All KafkaChannels As Specified In The ConfigMap's Data (Or Annotations)
+	r.updateReconcilePaused(configMap)
+
 	// Enable Sarama Logging If Specified In ConfigMap
 	if ekConfig, err := kafkasarama.LoadEventingKafkaSettings(configMap); err == nil && ekConfig != nil {
 		kafkasarama.EnableSaramaLogging(ekConfig.Kafka.EnableSaramaLogging)
@@ -241,10 +519,17 @@ func (r *Reconciler) configMapObserver(configMap *corev1.ConfigMap) {
 	// env.GetEnvironment is not necessary now.  If	those settings are needed in the future, the
 	// environment will also need to be re-parsed here.
 
-	// Load the Sarama settings from our configmap, ignoring the eventing-kafka result.
+	// Load the Sarama settings from our configmap, ignoring the eventing-kafka result.  Invalid settings
+	// (e.g. an unsupported kafka.version) are rejected, retaining the previous Sarama configuration, rather
+	// than terminating the controller (which would then crash-loop on the same invalid ConfigMap).
 	saramaConfig, err := kafkasarama.MergeSaramaSettings(nil, configMap)
 	if err != nil {
-		r.logger.Fatal("Failed To Load Eventing-Kafka Settings", zap.Error(err))
+		r.logger.Error("Invalid Sarama Settings In Updated ConfigMap - Retaining Previous Sarama Configuration", zap.Error(err))
+		if r.eventRecorder != nil {
+			r.eventRecorder.Eventf(configMap, corev1.EventTypeWarning, event.SaramaSettingsInvalid.String(),
+				"Invalid Sarama Settings Ignored (Previous Sarama Configuration Retained): %v", err)
+		}
+		return
 	}
 
 	// Note - We're not calling UpdateSaramaConfig() here because we load the Kafka Secret
@@ -252,4 +537,58 @@ func (r *Reconciler) configMapObserver(configMap *corev1.ConfigMap) {
 
 	r.logger.Info("ConfigMap Changed; Updating Sarama Configuration")
 	r.saramaConfig = saramaConfig
+
+	// Roll The Dispatcher Deployments (Via Their ConfigHash Annotation) Only If The Sarama Settings Meaningfully Changed
+	saramaConfigHash, err := kafkasarama.SaramaSettingsHash(configMap)
+	if err != nil {
+		r.logger.Error("Failed To Hash Sarama Settings; Dispatchers Will Not Be Restarted", zap.Error(err))
+	} else if saramaConfigHash != r.saramaConfigHash {
+		r.logger.Info("Sarama Settings Changed; Restarting Dispatcher Deployments", zap.String("ConfigHash", saramaConfigHash))
+		r.saramaConfigHash = saramaConfigHash
+		kafkasarama.LogInsecureSkipVerifyWarning(r.logger.Sugar(), saramaConfig)
+		if r.resyncChannels != nil {
+			r.resyncChannels()
+		}
+	}
+
+	// Flush Any Pooled AdminClients So They Are Recreated With The New Sarama Configuration
+	if r.adminClientPool != nil {
+		r.adminMutex.Lock()
+		defer r.adminMutex.Unlock()
+		_ = r.adminClientPool.Close()
+	}
+}
+
+// rootCAConfigMapObserver is the callback function that handles changes to the Root CA ConfigMap
+func (r *Reconciler) rootCAConfigMapObserver(configMap *corev1.ConfigMap) {
+	if r == nil {
+		// This typically happens during startup (There is no Reconciler logger to use, so ignore silently)
+		return
+	}
+	if configMap == nil {
+		r.logger.Warn("Nil ConfigMap passed to rootCAConfigMapObserver; ignoring")
+		return
+	}
+
+	// Update The Root CAs From The ConfigMap (Ignoring Unchanged Or Invalid CAs)
+	changed, err := kafkasarama.UpdateRootCAConfigMap(configMap)
+	if err != nil {
+		r.logger.Error("Invalid Root CA ConfigMap; ignoring", zap.Error(err))
+		return
+	} else if !changed || r.saramaConfig == nil {
+		return
+	}
+
+	// Copy The Current Sarama Config With The Rotated Root CAs (Subsequent ConfigMap Merges Also Apply Them)
+	r.logger.Info("Root CA ConfigMap Changed; Updating Sarama Configuration")
+	saramaConfig := *r.saramaConfig
+	kafkasarama.UpdateSaramaConfigRootCAs(&saramaConfig, kafkasarama.RootCAConfigMapPool())
+	r.saramaConfig = &saramaConfig
+
+	// Flush Any Pooled AdminClients So They Are Recreated With The New Root CAs
+	if r.adminClientPool != nil {
+		r.adminMutex.Lock()
+		defer r.adminMutex.Unlock()
+		_ = r.adminClientPool.Close()
+	}
 }
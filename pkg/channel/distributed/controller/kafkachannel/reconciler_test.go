@@ -18,28 +18,48 @@ package kafkachannel
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
 	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
+	kafkasarama "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/env"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
 	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
 	fakekafkaclient "knative.dev/eventing-kafka/pkg/client/injection/client/fake"
 	kafkachannelreconciler "knative.dev/eventing-kafka/pkg/client/injection/reconciler/messaging/v1beta1/kafkachannel"
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/addressable"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/logging/logkey"
 	logtesting "knative.dev/pkg/logging/testing"
 	. "knative.dev/pkg/reconciler/testing"
+	"knative.dev/pkg/resolver"
 )
 
 // Initialization - Add types to scheme
@@ -73,17 +93,215 @@ func TestSetKafkaAdminClient(t *testing.T) {
 	// Create A Reconciler To Test
 	reconciler := &Reconciler{
 		logger:          logger,
+		kubeClientset:   fake.NewSimpleClientset(controllertesting.NewKafkaSecret(controllertesting.WithKafkaSecretLabel)),
 		adminClientType: clientType,
 		adminClient:     mockAdminClient1,
 	}
 
 	// Perform The Test
-	reconciler.SetKafkaAdminClient(context.TODO())
+	channel := controllertesting.NewKafkaChannel()
+	err := reconciler.SetKafkaAdminClient(context.TODO(), channel)
 
 	// Verify Results
+	assert.Nil(t, err)
 	assert.True(t, mockAdminClient1.CloseCalled())
 	assert.NotNil(t, reconciler.adminClient)
-	assert.Equal(t, mockAdminClient2, reconciler.adminClient)
+	assert.Equal(t, kafkaadmin.NewInstrumentedAdminClient(mockAdminClient2, clientType), reconciler.adminClient)
+	connectionCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionConnectionReady)
+	assert.NotNil(t, connectionCondition)
+	assert.Equal(t, corev1.ConditionTrue, connectionCondition.Status)
+	assert.Equal(t, constants.KafkaConnectedReason, connectionCondition.Reason)
+	assert.Equal(t, "Connected To Kafka Brokers ["+controllertesting.KafkaSecretDataValueBrokers+"]", connectionCondition.Message)
+}
+
+// Test The Reconciler's SetKafkaAdminClient() Functionality When The Kafka Brokers Are Unreachable
+func TestSetKafkaAdminClientConnectionFailed(t *testing.T) {
+
+	// Mock The Failed Creation Of Kafka ClusterAdmin
+	newKafkaAdminClientWrapperPlaceholder := kafkaadmin.NewKafkaAdminClientWrapper
+	kafkaadmin.NewKafkaAdminClientWrapper = func(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (kafkaadmin.AdminClientInterface, error) {
+		return nil, errors.New(controllertesting.ErrorString)
+	}
+	defer func() {
+		kafkaadmin.NewKafkaAdminClientWrapper = newKafkaAdminClientWrapperPlaceholder
+	}()
+
+	// Create A Reconciler To Test
+	reconciler := &Reconciler{
+		logger:          logtesting.TestLogger(t).Desugar(),
+		kubeClientset:   fake.NewSimpleClientset(controllertesting.NewKafkaSecret(controllertesting.WithKafkaSecretLabel)),
+		adminClientType: kafkaadmin.Kafka,
+	}
+
+	// Perform The Test
+	channel := controllertesting.NewKafkaChannel()
+	err := reconciler.SetKafkaAdminClient(context.TODO(), channel)
+
+	// Verify Results
+	assert.NotNil(t, err)
+	assert.Nil(t, reconciler.adminClient)
+	connectionCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionConnectionReady)
+	assert.NotNil(t, connectionCondition)
+	assert.Equal(t, corev1.ConditionFalse, connectionCondition.Status)
+	assert.Equal(t, constants.KafkaConnectionFailedReason, connectionCondition.Reason)
+	assert.Equal(t, "Failed To Connect To Kafka Brokers ["+controllertesting.KafkaSecretDataValueBrokers+"]: "+controllertesting.ErrorString, connectionCondition.Message)
+}
+
+// Test The Reconciler's SetKafkaAdminClient() Functionality With The Circuit Breaker Enabled
+func TestSetKafkaAdminClientCircuitBreaker(t *testing.T) {
+
+	// Mock The Failed Creation Of Kafka ClusterAdmin (Counting The Connection Attempts)
+	connectionAttempts := 0
+	newKafkaAdminClientWrapperPlaceholder := kafkaadmin.NewKafkaAdminClientWrapper
+	kafkaadmin.NewKafkaAdminClientWrapper = func(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (kafkaadmin.AdminClientInterface, error) {
+		connectionAttempts++
+		return nil, errors.New(controllertesting.ErrorString)
+	}
+	defer func() {
+		kafkaadmin.NewKafkaAdminClientWrapper = newKafkaAdminClientWrapperPlaceholder
+	}()
+
+	// Create A Reconciler With A Circuit Breaker To Test
+	logger := logtesting.TestLogger(t).Desugar()
+	reconciler := &Reconciler{
+		logger:          logger,
+		kubeClientset:   fake.NewSimpleClientset(controllertesting.NewKafkaSecret(controllertesting.WithKafkaSecretLabel)),
+		adminClientType: kafkaadmin.Kafka,
+		circuitBreaker:  newCircuitBreaker(logger, commonconfig.EKCircuitBreakerConfig{Enabled: true, FailureThreshold: 2, OpenDurationMillis: 60000}),
+	}
+
+	// Perform The Test - Failures Up To The Threshold Attempt To Connect
+	for i := 0; i < 2; i++ {
+		channel := controllertesting.NewKafkaChannel()
+		assert.NotNil(t, reconciler.SetKafkaAdminClient(context.TODO(), channel))
+		assert.Equal(t, constants.KafkaConnectionFailedReason, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionConnectionReady).Reason)
+	}
+	assert.Equal(t, 2, connectionAttempts)
+
+	// Perform The Test - The Open Circuit Short-Circuits Without Attempting To Connect
+	channel := controllertesting.NewKafkaChannel()
+	err := reconciler.SetKafkaAdminClient(context.TODO(), channel)
+
+	// Verify Results
+	assert.NotNil(t, err)
+	assert.Equal(t, constants.KafkaCircuitBreakerOpenError, err.Error())
+	assert.Equal(t, 2, connectionAttempts)
+	assert.Nil(t, reconciler.adminClient)
+	connectionCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionConnectionReady)
+	assert.NotNil(t, connectionCondition)
+	assert.Equal(t, corev1.ConditionFalse, connectionCondition.Status)
+	assert.Equal(t, constants.KafkaCircuitBreakerOpenReason, connectionCondition.Reason)
+	assert.Equal(t, "Kafka Circuit Breaker Open For Kafka Brokers ["+controllertesting.KafkaSecretDataValueBrokers+"]", connectionCondition.Message)
+}
+
+// Test That A Failed Kafka AdminClient Creation Results In A Requeue (Error) Rather Than A Panic
+func TestReconcileKafkaAdminClientFailure(t *testing.T) {
+
+	// Mock The Failed Creation Of Kafka ClusterAdmin
+	newKafkaAdminClientWrapperPlaceholder := kafkaadmin.NewKafkaAdminClientWrapper
+	kafkaadmin.NewKafkaAdminClientWrapper = func(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (kafkaadmin.AdminClientInterface, error) {
+		return nil, errors.New(controllertesting.ErrorString)
+	}
+	defer func() {
+		kafkaadmin.NewKafkaAdminClientWrapper = newKafkaAdminClientWrapperPlaceholder
+	}()
+
+	// Create A Reconciler To Test
+	reconciler := &Reconciler{
+		logger:          logtesting.TestLogger(t).Desugar(),
+		kubeClientset:   fake.NewSimpleClientset(controllertesting.NewKafkaSecret(controllertesting.WithKafkaSecretLabel)),
+		adminClientType: kafkaadmin.Kafka,
+		environment:     controllertesting.NewEnvironment(),
+		config:          controllertesting.NewConfig(),
+		adminMutex:      &sync.Mutex{},
+	}
+
+	// Perform The Reconciliation Test (Should Return An Error To Requeue With Backoff, Not Panic)
+	channel := controllertesting.NewKafkaChannel()
+	var reconcileErr error
+	assert.NotPanics(t, func() { reconcileErr = reconciler.ReconcileKind(context.TODO(), channel) })
+	assert.NotNil(t, reconcileErr)
+	assert.Equal(t, constants.ReconciliationFailedError, reconcileErr.Error())
+	assert.Nil(t, reconciler.adminClient)
+	connectionCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionConnectionReady)
+	assert.NotNil(t, connectionCondition)
+	assert.Equal(t, corev1.ConditionFalse, connectionCondition.Status)
+
+	// Perform The Finalization Test (Should Return An Error To Requeue With Backoff, Not Panic)
+	var finalizeErr error
+	assert.NotPanics(t, func() { finalizeErr = reconciler.FinalizeKind(context.TODO(), controllertesting.NewKafkaChannel()) })
+	assert.NotNil(t, finalizeErr)
+	assert.Equal(t, constants.FinalizationFailedError, finalizeErr.Error())
+}
+
+// Test The Reconciler's Nil Kafka AdminClient Guards
+func TestNilKafkaAdminClientGuards(t *testing.T) {
+
+	// Create A Reconciler To Test (Without A Kafka AdminClient)
+	reconciler := &Reconciler{
+		logger: logtesting.TestLogger(t).Desugar(),
+		config: controllertesting.NewConfig(),
+	}
+	ctx := controller.WithEventRecorder(context.TODO(), record.NewFakeRecorder(10))
+	channel := controllertesting.NewKafkaChannel()
+
+	// Verify The Guards Return Errors / Empty Values Rather Than Panicking
+	assert.Empty(t, reconciler.adminClientKafkaSecretName(util.TopicName(channel)))
+	assert.Empty(t, reconciler.kafkaSecretName(channel))
+	err := reconciler.reconcileKafkaTopic(ctx, channel)
+	assert.NotNil(t, err)
+	assert.Equal(t, constants.KafkaAdminClientUnavailableError, err.Error())
+	err = reconciler.finalizeKafkaTopic(ctx, channel)
+	assert.NotNil(t, err)
+	assert.Equal(t, constants.KafkaAdminClientUnavailableError, err.Error())
+}
+
+// Test The Reconciler's SetKafkaAdminClient() Functionality With AdminClient Pooling Enabled
+func TestSetKafkaAdminClientPooled(t *testing.T) {
+
+	// Test Data
+	clientType := kafkaadmin.Kafka
+	ctx := context.WithValue(context.TODO(), kubeclient.Key{}, fake.NewSimpleClientset())
+
+	// Create A Test Logger
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Create A Mock AdminClient
+	mockAdminClient := &controllertesting.MockAdminClient{}
+
+	// Mock The Creation Of Kafka ClusterAdmin (Counting Creations)
+	createCount := 0
+	newKafkaAdminClientWrapperPlaceholder := kafkaadmin.NewKafkaAdminClientWrapper
+	kafkaadmin.NewKafkaAdminClientWrapper = func(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (kafkaadmin.AdminClientInterface, error) {
+		createCount++
+		return mockAdminClient, nil
+	}
+	defer func() {
+		kafkaadmin.NewKafkaAdminClientWrapper = newKafkaAdminClientWrapperPlaceholder
+	}()
+
+	// Create A Reconciler To Test
+	reconciler := &Reconciler{
+		logger:          logger,
+		adminClientType: clientType,
+		adminClientPool: kafkaadmin.NewAdminClientPool(logger, constants.ControllerComponentName, clientType, time.Minute),
+	}
+
+	// Perform The Test (Twice, Simulating Two Reconciliations)
+	reconciler.SetKafkaAdminClient(ctx, controllertesting.NewKafkaChannel())
+	pooledAdminClient := reconciler.adminClient
+	reconciler.ClearKafkaAdminClient()
+	reconciler.SetKafkaAdminClient(ctx, controllertesting.NewKafkaChannel())
+
+	// Verify Results
+	assert.NotNil(t, reconciler.adminClient)
+	assert.Same(t, pooledAdminClient, reconciler.adminClient)
+	assert.Equal(t, 1, createCount)
+	assert.False(t, mockAdminClient.CloseCalled())
+
+	// Verify Closing The Pool Closes The Underlying AdminClient
+	assert.Nil(t, reconciler.adminClientPool.Close())
+	assert.True(t, mockAdminClient.CloseCalled())
 }
 
 // Test The Reconciler's ClearKafkaAdminClient() Functionality
@@ -112,6 +330,319 @@ func TestClearKafkaAdminClient(t *testing.T) {
 	assert.True(t, mockAdminClient.CloseCalled())
 }
 
+// Test The Concurrent Channel & Dispatcher Reconciliation Matches The Sequential Reconciliation
+func TestReconcileChannelAndDispatcher(t *testing.T) {
+
+	// Utility Function For Creating A Reconciler With The Existing Channel & Dispatcher Resources
+	newReconciler := func(concurrent bool) *Reconciler {
+		objects := []runtime.Object{
+			controllertesting.NewKafkaChannelService(),
+			controllertesting.NewKafkaChannelDispatcherService(),
+			controllertesting.NewKafkaChannelDispatcherDeployment(),
+		}
+		listers := controllertesting.NewListers(objects)
+		return &Reconciler{
+			logger:                   logtesting.TestLogger(t).Desugar(),
+			kubeClientset:            fake.NewSimpleClientset(objects...),
+			adminClient:              &controllertesting.MockAdminClient{},
+			environment:              controllertesting.NewEnvironment(),
+			config:                   controllertesting.NewConfig(),
+			serviceLister:            listers.GetServiceLister(),
+			deploymentLister:         listers.GetDeploymentLister(),
+			concurrentReconciliation: concurrent,
+		}
+	}
+
+	// Perform The Sequential & Concurrent Reconciliations
+	ctx := controller.WithEventRecorder(context.TODO(), record.NewFakeRecorder(10))
+	sequentialChannel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	sequentialErr := newReconciler(false).reconcileChannelAndDispatcher(ctx, sequentialChannel)
+	concurrentChannel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	concurrentErr := newReconciler(true).reconcileChannelAndDispatcher(ctx, concurrentChannel)
+
+	// Verify The Results Are Identical
+	assert.Nil(t, sequentialErr)
+	assert.Nil(t, concurrentErr)
+	assert.Equal(t, sequentialChannel.Status.Address, concurrentChannel.Status.Address)
+	assert.NotNil(t, concurrentChannel.Status.Address)
+	for _, conditionType := range []apis.ConditionType{
+		kafkav1beta1.KafkaChannelConditionChannelServiceReady,
+		kafkav1beta1.KafkaChannelConditionAddressable,
+		kafkav1beta1.KafkaChannelConditionDispatcherReady,
+		kafkav1beta1.KafkaChannelConditionReady,
+	} {
+		sequentialCondition := sequentialChannel.Status.GetCondition(conditionType)
+		concurrentCondition := concurrentChannel.Status.GetCondition(conditionType)
+		assert.NotNil(t, concurrentCondition)
+		assert.Equal(t, sequentialCondition.Status, concurrentCondition.Status, conditionType)
+		assert.Equal(t, sequentialCondition.Reason, concurrentCondition.Reason, conditionType)
+		assert.Equal(t, sequentialCondition.Message, concurrentCondition.Message, conditionType)
+	}
+	assert.True(t, concurrentChannel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionChannelServiceReady).IsTrue())
+}
+
+// Test The Concurrent Channel & Dispatcher Reconciliation Propagates Both Errors
+func TestReconcileChannelAndDispatcherErrors(t *testing.T) {
+
+	// Create A Fake K8S Client Which Fails To Create The (Missing) KafkaChannel Service
+	kubeClientset := fake.NewSimpleClientset()
+	kubeClientset.PrependReactor("create", "services", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New(controllertesting.ErrorString)
+	})
+
+	// Initialize The Reconciler With An Environment Lacking The Dispatcher Image
+	environment := controllertesting.NewEnvironment()
+	environment.DispatcherImage = ""
+	listers := controllertesting.NewListers([]runtime.Object{})
+	r := &Reconciler{
+		logger:                   logtesting.TestLogger(t).Desugar(),
+		kubeClientset:            kubeClientset,
+		adminClient:              &controllertesting.MockAdminClient{},
+		environment:              environment,
+		config:                   controllertesting.NewConfig(),
+		serviceLister:            listers.GetServiceLister(),
+		deploymentLister:         listers.GetDeploymentLister(),
+		concurrentReconciliation: true,
+	}
+
+	// Perform The Test
+	ctx := controller.WithEventRecorder(context.TODO(), record.NewFakeRecorder(10))
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	err := r.reconcileChannelAndDispatcher(ctx, channel)
+
+	// Verify Both Errors Are Returned & Both Failures Are Reflected In The KafkaChannel Status
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "failed to reconcile channel resources")
+	assert.Contains(t, err.Error(), env.DispatcherImageEnvVarKey)
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionChannelServiceReady).IsFalse())
+	dispatcherCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	assert.True(t, dispatcherCondition.IsFalse())
+	assert.Equal(t, event.DispatcherImageInvalid.String(), dispatcherCondition.Reason)
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionReady).IsFalse())
+}
+
+// Test That All Logging Of A Reconciliation Shares The Channel & The TraceId Of The Knative Logger In The Context
+func TestReconcileLoggerTraceId(t *testing.T) {
+
+	// Mock The Failed Creation Of Kafka ClusterAdmin
+	newKafkaAdminClientWrapperPlaceholder := kafkaadmin.NewKafkaAdminClientWrapper
+	kafkaadmin.NewKafkaAdminClientWrapper = func(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (kafkaadmin.AdminClientInterface, error) {
+		return nil, errors.New(controllertesting.ErrorString)
+	}
+	defer func() {
+		kafkaadmin.NewKafkaAdminClientWrapper = newKafkaAdminClientWrapperPlaceholder
+	}()
+
+	// Create A Reconciler With An Observed Logger & The Existing Channel & Dispatcher Resources
+	observedCore, observedLogs := observer.New(zapcore.DebugLevel)
+	objects := []runtime.Object{
+		controllertesting.NewKafkaChannelService(),
+		controllertesting.NewKafkaChannelDispatcherService(),
+		controllertesting.NewKafkaChannelDispatcherDeployment(),
+	}
+	listers := controllertesting.NewListers(objects)
+	r := &Reconciler{
+		logger:           zap.New(observedCore),
+		kubeClientset:    fake.NewSimpleClientset(objects...),
+		adminClient:      &controllertesting.MockAdminClient{},
+		adminClientType:  kafkaadmin.Kafka,
+		environment:      controllertesting.NewEnvironment(),
+		config:           controllertesting.NewConfig(),
+		serviceLister:    listers.GetServiceLister(),
+		deploymentLister: listers.GetDeploymentLister(),
+		adminMutex:       &sync.Mutex{},
+	}
+
+	// Utility Function For Verifying All Logged Entries Share The Channel & The Specified TraceId
+	// Ignoring The Reconciler-Level Kafka AdminClient Logging, Which Is Not Specific To Any KafkaChannel
+	verifyLogs := func(traceId string) {
+		entries := observedLogs.TakeAll()
+		assert.NotEmpty(t, entries)
+		for _, entry := range entries {
+			if entry.Message == "Failed To Create Kafka AdminClient" {
+				continue
+			}
+			fields := entry.ContextMap()
+			assert.Equal(t, controllertesting.KafkaChannelNamespace+"/"+controllertesting.KafkaChannelName, fields["Channel"], entry.Message)
+			assert.Equal(t, traceId, fields[logkey.TraceID], entry.Message)
+		}
+	}
+
+	// Utility Function For Creating A Reconciliation Context As The Knative Controller Does (Logger With A TraceId)
+	newReconcileContext := func(traceId string) context.Context {
+		ctx := controller.WithEventRecorder(context.TODO(), record.NewFakeRecorder(10))
+		return logging.WithLogger(ctx, zap.New(observedCore).With(zap.String(logkey.TraceID, traceId)).Sugar())
+	}
+
+	// Verify The Channel & Dispatcher Reconciliation Logs With The Reconciliation's Logger
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	assert.Nil(t, r.reconcileChannelAndDispatcher(newReconcileContext("TestTraceId1"), channel))
+	verifyLogs("TestTraceId1")
+
+	// Verify ReconcileKind() Logs With The TraceId Of Its Own Reconciliation
+	assert.NotNil(t, r.ReconcileKind(newReconcileContext("TestTraceId2"), controllertesting.NewKafkaChannel()))
+	verifyLogs("TestTraceId2")
+}
+
+// Test The Reconciler's kafkaTopicReconciliationError() Requeue Behavior For The Different Kinds Of Kafka Errors
+func TestKafkaTopicReconciliationError(t *testing.T) {
+
+	// Test Data
+	requeueDelayMillis := int64(5000)
+	requeueJitterFactor := 0.5
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name              string
+		err               error
+		delayMillis       int64
+		expectedPermanent bool
+		expectedRequeue   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Permanent Kafka Error", err: adminutil.NewTopicError(sarama.ErrInvalidReplicationFactor, "invalid"), delayMillis: requeueDelayMillis, expectedPermanent: true},
+		{name: "Transient Kafka Error With Requeue Delay", err: adminutil.NewTopicError(sarama.ErrRequestTimedOut, "timeout"), delayMillis: requeueDelayMillis, expectedPermanent: true, expectedRequeue: true},
+		{name: "Transient Kafka Error Without Requeue Delay", err: adminutil.NewUnknownTopicError(sarama.ErrOutOfBrokers.Error()), delayMillis: 0},
+		{name: "Unclassified Kafka Error", err: adminutil.NewUnknownTopicError(controllertesting.ErrorString), delayMillis: requeueDelayMillis},
+		{name: "Wrapped Authorization Admin Error", err: adminutil.WrapTopicError(adminutil.NewTopicError(sarama.ErrGroupAuthorizationFailed, "denied")), delayMillis: requeueDelayMillis, expectedPermanent: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Reconciler To Test (Tracking Any Requeue)
+			var requeueKey types.NamespacedName
+			var requeueDelay time.Duration
+			configuration := controllertesting.NewConfig()
+			configuration.Kafka.TransientErrorRequeue = commonconfig.EKRequeueConfig{DelayMillis: testCase.delayMillis, JitterFactor: requeueJitterFactor}
+			reconciler := &Reconciler{
+				logger: logtesting.TestLogger(t).Desugar(),
+				config: configuration,
+				enqueueKeyAfter: func(key types.NamespacedName, delay time.Duration) {
+					requeueKey = key
+					requeueDelay = delay
+				},
+			}
+
+			// Perform The Test
+			channel := controllertesting.NewKafkaChannel()
+			err := reconciler.kafkaTopicReconciliationError(channel, testCase.err)
+
+			// Verify The Results
+			assert.NotNil(t, err)
+			assert.Equal(t, constants.ReconciliationFailedError, err.Error())
+			assert.Equal(t, testCase.expectedPermanent, controller.IsPermanentError(err))
+			if testCase.expectedRequeue {
+				assert.Equal(t, types.NamespacedName{Namespace: channel.Namespace, Name: channel.Name}, requeueKey)
+				minDelay := time.Duration(requeueDelayMillis) * time.Millisecond
+				assert.GreaterOrEqual(t, int64(requeueDelay), int64(minDelay))
+				assert.LessOrEqual(t, int64(requeueDelay), int64(float64(minDelay)*(1+requeueJitterFactor)))
+			} else {
+				assert.Empty(t, requeueKey.Name)
+				assert.Zero(t, requeueDelay)
+			}
+		})
+	}
+}
+
+// Test The Reconciler's reconcileInsecureSkipVerifyStatus() Functionality
+func TestReconcileInsecureSkipVerifyStatus(t *testing.T) {
+
+	// Verify The Status Annotation Is Set When TLS Verification Is Disabled
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Net.TLS.Config = &tls.Config{InsecureSkipVerify: true}
+	reconciler := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), saramaConfig: saramaConfig}
+	channel := controllertesting.NewKafkaChannel()
+	reconciler.reconcileInsecureSkipVerifyStatus(channel)
+	assert.Equal(t, "true", channel.Status.Annotations[constants.TLSInsecureSkipVerifyAnnotation])
+
+	// Verify The Status Annotation Is Removed When TLS Verification Is Re-Enabled
+	reconciler.saramaConfig = sarama.NewConfig()
+	reconciler.reconcileInsecureSkipVerifyStatus(channel)
+	assert.NotContains(t, channel.Status.Annotations, constants.TLSInsecureSkipVerifyAnnotation)
+
+	// Verify A Nil Sarama Config Is Treated As Verified
+	reconciler.saramaConfig = nil
+	reconciler.reconcileInsecureSkipVerifyStatus(channel)
+	assert.NotContains(t, channel.Status.Annotations, constants.TLSInsecureSkipVerifyAnnotation)
+}
+
+// Test The Reconciler's configMapObserver() Nil Guards (Nil ConfigMap & Nil Reconciler) Do Not Panic
+func TestConfigMapObserverNilGuards(t *testing.T) {
+
+	// Verify A Nil ConfigMap Is Ignored
+	reconciler := &Reconciler{logger: logtesting.TestLogger(t).Desugar()}
+	assert.NotPanics(t, func() { reconciler.configMapObserver(nil) })
+	assert.NotPanics(t, func() { reconciler.rootCAConfigMapObserver(nil) })
+	assert.Nil(t, reconciler.saramaConfig)
+
+	// Verify A Nil Reconciler Is Ignored (Startup Race)
+	var nilReconciler *Reconciler
+	configMap := commontesting.GetTestSaramaConfigMap(commontesting.OldSaramaConfig, commontesting.TestEKConfig)
+	assert.NotPanics(t, func() { nilReconciler.configMapObserver(configMap) })
+	assert.NotPanics(t, func() { nilReconciler.configMapObserver(nil) })
+	assert.NotPanics(t, func() { nilReconciler.rootCAConfigMapObserver(nil) })
+}
+
+// Test The Reconciler's configMapObserver() Only Resyncs KafkaChannels When The Sarama Settings Change
+func TestConfigMapObserverConfigHash(t *testing.T) {
+
+	// Create A Reconciler To Test (Counting KafkaChannel Resyncs)
+	resyncCount := 0
+	reconciler := &Reconciler{
+		logger:         logtesting.TestLogger(t).Desugar(),
+		resyncChannels: func() { resyncCount++ },
+	}
+
+	// Verify The Initial Sarama Settings Set The ConfigHash & Resync
+	configMap := commontesting.GetTestSaramaConfigMap(commontesting.OldSaramaConfig, commontesting.TestEKConfig)
+	reconciler.configMapObserver(configMap)
+	initialConfigHash := reconciler.saramaConfigHash
+	assert.NotEmpty(t, initialConfigHash)
+	assert.Equal(t, 1, resyncCount)
+
+	// Verify Unchanged Sarama Settings & Trivial Eventing-Kafka Changes Do Not Resync
+	reconciler.configMapObserver(configMap)
+	configMap.Data[commonconfig.EventingKafkaSettingsConfigKey] = commontesting.TestEKConfig + "kafka:\n  enableSaramaLogging: true\n"
+	reconciler.configMapObserver(configMap)
+	assert.Equal(t, initialConfigHash, reconciler.saramaConfigHash)
+	assert.Equal(t, 1, resyncCount)
+
+	// Verify Meaningful Sarama Changes (Consumer Tuning) Update The ConfigHash & Resync
+	configMap.Data[commontesting.SaramaSettingsConfigKey] = commontesting.OldSaramaConfig + "Consumer:\n  Fetch:\n    Max: 1048576\n"
+	reconciler.configMapObserver(configMap)
+	assert.NotEqual(t, initialConfigHash, reconciler.saramaConfigHash)
+	assert.Equal(t, 2, resyncCount)
+	kafkasarama.EnableSaramaLogging(false)
+}
+
+// Test The Reconciler's configMapObserver() Retains The Previous Sarama Configuration When The Updated Settings Are Invalid
+func TestConfigMapObserverInvalidSaramaSettings(t *testing.T) {
+
+	// Create A Reconciler To Test With The Initial (Valid) Sarama Settings
+	recorder := record.NewFakeRecorder(1)
+	reconciler := &Reconciler{
+		logger:        logtesting.TestLogger(t).Desugar(),
+		eventRecorder: recorder,
+	}
+	configMap := commontesting.GetTestSaramaConfigMap(commontesting.OldSaramaConfig, commontesting.TestEKConfig)
+	reconciler.configMapObserver(configMap)
+	initialSaramaConfig := reconciler.saramaConfig
+	initialConfigHash := reconciler.saramaConfigHash
+	assert.NotNil(t, initialSaramaConfig)
+
+	// Verify Invalid Sarama Settings Are Rejected With A Warning Event (Rather Than Terminating The Controller)
+	configMap.Data[commontesting.SaramaSettingsConfigKey] = commontesting.OldSaramaConfig + "Version: INVALID\n"
+	assert.NotPanics(t, func() { reconciler.configMapObserver(configMap) })
+	assert.Same(t, initialSaramaConfig, reconciler.saramaConfig)
+	assert.Equal(t, initialConfigHash, reconciler.saramaConfigHash)
+	assert.Contains(t, <-recorder.Events, event.SaramaSettingsInvalid.String())
+	kafkasarama.EnableSaramaLogging(false)
+}
+
 // Test The Reconcile Functionality
 func TestReconcile(t *testing.T) {
 
@@ -160,31 +691,154 @@ func TestReconcile(t *testing.T) {
 					Object: controllertesting.NewKafkaChannel(
 						controllertesting.WithAddress,
 						controllertesting.WithInitializedConditions,
+						controllertesting.WithConnectionReady,
+						controllertesting.WithKafkaChannelServiceReady,
+						controllertesting.WithDispatcherDeploymentReady,
+						controllertesting.WithTopicReady,
+					),
+				},
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				controllertesting.NewKafkaChannelLabelUpdate(
+					controllertesting.NewKafkaChannel(
+						controllertesting.WithFinalizer,
+						controllertesting.WithMetaData,
+						controllertesting.WithAddress,
+						controllertesting.WithInitializedConditions,
+						controllertesting.WithConnectionReady,
+						controllertesting.WithKafkaChannelServiceReady,
+						controllertesting.WithDispatcherDeploymentReady,
+						controllertesting.WithTopicReady,
+					),
+				),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{controllertesting.NewFinalizerPatchActionImpl()},
+			WantEvents: []string{
+				controllertesting.NewKafkaChannelFinalizerUpdateEvent(),
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
+				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
+			},
+		},
+
+		{
+			Name:                    "Complete Reconciliation Success With Dead Letter Sink",
+			SkipNamespaceValidation: true,
+			Key:                     controllertesting.KafkaChannelKey,
+			Objects: []runtime.Object{
+				controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions, controllertesting.WithDeadLetterSink),
+			},
+			WantCreates: []runtime.Object{
+				controllertesting.NewKafkaChannelService(),
+				controllertesting.NewKafkaChannelDispatcherService(),
+				controllertesting.NewKafkaChannelDispatcherDeployment(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: controllertesting.NewKafkaChannel(
+						controllertesting.WithDeadLetterSink,
+						controllertesting.WithAddress,
+						controllertesting.WithInitializedConditions,
+						controllertesting.WithConnectionReady,
 						controllertesting.WithKafkaChannelServiceReady,
 						controllertesting.WithDispatcherDeploymentReady,
 						controllertesting.WithTopicReady,
+						controllertesting.WithDeadLetterSinkResolved,
 					),
 				},
 			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{
 				controllertesting.NewKafkaChannelLabelUpdate(
 					controllertesting.NewKafkaChannel(
+						controllertesting.WithDeadLetterSink,
 						controllertesting.WithFinalizer,
 						controllertesting.WithMetaData,
 						controllertesting.WithAddress,
 						controllertesting.WithInitializedConditions,
+						controllertesting.WithConnectionReady,
 						controllertesting.WithKafkaChannelServiceReady,
 						controllertesting.WithDispatcherDeploymentReady,
 						controllertesting.WithTopicReady,
+						controllertesting.WithDeadLetterSinkResolved,
 					),
 				),
 			},
 			WantPatches: []clientgotesting.PatchActionImpl{controllertesting.NewFinalizerPatchActionImpl()},
 			WantEvents: []string{
 				controllertesting.NewKafkaChannelFinalizerUpdateEvent(),
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
 				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
 			},
 		},
+		{
+			Name:                    "Reconcile Missing Selected Kafka Secret",
+			SkipNamespaceValidation: true,
+			Key:                     controllertesting.KafkaChannelKey,
+			Objects: []runtime.Object{
+				controllertesting.NewKafkaChannel(
+					controllertesting.WithFinalizer,
+					controllertesting.WithMetaData,
+					controllertesting.WithMissingKafkaSecretAnnotation,
+					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
+				),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: controllertesting.NewKafkaChannel(
+						controllertesting.WithFinalizer,
+						controllertesting.WithMetaData,
+						controllertesting.WithMissingKafkaSecretAnnotation,
+						controllertesting.WithInitializedConditions,
+						controllertesting.WithConnectionReady,
+						controllertesting.WithKafkaSecretNotFound,
+					),
+				},
+			},
+			WantErr: true,
+			WantEvents: []string{
+				Eventf(corev1.EventTypeWarning, event.KafkaSecretNotFound.String(), "Selected Kafka Secret %s Not Found", controllertesting.MissingKafkaSecretName),
+				controllertesting.NewKafkaChannelFailedReconciliationEvent(),
+			},
+		},
+		{
+			Name:                    "Reconcile Unresolvable Dead Letter Sink",
+			SkipNamespaceValidation: true,
+			Key:                     controllertesting.KafkaChannelKey,
+			Objects: []runtime.Object{
+				controllertesting.NewKafkaChannel(
+					controllertesting.WithFinalizer,
+					controllertesting.WithMetaData,
+					controllertesting.WithInvalidDeadLetterSink,
+					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
+				),
+				controllertesting.NewKafkaChannelService(),
+				controllertesting.NewKafkaChannelDispatcherService(),
+				controllertesting.NewKafkaChannelDispatcherDeployment(),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: controllertesting.NewKafkaChannel(
+						controllertesting.WithFinalizer,
+						controllertesting.WithMetaData,
+						controllertesting.WithInvalidDeadLetterSink,
+						controllertesting.WithAddress,
+						controllertesting.WithInitializedConditions,
+						controllertesting.WithConnectionReady,
+						controllertesting.WithKafkaChannelServiceReady,
+						controllertesting.WithDispatcherDeploymentReady,
+						controllertesting.WithTopicReady,
+						controllertesting.WithDeadLetterSinkResolutionFailed,
+					),
+				},
+			},
+			WantErr: true,
+			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
+				Eventf(corev1.EventTypeWarning, event.DeadLetterSinkResolutionFailed.String(), "Failed To Resolve Dead Letter Sink: %s", controllertesting.NewDeadLetterSinkResolutionError()),
+				controllertesting.NewKafkaChannelFailedReconciliationEvent(),
+			},
+		},
 
 		//
 		// KafkaChannel Deletion (Finalizer)
@@ -211,6 +865,7 @@ func TestReconcile(t *testing.T) {
 				controllertesting.NewDeploymentDeleteActionImpl(controllertesting.NewKafkaChannelDispatcherDeployment(controllertesting.WithoutFinalizersDeployment)),
 			},
 			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditDeletedEvent(),
 				controllertesting.NewKafkaChannelSuccessfulFinalizedEvent(),
 			},
 		},
@@ -225,6 +880,23 @@ func TestReconcile(t *testing.T) {
 				),
 			},
 			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditDeletedEvent(),
+				controllertesting.NewKafkaChannelSuccessfulFinalizedEvent(),
+			},
+		},
+		{
+			Name: "Finalize Deleted KafkaChannel With Retained Topic",
+			Key:  controllertesting.KafkaChannelKey,
+			Objects: []runtime.Object{
+				controllertesting.NewKafkaChannel(
+					controllertesting.WithInitializedConditions,
+					controllertesting.WithLabels,
+					controllertesting.WithRetainTopicAnnotation,
+					controllertesting.WithDeletionTimestamp,
+				),
+			},
+			WantEvents: []string{
+				Eventf(corev1.EventTypeNormal, event.TopicRetained.String(), "Retained Kafka Topic %s", controllertesting.TopicName),
 				controllertesting.NewKafkaChannelSuccessfulFinalizedEvent(),
 			},
 		},
@@ -275,6 +947,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithMetaData,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithDispatcherDeploymentReady,
 					controllertesting.WithTopicReady,
@@ -283,7 +956,10 @@ func TestReconcile(t *testing.T) {
 				controllertesting.NewKafkaChannelDispatcherDeployment(),
 			},
 			WantCreates: []runtime.Object{controllertesting.NewKafkaChannelService()},
-			WantEvents:  []string{controllertesting.NewKafkaChannelSuccessfulReconciliationEvent()},
+			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
+				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
+			},
 		},
 		{
 			Name:                    "Reconcile Missing KafkaChannel Service Error(Create)",
@@ -294,6 +970,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithFinalizer,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithDispatcherDeploymentReady,
 					controllertesting.WithTopicReady,
@@ -310,6 +987,7 @@ func TestReconcile(t *testing.T) {
 						controllertesting.WithFinalizer,
 						controllertesting.WithAddress,
 						controllertesting.WithInitializedConditions,
+						controllertesting.WithConnectionReady,
 						controllertesting.WithKafkaChannelServiceFailed,
 						controllertesting.WithDispatcherDeploymentReady,
 						controllertesting.WithTopicReady,
@@ -317,6 +995,7 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
 				Eventf(corev1.EventTypeWarning, event.KafkaChannelServiceReconciliationFailed.String(), "Failed To Reconcile KafkaChannel Service: inducing failure for create services"),
 				controllertesting.NewKafkaChannelFailedReconciliationEvent(),
 			},
@@ -331,6 +1010,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithMetaData,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithDispatcherDeploymentReady,
 					controllertesting.WithTopicReady,
@@ -343,6 +1023,7 @@ func TestReconcile(t *testing.T) {
 			},
 			WantErr: true,
 			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
 				Eventf(corev1.EventTypeWarning, event.KafkaChannelServiceReconciliationFailed.String(), "Failed To Reconcile KafkaChannel Service: encountered KafkaChannel Service with DeletionTimestamp kafkachannel-namespace/kafkachannel-name-kn-channel - potential race condition"),
 				controllertesting.NewKafkaChannelFailedReconciliationEvent(),
 			},
@@ -362,6 +1043,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithMetaData,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithReceiverServiceReady,
 					controllertesting.WithReceiverDeploymentReady,
@@ -374,7 +1056,10 @@ func TestReconcile(t *testing.T) {
 				controllertesting.NewKafkaChannelDispatcherDeployment(),
 			},
 			WantCreates: []runtime.Object{controllertesting.NewKafkaChannelDispatcherService()},
-			WantEvents:  []string{controllertesting.NewKafkaChannelSuccessfulReconciliationEvent()},
+			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
+				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
+			},
 		},
 		{
 			Name:                    "Reconcile Missing Dispatcher Service Error(Create)",
@@ -386,6 +1071,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithMetaData,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithReceiverServiceReady,
 					controllertesting.WithReceiverDeploymentReady,
@@ -404,6 +1090,7 @@ func TestReconcile(t *testing.T) {
 				// Note - Not currently tracking status for the Dispatcher Service since it is only for Prometheus
 			},
 			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
 				Eventf(corev1.EventTypeWarning, event.DispatcherServiceReconciliationFailed.String(), "Failed To Reconcile Dispatcher Service: inducing failure for create services"),
 				controllertesting.NewKafkaChannelFailedReconciliationEvent(),
 			},
@@ -418,6 +1105,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithMetaData,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithDispatcherDeploymentReady,
 					controllertesting.WithTopicReady,
@@ -430,6 +1118,7 @@ func TestReconcile(t *testing.T) {
 			},
 			WantErr: false,
 			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
 				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
 			},
 		},
@@ -443,6 +1132,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithMetaData,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithDispatcherDeploymentReady,
 					controllertesting.WithTopicReady,
@@ -455,6 +1145,7 @@ func TestReconcile(t *testing.T) {
 			},
 			WantErr: false,
 			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
 				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
 			},
 		},
@@ -473,6 +1164,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithMetaData,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithReceiverServiceReady,
 					controllertesting.WithReceiverDeploymentReady,
@@ -485,7 +1177,10 @@ func TestReconcile(t *testing.T) {
 				controllertesting.NewKafkaChannelDispatcherService(),
 			},
 			WantCreates: []runtime.Object{controllertesting.NewKafkaChannelDispatcherDeployment()},
-			WantEvents:  []string{controllertesting.NewKafkaChannelSuccessfulReconciliationEvent()},
+			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
+				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
+			},
 		},
 		{
 			Name:                    "Reconcile Missing Dispatcher Deployment Error(Create)",
@@ -497,6 +1192,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithMetaData,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithReceiverServiceReady,
 					controllertesting.WithReceiverDeploymentReady,
@@ -518,6 +1214,7 @@ func TestReconcile(t *testing.T) {
 						controllertesting.WithMetaData,
 						controllertesting.WithAddress,
 						controllertesting.WithInitializedConditions,
+						controllertesting.WithConnectionReady,
 						controllertesting.WithKafkaChannelServiceReady,
 						controllertesting.WithReceiverServiceReady,
 						controllertesting.WithReceiverDeploymentReady,
@@ -527,10 +1224,118 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
 				Eventf(corev1.EventTypeWarning, event.DispatcherDeploymentReconciliationFailed.String(), "Failed To Reconcile Dispatcher Deployment: inducing failure for create deployments"),
 				controllertesting.NewKafkaChannelFailedReconciliationEvent(),
 			},
 		},
+		{
+			Name:                    "Reconcile Dispatcher Deployment Resource Overrides Success(Update)",
+			SkipNamespaceValidation: true,
+			Key:                     controllertesting.KafkaChannelKey,
+			Objects: []runtime.Object{
+				controllertesting.NewKafkaChannel(
+					controllertesting.WithFinalizer,
+					controllertesting.WithMetaData,
+					controllertesting.WithDispatcherResourceAnnotations,
+					controllertesting.WithAddress,
+					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
+					controllertesting.WithKafkaChannelServiceReady,
+					controllertesting.WithDispatcherDeploymentReady,
+					controllertesting.WithTopicReady,
+				),
+				controllertesting.NewKafkaChannelService(),
+				controllertesting.NewKafkaChannelReceiverService(),
+				controllertesting.NewKafkaChannelReceiverDeployment(),
+				controllertesting.NewKafkaChannelDispatcherService(),
+				controllertesting.NewKafkaChannelDispatcherDeployment(),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				controllertesting.NewDeploymentUpdateActionImpl(controllertesting.NewKafkaChannelDispatcherDeployment(controllertesting.WithDispatcherResourceOverridesDeployment)),
+			},
+			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
+				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
+			},
+		},
+		{
+			Name:                    "Reconcile Dispatcher Deployment ConfigHash Success(Update)",
+			SkipNamespaceValidation: true,
+			Key:                     controllertesting.KafkaChannelKey,
+			Objects: []runtime.Object{
+				controllertesting.NewKafkaChannel(
+					controllertesting.WithFinalizer,
+					controllertesting.WithMetaData,
+					controllertesting.WithAddress,
+					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
+					controllertesting.WithKafkaChannelServiceReady,
+					controllertesting.WithDispatcherDeploymentReady,
+					controllertesting.WithTopicReady,
+				),
+				controllertesting.NewKafkaChannelService(),
+				controllertesting.NewKafkaChannelReceiverService(),
+				controllertesting.NewKafkaChannelReceiverDeployment(),
+				controllertesting.NewKafkaChannelDispatcherService(),
+				controllertesting.NewKafkaChannelDispatcherDeployment(controllertesting.WithStaleConfigHashDeployment),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				controllertesting.NewDeploymentUpdateActionImpl(controllertesting.NewKafkaChannelDispatcherDeployment()),
+			},
+			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
+				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
+			},
+		},
+		{
+			Name:                    "Reconcile Dispatcher Deployment Resource Overrides Error(Update)",
+			SkipNamespaceValidation: true,
+			Key:                     controllertesting.KafkaChannelKey,
+			Objects: []runtime.Object{
+				controllertesting.NewKafkaChannel(
+					controllertesting.WithFinalizer,
+					controllertesting.WithMetaData,
+					controllertesting.WithDispatcherResourceAnnotations,
+					controllertesting.WithAddress,
+					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
+					controllertesting.WithKafkaChannelServiceReady,
+					controllertesting.WithDispatcherDeploymentReady,
+					controllertesting.WithTopicReady,
+				),
+				controllertesting.NewKafkaChannelService(),
+				controllertesting.NewKafkaChannelReceiverService(),
+				controllertesting.NewKafkaChannelReceiverDeployment(),
+				controllertesting.NewKafkaChannelDispatcherService(),
+				controllertesting.NewKafkaChannelDispatcherDeployment(),
+			},
+			WithReactors: []clientgotesting.ReactionFunc{InduceFailure("update", "Deployments")},
+			WantErr:      true,
+			WantUpdates: []clientgotesting.UpdateActionImpl{
+				controllertesting.NewDeploymentUpdateActionImpl(controllertesting.NewKafkaChannelDispatcherDeployment(controllertesting.WithDispatcherResourceOverridesDeployment)),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: controllertesting.NewKafkaChannel(
+						controllertesting.WithFinalizer,
+						controllertesting.WithMetaData,
+						controllertesting.WithDispatcherResourceAnnotations,
+						controllertesting.WithAddress,
+						controllertesting.WithInitializedConditions,
+						controllertesting.WithConnectionReady,
+						controllertesting.WithKafkaChannelServiceReady,
+						controllertesting.WithDispatcherUpdateFailed,
+						controllertesting.WithTopicReady,
+					),
+				},
+			},
+			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
+				Eventf(corev1.EventTypeWarning, event.DispatcherDeploymentReconciliationFailed.String(), "Failed To Reconcile Dispatcher Deployment: inducing failure for update deployments"),
+				controllertesting.NewKafkaChannelFailedReconciliationEvent(),
+			},
+		},
 		{
 			Name:                    "Reconcile Dispatcher Deployment With Deletion Timestamp And Finalizer",
 			SkipNamespaceValidation: true,
@@ -541,6 +1346,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithMetaData,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithDispatcherDeploymentReady,
 					controllertesting.WithTopicReady,
@@ -553,6 +1359,7 @@ func TestReconcile(t *testing.T) {
 			},
 			WantErr: false,
 			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
 				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
 			},
 		},
@@ -566,6 +1373,7 @@ func TestReconcile(t *testing.T) {
 					controllertesting.WithMetaData,
 					controllertesting.WithAddress,
 					controllertesting.WithInitializedConditions,
+					controllertesting.WithConnectionReady,
 					controllertesting.WithKafkaChannelServiceReady,
 					controllertesting.WithDispatcherDeploymentReady,
 					controllertesting.WithTopicReady,
@@ -578,6 +1386,7 @@ func TestReconcile(t *testing.T) {
 			},
 			WantErr: false,
 			WantEvents: []string{
+				controllertesting.NewKafkaTopicAuditCreatedEvent(),
 				controllertesting.NewKafkaChannelSuccessfulReconciliationEvent(),
 			},
 		},
@@ -602,12 +1411,14 @@ func TestReconcile(t *testing.T) {
 			adminClient:          nil,
 			environment:          controllertesting.NewEnvironment(),
 			config:               controllertesting.NewConfig(),
+			saramaConfigHash:     controllertesting.DispatcherConfigHash,
 			kafkachannelLister:   listers.GetKafkaChannelLister(),
 			kafkachannelInformer: nil,
 			deploymentLister:     listers.GetDeploymentLister(),
 			serviceLister:        listers.GetServiceLister(),
 			kafkaClientSet:       fakekafkaclient.Get(ctx),
 			adminMutex:           &sync.Mutex{},
+			uriResolver:          resolver.NewURIResolver(addressable.WithDuck(ctx), func(types.NamespacedName) {}),
 		}
 		return kafkachannelreconciler.NewReconciler(ctx, r.logger.Sugar(), r.kafkaClientSet, listers.GetKafkaChannelLister(), controller.GetEventRecorder(ctx), r)
 	}, logger.Desugar()))
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Shopify/sarama"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+)
+
+//
+// Weighted Kafka Topic Replica Assignment
+//
+// Kafka's automatic replica assignment spreads the partitions of new Topics evenly across all brokers,
+// which is undesirable when the brokers have heterogeneous capacity.  When broker weights are configured
+// the replicas of new Topics are instead explicitly assigned such that each broker receives a share of
+// the replicas (and of the partition leaders) proportional to its weight.  Brokers with a zero weight
+// receive no replicas.  The assignment is deterministic for a given configuration, and only applies to
+// Topic creation (partitions added to existing Topics are still automatically assigned by Kafka).
+//
+
+// Apply Any Configured Weighted Replica Assignment To The Specified TopicDetail (Unchanged For Automatic Assignment)
+func (r *Reconciler) assignTopicReplicas(topicDetail *sarama.TopicDetail) error {
+
+	// Only Kafka AdminClients Support Explicit Replica Assignment
+	if r.config == nil || len(r.config.Kafka.Topic.BrokerWeights) == 0 {
+		return nil
+	}
+	if adminType := r.config.Kafka.AdminType; adminType != "" && adminType != constants.KafkaAdminTypeValueKafka {
+		return nil
+	}
+
+	// Compute The Weighted Replica Assignment
+	replicaAssignment, err := weightedReplicaAssignment(r.config.Kafka.Topic.BrokerWeights, topicDetail.NumPartitions, topicDetail.ReplicationFactor)
+	if err != nil {
+		return err
+	}
+
+	// Kafka Requires The NumPartitions & ReplicationFactor To Be Unset (-1) When Specifying An Explicit Assignment
+	topicDetail.ReplicaAssignment = replicaAssignment
+	topicDetail.NumPartitions = -1
+	topicDetail.ReplicationFactor = -1
+	return nil
+}
+
+// Compute A Replica Assignment (Partition -> Broker IDs, Leader First) Proportional To The Specified Broker Weights
+func weightedReplicaAssignment(brokerWeights map[int32]int32, partitions int32, replicationFactor int16) (map[int32][]int32, error) {
+
+	// Validate The Requested Partitions & ReplicationFactor
+	if partitions <= 0 || replicationFactor <= 0 {
+		return nil, fmt.Errorf("unable to assign replicas for %d partitions with a replication factor of %d", partitions, replicationFactor)
+	}
+
+	// Determine The Eligible (Positively Weighted) Brokers In A Deterministic Order
+	brokerIds := make([]int32, 0, len(brokerWeights))
+	for brokerId, weight := range brokerWeights {
+		if weight > 0 {
+			brokerIds = append(brokerIds, brokerId)
+		}
+	}
+	sort.Slice(brokerIds, func(i, j int) bool { return brokerIds[i] < brokerIds[j] })
+	if int(replicationFactor) > len(brokerIds) {
+		return nil, fmt.Errorf("replication factor %d exceeds the %d brokers with a positive weight", replicationFactor, len(brokerIds))
+	}
+
+	// Assign Each Partition's Leader & Followers To The Distinct Brokers Furthest Below Their Weighted Share
+	leaderCounts := make(map[int32]int64, len(brokerIds))
+	replicaCounts := make(map[int32]int64, len(brokerIds))
+	replicaAssignment := make(map[int32][]int32, partitions)
+	for partition := int32(0); partition < partitions; partition++ {
+		replicas := make([]int32, 0, replicationFactor)
+		for replica := 0; replica < int(replicationFactor); replica++ {
+			counts := replicaCounts
+			if replica == 0 {
+				counts = leaderCounts
+			}
+			brokerId := leastLoadedBroker(brokerIds, brokerWeights, counts, replicas)
+			replicas = append(replicas, brokerId)
+			replicaCounts[brokerId]++
+			if replica == 0 {
+				leaderCounts[brokerId]++
+			}
+		}
+		replicaAssignment[partition] = replicas
+	}
+	return replicaAssignment, nil
+}
+
+// Select The Broker (Not Already Assigned) With The Lowest Weighted Load, Preferring The Lowest Broker ID On Ties
+func leastLoadedBroker(brokerIds []int32, brokerWeights map[int32]int32, counts map[int32]int64, assigned []int32) int32 {
+	selected := int32(-1)
+	for _, brokerId := range brokerIds {
+		if containsBroker(assigned, brokerId) {
+			continue
+		}
+		// Compare (count + 1) / weight Without Floating Point Division
+		if selected < 0 || (counts[brokerId]+1)*int64(brokerWeights[selected]) < (counts[selected]+1)*int64(brokerWeights[brokerId]) {
+			selected = brokerId
+		}
+	}
+	return selected
+}
+
+// Determine Whether The Specified Broker ID Is In The Specified Slice
+func containsBroker(brokerIds []int32, brokerId int32) bool {
+	for _, id := range brokerIds {
+		if id == brokerId {
+			return true
+		}
+	}
+	return false
+}
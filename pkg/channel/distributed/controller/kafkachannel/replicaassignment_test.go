@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The weightedReplicaAssignment() Functionality
+func TestWeightedReplicaAssignment(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name              string
+		brokerWeights     map[int32]int32
+		partitions        int32
+		replicationFactor int16
+		wantLeaders       map[int32]int
+		wantReplicas      map[int32]int
+		wantErr           bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:              "Equal Weights",
+			brokerWeights:     map[int32]int32{1: 1, 2: 1, 3: 1},
+			partitions:        6,
+			replicationFactor: 2,
+			wantLeaders:       map[int32]int{1: 2, 2: 2, 3: 2},
+			wantReplicas:      map[int32]int{1: 4, 2: 4, 3: 4},
+		},
+		{
+			name:              "Uneven Weights Single Replica",
+			brokerWeights:     map[int32]int32{1: 3, 2: 1},
+			partitions:        8,
+			replicationFactor: 1,
+			wantLeaders:       map[int32]int{1: 6, 2: 2},
+			wantReplicas:      map[int32]int{1: 6, 2: 2},
+		},
+		{
+			name:              "Uneven Weights Multiple Replicas",
+			brokerWeights:     map[int32]int32{0: 2, 1: 1, 2: 1},
+			partitions:        12,
+			replicationFactor: 2,
+			wantLeaders:       map[int32]int{0: 6, 1: 3, 2: 3},
+			wantReplicas:      map[int32]int{0: 12, 1: 6, 2: 6},
+		},
+		{
+			name:              "Zero Weight Broker Excluded",
+			brokerWeights:     map[int32]int32{1: 1, 2: 1, 3: 0},
+			partitions:        4,
+			replicationFactor: 2,
+			wantLeaders:       map[int32]int{1: 2, 2: 2},
+			wantReplicas:      map[int32]int{1: 4, 2: 4},
+		},
+		{
+			name:              "Replication Factor Exceeds Eligible Brokers",
+			brokerWeights:     map[int32]int32{1: 1, 2: 1, 3: 0},
+			partitions:        4,
+			replicationFactor: 3,
+			wantErr:           true,
+		},
+		{
+			name:              "Invalid Partitions",
+			brokerWeights:     map[int32]int32{1: 1},
+			partitions:        0,
+			replicationFactor: 1,
+			wantErr:           true,
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Perform The Test
+			replicaAssignment, err := weightedReplicaAssignment(testCase.brokerWeights, testCase.partitions, testCase.replicationFactor)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			if testCase.wantErr {
+				assert.Nil(t, replicaAssignment)
+				return
+			}
+			assert.Len(t, replicaAssignment, int(testCase.partitions))
+			leaders := make(map[int32]int)
+			replicas := make(map[int32]int)
+			for partition := int32(0); partition < testCase.partitions; partition++ {
+				partitionReplicas := replicaAssignment[partition]
+				assert.Len(t, partitionReplicas, int(testCase.replicationFactor))
+				leaders[partitionReplicas[0]]++
+				distinct := make(map[int32]bool)
+				for _, brokerId := range partitionReplicas {
+					assert.False(t, distinct[brokerId], "partition %d assigned broker %d more than once", partition, brokerId)
+					distinct[brokerId] = true
+					replicas[brokerId]++
+				}
+			}
+			assert.Equal(t, testCase.wantLeaders, leaders)
+			assert.Equal(t, testCase.wantReplicas, replicas)
+
+			// Verify The Assignment Is Deterministic
+			repeatedAssignment, err := weightedReplicaAssignment(testCase.brokerWeights, testCase.partitions, testCase.replicationFactor)
+			assert.Nil(t, err)
+			assert.Equal(t, replicaAssignment, repeatedAssignment)
+		})
+	}
+}
+
+// Test The createTopic() Functionality With & Without Configured Broker Weights
+func TestCreateTopicReplicaAssignment(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name           string
+		adminType      string
+		brokerWeights  map[int32]int32
+		wantAssignment bool
+		wantErr        bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Automatic Assignment Without Weights"},
+		{name: "Weighted Assignment", brokerWeights: map[int32]int32{1: 3, 2: 1}, wantAssignment: true},
+		{name: "Weighted Assignment Explicit Kafka AdminType", adminType: constants.KafkaAdminTypeValueKafka, brokerWeights: map[int32]int32{1: 3, 2: 1}, wantAssignment: true},
+		{name: "Weights Ignored For Azure AdminType", adminType: constants.KafkaAdminTypeValueAzure, brokerWeights: map[int32]int32{1: 3, 2: 1}},
+		{name: "Insufficient Weighted Brokers", brokerWeights: map[int32]int32{1: 1}, wantErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Initialize The Reconciler With A Mock AdminClient Which Captures The TopicDetail
+			var createdTopicDetail *sarama.TopicDetail
+			mockAdminClient := &controllertesting.MockAdminClient{
+				MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+					createdTopicDetail = topicDetail
+					return &sarama.TopicError{Err: sarama.ErrNoError}
+				},
+			}
+			config := controllertesting.NewConfig()
+			config.Kafka.AdminType = testCase.adminType
+			config.Kafka.Topic.BrokerWeights = testCase.brokerWeights
+			r := &Reconciler{
+				logger:      logtesting.TestLogger(t).Desugar(),
+				config:      config,
+				adminClient: mockAdminClient,
+			}
+
+			// Perform The Test
+			created, err := r.createTopic(context.TODO(), r.logger, "test-topic", 4, 2, 604800000, "delete", 0)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			assert.Equal(t, !testCase.wantErr, created)
+			if testCase.wantErr {
+				assert.Nil(t, createdTopicDetail)
+			} else if testCase.wantAssignment {
+				assert.Equal(t, int32(-1), createdTopicDetail.NumPartitions)
+				assert.Equal(t, int16(-1), createdTopicDetail.ReplicationFactor)
+				assert.Equal(t, map[int32][]int32{0: {1, 2}, 1: {1, 2}, 2: {1, 2}, 3: {2, 1}}, createdTopicDetail.ReplicaAssignment)
+			} else {
+				assert.Equal(t, int32(4), createdTopicDetail.NumPartitions)
+				assert.Equal(t, int16(2), createdTopicDetail.ReplicationFactor)
+				assert.Nil(t, createdTopicDetail.ReplicaAssignment)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"strconv"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonenv "knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+)
+
+//
+// Dispatcher KEDA ScaledObject (Optional)
+//
+// When enabled in the config-eventing-kafka ConfigMap, a KEDA ScaledObject is maintained alongside each
+// Dispatcher Deployment, with a "kafka" trigger for the ConsumerGroup of every Subscriber, so that KEDA
+// can scale the Dispatcher based on consumer lag.  Not all clusters run KEDA (and its types are not a
+// dependency of this project) so the ScaledObject is managed as an Unstructured resource via the
+// dynamic client, and only when the feature flag is set.
+//
+
+// The KEDA ScaledObject GroupVersionResource
+var scaledObjectGVR = schema.GroupVersionResource{Group: "keda.sh", Version: "v1alpha1", Resource: "scaledobjects"}
+
+// Reconcile The Dispatcher ScaledObject
+func (r *Reconciler) reconcileDispatcherScaledObject(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel) error {
+
+	// Remove Any Existing ScaledObject If KEDA Scaling Is Disabled, If There Are No Subscribers (ConsumerGroups) To Scale On, Or If Paused
+	if !r.dispatcherKedaEnabled() || len(channel.Spec.Subscribers) <= 0 || util.Paused(channel, logger) {
+		return r.finalizeDispatcherScaledObject(ctx, logger, channel)
+	}
+
+	// Create The Desired ScaledObject Model
+	desiredScaledObject := r.newDispatcherScaledObject(channel)
+	scaledObjects := r.dynamicClient.Resource(scaledObjectGVR).Namespace(desiredScaledObject.GetNamespace())
+
+	// Attempt To Get The Dispatcher ScaledObject Associated With The Specified Channel
+	scaledObject, err := scaledObjects.Get(ctx, desiredScaledObject.GetName(), metav1.GetOptions{})
+	if scaledObject == nil || err != nil {
+
+		// If The ScaledObject Was Not Found - Then Create A New One For The Channel
+		if errors.IsNotFound(err) {
+			logger.Info("Dispatcher ScaledObject Not Found - Creating New One")
+			_, err = scaledObjects.Create(ctx, desiredScaledObject, metav1.CreateOptions{})
+			if err != nil {
+				logger.Error("Failed To Create Dispatcher ScaledObject", zap.Error(err))
+				return err
+			} else {
+				logger.Info("Successfully Created Dispatcher ScaledObject")
+				return nil
+			}
+		} else {
+			logger.Error("Failed To Get Dispatcher ScaledObject For Reconciliation", zap.Error(err))
+			return err
+		}
+	}
+
+	// Nothing To Do If The Existing ScaledObject Is Already As Desired
+	if equality.Semantic.DeepEqual(scaledObject.Object["spec"], desiredScaledObject.Object["spec"]) &&
+		equality.Semantic.DeepEqual(scaledObject.GetLabels(), desiredScaledObject.GetLabels()) {
+		logger.Info("Successfully Verified Dispatcher ScaledObject")
+		return nil
+	}
+
+	// Otherwise Update The Existing ScaledObject (Subscribers Or KEDA Config Changed)
+	scaledObject = scaledObject.DeepCopy()
+	scaledObject.SetLabels(desiredScaledObject.GetLabels())
+	scaledObject.Object["spec"] = desiredScaledObject.Object["spec"]
+	_, err = scaledObjects.Update(ctx, scaledObject, metav1.UpdateOptions{})
+	if err != nil {
+		logger.Error("Failed To Update Dispatcher ScaledObject", zap.Error(err))
+		return err
+	} else {
+		logger.Info("Successfully Updated Dispatcher ScaledObject")
+		return nil
+	}
+}
+
+// Finalize The Dispatcher ScaledObject (Delete If Present)
+func (r *Reconciler) finalizeDispatcherScaledObject(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel) error {
+
+	// Nothing To Do If There Is No Dynamic Client (Unit Tests Of Unrelated Functionality)
+	if r.dynamicClient == nil {
+		return nil
+	}
+
+	// Delete The Dispatcher ScaledObject Associated With The Specified Channel
+	scaledObjectName := util.DispatcherDnsSafeName(channel)
+	err := r.dynamicClient.Resource(scaledObjectGVR).Namespace(commonconstants.KnativeEventingNamespace).Delete(ctx, scaledObjectName, metav1.DeleteOptions{})
+	if err != nil {
+
+		// If The ScaledObject Was Not Found (Or KEDA Is Not Installed) - Then Nothing To Do
+		if errors.IsNotFound(err) {
+			logger.Debug("Dispatcher ScaledObject Not Found - Nothing To Finalize")
+			return nil
+		} else {
+			logger.Error("Failed To Delete Dispatcher ScaledObject", zap.Error(err))
+			return err
+		}
+	}
+
+	// Return Success
+	logger.Info("Successfully Deleted Dispatcher ScaledObject")
+	return nil
+}
+
+// Create Dispatcher ScaledObject Model For The Specified Channel
+func (r *Reconciler) newDispatcherScaledObject(channel *kafkav1beta1.KafkaChannel) *unstructured.Unstructured {
+
+	// Get The Dispatcher Deployment Name & Kafka Topic For The Channel
+	deploymentName := util.DispatcherDnsSafeName(channel)
+	topicName := util.TopicName(channel)
+	kedaConfig := r.config.Dispatcher.Keda
+
+	// Create A Kafka Trigger For The ConsumerGroup Of Each Subscriber (Brokers Are Resolved From The Dispatcher Container's Env)
+	triggers := make([]interface{}, 0, len(channel.Spec.Subscribers))
+	for _, subscriber := range channel.Spec.Subscribers {
+		metadata := map[string]interface{}{
+			"bootstrapServersFromEnv": commonenv.KafkaBrokerEnvVarKey,
+			"consumerGroup":           commonkafkautil.ConsumerGroupId(channel.Namespace, channel.Name, string(subscriber.UID)),
+			"topic":                   topicName,
+		}
+		if kedaConfig.LagThreshold > 0 {
+			metadata["lagThreshold"] = strconv.FormatInt(kedaConfig.LagThreshold, 10)
+		}
+		trigger := map[string]interface{}{
+			"type":     constants.KedaKafkaTriggerType,
+			"metadata": metadata,
+		}
+		if len(kedaConfig.AuthenticationRef) > 0 {
+			trigger["authenticationRef"] = map[string]interface{}{"name": kedaConfig.AuthenticationRef}
+		}
+		triggers = append(triggers, trigger)
+	}
+
+	// Create The ScaledObject Spec
+	spec := map[string]interface{}{
+		"scaleTargetRef": map[string]interface{}{
+			"name": deploymentName,
+		},
+		"minReplicaCount": int64(r.dispatcherKedaMinReplicaCount()),
+		"maxReplicaCount": int64(r.dispatcherKedaMaxReplicaCount(channel)),
+		"triggers":        triggers,
+	}
+	if kedaConfig.PollingInterval > 0 {
+		spec["pollingInterval"] = int64(kedaConfig.PollingInterval)
+	}
+	if kedaConfig.CooldownPeriod > 0 {
+		spec["cooldownPeriod"] = int64(kedaConfig.CooldownPeriod)
+	}
+
+	// Create The Dispatcher's ScaledObject
+	scaledObject := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	scaledObject.SetAPIVersion(scaledObjectGVR.GroupVersion().String())
+	scaledObject.SetKind(constants.ScaledObjectKind)
+	scaledObject.SetName(deploymentName)
+	scaledObject.SetNamespace(commonconstants.KnativeEventingNamespace)
+	scaledObject.SetLabels(map[string]string{
+		constants.KafkaChannelDispatcherLabel: "true",            // Identifies the ScaledObject as being a KafkaChannel "Dispatcher"
+		constants.KafkaChannelNameLabel:       channel.Name,      // Identifies the ScaledObject's Owning KafkaChannel's Name
+		constants.KafkaChannelNamespaceLabel:  channel.Namespace, // Identifies the ScaledObject's Owning KafkaChannel's Namespace
+	})
+
+	// Return The Dispatcher's ScaledObject
+	return scaledObject
+}
+
+// Determine Whether The Dispatcher KEDA ScaledObject Feature Is Enabled In Config
+func (r *Reconciler) dispatcherKedaEnabled() bool {
+	return r.config != nil && r.config.Dispatcher.Keda.Enabled && r.dynamicClient != nil
+}
+
+// Get The Dispatcher's KEDA Minimum Replica Count From Config Or Default
+func (r *Reconciler) dispatcherKedaMinReplicaCount() int32 {
+	if r.config != nil && r.config.Dispatcher.Keda.MinReplicaCount > 0 {
+		return r.config.Dispatcher.Keda.MinReplicaCount
+	}
+	return constants.DispatcherKedaMinReplicaCount
+}
+
+// Get The Dispatcher's KEDA Maximum Replica Count From Config Or Default (The Topic's Partitions - Extra Replicas Would Sit Idle)
+func (r *Reconciler) dispatcherKedaMaxReplicaCount(channel *kafkav1beta1.KafkaChannel) int32 {
+	maxReplicaCount := util.NumPartitions(channel, r.config, r.logger)
+	if r.config != nil && r.config.Dispatcher.Keda.MaxReplicaCount > 0 {
+		maxReplicaCount = r.config.Dispatcher.Keda.MaxReplicaCount
+	}
+	if minReplicaCount := r.dispatcherKedaMinReplicaCount(); maxReplicaCount < minReplicaCount {
+		maxReplicaCount = minReplicaCount
+	}
+	return maxReplicaCount
+}
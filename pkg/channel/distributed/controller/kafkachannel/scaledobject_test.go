@@ -0,0 +1,293 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	clientgotesting "k8s.io/client-go/testing"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonenv "knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The Dispatcher ScaledObject Reconciliation
+func TestReconcileDispatcherScaledObject(t *testing.T) {
+
+	// Test Data
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithSubscribers)
+	channelWithoutSubscribers := controllertesting.NewKafkaChannel()
+	pausedChannel := controllertesting.NewKafkaChannel(controllertesting.WithSubscribers)
+	pausedChannel.Annotations = map[string]string{constants.PausedAnnotation: "true"}
+	staleScaledObject := newTestScaledObject(t, channel)
+	staleScaledObject.Object["spec"] = map[string]interface{}{"maxReplicaCount": int64(99)}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only        bool
+		name        string
+		enabled     bool
+		channel     *kafkav1beta1.KafkaChannel
+		existing    []runtime.Object
+		wantExists  bool
+		wantVerbs   []string
+		wantErr     bool
+		failingVerb string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:      "Disabled - None Existing",
+			channel:   channel,
+			wantVerbs: []string{"delete"},
+		},
+		{
+			name:      "Disabled - Existing Deleted",
+			channel:   channel,
+			existing:  []runtime.Object{newTestScaledObject(t, channel)},
+			wantVerbs: []string{"delete"},
+		},
+		{
+			name:      "Enabled - No Subscribers - Existing Deleted",
+			enabled:   true,
+			channel:   channelWithoutSubscribers,
+			existing:  []runtime.Object{newTestScaledObject(t, channelWithoutSubscribers)},
+			wantVerbs: []string{"delete"},
+		},
+		{
+			name:      "Enabled - Paused - Existing Deleted",
+			enabled:   true,
+			channel:   pausedChannel,
+			existing:  []runtime.Object{newTestScaledObject(t, pausedChannel)},
+			wantVerbs: []string{"delete"},
+		},
+		{
+			name:       "Enabled - Created",
+			enabled:    true,
+			channel:    channel,
+			wantExists: true,
+			wantVerbs:  []string{"get", "create"},
+		},
+		{
+			name:       "Enabled - Unchanged",
+			enabled:    true,
+			channel:    channel,
+			existing:   []runtime.Object{newTestScaledObject(t, channel)},
+			wantExists: true,
+			wantVerbs:  []string{"get"},
+		},
+		{
+			name:       "Enabled - Updated",
+			enabled:    true,
+			channel:    channel,
+			existing:   []runtime.Object{staleScaledObject},
+			wantExists: true,
+			wantVerbs:  []string{"get", "update"},
+		},
+		{
+			name:        "Enabled - Create Error",
+			enabled:     true,
+			channel:     channel,
+			wantVerbs:   []string{"get", "create"},
+			wantErr:     true,
+			failingVerb: "create",
+		},
+		{
+			name:        "Disabled - Delete Error",
+			channel:     channel,
+			existing:    []runtime.Object{newTestScaledObject(t, channel)},
+			wantExists:  true,
+			wantVerbs:   []string{"delete"},
+			wantErr:     true,
+			failingVerb: "delete",
+		},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Fake Dynamic Client With The Existing ScaledObjects
+			dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), testCase.existing...)
+			if len(testCase.failingVerb) > 0 {
+				dynamicClient.PrependReactor(testCase.failingVerb, "scaledobjects", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New(controllertesting.ErrorString)
+				})
+			}
+
+			// Initialize The Reconciler
+			r := &Reconciler{
+				logger:        logtesting.TestLogger(t).Desugar(),
+				dynamicClient: dynamicClient,
+				config:        controllertesting.NewConfig(),
+			}
+			r.config.Dispatcher.Keda.Enabled = testCase.enabled
+
+			// Perform The Test
+			err := r.reconcileDispatcherScaledObject(context.TODO(), r.logger, testCase.channel)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantErr, err != nil)
+			verbs := make([]string, 0)
+			for _, action := range dynamicClient.Actions() {
+				verbs = append(verbs, action.GetVerb())
+			}
+			assert.Equal(t, testCase.wantVerbs, verbs)
+			scaledObject, err := dynamicClient.Resource(scaledObjectGVR).Namespace(commonconstants.KnativeEventingNamespace).Get(context.TODO(), util.DispatcherDnsSafeName(testCase.channel), metav1.GetOptions{})
+			if testCase.wantExists {
+				assert.Nil(t, err)
+				if !testCase.wantErr {
+					assert.Equal(t, r.newDispatcherScaledObject(testCase.channel).Object["spec"], scaledObject.Object["spec"])
+				}
+			} else {
+				assert.True(t, apierrors.IsNotFound(err))
+			}
+		})
+	}
+}
+
+// Test The Dispatcher ScaledObject Finalization Without A Dynamic Client
+func TestFinalizeDispatcherScaledObjectNoDynamicClient(t *testing.T) {
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar()}
+	assert.Nil(t, r.finalizeDispatcherScaledObject(context.TODO(), r.logger, controllertesting.NewKafkaChannel()))
+}
+
+// Test The Dispatcher ScaledObject Model
+func TestNewDispatcherScaledObject(t *testing.T) {
+
+	// Test Data
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithSubscribers)
+	deploymentName := util.DispatcherDnsSafeName(channel)
+
+	// Initialize The Reconciler With Full KEDA Config
+	r := &Reconciler{
+		logger: logtesting.TestLogger(t).Desugar(),
+		config: controllertesting.NewConfig(),
+	}
+	r.config.Dispatcher.Keda.Enabled = true
+	r.config.Dispatcher.Keda.MinReplicaCount = 2
+	r.config.Dispatcher.Keda.MaxReplicaCount = 8
+	r.config.Dispatcher.Keda.PollingInterval = 15
+	r.config.Dispatcher.Keda.CooldownPeriod = 120
+	r.config.Dispatcher.Keda.LagThreshold = 50
+	r.config.Dispatcher.Keda.AuthenticationRef = "kafka-trigger-auth"
+
+	// Perform The Test
+	scaledObject := r.newDispatcherScaledObject(channel)
+
+	// Verify The Results
+	assert.Equal(t, "keda.sh/v1alpha1", scaledObject.GetAPIVersion())
+	assert.Equal(t, constants.ScaledObjectKind, scaledObject.GetKind())
+	assert.Equal(t, deploymentName, scaledObject.GetName())
+	assert.Equal(t, commonconstants.KnativeEventingNamespace, scaledObject.GetNamespace())
+	assert.Equal(t, map[string]string{
+		constants.KafkaChannelDispatcherLabel: "true",
+		constants.KafkaChannelNameLabel:       controllertesting.KafkaChannelName,
+		constants.KafkaChannelNamespaceLabel:  controllertesting.KafkaChannelNamespace,
+	}, scaledObject.GetLabels())
+	newTrigger := func(subscriberUid string) interface{} {
+		return map[string]interface{}{
+			"type": constants.KedaKafkaTriggerType,
+			"metadata": map[string]interface{}{
+				"bootstrapServersFromEnv": commonenv.KafkaBrokerEnvVarKey,
+				"consumerGroup":           "kafka." + subscriberUid,
+				"topic":                   controllertesting.TopicName,
+				"lagThreshold":            "50",
+			},
+			"authenticationRef": map[string]interface{}{"name": "kafka-trigger-auth"},
+		}
+	}
+	assert.Equal(t, map[string]interface{}{
+		"scaleTargetRef":  map[string]interface{}{"name": deploymentName},
+		"minReplicaCount": int64(2),
+		"maxReplicaCount": int64(8),
+		"pollingInterval": int64(15),
+		"cooldownPeriod":  int64(120),
+		"triggers": []interface{}{
+			newTrigger(controllertesting.SubscriberUid1),
+			newTrigger(controllertesting.SubscriberUid2),
+		},
+	}, scaledObject.Object["spec"])
+
+	// Verify The Optional Fields Are Omitted When Not Configured
+	r.config = controllertesting.NewConfig()
+	scaledObject = r.newDispatcherScaledObject(channel)
+	spec := scaledObject.Object["spec"].(map[string]interface{})
+	assert.NotContains(t, spec, "pollingInterval")
+	assert.NotContains(t, spec, "cooldownPeriod")
+	trigger := spec["triggers"].([]interface{})[0].(map[string]interface{})
+	assert.NotContains(t, trigger, "authenticationRef")
+	assert.NotContains(t, trigger["metadata"], "lagThreshold")
+}
+
+// Test The Dispatcher KEDA Replica Count Defaults
+func TestDispatcherKedaReplicaCounts(t *testing.T) {
+
+	// Test Data
+	channel := controllertesting.NewKafkaChannel()
+	r := &Reconciler{
+		logger: logtesting.TestLogger(t).Desugar(),
+		config: controllertesting.NewConfig(),
+	}
+
+	// Verify The Defaults (Minimum Of One, Maximum Of The Topic's Partitions)
+	assert.Equal(t, int32(constants.DispatcherKedaMinReplicaCount), r.dispatcherKedaMinReplicaCount())
+	assert.Equal(t, int32(controllertesting.NumPartitions), r.dispatcherKedaMaxReplicaCount(channel))
+
+	// Verify The Configured Values
+	r.config.Dispatcher.Keda.MinReplicaCount = 3
+	r.config.Dispatcher.Keda.MaxReplicaCount = 6
+	assert.Equal(t, int32(3), r.dispatcherKedaMinReplicaCount())
+	assert.Equal(t, int32(6), r.dispatcherKedaMaxReplicaCount(channel))
+
+	// Verify The Maximum Is Never Less Than The Minimum
+	r.config.Dispatcher.Keda.MaxReplicaCount = 2
+	assert.Equal(t, int32(3), r.dispatcherKedaMaxReplicaCount(channel))
+}
+
+// Utility Function For Creating An Existing ScaledObject For The Specified KafkaChannel
+func newTestScaledObject(t *testing.T, channel *kafkav1beta1.KafkaChannel) *unstructured.Unstructured {
+	r := &Reconciler{
+		logger: logtesting.TestLogger(t).Desugar(),
+		config: controllertesting.NewConfig(),
+	}
+	return r.newDispatcherScaledObject(channel)
+}
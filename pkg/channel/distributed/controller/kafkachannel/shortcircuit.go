@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+)
+
+//
+// KafkaChannel Reconciliation Short-Circuit
+//
+// Every KafkaChannel reconciliation (including those of the periodic resync) creates a Kafka AdminClient and
+// describes the Kafka Topic, which is expensive in large clusters even when nothing has changed.  When enabled,
+// the reconciliation of a KafkaChannel which is unchanged since its last successful full reconciliation, is Ready,
+// and whose Services and Dispatcher Deployment are present and current, is reduced to a lightweight health check
+// using only the informer caches.  "Unchanged" is tracked via a fingerprint of the KafkaChannel's generation, its
+// labels and annotations (which carry much of the per-channel configuration), and the Sarama settings hash.  A full
+// ("deep") reconciliation is still performed once the deep reconcile interval has elapsed, in order to catch drift
+// outside of Kubernetes (e.g. a deleted Kafka Topic), and whenever the KafkaChannel's Kafka Secret or Dead Letter
+// Sink changes.
+//
+
+// The Last Successful Full Reconciliation Of A Single KafkaChannel
+type reconcileShortCircuitEntry struct {
+	fingerprint       string
+	deepReconcileTime time.Time
+}
+
+// A Per-KafkaChannel Record Of The Last Full Reconciliation (A nil reconcileShortCircuit Is Disabled And Never Short-Circuits)
+type reconcileShortCircuit struct {
+	deepReconcileInterval time.Duration
+	mutex                 sync.Mutex
+	entries               map[types.NamespacedName]*reconcileShortCircuitEntry
+}
+
+// Create A New reconcileShortCircuit From The Specified Configuration (nil If The Short-Circuit Is Disabled)
+func newReconcileShortCircuit(logger *zap.Logger, shortCircuitConfig config.EKReconcileShortCircuitConfig) *reconcileShortCircuit {
+	if !shortCircuitConfig.Enabled {
+		return nil
+	}
+	deepReconcileIntervalSeconds := shortCircuitConfig.DeepReconcileIntervalSeconds
+	if deepReconcileIntervalSeconds <= 0 {
+		deepReconcileIntervalSeconds = constants.ReconcileShortCircuitDeepReconcileIntervalSeconds
+	}
+	deepReconcileInterval := time.Duration(deepReconcileIntervalSeconds) * time.Second
+	logger.Info("Enabling KafkaChannel Reconciliation Short-Circuit", zap.Duration("DeepReconcileInterval", deepReconcileInterval))
+	return &reconcileShortCircuit{
+		deepReconcileInterval: deepReconcileInterval,
+		entries:               make(map[types.NamespacedName]*reconcileShortCircuitEntry),
+	}
+}
+
+// Record The Fingerprint Of A KafkaChannel Which Was Successfully Fully Reconciled
+func (s *reconcileShortCircuit) record(key types.NamespacedName, fingerprint string) {
+	if s == nil || len(fingerprint) <= 0 {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.entries[key] = &reconcileShortCircuitEntry{fingerprint: fingerprint, deepReconcileTime: nowWrapper()}
+}
+
+// Whether The KafkaChannel's Fingerprint Is Unchanged Since Its Last Full Reconciliation (Within The Deep Reconcile Interval)
+func (s *reconcileShortCircuit) unchanged(key types.NamespacedName, fingerprint string) bool {
+	if s == nil || len(fingerprint) <= 0 {
+		return false
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	entry := s.entries[key]
+	return entry != nil && entry.fingerprint == fingerprint && nowWrapper().Sub(entry.deepReconcileTime) < s.deepReconcileInterval
+}
+
+// Forget The Specified KafkaChannel (Forcing Its Next Reconciliation To Be A Full Reconciliation)
+func (s *reconcileShortCircuit) forget(key types.NamespacedName) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.entries, key)
+}
+
+// Get The Fingerprint Of The KafkaChannel's Desired State (Empty If It Cannot Be Determined)
+func (r *Reconciler) reconcileFingerprint(channel *kafkav1beta1.KafkaChannel) string {
+	fingerprintJson, err := json.Marshal(struct {
+		Generation       int64
+		Labels           map[string]string
+		Annotations      map[string]string
+		SaramaConfigHash string
+	}{
+		Generation:       channel.Generation,
+		Labels:           channel.Labels,
+		Annotations:      channel.Annotations,
+		SaramaConfigHash: r.saramaConfigHash,
+	})
+	if err != nil {
+		r.logger.Warn("Failed To Fingerprint KafkaChannel - Short-Circuit Disabled For Reconciliation", zap.Error(err))
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(fingerprintJson))
+}
+
+// Determine Whether The KafkaChannel's Reconciliation May Be Short-Circuited (Performing The Lightweight Health Check If So)
+func (r *Reconciler) shortCircuitReconcile(channel *kafkav1beta1.KafkaChannel) bool {
+	if r.reconcileShortCircuit == nil {
+		return false
+	}
+	if channel.Status.ObservedGeneration != channel.Generation || !channel.Status.IsReady() {
+		return false
+	}
+	key := types.NamespacedName{Namespace: channel.Namespace, Name: channel.Name}
+	if !r.reconcileShortCircuit.unchanged(key, r.reconcileFingerprint(channel)) {
+		return false
+	}
+	return r.dependentsHealthy(channel)
+}
+
+//
+// Lightweight Health Check Of The KafkaChannel's Services & Dispatcher Deployment (Using Only The Informer Caches)
+//
+// The Services and Dispatcher Deployment must exist (and not be pending deletion), and the Dispatcher Deployment
+// must have observed its latest spec and be annotated with the current Sarama settings hash.  The Dispatcher
+// Deployment's status is propagated to the KafkaChannel, which must then remain Ready.
+//
+func (r *Reconciler) dependentsHealthy(channel *kafkav1beta1.KafkaChannel) bool {
+
+	// Verify The KafkaChannel Service
+	channelService, err := r.getKafkaChannelService(channel)
+	if err != nil || channelService == nil || !channelService.DeletionTimestamp.IsZero() {
+		return false
+	}
+
+	// Verify The Dispatcher Service
+	dispatcherService, err := r.getDispatcherService(channel)
+	if err != nil || dispatcherService == nil || !dispatcherService.DeletionTimestamp.IsZero() {
+		return false
+	}
+
+	// Verify The Dispatcher Deployment Is Current
+	deployment, err := r.getDispatcherDeployment(channel)
+	if err != nil || deployment == nil || !deployment.DeletionTimestamp.IsZero() {
+		return false
+	}
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false
+	}
+	if len(r.saramaConfigHash) > 0 && deployment.Spec.Template.Annotations[constants.ConfigHashAnnotation] != r.saramaConfigHash {
+		return false
+	}
+
+	// Propagate The Dispatcher Deployment's Status (Which Must Leave The KafkaChannel Ready)
+	channel.Status.PropagateDispatcherStatus(&deployment.Status)
+	return channel.Status.IsReady()
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/pkg/apis"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The newReconcileShortCircuit() Constructor
+func TestNewReconcileShortCircuit(t *testing.T) {
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Verify The Short-Circuit Is Disabled By Default
+	assert.Nil(t, newReconcileShortCircuit(logger, config.EKReconcileShortCircuitConfig{}))
+
+	// Verify The Default & Configured Deep Reconcile Intervals
+	shortCircuit := newReconcileShortCircuit(logger, config.EKReconcileShortCircuitConfig{Enabled: true})
+	assert.NotNil(t, shortCircuit)
+	assert.Equal(t, constants.ReconcileShortCircuitDeepReconcileIntervalSeconds*time.Second, shortCircuit.deepReconcileInterval)
+	shortCircuit = newReconcileShortCircuit(logger, config.EKReconcileShortCircuitConfig{Enabled: true, DeepReconcileIntervalSeconds: 60})
+	assert.Equal(t, 60*time.Second, shortCircuit.deepReconcileInterval)
+}
+
+// Test The reconcileShortCircuit record() / unchanged() / forget() Functionality
+func TestReconcileShortCircuitEntries(t *testing.T) {
+
+	// Mock The Current Time
+	now := time.Now()
+	nowWrapperPlaceholder := nowWrapper
+	nowWrapper = func() time.Time { return now }
+	defer func() { nowWrapper = nowWrapperPlaceholder }()
+
+	// Test Data
+	key := types.NamespacedName{Namespace: controllertesting.KafkaChannelNamespace, Name: controllertesting.KafkaChannelName}
+	shortCircuit := newReconcileShortCircuit(logtesting.TestLogger(t).Desugar(), config.EKReconcileShortCircuitConfig{Enabled: true, DeepReconcileIntervalSeconds: 60})
+
+	// Verify Unrecorded, Changed & Empty Fingerprints Are Not Unchanged
+	assert.False(t, shortCircuit.unchanged(key, "fingerprint-1"))
+	shortCircuit.record(key, "fingerprint-1")
+	assert.True(t, shortCircuit.unchanged(key, "fingerprint-1"))
+	assert.False(t, shortCircuit.unchanged(key, "fingerprint-2"))
+	assert.False(t, shortCircuit.unchanged(key, ""))
+
+	// Verify The Deep Reconcile Interval Expires The Entry
+	now = now.Add(59 * time.Second)
+	assert.True(t, shortCircuit.unchanged(key, "fingerprint-1"))
+	now = now.Add(time.Second)
+	assert.False(t, shortCircuit.unchanged(key, "fingerprint-1"))
+
+	// Verify Forgotten Entries Are Not Unchanged
+	shortCircuit.record(key, "fingerprint-1")
+	shortCircuit.forget(key)
+	assert.False(t, shortCircuit.unchanged(key, "fingerprint-1"))
+
+	// Verify A nil (Disabled) Short-Circuit Is Safe & Never Unchanged
+	var disabled *reconcileShortCircuit
+	disabled.record(key, "fingerprint-1")
+	disabled.forget(key)
+	assert.False(t, disabled.unchanged(key, "fingerprint-1"))
+}
+
+// Test The shortCircuitReconcile() Functionality
+func TestShortCircuitReconcile(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name             string
+		modifyChannel    func(channel *kafkav1beta1.KafkaChannel)
+		modifyDeployment func(deployment *appsv1.Deployment)
+		withoutService   bool
+		forget           bool
+		disabled         bool
+		want             bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Unchanged & Healthy", want: true},
+		{name: "Disabled", disabled: true},
+		{name: "Not Fully Reconciled", forget: true},
+		{name: "Generation Not Observed", modifyChannel: func(channel *kafkav1beta1.KafkaChannel) { channel.Generation = 2 }},
+		{name: "Annotations Changed", modifyChannel: func(channel *kafkav1beta1.KafkaChannel) {
+			channel.Annotations = map[string]string{"test-annotation": "true"}
+		}},
+		{name: "Not Ready", modifyChannel: func(channel *kafkav1beta1.KafkaChannel) { channel.Status.MarkTopicFailed("TestReason", "Test Message") }},
+		{name: "KafkaChannel Service Missing", withoutService: true},
+		{name: "Dispatcher Rollout Pending", modifyDeployment: func(deployment *appsv1.Deployment) { deployment.Generation = 2 }},
+		{name: "Dispatcher Stale ConfigHash", modifyDeployment: controllertesting.WithStaleConfigHashDeployment},
+		{name: "Dispatcher Unavailable", modifyDeployment: func(deployment *appsv1.Deployment) {
+			deployment.Status.Conditions[0].Status = corev1.ConditionFalse
+		}},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Ready KafkaChannel
+			channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions, controllertesting.WithAddress, controllertesting.WithTopicReady,
+				controllertesting.WithKafkaChannelServiceReady, controllertesting.WithReceiverServiceReady, controllertesting.WithReceiverDeploymentReady)
+			channel.Generation = 1
+			channel.Status.ObservedGeneration = 1
+			channel.Status.GetConditionSet().Manage(&channel.Status).MarkTrue(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+			assert.True(t, channel.Status.IsReady())
+
+			// Create The KafkaChannel's Current & Available Dependents
+			deployment := controllertesting.NewKafkaChannelDispatcherDeployment()
+			deployment.Generation = 1
+			deployment.Status.ObservedGeneration = 1
+			deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}
+			if testCase.modifyDeployment != nil {
+				testCase.modifyDeployment(deployment)
+			}
+			objects := []runtime.Object{controllertesting.NewKafkaChannelDispatcherService(), deployment}
+			if !testCase.withoutService {
+				objects = append(objects, controllertesting.NewKafkaChannelService())
+			}
+			listers := controllertesting.NewListers(objects)
+
+			// Create A Reconciler Which Has Fully Reconciled The KafkaChannel
+			r := &Reconciler{
+				logger:           logtesting.TestLogger(t).Desugar(),
+				saramaConfigHash: controllertesting.DispatcherConfigHash,
+				serviceLister:    listers.GetServiceLister(),
+				deploymentLister: listers.GetDeploymentLister(),
+			}
+			if !testCase.disabled {
+				r.reconcileShortCircuit = newReconcileShortCircuit(r.logger, config.EKReconcileShortCircuitConfig{Enabled: true})
+			}
+			key := types.NamespacedName{Namespace: channel.Namespace, Name: channel.Name}
+			r.reconcileShortCircuit.record(key, r.reconcileFingerprint(channel))
+			if testCase.forget {
+				r.reconcileShortCircuit.forget(key)
+			}
+
+			// Perform The Test
+			if testCase.modifyChannel != nil {
+				testCase.modifyChannel(channel)
+			}
+			assert.Equal(t, testCase.want, r.shortCircuitReconcile(channel))
+		})
+	}
+}
+
+// Test The ReconcileKind() Short-Circuit Does Not Create A Kafka AdminClient & Is Counted
+func TestReconcileKindShortCircuit(t *testing.T) {
+
+	// Replace The recordWrapper With One Capturing The Reconciliation Modes (Restoring When Done)
+	modes := make([]string, 0)
+	recordWrapperRef := recordWrapper
+	defer func() { recordWrapper = recordWrapperRef }()
+	recordWrapper = func(ctx context.Context, measurement stats.Measurement, _ ...stats.Options) {
+		if measurement.Measure().Name() == reconcileCount.Name() {
+			mode, _ := tag.FromContext(ctx).Value(modeKey)
+			modes = append(modes, mode)
+		}
+	}
+
+	// Create A Ready KafkaChannel With A Current & Available Dispatcher
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions, controllertesting.WithAddress, controllertesting.WithTopicReady,
+		controllertesting.WithKafkaChannelServiceReady, controllertesting.WithReceiverServiceReady, controllertesting.WithReceiverDeploymentReady)
+	channel.Status.GetConditionSet().Manage(&channel.Status).MarkTrue(kafkav1beta1.KafkaChannelConditionDispatcherReady)
+	deployment := controllertesting.NewKafkaChannelDispatcherDeployment()
+	deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}
+	listers := controllertesting.NewListers([]runtime.Object{controllertesting.NewKafkaChannelService(), controllertesting.NewKafkaChannelDispatcherService(), deployment})
+
+	// Create A Reconciler Which Has Fully Reconciled The KafkaChannel (Without Any Kafka AdminClient Configuration)
+	r := &Reconciler{
+		logger:           logtesting.TestLogger(t).Desugar(),
+		saramaConfigHash: controllertesting.DispatcherConfigHash,
+		serviceLister:    listers.GetServiceLister(),
+		deploymentLister: listers.GetDeploymentLister(),
+	}
+	r.reconcileShortCircuit = newReconcileShortCircuit(r.logger, config.EKReconcileShortCircuitConfig{Enabled: true})
+	r.reconcileShortCircuit.record(types.NamespacedName{Namespace: channel.Namespace, Name: channel.Name}, r.reconcileFingerprint(channel))
+
+	// Perform The Test
+	err := r.ReconcileKind(context.TODO(), channel)
+
+	// Verify The Reconciliation Was Short-Circuited (The nil adminMutex Would Otherwise Panic) & Counted
+	assert.Nil(t, err)
+	assert.Nil(t, r.adminClient)
+	assert.Equal(t, []string{ReconcileModeShortCircuit}, modes)
+	assert.True(t, channel.Status.GetCondition(apis.ConditionReady).IsTrue())
+}
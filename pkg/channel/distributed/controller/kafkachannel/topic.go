@@ -18,16 +18,26 @@ package kafkachannel
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	adminutil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin/util"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
 	"knative.dev/pkg/controller"
 )
 
@@ -38,18 +48,78 @@ func (r *Reconciler) reconcileKafkaTopic(ctx context.Context, channel *kafkav1be
 	topicName := util.TopicName(channel)
 
 	// Get Channel Specific Logger & Add Topic Name
-	logger := util.ChannelLogger(r.logger, channel).With(zap.String("TopicName", topicName))
+	logger := util.ReconcileLogger(ctx, channel).With(zap.String("TopicName", topicName))
 
 	// Get The Topic Configuration (First From Channel With Failover To Environment)
-	numPartitions := util.NumPartitions(channel, r.config, r.logger)
-	replicationFactor := util.ReplicationFactor(channel, r.config, r.logger)
-	retentionMillis := util.RetentionMillis(channel, r.config, r.logger)
+	numPartitions := util.NumPartitions(channel, r.config, logger)
+	replicationFactor := util.ReplicationFactor(channel, r.config, logger)
+	retentionMillis := util.RetentionMillis(channel, r.config, logger)
+	cleanupPolicy := util.CleanupPolicy(channel, logger)
+	maxMessageBytes := util.MaxMessageBytes(channel, logger)
 
-	// Create The Topic (Handles Case Where Already Exists)
-	err := r.createTopic(ctx, logger, topicName, numPartitions, replicationFactor, retentionMillis)
+	// Only Log / Record The Topic Creation Which Would Be Performed When In DryRun Mode
+	if util.DryRun(channel, r.config, logger) {
+		topicDetail := newTopicDetail(numPartitions, replicationFactor, retentionMillis, cleanupPolicy, maxMessageBytes)
+		if err := r.assignTopicReplicas(topicDetail); err != nil {
+			logger.Warn("DryRun - Failed To Assign Topic Replicas", zap.Error(err))
+		}
+		logger.Info("DryRun - Skipping Kafka Topic Creation", zap.Any("TopicDetail", topicDetail))
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeNormal, event.KafkaTopicDryRun.String(),
+			"DryRun - Would Create Kafka Topic %s (NumPartitions: %d, ReplicationFactor: %d, RetentionMillis: %d, CleanupPolicy: %s)", topicName, numPartitions, replicationFactor, retentionMillis, cleanupPolicy)
+		channel.Status.MarkTopicDryRun("TopicDryRun", "Channel Kafka Topic Not Created (DryRun): %s", topicName)
+		return nil
+	}
+
+	// All Remaining Topic Operations Require A Kafka AdminClient
+	if r.adminClient == nil {
+		logger.Error("Failed To Reconcile Kafka Topic - No Kafka AdminClient")
+		channel.Status.MarkTopicFailed("TopicFailed", "Channel Kafka Topic Failed: %s", constants.KafkaAdminClientUnavailableError)
+		return fmt.Errorf(constants.KafkaAdminClientUnavailableError)
+	}
+
+	// Only Verify The Existence Of Pre-Created Topics When Topic Auto-Creation Is Disabled
+	if util.DisableTopicAutoCreate(channel, r.config, logger) {
+		return r.verifyKafkaTopic(ctx, logger, channel, topicName)
+	}
+
+	// Create The Topic (Handles Case Where Already Exists), Audit Any Actual Creation Attempt & Record Ownership
+	created, err := r.createTopic(ctx, logger, topicName, numPartitions, replicationFactor, retentionMillis, cleanupPolicy, maxMessageBytes)
+	if created || err != nil {
+		r.auditKafkaTopicCreation(ctx, logger, channel, topicName, numPartitions, replicationFactor, err)
+	}
+	if err == nil {
+		r.reconcileTopicOwner(logger, channel, topicName, created)
+	}
+
+	// Converge Any Drift In The Existing Topic's Partitions / Replication
+	if err == nil {
+		err = r.reconcileTopicPartitions(ctx, logger, channel, topicName, numPartitions, replicationFactor)
+	}
+
+	// Converge Any Drift In The Existing Topic's Configuration
+	if err == nil {
+		err = r.reconcileTopicConfig(ctx, logger, channel, topicName, newTopicConfigEntries(retentionMillis, cleanupPolicy, maxMessageBytes))
+	}
+
+	// Grant The Dispatcher & Receiver Principals Access To The Topic (When Enabled)
+	if err == nil {
+		err = r.reconcileTopicACLs(ctx, logger, topicName)
+	}
+
+	// Ensure Any Per-Subscription Dead Letter Topics Exist
+	if err == nil {
+		err = r.reconcileDeadLetterTopics(ctx, logger, channel, numPartitions, replicationFactor, retentionMillis, maxMessageBytes)
+	}
+
+	// Probe Whether The Topic Is Actually Writable (Rather Than Merely Existing)
+	if err == nil {
+		err = r.probeKafkaTopic(ctx, logger, topicName)
+	}
 
 	// Log Results & Return Status
-	if err != nil {
+	if isTopicNotReadyError(err) {
+		r.markKafkaTopicNotReady(ctx, logger, channel, topicName, err)
+	} else if err != nil {
 		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicReconciliationFailed.String(), "Failed To Reconcile Kafka Topic For Channel: %v", err)
 		logger.Error("Failed To Reconcile Kafka Topic", zap.Error(err))
 		channel.Status.MarkTopicFailed("TopicFailed", fmt.Sprintf("Channel Kafka Topic Failed: %s", err))
@@ -67,10 +137,62 @@ func (r *Reconciler) finalizeKafkaTopic(ctx context.Context, channel *kafkav1bet
 	topicName := util.TopicName(channel)
 
 	// Get Channel Specific Logger & Add Topic Name
-	logger := util.ChannelLogger(r.logger, channel).With(zap.String("TopicName", topicName))
+	logger := util.ReconcileLogger(ctx, channel).With(zap.String("TopicName", topicName))
+
+	// Preserve The Kafka Topic (And Its Data) If Requested Via The KafkaChannel's Annotation At Deletion Time
+	if util.RetainTopic(channel, logger) {
+		logger.Info("RetainTopic - Skipping Kafka Topic Deletion")
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeNormal, event.TopicRetained.String(), "Retained Kafka Topic %s", topicName)
+		return nil
+	}
+
+	// Pre-Created Kafka Topics Are Managed Externally And Never Deleted When Topic Auto-Creation Is Disabled
+	if util.DisableTopicAutoCreate(channel, r.config, logger) {
+		logger.Info("DisableTopicAutoCreate - Skipping Kafka Topic Deletion")
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeNormal, event.TopicRetained.String(), "Retained Pre-Created Kafka Topic %s", topicName)
+		return nil
+	}
+
+	// Topic Deletion Requires A Kafka AdminClient
+	if r.adminClient == nil {
+		logger.Error("Failed To Finalize Kafka Topic - No Kafka AdminClient")
+		return fmt.Errorf(constants.KafkaAdminClientUnavailableError)
+	}
+
+	// Only Log / Record The Topic Deletion Which Would Be Performed When In DryRun Mode
+	if util.DryRun(channel, r.config, logger) {
+		return r.finalizeKafkaTopicDryRun(ctx, logger, channel, topicName)
+	}
 
-	// Delete The Kafka Topic & Handle Error Response
-	err := r.deleteTopic(ctx, logger, topicName)
+	// Never Delete (Or Remove The ACLs Of) A Topic Which The KafkaChannel Does Not Own (When Ownership Is Enabled)
+	if !r.verifyTopicOwner(ctx, logger, channel, topicName) {
+		return nil
+	}
+
+	// Remove The Topic's ACLs (Kafka Retains ACLs After Topic Deletion) Before Deleting The Topic
+	err := r.finalizeTopicACLs(ctx, logger, topicName)
+	if err != nil {
+		logger.Error("Failed To Finalize Kafka Topic ACLs", zap.Error(err))
+		return err
+	}
+
+	// Delete The Kafka Topic, Leaving Any Deletion Abandoned After The Finalization Timeout To Complete In The Background
+	deleted, err := r.deleteTopic(ctx, logger, topicName)
+	var timeoutErr *topicDeletionTimeoutError
+	if errors.As(err, &timeoutErr) {
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicFinalizationTimedOut.String(),
+			"Timed Out Deleting Kafka Topic %s After %dms", topicName, r.config.Kafka.TopicFinalization.TimeoutMillis)
+		allowOrphan := r.config.Kafka.TopicFinalization.AllowOrphan
+		go r.awaitAbandonedTopicDeletion(ctx, logger, channel.DeepCopy(), topicName, timeoutErr, allowOrphan)
+		if allowOrphan {
+			return nil
+		}
+	}
+
+	// Audit Any Actual Deletion Attempt & Handle Error Response
+	if deleted || (err != nil && timeoutErr == nil) {
+		r.auditKafkaTopicDeletion(ctx, logger, channel, topicName, r.adminClientKafkaSecretName(topicName), err)
+	}
 	if err != nil {
 		logger.Error("Failed To Finalize Kafka Topic", zap.Error(err))
 		return err
@@ -80,72 +202,759 @@ func (r *Reconciler) finalizeKafkaTopic(ctx context.Context, channel *kafkav1bet
 	}
 }
 
-// Create The Specified Kafka Topic
-func (r *Reconciler) createTopic(ctx context.Context, logger *zap.Logger, topicName string, partitions int32, replicationFactor int16, retentionMillis int64) error {
+//
+// Finalize The Kafka Topic Associated With The Specified Channel In DryRun Mode (Without Deleting It)
+//
+// A Topic which was never created (e.g. the KafkaChannel has only ever been reconciled in DryRun mode) is
+// finalized normally.  Otherwise the Topic deletion is withheld, along with the finalizer (by returning an
+// error) and a Warning event, so that the Topic is neither deleted nor silently orphaned until DryRun mode
+// is disabled.  AdminClients which cannot describe topics return nil metadata, in which case the Topic is
+// assumed to exist.
+//
+func (r *Reconciler) finalizeKafkaTopicDryRun(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string) error {
 
-	// Create The TopicDefinition
-	retentionMillisString := strconv.FormatInt(retentionMillis, 10)
-	topicDetail := &sarama.TopicDetail{
-		NumPartitions:     partitions,
-		ReplicationFactor: replicationFactor,
-		ReplicaAssignment: nil, // Currently Not Assigning Partitions To Replicas
-		ConfigEntries: map[string]*string{
-			constants.KafkaTopicConfigRetentionMs: &retentionMillisString,
-		},
+	// Describe The Topic To Determine Whether It Exists
+	_, topicError := r.adminClient.DescribeTopic(ctx, topicName)
+	err := adminutil.WrapTopicError(topicError)
+	recordTopicOperation(ctx, TopicOperationDescribe, topicOperationResult(err))
+	if errors.Is(err, adminutil.ErrUnknownTopic) {
+		logger.Info("DryRun - Kafka Topic Does Not Exist - No Deletion Required")
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeNormal, event.KafkaTopicDryRun.String(), "DryRun - Kafka Topic %s Does Not Exist", topicName)
+		return nil
+	} else if err != nil {
+		logger.Error("DryRun - Failed To Describe Kafka Topic", zap.Any("TopicError", topicError))
+		return err
+	}
+
+	// Retain The Finalizer Rather Than Reporting The (Skipped) Deletion Of The Existing Topic As Successful
+	logger.Warn("DryRun - Skipping Kafka Topic Deletion & Retaining Finalizer")
+	controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicDryRun.String(),
+		"DryRun - Would Delete Kafka Topic %s (Finalizer Retained Until DryRun Is Disabled)", topicName)
+	return fmt.Errorf(constants.KafkaTopicDryRunRetainedError)
+}
+
+//
+// Verify The Existence Of A Pre-Created Kafka Topic (Topic Auto-Creation Disabled)
+//
+// The Topic is managed externally so no creation or partition / configuration convergence is performed.
+// AdminClients which cannot describe topics return nil metadata, in which case the Topic is assumed to exist.
+//
+func (r *Reconciler) verifyKafkaTopic(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string) error {
+
+	// Describe The Topic To Verify It Exists
+	topicMetadata, topicError := r.adminClient.DescribeTopic(ctx, topicName)
+	err := adminutil.WrapTopicError(topicError)
+	recordTopicOperation(ctx, TopicOperationDescribe, topicOperationResult(err))
+	if err == nil {
+		err = topicLeadershipError(topicMetadata)
+	}
+	if err != nil {
+		if isTopicNotReadyError(err) {
+			r.markKafkaTopicNotReady(ctx, logger, channel, topicName, err)
+		} else if errors.Is(err, adminutil.ErrUnknownTopic) {
+			logger.Error("Kafka Topic Not Found - Topic Must Be Pre-Created When Topic Auto-Creation Is Disabled")
+			controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicReconciliationFailed.String(), "Kafka Topic %s Not Found - Topic Must Be Pre-Created", topicName)
+			channel.Status.MarkTopicFailed("TopicNotFound", "Channel Kafka Topic %s Not Found - Topic Must Be Pre-Created (Topic Auto-Creation Disabled)", topicName)
+		} else {
+			logger.Error("Failed To Verify Pre-Created Kafka Topic", zap.Any("TopicError", topicError))
+			controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicReconciliationFailed.String(), "Failed To Verify Kafka Topic For Channel: %v", topicError)
+			channel.Status.MarkTopicFailed("TopicFailed", fmt.Sprintf("Channel Kafka Topic Failed: %s", topicError))
+		}
+		return err
+	}
+
+	// Return Success
+	logger.Info("Successfully Verified Pre-Created Kafka Topic")
+	channel.Status.MarkTopicTrue()
+	return nil
+}
+
+//
+// Probe Whether The Kafka Topic Is Writable (Every Partition Has An Elected Leader)
+//
+// A Topic can exist (CreateTopic succeeding or returning TopicAlreadyExists) while some of its partitions have
+// no leader during broker churn, so the Topic is described afresh after it has been reconciled.  Any such
+// partitions result in a transient Kafka error so that the Topic is re-probed when the KafkaChannel is requeued.
+// Partitions whose in-sync replicas have shrunk are still writable (per the topic's min.insync.replicas, which the
+// brokers enforce when producing), so they do not affect readiness.  AdminClients which cannot describe topics
+// return nil metadata, in which case the Topic is assumed writable.
+//
+func (r *Reconciler) probeKafkaTopic(ctx context.Context, logger *zap.Logger, topicName string) error {
+	topicMetadata, topicError := r.adminClient.DescribeTopic(ctx, topicName)
+	err := adminutil.WrapTopicError(topicError)
+	recordTopicOperation(ctx, TopicOperationDescribe, topicOperationResult(err))
+	if err != nil {
+		logger.Error("Failed To Probe Kafka Topic", zap.Any("TopicError", topicError))
+		return err
+	}
+	return topicLeadershipError(topicMetadata)
+}
+
+// Get The (Transient) Error Identifying Any Partitions Of The Described Topic Which Have No Leader
+func topicLeadershipError(topicMetadata *sarama.TopicMetadata) error {
+	if topicMetadata == nil {
+		return nil
+	}
+	leaderlessPartitions := make([]int32, 0)
+	for _, partition := range topicMetadata.Partitions {
+		if partition != nil && (partition.Leader < 0 || partition.Err == sarama.ErrLeaderNotAvailable) {
+			leaderlessPartitions = append(leaderlessPartitions, partition.ID)
+		}
+	}
+	if len(leaderlessPartitions) > 0 {
+		return fmt.Errorf("kafka topic %s partitions %v have no leader: %w", topicMetadata.Name, leaderlessPartitions, sarama.ErrLeaderNotAvailable)
+	}
+	return nil
+}
+
+// Determine Whether The Specified Error Indicates The Topic Exists But Is Not (Yet) Writable
+func isTopicNotReadyError(err error) bool {
+	return errors.Is(err, sarama.ErrLeaderNotAvailable)
+}
+
+// Mark The Channel's Kafka Topic As NotReady (Existing But Not Yet Writable) & Emit A Warning Event
+func (r *Reconciler) markKafkaTopicNotReady(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string, err error) {
+	logger.Warn("Kafka Topic Not Ready - Requeueing To Re-Probe", zap.Error(err))
+	controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicNotReady.String(), "Kafka Topic %s Not Ready: %v", topicName, err)
+	channel.Status.MarkTopicNotReady("TopicLeaderNotAvailable", "Channel Kafka Topic Not Ready: %v", err)
+}
+
+//
+// Ensure The Per-Subscription Dead Letter Topics Of The Specified Channel Exist (Topic Auto-Creation Enabled)
+//
+// Missing dead letter topics are created with the same partitions, replication factor, retention and max message
+// bytes as the KafkaChannel's own topic (but always the default cleanup policy, as compaction would lose events).
+// Dead letter topics may be shared by several subscriptions (or KafkaChannels) and hold the only copy of the
+// events which could not be delivered, so their configuration is not converged and they are never deleted when
+// the KafkaChannel is finalized.
+//
+func (r *Reconciler) reconcileDeadLetterTopics(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, numPartitions int32, replicationFactor int16, retentionMillis int64, maxMessageBytes int32) error {
+
+	// Get The Per-Subscription Dead Letter Topics (Already Validated When Reconciling The Dispatcher)
+	deadLetterTopics, err := r.subscriberDeadLetterTopics(channel)
+	if err != nil {
+		return err
+	}
+
+	// Create Each Distinct Dead Letter Topic (Handles Case Where Already Exists) & Audit Any Actual Creation Attempt
+	created := make(map[string]bool, len(deadLetterTopics))
+	for _, deadLetterTopicName := range deadLetterTopics {
+		if created[deadLetterTopicName] {
+			continue
+		}
+		deadLetterLogger := logger.With(zap.String("DeadLetterTopicName", deadLetterTopicName))
+		topicCreated, err := r.createTopic(ctx, deadLetterLogger, deadLetterTopicName, numPartitions, replicationFactor, retentionMillis, commonconstants.DefaultCleanupPolicy, maxMessageBytes)
+		if topicCreated || err != nil {
+			r.auditKafkaTopicCreation(ctx, deadLetterLogger, channel, deadLetterTopicName, numPartitions, replicationFactor, err)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create dead letter topic %s: %w", deadLetterTopicName, err)
+		}
+		created[deadLetterTopicName] = true
+	}
+	return nil
+}
+
+// Get The Per-Subscription Dead Letter Topics Of The Specified Channel (Which May Not Be The Channel's Own Topic)
+func (r *Reconciler) subscriberDeadLetterTopics(channel *kafkav1beta1.KafkaChannel) (map[string]string, error) {
+	deadLetterTopics, err := consumer.SubscriberDeadLetterTopics(channel.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	topicName := util.TopicName(channel)
+	for uid, deadLetterTopicName := range deadLetterTopics {
+		if deadLetterTopicName == topicName {
+			return nil, fmt.Errorf("invalid subscriber dead letter topic '%s' for subscription '%s': the dead letter topic must not be the KafkaChannel's own topic '%s'",
+				kafkaconstants.SubscriberDeadLetterTopicAnnotation, uid, topicName)
+		}
+	}
+	return deadLetterTopics, nil
+}
+
+// Create The Specified Kafka Topic (Returning Whether A New Topic Was Actually Created)
+func (r *Reconciler) createTopic(ctx context.Context, logger *zap.Logger, topicName string, partitions int32, replicationFactor int16, retentionMillis int64, cleanupPolicy string, maxMessageBytes int32) (bool, error) {
+
+	// Create The TopicDefinition (With Any Configured Weighted Replica Assignment)
+	topicDetail := newTopicDetail(partitions, replicationFactor, retentionMillis, cleanupPolicy, maxMessageBytes)
+	err := r.assignTopicReplicas(topicDetail)
+	if err != nil {
+		logger.Error("Failed To Assign Topic Replicas", zap.Error(err))
+		recordTopicOperation(ctx, TopicOperationCreate, TopicResultError)
+		return false, err
 	}
 
 	// Attempt To Create The Topic & Process TopicError Results (Including Success ;)
-	err := r.adminClient.CreateTopic(ctx, topicName, topicDetail)
+	topicError := r.adminClient.CreateTopic(ctx, topicName, topicDetail)
+	err = adminutil.WrapTopicError(topicError)
+	switch {
+	case err == nil:
+		logger.Info("Successfully Created New Kafka Topic")
+		recordTopicOperation(ctx, TopicOperationCreate, TopicResultSuccess)
+		return true, nil
+	case errors.Is(err, adminutil.ErrTopicExists):
+		logger.Info("Kafka Topic Already Exists - No Creation Required")
+		recordTopicOperation(ctx, TopicOperationCreate, TopicResultExists)
+		return false, nil
+	default:
+		logger.Error("Failed To Create Topic", zap.Any("TopicError", topicError))
+		recordTopicOperation(ctx, TopicOperationCreate, TopicResultError)
+		return false, err
+	}
+}
+
+//
+// Reconcile The Partitions & Replication Of An Existing Kafka Topic Against The Desired Values
+//
+// Kafka only supports increasing the number of partitions in a Topic, so a request for fewer partitions
+// is returned as an error (resulting in a failed TopicReady condition) rather than being applied.  An
+// increase changes the key-to-partition mapping (breaking any per-key ordering assumptions across the
+// change), so it is surfaced as a distinct TopicPartitionsIncreased Warning event.  The
+// replication factor cannot be changed via the admin API without a manual partition reassignment, so
+// a mismatch is only surfaced as a Warning event.  AdminClients which cannot describe topics return
+// nil metadata, in which case no drift detection is performed.
+//
+func (r *Reconciler) reconcileTopicPartitions(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string, numPartitions int32, replicationFactor int16) error {
+
+	// Describe The Current Topic
+	topicMetadata, topicError := r.adminClient.DescribeTopic(ctx, topicName)
+	err := adminutil.WrapTopicError(topicError)
+	recordTopicOperation(ctx, TopicOperationDescribe, topicOperationResult(err))
 	if err != nil {
-		switch err.Err {
-		case sarama.ErrNoError:
-			logger.Info("Successfully Created New Kafka Topic (ErrNoError)")
-			return nil
-		case sarama.ErrTopicAlreadyExists:
-			logger.Info("Kafka Topic Already Exists - No Creation Required")
-			return nil
-		default:
-			logger.Error("Failed To Create Topic", zap.Any("TopicError", err))
+		logger.Error("Failed To Describe Topic", zap.Any("TopicError", topicError))
+		return err
+	} else if topicMetadata == nil {
+		logger.Debug("Kafka Topic Metadata Not Available - Skipping Partition Reconciliation")
+		return nil
+	}
+
+	// Surface Any Replication Factor Mismatch In The Status (Not Supported By The Kafka Admin API)
+	currentPartitions := int32(len(topicMetadata.Partitions))
+	if currentPartitions > 0 && topicMetadata.Partitions[0] != nil {
+		r.reconcileReplicationFactorMismatch(ctx, logger, channel, topicName, int16(len(topicMetadata.Partitions[0].Replicas)), replicationFactor)
+	}
+
+	// Reconcile The Partition Count (Increase Only)
+	if numPartitions < currentPartitions {
+		logger.Error("Unable To Reduce Kafka Topic Partitions", zap.Int32("Current", currentPartitions), zap.Int32("Desired", numPartitions))
+		return fmt.Errorf("unable to reduce Kafka topic partitions from %d to %d - kafka only supports increasing the number of partitions", currentPartitions, numPartitions)
+	} else if numPartitions > currentPartitions {
+		topicError = r.adminClient.CreatePartitions(ctx, topicName, numPartitions)
+		err = adminutil.WrapTopicError(topicError)
+		recordTopicOperation(ctx, TopicOperationAlter, topicOperationResult(err))
+		if err != nil {
+			logger.Error("Failed To Increase Kafka Topic Partitions", zap.Any("TopicError", topicError))
 			return err
 		}
-	} else {
-		logger.Info("Successfully Created New Kafka Topic (Nil TopicError)")
-		return nil
+		logger.Warn("Increased Kafka Topic Partitions - Key-To-Partition Mapping Has Changed & Per-Key Ordering May Be Affected",
+			zap.Int32("Previous", currentPartitions), zap.Int32("Current", numPartitions))
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.TopicPartitionsIncreased.String(),
+			"Increased Kafka Topic %s Partitions From %d To %d - Key-To-Partition Mapping Has Changed", topicName, currentPartitions, numPartitions)
 	}
+	return nil
 }
 
-// Delete The Specified Kafka Topic
-func (r *Reconciler) deleteTopic(ctx context.Context, logger *zap.Logger, topicName string) error {
+//
+// Reconcile The Configuration Of An Existing Kafka Topic Against The Desired ConfigEntries
+//
+// Only entries which are present in the live Topic configuration and differ from the desired value are
+// considered drift (AdminClients which cannot describe topic configuration return an empty map).  The
+// full set of desired ConfigEntries is then applied, which the AdminClient merges into the Topic's other
+// existing overrides (the Kafka AlterConfigs API is not incremental) so that they are left unchanged.
+// Values are compared in their canonical form so that equivalent representations never cause repeated
+// (spurious) AlterConfigs calls, and the drift is reported in ConfigEntry name order.  Brokers silently
+// clamp values outside of their enforced limits, so the configuration is described again after altering
+// it and any value which still differs is recorded (with its effective value) in a KafkaChannel status
+// annotation, with a single warning event, and reconciled against the effective value from then on
+// (rather than being altered on every reconciliation) until the desired value changes.
+//
+func (r *Reconciler) reconcileTopicConfig(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string, configEntries map[string]*string) error {
 
-	// Attempt To Delete The Topic & Process Results
-	err := r.adminClient.DeleteTopic(ctx, topicName)
+	// Describe The Current Topic Configuration
+	topicConfig, topicError := r.adminClient.DescribeTopicConfig(ctx, topicName)
+	err := adminutil.WrapTopicError(topicError)
+	recordTopicOperation(ctx, TopicOperationDescribe, topicOperationResult(err))
 	if err != nil {
-		switch err.Err {
-		case sarama.ErrNoError:
-			logger.Info("Successfully Deleted Existing Kafka Topic (ErrNoError)")
-			return nil
-		case sarama.ErrUnknownTopicOrPartition, sarama.ErrInvalidTopic, sarama.ErrInvalidPartitions:
-			logger.Info("Kafka Topic or Partition Not Found - No Deletion Required")
-			return nil
-		case sarama.ErrInvalidConfig:
-			if r.config.Kafka.AdminType == constants.KafkaAdminTypeValueAzure {
-				// While this could be a valid Kafka error, this most likely is coming from our custom EventHub AdminClient
-				// implementation and represents the fact that the EventHub Cache does not contain this topic.  This can
-				// happen when an EventHub could not be created due to exceeding the number of allowable EventHubs.  The
-				// KafkaChannel is then in an "UNKNOWN" state having never been fully reconciled.  We want to swallow this
-				// error here so that the deletion of the Topic / EventHub doesn't block the deletion of the KafkaChannel.
-				logger.Warn("Unable To Delete Topic Due To Invalid Kafka Topic Config (Likely EventHub Namespace Cache)", zap.Error(err))
-				return nil
-			} else {
-				logger.Error("Failed To Delete Topic Due To Invalid Config", zap.Any("TopicError", err))
-				return err
+		logger.Error("Failed To Describe Topic Config", zap.Any("TopicError", topicError))
+		return err
+	}
+
+	// Converge On The Effective Values Of Any Desired ConfigEntries Previously Clamped By The Brokers Instead
+	clamped := topicConfigClamped(channel, configEntries)
+	effectiveEntries := clampedTopicConfigEntries(configEntries, clamped)
+
+	// Determine Whether Any Of The (Effective) Desired ConfigEntries Have Drifted
+	drifted := topicConfigDrift(effectiveEntries, topicConfig)
+	if len(drifted) == 0 {
+		logger.Debug("Kafka Topic Config Matches Desired Config - No Update Required")
+		setTopicConfigClamped(channel, clamped)
+		return nil
+	}
+
+	// Alter The Topic Configuration To Converge On The Desired ConfigEntries (Preserving Any Owner Tag)
+	logger.Info("Kafka Topic Config Drift Detected - Updating", zap.Strings("Drift", drifted))
+	topicError = r.adminClient.AlterTopicConfig(ctx, topicName, effectiveEntries)
+	err = adminutil.WrapTopicError(topicError)
+	recordTopicOperation(ctx, TopicOperationAlter, topicOperationResult(err))
+	if err != nil {
+		logger.Error("Failed To Alter Topic Config", zap.Any("TopicError", topicError))
+		return err
+	}
+	controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeNormal, event.KafkaTopicConfigUpdated.String(), "Updated Kafka Topic Config (%s)", strings.Join(drifted, ", "))
+
+	// Describe The Altered Topic Configuration To Detect Any Values Clamped By The Brokers
+	alteredTopicConfig, topicError := r.adminClient.DescribeTopicConfig(ctx, topicName)
+	err = adminutil.WrapTopicError(topicError)
+	recordTopicOperation(ctx, TopicOperationDescribe, topicOperationResult(err))
+	if err != nil {
+		logger.Warn("Failed To Describe Altered Topic Config - Unable To Detect Clamped Values", zap.Any("TopicError", topicError))
+		setTopicConfigClamped(channel, clamped)
+		return nil
+	}
+	newlyClamped := make([]string, 0)
+	for _, name := range sortedTopicConfigNames(effectiveEntries) {
+		value := effectiveEntries[name]
+		alteredValue, ok := alteredTopicConfig[name]
+		if ok && value != nil && canonicalTopicConfigValue(alteredValue) != canonicalTopicConfigValue(*value) {
+			clamped[name] = topicConfigClamp{Desired: *configEntries[name], Effective: alteredValue}
+			newlyClamped = append(newlyClamped, fmt.Sprintf("%s: %s -> %s", name, *configEntries[name], alteredValue))
+		}
+	}
+	if len(newlyClamped) > 0 {
+		logger.Warn("Kafka Topic Config Clamped By Brokers", zap.Strings("Clamped", newlyClamped))
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicConfigClamped.String(), "Kafka Topic Config Clamped By Brokers (%s)", strings.Join(newlyClamped, ", "))
+	}
+	setTopicConfigClamped(channel, clamped)
+	return nil
+}
+
+// The Desired & Effective Values Of A Topic Config Value Clamped By The Brokers (Recorded In The KafkaChannel Status)
+type topicConfigClamp struct {
+	Desired   string `json:"desired"`
+	Effective string `json:"effective"`
+}
+
+// Get The Recorded Topic Config Clamps Of The Specified Channel Which Still Apply To The Desired ConfigEntries (Empty If None / Invalid)
+func topicConfigClamped(channel *kafkav1beta1.KafkaChannel, configEntries map[string]*string) map[string]topicConfigClamp {
+	clamped := make(map[string]topicConfigClamp)
+	if clampedJson, ok := channel.Status.Annotations[constants.TopicConfigClampedAnnotation]; ok {
+		recorded := make(map[string]topicConfigClamp)
+		if err := json.Unmarshal([]byte(clampedJson), &recorded); err == nil {
+			for name, clamp := range recorded {
+				if value := configEntries[name]; value != nil && canonicalTopicConfigValue(*value) == canonicalTopicConfigValue(clamp.Desired) {
+					clamped[name] = clamp
+				}
 			}
-		default:
-			logger.Error("Failed To Delete Topic", zap.Any("TopicError", err))
+		}
+	}
+	return clamped
+}
+
+// Get The Desired ConfigEntries With Any Clamped Values Replaced By Their Effective Values
+func clampedTopicConfigEntries(configEntries map[string]*string, clamped map[string]topicConfigClamp) map[string]*string {
+	effectiveEntries := make(map[string]*string, len(configEntries))
+	for name, value := range configEntries {
+		effectiveEntries[name] = value
+		if clamp, ok := clamped[name]; ok {
+			effective := clamp.Effective
+			effectiveEntries[name] = &effective
+		}
+	}
+	return effectiveEntries
+}
+
+// Record The Specified Topic Config Clamps In The Channel's Status Annotations (Removing The Annotation If There Are None)
+func setTopicConfigClamped(channel *kafkav1beta1.KafkaChannel, clamped map[string]topicConfigClamp) {
+	if len(clamped) == 0 {
+		delete(channel.Status.Annotations, constants.TopicConfigClampedAnnotation)
+		return
+	}
+	clampedJson, err := json.Marshal(clamped)
+	if err != nil {
+		return
+	}
+	if channel.Status.Annotations == nil {
+		channel.Status.Annotations = make(map[string]string)
+	}
+	channel.Status.Annotations[constants.TopicConfigClampedAnnotation] = string(clampedJson)
+}
+
+// The Current & Desired ReplicationFactor Of A Kafka Topic Recorded In The TopicReplicationFactorMismatchAnnotation
+type replicationFactorMismatch struct {
+	Current int16 `json:"current"`
+	Desired int16 `json:"desired"`
+}
+
+//
+// Record Any Mismatch Between The Kafka Topic's Current & Desired ReplicationFactor In The KafkaChannel's Status
+//
+// Changing the replication factor requires a manual partition reassignment, so a mismatch persists across
+// reconciliations.  It is recorded in the TopicReplicationFactorMismatchAnnotation, and the Warning event is
+// only emitted when that recorded mismatch changes (rather than on every reconciliation).
+//
+func (r *Reconciler) reconcileReplicationFactorMismatch(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string, currentReplicationFactor int16, replicationFactor int16) {
+
+	// Clear Any Recorded Mismatch Once The ReplicationFactor Matches
+	if currentReplicationFactor == replicationFactor {
+		delete(channel.Status.Annotations, constants.TopicReplicationFactorMismatchAnnotation)
+		return
+	}
+
+	// Record The Mismatch & Emit The Warning Event Only When It Has Changed
+	logger.Warn("Kafka Topic ReplicationFactor Does Not Match Desired ReplicationFactor",
+		zap.Int16("Current", currentReplicationFactor), zap.Int16("Desired", replicationFactor))
+	mismatchJson, err := json.Marshal(replicationFactorMismatch{Current: currentReplicationFactor, Desired: replicationFactor})
+	if err != nil || channel.Status.Annotations[constants.TopicReplicationFactorMismatchAnnotation] == string(mismatchJson) {
+		return
+	}
+	if channel.Status.Annotations == nil {
+		channel.Status.Annotations = make(map[string]string)
+	}
+	channel.Status.Annotations[constants.TopicReplicationFactorMismatchAnnotation] = string(mismatchJson)
+	controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicReplicationFactorMismatch.String(),
+		"Kafka Topic %s ReplicationFactor (%d) Does Not Match Desired ReplicationFactor (%d) - Manual Partition Reassignment Required", topicName, currentReplicationFactor, replicationFactor)
+}
+
+// Get The Names Of The Specified ConfigEntries In Sorted Order
+func sortedTopicConfigNames(configEntries map[string]*string) []string {
+	names := make([]string, 0, len(configEntries))
+	for name := range configEntries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get The Drift ("name: current -> desired") Of The Desired ConfigEntries Present In The Live Topic Config (Sorted By Name)
+func topicConfigDrift(configEntries map[string]*string, topicConfig map[string]string) []string {
+	drifted := make([]string, 0)
+	for _, name := range sortedTopicConfigNames(configEntries) {
+		value := configEntries[name]
+		currentValue, ok := topicConfig[name]
+		if ok && value != nil && canonicalTopicConfigValue(currentValue) != canonicalTopicConfigValue(*value) {
+			drifted = append(drifted, fmt.Sprintf("%s: %s -> %s", name, currentValue, *value))
+		}
+	}
+	return drifted
+}
+
+//
+// Get The Canonical Form Of A Kafka Topic Config Value For Comparison
+//
+// Kafka returns numeric values in their plain decimal form and list values (e.g. a "compact,delete"
+// cleanup.policy) in an unspecified order, so whitespace is trimmed, integers are re-formatted, and list
+// elements are lower-cased & sorted.  Values which are not integers or lists are only trimmed.
+//
+func canonicalTopicConfigValue(value string) string {
+	value = strings.TrimSpace(value)
+	if intValue, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return strconv.FormatInt(intValue, 10)
+	}
+	if strings.Contains(value, ",") {
+		elements := strings.Split(value, ",")
+		for index, element := range elements {
+			elements[index] = strings.ToLower(strings.TrimSpace(element))
+		}
+		sort.Strings(elements)
+		return strings.Join(elements, ",")
+	}
+	return value
+}
+
+//
+// Reconcile The ACLs Granting The Dispatcher (Read) & Receiver (Write) Principals Access To The Kafka Topic
+//
+// ACLs are only created when enabled in the ConfigMap, and only for the principals which are configured.
+// Creating an existing ACL is a no-op in Kafka, so they are (re)created on every reconciliation.  Clusters
+// without an authorizer, and AdminClients which do not support ACLs, result in a failed TopicReady condition
+// rather than a Topic which the Dispatcher / Receiver are silently unable to use.
+//
+func (r *Reconciler) reconcileTopicACLs(ctx context.Context, logger *zap.Logger, topicName string) error {
+	acls, err := r.topicACLs()
+	if err != nil {
+		logger.Error("Invalid Kafka Topic ACL Configuration", zap.Error(err))
+		return err
+	}
+	for _, acl := range acls {
+		topicError := r.adminClient.CreateTopicACL(ctx, topicName, acl)
+		if err := adminutil.WrapTopicError(topicError); err != nil {
+			logger.Error("Failed To Create Kafka Topic ACL", zap.String("Principal", acl.Principal), zap.Any("TopicError", topicError))
 			return err
 		}
+	}
+	if len(acls) > 0 {
+		logger.Debug("Successfully Reconciled Kafka Topic ACLs", zap.Int("Count", len(acls)))
+	}
+	return nil
+}
+
+// Delete The ACLs Granting The Dispatcher & Receiver Principals Access To The Kafka Topic (When Enabled)
+func (r *Reconciler) finalizeTopicACLs(ctx context.Context, logger *zap.Logger, topicName string) error {
+	acls, err := r.topicACLs()
+	if err != nil {
+		return err
+	}
+	for _, acl := range acls {
+		topicError := r.adminClient.DeleteTopicACL(ctx, topicName, acl)
+		if err := adminutil.WrapTopicError(topicError); err != nil {
+			logger.Error("Failed To Delete Kafka Topic ACL", zap.String("Principal", acl.Principal), zap.Any("TopicError", topicError))
+			return err
+		}
+	}
+	return nil
+}
+
+// Get The Kafka Topic ACLs For The Configured Dispatcher (Read) & Receiver (Write) Principals (None Unless Enabled)
+func (r *Reconciler) topicACLs() ([]sarama.Acl, error) {
+	aclConfig := r.config.Kafka.TopicACLs
+	acls := make([]sarama.Acl, 0, 2)
+	if !aclConfig.Enabled {
+		return acls, nil
+	}
+	for _, principal := range []struct {
+		name      string
+		operation sarama.AclOperation
+	}{
+		{name: aclConfig.DispatcherPrincipal, operation: sarama.AclOperationRead},
+		{name: aclConfig.ReceiverPrincipal, operation: sarama.AclOperationWrite},
+	} {
+		if len(principal.name) == 0 {
+			continue
+		} else if !strings.Contains(principal.name, ":") {
+			return nil, fmt.Errorf("invalid Kafka topic ACL principal '%s' - expected '<type>:<name>' (e.g. 'User:dispatcher')", principal.name)
+		}
+		acls = append(acls, sarama.Acl{
+			Principal:      principal.name,
+			Host:           constants.KafkaTopicACLHost,
+			Operation:      principal.operation,
+			PermissionType: sarama.AclPermissionAllow,
+		})
+	}
+	return acls, nil
+}
+
+// Create The Sarama TopicDetail For The Specified Kafka Topic Configuration
+func newTopicDetail(partitions int32, replicationFactor int16, retentionMillis int64, cleanupPolicy string, maxMessageBytes int32) *sarama.TopicDetail {
+	return &sarama.TopicDetail{
+		NumPartitions:     partitions,
+		ReplicationFactor: replicationFactor,
+		ReplicaAssignment: nil, // Automatic Assignment Unless Broker Weights Are Configured (See assignTopicReplicas)
+		ConfigEntries:     newTopicConfigEntries(retentionMillis, cleanupPolicy, maxMessageBytes),
+	}
+}
+
+// Create The Kafka Topic ConfigEntries For The Specified Kafka Topic Configuration (Zero MaxMessageBytes Uses The Broker Default)
+func newTopicConfigEntries(retentionMillis int64, cleanupPolicy string, maxMessageBytes int32) map[string]*string {
+	retentionMillisString := strconv.FormatInt(retentionMillis, 10)
+	configEntries := map[string]*string{
+		constants.KafkaTopicConfigRetentionMs:   &retentionMillisString,
+		constants.KafkaTopicConfigCleanupPolicy: &cleanupPolicy,
+	}
+	if maxMessageBytes > 0 {
+		maxMessageBytesString := strconv.FormatInt(int64(maxMessageBytes), 10)
+		configEntries[constants.KafkaTopicConfigMaxMessageBytes] = &maxMessageBytesString
+	}
+	return configEntries
+}
+
+// Delete The Specified Kafka Topic (Returning Whether An Existing Topic Was Actually Deleted)
+func (r *Reconciler) deleteTopic(ctx context.Context, logger *zap.Logger, topicName string) (bool, error) {
+
+	// Attempt To Delete The Topic (Giving Up After Any Finalization Timeout) & Process Results
+	topicError, err := r.deleteTopicWithTimeout(ctx, topicName)
+	var timeoutErr *topicDeletionTimeoutError
+	if errors.As(err, &timeoutErr) {
+		logger.Warn("Timed Out Deleting Kafka Topic - Abandoning Deletion To The Background", zap.Error(err))
+		return false, err // The Operation Is Recorded Once The Abandoned Deletion Returns
+	} else if err != nil {
+		logger.Error("Failed To Delete Kafka Topic", zap.Error(err))
+		recordTopicOperation(ctx, TopicOperationDelete, TopicResultError)
+		return false, err
+	}
+	return r.processDeleteTopicResult(ctx, logger, topicError)
+}
+
+// Process The Result Of A Kafka Topic Deletion (Returning Whether An Existing Topic Was Actually Deleted)
+func (r *Reconciler) processDeleteTopicResult(ctx context.Context, logger *zap.Logger, topicError *sarama.TopicError) (bool, error) {
+	err := adminutil.WrapTopicError(topicError)
+	switch {
+	case err == nil:
+		logger.Info("Successfully Deleted Existing Kafka Topic")
+		recordTopicOperation(ctx, TopicOperationDelete, TopicResultSuccess)
+		return true, nil
+	case errors.Is(err, adminutil.ErrUnknownTopic), errors.Is(err, sarama.ErrInvalidTopic), errors.Is(err, sarama.ErrInvalidPartitions):
+		logger.Info("Kafka Topic or Partition Not Found - No Deletion Required")
+		recordTopicOperation(ctx, TopicOperationDelete, TopicResultNotFound)
+		return false, nil
+	case errors.Is(err, sarama.ErrInvalidConfig):
+		if r.config.Kafka.AdminType == constants.KafkaAdminTypeValueAzure {
+			// While this could be a valid Kafka error, this most likely is coming from our custom EventHub AdminClient
+			// implementation and represents the fact that the EventHub Cache does not contain this topic.  This can
+			// happen when an EventHub could not be created due to exceeding the number of allowable EventHubs.  The
+			// KafkaChannel is then in an "UNKNOWN" state having never been fully reconciled.  We want to swallow this
+			// error here so that the deletion of the Topic / EventHub doesn't block the deletion of the KafkaChannel.
+			logger.Warn("Unable To Delete Topic Due To Invalid Kafka Topic Config (Likely EventHub Namespace Cache)", zap.Error(err))
+			recordTopicOperation(ctx, TopicOperationDelete, TopicResultNotFound)
+			return false, nil
+		} else {
+			logger.Error("Failed To Delete Topic Due To Invalid Config", zap.Any("TopicError", topicError))
+			recordTopicOperation(ctx, TopicOperationDelete, TopicResultError)
+			return false, err
+		}
+	default:
+		logger.Error("Failed To Delete Topic", zap.Any("TopicError", topicError))
+		recordTopicOperation(ctx, TopicOperationDelete, TopicResultError)
+		return false, err
+	}
+}
+
+// Error Returned When A Kafka Topic Deletion Is Abandoned After The Finalization Timeout (Wraps context.DeadlineExceeded)
+type topicDeletionTimeoutError struct {
+	err             error
+	kafkaSecretName string
+	result          <-chan *sarama.TopicError // Receives The Eventual Result Of The Abandoned Deletion
+}
+
+// Return The Timeout Error's Message
+func (e *topicDeletionTimeoutError) Error() string {
+	return e.err.Error()
+}
+
+// Return The Wrapped Timeout Error (e.g. context.DeadlineExceeded)
+func (e *topicDeletionTimeoutError) Unwrap() error {
+	return e.err
+}
+
+//
+// Delete The Specified Kafka Topic Via The AdminClient, Giving Up After The Topic Finalization Timeout (If Configured)
+//
+// Broker-side Topic deletion can stall indefinitely (and most AdminClients are not context aware), which would
+// otherwise block the KafkaChannel's finalization forever.  The deletion is therefore performed in the background
+// (with a context bounded by the timeout for those AdminClients which are context aware), and abandoned with a
+// topicDeletionTimeoutError once the timeout expires.  The abandoned deletion may still be running (and may even
+// still succeed) after the finalization has moved on, so it uses a dedicated AdminClient rather than the shared /
+// pooled one (which is cleared, and possibly reused, after finalization), and closes it only once it returns.
+//
+func (r *Reconciler) deleteTopicWithTimeout(ctx context.Context, topicName string) (*sarama.TopicError, error) {
+
+	// Delete The Topic Directly When No Timeout Is Configured
+	timeout := time.Duration(r.config.Kafka.TopicFinalization.TimeoutMillis) * time.Millisecond
+	if timeout <= 0 {
+		return r.adminClient.DeleteTopic(ctx, topicName), nil
+	}
+
+	// Create A Dedicated AdminClient Which Is Owned (And Closed) By The Background Deletion
+	adminClient, err := kafkaadmin.CreateAdminClient(ctx, r.saramaConfig, constants.ControllerComponentName, r.adminClientType)
+	if err == nil && adminClient == nil {
+		err = fmt.Errorf(constants.KafkaAdminClientUnavailableError)
+	}
+	if err != nil {
+		return nil, err
+	}
+	kafkaSecretName := adminClient.GetKafkaSecretName(topicName)
+
+	// Delete The Topic In The Background
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	topicErrorChan := make(chan *sarama.TopicError, 1)
+	go func() {
+		defer cancel()
+		topicError := adminClient.DeleteTopic(timeoutCtx, topicName)
+		_ = adminClient.Close()
+		topicErrorChan <- topicError
+	}()
+
+	// Wait For The Deletion Or The Timeout, Whichever Comes First
+	select {
+	case topicError := <-topicErrorChan:
+		return topicError, nil
+	case <-timeoutCtx.Done():
+		return nil, &topicDeletionTimeoutError{
+			err:             fmt.Errorf("timed out deleting kafka topic %s after %v: %w", topicName, timeout, timeoutCtx.Err()),
+			kafkaSecretName: kafkaSecretName,
+			result:          topicErrorChan,
+		}
+	}
+}
+
+//
+// Await The Eventual Result Of A Kafka Topic Deletion Abandoned After The Finalization Timeout
+//
+// The abandoned deletion may still succeed, so it is only audited (and the Topic only recorded as orphaned when
+// allowed) once it has actually returned.  Without AllowOrphan the retained finalizer retries the deletion anyway.
+// A deletion which never returns is surfaced solely by the KafkaTopicFinalizationTimedOut event.
+//
+func (r *Reconciler) awaitAbandonedTopicDeletion(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string, timeoutErr *topicDeletionTimeoutError, allowOrphan bool) {
+	deleted, err := r.processDeleteTopicResult(ctx, logger, <-timeoutErr.result)
+	if deleted || err != nil {
+		r.auditKafkaTopicDeletion(ctx, logger, channel, topicName, timeoutErr.kafkaSecretName, err)
+	}
+	if err != nil && allowOrphan {
+		r.auditKafkaTopicOrphaned(ctx, logger, channel, topicName, timeoutErr.kafkaSecretName)
+	}
+}
+
+//
+// Kafka Topic Lifecycle Audit
+//
+// Every actual creation / deletion attempt of a Kafka Topic (not those which already exist / were not found)
+// is recorded as a dedicated K8S Event (KafkaTopicAudit* reasons) and as a structured log entry with a
+// consistent schema (KafkaTopicAuditLogMessage plus the Audit* fields) so that they can be filtered into
+// an audit pipeline independently of the general reconciliation events.
+//
+
+// Audit The Creation Attempt Of The Specified Kafka Topic
+func (r *Reconciler) auditKafkaTopicCreation(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string, numPartitions int32, replicationFactor int16, err error) {
+	kafkaSecretName := r.adminClientKafkaSecretName(topicName)
+	auditFields := append(topicAuditFields(channel, constants.KafkaTopicAuditActionCreate, kafkaSecretName, err),
+		zap.Int32("AuditNumPartitions", numPartitions), zap.Int16("AuditReplicationFactor", replicationFactor))
+	if err != nil {
+		logger.Error(constants.KafkaTopicAuditLogMessage, auditFields...)
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicAuditCreationFailed.String(),
+			"Failed To Create Kafka Topic %s (NumPartitions: %d, ReplicationFactor: %d, KafkaSecret: %s, Trigger: %s): %v", topicName, numPartitions, replicationFactor, kafkaSecretName, topicAuditTrigger(channel), err)
 	} else {
-		logger.Info("Successfully Deleted Existing Kafka Topic (Nil TopicError)")
-		return nil
+		logger.Info(constants.KafkaTopicAuditLogMessage, auditFields...)
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeNormal, event.KafkaTopicAuditCreated.String(),
+			"Created Kafka Topic %s (NumPartitions: %d, ReplicationFactor: %d, KafkaSecret: %s, Trigger: %s)", topicName, numPartitions, replicationFactor, kafkaSecretName, topicAuditTrigger(channel))
+	}
+}
+
+// Audit The Deletion Attempt Of The Specified Kafka Topic
+func (r *Reconciler) auditKafkaTopicDeletion(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string, kafkaSecretName string, err error) {
+	auditFields := topicAuditFields(channel, constants.KafkaTopicAuditActionDelete, kafkaSecretName, err)
+	if err != nil {
+		logger.Error(constants.KafkaTopicAuditLogMessage, auditFields...)
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicAuditDeletionFailed.String(),
+			"Failed To Delete Kafka Topic %s (KafkaSecret: %s, Trigger: %s): %v", topicName, kafkaSecretName, topicAuditTrigger(channel), err)
+	} else {
+		logger.Info(constants.KafkaTopicAuditLogMessage, auditFields...)
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeNormal, event.KafkaTopicAuditDeleted.String(),
+			"Deleted Kafka Topic %s (KafkaSecret: %s, Trigger: %s)", topicName, kafkaSecretName, topicAuditTrigger(channel))
 	}
 }
+
+// Audit The Orphaning Of The Specified Kafka Topic (Finalization Allowed To Succeed Despite The Abandoned Topic Deletion Failing)
+func (r *Reconciler) auditKafkaTopicOrphaned(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string, kafkaSecretName string) {
+	logger.Warn(constants.KafkaTopicAuditLogMessage, topicAuditFields(channel, constants.KafkaTopicAuditActionOrphan, kafkaSecretName, nil)...)
+	controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicAuditOrphaned.String(),
+		"Orphaned Kafka Topic %s Requires Manual Cleanup (KafkaSecret: %s, Trigger: %s)", topicName, kafkaSecretName, topicAuditTrigger(channel))
+}
+
+// Create The Common Structured Log Fields For A Kafka Topic Audit Entry (The Logger Already Includes The TopicName)
+func topicAuditFields(channel *kafkav1beta1.KafkaChannel, action string, kafkaSecretName string, err error) []zap.Field {
+	result := constants.KafkaTopicAuditResultSuccess
+	if err != nil {
+		result = constants.KafkaTopicAuditResultFailure
+	}
+	return []zap.Field{
+		zap.String("AuditAction", action),
+		zap.String("AuditResult", result),
+		zap.String("AuditKafkaSecret", kafkaSecretName),
+		zap.String("AuditTrigger", topicAuditTrigger(channel)),
+		zap.String("AuditComponent", constants.ControllerComponentName),
+		zap.Error(err),
+	}
+}
+
+// Describe The Trigger (Owning KafkaChannel) Of A Kafka Topic Audit Entry
+func topicAuditTrigger(channel *kafkav1beta1.KafkaChannel) string {
+	return fmt.Sprintf("%s %s/%s", constants.KafkaChannelKind, channel.Namespace, channel.Name)
+}
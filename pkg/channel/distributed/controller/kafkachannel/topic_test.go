@@ -18,16 +18,30 @@ package kafkachannel
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
 	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
 	"knative.dev/pkg/controller"
 	logtesting "knative.dev/pkg/logging/testing"
 )
@@ -85,7 +99,10 @@ func TestReconcileTopic(t *testing.T) {
 			WantTopicDetail: &sarama.TopicDetail{
 				NumPartitions:     controllertesting.NumPartitions,
 				ReplicationFactor: controllertesting.ReplicationFactor,
-				ConfigEntries:     map[string]*string{constants.KafkaTopicConfigRetentionMs: &controllertesting.DefaultRetentionMillisString},
+				ConfigEntries: map[string]*string{
+					constants.KafkaTopicConfigRetentionMs:   &controllertesting.DefaultRetentionMillisString,
+					constants.KafkaTopicConfigCleanupPolicy: &controllertesting.DefaultCleanupPolicyString,
+				},
 			},
 		},
 		{
@@ -104,7 +121,10 @@ func TestReconcileTopic(t *testing.T) {
 			WantTopicDetail: &sarama.TopicDetail{
 				NumPartitions:     controllertesting.NumPartitions,
 				ReplicationFactor: controllertesting.ReplicationFactor,
-				ConfigEntries:     map[string]*string{constants.KafkaTopicConfigRetentionMs: &controllertesting.DefaultRetentionMillisString},
+				ConfigEntries: map[string]*string{
+					constants.KafkaTopicConfigRetentionMs:   &controllertesting.DefaultRetentionMillisString,
+					constants.KafkaTopicConfigCleanupPolicy: &controllertesting.DefaultCleanupPolicyString,
+				},
 			},
 			MockErrorCode: sarama.ErrTopicAlreadyExists,
 		},
@@ -124,7 +144,10 @@ func TestReconcileTopic(t *testing.T) {
 			WantTopicDetail: &sarama.TopicDetail{
 				NumPartitions:     controllertesting.NumPartitions,
 				ReplicationFactor: controllertesting.ReplicationFactor,
-				ConfigEntries:     map[string]*string{constants.KafkaTopicConfigRetentionMs: &controllertesting.DefaultRetentionMillisString},
+				ConfigEntries: map[string]*string{
+					constants.KafkaTopicConfigRetentionMs:   &controllertesting.DefaultRetentionMillisString,
+					constants.KafkaTopicConfigCleanupPolicy: &controllertesting.DefaultCleanupPolicyString,
+				},
 			},
 			MockErrorCode: sarama.ErrBrokerNotAvailable,
 			WantError:     sarama.ErrBrokerNotAvailable.Error() + " - " + controllertesting.ErrorString,
@@ -284,3 +307,1282 @@ func createMockAdminClientForTestCase(t *testing.T, tc TopicTestCase) *controlle
 		},
 	}
 }
+
+// Test The Kafka Topic Reconciliation & Finalization In DryRun Mode
+func TestReconcileTopicDryRun(t *testing.T) {
+
+	// Setup Context With New Recorder For Testing
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+
+	// Create A Mock Kafka AdminClient Which Should Never Be Called
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+			t.Error("Unexpected CreateTopics() Call")
+			return nil
+		},
+		MockDeleteTopicFunc: func(ctx context.Context, topicName string) *sarama.TopicError {
+			t.Error("Unexpected DeleteTopics() Call")
+			return nil
+		},
+	}
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: mockAdminClient,
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Create A KafkaChannel With The DryRun Annotation
+	channel := controllertesting.NewKafkaChannel(
+		controllertesting.WithFinalizer,
+		controllertesting.WithInitializedConditions,
+		controllertesting.WithDryRunAnnotation,
+	)
+
+	// Perform The Test (Create)
+	err := r.reconcileKafkaTopic(ctx, channel)
+	assert.Nil(t, err)
+	assert.False(t, mockAdminClient.CreateTopicsCalled())
+	topicCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionTopicReady)
+	assert.NotNil(t, topicCondition)
+	assert.Equal(t, corev1.ConditionUnknown, topicCondition.Status)
+	assert.Equal(t, "TopicDryRun", topicCondition.Reason)
+	assert.Contains(t, <-recorder.Events, "DryRun - Would Create Kafka Topic "+controllertesting.TopicName)
+
+	// Perform The Test (Delete An Existing Topic - Retaining The Finalizer With A Warning)
+	err = r.finalizeKafkaTopic(ctx, channel)
+	assert.NotNil(t, err)
+	assert.Equal(t, constants.KafkaTopicDryRunRetainedError, err.Error())
+	assert.False(t, mockAdminClient.DeleteTopicsCalled())
+	assert.Equal(t, fmt.Sprintf("Warning %s DryRun - Would Delete Kafka Topic %s (Finalizer Retained Until DryRun Is Disabled)", event.KafkaTopicDryRun.String(), controllertesting.TopicName), <-recorder.Events)
+
+	// Perform The Test (Delete A Topic Which Was Never Created)
+	mockAdminClient.MockDescribeTopicFunc = func(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+		return nil, &sarama.TopicError{Err: sarama.ErrUnknownTopicOrPartition}
+	}
+	err = r.finalizeKafkaTopic(ctx, channel)
+	assert.Nil(t, err)
+	assert.False(t, mockAdminClient.DeleteTopicsCalled())
+	assert.Contains(t, <-recorder.Events, "DryRun - Kafka Topic "+controllertesting.TopicName+" Does Not Exist")
+
+	// Perform The Test (Describe Failure)
+	mockAdminClient.MockDescribeTopicFunc = func(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+		return nil, &sarama.TopicError{Err: sarama.ErrRequestTimedOut}
+	}
+	assert.NotNil(t, r.finalizeKafkaTopic(ctx, channel))
+	assert.False(t, mockAdminClient.DeleteTopicsCalled())
+}
+
+// Test The Kafka Topic Finalization When The RetainTopic Annotation Is Added After Creation
+func TestFinalizeTopicRetained(t *testing.T) {
+
+	// Setup Context With New Recorder For Testing
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+
+	// Create A Mock Kafka AdminClient Which Should Only Create The Topic
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockDeleteTopicFunc: func(ctx context.Context, topicName string) *sarama.TopicError {
+			t.Error("Unexpected DeleteTopics() Call")
+			return nil
+		},
+	}
+
+	// Initialize The Reconciler
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: mockAdminClient,
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Create A KafkaChannel Without The RetainTopic Annotation
+	channel := controllertesting.NewKafkaChannel(
+		controllertesting.WithFinalizer,
+		controllertesting.WithInitializedConditions,
+	)
+
+	// Perform The Test (Create)
+	err := r.reconcileKafkaTopic(ctx, channel)
+	assert.Nil(t, err)
+	assert.True(t, mockAdminClient.CreateTopicsCalled())
+	assert.Equal(t, controllertesting.NewKafkaTopicAuditCreatedEvent(), <-recorder.Events)
+
+	// Add The RetainTopic Annotation Before Deletion
+	controllertesting.WithRetainTopicAnnotation(channel)
+	controllertesting.WithDeletionTimestamp(channel)
+
+	// Perform The Test (Delete)
+	err = r.finalizeKafkaTopic(ctx, channel)
+	assert.Nil(t, err)
+	assert.False(t, mockAdminClient.DeleteTopicsCalled())
+	assert.Contains(t, <-recorder.Events, "Retained Kafka Topic "+controllertesting.TopicName)
+}
+
+// Test The Kafka Topic Finalization When The Topic Deletion Exceeds The Finalization Timeout
+func TestFinalizeTopicTimeout(t *testing.T) {
+
+	// Test Data
+	errMsg := controllertesting.ErrorString
+	deleteTopicError := &sarama.TopicError{Err: sarama.ErrRequestTimedOut, ErrMsg: &errMsg}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name           string
+		stall          bool
+		lateError      *sarama.TopicError
+		allowOrphan    bool
+		wantError      bool
+		wantEvents     []string
+		wantLateEvents []string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:       "Within Timeout",
+			wantEvents: []string{event.KafkaTopicAuditDeleted.String()},
+		},
+		{
+			name:           "Strict",
+			stall:          true,
+			lateError:      deleteTopicError,
+			wantError:      true,
+			wantEvents:     []string{event.KafkaTopicFinalizationTimedOut.String()},
+			wantLateEvents: []string{event.KafkaTopicAuditDeletionFailed.String()},
+		},
+		{
+			name:           "Allow Orphan",
+			stall:          true,
+			lateError:      deleteTopicError,
+			allowOrphan:    true,
+			wantEvents:     []string{event.KafkaTopicFinalizationTimedOut.String()},
+			wantLateEvents: []string{event.KafkaTopicAuditDeletionFailed.String(), event.KafkaTopicAuditOrphaned.String()},
+		},
+		{
+			name:           "Allow Orphan Late Deletion",
+			stall:          true,
+			allowOrphan:    true,
+			wantEvents:     []string{event.KafkaTopicFinalizationTimedOut.String()},
+			wantLateEvents: []string{event.KafkaTopicAuditDeleted.String()},
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Mock Kafka AdminClient Whose Topic Deletion (Optionally) Stalls Until Released After The Timeout
+			stall := make(chan struct{})
+			mockAdminClient := &controllertesting.MockAdminClient{
+				MockDeleteTopicFunc: func(ctx context.Context, topicName string) *sarama.TopicError {
+					if testCase.stall {
+						<-stall
+						return testCase.lateError
+					}
+					return nil
+				},
+			}
+
+			// Mock The Creation Of The Dedicated Kafka AdminClient
+			newKafkaAdminClientWrapperPlaceholder := kafkaadmin.NewKafkaAdminClientWrapper
+			kafkaadmin.NewKafkaAdminClientWrapper = func(ctx context.Context, saramaConfig *sarama.Config, clientId string, namespace string) (kafkaadmin.AdminClientInterface, error) {
+				return mockAdminClient, nil
+			}
+			defer func() {
+				kafkaadmin.NewKafkaAdminClientWrapper = newKafkaAdminClientWrapperPlaceholder
+			}()
+
+			// Initialize The Reconciler With A Short Topic Finalization Timeout (The Shared AdminClient Is Never Used)
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			configuration := controllertesting.NewConfig()
+			configuration.Kafka.TopicFinalization = config.EKTopicFinalizationConfig{TimeoutMillis: 100, AllowOrphan: testCase.allowOrphan}
+			r := &Reconciler{
+				logger:          logtesting.TestLogger(t).Desugar(),
+				adminClient:     &controllertesting.MockAdminClient{},
+				adminClientType: kafkaadmin.Kafka,
+				config:          configuration,
+			}
+
+			// Perform The Test
+			channel := controllertesting.NewKafkaChannel(controllertesting.WithFinalizer, controllertesting.WithDeletionTimestamp)
+			err := r.finalizeKafkaTopic(ctx, channel)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantError, err != nil)
+			if testCase.wantError {
+				assert.True(t, errors.Is(err, context.DeadlineExceeded))
+			}
+			assert.Len(t, recorder.Events, len(testCase.wantEvents))
+			for _, wantEvent := range testCase.wantEvents {
+				assert.Contains(t, <-recorder.Events, wantEvent)
+			}
+			assert.Equal(t, !testCase.stall, mockAdminClient.CloseCalled())
+
+			// Release The Stalled Deletion & Verify Its Late Result Is Audited (And The Dedicated AdminClient Closed)
+			close(stall)
+			for _, wantLateEvent := range testCase.wantLateEvents {
+				select {
+				case lateEvent := <-recorder.Events:
+					assert.Contains(t, lateEvent, wantLateEvent)
+				case <-time.After(5 * time.Second):
+					assert.Fail(t, "Timed Out Waiting For Event", wantLateEvent)
+				}
+			}
+			assert.Len(t, recorder.Events, 0)
+			assert.True(t, mockAdminClient.CloseCalled())
+		})
+	}
+}
+
+// Test The Kafka Topic Reconciliation & Finalization With Topic Auto-Creation Disabled
+func TestReconcileTopicDisableAutoCreate(t *testing.T) {
+
+	// Test Data
+	errMsg := controllertesting.ErrorString
+	unknownTopicError := &sarama.TopicError{Err: sarama.ErrUnknownTopicOrPartition, ErrMsg: &errMsg}
+	describeError := &sarama.TopicError{Err: sarama.ErrBrokerNotAvailable, ErrMsg: &errMsg}
+	topicMetadata := &sarama.TopicMetadata{Err: sarama.ErrNoError, Name: controllertesting.TopicName}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name          string
+		metadata      *sarama.TopicMetadata
+		describeError *sarama.TopicError
+		wantError     bool
+		wantReason    string
+		wantEvent     string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:     "Topic Exists",
+			metadata: topicMetadata,
+		},
+		{
+			name: "Metadata Not Available",
+		},
+		{
+			name:       "Topic Leader Not Available",
+			metadata:   &sarama.TopicMetadata{Err: sarama.ErrNoError, Name: controllertesting.TopicName, Partitions: []*sarama.PartitionMetadata{{ID: 0, Leader: -1, Err: sarama.ErrLeaderNotAvailable}}},
+			wantError:  true,
+			wantReason: "TopicLeaderNotAvailable",
+			wantEvent:  "Kafka Topic " + controllertesting.TopicName + " Not Ready",
+		},
+		{
+			name:          "Topic Not Found",
+			describeError: unknownTopicError,
+			wantError:     true,
+			wantReason:    "TopicNotFound",
+			wantEvent:     "Kafka Topic " + controllertesting.TopicName + " Not Found - Topic Must Be Pre-Created",
+		},
+		{
+			name:          "Describe Error",
+			describeError: describeError,
+			wantError:     true,
+			wantReason:    "TopicFailed",
+			wantEvent:     "Failed To Verify Kafka Topic For Channel",
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Mock Kafka AdminClient Which Should Only Describe The Topic
+			mockAdminClient := &controllertesting.MockAdminClient{
+				MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+					t.Error("Unexpected CreateTopics() Call")
+					return nil
+				},
+				MockDeleteTopicFunc: func(ctx context.Context, topicName string) *sarama.TopicError {
+					t.Error("Unexpected DeleteTopics() Call")
+					return nil
+				},
+				MockDescribeTopicFunc: func(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+					assert.Equal(t, controllertesting.TopicName, topicName)
+					return testCase.metadata, testCase.describeError
+				},
+			}
+
+			// Initialize The Reconciler
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			r := &Reconciler{
+				logger:      logtesting.TestLogger(t).Desugar(),
+				adminClient: mockAdminClient,
+				config:      controllertesting.NewConfig(),
+			}
+
+			// Create A KafkaChannel With The DisableTopicAutoCreate Annotation
+			channel := controllertesting.NewKafkaChannel(
+				controllertesting.WithFinalizer,
+				controllertesting.WithInitializedConditions,
+				controllertesting.WithDisableTopicAutoCreateAnnotation,
+			)
+
+			// Perform The Test (Create)
+			err := r.reconcileKafkaTopic(ctx, channel)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantError, err != nil)
+			assert.False(t, mockAdminClient.CreateTopicsCalled())
+			assert.False(t, mockAdminClient.CreatePartitionsCalled())
+			topicCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionTopicReady)
+			assert.NotNil(t, topicCondition)
+			assert.Equal(t, !testCase.wantError, topicCondition.IsTrue())
+			if testCase.wantReason != "" {
+				assert.Equal(t, testCase.wantReason, topicCondition.Reason)
+			}
+			if testCase.wantEvent != "" {
+				assert.Contains(t, <-recorder.Events, testCase.wantEvent)
+			}
+
+			// Perform The Test (Delete)
+			err = r.finalizeKafkaTopic(ctx, channel)
+			assert.Nil(t, err)
+			assert.False(t, mockAdminClient.DeleteTopicsCalled())
+			assert.Contains(t, <-recorder.Events, "Retained Pre-Created Kafka Topic "+controllertesting.TopicName)
+		})
+	}
+}
+
+// Test The Reconciliation Of A Kafka Topic Whose Partitions Have No Leader (Re-Probed On Requeue)
+func TestReconcileTopicNotReady(t *testing.T) {
+
+	// Utility Function For Creating TopicMetadata Whose First Partition Has The Specified Leader & Number Of In-Sync Replicas
+	newTopicMetadata := func(leader int32, inSyncReplicas int) *sarama.TopicMetadata {
+		topicMetadata := &sarama.TopicMetadata{Err: sarama.ErrNoError, Name: controllertesting.TopicName}
+		for partition := 0; partition < controllertesting.NumPartitions; partition++ {
+			topicMetadata.Partitions = append(topicMetadata.Partitions, &sarama.PartitionMetadata{ID: int32(partition), Leader: 1, Replicas: make([]int32, controllertesting.ReplicationFactor), Isr: make([]int32, controllertesting.ReplicationFactor)})
+		}
+		topicMetadata.Partitions[0].Leader = leader
+		topicMetadata.Partitions[0].Isr = make([]int32, inSyncReplicas)
+		return topicMetadata
+	}
+
+	// Create A Mock Kafka AdminClient For An Existing Topic Which Is Described As Returned By The Current Metadata
+	var topicMetadata *sarama.TopicMetadata
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+			return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+		},
+		MockDescribeTopicFunc: func(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+			return topicMetadata, nil
+		},
+	}
+
+	// Initialize The Reconciler (Tracking Any Explicit Requeue Of The KafkaChannel)
+	requeued := false
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	configuration := controllertesting.NewConfig()
+	configuration.Kafka.TransientErrorRequeue.DelayMillis = 5000
+	r := &Reconciler{
+		logger:          logtesting.TestLogger(t).Desugar(),
+		adminClient:     mockAdminClient,
+		config:          configuration,
+		enqueueKeyAfter: func(key types.NamespacedName, delay time.Duration) { requeued = true },
+	}
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+
+	// Verify A Topic With A Leaderless Partition Is NotReady & Requeued As A Transient Kafka Error
+	topicMetadata = newTopicMetadata(-1, 0)
+	err := r.reconcileKafkaTopic(ctx, channel)
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, sarama.ErrLeaderNotAvailable))
+	topicCondition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionTopicReady)
+	assert.Equal(t, corev1.ConditionUnknown, topicCondition.Status)
+	assert.Equal(t, "TopicLeaderNotAvailable", topicCondition.Reason)
+	assert.Contains(t, <-recorder.Events, event.KafkaTopicNotReady.String())
+	assert.NotNil(t, r.kafkaTopicReconciliationError(channel, err))
+	assert.True(t, requeued)
+
+	// Verify The Topic Becomes Ready Once The Re-Probe Finds Every Partition Led (Even If Under-Replicated)
+	topicMetadata = newTopicMetadata(2, controllertesting.ReplicationFactor-1)
+	assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionTopicReady).IsTrue())
+}
+
+// Test The Reconciliation Of A Kafka Topic Which Already Exists Is Idempotent
+func TestReconcileTopicAlreadyExists(t *testing.T) {
+
+	// Test Data
+	errMsg := controllertesting.ErrorString
+	alreadyExistsMsg := fmt.Sprintf("Topic '%s' already exists.", controllertesting.TopicName)
+	createPartitionsError := &sarama.TopicError{Err: sarama.ErrInvalidPartitions, ErrMsg: &errMsg}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name                  string
+		createTopicError      *sarama.TopicError
+		partitions            int
+		createPartitionsError *sarama.TopicError
+		wantError             bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:             "Kafka Error Code",
+			createTopicError: &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists},
+			partitions:       controllertesting.NumPartitions,
+		},
+		{
+			name:             "Promoted Error Message",
+			createTopicError: &sarama.TopicError{Err: sarama.ErrUnknown, ErrMsg: &alreadyExistsMsg},
+			partitions:       controllertesting.NumPartitions,
+		},
+		{
+			name:                  "Insufficient Partitions",
+			createTopicError:      &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists},
+			partitions:            controllertesting.NumPartitions - 1,
+			createPartitionsError: createPartitionsError,
+			wantError:             true,
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Mock Kafka AdminClient For The TestCase (Tracking Whether The Existing Topic's Config Was Verified)
+			describeTopicConfigCalled := false
+			mockAdminClient := &controllertesting.MockAdminClient{
+				MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+					return testCase.createTopicError
+				},
+				MockDescribeTopicFunc: func(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+					topicMetadata := &sarama.TopicMetadata{Err: sarama.ErrNoError, Name: topicName}
+					for partition := 0; partition < testCase.partitions; partition++ {
+						topicMetadata.Partitions = append(topicMetadata.Partitions, &sarama.PartitionMetadata{ID: int32(partition), Replicas: make([]int32, controllertesting.ReplicationFactor), Isr: make([]int32, controllertesting.ReplicationFactor)})
+					}
+					return topicMetadata, nil
+				},
+				MockCreatePartitionsFunc: func(ctx context.Context, topicName string, count int32) *sarama.TopicError {
+					return testCase.createPartitionsError
+				},
+				MockDescribeTopicConfigFunc: func(ctx context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+					describeTopicConfigCalled = true
+					return map[string]string{}, nil
+				},
+			}
+
+			// Initialize The Reconciler
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			r := &Reconciler{
+				logger:      logtesting.TestLogger(t).Desugar(),
+				adminClient: mockAdminClient,
+				config:      controllertesting.NewConfig(),
+			}
+
+			// Perform The Test
+			channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+			err := r.reconcileKafkaTopic(ctx, channel)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantError, err != nil)
+			assert.Equal(t, !testCase.wantError, describeTopicConfigCalled)
+			assert.Equal(t, !testCase.wantError, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionTopicReady).IsTrue())
+			for len(recorder.Events) > 0 {
+				assert.NotContains(t, <-recorder.Events, event.KafkaTopicAuditCreated.String())
+			}
+		})
+	}
+}
+
+// Test The Kafka Topic Config Drift Reconciliation
+func TestReconcileTopicConfig(t *testing.T) {
+
+	// Test Data
+	errMsg := controllertesting.ErrorString
+	describeError := &sarama.TopicError{Err: sarama.ErrBrokerNotAvailable, ErrMsg: &errMsg}
+	alterError := &sarama.TopicError{Err: sarama.ErrInvalidConfig, ErrMsg: &errMsg}
+	desiredConfig := map[string]*string{
+		constants.KafkaTopicConfigRetentionMs:   &controllertesting.DefaultRetentionMillisString,
+		constants.KafkaTopicConfigCleanupPolicy: &controllertesting.DefaultCleanupPolicyString,
+	}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name          string
+		liveConfig    map[string]string
+		describeError *sarama.TopicError
+		alterError    *sarama.TopicError
+		wantAlter     bool
+		wantError     bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:       "No Drift",
+			liveConfig: map[string]string{constants.KafkaTopicConfigRetentionMs: controllertesting.DefaultRetentionMillisString, constants.KafkaTopicConfigCleanupPolicy: "delete"},
+		},
+		{
+			name:       "Unknown Config",
+			liveConfig: map[string]string{},
+		},
+		{
+			name:       "Retention Drift",
+			liveConfig: map[string]string{constants.KafkaTopicConfigRetentionMs: "12345", constants.KafkaTopicConfigCleanupPolicy: "delete"},
+			wantAlter:  true,
+		},
+		{
+			name:       "CleanupPolicy Drift",
+			liveConfig: map[string]string{constants.KafkaTopicConfigRetentionMs: controllertesting.DefaultRetentionMillisString, constants.KafkaTopicConfigCleanupPolicy: "compact"},
+			wantAlter:  true,
+		},
+		{
+			name:          "Describe Error",
+			describeError: describeError,
+			wantError:     true,
+		},
+		{
+			name:       "Alter Error",
+			liveConfig: map[string]string{constants.KafkaTopicConfigRetentionMs: "12345"},
+			alterError: alterError,
+			wantAlter:  true,
+			wantError:  true,
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Mock Kafka AdminClient For The TestCase (Topic Already Exists)
+			mockAdminClient := &controllertesting.MockAdminClient{
+				MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+					return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+				},
+				MockDescribeTopicConfigFunc: func(ctx context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+					assert.Equal(t, controllertesting.TopicName, topicName)
+					return testCase.liveConfig, testCase.describeError
+				},
+				MockAlterTopicConfigFunc: func(ctx context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+					assert.Equal(t, controllertesting.TopicName, topicName)
+					assert.Equal(t, desiredConfig, configEntries)
+					return testCase.alterError
+				},
+			}
+
+			// Initialize The Reconciler
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			r := &Reconciler{
+				logger:      logtesting.TestLogger(t).Desugar(),
+				adminClient: mockAdminClient,
+				config:      controllertesting.NewConfig(),
+			}
+
+			// Perform The Test
+			err := r.reconcileKafkaTopic(ctx, controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions))
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantError, err != nil)
+			assert.Equal(t, testCase.wantAlter, mockAdminClient.AlterTopicConfigCalled())
+			if testCase.wantAlter && !testCase.wantError {
+				assert.Contains(t, <-recorder.Events, "Updated Kafka Topic Config")
+			}
+		})
+	}
+}
+
+// Test Reconciling The Same KafkaChannel Twice Only Alters The Drifted Topic Config On The First Pass
+func TestReconcileTopicConfigIdempotent(t *testing.T) {
+
+	// Live Topic Config Which Has Drifted (Retention) Along With Equivalent Representations Of Other Values
+	liveConfig := map[string]string{
+		constants.KafkaTopicConfigRetentionMs:     "12345",
+		constants.KafkaTopicConfigCleanupPolicy:   " delete ",
+		constants.KafkaTopicConfigMaxMessageBytes: "02097152",
+	}
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{kafkaconstants.MaxMessageBytesAnnotation: "2097152"}
+
+	// Create A Mock Kafka AdminClient Which Applies Altered Config To The Live Config (Topic Already Exists)
+	alterCount := 0
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+			return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+		},
+		MockDescribeTopicConfigFunc: func(ctx context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+			return liveConfig, nil
+		},
+		MockAlterTopicConfigFunc: func(ctx context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+			alterCount++
+			assert.Equal(t, controllertesting.DefaultRetentionMillisString, *configEntries[constants.KafkaTopicConfigRetentionMs])
+			for name, value := range configEntries {
+				liveConfig[name] = *value
+			}
+			return nil
+		},
+	}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: mockAdminClient,
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Perform The Test & Verify The Results (Only The Retention Drift Is Reported & Altered, And Only Once)
+	assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+	assert.Equal(t, 1, alterCount)
+	assert.Equal(t, fmt.Sprintf("Normal %s Updated Kafka Topic Config (%s: 12345 -> %s)", event.KafkaTopicConfigUpdated.String(), constants.KafkaTopicConfigRetentionMs, controllertesting.DefaultRetentionMillisString), <-recorder.Events)
+	assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+	assert.Equal(t, 1, alterCount)
+}
+
+// Test Reconciling A Topic Config Value Clamped By The Brokers Converges Instead Of Altering It Forever
+func TestReconcileTopicConfigClamped(t *testing.T) {
+
+	// Broker Which Clamps The Topic Retention To A Maximum Below The Desired Retention (Live Config Has Drifted Below It)
+	maxRetentionMillis := "50000"
+	liveConfig := map[string]string{constants.KafkaTopicConfigRetentionMs: "12345"}
+
+	// Create A Mock Kafka AdminClient Which Clamps Altered Config Before Applying It To The Live Config (Topic Already Exists)
+	alterCount := 0
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+			return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+		},
+		MockDescribeTopicConfigFunc: func(ctx context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+			return liveConfig, nil
+		},
+		MockAlterTopicConfigFunc: func(ctx context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+			alterCount++
+			liveConfig[constants.KafkaTopicConfigRetentionMs] = maxRetentionMillis
+			return nil
+		},
+	}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: mockAdminClient,
+		config:      controllertesting.NewConfig(),
+	}
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	clampedAnnotation := fmt.Sprintf(`{"%s":{"desired":"%s","effective":"%s"}}`, constants.KafkaTopicConfigRetentionMs, controllertesting.DefaultRetentionMillisString, maxRetentionMillis)
+
+	// Verify The First Pass Alters The Drifted Retention, Detects The Clamp From The Value Read Back & Records It (Once)
+	assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+	assert.Equal(t, 1, alterCount)
+	assert.Equal(t, fmt.Sprintf("Normal %s Updated Kafka Topic Config (%s: 12345 -> %s)", event.KafkaTopicConfigUpdated.String(), constants.KafkaTopicConfigRetentionMs, controllertesting.DefaultRetentionMillisString), <-recorder.Events)
+	assert.Equal(t, fmt.Sprintf("Warning %s Kafka Topic Config Clamped By Brokers (%s: %s -> %s)", event.KafkaTopicConfigClamped.String(), constants.KafkaTopicConfigRetentionMs, controllertesting.DefaultRetentionMillisString, maxRetentionMillis), <-recorder.Events)
+	assert.Equal(t, clampedAnnotation, channel.Status.Annotations[constants.TopicConfigClampedAnnotation])
+
+	// Verify Subsequent Passes Neither Alter The (Clamped) Retention Again Nor Emit Any Further Events
+	for pass := 0; pass < 3; pass++ {
+		assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+		assert.Equal(t, 1, alterCount)
+		assert.Len(t, recorder.Events, 0)
+		assert.Equal(t, clampedAnnotation, channel.Status.Annotations[constants.TopicConfigClampedAnnotation])
+	}
+
+	// Verify Drift From The Effective (Clamped) Value Is Still Corrected
+	liveConfig[constants.KafkaTopicConfigRetentionMs] = "12345"
+	assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+	assert.Equal(t, 2, alterCount)
+	assert.Equal(t, fmt.Sprintf("Normal %s Updated Kafka Topic Config (%s: 12345 -> %s)", event.KafkaTopicConfigUpdated.String(), constants.KafkaTopicConfigRetentionMs, maxRetentionMillis), <-recorder.Events)
+	assert.Len(t, recorder.Events, 0)
+
+	// Verify A Change To The Desired Value Is Applied Again (Discarding The Stale Clamp Once It Is No Longer Clamped)
+	channel.Spec.RetentionDuration = "PT10S"
+	maxRetentionMillis = "10000"
+	assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+	assert.Equal(t, 3, alterCount)
+	assert.Contains(t, <-recorder.Events, "Updated Kafka Topic Config")
+	assert.Len(t, recorder.Events, 0)
+	assert.NotContains(t, channel.Status.Annotations, constants.TopicConfigClampedAnnotation)
+}
+
+// Test The Recorded Topic Config Clamps Only Apply While The Desired Value Is Unchanged
+func TestTopicConfigClamped(t *testing.T) {
+
+	// Test Data
+	retention := "99999"
+	cleanupPolicy := "delete"
+	configEntries := map[string]*string{
+		constants.KafkaTopicConfigRetentionMs:   &retention,
+		constants.KafkaTopicConfigCleanupPolicy: &cleanupPolicy,
+	}
+	channel := controllertesting.NewKafkaChannel()
+
+	// Verify No Clamps Without (Or With An Invalid) Status Annotation
+	assert.Empty(t, topicConfigClamped(channel, configEntries))
+	channel.Status.Annotations = map[string]string{constants.TopicConfigClampedAnnotation: "invalid"}
+	assert.Empty(t, topicConfigClamped(channel, configEntries))
+
+	// Verify Only The Clamps Of Unchanged Desired Values Apply, Replacing Them With Their Effective Values
+	setTopicConfigClamped(channel, map[string]topicConfigClamp{
+		constants.KafkaTopicConfigRetentionMs:     {Desired: " 99999", Effective: "50000"},
+		constants.KafkaTopicConfigMaxMessageBytes: {Desired: "1024", Effective: "2048"},
+		constants.KafkaTopicConfigCleanupPolicy:   {Desired: "compact", Effective: "delete"},
+	})
+	clamped := topicConfigClamped(channel, configEntries)
+	assert.Equal(t, map[string]topicConfigClamp{constants.KafkaTopicConfigRetentionMs: {Desired: " 99999", Effective: "50000"}}, clamped)
+	effectiveEntries := clampedTopicConfigEntries(configEntries, clamped)
+	assert.Equal(t, "50000", *effectiveEntries[constants.KafkaTopicConfigRetentionMs])
+	assert.Equal(t, &cleanupPolicy, effectiveEntries[constants.KafkaTopicConfigCleanupPolicy])
+	assert.Equal(t, "99999", retention)
+
+	// Verify The Status Annotation Is Removed When There Are No Clamps
+	setTopicConfigClamped(channel, map[string]topicConfigClamp{})
+	assert.NotContains(t, channel.Status.Annotations, constants.TopicConfigClampedAnnotation)
+}
+
+// Test The Kafka Topic Operation Metrics Recorded While Reconciling & Deleting Topics
+func TestReconcileTopicOperationMetrics(t *testing.T) {
+
+	// Replace The recordWrapper With One Capturing The Topic Operation Measurements (Restoring When Done)
+	operations := make([]string, 0)
+	recordWrapperRef := recordWrapper
+	defer func() { recordWrapper = recordWrapperRef }()
+	recordWrapper = func(ctx context.Context, measurement stats.Measurement, _ ...stats.Options) {
+		if measurement.Measure().Name() == topicOperationCount.Name() {
+			operation, _ := tag.FromContext(ctx).Value(operationKey)
+			result, _ := tag.FromContext(ctx).Value(resultKey)
+			operations = append(operations, operation+"/"+result)
+		}
+	}
+
+	// Create A Mock Kafka AdminClient For An Existing Topic Whose Config Has Drifted & Which Can Only Be Deleted Once
+	deleteTopicError := &sarama.TopicError{Err: sarama.ErrNoError}
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+			return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+		},
+		MockDescribeTopicConfigFunc: func(ctx context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+			return map[string]string{constants.KafkaTopicConfigRetentionMs: "12345"}, nil
+		},
+		MockAlterTopicConfigFunc: func(ctx context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+			return nil
+		},
+		MockDeleteTopicFunc: func(ctx context.Context, topicName string) *sarama.TopicError {
+			return deleteTopicError
+		},
+	}
+
+	// Initialize The Reconciler
+	ctx := controller.WithEventRecorder(context.TODO(), record.NewFakeRecorder(10))
+	logger := logtesting.TestLogger(t).Desugar()
+	r := &Reconciler{
+		logger:      logger,
+		adminClient: mockAdminClient,
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Perform The Test
+	assert.Nil(t, r.reconcileKafkaTopic(ctx, controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)))
+	deleted, err := r.deleteTopic(ctx, logger, controllertesting.TopicName)
+	assert.True(t, deleted)
+	assert.Nil(t, err)
+	deleteTopicError = &sarama.TopicError{Err: sarama.ErrUnknownTopicOrPartition}
+	deleted, err = r.deleteTopic(ctx, logger, controllertesting.TopicName)
+	assert.False(t, deleted)
+	assert.Nil(t, err)
+	deleteTopicError = &sarama.TopicError{Err: sarama.ErrRequestTimedOut}
+	deleted, err = r.deleteTopic(ctx, logger, controllertesting.TopicName)
+	assert.False(t, deleted)
+	assert.NotNil(t, err)
+
+	// Verify The Results
+	assert.Equal(t, []string{
+		TopicOperationCreate + "/" + TopicResultExists,
+		TopicOperationDescribe + "/" + TopicResultSuccess,
+		TopicOperationDescribe + "/" + TopicResultSuccess,
+		TopicOperationAlter + "/" + TopicResultSuccess,
+		TopicOperationDescribe + "/" + TopicResultSuccess,
+		TopicOperationDescribe + "/" + TopicResultSuccess,
+		TopicOperationDelete + "/" + TopicResultSuccess,
+		TopicOperationDelete + "/" + TopicResultNotFound,
+		TopicOperationDelete + "/" + TopicResultError,
+	}, operations)
+}
+
+// Test The Topic Config Drift Is Detected Using Canonical Values & Reported In Name Order
+func TestTopicConfigDrift(t *testing.T) {
+	retention := "604800000"
+	cleanupPolicy := "compact,delete"
+	maxMessageBytes := "2097152"
+	configEntries := map[string]*string{
+		constants.KafkaTopicConfigRetentionMs:     &retention,
+		constants.KafkaTopicConfigCleanupPolicy:   &cleanupPolicy,
+		constants.KafkaTopicConfigMaxMessageBytes: &maxMessageBytes,
+		"unset.config": nil,
+	}
+	assert.Empty(t, topicConfigDrift(configEntries, map[string]string{
+		constants.KafkaTopicConfigRetentionMs:     " 604800000",
+		constants.KafkaTopicConfigCleanupPolicy:   "Delete, compact",
+		constants.KafkaTopicConfigMaxMessageBytes: "2097152",
+		"unset.config": "something",
+	}))
+	assert.Equal(t, []string{
+		constants.KafkaTopicConfigCleanupPolicy + ": delete -> compact,delete",
+		constants.KafkaTopicConfigMaxMessageBytes + ": 1048588 -> 2097152",
+		constants.KafkaTopicConfigRetentionMs + ": 1 -> 604800000",
+	}, topicConfigDrift(configEntries, map[string]string{
+		constants.KafkaTopicConfigRetentionMs:     "1",
+		constants.KafkaTopicConfigCleanupPolicy:   "delete",
+		constants.KafkaTopicConfigMaxMessageBytes: "1048588",
+	}))
+}
+
+// Test The Kafka Topic max.message.bytes Is Set From The KafkaChannel Max Message Bytes Annotation
+func TestReconcileTopicMaxMessageBytes(t *testing.T) {
+
+	// Test Data
+	maxMessageBytes := "2097152"
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{kafkaconstants.MaxMessageBytesAnnotation: maxMessageBytes}
+
+	// Create A Mock Kafka AdminClient Verifying The Created & Altered Topic Config (Topic Already Exists With The Broker Default)
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+			assert.Equal(t, maxMessageBytes, *topicDetail.ConfigEntries[constants.KafkaTopicConfigMaxMessageBytes])
+			return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+		},
+		MockDescribeTopicConfigFunc: func(ctx context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+			return map[string]string{constants.KafkaTopicConfigMaxMessageBytes: "1048588"}, nil
+		},
+		MockAlterTopicConfigFunc: func(ctx context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+			assert.Equal(t, maxMessageBytes, *configEntries[constants.KafkaTopicConfigMaxMessageBytes])
+			return nil
+		},
+	}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: mockAdminClient,
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Perform The Test & Verify The Results
+	assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+	assert.True(t, mockAdminClient.AlterTopicConfigCalled())
+	assert.Contains(t, <-recorder.Events, "max.message.bytes: 1048588 -> 2097152")
+}
+
+// Test The Per-Subscription Dead Letter Topics Are Created With The KafkaChannel's Topic (Unless Auto-Creation Is Disabled)
+func TestReconcileDeadLetterTopics(t *testing.T) {
+
+	// Test Data
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.Annotations = map[string]string{kafkaconstants.SubscriberDeadLetterTopicAnnotation: `{"uid-1":"dead-letter","uid-2":"dead-letter","uid-3":"existing-dead-letter"}`}
+
+	// Create A Mock Kafka AdminClient Tracking The Created Topics (The Existing Dead Letter Topic Already Exists)
+	var createdTopics []string
+	mockAdminClient := &controllertesting.MockAdminClient{
+		MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+			createdTopics = append(createdTopics, topicName)
+			if topicName == "existing-dead-letter" {
+				return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+			}
+			assert.Equal(t, commonconstants.DefaultCleanupPolicy, *topicDetail.ConfigEntries[constants.KafkaTopicConfigCleanupPolicy])
+			return &sarama.TopicError{Err: sarama.ErrNoError}
+		},
+	}
+
+	// Initialize The Reconciler
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{
+		logger:      logtesting.TestLogger(t).Desugar(),
+		adminClient: mockAdminClient,
+		config:      controllertesting.NewConfig(),
+	}
+
+	// Verify The KafkaChannel's Topic & Each Distinct Dead Letter Topic Are Created
+	assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+	assert.ElementsMatch(t, []string{util.TopicName(channel), "dead-letter", "existing-dead-letter"}, createdTopics)
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionTopicReady).IsTrue())
+
+	// Verify A Failure To Create A Dead Letter Topic Fails The Topic Reconciliation
+	mockAdminClient.MockCreateTopicFunc = func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+		if topicName == util.TopicName(channel) {
+			return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+		}
+		return &sarama.TopicError{Err: sarama.ErrTopicAuthorizationFailed}
+	}
+	assert.NotNil(t, r.reconcileKafkaTopic(ctx, channel))
+	assert.True(t, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionTopicReady).IsFalse())
+
+	// Verify No Dead Letter Topics Are Created When Topic Auto-Creation Is Disabled
+	createdTopics = nil
+	mockAdminClient.MockCreateTopicFunc = func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+		createdTopics = append(createdTopics, topicName)
+		return &sarama.TopicError{Err: sarama.ErrNoError}
+	}
+	r.config.Kafka.DisableTopicAutoCreate = true
+	assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+	assert.Empty(t, createdTopics)
+}
+
+// Test The Kafka Topic ACL Reconciliation & Finalization
+func TestReconcileTopicACLs(t *testing.T) {
+
+	// Test Data
+	errMsg := controllertesting.ErrorString
+	securityDisabledError := &sarama.TopicError{Err: sarama.ErrSecurityDisabled, ErrMsg: &errMsg}
+	dispatcherACL := sarama.Acl{Principal: "User:dispatcher", Host: "*", Operation: sarama.AclOperationRead, PermissionType: sarama.AclPermissionAllow}
+	receiverACL := sarama.Acl{Principal: "User:receiver", Host: "*", Operation: sarama.AclOperationWrite, PermissionType: sarama.AclPermissionAllow}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name         string
+		aclConfig    config.EKTopicACLConfig
+		aclError     *sarama.TopicError
+		expectedACLs []sarama.Acl
+		wantError    bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:         "Disabled",
+			aclConfig:    config.EKTopicACLConfig{DispatcherPrincipal: "User:dispatcher", ReceiverPrincipal: "User:receiver"},
+			expectedACLs: []sarama.Acl{},
+		},
+		{
+			name:         "Enabled Without Principals",
+			aclConfig:    config.EKTopicACLConfig{Enabled: true},
+			expectedACLs: []sarama.Acl{},
+		},
+		{
+			name:         "Enabled With Principals",
+			aclConfig:    config.EKTopicACLConfig{Enabled: true, DispatcherPrincipal: "User:dispatcher", ReceiverPrincipal: "User:receiver"},
+			expectedACLs: []sarama.Acl{dispatcherACL, receiverACL},
+		},
+		{
+			name:         "Enabled With Dispatcher Principal Only",
+			aclConfig:    config.EKTopicACLConfig{Enabled: true, DispatcherPrincipal: "User:dispatcher"},
+			expectedACLs: []sarama.Acl{dispatcherACL},
+		},
+		{
+			name:         "Invalid Principal",
+			aclConfig:    config.EKTopicACLConfig{Enabled: true, DispatcherPrincipal: "dispatcher"},
+			expectedACLs: []sarama.Acl{},
+			wantError:    true,
+		},
+		{
+			name:         "No Authorizer",
+			aclConfig:    config.EKTopicACLConfig{Enabled: true, DispatcherPrincipal: "User:dispatcher", ReceiverPrincipal: "User:receiver"},
+			aclError:     securityDisabledError,
+			expectedACLs: []sarama.Acl{dispatcherACL},
+			wantError:    true,
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Mock Kafka AdminClient Tracking The Created / Deleted ACLs
+			createdACLs := make([]sarama.Acl, 0)
+			deletedACLs := make([]sarama.Acl, 0)
+			mockAdminClient := &controllertesting.MockAdminClient{
+				MockCreateTopicACLFunc: func(ctx context.Context, topicName string, acl sarama.Acl) *sarama.TopicError {
+					assert.Equal(t, controllertesting.TopicName, topicName)
+					createdACLs = append(createdACLs, acl)
+					return testCase.aclError
+				},
+				MockDeleteTopicACLFunc: func(ctx context.Context, topicName string, acl sarama.Acl) *sarama.TopicError {
+					assert.Equal(t, controllertesting.TopicName, topicName)
+					deletedACLs = append(deletedACLs, acl)
+					return testCase.aclError
+				},
+			}
+
+			// Initialize The Reconciler With The TestCase's ACL Config
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			r := &Reconciler{
+				logger:      logtesting.TestLogger(t).Desugar(),
+				adminClient: mockAdminClient,
+				config:      controllertesting.NewConfig(),
+			}
+			r.config.Kafka.TopicACLs = testCase.aclConfig
+			channel := controllertesting.NewKafkaChannel(controllertesting.WithFinalizer, controllertesting.WithInitializedConditions)
+
+			// Perform The Test (Create)
+			err := r.reconcileKafkaTopic(ctx, channel)
+			assert.Equal(t, testCase.wantError, err != nil)
+			assert.Equal(t, testCase.expectedACLs, createdACLs)
+			assert.Equal(t, !testCase.wantError, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionTopicReady).IsTrue())
+
+			// Perform The Test (Delete)
+			err = r.finalizeKafkaTopic(ctx, channel)
+			assert.Equal(t, testCase.wantError, err != nil)
+			assert.Equal(t, testCase.expectedACLs, deletedACLs)
+			assert.Equal(t, !testCase.wantError, mockAdminClient.DeleteTopicsCalled())
+		})
+	}
+}
+
+// Test The ReplicationFactor Mismatch Is Recorded In The Status & Only Emits A Warning Event When It Changes
+func TestReconcileReplicationFactorMismatch(t *testing.T) {
+
+	// Initialize The Reconciler & KafkaChannel
+	recorder := record.NewFakeRecorder(10)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), config: controllertesting.NewConfig()}
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+
+	// Verify The First Reconciliation Of A Mismatch Records It & Emits The Warning Event
+	r.reconcileReplicationFactorMismatch(ctx, r.logger, channel, controllertesting.TopicName, 1, 3)
+	assert.JSONEq(t, `{"current":1,"desired":3}`, channel.Status.Annotations[constants.TopicReplicationFactorMismatchAnnotation])
+	assert.Contains(t, <-recorder.Events, event.KafkaTopicReplicationFactorMismatch.String())
+
+	// Verify Subsequent Reconciliations Of The Same Mismatch Emit No Further Events
+	r.reconcileReplicationFactorMismatch(ctx, r.logger, channel, controllertesting.TopicName, 1, 3)
+	assert.Len(t, recorder.Events, 0)
+
+	// Verify A Changed Mismatch Is Recorded & Emits A New Warning Event
+	r.reconcileReplicationFactorMismatch(ctx, r.logger, channel, controllertesting.TopicName, 1, 2)
+	assert.JSONEq(t, `{"current":1,"desired":2}`, channel.Status.Annotations[constants.TopicReplicationFactorMismatchAnnotation])
+	assert.Contains(t, <-recorder.Events, event.KafkaTopicReplicationFactorMismatch.String())
+
+	// Verify The Mismatch Is Cleared Once The ReplicationFactor Matches
+	r.reconcileReplicationFactorMismatch(ctx, r.logger, channel, controllertesting.TopicName, 2, 2)
+	assert.NotContains(t, channel.Status.Annotations, constants.TopicReplicationFactorMismatchAnnotation)
+	assert.Len(t, recorder.Events, 0)
+}
+
+// Test The Kafka Topic Partition / Replication Drift Reconciliation
+func TestReconcileTopicPartitions(t *testing.T) {
+
+	// Test Data
+	errMsg := controllertesting.ErrorString
+	describeError := &sarama.TopicError{Err: sarama.ErrBrokerNotAvailable, ErrMsg: &errMsg}
+	createPartitionsError := &sarama.TopicError{Err: sarama.ErrInvalidPartitions, ErrMsg: &errMsg}
+
+	// Utility Function For Creating TopicMetadata With The Specified Partitions & Replicas
+	newTopicMetadata := func(partitions int, replicas int) *sarama.TopicMetadata {
+		topicMetadata := &sarama.TopicMetadata{Err: sarama.ErrNoError, Name: controllertesting.TopicName}
+		for partition := 0; partition < partitions; partition++ {
+			topicMetadata.Partitions = append(topicMetadata.Partitions, &sarama.PartitionMetadata{ID: int32(partition), Replicas: make([]int32, replicas), Isr: make([]int32, replicas)})
+		}
+		return topicMetadata
+	}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name                  string
+		metadata              *sarama.TopicMetadata
+		describeError         *sarama.TopicError
+		createPartitionsError *sarama.TopicError
+		wantCreatePartitions  bool
+		wantError             bool
+		wantEvent             string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:     "No Drift",
+			metadata: newTopicMetadata(controllertesting.NumPartitions, controllertesting.ReplicationFactor),
+		},
+		{
+			name: "Metadata Not Available",
+		},
+		{
+			name:                 "Increase Partitions",
+			metadata:             newTopicMetadata(controllertesting.NumPartitions-3, controllertesting.ReplicationFactor),
+			wantCreatePartitions: true,
+			wantEvent: fmt.Sprintf("Warning TopicPartitionsIncreased Increased Kafka Topic %s Partitions From %d To %d",
+				controllertesting.TopicName, controllertesting.NumPartitions-3, controllertesting.NumPartitions),
+		},
+		{
+			name:      "Decrease Partitions",
+			metadata:  newTopicMetadata(controllertesting.NumPartitions+3, controllertesting.ReplicationFactor),
+			wantError: true,
+			wantEvent: "unable to reduce Kafka topic partitions",
+		},
+		{
+			name:      "ReplicationFactor Mismatch",
+			metadata:  newTopicMetadata(controllertesting.NumPartitions, 3),
+			wantEvent: "Does Not Match Desired ReplicationFactor",
+		},
+		{
+			name:          "Describe Error",
+			describeError: describeError,
+			wantError:     true,
+		},
+		{
+			name:                  "CreatePartitions Error",
+			metadata:              newTopicMetadata(controllertesting.NumPartitions-3, controllertesting.ReplicationFactor),
+			createPartitionsError: createPartitionsError,
+			wantCreatePartitions:  true,
+			wantError:             true,
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Mock Kafka AdminClient For The TestCase (Topic Already Exists)
+			mockAdminClient := &controllertesting.MockAdminClient{
+				MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+					return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+				},
+				MockDescribeTopicFunc: func(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+					assert.Equal(t, controllertesting.TopicName, topicName)
+					return testCase.metadata, testCase.describeError
+				},
+				MockCreatePartitionsFunc: func(ctx context.Context, topicName string, count int32) *sarama.TopicError {
+					assert.Equal(t, controllertesting.TopicName, topicName)
+					assert.Equal(t, int32(controllertesting.NumPartitions), count)
+					return testCase.createPartitionsError
+				},
+			}
+
+			// Initialize The Reconciler
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			r := &Reconciler{
+				logger:      logtesting.TestLogger(t).Desugar(),
+				adminClient: mockAdminClient,
+				config:      controllertesting.NewConfig(),
+			}
+
+			// Perform The Test
+			channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+			err := r.reconcileKafkaTopic(ctx, channel)
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantError, err != nil)
+			assert.Equal(t, testCase.wantCreatePartitions, mockAdminClient.CreatePartitionsCalled())
+			assert.Equal(t, !testCase.wantError, channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionTopicReady).IsTrue())
+			if testCase.wantEvent != "" {
+				assert.Contains(t, <-recorder.Events, testCase.wantEvent)
+			}
+			for len(recorder.Events) > 0 {
+				assert.NotContains(t, <-recorder.Events, event.TopicPartitionsIncreased.String())
+			}
+		})
+	}
+}
+
+// Test The Kafka Topic Lifecycle Audit Events
+func TestReconcileTopicAudit(t *testing.T) {
+
+	// Test Data
+	errMsg := controllertesting.ErrorString
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only          bool
+		name          string
+		finalize      bool
+		mockErrorCode sarama.KError
+		wantEvent     string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:      "Topic Created",
+			wantEvent: controllertesting.NewKafkaTopicAuditCreatedEvent(),
+		},
+		{
+			name:          "Topic Already Exists",
+			mockErrorCode: sarama.ErrTopicAlreadyExists,
+		},
+		{
+			name:          "Topic Creation Failed",
+			mockErrorCode: sarama.ErrBrokerNotAvailable,
+			wantEvent:     event.KafkaTopicAuditCreationFailed.String(),
+		},
+		{
+			name:      "Topic Deleted",
+			finalize:  true,
+			wantEvent: controllertesting.NewKafkaTopicAuditDeletedEvent(),
+		},
+		{
+			name:          "Topic Not Found",
+			finalize:      true,
+			mockErrorCode: sarama.ErrUnknownTopicOrPartition,
+		},
+		{
+			name:          "Topic Deletion Failed",
+			finalize:      true,
+			mockErrorCode: sarama.ErrBrokerNotAvailable,
+			wantEvent:     event.KafkaTopicAuditDeletionFailed.String(),
+		},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Mock Kafka AdminClient Returning The TestCase's Error Code
+			topicError := &sarama.TopicError{Err: testCase.mockErrorCode, ErrMsg: &errMsg}
+			mockAdminClient := &controllertesting.MockAdminClient{
+				MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+					return topicError
+				},
+				MockDeleteTopicFunc: func(ctx context.Context, topicName string) *sarama.TopicError {
+					return topicError
+				},
+			}
+
+			// Initialize The Reconciler
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+			r := &Reconciler{
+				logger:      logtesting.TestLogger(t).Desugar(),
+				adminClient: mockAdminClient,
+				config:      controllertesting.NewConfig(),
+			}
+
+			// Perform The Test
+			channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+			if testCase.finalize {
+				_ = r.finalizeKafkaTopic(ctx, channel)
+			} else {
+				_ = r.reconcileKafkaTopic(ctx, channel)
+			}
+
+			// Verify The Audit Event (If Any) Is The First Event Recorded
+			auditEvent := ""
+			select {
+			case recordedEvent := <-recorder.Events:
+				if strings.Contains(recordedEvent, "KafkaTopicAudit") {
+					auditEvent = recordedEvent
+				}
+			default:
+			}
+			if testCase.wantEvent == "" {
+				assert.Empty(t, auditEvent)
+			} else {
+				assert.Contains(t, auditEvent, testCase.wantEvent)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
+	"knative.dev/pkg/controller"
+)
+
+//
+// Kafka Topic Ownership
+//
+// When enabled, the owner of a KafkaChannel's Kafka Topic is recorded in the KafkaChannel's status via the
+// TopicOwnerAnnotation (a JSON record of the owning KafkaChannel's UID, Namespace & Name along with the Topic's
+// name), and only Topics owned by the KafkaChannel being finalized are deleted.  A KafkaChannel which merely
+// reused the name of a retained (or otherwise pre-existing) Topic is recorded without an owner and therefore
+// never deletes it.  KafkaChannels whose Topic was already READY before ownership was enabled carry no record
+// at all, and adopt their Topic (with a logged warning) so that it is not leaked when they are later deleted.
+// The record lives on the KafkaChannel rather than the Topic, as Apache Kafka brokers reject unknown Topic
+// configuration names.
+//
+
+// The Owner Of A Kafka Topic As Recorded In The TopicOwnerAnnotation
+type topicOwner struct {
+	UID       types.UID `json:"uid,omitempty"`
+	Namespace string    `json:"namespace,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Topic     string    `json:"topic"`
+}
+
+// Determine Whether Kafka Topic Ownership Is Enabled
+func (r *Reconciler) topicOwnershipEnabled() bool {
+	return r.config != nil && r.config.Kafka.TopicOwnership.Enabled
+}
+
+// Record In The KafkaChannel's Status Whether It Owns (Created) The Specified Topic (When Ownership Is Enabled)
+func (r *Reconciler) recordTopicOwner(channel *kafkav1beta1.KafkaChannel, topicName string, owned bool) {
+	if !r.topicOwnershipEnabled() {
+		return
+	}
+	owner := topicOwner{Topic: topicName}
+	if owned {
+		owner.UID = channel.UID
+		owner.Namespace = channel.Namespace
+		owner.Name = channel.Name
+	}
+	ownerJson, err := json.Marshal(owner)
+	if err != nil {
+		return // Not Possible For This Simple Struct
+	}
+	if channel.Status.Annotations == nil {
+		channel.Status.Annotations = make(map[string]string)
+	}
+	channel.Status.Annotations[constants.TopicOwnerAnnotation] = string(ownerJson)
+}
+
+// Get The Recorded Owner Of The KafkaChannel's Topic (Returning Whether Any Record Exists)
+func getTopicOwner(channel *kafkav1beta1.KafkaChannel) (*topicOwner, bool) {
+	ownerJson, ok := channel.Status.Annotations[constants.TopicOwnerAnnotation]
+	if !ok {
+		return nil, false
+	}
+	owner := &topicOwner{}
+	if err := json.Unmarshal([]byte(ownerJson), owner); err != nil {
+		return &topicOwner{}, true // An Unparseable Record Owns Nothing
+	}
+	return owner, true
+}
+
+// Determine Whether The Topic Of A KafkaChannel With No Ownership Record Should Be Adopted (It Predates Ownership)
+func isTopicOwnershipPredated(channel *kafkav1beta1.KafkaChannel) bool {
+	condition := channel.Status.GetCondition(kafkav1beta1.KafkaChannelConditionTopicReady)
+	return condition != nil && condition.IsTrue()
+}
+
+// Reconcile The Ownership Record Of The KafkaChannel's Topic After It Was Created (Or Found To Already Exist)
+func (r *Reconciler) reconcileTopicOwner(logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string, created bool) {
+	if !r.topicOwnershipEnabled() {
+		return
+	}
+	if created {
+		r.recordTopicOwner(channel, topicName, true)
+	} else if _, recorded := getTopicOwner(channel); !recorded {
+		if isTopicOwnershipPredated(channel) {
+			logger.Warn("Adopting Kafka Topic Of KafkaChannel Created Before Topic Ownership Was Enabled")
+			r.recordTopicOwner(channel, topicName, true)
+		} else {
+			r.recordTopicOwner(channel, topicName, false)
+		}
+	}
+}
+
+// Verify That The KafkaChannel Owns The Specified Topic (Returning Whether It May Be Deleted)
+func (r *Reconciler) verifyTopicOwner(ctx context.Context, logger *zap.Logger, channel *kafkav1beta1.KafkaChannel, topicName string) bool {
+
+	// Any Topic May Be Deleted When Ownership Is Disabled
+	if !r.topicOwnershipEnabled() {
+		return true
+	}
+
+	// Adopt The Topic Of A KafkaChannel Which Predates Ownership (Otherwise It Would Never Be Deleted)
+	owner, recorded := getTopicOwner(channel)
+	if !recorded && isTopicOwnershipPredated(channel) {
+		logger.Warn("Adopting Kafka Topic Of KafkaChannel Created Before Topic Ownership Was Enabled - Deleting")
+		return true
+	}
+
+	// Refuse To Delete A Topic Which The KafkaChannel Is Not Recorded As Owning
+	if !recorded || len(owner.UID) == 0 || owner.UID != channel.UID || owner.Topic != topicName {
+		logger.Warn("Kafka Topic Is Not Owned By This KafkaChannel - Skipping Deletion")
+		controller.GetEventRecorder(ctx).Eventf(channel, corev1.EventTypeWarning, event.KafkaTopicOwnershipMismatch.String(),
+			"Retained Kafka Topic %s Not Created By This KafkaChannel", topicName)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test Data
+const kafkaChannelUID = "kafkachannel-uid"
+
+// Test The Recording Of Kafka Topic Ownership On Creation & Its Verification On Finalization
+func TestFinalizeTopicOwnership(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name         string
+		enabled      bool
+		topicExists  bool
+		predated     bool
+		wantOwned    bool
+		wantDeleted  bool
+		wantMismatch bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Ownership Disabled", wantDeleted: true},
+		{name: "Ownership Disabled Existing Topic", topicExists: true, wantDeleted: true},
+		{name: "Created Topic", enabled: true, wantOwned: true, wantDeleted: true},
+		{name: "Existing Topic", enabled: true, topicExists: true, wantMismatch: true},
+		{name: "Predated Existing Topic", enabled: true, topicExists: true, predated: true, wantOwned: true, wantDeleted: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Setup Context With New Recorder For Testing
+			recorder := record.NewFakeRecorder(20)
+			ctx := controller.WithEventRecorder(context.TODO(), recorder)
+
+			// Create A Mock Kafka AdminClient Which Creates (Or Finds Existing) Topics
+			mockAdminClient := &controllertesting.MockAdminClient{
+				MockCreateTopicFunc: func(ctx context.Context, topicName string, topicDetail *sarama.TopicDetail) *sarama.TopicError {
+					if testCase.topicExists {
+						return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+					}
+					return &sarama.TopicError{Err: sarama.ErrNoError}
+				},
+			}
+
+			// Initialize The Reconciler
+			config := controllertesting.NewConfig()
+			config.Kafka.TopicOwnership.Enabled = testCase.enabled
+			r := &Reconciler{
+				logger:      logtesting.TestLogger(t).Desugar(),
+				adminClient: mockAdminClient,
+				config:      config,
+			}
+
+			// Reconcile The KafkaChannel's Topic (Predated Channels Were READY Before Ownership) & Verify Its Recorded Owner
+			channel := controllertesting.NewKafkaChannel(controllertesting.WithFinalizer, controllertesting.WithInitializedConditions)
+			channel.UID = kafkaChannelUID
+			if testCase.predated {
+				controllertesting.WithTopicReady(channel)
+			}
+			assert.Nil(t, r.reconcileKafkaTopic(ctx, channel))
+			owner, recorded := getTopicOwner(channel)
+			assert.Equal(t, testCase.enabled, recorded)
+			if recorded {
+				assert.Equal(t, controllertesting.TopicName, owner.Topic)
+				assert.Equal(t, testCase.wantOwned, owner.UID == channel.UID)
+				assert.Equal(t, testCase.wantOwned, owner.Namespace == channel.Namespace && owner.Name == channel.Name)
+			}
+
+			// Finalize The KafkaChannel's Topic
+			channel.DeletionTimestamp = controllertesting.NewKafkaChannel(controllertesting.WithDeletionTimestamp).DeletionTimestamp
+			assert.Nil(t, r.finalizeKafkaTopic(ctx, channel))
+
+			// Verify The Results
+			assert.Equal(t, testCase.wantDeleted, mockAdminClient.DeleteTopicsCalled())
+			mismatch := false
+			for len(recorder.Events) > 0 {
+				if strings.Contains(<-recorder.Events, event.KafkaTopicOwnershipMismatch.String()) {
+					mismatch = true
+				}
+			}
+			assert.Equal(t, testCase.wantMismatch, mismatch)
+		})
+	}
+}
+
+// Test That A KafkaChannel Recorded As Owning A Different Topic (e.g. Renamed) Or Recreated With The Same Name Does Not Delete Its Current Topic
+func TestVerifyTopicOwnerMismatch(t *testing.T) {
+	config := controllertesting.NewConfig()
+	config.Kafka.TopicOwnership.Enabled = true
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), config: config}
+	recorder := record.NewFakeRecorder(2)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	channel := controllertesting.NewKafkaChannel()
+	channel.UID = kafkaChannelUID
+	r.recordTopicOwner(channel, "other-topic", true)
+	assert.False(t, r.verifyTopicOwner(ctx, r.logger, channel, controllertesting.TopicName))
+	assert.Contains(t, <-recorder.Events, event.KafkaTopicOwnershipMismatch.String())
+	r.recordTopicOwner(channel, controllertesting.TopicName, true)
+	channel.UID = "recreated-kafkachannel-uid"
+	assert.False(t, r.verifyTopicOwner(ctx, r.logger, channel, controllertesting.TopicName))
+	assert.Contains(t, <-recorder.Events, event.KafkaTopicOwnershipMismatch.String())
+	channel.UID = kafkaChannelUID
+	assert.True(t, r.verifyTopicOwner(ctx, r.logger, channel, controllertesting.TopicName))
+}
+
+// Test That A KafkaChannel Without An Ownership Record Adopts Its Topic Only When It Predates Ownership
+func TestVerifyTopicOwnerMissingRecord(t *testing.T) {
+	config := controllertesting.NewConfig()
+	config.Kafka.TopicOwnership.Enabled = true
+	r := &Reconciler{logger: logtesting.TestLogger(t).Desugar(), config: config}
+	recorder := record.NewFakeRecorder(1)
+	ctx := controller.WithEventRecorder(context.TODO(), recorder)
+	channel := controllertesting.NewKafkaChannel(controllertesting.WithInitializedConditions)
+	channel.UID = kafkaChannelUID
+	assert.False(t, r.verifyTopicOwner(ctx, r.logger, channel, controllertesting.TopicName))
+	assert.Contains(t, <-recorder.Events, event.KafkaTopicOwnershipMismatch.String())
+	controllertesting.WithTopicReady(channel)
+	assert.True(t, r.verifyTopicOwner(ctx, r.logger, channel, controllertesting.TopicName))
+	assert.Len(t, recorder.Events, 0)
+}
@@ -27,9 +27,12 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
 	commonenv "knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/health"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/env"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
 	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
@@ -116,8 +119,18 @@ func (r *Reconciler) reconcileReceiverService(ctx context.Context, logger *zap.L
 		}
 	} else {
 
-		// Verify Receiver Service Is Not Terminating
+		// Verify Receiver Service Is Not Terminating (Converging Its Additional Metadata)
 		if service.DeletionTimestamp.IsZero() {
+			desiredService := r.newReceiverService(secret)
+			updatedService := service.DeepCopy()
+			if util.ConvergeAdditionalMetadata(&updatedService.ObjectMeta, &desiredService.ObjectMeta) {
+				_, err = r.kubeClientset.CoreV1().Services(updatedService.Namespace).Update(ctx, updatedService, metav1.UpdateOptions{})
+				if err != nil {
+					logger.Error("Failed To Update Receiver Service Metadata", zap.Error(err))
+					return err
+				}
+				logger.Info("Successfully Updated Receiver Service Metadata")
+			}
 			logger.Info("Successfully Verified Receiver Service")
 			return nil
 		} else {
@@ -146,8 +159,8 @@ func (r *Reconciler) newReceiverService(secret *corev1.Secret) *corev1.Service {
 	// Get The Receiver Deployment Name For The Secret - Use Same For Service
 	deploymentName := util.ReceiverDnsSafeName(secret.Name)
 
-	// Create & Return The Receiver Service Model
-	return &corev1.Service{
+	// Create The Receiver Service Model
+	service := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: corev1.SchemeGroupVersion.String(),
 			Kind:       constants.ServiceKind,
@@ -181,6 +194,10 @@ func (r *Reconciler) newReceiverService(secret *corev1.Secret) *corev1.Service {
 			},
 		},
 	}
+
+	// Merge Any Additional Labels & Annotations From The ConfigMap & Return The Service Model
+	util.AddAdditionalMetadata(&service.ObjectMeta, r.additionalMetadata())
+	return service
 }
 
 //
@@ -190,6 +207,13 @@ func (r *Reconciler) newReceiverService(secret *corev1.Secret) *corev1.Service {
 // Reconcile The Receiver Deployment
 func (r *Reconciler) reconcileReceiverDeployment(ctx context.Context, logger *zap.Logger, secret *corev1.Secret) error {
 
+	// Validate The Receiver Image (Rather Than Creating A Deployment Which Can Never Start)
+	err := env.ValidateImage(env.ReceiverImageEnvVarKey, r.environment.ReceiverImage)
+	if err != nil {
+		logger.Error("Invalid Receiver Image", zap.Error(err))
+		return err
+	}
+
 	// Attempt To Get The Receiver Deployment Associated With The Specified Secret
 	deployment, err := r.getReceiverDeployment(secret)
 	if deployment == nil || err != nil {
@@ -222,8 +246,25 @@ func (r *Reconciler) reconcileReceiverDeployment(ctx context.Context, logger *za
 		}
 	} else {
 
-		// Verify Receiver Deployment Is Not Terminating
+		// Verify Receiver Deployment Is Not Terminating (Converging Its Additional Metadata & Scheduling Controls)
 		if deployment.DeletionTimestamp.IsZero() {
+			desiredDeployment, err := r.newReceiverDeployment(logger, secret)
+			if err != nil {
+				logger.Error("Failed To Create Receiver Deployment YAML", zap.Error(err))
+				return err
+			}
+			updatedDeployment := deployment.DeepCopy()
+			metadataChanged := util.ConvergeAdditionalMetadata(&updatedDeployment.ObjectMeta, &desiredDeployment.ObjectMeta)
+			metadataChanged = util.ConvergeAdditionalMetadata(&updatedDeployment.Spec.Template.ObjectMeta, &desiredDeployment.Spec.Template.ObjectMeta) || metadataChanged
+			schedulingChanged := util.ConvergeScheduling(&updatedDeployment.Spec.Template.Spec, &desiredDeployment.Spec.Template.Spec)
+			if metadataChanged || schedulingChanged {
+				_, err = r.kubeClientset.AppsV1().Deployments(updatedDeployment.Namespace).Update(ctx, updatedDeployment, metav1.UpdateOptions{})
+				if err != nil {
+					logger.Error("Failed To Update Receiver Deployment", zap.Error(err))
+					return err
+				}
+				logger.Info("Successfully Updated Receiver Deployment", zap.Bool("MetadataChanged", metadataChanged), zap.Bool("SchedulingChanged", schedulingChanged))
+			}
 			logger.Info("Successfully Verified Receiver Deployment")
 			return nil
 		} else {
@@ -343,10 +384,25 @@ func (r *Reconciler) newReceiverDeployment(logger *zap.Logger, secret *corev1.Se
 		},
 	}
 
+	// Merge Any Additional Labels & Annotations From The ConfigMap Into The Deployment & Pod Template
+	util.AddAdditionalMetadata(&deployment.ObjectMeta, r.additionalMetadata())
+	util.AddAdditionalMetadata(&deployment.Spec.Template.ObjectMeta, r.additionalMetadata())
+
+	// Apply The Receiver Scheduling Controls (NodeSelector, Tolerations & Affinity) From The ConfigMap
+	util.AddScheduling(&deployment.Spec.Template.Spec, r.config.Receiver.EKKubernetesConfig)
+
 	// Return Receiver Deployment
 	return deployment, nil
 }
 
+// Get The Additional Labels & Annotations For Generated Deployments / Services (None If Not Configured)
+func (r *Reconciler) additionalMetadata() config.EKMetadataConfig {
+	if r.config == nil {
+		return config.EKMetadataConfig{}
+	}
+	return r.config.Metadata
+}
+
 // Create The Receiver Deployment's Env Vars
 func (r *Reconciler) receiverDeploymentEnvVars(secret *corev1.Secret) ([]corev1.EnvVar, error) {
 
@@ -423,6 +479,54 @@ func (r *Reconciler) receiverDeploymentEnvVars(secret *corev1.Secret) ([]corev1.
 		},
 	})
 
+	// Append The Kafka SASL Mechanism As Env Var (Optional - Defaults To PLAIN)
+	envVars = append(envVars, corev1.EnvVar{
+		Name: commonenv.KafkaSaslMechanismEnvVarKey,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+				Key:                  constants.KafkaSecretDataKeySaslMechanism,
+				Optional:             pointer.BoolPtr(true),
+			},
+		},
+	})
+
+	// Append The Kafka TLS Client Certificate As Env Var (Optional - mTLS)
+	envVars = append(envVars, corev1.EnvVar{
+		Name: commonenv.KafkaTLSCertEnvVarKey,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+				Key:                  constants.KafkaSecretDataKeyUserCert,
+				Optional:             pointer.BoolPtr(true),
+			},
+		},
+	})
+
+	// Append The Kafka TLS Client Key As Env Var (Optional - mTLS)
+	envVars = append(envVars, corev1.EnvVar{
+		Name: commonenv.KafkaTLSKeyEnvVarKey,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+				Key:                  constants.KafkaSecretDataKeyUserKey,
+				Optional:             pointer.BoolPtr(true),
+			},
+		},
+	})
+
+	// Append The Kafka TLS Enable Override As Env Var (Optional)
+	envVars = append(envVars, corev1.EnvVar{
+		Name: commonenv.KafkaTLSEnableEnvVarKey,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+				Key:                  constants.KafkaSecretDataKeyTLSEnable,
+				Optional:             pointer.BoolPtr(true),
+			},
+		},
+	})
+
 	// Return The Receiver Deployment EnvVars Array
 	return envVars, nil
 }
@@ -27,7 +27,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	consolidatedresources "knative.dev/eventing-kafka/pkg/channel/consolidated/reconciler/controller/resources"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
 	commonenv "knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/health"
@@ -38,8 +40,10 @@ import (
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/event"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
 	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/eventing/pkg/apis/messaging"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/logging"
 	reconcilertesting "knative.dev/pkg/reconciler/testing"
 	"knative.dev/pkg/system"
@@ -74,14 +78,19 @@ const (
 	ReceiverDeploymentName = KafkaSecretName + "-b9176d5f-receiver" // Truncated MD5 Hash Of KafkaSecretName
 	ReceiverServiceName    = ReceiverDeploymentName
 	TopicName              = KafkaChannelNamespace + "." + KafkaChannelName
+	MissingKafkaSecretName = "missing-kafkasecret-name"
 
 	KafkaSecretDataValueBrokers  = "TestKafkaSecretDataBrokers"
 	KafkaSecretDataValueUsername = "TestKafkaSecretDataUsername"
 	KafkaSecretDataValuePassword = "TestKafkaSecretDataPassword"
 
 	// ChannelSpec Test Data
-	NumPartitions     = 123
-	ReplicationFactor = 456
+	NumPartitions            = 123
+	ReplicationFactor        = 456
+	DeadLetterSinkURI        = "http://dead-letter-sink.kafkachannel-namespace.svc.cluster.local/"
+	InvalidDeadLetterSinkURI = "/not/absolute"
+	SubscriberUid1           = "subscriber-uid-1"
+	SubscriberUid2           = "subscriber-uid-2"
 
 	// Test MetaData
 	ErrorString   = "Expected Mock Test Error"
@@ -93,6 +102,14 @@ const (
 	DispatcherMemoryLimit   = "50Mi"
 	DispatcherCpuLimit      = "300m"
 
+	// Test Dispatcher Resource Overrides (KafkaChannel Annotations)
+	DispatcherCpuRequestOverride  = "200m"
+	DispatcherMemoryLimitOverride = "100Mi"
+
+	// Test Dispatcher Sarama ConfigHash (Pod Template Annotation)
+	DispatcherConfigHash      = "TestDispatcherConfigHash"
+	DispatcherStaleConfigHash = "TestDispatcherStaleConfigHash"
+
 	// Test Receiver Resources
 	ReceiverMemoryRequest = "10Mi"
 	ReceiverMemoryLimit   = "20Mi"
@@ -151,6 +168,7 @@ Producer:
 
 var (
 	DefaultRetentionMillisString = strconv.FormatInt(DefaultRetentionMillis, 10)
+	DefaultCleanupPolicyString   = kafkav1beta1.CleanupPolicyDelete
 	DeletionTimestamp            = metav1.Now()
 )
 
@@ -172,6 +190,28 @@ func WithDeletionTimestampDeployment(deployment *appsv1.Deployment) {
 	deployment.ObjectMeta.SetDeletionTimestamp(&DeletionTimestamp)
 }
 
+// Set The Dispatcher Deployment's Resources To Those Of The Dispatcher Resource Annotations
+func WithDispatcherResourceOverridesDeployment(deployment *appsv1.Deployment) {
+	deployment.Spec.Template.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU] = resource.MustParse(DispatcherCpuRequestOverride)
+	deployment.Spec.Template.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory] = resource.MustParse(DispatcherMemoryLimitOverride)
+}
+
+// Set The Dispatcher Deployment's Pod Template ConfigHash Annotation To A Stale (Previous Sarama Settings) Value
+func WithStaleConfigHashDeployment(deployment *appsv1.Deployment) {
+	deployment.Spec.Template.Annotations[constants.ConfigHashAnnotation] = DispatcherStaleConfigHash
+}
+
+// Set The KafkaChannel Service's Labels & Spec To Those Created By The "Consolidated" Implementation
+func WithConsolidatedChannelService(service *corev1.Service) {
+	service.ObjectMeta.Labels = map[string]string{
+		consolidatedresources.MessagingRoleLabel: consolidatedresources.MessagingRole,
+	}
+	service.Spec = corev1.ServiceSpec{
+		Type:         corev1.ServiceTypeExternalName,
+		ExternalName: "kafka-ch-dispatcher." + commonconstants.KnativeEventingNamespace + ".svc.cluster.local",
+	}
+}
+
 // Clear The Specified Service's Finalizers
 func WithoutFinalizersService(service *corev1.Service) {
 	service.ObjectMeta.Finalizers = []string{}
@@ -271,6 +311,11 @@ func WithKafkaSecretDeleted(secret *corev1.Secret) {
 	secret.ObjectMeta.SetDeletionTimestamp(&DeletionTimestamp)
 }
 
+// Set The Kafka Secret's Label (Identifying It As A Kafka Secret)
+func WithKafkaSecretLabel(secret *corev1.Secret) {
+	secret.ObjectMeta.Labels = map[string]string{kafkaconstants.KafkaSecretLabel: "true"}
+}
+
 // Set The Kafka Secret's Finalizer
 func WithKafkaSecretFinalizer(secret *corev1.Secret) {
 	secret.ObjectMeta.Finalizers = []string{constants.EventingKafkaFinalizerPrefix + "kafkasecrets.eventing-kafka.knative.dev"}
@@ -334,7 +379,7 @@ func NewKafkaChannel(options ...KafkaChannelOption) *kafkav1beta1.KafkaChannel {
 		Spec: kafkav1beta1.KafkaChannelSpec{
 			NumPartitions:     NumPartitions,
 			ReplicationFactor: ReplicationFactor,
-			// TODO RetentionMillis:   RetentionMillis,
+			CleanupPolicy:     kafkav1beta1.CleanupPolicyDelete,
 		},
 	}
 
@@ -376,6 +421,47 @@ func WithAnnotations(kafkachannel *kafkav1beta1.KafkaChannel) {
 	}
 }
 
+// Set The KafkaChannel's DryRun Annotation
+func WithDryRunAnnotation(kafkachannel *kafkav1beta1.KafkaChannel) {
+	if kafkachannel.ObjectMeta.Annotations == nil {
+		kafkachannel.ObjectMeta.Annotations = make(map[string]string)
+	}
+	kafkachannel.ObjectMeta.Annotations[constants.DryRunAnnotation] = "true"
+}
+
+// Set The KafkaChannel's Dispatcher Resource Override Annotations
+func WithDispatcherResourceAnnotations(kafkachannel *kafkav1beta1.KafkaChannel) {
+	if kafkachannel.ObjectMeta.Annotations == nil {
+		kafkachannel.ObjectMeta.Annotations = make(map[string]string)
+	}
+	kafkachannel.ObjectMeta.Annotations[constants.DispatcherCpuRequestAnnotation] = DispatcherCpuRequestOverride
+	kafkachannel.ObjectMeta.Annotations[constants.DispatcherMemoryLimitAnnotation] = DispatcherMemoryLimitOverride
+}
+
+// Set The KafkaChannel's KafkaSecret Annotation (Selecting A Kafka Secret Which Does Not Exist)
+func WithMissingKafkaSecretAnnotation(kafkachannel *kafkav1beta1.KafkaChannel) {
+	if kafkachannel.ObjectMeta.Annotations == nil {
+		kafkachannel.ObjectMeta.Annotations = make(map[string]string)
+	}
+	kafkachannel.ObjectMeta.Annotations[constants.KafkaSecretAnnotation] = MissingKafkaSecretName
+}
+
+// Set The KafkaChannel's RetainTopic Annotation
+func WithRetainTopicAnnotation(kafkachannel *kafkav1beta1.KafkaChannel) {
+	if kafkachannel.ObjectMeta.Annotations == nil {
+		kafkachannel.ObjectMeta.Annotations = make(map[string]string)
+	}
+	kafkachannel.ObjectMeta.Annotations[constants.RetainTopicAnnotation] = "true"
+}
+
+// Set The KafkaChannel's DisableTopicAutoCreate Annotation
+func WithDisableTopicAutoCreateAnnotation(kafkachannel *kafkav1beta1.KafkaChannel) {
+	if kafkachannel.ObjectMeta.Annotations == nil {
+		kafkachannel.ObjectMeta.Annotations = make(map[string]string)
+	}
+	kafkachannel.ObjectMeta.Annotations[constants.DisableTopicAutoCreateAnnotation] = "true"
+}
+
 // Set The KafkaChannel's Labels
 func WithLabels(kafkachannel *kafkav1beta1.KafkaChannel) {
 	kafkachannel.ObjectMeta.Labels = map[string]string{
@@ -444,11 +530,62 @@ func WithDispatcherFailed(kafkachannel *kafkav1beta1.KafkaChannel) {
 	kafkachannel.Status.MarkDispatcherFailed(event.DispatcherDeploymentReconciliationFailed.String(), "Failed To Create Dispatcher Deployment: inducing failure for create deployments")
 }
 
+// Set The KafkaChannel's Dispatcher Deployment As Failed To Update
+func WithDispatcherUpdateFailed(kafkachannel *kafkav1beta1.KafkaChannel) {
+	kafkachannel.Status.MarkDispatcherFailed(event.DispatcherDeploymentReconciliationFailed.String(), "Failed To Update Dispatcher Deployment: inducing failure for update deployments")
+}
+
+// Set The KafkaChannel's Configuration As Failed Due To The Selected Kafka Secret Not Being Found
+func WithKafkaSecretNotFound(kafkachannel *kafkav1beta1.KafkaChannel) {
+	kafkachannel.Status.MarkConfigFailed(event.KafkaSecretNotFound.String(), "Selected Kafka Secret %s Not Found", MissingKafkaSecretName)
+}
+
 // Set The KafkaChannel's Topic READY
 func WithTopicReady(kafkachannel *kafkav1beta1.KafkaChannel) {
 	kafkachannel.Status.MarkTopicTrue()
 }
 
+// Set The KafkaChannel's Subscribers
+func WithSubscribers(kafkachannel *kafkav1beta1.KafkaChannel) {
+	kafkachannel.Spec.Subscribers = []eventingduck.SubscriberSpec{
+		{UID: SubscriberUid1},
+		{UID: SubscriberUid2},
+	}
+}
+
+// Set The KafkaChannel's ConnectionReady Condition As True (No Labeled Kafka Secrets In Table Tests)
+func WithConnectionReady(kafkachannel *kafkav1beta1.KafkaChannel) {
+	kafkachannel.Status.MarkConnectionTrue(constants.KafkaConnectedReason, "Connected To Kafka Brokers []")
+}
+
+// Set The KafkaChannel's Channel-Level Dead Letter Sink
+func WithDeadLetterSink(kafkachannel *kafkav1beta1.KafkaChannel) {
+	deadLetterSinkURI, _ := apis.ParseURL(DeadLetterSinkURI)
+	kafkachannel.Spec.Delivery = &eventingduck.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: deadLetterSinkURI}}
+}
+
+// Set The KafkaChannel's Channel-Level Dead Letter Sink To An Unresolvable (Relative) URI
+func WithInvalidDeadLetterSink(kafkachannel *kafkav1beta1.KafkaChannel) {
+	deadLetterSinkURI, _ := apis.ParseURL(InvalidDeadLetterSinkURI)
+	kafkachannel.Spec.Delivery = &eventingduck.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: deadLetterSinkURI}}
+}
+
+// Set The KafkaChannel's Channel-Level Dead Letter Sink As Resolved
+func WithDeadLetterSinkResolved(kafkachannel *kafkav1beta1.KafkaChannel) {
+	deadLetterSinkURI, _ := apis.ParseURL(DeadLetterSinkURI)
+	kafkachannel.Status.MarkDeadLetterSinkResolved(deadLetterSinkURI)
+}
+
+// Set The KafkaChannel's Channel-Level Dead Letter Sink As Failed To Resolve
+func WithDeadLetterSinkResolutionFailed(kafkachannel *kafkav1beta1.KafkaChannel) {
+	kafkachannel.Status.MarkDeadLetterSinkResolvedFailed(event.DeadLetterSinkResolutionFailed.String(), "Failed To Resolve Dead Letter Sink: %s", NewDeadLetterSinkResolutionError())
+}
+
+// Utility Function For Creating The Expected Dead Letter Sink Resolution Error Message
+func NewDeadLetterSinkResolutionError() string {
+	return fmt.Sprintf("URI is not absolute(both scheme and host should be non-empty): %q", InvalidDeadLetterSinkURI)
+}
+
 // Utility Function For Creating A Custom KafkaChannel "Channel" Service For Testing
 func NewKafkaChannelService(options ...ServiceOption) *corev1.Service {
 
@@ -666,6 +803,46 @@ func NewKafkaChannelReceiverDeployment(options ...DeploymentOption) *appsv1.Depl
 										},
 									},
 								},
+								{
+									Name: commonenv.KafkaSaslMechanismEnvVarKey,
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: KafkaSecretName},
+											Key:                  constants.KafkaSecretDataKeySaslMechanism,
+											Optional:             pointer.BoolPtr(true),
+										},
+									},
+								},
+								{
+									Name: commonenv.KafkaTLSCertEnvVarKey,
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: KafkaSecretName},
+											Key:                  constants.KafkaSecretDataKeyUserCert,
+											Optional:             pointer.BoolPtr(true),
+										},
+									},
+								},
+								{
+									Name: commonenv.KafkaTLSKeyEnvVarKey,
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: KafkaSecretName},
+											Key:                  constants.KafkaSecretDataKeyUserKey,
+											Optional:             pointer.BoolPtr(true),
+										},
+									},
+								},
+								{
+									Name: commonenv.KafkaTLSEnableEnvVarKey,
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: KafkaSecretName},
+											Key:                  constants.KafkaSecretDataKeyTLSEnable,
+											Optional:             pointer.BoolPtr(true),
+										},
+									},
+								},
 							},
 							ImagePullPolicy: corev1.PullIfNotPresent,
 							Resources: corev1.ResourceRequirements{
@@ -781,9 +958,13 @@ func NewKafkaChannelDispatcherDeployment(options ...DeploymentOption) *appsv1.De
 					Labels: map[string]string{
 						"app": dispatcherName,
 					},
+					Annotations: map[string]string{
+						constants.ConfigHashAnnotation: DispatcherConfigHash,
+					},
 				},
 				Spec: corev1.PodSpec{
-					ServiceAccountName: ServiceAccount,
+					ServiceAccountName:            ServiceAccount,
+					TerminationGracePeriodSeconds: pointer.Int64Ptr(constants.DispatcherDrainTimeoutSeconds + constants.DispatcherTerminationGracePeriodBuffer),
 					Containers: []corev1.Container{
 						{
 							Name:  dispatcherName,
@@ -802,12 +983,22 @@ func NewKafkaChannelDispatcherDeployment(options ...DeploymentOption) *appsv1.De
 								Handler: corev1.Handler{
 									HTTPGet: &corev1.HTTPGetAction{
 										Port: intstr.FromInt(constants.HealthPort),
-										Path: health.ReadinessPath,
+										Path: health.KafkaReadinessPath,
 									},
 								},
 								InitialDelaySeconds: constants.DispatcherReadinessDelay,
 								PeriodSeconds:       constants.DispatcherReadinessPeriod,
 							},
+							StartupProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Port: intstr.FromInt(constants.HealthPort),
+										Path: health.LivenessPath,
+									},
+								},
+								PeriodSeconds:    constants.DispatcherStartupPeriod,
+								FailureThreshold: constants.DispatcherStartupFailureThreshold,
+							},
 							Env: []corev1.EnvVar{
 								{
 									Name:  system.NamespaceEnvKey,
@@ -853,6 +1044,18 @@ func NewKafkaChannelDispatcherDeployment(options ...DeploymentOption) *appsv1.De
 									Name:  commonenv.KafkaTopicEnvVarKey,
 									Value: topicName,
 								},
+								{
+									Name:  commonenv.KafkaReadinessIntervalEnvVarKey,
+									Value: strconv.Itoa(constants.DispatcherReadinessPeriod),
+								},
+								{
+									Name:  commonenv.DrainTimeoutEnvVarKey,
+									Value: strconv.Itoa(constants.DispatcherDrainTimeoutSeconds),
+								},
+								{
+									Name:  commonenv.ConsumerLagIntervalEnvVarKey,
+									Value: strconv.Itoa(constants.DispatcherConsumerLagIntervalSeconds),
+								},
 								{
 									Name: commonenv.KafkaBrokerEnvVarKey,
 									ValueFrom: &corev1.EnvVarSource{
@@ -880,6 +1083,46 @@ func NewKafkaChannelDispatcherDeployment(options ...DeploymentOption) *appsv1.De
 										},
 									},
 								},
+								{
+									Name: commonenv.KafkaSaslMechanismEnvVarKey,
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: KafkaSecretName},
+											Key:                  constants.KafkaSecretDataKeySaslMechanism,
+											Optional:             pointer.BoolPtr(true),
+										},
+									},
+								},
+								{
+									Name: commonenv.KafkaTLSCertEnvVarKey,
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: KafkaSecretName},
+											Key:                  constants.KafkaSecretDataKeyUserCert,
+											Optional:             pointer.BoolPtr(true),
+										},
+									},
+								},
+								{
+									Name: commonenv.KafkaTLSKeyEnvVarKey,
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: KafkaSecretName},
+											Key:                  constants.KafkaSecretDataKeyUserKey,
+											Optional:             pointer.BoolPtr(true),
+										},
+									},
+								},
+								{
+									Name: commonenv.KafkaTLSEnableEnvVarKey,
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: KafkaSecretName},
+											Key:                  constants.KafkaSecretDataKeyTLSEnable,
+											Optional:             pointer.BoolPtr(true),
+										},
+									},
+								},
 							},
 							ImagePullPolicy: corev1.PullIfNotPresent,
 							Resources: corev1.ResourceRequirements{
@@ -990,6 +1233,18 @@ func NewKafkaChannelSuccessfulFinalizedEvent() string {
 	return reconcilertesting.Eventf(corev1.EventTypeNormal, event.KafkaChannelFinalized.String(), fmt.Sprintf("KafkaChannel Finalized Successfully: \"%s/%s\"", KafkaChannelNamespace, KafkaChannelName))
 }
 
+// Utility Function For Creating A Successful Kafka Topic Creation Audit Event
+func NewKafkaTopicAuditCreatedEvent() string {
+	return reconcilertesting.Eventf(corev1.EventTypeNormal, event.KafkaTopicAuditCreated.String(), "Created Kafka Topic %s (NumPartitions: %d, ReplicationFactor: %d, KafkaSecret: %s, Trigger: %s %s/%s)",
+		TopicName, NumPartitions, ReplicationFactor, KafkaSecretName, constants.KafkaChannelKind, KafkaChannelNamespace, KafkaChannelName)
+}
+
+// Utility Function For Creating A Successful Kafka Topic Deletion Audit Event
+func NewKafkaTopicAuditDeletedEvent() string {
+	return reconcilertesting.Eventf(corev1.EventTypeNormal, event.KafkaTopicAuditDeleted.String(), "Deleted Kafka Topic %s (KafkaSecret: %s, Trigger: %s %s/%s)",
+		TopicName, KafkaSecretName, constants.KafkaChannelKind, KafkaChannelNamespace, KafkaChannelName)
+}
+
 // Utility Function For Creating A UpdateActionImpl For A Service Update Command
 func NewServiceUpdateActionImpl(service *corev1.Service) clientgotesting.UpdateActionImpl {
 	return clientgotesting.UpdateActionImpl{
@@ -32,11 +32,27 @@ var _ kafkaadmin.AdminClientInterface = &MockAdminClient{}
 
 // Mock Kafka AdminClient Implementation
 type MockAdminClient struct {
-	closeCalled         bool
-	createTopicsCalled  bool
-	deleteTopicsCalled  bool
-	MockCreateTopicFunc func(context.Context, string, *sarama.TopicDetail) *sarama.TopicError
-	MockDeleteTopicFunc func(context.Context, string) *sarama.TopicError
+	closeCalled                 bool
+	createTopicACLCalled        bool
+	deleteTopicACLCalled        bool
+	createTopicsCalled          bool
+	createTopicsBatchCalled     bool
+	deleteTopicsCalled          bool
+	alterTopicConfigCalled      bool
+	createPartitionsCalled      bool
+	MockCreateTopicFunc         func(context.Context, string, *sarama.TopicDetail) *sarama.TopicError
+	MockCreateTopicsFunc        func(context.Context, map[string]*sarama.TopicDetail) map[string]*sarama.TopicError
+	MockDeleteTopicFunc         func(context.Context, string) *sarama.TopicError
+	MockDescribeTopicFunc       func(context.Context, string) (*sarama.TopicMetadata, *sarama.TopicError)
+	MockCreatePartitionsFunc    func(context.Context, string, int32) *sarama.TopicError
+	MockDescribeTopicConfigFunc func(context.Context, string) (map[string]string, *sarama.TopicError)
+	MockAlterTopicConfigFunc    func(context.Context, string, map[string]*string) *sarama.TopicError
+	MockDescribeClusterFunc     func(context.Context) ([]*sarama.Broker, error)
+	MockListManagedTopicsFunc   func(context.Context) (map[string]sarama.TopicDetail, error)
+	MockDescribeGroupsFunc      func(context.Context, []string) ([]*sarama.GroupDescription, error)
+	MockListGroupOffsetsFunc    func(context.Context, string, map[string][]int32) (*sarama.OffsetFetchResponse, error)
+	MockCreateTopicACLFunc      func(context.Context, string, sarama.Acl) *sarama.TopicError
+	MockDeleteTopicACLFunc      func(context.Context, string, sarama.Acl) *sarama.TopicError
 }
 
 // Mock Kafka AdminClient CreateTopic() Function - Calls Custom CreateTopic() If Specified, Otherwise Returns Success
@@ -54,6 +70,25 @@ func (m *MockAdminClient) CreateTopicsCalled() bool {
 	return m.createTopicsCalled
 }
 
+// Mock Kafka AdminClient CreateTopics() Function - Calls Custom CreateTopics() If Specified, Otherwise Returns Success For Each Topic
+func (m *MockAdminClient) CreateTopics(ctx context.Context, topicDetails map[string]*sarama.TopicDetail) map[string]*sarama.TopicError {
+	m.createTopicsBatchCalled = true
+	if m.MockCreateTopicsFunc != nil {
+		return m.MockCreateTopicsFunc(ctx, topicDetails)
+	}
+	topicErrors := make(map[string]*sarama.TopicError, len(topicDetails))
+	for topicName := range topicDetails {
+		errMsg := "mock CreateTopics() success"
+		topicErrors[topicName] = &sarama.TopicError{Err: sarama.ErrNoError, ErrMsg: &errMsg}
+	}
+	return topicErrors
+}
+
+// Check On Calls To The Bulk CreateTopics()
+func (m *MockAdminClient) CreateTopicsBatchCalled() bool {
+	return m.createTopicsBatchCalled
+}
+
 // Mock Kafka AdminClient DeleteTopic() Function - Calls Custom DeleteTopic() If Specified, Otherwise Returns Success
 func (m *MockAdminClient) DeleteTopic(ctx context.Context, topicName string) *sarama.TopicError {
 	m.deleteTopicsCalled = true
@@ -69,6 +104,110 @@ func (m *MockAdminClient) DeleteTopicsCalled() bool {
 	return m.deleteTopicsCalled
 }
 
+// Mock Kafka AdminClient DescribeTopic() Function - Calls Custom DescribeTopic() If Specified, Otherwise Returns Nil Metadata
+func (m *MockAdminClient) DescribeTopic(ctx context.Context, topicName string) (*sarama.TopicMetadata, *sarama.TopicError) {
+	if m.MockDescribeTopicFunc != nil {
+		return m.MockDescribeTopicFunc(ctx, topicName)
+	}
+	return nil, nil
+}
+
+// Mock Kafka AdminClient CreatePartitions() Function - Calls Custom CreatePartitions() If Specified, Otherwise Returns Success
+func (m *MockAdminClient) CreatePartitions(ctx context.Context, topicName string, count int32) *sarama.TopicError {
+	m.createPartitionsCalled = true
+	if m.MockCreatePartitionsFunc != nil {
+		return m.MockCreatePartitionsFunc(ctx, topicName, count)
+	}
+	return nil
+}
+
+// Check On Calls To CreatePartitions()
+func (m *MockAdminClient) CreatePartitionsCalled() bool {
+	return m.createPartitionsCalled
+}
+
+// Mock Kafka AdminClient DescribeTopicConfig() Function - Calls Custom DescribeTopicConfig() If Specified, Otherwise Returns Empty Config
+func (m *MockAdminClient) DescribeTopicConfig(ctx context.Context, topicName string) (map[string]string, *sarama.TopicError) {
+	if m.MockDescribeTopicConfigFunc != nil {
+		return m.MockDescribeTopicConfigFunc(ctx, topicName)
+	}
+	return map[string]string{}, nil
+}
+
+// Mock Kafka AdminClient AlterTopicConfig() Function - Calls Custom AlterTopicConfig() If Specified, Otherwise Returns Success
+func (m *MockAdminClient) AlterTopicConfig(ctx context.Context, topicName string, configEntries map[string]*string) *sarama.TopicError {
+	m.alterTopicConfigCalled = true
+	if m.MockAlterTopicConfigFunc != nil {
+		return m.MockAlterTopicConfigFunc(ctx, topicName, configEntries)
+	}
+	return nil
+}
+
+// Check On Calls To AlterTopicConfig()
+func (m *MockAdminClient) AlterTopicConfigCalled() bool {
+	return m.alterTopicConfigCalled
+}
+
+// Mock Kafka AdminClient DescribeCluster() Function - Calls Custom DescribeCluster() If Specified, Otherwise Returns No Brokers
+func (m *MockAdminClient) DescribeCluster(ctx context.Context) ([]*sarama.Broker, error) {
+	if m.MockDescribeClusterFunc != nil {
+		return m.MockDescribeClusterFunc(ctx)
+	}
+	return []*sarama.Broker{}, nil
+}
+
+// Mock Kafka AdminClient ListManagedTopics() Function - Calls Custom ListManagedTopics() If Specified, Otherwise Returns No Topics
+func (m *MockAdminClient) ListManagedTopics(ctx context.Context) (map[string]sarama.TopicDetail, error) {
+	if m.MockListManagedTopicsFunc != nil {
+		return m.MockListManagedTopicsFunc(ctx)
+	}
+	return map[string]sarama.TopicDetail{}, nil
+}
+
+// Mock Kafka AdminClient DescribeConsumerGroups() Function - Calls Custom DescribeConsumerGroups() If Specified, Otherwise Returns No Groups
+func (m *MockAdminClient) DescribeConsumerGroups(ctx context.Context, groupIds []string) ([]*sarama.GroupDescription, error) {
+	if m.MockDescribeGroupsFunc != nil {
+		return m.MockDescribeGroupsFunc(ctx, groupIds)
+	}
+	return []*sarama.GroupDescription{}, nil
+}
+
+// Mock Kafka AdminClient ListConsumerGroupOffsets() Function - Calls Custom ListConsumerGroupOffsets() If Specified, Otherwise Returns No Offsets
+func (m *MockAdminClient) ListConsumerGroupOffsets(ctx context.Context, groupId string, topicPartitions map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	if m.MockListGroupOffsetsFunc != nil {
+		return m.MockListGroupOffsetsFunc(ctx, groupId, topicPartitions)
+	}
+	return &sarama.OffsetFetchResponse{}, nil
+}
+
+// Mock Kafka AdminClient CreateTopicACL() Function - Calls Custom CreateTopicACL() If Specified, Otherwise Returns Success
+func (m *MockAdminClient) CreateTopicACL(ctx context.Context, topicName string, acl sarama.Acl) *sarama.TopicError {
+	m.createTopicACLCalled = true
+	if m.MockCreateTopicACLFunc != nil {
+		return m.MockCreateTopicACLFunc(ctx, topicName, acl)
+	}
+	return nil
+}
+
+// Check On Calls To CreateTopicACL()
+func (m *MockAdminClient) CreateTopicACLCalled() bool {
+	return m.createTopicACLCalled
+}
+
+// Mock Kafka AdminClient DeleteTopicACL() Function - Calls Custom DeleteTopicACL() If Specified, Otherwise Returns Success
+func (m *MockAdminClient) DeleteTopicACL(ctx context.Context, topicName string, acl sarama.Acl) *sarama.TopicError {
+	m.deleteTopicACLCalled = true
+	if m.MockDeleteTopicACLFunc != nil {
+		return m.MockDeleteTopicACLFunc(ctx, topicName, acl)
+	}
+	return nil
+}
+
+// Check On Calls To DeleteTopicACL()
+func (m *MockAdminClient) DeleteTopicACLCalled() bool {
+	return m.deleteTopicACLCalled
+}
+
 // Mock Kafka AdminClient Close Function - NoOp
 func (m *MockAdminClient) Close() error {
 	m.closeCalled = true
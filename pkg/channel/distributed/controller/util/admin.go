@@ -0,0 +1,41 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"go.uber.org/zap"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+)
+
+// Utility Function To Get The Kafka AdminClient Type From The ConfigMap-Provided Settings (Assume Kafka Unless Otherwise Specified)
+func AdminClientType(configuration *config.EventingKafkaConfig, logger *zap.Logger) kafkaadmin.AdminClientType {
+	switch configuration.Kafka.AdminType {
+	case constants.KafkaAdminTypeValueKafka:
+		return kafkaadmin.Kafka
+	case constants.KafkaAdminTypeValueAzure:
+		return kafkaadmin.EventHub
+	case constants.KafkaAdminTypeValueCustom:
+		return kafkaadmin.Custom
+	case constants.KafkaAdminTypeValueConfluent:
+		return kafkaadmin.Confluent
+	default:
+		logger.Warn("Encountered Unexpected Kafka AdminType - Defaulting To 'kafka'", zap.String("AdminType", configuration.Kafka.AdminType))
+		return kafkaadmin.Kafka
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The AdminClientType() Functionality
+func TestAdminClientType(t *testing.T) {
+
+	// Test Logger
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		adminType string
+		expected  kafkaadmin.AdminClientType
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{adminType: constants.KafkaAdminTypeValueKafka, expected: kafkaadmin.Kafka},
+		{adminType: constants.KafkaAdminTypeValueAzure, expected: kafkaadmin.EventHub},
+		{adminType: constants.KafkaAdminTypeValueCustom, expected: kafkaadmin.Custom},
+		{adminType: constants.KafkaAdminTypeValueConfluent, expected: kafkaadmin.Confluent},
+		{adminType: "", expected: kafkaadmin.Kafka},
+		{adminType: "unknown", expected: kafkaadmin.Kafka},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		configuration := &config.EventingKafkaConfig{Kafka: config.EKKafkaConfig{AdminType: testCase.adminType}}
+		assert.Equal(t, testCase.expected, AdminClientType(configuration, logger), testCase.adminType)
+	}
+}
@@ -17,14 +17,21 @@ limitations under the License.
 package util
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	"knative.dev/pkg/logging"
 	"knative.dev/pkg/network"
 )
 
@@ -33,6 +40,17 @@ func ChannelLogger(logger *zap.Logger, channel *kafkav1beta1.KafkaChannel) *zap.
 	return logger.With(zap.String("Channel", fmt.Sprintf("%s/%s", channel.Namespace, channel.Name)))
 }
 
+//
+// Get A Logger With Channel Info For The Current Reconciliation / Finalization
+//
+// The Knative controller adds a unique knative.dev/traceid (and the knative.dev/key) to the logger in the Context
+// of each reconciliation, so deriving the logger from the Context allows all of the lines of one reconciliation
+// to be correlated.
+//
+func ReconcileLogger(ctx context.Context, channel *kafkav1beta1.KafkaChannel) *zap.Logger {
+	return ChannelLogger(logging.FromContext(ctx).Desugar(), channel)
+}
+
 // Create A Knative Reconciler "Key" Formatted Representation Of The Specified Channel
 func ChannelKey(channel *kafkav1beta1.KafkaChannel) string {
 	return fmt.Sprintf("%s/%s", channel.Namespace, channel.Name)
@@ -102,15 +120,111 @@ func ReplicationFactor(channel *kafkav1beta1.KafkaChannel, configuration *config
 
 // Utility Function To Get The RetentionMillis - First From Channel Spec And Then From ConfigMap-Provided Settings
 func RetentionMillis(channel *kafkav1beta1.KafkaChannel, configuration *config.EventingKafkaConfig, logger *zap.Logger) int64 {
-	//
-	// TODO - The eventing-contrib KafkaChannel CRD does not include RetentionMillis so we're
-	//        currently just using the default value specified in Controller Environment Variables.
-	//
-	//value := channel.Spec.RetentionMillis
-	//if value <= 0 {
-	//	logger.Debug("Kafka Channel Spec 'RetentionMillis' Not Specified - Using Default", zap.Int64("Value", environment.DefaultRetentionMillis))
-	//	value = environment.DefaultRetentionMillis
-	//}
-	//return value
+	if len(channel.Spec.RetentionDuration) > 0 {
+		retentionDuration, err := channel.Spec.ParseRetentionDuration()
+		if err == nil && retentionDuration > 0 {
+			return retentionDuration.Milliseconds()
+		}
+		logger.Warn("Kafka Channel Spec 'RetentionDuration' Invalid - Using Default", zap.String("RetentionDuration", channel.Spec.RetentionDuration), zap.Error(err))
+	} else {
+		logger.Debug("Kafka Channel Spec 'RetentionDuration' Not Specified - Using Default", zap.Int64("Value", configuration.Kafka.Topic.DefaultRetentionMillis))
+	}
 	return configuration.Kafka.Topic.DefaultRetentionMillis
 }
+
+// Utility Function To Get The CleanupPolicy - First From Channel Spec And Then From The KafkaChannel Default
+func CleanupPolicy(channel *kafkav1beta1.KafkaChannel, logger *zap.Logger) string {
+	value := channel.Spec.CleanupPolicy
+	if len(value) <= 0 {
+		logger.Debug("Kafka Channel Spec 'CleanupPolicy' Not Specified - Using Default", zap.String("Value", commonconstants.DefaultCleanupPolicy))
+		value = commonconstants.DefaultCleanupPolicy
+	}
+	return value
+}
+
+// Utility Function To Get The MaxMessageBytes From The Channel Annotation (Zero If Not Specified Or Invalid)
+func MaxMessageBytes(channel *kafkav1beta1.KafkaChannel, logger *zap.Logger) int32 {
+	if annotation, ok := channel.Annotations[kafkaconstants.MaxMessageBytesAnnotation]; ok {
+		value, err := commonkafkautil.ParseMaxMessageBytes(annotation)
+		if err != nil {
+			logger.Warn("Kafka Channel 'MaxMessageBytes' Annotation Invalid - Using Kafka Default", zap.String("Annotation", annotation), zap.Error(err))
+			return 0
+		}
+		return value
+	}
+	return 0
+}
+
+// Utility Function To Get The DryRun Setting - From The ConfigMap-Provided Settings Or Channel Annotation (Which Can Only Enable It)
+func DryRun(channel *kafkav1beta1.KafkaChannel, configuration *config.EventingKafkaConfig, logger *zap.Logger) bool {
+	value := configuration.Kafka.DryRun
+	if annotation, ok := channel.Annotations[constants.DryRunAnnotation]; ok {
+		annotationValue, err := strconv.ParseBool(annotation)
+		if err != nil {
+			logger.Warn("Kafka Channel 'DryRun' Annotation Invalid - Using Default", zap.String("Annotation", annotation), zap.Bool("Value", value))
+		} else if !annotationValue && value {
+			logger.Warn("Kafka Channel 'DryRun' Annotation Cannot Disable The Configured DryRun Mode - Ignoring", zap.String("Annotation", annotation))
+		} else {
+			value = annotationValue
+		}
+	}
+	return value
+}
+
+// Utility Function To Get The DisableTopicAutoCreate Setting - First From Channel Annotation And Then From ConfigMap-Provided Settings
+func DisableTopicAutoCreate(channel *kafkav1beta1.KafkaChannel, configuration *config.EventingKafkaConfig, logger *zap.Logger) bool {
+	value := configuration.Kafka.DisableTopicAutoCreate
+	if annotation, ok := channel.Annotations[constants.DisableTopicAutoCreateAnnotation]; ok {
+		annotationValue, err := strconv.ParseBool(annotation)
+		if err != nil {
+			logger.Warn("Kafka Channel 'DisableTopicAutoCreate' Annotation Invalid - Using Default", zap.String("Annotation", annotation), zap.Bool("Value", value))
+		} else {
+			value = annotationValue
+		}
+	}
+	return value
+}
+
+// Utility Function To Determine Whether Migration From The "Consolidated" Implementation Was Requested Via Channel Annotation
+func MigrateFromConsolidated(channel *kafkav1beta1.KafkaChannel, logger *zap.Logger) bool {
+	if annotation, ok := channel.Annotations[constants.MigrateFromConsolidatedAnnotation]; ok {
+		value, err := strconv.ParseBool(annotation)
+		if err != nil {
+			logger.Warn("Kafka Channel 'MigrateFromConsolidated' Annotation Invalid - Ignoring", zap.String("Annotation", annotation))
+			return false
+		}
+		return value
+	}
+	return false
+}
+
+// Utility Function To Determine Whether Consumption Of The Channel Was Paused Via Channel Annotation (Defaults To False)
+func Paused(channel *kafkav1beta1.KafkaChannel, logger *zap.Logger) bool {
+	if annotation, ok := channel.Annotations[constants.PausedAnnotation]; ok {
+		value, err := strconv.ParseBool(annotation)
+		if err != nil {
+			logger.Warn("Kafka Channel 'Paused' Annotation Invalid - Ignoring", zap.String("Annotation", annotation))
+			return false
+		}
+		return value
+	}
+	return false
+}
+
+// Utility Function To Get The Explicitly Selected Kafka Secret Name From The Channel Annotation (Empty For Implicit Selection)
+func KafkaSecretName(channel *kafkav1beta1.KafkaChannel) string {
+	return strings.TrimSpace(channel.Annotations[constants.KafkaSecretAnnotation])
+}
+
+// Utility Function To Get The RetainTopic Setting From The Channel Annotation (Defaults To False)
+func RetainTopic(channel *kafkav1beta1.KafkaChannel, logger *zap.Logger) bool {
+	if annotation, ok := channel.Annotations[constants.RetainTopicAnnotation]; ok {
+		annotationValue, err := strconv.ParseBool(annotation)
+		if err != nil {
+			logger.Warn("Kafka Channel 'RetainTopic' Annotation Invalid - Topic Will Not Be Retained", zap.String("Annotation", annotation))
+		} else {
+			return annotationValue
+		}
+	}
+	return false
+}
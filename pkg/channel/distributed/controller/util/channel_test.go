@@ -17,15 +17,22 @@ limitations under the License.
 package util
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/logging/logkey"
 	logtesting "knative.dev/pkg/logging/testing"
 )
 
@@ -59,6 +66,30 @@ func TestChannelLogger(t *testing.T) {
 	channelLogger.Info("Testing Channel Logger")
 }
 
+// Test The ReconcileLogger() Functionality
+func TestReconcileLogger(t *testing.T) {
+
+	// Test Logger (Observed) With The Fields Added By The Knative Controller
+	observedCore, observedLogs := observer.New(zapcore.DebugLevel)
+	logger := zap.New(observedCore).With(zap.String(logkey.TraceID, "TestTraceId"), zap.String(logkey.Key, "TestChannelNamespace/TestChannelName"))
+	ctx := logging.WithLogger(context.TODO(), logger.Sugar())
+
+	// Test Data
+	channel := &kafkav1beta1.KafkaChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "TestChannelName", Namespace: "TestChannelNamespace"},
+	}
+
+	// Perform The Test
+	ReconcileLogger(ctx, channel).Info("Reconcile Logger")
+
+	// Verify The Logged Fields
+	entries := observedLogs.AllUntimed()
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "TestChannelNamespace/TestChannelName", entries[0].ContextMap()["Channel"])
+	assert.Equal(t, "TestTraceId", entries[0].ContextMap()[logkey.TraceID])
+	assert.Equal(t, "TestChannelNamespace/TestChannelName", entries[0].ContextMap()[logkey.Key])
+}
+
 // Test The ChannelKey() Functionality
 func TestChannelKey(t *testing.T) {
 
@@ -167,9 +198,174 @@ func TestRetentionMillis(t *testing.T) {
 	actualRetentionMillis := RetentionMillis(channel, configuration, logger)
 	assert.Equal(t, defaultRetentionMillis, actualRetentionMillis)
 
-	// TODO - No RetentionMillis In eventing-contrib KafkaChannel
-	//// Test The Valid RetentionMillis Use Case
-	//channel = &kafkav1beta1.KafkaChannel{Spec: kafkav1beta1.KafkaChannelSpec{RetentionMillis: retentionMillis}}
-	//actualRetentionMillis = RetentionMillis(channel, environment, logger)
-	//assert.Equal(t, retentionMillis, actualRetentionMillis)
+	// Test The Valid RetentionDuration Use Case
+	channel = &kafkav1beta1.KafkaChannel{Spec: kafkav1beta1.KafkaChannelSpec{RetentionDuration: "PT1H"}}
+	actualRetentionMillis = RetentionMillis(channel, configuration, logger)
+	assert.Equal(t, int64(3600000), actualRetentionMillis)
+
+	// Test The Invalid RetentionDuration Use Case
+	channel = &kafkav1beta1.KafkaChannel{Spec: kafkav1beta1.KafkaChannelSpec{RetentionDuration: "invalid"}}
+	actualRetentionMillis = RetentionMillis(channel, configuration, logger)
+	assert.Equal(t, defaultRetentionMillis, actualRetentionMillis)
+}
+
+// Test The CleanupPolicy() Functionality
+func TestCleanupPolicy(t *testing.T) {
+
+	// Test Logger
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Test The Default Failover Use Case
+	channel := &kafkav1beta1.KafkaChannel{}
+	assert.Equal(t, kafkav1beta1.CleanupPolicyDelete, CleanupPolicy(channel, logger))
+
+	// Test The Valid CleanupPolicy Use Case
+	channel = &kafkav1beta1.KafkaChannel{Spec: kafkav1beta1.KafkaChannelSpec{CleanupPolicy: kafkav1beta1.CleanupPolicyCompact}}
+	assert.Equal(t, kafkav1beta1.CleanupPolicyCompact, CleanupPolicy(channel, logger))
+}
+
+// Test The DryRun() Functionality
+func TestDryRun(t *testing.T) {
+
+	// Test Logger
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Test Data
+	dryRunConfiguration := &config.EventingKafkaConfig{Kafka: config.EKKafkaConfig{DryRun: true}}
+	normalConfiguration := &config.EventingKafkaConfig{Kafka: config.EKKafkaConfig{DryRun: false}}
+	newChannel := func(annotation string) *kafkav1beta1.KafkaChannel {
+		return &kafkav1beta1.KafkaChannel{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constants.DryRunAnnotation: annotation}}}
+	}
+
+	// Test The Default Failover Use Cases
+	assert.True(t, DryRun(&kafkav1beta1.KafkaChannel{}, dryRunConfiguration, logger))
+	assert.False(t, DryRun(&kafkav1beta1.KafkaChannel{}, normalConfiguration, logger))
+
+	// Test The Annotation Override Use Cases
+	assert.True(t, DryRun(newChannel("true"), normalConfiguration, logger))
+	assert.False(t, DryRun(newChannel("false"), normalConfiguration, logger))
+
+	// Test The Annotation Cannot Disable The Configured DryRun Mode
+	assert.True(t, DryRun(newChannel("false"), dryRunConfiguration, logger))
+
+	// Test The Invalid Annotation Use Case
+	assert.True(t, DryRun(newChannel("invalid"), dryRunConfiguration, logger))
+	assert.False(t, DryRun(newChannel("invalid"), normalConfiguration, logger))
+}
+
+// Test The DisableTopicAutoCreate() Functionality
+func TestDisableTopicAutoCreate(t *testing.T) {
+
+	// Test Logger
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Test Data
+	disabledConfiguration := &config.EventingKafkaConfig{Kafka: config.EKKafkaConfig{DisableTopicAutoCreate: true}}
+	normalConfiguration := &config.EventingKafkaConfig{Kafka: config.EKKafkaConfig{DisableTopicAutoCreate: false}}
+	newChannel := func(annotation string) *kafkav1beta1.KafkaChannel {
+		return &kafkav1beta1.KafkaChannel{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constants.DisableTopicAutoCreateAnnotation: annotation}}}
+	}
+
+	// Test The Default Failover Use Cases
+	assert.True(t, DisableTopicAutoCreate(&kafkav1beta1.KafkaChannel{}, disabledConfiguration, logger))
+	assert.False(t, DisableTopicAutoCreate(&kafkav1beta1.KafkaChannel{}, normalConfiguration, logger))
+
+	// Test The Annotation Override Use Cases
+	assert.True(t, DisableTopicAutoCreate(newChannel("true"), normalConfiguration, logger))
+	assert.False(t, DisableTopicAutoCreate(newChannel("false"), disabledConfiguration, logger))
+
+	// Test The Invalid Annotation Use Case
+	assert.True(t, DisableTopicAutoCreate(newChannel("invalid"), disabledConfiguration, logger))
+	assert.False(t, DisableTopicAutoCreate(newChannel("invalid"), normalConfiguration, logger))
+}
+
+// Test The MigrateFromConsolidated() Functionality
+func TestMigrateFromConsolidated(t *testing.T) {
+
+	// Test Logger
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Test Data
+	newChannel := func(annotation string) *kafkav1beta1.KafkaChannel {
+		return &kafkav1beta1.KafkaChannel{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constants.MigrateFromConsolidatedAnnotation: annotation}}}
+	}
+
+	// Test The Missing Annotation Use Case
+	assert.False(t, MigrateFromConsolidated(&kafkav1beta1.KafkaChannel{}, logger))
+
+	// Test The Annotation Use Cases
+	assert.True(t, MigrateFromConsolidated(newChannel("true"), logger))
+	assert.False(t, MigrateFromConsolidated(newChannel("false"), logger))
+	assert.False(t, MigrateFromConsolidated(newChannel("invalid"), logger))
+}
+
+// Test The Paused() Functionality
+func TestPaused(t *testing.T) {
+
+	// Test Logger
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Test Data
+	newChannel := func(annotation string) *kafkav1beta1.KafkaChannel {
+		return &kafkav1beta1.KafkaChannel{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constants.PausedAnnotation: annotation}}}
+	}
+
+	// Test The Missing Annotation Use Case
+	assert.False(t, Paused(&kafkav1beta1.KafkaChannel{}, logger))
+
+	// Test The Annotation Use Cases
+	assert.True(t, Paused(newChannel("true"), logger))
+	assert.False(t, Paused(newChannel("false"), logger))
+	assert.False(t, Paused(newChannel("invalid"), logger))
+}
+
+// Test The KafkaSecretName() Functionality
+func TestKafkaSecretName(t *testing.T) {
+	assert.Equal(t, "", KafkaSecretName(&kafkav1beta1.KafkaChannel{}))
+	channel := &kafkav1beta1.KafkaChannel{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constants.KafkaSecretAnnotation: " kafka-secret-2 "}}}
+	assert.Equal(t, "kafka-secret-2", KafkaSecretName(channel))
+}
+
+// Test The RetainTopic() Functionality
+func TestRetainTopic(t *testing.T) {
+
+	// Test Logger
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Test Data
+	newChannel := func(annotation string) *kafkav1beta1.KafkaChannel {
+		return &kafkav1beta1.KafkaChannel{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constants.RetainTopicAnnotation: annotation}}}
+	}
+
+	// Test The Default (No Annotation) Use Case
+	assert.False(t, RetainTopic(&kafkav1beta1.KafkaChannel{}, logger))
+
+	// Test The Annotation Use Cases
+	assert.True(t, RetainTopic(newChannel("true"), logger))
+	assert.False(t, RetainTopic(newChannel("false"), logger))
+
+	// Test The Invalid Annotation Use Case
+	assert.False(t, RetainTopic(newChannel("invalid"), logger))
+}
+
+// Test The MaxMessageBytes() Functionality
+func TestMaxMessageBytes(t *testing.T) {
+
+	// Test Logger
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Test Data
+	newChannel := func(annotation string) *kafkav1beta1.KafkaChannel {
+		return &kafkav1beta1.KafkaChannel{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{kafkaconstants.MaxMessageBytesAnnotation: annotation}}}
+	}
+
+	// Test The Default (No Annotation) Use Case
+	assert.Equal(t, int32(0), MaxMessageBytes(&kafkav1beta1.KafkaChannel{}, logger))
+
+	// Test The Annotation Use Case
+	assert.Equal(t, int32(2097152), MaxMessageBytes(newChannel("2097152"), logger))
+
+	// Test The Invalid Annotation Use Cases
+	assert.Equal(t, int32(0), MaxMessageBytes(newChannel("-1"), logger))
+	assert.Equal(t, int32(0), MaxMessageBytes(newChannel("invalid"), logger))
 }
@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+)
+
+//
+// Additional Env Vars
+//
+// The ConfigMap may specify additional env vars (e.g. proxy settings or Secret / ConfigMap references) to be
+// merged into the first container of the generated pod templates.  Controller-managed env vars always take
+// precedence, and the names which were actually applied are tracked in a pod template annotation so that they
+// can be pruned from existing resources once they are no longer configured.
+//
+
+// Merge The Configured Additional Env Vars Into The First Container Of The Specified (New) PodTemplateSpec Without Overwriting Existing Env Vars
+func AddEnv(podTemplateSpec *corev1.PodTemplateSpec, envVars []corev1.EnvVar) {
+	if podTemplateSpec == nil || len(podTemplateSpec.Spec.Containers) == 0 {
+		return
+	}
+	container := &podTemplateSpec.Spec.Containers[0]
+	existingNames := make(map[string]struct{}, len(container.Env))
+	for _, envVar := range container.Env {
+		existingNames[envVar.Name] = struct{}{}
+	}
+	names := make([]string, 0, len(envVars))
+	for _, envVar := range envVars {
+		if _, exists := existingNames[envVar.Name]; !exists {
+			container.Env = append(container.Env, envVar)
+			existingNames[envVar.Name] = struct{}{}
+			names = append(names, envVar.Name)
+		}
+	}
+	if len(names) > 0 {
+		podTemplateSpec.Annotations = setEntry(podTemplateSpec.Annotations, constants.AdditionalEnvAnnotation, strings.Join(names, ","))
+	}
+}
+
+//
+// Converge The Additional Env Vars Of An Existing PodTemplateSpec With Those Of The Desired PodTemplateSpec
+//
+// The desired PodTemplateSpec is expected to have been populated via AddEnv().  Previously applied env vars
+// which are no longer configured are removed (or replaced by the controller-managed env var of the same name),
+// and the currently configured env vars are added / updated.  Returns true if the existing PodTemplateSpec
+// was modified (which triggers a rolling restart).
+//
+func ConvergeEnv(existing *corev1.PodTemplateSpec, desired *corev1.PodTemplateSpec) bool {
+	if existing == nil || desired == nil || len(existing.Spec.Containers) == 0 || len(desired.Spec.Containers) == 0 {
+		return false
+	}
+	changed := false
+	for name := range splitKeys(existing.Annotations[constants.AdditionalEnvAnnotation]) {
+		changed = ConvergeEnvVar(&existing.Spec.Containers[0], &desired.Spec.Containers[0], name) || changed
+	}
+	for _, name := range strings.Split(desired.Annotations[constants.AdditionalEnvAnnotation], ",") {
+		changed = ConvergeEnvVar(&existing.Spec.Containers[0], &desired.Spec.Containers[0], name) || changed
+	}
+	return convergeEntry(&existing.Annotations, desired.Annotations, constants.AdditionalEnvAnnotation) || changed
+}
+
+// Converge The Specified Env Var Of The Existing Container To That Of The Desired Container (Returning Whether It Changed)
+func ConvergeEnvVar(existing *corev1.Container, desired *corev1.Container, name string) bool {
+	var desiredEnvVar *corev1.EnvVar
+	for index := range desired.Env {
+		if desired.Env[index].Name == name {
+			desiredEnvVar = &desired.Env[index]
+			break
+		}
+	}
+	for index := range existing.Env {
+		if existing.Env[index].Name == name {
+			if desiredEnvVar == nil {
+				existing.Env = append(existing.Env[:index], existing.Env[index+1:]...)
+				return true
+			} else if equality.Semantic.DeepEqual(existing.Env[index], *desiredEnvVar) {
+				return false
+			}
+			existing.Env[index] = *desiredEnvVar
+			return true
+		}
+	}
+	if desiredEnvVar != nil {
+		existing.Env = append(existing.Env, *desiredEnvVar)
+		return true
+	}
+	return false
+}
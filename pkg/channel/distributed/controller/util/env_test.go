@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+)
+
+// Test The AddEnv() & ConvergeEnv() Functionality
+func TestEnv(t *testing.T) {
+
+	// Test Data
+	managed := corev1.EnvVar{Name: "METRICS_PORT", Value: "8081"}
+	proxy := corev1.EnvVar{Name: "HTTP_PROXY", Value: "http://proxy:3128"}
+	token := corev1.EnvVar{Name: "TRACING_TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tracing"}, Key: "token"}}}
+	newPodTemplateSpec := func() *corev1.PodTemplateSpec {
+		return &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "dispatcher", Env: []corev1.EnvVar{managed}}}}}
+	}
+
+	// Verify The Env Vars Are Merged Into A New PodTemplateSpec Without Clobbering The Controller-Managed Env Vars
+	desired := newPodTemplateSpec()
+	AddEnv(desired, []corev1.EnvVar{proxy, {Name: "METRICS_PORT", Value: "9999"}, token})
+	assert.Equal(t, []corev1.EnvVar{managed, proxy, token}, desired.Spec.Containers[0].Env)
+	assert.Equal(t, "HTTP_PROXY,TRACING_TOKEN", desired.Annotations[constants.AdditionalEnvAnnotation])
+	AddEnv(nil, []corev1.EnvVar{proxy})
+
+	// Verify Nothing Is Tracked When No Env Vars Are Configured
+	empty := newPodTemplateSpec()
+	AddEnv(empty, nil)
+	assert.Equal(t, []corev1.EnvVar{managed}, empty.Spec.Containers[0].Env)
+	assert.Nil(t, empty.Annotations)
+
+	// Verify Added Env Vars Are Converged Into An Existing PodTemplateSpec & Subsequently Unchanged
+	existing := newPodTemplateSpec()
+	assert.True(t, ConvergeEnv(existing, desired))
+	assert.Equal(t, []corev1.EnvVar{managed, proxy, token}, existing.Spec.Containers[0].Env)
+	assert.Equal(t, "HTTP_PROXY,TRACING_TOKEN", existing.Annotations[constants.AdditionalEnvAnnotation])
+	assert.False(t, ConvergeEnv(existing, desired))
+
+	// Verify Updated Env Vars Are Converged & Removed Env Vars Are Pruned
+	updated := newPodTemplateSpec()
+	AddEnv(updated, []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://proxy:8080"}})
+	assert.True(t, ConvergeEnv(existing, updated))
+	assert.Equal(t, []corev1.EnvVar{managed, {Name: "HTTP_PROXY", Value: "http://proxy:8080"}}, existing.Spec.Containers[0].Env)
+	assert.Equal(t, "HTTP_PROXY", existing.Annotations[constants.AdditionalEnvAnnotation])
+
+	// Verify All Env Vars Are Pruned (Leaving The Controller-Managed Env Vars) Once None Are Configured
+	assert.True(t, ConvergeEnv(existing, empty))
+	assert.Equal(t, []corev1.EnvVar{managed}, existing.Spec.Containers[0].Env)
+	assert.NotContains(t, existing.Annotations, constants.AdditionalEnvAnnotation)
+
+	// Verify Unrelated (Untracked) Env Vars Of The Existing PodTemplateSpec Are Left Alone
+	existing.Spec.Containers[0].Env = append(existing.Spec.Containers[0].Env, proxy)
+	assert.False(t, ConvergeEnv(existing, empty))
+	assert.Equal(t, []corev1.EnvVar{managed, proxy}, existing.Spec.Containers[0].Env)
+
+	// Verify Nil PodTemplateSpecs Are Handled
+	assert.False(t, ConvergeEnv(nil, desired))
+}
+
+// Test Converging A Single Env Var Of An Existing Container
+func TestConvergeEnvVar(t *testing.T) {
+	const name = "TEST_ENV_VAR"
+	other := corev1.EnvVar{Name: "OTHER_ENV_VAR", Value: "other"}
+	tests := []struct {
+		name     string
+		existing []corev1.EnvVar
+		desired  []corev1.EnvVar
+		changed  bool
+		expected []corev1.EnvVar
+	}{
+		{name: "Both Absent", existing: []corev1.EnvVar{other}, desired: []corev1.EnvVar{other}, changed: false, expected: []corev1.EnvVar{other}},
+		{name: "Unchanged", existing: []corev1.EnvVar{{Name: name, Value: "1"}, other}, desired: []corev1.EnvVar{other, {Name: name, Value: "1"}}, changed: false, expected: []corev1.EnvVar{{Name: name, Value: "1"}, other}},
+		{name: "Added", existing: []corev1.EnvVar{other}, desired: []corev1.EnvVar{other, {Name: name, Value: "1"}}, changed: true, expected: []corev1.EnvVar{other, {Name: name, Value: "1"}}},
+		{name: "Updated", existing: []corev1.EnvVar{{Name: name, Value: "1"}, other}, desired: []corev1.EnvVar{{Name: name, Value: "2"}}, changed: true, expected: []corev1.EnvVar{{Name: name, Value: "2"}, other}},
+		{name: "Removed", existing: []corev1.EnvVar{{Name: name, Value: "1"}, other}, desired: []corev1.EnvVar{other}, changed: true, expected: []corev1.EnvVar{other}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			existing := &corev1.Container{Env: test.existing}
+			desired := &corev1.Container{Env: test.desired}
+			assert.Equal(t, test.changed, ConvergeEnvVar(existing, desired, name))
+			assert.Equal(t, test.expected, existing.Env)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+)
+
+//
+// Additional Metadata
+//
+// The ConfigMap may specify additional labels & annotations (e.g. cost-allocation labels or service-mesh
+// annotations) to be merged onto the Deployments & Services generated by the controller.  Controller-owned
+// keys always take precedence, and the keys which were actually applied are tracked in annotations so that
+// they can be pruned from existing resources once they are no longer configured.
+//
+
+// Merge The Configured Additional Labels & Annotations Into The Specified (New) ObjectMeta Without Overwriting Existing Keys
+func AddAdditionalMetadata(objectMeta *metav1.ObjectMeta, metadata config.EKMetadataConfig) {
+	if objectMeta == nil {
+		return
+	}
+	labelKeys := mergeAdditionalEntries(&objectMeta.Labels, metadata.Labels)
+	annotationKeys := mergeAdditionalEntries(&objectMeta.Annotations, metadata.Annotations)
+	if len(labelKeys) > 0 {
+		objectMeta.Annotations = setEntry(objectMeta.Annotations, constants.AdditionalLabelsAnnotation, strings.Join(labelKeys, ","))
+	}
+	if len(annotationKeys) > 0 {
+		objectMeta.Annotations = setEntry(objectMeta.Annotations, constants.AdditionalAnnotationsAnnotation, strings.Join(annotationKeys, ","))
+	}
+}
+
+//
+// Converge The Additional Labels & Annotations Of An Existing ObjectMeta With Those Of The Desired ObjectMeta
+//
+// The desired ObjectMeta is expected to have been populated via AddAdditionalMetadata().  Previously applied
+// keys which are no longer configured are removed (unless owned by the controller), and the currently configured
+// keys are added / updated.  Returns true if the existing ObjectMeta was modified.
+//
+func ConvergeAdditionalMetadata(existing *metav1.ObjectMeta, desired *metav1.ObjectMeta) bool {
+	if existing == nil || desired == nil {
+		return false
+	}
+	labelsChanged := convergeAdditionalEntries(&existing.Labels, desired.Labels, existing.Annotations[constants.AdditionalLabelsAnnotation], desired.Annotations[constants.AdditionalLabelsAnnotation])
+	annotationsChanged := convergeAdditionalEntries(&existing.Annotations, desired.Annotations, existing.Annotations[constants.AdditionalAnnotationsAnnotation], desired.Annotations[constants.AdditionalAnnotationsAnnotation])
+	trackingChanged := convergeEntry(&existing.Annotations, desired.Annotations, constants.AdditionalLabelsAnnotation)
+	trackingChanged = convergeEntry(&existing.Annotations, desired.Annotations, constants.AdditionalAnnotationsAnnotation) || trackingChanged
+	return labelsChanged || annotationsChanged || trackingChanged
+}
+
+// Merge The Additional Entries Into The Specified Map (Existing Keys Win) & Return The Sorted Keys Which Were Added
+func mergeAdditionalEntries(entries *map[string]string, additional map[string]string) []string {
+	keys := make([]string, 0, len(additional))
+	for key, value := range additional {
+		if _, exists := (*entries)[key]; !exists {
+			*entries = setEntry(*entries, key, value)
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Converge The Tracked Additional Entries Of The Existing Map With Those Of The Desired Map (Returning Whether Any Changed)
+func convergeAdditionalEntries(existing *map[string]string, desired map[string]string, existingKeys string, desiredKeys string) bool {
+	changed := false
+	desiredKeySet := splitKeys(desiredKeys)
+
+	// Prune Previously Applied Keys Which Are No Longer Configured (Unless Owned By The Controller)
+	for key := range splitKeys(existingKeys) {
+		if _, configured := desiredKeySet[key]; configured {
+			continue
+		}
+		if _, owned := desired[key]; owned {
+			continue
+		}
+		if _, exists := (*existing)[key]; exists {
+			delete(*existing, key)
+			changed = true
+		}
+	}
+
+	// Add / Update The Currently Configured Keys
+	for key := range desiredKeySet {
+		changed = convergeEntry(existing, desired, key) || changed
+	}
+	return changed
+}
+
+// Converge A Single Entry Of The Existing Map With The Desired Map (Removing It If Not Desired)
+func convergeEntry(existing *map[string]string, desired map[string]string, key string) bool {
+	desiredValue, desiredExists := desired[key]
+	existingValue, existingExists := (*existing)[key]
+	if !desiredExists {
+		if existingExists {
+			delete(*existing, key)
+			return true
+		}
+		return false
+	}
+	if existingExists && existingValue == desiredValue {
+		return false
+	}
+	*existing = setEntry(*existing, key, desiredValue)
+	return true
+}
+
+// Set The Specified Entry In The Map (Creating The Map If Necessary)
+func setEntry(entries map[string]string, key string, value string) map[string]string {
+	if entries == nil {
+		entries = make(map[string]string)
+	}
+	entries[key] = value
+	return entries
+}
+
+// Split A Comma Separated List Of Keys Into A Set
+func splitKeys(keys string) map[string]struct{} {
+	keySet := make(map[string]struct{})
+	for _, key := range strings.Split(keys, ",") {
+		if len(key) > 0 {
+			keySet[key] = struct{}{}
+		}
+	}
+	return keySet
+}
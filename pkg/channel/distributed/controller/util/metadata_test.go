@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/constants"
+)
+
+// Test The AddAdditionalMetadata() Functionality
+func TestAddAdditionalMetadata(t *testing.T) {
+
+	// Test Data
+	objectMeta := &metav1.ObjectMeta{
+		Labels:      map[string]string{constants.AppLabel: "app"},
+		Annotations: map[string]string{constants.ConfigHashAnnotation: "hash"},
+	}
+	metadata := config.EKMetadataConfig{
+		Labels:      map[string]string{"cost-center": "1234", "team": "eventing", constants.AppLabel: "clobbered"},
+		Annotations: map[string]string{"sidecar.istio.io/inject": "true", constants.ConfigHashAnnotation: "clobbered"},
+	}
+
+	// Perform The Test
+	AddAdditionalMetadata(objectMeta, metadata)
+
+	// Verify The Results (Controller-Owned Keys Are Not Clobbered Or Tracked)
+	assert.Equal(t, map[string]string{constants.AppLabel: "app", "cost-center": "1234", "team": "eventing"}, objectMeta.Labels)
+	assert.Equal(t, map[string]string{
+		constants.ConfigHashAnnotation:            "hash",
+		"sidecar.istio.io/inject":                 "true",
+		constants.AdditionalLabelsAnnotation:      "cost-center,team",
+		constants.AdditionalAnnotationsAnnotation: "sidecar.istio.io/inject",
+	}, objectMeta.Annotations)
+
+	// Verify Nil Maps & Empty Config Are Handled
+	emptyObjectMeta := &metav1.ObjectMeta{}
+	AddAdditionalMetadata(emptyObjectMeta, config.EKMetadataConfig{})
+	assert.Nil(t, emptyObjectMeta.Labels)
+	assert.Nil(t, emptyObjectMeta.Annotations)
+	AddAdditionalMetadata(emptyObjectMeta, config.EKMetadataConfig{Labels: map[string]string{"team": "eventing"}})
+	assert.Equal(t, map[string]string{"team": "eventing"}, emptyObjectMeta.Labels)
+	assert.Equal(t, map[string]string{constants.AdditionalLabelsAnnotation: "team"}, emptyObjectMeta.Annotations)
+	AddAdditionalMetadata(nil, config.EKMetadataConfig{Labels: map[string]string{"team": "eventing"}})
+}
+
+// Test The ConvergeAdditionalMetadata() Functionality
+func TestConvergeAdditionalMetadata(t *testing.T) {
+
+	// Utility Function For Creating A Desired ObjectMeta With The Specified Additional Metadata
+	newDesired := func(metadata config.EKMetadataConfig) *metav1.ObjectMeta {
+		objectMeta := &metav1.ObjectMeta{Labels: map[string]string{constants.AppLabel: "app"}}
+		AddAdditionalMetadata(objectMeta, metadata)
+		return objectMeta
+	}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only            bool
+		name            string
+		existing        *metav1.ObjectMeta
+		metadata        config.EKMetadataConfig
+		wantChanged     bool
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:       "Unchanged Without Additional Metadata",
+			existing:   &metav1.ObjectMeta{Labels: map[string]string{constants.AppLabel: "app", "external": "value"}},
+			wantLabels: map[string]string{constants.AppLabel: "app", "external": "value"},
+		},
+		{
+			name:     "Unchanged With Additional Metadata",
+			existing: newDesired(config.EKMetadataConfig{Labels: map[string]string{"team": "eventing"}}),
+			metadata: config.EKMetadataConfig{Labels: map[string]string{"team": "eventing"}},
+			wantLabels: map[string]string{
+				constants.AppLabel: "app",
+				"team":             "eventing",
+			},
+			wantAnnotations: map[string]string{constants.AdditionalLabelsAnnotation: "team"},
+		},
+		{
+			name:        "Added",
+			existing:    &metav1.ObjectMeta{Labels: map[string]string{constants.AppLabel: "app"}},
+			metadata:    config.EKMetadataConfig{Labels: map[string]string{"team": "eventing"}, Annotations: map[string]string{"mesh": "true"}},
+			wantChanged: true,
+			wantLabels:  map[string]string{constants.AppLabel: "app", "team": "eventing"},
+			wantAnnotations: map[string]string{
+				"mesh":                                    "true",
+				constants.AdditionalLabelsAnnotation:      "team",
+				constants.AdditionalAnnotationsAnnotation: "mesh",
+			},
+		},
+		{
+			name:        "Updated",
+			existing:    newDesired(config.EKMetadataConfig{Labels: map[string]string{"team": "eventing"}}),
+			metadata:    config.EKMetadataConfig{Labels: map[string]string{"team": "messaging"}},
+			wantChanged: true,
+			wantLabels: map[string]string{
+				constants.AppLabel: "app",
+				"team":             "messaging",
+			},
+			wantAnnotations: map[string]string{constants.AdditionalLabelsAnnotation: "team"},
+		},
+		{
+			name: "Pruned",
+			existing: func() *metav1.ObjectMeta {
+				objectMeta := newDesired(config.EKMetadataConfig{Labels: map[string]string{"team": "eventing", "cost-center": "1234"}, Annotations: map[string]string{"mesh": "true"}})
+				objectMeta.Labels["external"] = "value"
+				return objectMeta
+			}(),
+			metadata:        config.EKMetadataConfig{Labels: map[string]string{"team": "eventing"}},
+			wantChanged:     true,
+			wantLabels:      map[string]string{constants.AppLabel: "app", "team": "eventing", "external": "value"},
+			wantAnnotations: map[string]string{constants.AdditionalLabelsAnnotation: "team"},
+		},
+		{
+			name:            "Controller-Owned Label Not Pruned",
+			existing:        &metav1.ObjectMeta{Labels: map[string]string{constants.AppLabel: "app"}, Annotations: map[string]string{constants.AdditionalLabelsAnnotation: constants.AppLabel}},
+			wantChanged:     true,
+			wantLabels:      map[string]string{constants.AppLabel: "app"},
+			wantAnnotations: map[string]string{},
+		},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			changed := ConvergeAdditionalMetadata(testCase.existing, newDesired(testCase.metadata))
+			assert.Equal(t, testCase.wantChanged, changed)
+			assert.Equal(t, testCase.wantLabels, testCase.existing.Labels)
+			assert.Equal(t, testCase.wantAnnotations, testCase.existing.Annotations)
+		})
+	}
+
+	// Verify Nil ObjectMeta Is Handled
+	assert.False(t, ConvergeAdditionalMetadata(nil, &metav1.ObjectMeta{}))
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+)
+
+// Apply The Configured Scheduling Controls (NodeSelector, Tolerations & Affinity) To The Specified (New) PodSpec
+func AddScheduling(podSpec *corev1.PodSpec, kubernetesConfig config.EKKubernetesConfig) {
+	if podSpec == nil {
+		return
+	}
+	podSpec.NodeSelector = kubernetesConfig.NodeSelector
+	podSpec.Tolerations = kubernetesConfig.Tolerations
+	podSpec.Affinity = kubernetesConfig.Affinity
+}
+
+// Converge The Scheduling Controls Of An Existing PodSpec With Those Of The Desired PodSpec (Returning Whether Any Changed)
+func ConvergeScheduling(existing *corev1.PodSpec, desired *corev1.PodSpec) bool {
+	if existing == nil || desired == nil {
+		return false
+	}
+	changed := false
+	if !equality.Semantic.DeepEqual(existing.NodeSelector, desired.NodeSelector) {
+		existing.NodeSelector = desired.NodeSelector
+		changed = true
+	}
+	if !equality.Semantic.DeepEqual(existing.Tolerations, desired.Tolerations) {
+		existing.Tolerations = desired.Tolerations
+		changed = true
+	}
+	if !equality.Semantic.DeepEqual(existing.Affinity, desired.Affinity) {
+		existing.Affinity = desired.Affinity
+		changed = true
+	}
+	return changed
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+)
+
+// Test The AddScheduling() & ConvergeScheduling() Functionality
+func TestScheduling(t *testing.T) {
+
+	// Test Data
+	kubernetesConfig := config.EKKubernetesConfig{
+		NodeSelector: map[string]string{"pool": "kafka"},
+		Tolerations:  []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+		Affinity:     &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{}},
+	}
+
+	// Verify The Scheduling Controls Are Applied To A New PodSpec
+	desired := &corev1.PodSpec{}
+	AddScheduling(desired, kubernetesConfig)
+	assert.Equal(t, kubernetesConfig.NodeSelector, desired.NodeSelector)
+	assert.Equal(t, kubernetesConfig.Tolerations, desired.Tolerations)
+	assert.Equal(t, kubernetesConfig.Affinity, desired.Affinity)
+	AddScheduling(nil, kubernetesConfig)
+
+	// Verify An Existing PodSpec Is Converged & Subsequently Unchanged
+	existing := &corev1.PodSpec{NodeSelector: map[string]string{"pool": "default"}}
+	assert.True(t, ConvergeScheduling(existing, desired))
+	assert.Equal(t, desired.NodeSelector, existing.NodeSelector)
+	assert.Equal(t, desired.Tolerations, existing.Tolerations)
+	assert.Equal(t, desired.Affinity, existing.Affinity)
+	assert.False(t, ConvergeScheduling(existing, desired))
+
+	// Verify Removed Scheduling Controls Are Pruned
+	assert.True(t, ConvergeScheduling(existing, &corev1.PodSpec{}))
+	assert.Nil(t, existing.NodeSelector)
+	assert.Nil(t, existing.Tolerations)
+	assert.Nil(t, existing.Affinity)
+
+	// Verify Nil PodSpecs Are Handled
+	assert.False(t, ConvergeScheduling(nil, desired))
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// Apply The Configured Additional Volumes & VolumeMounts (Mounted Into The First Container) To The Specified (New) PodSpec
+func AddVolumes(podSpec *corev1.PodSpec, volumes []corev1.Volume, volumeMounts []corev1.VolumeMount) {
+	if podSpec == nil {
+		return
+	}
+	podSpec.Volumes = volumes
+	if len(podSpec.Containers) > 0 {
+		podSpec.Containers[0].VolumeMounts = volumeMounts
+	}
+}
+
+// Converge The Volumes & First Container's VolumeMounts Of An Existing PodSpec With Those Of The Desired PodSpec (Returning Whether Any Changed)
+func ConvergeVolumes(existing *corev1.PodSpec, desired *corev1.PodSpec) bool {
+	if existing == nil || desired == nil {
+		return false
+	}
+	changed := false
+	if !equality.Semantic.DeepEqual(existing.Volumes, desired.Volumes) {
+		existing.Volumes = desired.Volumes
+		changed = true
+	}
+	if len(existing.Containers) > 0 && len(desired.Containers) > 0 && !equality.Semantic.DeepEqual(existing.Containers[0].VolumeMounts, desired.Containers[0].VolumeMounts) {
+		existing.Containers[0].VolumeMounts = desired.Containers[0].VolumeMounts
+		changed = true
+	}
+	return changed
+}
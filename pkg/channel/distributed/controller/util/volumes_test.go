@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Test The AddVolumes() & ConvergeVolumes() Functionality
+func TestVolumes(t *testing.T) {
+
+	// Test Data
+	volumes := []corev1.Volume{{Name: "kafka-certs", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "kafka-certs"}}}}
+	volumeMounts := []corev1.VolumeMount{{Name: "kafka-certs", MountPath: "/etc/kafka/certs", ReadOnly: true}}
+
+	// Verify The Volumes & VolumeMounts Are Applied To A New PodSpec
+	desired := &corev1.PodSpec{Containers: []corev1.Container{{Name: "dispatcher"}}}
+	AddVolumes(desired, volumes, volumeMounts)
+	assert.Equal(t, volumes, desired.Volumes)
+	assert.Equal(t, volumeMounts, desired.Containers[0].VolumeMounts)
+	AddVolumes(nil, volumes, volumeMounts)
+
+	// Verify Added Volumes Are Converged Into An Existing PodSpec & Subsequently Unchanged
+	existing := &corev1.PodSpec{Containers: []corev1.Container{{Name: "dispatcher"}}}
+	assert.True(t, ConvergeVolumes(existing, desired))
+	assert.Equal(t, volumes, existing.Volumes)
+	assert.Equal(t, volumeMounts, existing.Containers[0].VolumeMounts)
+	assert.False(t, ConvergeVolumes(existing, desired))
+
+	// Verify Updated VolumeMounts Are Converged
+	updated := &corev1.PodSpec{Containers: []corev1.Container{{Name: "dispatcher"}}}
+	AddVolumes(updated, volumes, []corev1.VolumeMount{{Name: "kafka-certs", MountPath: "/etc/kafka/tls", ReadOnly: true}})
+	assert.True(t, ConvergeVolumes(existing, updated))
+	assert.Equal(t, "/etc/kafka/tls", existing.Containers[0].VolumeMounts[0].MountPath)
+
+	// Verify Removed Volumes & VolumeMounts Are Pruned
+	assert.True(t, ConvergeVolumes(existing, &corev1.PodSpec{Containers: []corev1.Container{{Name: "dispatcher"}}}))
+	assert.Nil(t, existing.Volumes)
+	assert.Nil(t, existing.Containers[0].VolumeMounts)
+
+	// Verify Nil PodSpecs Are Handled
+	assert.False(t, ConvergeVolumes(nil, desired))
+}
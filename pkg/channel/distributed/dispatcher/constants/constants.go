@@ -19,4 +19,16 @@ package constants
 // Global Constants
 const (
 	Component = "eventing-kafka-channel-dispatcher"
+
+	// Default Interval Between Kafka Connectivity Readiness Checks
+	DefaultKafkaReadinessIntervalSeconds = "5"
+
+	// Default Time Allowed For In-Flight Deliveries To Complete When Shutting Down
+	DefaultDrainTimeoutSeconds = "30"
+
+	// Default Interval Between Consumer Lag Metric Updates
+	DefaultConsumerLagIntervalSeconds = "30"
+
+	// Suffix Of The Dispatcher Service Name Forming The Name Of The ConfigMap Used By The "configmap" Offset Store
+	OffsetConfigMapNameSuffix = "-offsets"
 )
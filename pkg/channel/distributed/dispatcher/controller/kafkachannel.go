@@ -31,6 +31,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/dispatcher"
 	"knative.dev/eventing-kafka/pkg/client/clientset/versioned"
 	"knative.dev/eventing-kafka/pkg/client/clientset/versioned/scheme"
@@ -179,6 +180,19 @@ func (r Reconciler) reconcile(channel *kafkav1beta1.KafkaChannel) error {
 		subscribers = make([]eventingduck.SubscriberSpec, 0)
 	}
 
+	// Update The Channel-Level Dead Letter Sink (Resolved By The Controller) For Subscribers Without Their Own
+	r.dispatcher.UpdateDeadLetterSink(channel.Status.DeadLetterSinkURI)
+
+	// Update The Channel-Level DeliverySpec (Retry Settings) For Subscribers Without Their Own
+	r.dispatcher.UpdateChannelDelivery(channel.Spec.Delivery)
+
+	// Update The Per-Subscription Shadow Destinations (Resolved By The Controller - Invalid Status Disables Shadowing)
+	subscriberShadows, err := consumer.ResolvedSubscriberShadows(channel.Status.Annotations)
+	if err != nil {
+		r.logger.Warn("Invalid Resolved Subscriber Shadows - Disabling Shadow Delivery", zap.Error(err))
+	}
+	r.dispatcher.UpdateSubscriberShadows(subscriberShadows)
+
 	// Update The ConsumerGroups To Align With Current KafkaChannel Subscribers
 	failedSubscriptions := r.dispatcher.UpdateSubscriptions(subscribers)
 
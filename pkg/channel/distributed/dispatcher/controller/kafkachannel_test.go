@@ -17,9 +17,11 @@ limitations under the License.
 package controller
 
 import (
+	"crypto/x509"
 	"testing"
 	"time"
 
+	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -28,12 +30,14 @@ import (
 	clientgotesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
 	"knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/dispatcher"
 	reconciletesting "knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/testing"
 	"knative.dev/eventing-kafka/pkg/client/clientset/versioned"
 	fakeclientset "knative.dev/eventing-kafka/pkg/client/clientset/versioned/fake"
 	"knative.dev/eventing-kafka/pkg/client/informers/externalversions"
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/pkg/apis"
 	"knative.dev/pkg/controller"
 	kncontroller "knative.dev/pkg/controller"
 	logtesting "knative.dev/pkg/logging/testing"
@@ -197,6 +201,34 @@ func (m MockDispatcher) UpdateSubscriptions(_ []eventingduck.SubscriberSpec) map
 	return nil
 }
 
+func (m MockDispatcher) UpdateDeadLetterSink(_ *apis.URL) {
+}
+
+func (m MockDispatcher) UpdateChannelDelivery(_ *eventingduck.DeliverySpec) {
+}
+
+func (m MockDispatcher) UpdateSubscriberShadows(_ map[string]consumer.ResolvedSubscriberShadow) {
+}
+
+func (m MockDispatcher) ReportConsumerLag() {
+}
+
 func (m MockDispatcher) ConfigChanged(*corev1.ConfigMap) dispatcher.Dispatcher {
 	return nil
 }
+
+func (m MockDispatcher) RootCAsChanged(*x509.CertPool) dispatcher.Dispatcher {
+	return nil
+}
+
+func (m MockDispatcher) BrokersChanged([]string) dispatcher.Dispatcher {
+	return nil
+}
+
+func (m MockDispatcher) KafkaReady() bool {
+	return true
+}
+
+func (m MockDispatcher) EffectiveSaramaConfig() *sarama.Config {
+	return nil
+}
@@ -18,18 +18,32 @@ package dispatcher
 
 import (
 	"context"
-	"fmt"
+	"crypto/x509"
+	"net/url"
+	"reflect"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/Shopify/sarama"
+	gometrics "github.com/rcrowley/go-metrics"
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	commonkafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
+	kafkaproducer "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/producer"
 	kafkasarama "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/metrics"
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/channel"
+	"knative.dev/pkg/apis"
 )
 
 // Define A Dispatcher Config Struct To Hold Configuration
@@ -44,6 +58,59 @@ type DispatcherConfig struct {
 	StatsReporter   metrics.StatsReporter
 	SaramaConfig    *sarama.Config
 	SubscriberSpecs []eventingduck.SubscriberSpec
+
+	// Kafka Secret Override Of Whether TLS Is Enabled ("true" / "false" - Empty Uses The ConfigMap)
+	TLSEnable string
+
+	// Per-Channel Consumer Config Overrides (From KafkaChannel Annotations)
+	ConsumerConfigOverrides map[string]string
+
+	// Per-Subscription Worker Concurrency Keyed By Subscription UID (From KafkaChannel Annotations)
+	SubscriberConcurrency map[string]int
+
+	// Per-Subscription Ordering Mode ("ordered" / "unordered") Keyed By Subscription UID (From KafkaChannel Annotations)
+	SubscriberOrdering map[string]string
+
+	// Per-Subscription Dead Letter Kafka Topics (Used Instead Of Any HTTP Dead Letter Sink) Keyed By Subscription UID (From KafkaChannel Annotations)
+	SubscriberDeadLetterTopics map[string]string
+
+	// Per-Subscription Initial Offset Policy ("earliest" / "latest") For ConsumerGroups Without Committed Offsets Keyed By Subscription UID (From KafkaChannel Annotations)
+	SubscriberInitialOffsets map[string]string
+
+	// Per-Subscription Attribute Filters Keyed By Subscription UID (From KafkaChannel Annotations)
+	SubscriberFilters map[string]eventingv1.TriggerFilter
+
+	// The CloudEvent Extension Allow / Deny Lists Applied Before Delivery (Nil Propagates All - From KafkaChannel Annotations & Config)
+	ExtensionFilter *commonkafkautil.ExtensionFilter
+
+	// The Timestamp From Which ConsumerGroups Replay (Zero For None - From KafkaChannel Annotations)
+	ReplayFromTimestamp time.Time
+
+	// How Long In-Flight Deliveries May Continue When Closing ConsumerGroups
+	DrainTimeout time.Duration
+
+	// The KafkaChannel's Resolved Dead Letter Sink (Used For Subscribers Without Their Own)
+	DeadLetterSinkURI *apis.URL
+
+	// The KafkaChannel's DeliverySpec (Retry Settings Used For Subscribers Without Their Own)
+	ChannelDelivery *eventingduck.DeliverySpec
+
+	// Per-Subscription Resolved Shadow Destinations Keyed By Subscription UID (From The KafkaChannel Status)
+	SubscriberShadows map[string]consumer.ResolvedSubscriberShadow
+
+	// Whether To Add The Kafka Record's Timestamp, Partition & Offset As CloudEvent Extensions (From The ConfigMap)
+	KafkaExtensions bool
+
+	// The Offset Commit Strategy, Interval & Store (From The ConfigMap - Must Also Be Applied To The SaramaConfig)
+	OffsetCommit commonconfig.EKOffsetCommitConfig
+
+	// The Kubernetes Client & The Namespace / Name Of The ConfigMap Persisting Offsets (Only Used By The "configmap" Offset Store)
+	ConfigMapsClient         corev1client.ConfigMapsGetter
+	OffsetConfigMapNamespace string
+	OffsetConfigMapName      string
+
+	// The Dispatcher & Per-Subscription Max In-Flight Delivery Limits (From The ConfigMap - Zero Is Unlimited)
+	MaxInFlight commonconfig.EKMaxInFlightConfig
 }
 
 // Knative Eventing SubscriberSpec Wrapper Enhanced With Sarama ConsumerGroup
@@ -52,26 +119,52 @@ type SubscriberWrapper struct {
 	GroupId       string
 	ConsumerGroup sarama.ConsumerGroup
 	StopChan      chan struct{}
+	DoneChan      chan struct{} // Closed when message consumption has stopped (nil if never started)
+	Handler       *Handler
+	ctx           context.Context
+	cancel        context.CancelFunc
 }
 
 // SubscriberWrapper Constructor
 func NewSubscriberWrapper(subscriberSpec eventingduck.SubscriberSpec, groupId string, consumerGroup sarama.ConsumerGroup) *SubscriberWrapper {
-	return &SubscriberWrapper{subscriberSpec, groupId, consumerGroup, make(chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &SubscriberWrapper{
+		SubscriberSpec: subscriberSpec,
+		GroupId:        groupId,
+		ConsumerGroup:  consumerGroup,
+		StopChan:       make(chan struct{}),
+		ctx:            ctx,
+		cancel:         cancel,
+	}
 }
 
 //  Dispatcher Interface
 type Dispatcher interface {
 	ConfigChanged(*v1.ConfigMap) Dispatcher
+	RootCAsChanged(*x509.CertPool) Dispatcher
+	BrokersChanged([]string) Dispatcher
+	KafkaReady() bool
+	EffectiveSaramaConfig() *sarama.Config
 	Shutdown()
 	UpdateSubscriptions(subscriberSpecs []eventingduck.SubscriberSpec) map[eventingduck.SubscriberSpec]error
+	UpdateDeadLetterSink(deadLetterSinkURI *apis.URL)
+	UpdateChannelDelivery(channelDelivery *eventingduck.DeliverySpec)
+	UpdateSubscriberShadows(subscriberShadows map[string]consumer.ResolvedSubscriberShadow)
+	ReportConsumerLag()
 }
 
 // Define A DispatcherImpl Struct With Configuration & ConsumerGroup State
 type DispatcherImpl struct {
 	DispatcherConfig
-	subscribers        map[types.UID]*SubscriberWrapper
-	consumerUpdateLock sync.Mutex
-	messageDispatcher  channel.MessageDispatcher
+	subscribers            map[types.UID]*SubscriberWrapper
+	consumerUpdateLock     sync.Mutex
+	channelDeliveryLock    sync.RWMutex // Guards The DeadLetterSinkURI, ChannelDelivery & SubscriberShadows
+	messageDispatcher      channel.MessageDispatcher
+	kafkaExtensions        int32               // Atomically set while the Kafka record CloudEvent extensions are enabled
+	deadLetterProducer     sarama.SyncProducer // Lazily created for producing to the Subscribers' dead letter topics
+	deadLetterProducerLock sync.Mutex
+	inFlightSemaphore      inFlightSemaphore // Limits the in-flight deliveries of all Subscriptions (nil is unlimited)
+	inFlightDeliveries     int64             // Atomically updated count of the current in-flight deliveries
 }
 
 // Verify The DispatcherImpl Implements The Dispatcher Interface
@@ -85,19 +178,40 @@ func NewDispatcher(dispatcherConfig DispatcherConfig) Dispatcher {
 		DispatcherConfig:  dispatcherConfig,
 		subscribers:       make(map[types.UID]*SubscriberWrapper),
 		messageDispatcher: channel.NewMessageDispatcher(dispatcherConfig.Logger),
+		inFlightSemaphore: newInFlightSemaphore(dispatcherConfig.MaxInFlight.Dispatcher),
 	}
+	dispatcher.updateKafkaExtensions(dispatcherConfig.KafkaExtensions)
 
 	// Return The DispatcherImpl
 	return dispatcher
 }
 
+//
 // Shutdown The Dispatcher
+//
+// The ConsumerGroups of all subscriptions are drained in parallel, so that none of them continue fetching new
+// messages while another is finishing its in-flight deliveries, before they are closed (leaving the group).
+//
 func (d *DispatcherImpl) Shutdown() {
 
+	// Stop All The ConsumerGroups' Sessions & Wait For Them To Drain
+	var waitGroup sync.WaitGroup
+	for _, subscriber := range d.subscribers {
+		waitGroup.Add(1)
+		go func(subscriber *SubscriberWrapper) {
+			defer waitGroup.Done()
+			d.drainConsumerGroup(subscriber)
+		}(subscriber)
+	}
+	waitGroup.Wait()
+
 	// Close ConsumerGroups Of All Subscriptions
 	for _, subscriber := range d.subscribers {
 		d.closeConsumerGroup(subscriber)
 	}
+
+	// Close The Dead Letter Topic Producer (If Created) Now That No Deliveries Remain In-Flight
+	d.closeDeadLetterProducer()
 }
 
 // Update The Dispatcher's Subscriptions To Align With New State
@@ -123,13 +237,14 @@ func (d *DispatcherImpl) UpdateSubscriptions(subscriberSpecs []eventingduck.Subs
 		if _, ok := d.subscribers[subscriberSpec.UID]; !ok {
 
 			// Format The GroupId For The Specified Subscriber
-			groupId := fmt.Sprintf("kafka.%s", subscriberSpec.UID)
+			groupId := d.consumerGroupId(subscriberSpec.UID)
 
 			// Create A ConsumerGroup Logger
 			logger := d.Logger.With(zap.String("GroupId", groupId))
 
-			// Attempt To Create A Kafka ConsumerGroup
-			consumerGroup, _, err := consumer.CreateConsumerGroup(d.Brokers, d.SaramaConfig, groupId)
+			// Attempt To Create A Kafka ConsumerGroup (Starting From The Subscription's Initial Offset Policy, If Any, When No Offsets Are Committed)
+			saramaConfig := consumer.InitialOffsetConfig(d.SaramaConfig, d.SubscriberInitialOffsets[string(subscriberSpec.UID)])
+			consumerGroup, _, err := consumer.CreateConsumerGroup(d.Brokers, saramaConfig, groupId)
 			if err != nil {
 
 				// Log & Return Failure
@@ -175,6 +290,134 @@ func (d *DispatcherImpl) UpdateSubscriptions(subscriberSpecs []eventingduck.Subs
 	return failedSubscriptions
 }
 
+// Update The KafkaChannel's Dead Letter Sink (Takes Effect Immediately For All Subscribers Without Their Own)
+func (d *DispatcherImpl) UpdateDeadLetterSink(deadLetterSinkURI *apis.URL) {
+	d.channelDeliveryLock.Lock()
+	defer d.channelDeliveryLock.Unlock()
+	if d.DeadLetterSinkURI.String() != deadLetterSinkURI.String() {
+		d.Logger.Info("Updating KafkaChannel Dead Letter Sink", zap.String("URI", deadLetterSinkURI.String()))
+		d.DeadLetterSinkURI = deadLetterSinkURI
+	}
+}
+
+// Get The KafkaChannel's Dead Letter Sink URL (Nil If None)
+func (d *DispatcherImpl) channelDeadLetterURL() *url.URL {
+	d.channelDeliveryLock.RLock()
+	defer d.channelDeliveryLock.RUnlock()
+	if d.DeadLetterSinkURI.IsEmpty() {
+		return nil
+	}
+	return d.DeadLetterSinkURI.URL()
+}
+
+// Update The KafkaChannel's DeliverySpec (Retry Settings Take Effect With The Next Message For All Subscribers Without Their Own)
+func (d *DispatcherImpl) UpdateChannelDelivery(channelDelivery *eventingduck.DeliverySpec) {
+	d.channelDeliveryLock.Lock()
+	defer d.channelDeliveryLock.Unlock()
+	if !equality.Semantic.DeepEqual(d.ChannelDelivery, channelDelivery) {
+		d.Logger.Info("Updating KafkaChannel DeliverySpec", zap.Any("Delivery", channelDelivery))
+		d.ChannelDelivery = channelDelivery.DeepCopy()
+	}
+}
+
+// Get The KafkaChannel's DeliverySpec (Nil If None)
+func (d *DispatcherImpl) channelDelivery() *eventingduck.DeliverySpec {
+	d.channelDeliveryLock.RLock()
+	defer d.channelDeliveryLock.RUnlock()
+	return d.ChannelDelivery
+}
+
+// Get The Effective Sarama Config (Defaults, ConfigMap, Kafka Secret & KafkaChannel Overrides) Used By The ConsumerGroups
+func (d *DispatcherImpl) EffectiveSaramaConfig() *sarama.Config {
+	return d.SaramaConfig
+}
+
+// Update The Per-Subscription Shadow Destinations (Takes Effect With The Next Message For All Subscribers)
+func (d *DispatcherImpl) UpdateSubscriberShadows(subscriberShadows map[string]consumer.ResolvedSubscriberShadow) {
+	d.channelDeliveryLock.Lock()
+	defer d.channelDeliveryLock.Unlock()
+	if len(d.SubscriberShadows) != len(subscriberShadows) || (len(subscriberShadows) > 0 && !reflect.DeepEqual(d.SubscriberShadows, subscriberShadows)) {
+		d.Logger.Info("Updating Subscriber Shadows", zap.Any("Shadows", subscriberShadows))
+		d.SubscriberShadows = subscriberShadows
+	}
+}
+
+// Get A Function Returning The Specified Subscription's Current Shadow Destination (Nil If None)
+func (d *DispatcherImpl) subscriberShadow(uid types.UID) func() *consumer.ResolvedSubscriberShadow {
+	return func() *consumer.ResolvedSubscriberShadow {
+		d.channelDeliveryLock.RLock()
+		defer d.channelDeliveryLock.RUnlock()
+		if shadow, ok := d.SubscriberShadows[string(uid)]; ok {
+			return &shadow
+		}
+		return nil
+	}
+}
+
+// Enable / Disable The Kafka Record CloudEvent Extensions (Takes Effect With The Next Message For All Subscribers)
+func (d *DispatcherImpl) updateKafkaExtensions(enabled bool) {
+	d.DispatcherConfig.KafkaExtensions = enabled
+	if enabled {
+		atomic.StoreInt32(&d.kafkaExtensions, 1)
+	} else {
+		atomic.StoreInt32(&d.kafkaExtensions, 0)
+	}
+}
+
+// Determine Whether The Kafka Record CloudEvent Extensions Are Enabled
+func (d *DispatcherImpl) kafkaExtensionsEnabled() bool {
+	return atomic.LoadInt32(&d.kafkaExtensions) == 1
+}
+
+//
+// Get The SyncProducer For Producing To The Subscribers' Dead Letter Topics
+//
+// The SyncProducer is only created (using the Dispatcher's Kafka brokers & authentication) the first time an
+// event is sent to a dead letter topic, so that Dispatchers without any dead letter topics never connect a
+// producer to Kafka.  A failed creation is returned as an error and retried with the next dead letter event.
+//
+func (d *DispatcherImpl) deadLetterSyncProducer() (sarama.SyncProducer, error) {
+	d.deadLetterProducerLock.Lock()
+	defer d.deadLetterProducerLock.Unlock()
+	if d.deadLetterProducer == nil {
+
+		// Copy The Current Sarama Config (With A Fresh Metrics Registry) & Enable The Results Required By A SyncProducer
+		producerConfig := *d.SaramaConfig
+		producerConfig.ClientID = d.clientId(commonkafkaconstants.ClientIdRoleProducer)
+		producerConfig.MetricRegistry = gometrics.NewRegistry()
+		producerConfig.Producer.Return.Successes = true
+		producerConfig.Producer.Return.Errors = true
+
+		// Create The Dead Letter SyncProducer
+		deadLetterProducer, _, err := createSyncProducerWrapper(d.Brokers, &producerConfig)
+		if err != nil {
+			d.Logger.Error("Failed To Create Dead Letter Topic SyncProducer", zap.Error(err))
+			return nil, err
+		}
+		d.Logger.Info("Successfully Created Dead Letter Topic SyncProducer")
+		d.deadLetterProducer = deadLetterProducer
+	}
+	return d.deadLetterProducer, nil
+}
+
+// Close The Dead Letter Topic SyncProducer (If Created)
+func (d *DispatcherImpl) closeDeadLetterProducer() {
+	d.deadLetterProducerLock.Lock()
+	defer d.deadLetterProducerLock.Unlock()
+	if d.deadLetterProducer != nil {
+		err := d.deadLetterProducer.Close()
+		if err != nil {
+			d.Logger.Error("Failed To Close Dead Letter Topic SyncProducer", zap.Error(err))
+		}
+		d.deadLetterProducer = nil
+	}
+}
+
+// Wrapper Around Common Kafka SyncProducer Creation To Facilitate Unit Testing
+var createSyncProducerWrapper = func(brokers []string, config *sarama.Config) (sarama.SyncProducer, gometrics.Registry, error) {
+	return kafkaproducer.CreateSyncProducer(brokers, config)
+}
+
 // Start Consuming Messages With The Specified Subscriber's ConsumerGroup
 func (d *DispatcherImpl) startConsuming(subscriber *SubscriberWrapper) {
 
@@ -193,14 +436,40 @@ func (d *DispatcherImpl) startConsuming(subscriber *SubscriberWrapper) {
 			logger.Info("ConsumerGroup Error Processing Terminated")
 		}()
 
-		// Create A New ConsumerGroupHandler To Consume Messages With
-		handler := NewHandler(logger, &subscriber.SubscriberSpec)
+		// Create A New ConsumerGroupHandler To Consume Messages With (Tracked For Readiness Checks)
+		handler := NewHandler(logger, &subscriber.SubscriberSpec, d.DrainTimeout, d.channelDeadLetterURL, d.channelDelivery)
+		handler.Concurrency = d.SubscriberConcurrency[string(subscriber.UID)]
+		handler.Ordering = d.SubscriberOrdering[string(subscriber.UID)]
+		if deadLetterTopic, ok := d.SubscriberDeadLetterTopics[string(subscriber.UID)]; ok {
+			handler.DeadLetterTopic = deadLetterTopic
+			handler.DeadLetterProducer = d.deadLetterSyncProducer
+		}
+		handler.KafkaExtensions = d.kafkaExtensionsEnabled
+		handler.ExtensionFilter = d.ExtensionFilter
+		handler.Shadow = d.subscriberShadow(subscriber.UID)
+		handler.InFlight = d.newInFlightLimiter()
+		handler.ManualCommit = d.OffsetCommit.Strategy == commonconfig.OffsetCommitStrategyManualAfterAck
+		handler.CommitInterval = time.Duration(d.OffsetCommit.IntervalMillis) * time.Millisecond
+		handler.OffsetStore = d.newOffsetStore(logger, subscriber.GroupId, handler.ManualCommit, handler.CommitInterval)
+		if filter, ok := d.SubscriberFilters[string(subscriber.UID)]; ok {
+			handler.Filter = &filter
+		}
+		if !d.ReplayFromTimestamp.IsZero() {
+			groupId := subscriber.GroupId
+			handler.OffsetMetadata = consumer.ReplayOffsetMetadata(d.ReplayFromTimestamp)
+			handler.Replay = func(session sarama.ConsumerGroupSession) error {
+				return d.replayOffsets(logger, session, groupId)
+			}
+		}
+		subscriber.Handler = handler
 
 		// Consume Messages Asynchronously
+		subscriber.DoneChan = make(chan struct{})
 		go func() {
+			defer close(subscriber.DoneChan)
 
 			// Infinite Loop To Support Server-Side ConsumerGroup Re-Balance Which Ends Consume() Execution
-			ctx := context.Background()
+			ctx := subscriber.ctx
 			for {
 				select {
 
@@ -219,14 +488,61 @@ func (d *DispatcherImpl) startConsuming(subscriber *SubscriberWrapper) {
 							break
 						} else {
 							logger.Error("ConsumerGroup Failed To Consume Messages", zap.Error(err))
+							handler.recordFailure()
 						}
 					}
+
+					// Back Off Before Re-Joining After Failed Sessions (Interrupted By Stopping The Subscriber)
+					if backoff := handler.rejoinBackoff(); backoff > 0 {
+						logger.Info("Backing Off Before Re-Joining ConsumerGroup", zap.Duration("Backoff", backoff))
+						timer := time.NewTimer(backoff)
+						select {
+						case <-subscriber.StopChan:
+						case <-timer.C:
+						}
+						timer.Stop()
+					}
 				}
 			}
 		}()
 	}
 }
 
+//
+// Drain The ConsumerGroup Associated With A Single Subscriber
+//
+// Ending the ConsumerGroup's session (rather than simply closing it) stops the fetching of new messages, allows
+// in-flight deliveries to complete (within the DrainTimeout) and commits their offsets before the ConsumerGroup
+// is closed and leaves the group.  Waiting is bounded in case the consumption does not stop in a timely manner.
+//
+func (d *DispatcherImpl) drainConsumerGroup(subscriber *SubscriberWrapper) {
+
+	// Mark The Subscriber's ConsumerGroup As Stopped (Only Once) & End The Current Session
+	select {
+	case <-subscriber.StopChan:
+	default:
+		close(subscriber.StopChan)
+	}
+	if subscriber.cancel != nil {
+		subscriber.cancel()
+	}
+
+	// Wait For The Message Consumption To Stop (If It Was Started)
+	if subscriber.DoneChan != nil {
+		timer := time.NewTimer(d.DrainTimeout + drainCommitTimeout)
+		defer timer.Stop()
+		select {
+		case <-subscriber.DoneChan:
+			d.Logger.Info("Successfully Drained ConsumerGroup", zap.String("GroupId", subscriber.GroupId))
+		case <-timer.C:
+			d.Logger.Warn("Timed Out Draining ConsumerGroup", zap.String("GroupId", subscriber.GroupId), zap.Duration("DrainTimeout", d.DrainTimeout))
+		}
+	}
+}
+
+// Additional Time Allowed Beyond The DrainTimeout For Committing Offsets While Draining A ConsumerGroup
+const drainCommitTimeout = 5 * time.Second
+
 // Close The ConsumerGroup Associated With A Single Subscriber
 func (d *DispatcherImpl) closeConsumerGroup(subscriber *SubscriberWrapper) {
 
@@ -239,8 +555,8 @@ func (d *DispatcherImpl) closeConsumerGroup(subscriber *SubscriberWrapper) {
 	// If The ConsumerGroup Is Valid
 	if consumerGroup != nil {
 
-		// Mark The Subscriber's ConsumerGroup As Stopped
-		close(subscriber.StopChan)
+		// Drain The Subscriber's ConsumerGroup (Stops Consumption & Commits Offsets Of In-Flight Deliveries)
+		d.drainConsumerGroup(subscriber)
 
 		// Close The ConsumerGroup
 		err := consumerGroup.Close()
@@ -280,20 +596,54 @@ func (d *DispatcherImpl) ConfigChanged(configMap *v1.ConfigMap) Dispatcher {
 
 	// Validate Configuration (Should Always Be Present)
 	if d.SaramaConfig != nil {
+		maxInFlightChanged := false
+		offsetStoreChanged := false
 
 		// Some of the current config settings may not be overridden by the configmap (username, password, etc.)
 		kafkasarama.UpdateSaramaConfig(newConfig, d.SaramaConfig.ClientID, d.SaramaConfig.Net.SASL.User, d.SaramaConfig.Net.SASL.Password)
+		err = kafkasarama.UpdateSaramaConfigSaslMechanism(newConfig, string(d.SaramaConfig.Net.SASL.Mechanism))
+		if err != nil {
+			d.Logger.Error("Unable to carry forward SASL mechanism", zap.Error(err))
+			return nil
+		}
+		kafkasarama.UpdateSaramaConfigTLSCertificates(newConfig, kafkasarama.TLSCertificates(d.SaramaConfig))
+		err = kafkasarama.UpdateSaramaConfigTLSEnable(newConfig, d.TLSEnable)
+		if err != nil {
+			d.Logger.Error("Unable to carry forward TLS enable override", zap.Error(err))
+			return nil
+		}
+
+		// The Kafka rack ID is determined at startup (env var or node zone) and is not part of the ConfigMap
+		kafkasarama.UpdateSaramaConfigRackId(newConfig, d.SaramaConfig.RackID)
+
+		// The per-channel consumer config overrides take precedence over the ConfigMap (but are ignored if invalid against it)
+		err = kafkasarama.UpdateSaramaConfigConsumerOverrides(newConfig, d.ConsumerConfigOverrides)
+		if err != nil {
+			d.Logger.Error("Invalid consumer config overrides - ignoring", zap.Any("Overrides", d.ConsumerConfigOverrides), zap.Error(err))
+		}
 
 		// Enable Sarama Logging If Specified In ConfigMap
 		if ekConfig, err := kafkasarama.LoadEventingKafkaSettings(configMap); err == nil && ekConfig != nil {
 			kafkasarama.EnableSaramaLogging(ekConfig.Kafka.EnableSaramaLogging)
 			d.Logger.Debug("Updated Sarama logging", zap.Bool("Kafka.EnableSaramaLogging", ekConfig.Kafka.EnableSaramaLogging))
+			d.updateKafkaExtensions(ekConfig.Dispatcher.EnableKafkaExtensions)
+			d.Logger.Debug("Updated Kafka extensions", zap.Bool("Dispatcher.EnableKafkaExtensions", ekConfig.Dispatcher.EnableKafkaExtensions))
+
+			// The offset commit strategy is applied to the Sarama config, so that changes recreate the Dispatcher below (as do offset store changes)
+			offsetStoreChanged = ekConfig.Dispatcher.OffsetCommit.Store != d.OffsetCommit.Store
+			d.DispatcherConfig.OffsetCommit = ekConfig.Dispatcher.OffsetCommit
+			kafkasarama.UpdateSaramaConfigOffsetCommit(newConfig, ekConfig.Dispatcher.OffsetCommit)
+
+			// The in-flight delivery semaphores are created with the Handlers, so changes also recreate the Dispatcher below
+			maxInFlightChanged = ekConfig.Dispatcher.MaxInFlight != d.MaxInFlight
+			d.DispatcherConfig.MaxInFlight = ekConfig.Dispatcher.MaxInFlight
 		} else {
 			d.Logger.Error("Could Not Extract Eventing-Kafka Setting From Updated ConfigMap", zap.Error(err))
+			kafkasarama.UpdateSaramaConfigOffsetCommit(newConfig, d.OffsetCommit)
 		}
 
 		// Ignore the "Producer" section as changes to that do not require recreating the Dispatcher
-		if kafkasarama.ConfigEqual(newConfig, d.SaramaConfig, newConfig.Producer) {
+		if !maxInFlightChanged && !offsetStoreChanged && kafkasarama.ConfigEqual(newConfig, d.SaramaConfig, newConfig.Producer) {
 			d.Logger.Info("No Consumer Changes Detected In New Configuration - Ignoring")
 			return nil
 		}
@@ -311,3 +661,76 @@ func (d *DispatcherImpl) ConfigChanged(configMap *v1.ConfigMap) Dispatcher {
 	}
 	return newDispatcher
 }
+
+// RootCAsChanged is called by the rootCAConfigMapObserver handler function in main() so that the Dispatcher
+// may be recreated with the rotated Root CAs.  This is necessary because the ConfigChanged() comparison
+// is unable to detect changes to the (opaque) CertPool.  All other existing config is reused.
+func (d *DispatcherImpl) RootCAsChanged(certPool *x509.CertPool) Dispatcher {
+
+	// Nothing To Do Without An Existing Configuration & New Root CAs
+	if d.SaramaConfig == nil || certPool == nil {
+		return nil
+	}
+
+	// Copy The Current Sarama Config (With A Fresh Metrics Registry) & Update Its Root CAs
+	newConfig := *d.SaramaConfig
+	newConfig.MetricRegistry = gometrics.NewRegistry()
+	kafkasarama.UpdateSaramaConfigRootCAs(&newConfig, certPool)
+
+	// Create A New Dispatcher With The New Configuration (Reusing All Other Existing Config)
+	d.Logger.Info("Root CAs Changed - Recreating Dispatcher")
+	d.Shutdown()
+	d.DispatcherConfig.SaramaConfig = &newConfig
+	newDispatcher := NewDispatcher(d.DispatcherConfig)
+	failedSubscriptions := newDispatcher.UpdateSubscriptions(d.SubscriberSpecs)
+	if len(failedSubscriptions) > 0 {
+		d.Logger.Fatal("Failed To Subscribe Kafka Subscriptions For New Dispatcher", zap.Int("Count", len(failedSubscriptions)))
+		return nil
+	}
+	return newDispatcher
+}
+
+// BrokersChanged is called by the BrokerResolver handler function in main() so that the Dispatcher may be
+// recreated with the re-resolved Kafka brokers (from a DNS SRV record).  All other existing config is reused.
+func (d *DispatcherImpl) BrokersChanged(brokers []string) Dispatcher {
+
+	// Nothing To Do Without An Existing Configuration & New (Different) Brokers
+	if d.SaramaConfig == nil || len(brokers) <= 0 || reflect.DeepEqual(brokers, d.Brokers) {
+		return nil
+	}
+
+	// Copy The Current Sarama Config (With A Fresh Metrics Registry)
+	newConfig := *d.SaramaConfig
+	newConfig.MetricRegistry = gometrics.NewRegistry()
+
+	// Create A New Dispatcher With The New Brokers (Reusing All Other Existing Config)
+	d.Logger.Info("Kafka Brokers Changed - Recreating Dispatcher", zap.Strings("Previous", d.Brokers), zap.Strings("Current", brokers))
+	d.Shutdown()
+	d.DispatcherConfig.SaramaConfig = &newConfig
+	d.DispatcherConfig.Brokers = brokers
+	newDispatcher := NewDispatcher(d.DispatcherConfig)
+	failedSubscriptions := newDispatcher.UpdateSubscriptions(d.SubscriberSpecs)
+	if len(failedSubscriptions) > 0 {
+		d.Logger.Fatal("Failed To Subscribe Kafka Subscriptions For New Dispatcher", zap.Int("Count", len(failedSubscriptions)))
+		return nil
+	}
+	return newDispatcher
+}
+
+// Get The Kafka ConsumerGroup ID For The Specified Subscriber (Rendered Via The ConsumerGroup Name Template)
+func (d *DispatcherImpl) consumerGroupId(subscriberUid types.UID) string {
+	namespace, name, err := cache.SplitMetaNamespaceKey(d.ChannelKey)
+	if err != nil {
+		d.Logger.Warn("Failed To Parse ChannelKey - Formatting ConsumerGroup ID Without KafkaChannel", zap.String("ChannelKey", d.ChannelKey), zap.Error(err))
+	}
+	return commonkafkautil.ConsumerGroupId(namespace, name, string(subscriberUid))
+}
+
+// Get The Kafka Client ID For The Specified Role (Rendered Via The Client ID Template)
+func (d *DispatcherImpl) clientId(role string) string {
+	namespace, name, err := cache.SplitMetaNamespaceKey(d.ChannelKey)
+	if err != nil {
+		d.Logger.Warn("Failed To Parse ChannelKey - Formatting Client ID Without KafkaChannel", zap.String("ChannelKey", d.ChannelKey), zap.Error(err))
+	}
+	return commonkafkautil.ClientId(namespace, name, role)
+}
@@ -17,22 +17,29 @@ limitations under the License.
 package dispatcher
 
 import (
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/Shopify/sarama"
 	"github.com/ghodss/yaml"
+	gometrics "github.com/rcrowley/go-metrics"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	commonkafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
 	kafkaconsumer "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
 	kafkatesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/testing"
+	commonkafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	dispatchertesting "knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/testing"
 	"knative.dev/eventing-kafka/pkg/common/constants"
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/eventing/pkg/channel"
+	"knative.dev/pkg/apis"
 	logtesting "knative.dev/pkg/logging/testing"
 	"knative.dev/pkg/system"
 
@@ -104,6 +111,29 @@ Admin:
 	TestEventingKafka = `
 kafka:
   enableSaramaLogging: true`
+
+	TestEventingKafkaOffsetCommit = `
+dispatcher:
+  offsetCommit:
+    strategy: manual-after-ack
+    intervalMillis: 250`
+	TestEventingKafkaMaxInFlight = `
+dispatcher:
+  offsetCommit:
+    strategy: manual-after-ack
+    intervalMillis: 250
+  maxInFlight:
+    dispatcher: 10
+    subscription: 2`
+	TestEventingKafkaOffsetStore = `
+dispatcher:
+  offsetCommit:
+    strategy: manual-after-ack
+    intervalMillis: 250
+    store: configmap
+  maxInFlight:
+    dispatcher: 10
+    subscription: 2`
 )
 
 // Test The NewSubscriberWrapper() Functionality
@@ -129,13 +159,122 @@ func TestNewSubscriberWrapper(t *testing.T) {
 func TestNewDispatcher(t *testing.T) {
 
 	// Test Data
-	dispatcherConfig := DispatcherConfig{}
+	dispatcherConfig := DispatcherConfig{SaramaConfig: sarama.NewConfig()}
 
 	// Perform The Test
 	dispatcher := NewDispatcher(dispatcherConfig)
 
 	// Verify The Results
 	assert.NotNil(t, dispatcher)
+	assert.Equal(t, dispatcherConfig.SaramaConfig, dispatcher.EffectiveSaramaConfig())
+}
+
+// Test The Dispatcher's UpdateDeadLetterSink() Functionality
+func TestUpdateDeadLetterSink(t *testing.T) {
+
+	// Test Data
+	deadLetterSinkURI, err := apis.ParseURL("http://dead-letter-sink.test-namespace.svc.cluster.local/")
+	assert.Nil(t, err)
+	dispatcher := NewDispatcher(DispatcherConfig{Logger: logtesting.TestLogger(t).Desugar()}).(*DispatcherImpl)
+
+	// Verify There Is No Channel Dead Letter Sink Initially
+	assert.Nil(t, dispatcher.channelDeadLetterURL())
+
+	// Verify An Updated Channel Dead Letter Sink Is Used (And Retained For Recreating The Dispatcher)
+	dispatcher.UpdateDeadLetterSink(deadLetterSinkURI)
+	assert.Equal(t, deadLetterSinkURI.URL(), dispatcher.channelDeadLetterURL())
+	assert.Equal(t, deadLetterSinkURI, dispatcher.DispatcherConfig.DeadLetterSinkURI)
+
+	// Verify The Channel Dead Letter Sink Can Be Removed
+	dispatcher.UpdateDeadLetterSink(nil)
+	assert.Nil(t, dispatcher.channelDeadLetterURL())
+}
+
+// Test The Dispatcher's UpdateSubscriberShadows() Functionality
+func TestUpdateSubscriberShadows(t *testing.T) {
+
+	// Test Data
+	shadowURI, err := apis.ParseURL("http://shadow.test-namespace.svc.cluster.local/")
+	assert.Nil(t, err)
+	dispatcher := NewDispatcher(DispatcherConfig{Logger: logtesting.TestLogger(t).Desugar()}).(*DispatcherImpl)
+	subscriberShadow := dispatcher.subscriberShadow(types.UID("uid1"))
+	otherSubscriberShadow := dispatcher.subscriberShadow(types.UID("uid2"))
+
+	// Verify There Are No Subscriber Shadows Initially
+	assert.Nil(t, subscriberShadow())
+
+	// Verify Updated Subscriber Shadows Are Used By The Existing Subscribers (And Retained For Recreating The Dispatcher)
+	subscriberShadows := map[string]kafkaconsumer.ResolvedSubscriberShadow{"uid1": {URI: shadowURI, Percent: 25}}
+	dispatcher.UpdateSubscriberShadows(subscriberShadows)
+	assert.Equal(t, &kafkaconsumer.ResolvedSubscriberShadow{URI: shadowURI, Percent: 25}, subscriberShadow())
+	assert.Nil(t, otherSubscriberShadow())
+	assert.Equal(t, subscriberShadows, dispatcher.DispatcherConfig.SubscriberShadows)
+
+	// Verify The Subscriber Shadows Can Be Removed
+	dispatcher.UpdateSubscriberShadows(nil)
+	assert.Nil(t, subscriberShadow())
+}
+
+// Test The Dispatcher's UpdateChannelDelivery() Functionality
+func TestUpdateChannelDelivery(t *testing.T) {
+
+	// Test Data
+	retry := int32(3)
+	channelDelivery := &eventingduck.DeliverySpec{Retry: &retry}
+	dispatcher := NewDispatcher(DispatcherConfig{Logger: logtesting.TestLogger(t).Desugar()}).(*DispatcherImpl)
+
+	// Verify There Is No Channel DeliverySpec Initially
+	assert.Nil(t, dispatcher.channelDelivery())
+
+	// Verify An Updated Channel DeliverySpec Is Used (A Copy, Retained For Recreating The Dispatcher)
+	dispatcher.UpdateChannelDelivery(channelDelivery)
+	assert.Equal(t, channelDelivery, dispatcher.channelDelivery())
+	assert.False(t, channelDelivery == dispatcher.channelDelivery())
+	assert.Equal(t, channelDelivery, dispatcher.DispatcherConfig.ChannelDelivery)
+
+	// Verify The Channel DeliverySpec Can Be Removed
+	dispatcher.UpdateChannelDelivery(nil)
+	assert.Nil(t, dispatcher.channelDelivery())
+}
+
+// Test Enabling & Disabling The Kafka Record CloudEvent Extensions
+func TestUpdateKafkaExtensions(t *testing.T) {
+
+	// Verify The Initial State Comes From The DispatcherConfig
+	dispatcher := NewDispatcher(DispatcherConfig{Logger: logtesting.TestLogger(t).Desugar(), KafkaExtensions: true}).(*DispatcherImpl)
+	assert.True(t, dispatcher.kafkaExtensionsEnabled())
+
+	// Verify The Extensions Can Be Disabled (Retained For Recreating The Dispatcher)
+	dispatcher.updateKafkaExtensions(false)
+	assert.False(t, dispatcher.kafkaExtensionsEnabled())
+	assert.False(t, dispatcher.DispatcherConfig.KafkaExtensions)
+
+	// Verify The Extensions Can Be Re-Enabled
+	dispatcher.updateKafkaExtensions(true)
+	assert.True(t, dispatcher.kafkaExtensionsEnabled())
+	assert.True(t, dispatcher.DispatcherConfig.KafkaExtensions)
+}
+
+// Test The Dispatcher's consumerGroupId() Functionality
+func TestConsumerGroupId(t *testing.T) {
+
+	// Restore The Default ConsumerGroup Name Template When Done
+	defer func() { assert.Nil(t, commonkafkautil.SetConsumerGroupIdTemplate("")) }()
+
+	// Create A Dispatcher For A Specific KafkaChannel
+	dispatcher := &DispatcherImpl{
+		DispatcherConfig: DispatcherConfig{
+			Logger:     logtesting.TestLogger(t).Desugar(),
+			ChannelKey: "test-namespace/test-name",
+		},
+	}
+
+	// Verify The Default & Custom ConsumerGroup Name Templates (Consistent For The Same Subscriber)
+	assert.Equal(t, fmt.Sprintf("kafka.%s", id123), dispatcher.consumerGroupId(uid123))
+	assert.Nil(t, commonkafkautil.SetConsumerGroupIdTemplate("prod.{{.Namespace}}.{{.Name}}.{{.SubscriberUID}}"))
+	assert.Equal(t, fmt.Sprintf("prod.test-namespace.test-name.%s", id123), dispatcher.consumerGroupId(uid123))
+	assert.Equal(t, dispatcher.consumerGroupId(uid123), dispatcher.consumerGroupId(uid123))
+	assert.NotEqual(t, dispatcher.consumerGroupId(uid123), dispatcher.consumerGroupId(uid456))
 }
 
 // Test The Dispatcher's Shutdown() Functionality
@@ -176,6 +315,90 @@ func TestShutdown(t *testing.T) {
 	assert.Len(t, dispatcher.subscribers, 0)
 }
 
+// Test The Dispatcher's Shutdown() Drains Consuming ConsumerGroups Before Closing Them
+// Test The Lazily Created Dead Letter Topic SyncProducer Is Reused & Closed On Shutdown
+func TestDeadLetterSyncProducer(t *testing.T) {
+
+	// Mock The createSyncProducerWrapper Function (Failing The First Creation) & Restore Post-Test
+	mockSyncProducer := dispatchertesting.NewMockSyncProducer(nil)
+	createCount := 0
+	createSyncProducerWrapperPlaceholder := createSyncProducerWrapper
+	createSyncProducerWrapper = func(brokers []string, config *sarama.Config) (sarama.SyncProducer, gometrics.Registry, error) {
+		createCount++
+		assert.Equal(t, []string{"broker-0:9092"}, brokers)
+		assert.True(t, config.Producer.Return.Successes)
+		assert.True(t, config.Producer.Return.Errors)
+		assert.Equal(t, "knative-test-namespace-test-channel-producer", config.ClientID)
+		if createCount == 1 {
+			return nil, nil, errors.New("test producer error")
+		}
+		return mockSyncProducer, config.MetricRegistry, nil
+	}
+	defer func() { createSyncProducerWrapper = createSyncProducerWrapperPlaceholder }()
+
+	// Create The Dispatcher To Test
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.ClientID = "knative-test-namespace-test-channel-dispatcher"
+	dispatcher := &DispatcherImpl{
+		DispatcherConfig: DispatcherConfig{
+			Logger:       logtesting.TestLogger(t).Desugar(),
+			Brokers:      []string{"broker-0:9092"},
+			ChannelKey:   "test-namespace/test-channel",
+			SaramaConfig: saramaConfig,
+		},
+		subscribers: map[types.UID]*SubscriberWrapper{},
+	}
+
+	// Perform The Test (A Failed Creation Is Retried, After Which The SyncProducer Is Reused)
+	producer, err := dispatcher.deadLetterSyncProducer()
+	assert.NotNil(t, err)
+	assert.Nil(t, producer)
+	producer, err = dispatcher.deadLetterSyncProducer()
+	assert.Nil(t, err)
+	assert.Equal(t, mockSyncProducer, producer)
+	producer, err = dispatcher.deadLetterSyncProducer()
+	assert.Nil(t, err)
+	assert.Equal(t, mockSyncProducer, producer)
+	dispatcher.Shutdown()
+
+	// Verify The Results (The Dispatcher's Own Sarama Config Was Not Modified)
+	assert.Equal(t, 2, createCount)
+	assert.False(t, saramaConfig.Producer.Return.Successes)
+	assert.Equal(t, "knative-test-namespace-test-channel-dispatcher", saramaConfig.ClientID)
+	assert.True(t, mockSyncProducer.Closed())
+	assert.Nil(t, dispatcher.deadLetterProducer)
+}
+
+func TestShutdownDrain(t *testing.T) {
+
+	// Create A Mock ConsumerGroup & Subscriber Which Is Actively Consuming
+	consumerGroup := kafkatesting.NewMockConsumerGroup(t)
+	subscriberSpec := eventingduck.SubscriberSpec{UID: id123}
+	subscriber := NewSubscriberWrapper(subscriberSpec, fmt.Sprintf("kafka.%s", subscriberSpec.UID), consumerGroup)
+
+	// Create The Dispatcher To Test With The Consuming Subscriber
+	dispatcher := &DispatcherImpl{
+		DispatcherConfig: DispatcherConfig{
+			Logger:       logtesting.TestLogger(t).Desugar(),
+			DrainTimeout: time.Minute,
+		},
+		subscribers: map[types.UID]*SubscriberWrapper{subscriber.UID: subscriber},
+	}
+	dispatcher.startConsuming(subscriber)
+
+	// Perform The Test (Should Not Wait For The DrainTimeout Since Consumption Stops Once The Session Ends)
+	startTime := time.Now()
+	dispatcher.Shutdown()
+
+	// Verify The Results
+	assert.True(t, time.Since(startTime) < time.Minute)
+	assert.True(t, consumerGroup.Closed)
+	assert.NotNil(t, subscriber.ctx.Err())
+	_, open := <-subscriber.DoneChan
+	assert.False(t, open)
+	assert.Len(t, dispatcher.subscribers, 0)
+}
+
 func getSaramaConfigFromYaml(t *testing.T, saramaYaml string) *sarama.Config {
 	var config *sarama.Config
 	jsonSettings, err := yaml.YAMLToJSON([]byte(saramaYaml))
@@ -340,6 +563,43 @@ func TestUpdateSubscriptions(t *testing.T) {
 	}
 }
 
+// Test The Per-Subscription Initial Offset Policy Is Applied To The Sarama Config Of The Subscription's ConsumerGroup
+func TestUpdateSubscriptionsInitialOffset(t *testing.T) {
+
+	// Replace The NewConsumerGroupWrapper With Mock Capturing The Sarama Config Of Each GroupId & Restore After Test
+	groupConfigs := make(map[string]*sarama.Config)
+	newConsumerGroupWrapperPlaceholder := kafkaconsumer.NewConsumerGroupWrapper
+	kafkaconsumer.NewConsumerGroupWrapper = func(brokersArg []string, groupIdArg string, configArg *sarama.Config) (sarama.ConsumerGroup, error) {
+		groupConfigs[groupIdArg] = configArg
+		return kafkatesting.NewMockConsumerGroup(t), nil
+	}
+	defer func() {
+		kafkaconsumer.NewConsumerGroupWrapper = newConsumerGroupWrapperPlaceholder
+	}()
+
+	// Create A New DispatcherImpl (Defaulting To The Oldest Offset) With A "latest" Initial Offset For One Subscription
+	saramaConfig := getSaramaConfigFromYaml(t, TestConfigBase)
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	dispatcher := &DispatcherImpl{
+		DispatcherConfig: DispatcherConfig{
+			SaramaConfig:             saramaConfig,
+			Logger:                   logtesting.TestLogger(t).Desugar(),
+			SubscriberInitialOffsets: map[string]string{string(uid123): commonkafkaconstants.SubscriberInitialOffsetLatest},
+		},
+		subscribers: map[types.UID]*SubscriberWrapper{},
+	}
+
+	// Perform The Test
+	failedSubscriptions := dispatcher.UpdateSubscriptions([]eventingduck.SubscriberSpec{{UID: uid123}, {UID: uid456}})
+	dispatcher.Shutdown()
+
+	// Verify Only The Configured Subscription's ConsumerGroup Starts From The Newest Offset
+	assert.Empty(t, failedSubscriptions)
+	assert.Equal(t, sarama.OffsetNewest, groupConfigs[dispatcher.consumerGroupId(uid123)].Consumer.Offsets.Initial)
+	assert.Equal(t, sarama.OffsetOldest, groupConfigs[dispatcher.consumerGroupId(uid456)].Consumer.Offsets.Initial)
+	assert.Equal(t, sarama.OffsetOldest, saramaConfig.Consumer.Offsets.Initial)
+}
+
 // Utility Function For Creating A SubscriberWrapper With Specified UID & Mock ConsumerGroup
 func createSubscriberWrapper(t *testing.T, uid types.UID) *SubscriberWrapper {
 	return NewSubscriberWrapper(eventingduck.SubscriberSpec{UID: uid}, fmt.Sprintf("kafka.%s", string(uid)), kafkatesting.NewMockConsumerGroup(t))
@@ -391,9 +651,100 @@ func TestConfigChanged(t *testing.T) {
 	// Verify that Producer changes do not cause Reconfigure to be called
 	dispatcher = runConfigChangedTest(t, dispatcher, getBaseConfigMap(), TestConfigProducerChange, "", false)
 
+	// Verify that the Kafka rack ID (not part of the configmap) is carried forward to the new dispatcher
+	dispatcher.(*DispatcherImpl).SaramaConfig.RackID = "TestRackId"
+	dispatcher = runConfigChangedTest(t, dispatcher, getBaseConfigMap(), TestConfigConsumerChange, "", true)
+	assert.Equal(t, "TestRackId", dispatcher.(*DispatcherImpl).SaramaConfig.RackID)
+
+	// Verify that the Kafka Secret's TLS enable override takes precedence over the configmap in the new dispatcher
+	dispatcher.(*DispatcherImpl).TLSEnable = "true"
+	dispatcher = runConfigChangedTest(t, dispatcher, getBaseConfigMap(), TestConfigConsumerAdd, "", true)
+	assert.True(t, dispatcher.(*DispatcherImpl).SaramaConfig.Net.TLS.Enable)
+
+	// Verify that consumer config overrides which are invalid against the configmap are ignored (rather than blocking the change)
+	dispatcher.(*DispatcherImpl).ConsumerConfigOverrides = map[string]string{commonkafkaconstants.ConsumerConfigFetchMax: "2048", commonkafkaconstants.ConsumerConfigSessionTimeoutMs: "1"}
+	dispatcher = runConfigChangedTest(t, dispatcher, getBaseConfigMap(), TestConfigConsumerChange, "", true)
+	assert.NotEqual(t, int32(2048), dispatcher.(*DispatcherImpl).SaramaConfig.Consumer.Fetch.Max)
+	assert.NotEqual(t, time.Millisecond, dispatcher.(*DispatcherImpl).SaramaConfig.Consumer.Group.Session.Timeout)
+	dispatcher.(*DispatcherImpl).ConsumerConfigOverrides = nil
+
 	// Verify that having eventing-kafka settings in the configmap doesn't cause trouble
 	dispatcher = runConfigChangedTest(t, dispatcher, getBaseConfigMap(), TestConfigBase, TestEventingKafka, false)
 	assert.NotNil(t, dispatcher)
+
+	// Verify that changing the offset commit strategy recreates the dispatcher with Sarama's automatic commits disabled
+	dispatcher = runConfigChangedTest(t, dispatcher, getBaseConfigMap(), TestConfigBase, TestEventingKafkaOffsetCommit, true)
+	assert.Equal(t, commonconfig.OffsetCommitStrategyManualAfterAck, dispatcher.(*DispatcherImpl).OffsetCommit.Strategy)
+	assert.False(t, dispatcher.(*DispatcherImpl).SaramaConfig.Consumer.Offsets.AutoCommit.Enable)
+	assert.Equal(t, 250*time.Millisecond, dispatcher.(*DispatcherImpl).SaramaConfig.Consumer.Offsets.AutoCommit.Interval)
+
+	// Verify that changing the max in-flight delivery limits recreates the dispatcher (only once) with the new limits
+	dispatcher = runConfigChangedTest(t, dispatcher, getBaseConfigMap(), TestConfigBase, TestEventingKafkaMaxInFlight, true)
+	assert.Equal(t, commonconfig.EKMaxInFlightConfig{Dispatcher: 10, Subscription: 2}, dispatcher.(*DispatcherImpl).MaxInFlight)
+	assert.Equal(t, 10, cap(dispatcher.(*DispatcherImpl).inFlightSemaphore))
+	dispatcher = runConfigChangedTest(t, dispatcher, getBaseConfigMap(), TestConfigBase, TestEventingKafkaMaxInFlight, false)
+	assert.NotNil(t, dispatcher)
+
+	// Verify that changing the offset store recreates the dispatcher (only once) with the new store
+	dispatcher = runConfigChangedTest(t, dispatcher, getBaseConfigMap(), TestConfigBase, TestEventingKafkaOffsetStore, true)
+	assert.Equal(t, commonconfig.OffsetStoreConfigMap, dispatcher.(*DispatcherImpl).OffsetCommit.Store)
+	dispatcher = runConfigChangedTest(t, dispatcher, getBaseConfigMap(), TestConfigBase, TestEventingKafkaOffsetStore, false)
+	assert.NotNil(t, dispatcher)
+}
+
+// Test The RootCAsChanged() Functionality
+func TestRootCAsChanged(t *testing.T) {
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Create A Dispatcher With An Existing Sarama Config
+	originalConfig := sarama.NewConfig()
+	originalConfig.RackID = "TestRackId"
+	var dispatcher Dispatcher
+	dispatcher = &DispatcherImpl{
+		DispatcherConfig:  DispatcherConfig{Logger: logger, SaramaConfig: originalConfig},
+		subscribers:       make(map[types.UID]*SubscriberWrapper),
+		messageDispatcher: channel.NewMessageDispatcher(logger),
+	}
+
+	// Verify Nil RootCAs Do Not Recreate The Dispatcher
+	assert.Nil(t, dispatcher.RootCAsChanged(nil))
+
+	// Verify New RootCAs Recreate The Dispatcher With The Existing Config Carried Forward
+	rootCAs := x509.NewCertPool()
+	newDispatcher := dispatcher.RootCAsChanged(rootCAs)
+	assert.NotNil(t, newDispatcher)
+	newConfig := newDispatcher.(*DispatcherImpl).SaramaConfig
+	assert.Equal(t, rootCAs, newConfig.Net.TLS.Config.RootCAs)
+	assert.Equal(t, "TestRackId", newConfig.RackID)
+	assert.Nil(t, originalConfig.Net.TLS.Config)
+}
+
+func TestBrokersChanged(t *testing.T) {
+	logger := logtesting.TestLogger(t).Desugar()
+
+	// Create A Dispatcher With An Existing Sarama Config & Brokers
+	originalConfig := sarama.NewConfig()
+	originalConfig.RackID = "TestRackId"
+	originalBrokers := []string{"broker-0:9092"}
+	var dispatcher Dispatcher
+	dispatcher = &DispatcherImpl{
+		DispatcherConfig:  DispatcherConfig{Logger: logger, SaramaConfig: originalConfig, Brokers: originalBrokers},
+		subscribers:       make(map[types.UID]*SubscriberWrapper),
+		messageDispatcher: channel.NewMessageDispatcher(logger),
+	}
+
+	// Verify Empty Or Unchanged Brokers Do Not Recreate The Dispatcher
+	assert.Nil(t, dispatcher.BrokersChanged(nil))
+	assert.Nil(t, dispatcher.BrokersChanged([]string{"broker-0:9092"}))
+
+	// Verify New Brokers Recreate The Dispatcher With The Existing Config Carried Forward
+	newBrokers := []string{"broker-0:9092", "broker-1:9092"}
+	newDispatcher := dispatcher.BrokersChanged(newBrokers)
+	assert.NotNil(t, newDispatcher)
+	newDispatcherImpl := newDispatcher.(*DispatcherImpl)
+	assert.Equal(t, newBrokers, newDispatcherImpl.Brokers)
+	assert.Equal(t, "TestRackId", newDispatcherImpl.SaramaConfig.RackID)
+	assert.NotSame(t, originalConfig, newDispatcherImpl.SaramaConfig)
 }
 
 func runConfigChangedTest(t *testing.T, originalDispatcher Dispatcher, base *corev1.ConfigMap, changed string, eventingKafka string, expectedNewDispatcher bool) Dispatcher {
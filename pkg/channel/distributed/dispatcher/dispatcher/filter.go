@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+)
+
+//
+// Determine Whether The Specified CloudEvent Passes The (Trigger-Style) Attribute Filter
+//
+// Mirrors the Knative Trigger filter model - every filter attribute must exactly match the corresponding event
+// context attribute or extension (in its canonical string form), except for those with an empty value which
+// match any value (including none).  A nil or empty filter matches all events.
+//
+func filterMatches(filter *eventingv1.TriggerFilter, cloudEvent *event.Event) bool {
+	if filter == nil {
+		return true
+	}
+	for name, value := range filter.Attributes {
+		if value == eventingv1.TriggerAnyFilter {
+			continue
+		}
+		eventValue, ok := eventAttribute(cloudEvent, name)
+		if !ok || eventValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Get The Canonical String Value Of The Named CloudEvent Context Attribute Or Extension (If Present)
+func eventAttribute(cloudEvent *event.Event, name string) (string, bool) {
+	switch name {
+	case "specversion":
+		return cloudEvent.SpecVersion(), true
+	case "type":
+		return cloudEvent.Type(), true
+	case "source":
+		return cloudEvent.Source(), true
+	case "subject":
+		return cloudEvent.Subject(), len(cloudEvent.Subject()) > 0
+	case "id":
+		return cloudEvent.ID(), true
+	case "time":
+		if cloudEvent.Time().IsZero() {
+			return "", false
+		}
+		return types.FormatTime(cloudEvent.Time()), true
+	case "dataschema", "schemaurl":
+		return cloudEvent.DataSchema(), len(cloudEvent.DataSchema()) > 0
+	case "datacontenttype":
+		return cloudEvent.DataContentType(), len(cloudEvent.DataContentType()) > 0
+	}
+	extension, ok := cloudEvent.Extensions()[name]
+	if !ok {
+		return "", false
+	}
+	extensionValue, err := types.Format(extension)
+	if err != nil {
+		return "", false
+	}
+	return extensionValue, true
+}
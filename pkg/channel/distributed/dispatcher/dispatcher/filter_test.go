@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/stretchr/testify/assert"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+)
+
+// Test The filterMatches() Functionality
+func TestFilterMatches(t *testing.T) {
+
+	// Create The CloudEvent To Filter
+	eventTime := time.Date(2020, 11, 12, 13, 14, 15, 0, time.UTC)
+	cloudEvent := event.New()
+	cloudEvent.SetID(testMsgId)
+	cloudEvent.SetType(testMsgType)
+	cloudEvent.SetSource(testMsgSource)
+	cloudEvent.SetTime(eventTime)
+	cloudEvent.SetExtension("stringext", "foo")
+	cloudEvent.SetExtension("intext", 7)
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name       string
+		attributes eventingv1.TriggerFilterAttributes
+		expected   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Empty Filter", attributes: eventingv1.TriggerFilterAttributes{}, expected: true},
+		{name: "Matching Type & Source", attributes: eventingv1.TriggerFilterAttributes{"type": testMsgType, "source": testMsgSource}, expected: true},
+		{name: "Mismatched Type", attributes: eventingv1.TriggerFilterAttributes{"type": "OtherType", "source": testMsgSource}, expected: false},
+		{name: "Any Value", attributes: eventingv1.TriggerFilterAttributes{"type": eventingv1.TriggerAnyFilter}, expected: true},
+		{name: "Any Value Of Absent Attribute", attributes: eventingv1.TriggerFilterAttributes{"subject": eventingv1.TriggerAnyFilter}, expected: true},
+		{name: "Absent Attribute", attributes: eventingv1.TriggerFilterAttributes{"subject": "foo"}, expected: false},
+		{name: "Matching Time", attributes: eventingv1.TriggerFilterAttributes{"time": "2020-11-12T13:14:15Z"}, expected: true},
+		{name: "Matching String Extension", attributes: eventingv1.TriggerFilterAttributes{"stringext": "foo"}, expected: true},
+		{name: "Matching Integer Extension", attributes: eventingv1.TriggerFilterAttributes{"intext": "7"}, expected: true},
+		{name: "Mismatched Extension", attributes: eventingv1.TriggerFilterAttributes{"stringext": "bar"}, expected: false},
+		{name: "Absent Extension", attributes: eventingv1.TriggerFilterAttributes{"otherext": "foo"}, expected: false},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, filterMatches(&eventingv1.TriggerFilter{Attributes: testCase.attributes}, &cloudEvent))
+		})
+	}
+
+	// Verify A Nil Filter Matches Everything
+	assert.True(t, filterMatches(nil, &cloudEvent))
+}
@@ -19,35 +19,99 @@ package dispatcher
 import (
 	"context"
 	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/Shopify/sarama"
 	kafkasaramaprotocol "github.com/cloudevents/sdk-go/protocol/kafka_sarama/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
+	"go.opencensus.io/trace"
 	"go.uber.org/zap"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/common/tracing"
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/channel"
 	"knative.dev/eventing/pkg/kncloudevents"
 )
 
+// CloudEvent Extensions Added To Messages Sent To The Dead Letter Sink
+const (
+	ErrorDestinationExtension = "knativeerrordest"
+	ErrorCodeExtension        = "knativeerrorcode"
+)
+
+// CloudEvent Extensions Describing The Kafka Record (Added To All Messages When Enabled)
+const (
+	KafkaTimestampExtension = "kafkatimestamp" // Timestamp - When the record was written to Kafka (per the topic's timestamp type)
+	KafkaPartitionExtension = "kafkapartition" // Integer - The partition from which the record was consumed
+	KafkaOffsetExtension    = "kafkaoffset"    // String - The record's (64 bit) offset, which exceeds the 32 bit CloudEvent Integer type
+)
+
+// Limits On The Asynchronous (Best Effort) Deliveries To A Subscriber's Shadow Destination
+const (
+	maxShadowDeliveries   = 100              // The maximum concurrent shadow deliveries per Handler (samples are skipped while at the limit)
+	shadowDeliveryTimeout = 30 * time.Second // The maximum duration of a single shadow delivery (which is never retried)
+)
+
+// Capped Exponential Backoff Before Re-Joining The ConsumerGroup After Consecutive Failed Sessions
+const (
+	minRejoinBackoff = 100 * time.Millisecond // The backoff after the first failure (doubled for each consecutive failure)
+	maxRejoinBackoff = 30 * time.Second       // The maximum backoff regardless of the number of consecutive failures
+)
+
+// The Error Returned For Messages Which Can Never Be Delivered (Acknowledged Even When Manually Committing)
+var errUnknownEncoding = errors.New("received a message with unknown encoding - skipping")
+
 // Verify The Handler Implements The Sarama ConsumerGroupHandler
 var _ sarama.ConsumerGroupHandler = &Handler{}
 
 // Define A Sarama ConsumerGroupHandler Implementation
 type Handler struct {
-	Logger            *zap.Logger
-	Subscriber        *eventingduck.SubscriberSpec
-	MessageDispatcher channel.MessageDispatcher
+	Logger               *zap.Logger
+	Subscriber           *eventingduck.SubscriberSpec
+	MessageDispatcher    channel.MessageDispatcher
+	DrainTimeout         time.Duration                             // How long in-flight deliveries may continue after the ConsumerGroup session ends
+	ChannelDeadLetterURL func() *url.URL                           // The KafkaChannel's dead letter sink (used if the Subscriber has none)
+	ChannelDelivery      func() *eventingduck.DeliverySpec         // The KafkaChannel's DeliverySpec (retry settings used if the Subscriber has none)
+	Concurrency          int                                       // The number of worker goroutines delivering each claim's messages (<= 1 is strictly sequential)
+	Ordering             string                                    // Optional ordering mode ("ordered" blocks the partition on failure, "unordered" delivers concurrently)
+	Filter               *eventingv1.TriggerFilter                 // Optional attribute filter (non-matching messages are dropped but still marked)
+	KafkaExtensions      func() bool                               // Whether to add the Kafka record's timestamp, partition & offset as CloudEvent extensions
+	ExtensionFilter      *kafkautil.ExtensionFilter                // Optional CloudEvent extension allow / deny lists (disallowed extensions are stripped before delivery)
+	Shadow               func() *consumer.ResolvedSubscriberShadow // Optional shadow destination to which a sample of the messages is mirrored (results are ignored)
+	Replay               func(sarama.ConsumerGroupSession) error   // Optional offset reset performed when each ConsumerGroup session is set up
+	OffsetMetadata       string                                    // The metadata committed with consumed offsets (identifies any applied replay)
+	ManualCommit         bool                                      // Whether offsets are only marked once acknowledged & committed by the Handler ("manual-after-ack")
+	CommitInterval       time.Duration                             // The minimum time between the Handler's manual commits (zero commits after every acknowledged message)
+	OffsetStore          OffsetStore                               // Optional store persisting consumed offsets (nil uses Kafka's native offset management)
+	DeadLetterTopic      string                                    // Optional Kafka topic to which failed messages are produced (instead of any dead letter sink)
+	DeadLetterProducer   func() (sarama.SyncProducer, error)       // The SyncProducer used to produce to the DeadLetterTopic
+	InFlight             *inFlightLimiter                          // Optional limits on the concurrent in-flight deliveries (nil is unlimited)
+	joined               int32                                     // Atomically set while the ConsumerGroup session is active (between Setup & Cleanup)
+	failures             int32                                     // Atomic count of consecutive failed sessions (reset by any successful commit)
+	shadowSlots          chan struct{}                             // Bounds the concurrent shadow deliveries (non-blocking so the primary delivery is never delayed)
 }
 
 // Create A New Handler
-func NewHandler(logger *zap.Logger, subscriber *eventingduck.SubscriberSpec) *Handler {
+func NewHandler(logger *zap.Logger, subscriber *eventingduck.SubscriberSpec, drainTimeout time.Duration, channelDeadLetterURL func() *url.URL, channelDelivery func() *eventingduck.DeliverySpec) *Handler {
 	return &Handler{
-		Logger:            logger,
-		Subscriber:        subscriber,
-		MessageDispatcher: newMessageDispatcherWrapper(logger),
+		Logger:               logger,
+		Subscriber:           subscriber,
+		MessageDispatcher:    newMessageDispatcherWrapper(logger),
+		DrainTimeout:         drainTimeout,
+		ChannelDeadLetterURL: channelDeadLetterURL,
+		ChannelDelivery:      channelDelivery,
+		shadowSlots:          make(chan struct{}, maxShadowDeliveries),
 	}
 }
 
@@ -56,14 +120,95 @@ var newMessageDispatcherWrapper = func(logger *zap.Logger) channel.MessageDispat
 	return channel.NewMessageDispatcher(logger)
 }
 
+// Record A Failed ConsumerGroup Session (Redelivery Or Consume Error) Which Extends The Backoff Before Re-Joining
+func (h *Handler) recordFailure() {
+	atomic.AddInt32(&h.failures, 1)
+}
+
+// Reset The Consecutive Failures After A Successful Commit (Re-Joins Are Immediate Again)
+func (h *Handler) resetFailures() {
+	atomic.StoreInt32(&h.failures, 0)
+}
+
+//
+// Return The Backoff Before Re-Joining The ConsumerGroup (Zero Unless A Session Has Failed Since The Last Commit)
+//
+// The backoff starts at minRejoinBackoff and doubles with each consecutive failure up to maxRejoinBackoff, so
+// that a persistently failing subscriber (or Kafka cluster) is not hammered with immediate re-joins & redeliveries.
+//
+func (h *Handler) rejoinBackoff() time.Duration {
+	failures := atomic.LoadInt32(&h.failures)
+	if failures <= 0 {
+		return 0
+	}
+	backoff := minRejoinBackoff
+	for i := int32(1); i < failures && backoff < maxRejoinBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRejoinBackoff {
+		backoff = maxRejoinBackoff
+	}
+	return backoff
+}
+
 // ConsumerGroupHandler Lifecycle Method (Runs before any ConsumeClaims)
-func (h *Handler) Setup(_ sarama.ConsumerGroupSession) error {
-	return nil // Nothing To Do As Of Yet
+func (h *Handler) Setup(session sarama.ConsumerGroupSession) error {
+
+	// Restore The Claimed Partitions' Offsets From Any Non-Kafka OffsetStore (Failing The Session If Unable)
+	if _, native := h.OffsetStore.(*kafkaOffsetStore); h.OffsetStore != nil && !native {
+		err := h.restoreOffsets(session)
+		if err != nil {
+			h.Logger.Error("Failed To Restore ConsumerGroup Offsets From OffsetStore", zap.Error(err))
+			return err
+		}
+	}
+
+	// Reset The Claimed Partitions' Offsets Before Consumption Starts From Them (Failing The Session If Unable)
+	if h.Replay != nil {
+		err := h.Replay(session)
+		if err != nil {
+			h.Logger.Error("Failed To Replay ConsumerGroup", zap.Error(err))
+			return err
+		}
+	}
+
+	atomic.StoreInt32(&h.joined, 1) // The ConsumerGroup Has Been Joined
+	return nil
+}
+
+//
+// Restore The Session's Claimed Partitions' Offsets From The OffsetStore
+//
+// Sarama only marks offsets forwards and resets them backwards, so both are applied to position each partition
+// exactly at the stored offset, regardless of the offset Kafka would otherwise have started consuming from.
+// Partitions without a stored offset are consumed per the ConsumerGroup's initial offset policy as usual.
+//
+func (h *Handler) restoreOffsets(session sarama.ConsumerGroupSession) error {
+	for topic, partitions := range session.Claims() {
+		for _, partition := range partitions {
+			offset, metadata, err := h.OffsetStore.Fetch(session, topic, partition)
+			if err != nil {
+				return fmt.Errorf("failed to fetch stored offset of partition %d: %w", partition, err)
+			} else if offset < 0 {
+				continue
+			}
+			session.MarkOffset(topic, partition, offset, metadata)
+			session.ResetOffset(topic, partition, offset, metadata)
+			h.Logger.Debug("Restored Partition Offset From OffsetStore", zap.String("Topic", topic), zap.Int32("Partition", partition), zap.Int64("Offset", offset))
+		}
+	}
+	return nil
 }
 
 // ConsumerGroupHandler Lifecycle Method (Runs after all ConsumeClaims stop but before final offset commit)
 func (h *Handler) Cleanup(_ sarama.ConsumerGroupSession) error {
-	return nil // Nothing To Do As Of Yet
+	atomic.StoreInt32(&h.joined, 0) // The ConsumerGroup Session Has Ended (Re-Balance Or Shutdown)
+	return nil
+}
+
+// Determine Whether The Handler Currently Has An Active ConsumerGroup Session
+func (h *Handler) Joined() bool {
+	return atomic.LoadInt32(&h.joined) == 1
 }
 
 // ConsumerGroupHandler Lifecycle Method (Main processing loop, must finish when claim.Messages() channel closes.)
@@ -81,41 +226,324 @@ func (h *Handler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama
 		replyURL = h.Subscriber.ReplyURI.URL()
 	}
 
-	// Validate The Subscriber's Delivery (Optional)
-	var deadLetterURL *url.URL
-	retryConfig := kncloudevents.NoRetries()
-	if h.Subscriber.Delivery != nil {
-
-		// Extract The DeadLetterSink From The Subscriber.Delivery
-		if h.Subscriber.Delivery.DeadLetterSink != nil &&
-			h.Subscriber.Delivery.DeadLetterSink.URI != nil &&
-			!h.Subscriber.Delivery.DeadLetterSink.URI.IsEmpty() {
-			deadLetterURL = h.Subscriber.Delivery.DeadLetterSink.URI.URL()
+	// Create A Delivery Context Which Outlives The Session By The DrainTimeout (Allows In-Flight Deliveries To Complete)
+	deliveryCtx, cancel := newDrainContext(session.Context(), h.DrainTimeout)
+	defer cancel()
+
+	// Deliver Messages Concurrently If The Subscriber Has Been Configured With Multiple Workers
+	if h.concurrency() > 1 {
+		return h.consumeClaimConcurrently(session, claim, deliveryCtx, destinationURL, replyURL)
+	}
+
+	// Track The Consumed Messages So That They Are Marked (And Committed) Per The Offset Commit Strategy
+	tracker := h.newOffsetTracker(session)
+
+	// Pull Any Available Messages From The ConsumerGroupClaim (Until The Channel Closes Or The Session Ends)
+	for {
+		select {
+
+		// Stop Processing (Buffered) Messages Once The Session Ends (Re-Balance Or Shutdown) - They Will Be Redelivered
+		case <-session.Context().Done():
+			h.Logger.Info("ConsumerGroup Session Ended - Ceasing Message Consumption")
+			return nil
+
+		case message, ok := <-claim.Messages():
+			if !ok || session.Context().Err() != nil {
+				return nil // Claim Closed Or Session Ended While Waiting (Don't Start A New Delivery)
+			}
+
+			// Determine The Current Dead Letter Sink & Retry Configuration (The KafkaChannel's May Change At Any Time)
+			deadLetterURL, retryConfig := h.deliveryConfig()
+
+			// Consume The Message (Errors Will have already been retried and are only fatal to the claim when manually committing)
+			tracker.track(message)
+			err := h.consumeMessage(deliveryCtx, message, destinationURL, replyURL, deadLetterURL, &retryConfig)
+
+			// Mark The Message As Having Been Consumed (In "auto" Mode Does Not Imply Successful Delivery - Only Full Retry Attempts Made)
+			if !tracker.complete(message, err) {
+				return h.redeliveryError(message, err)
+			}
 		}
+	}
+}
 
-		// Extract The RetryConfig From The Subscriber.Delivery (Defaults To NoRetries)
-		var err error
-		retryConfig, err = kncloudevents.RetryConfigFromDeliverySpec(*h.Subscriber.Delivery)
+//
+// Determine The Number Of Worker Goroutines Per The Handler's Concurrency & Ordering Mode
+//
+// The "ordered" mode is strictly sequential regardless of the Concurrency, while the "unordered" mode delivers with
+// the DefaultUnorderedSubscriberConcurrency unless a Concurrency of more than one worker has been configured.
+//
+func (h *Handler) concurrency() int {
+	switch h.Ordering {
+	case constants.SubscriberOrderingOrdered:
+		return 1
+	case constants.SubscriberOrderingUnordered:
+		if h.Concurrency <= 1 {
+			return constants.DefaultUnorderedSubscriberConcurrency
+		}
+	}
+	return h.Concurrency
+}
+
+//
+// Log & Return The Error Ending The ConsumerGroup Session After A Failed Delivery When Manually Committing (Or Ordered)
+//
+// Kafka only tracks a single committed offset per partition, so the failed message's offset must never be committed
+// (or subsequent messages would be marked beyond it).  Returning from ConsumeClaim() ends the ConsumerGroup session,
+// which the Dispatcher re-joins (after a capped exponential backoff), resuming consumption from the last committed
+// offset so that the failed message (and any after it) are redelivered.
+//
+func (h *Handler) redeliveryError(message *sarama.ConsumerMessage, err error) error {
+	h.recordFailure()
+	h.Logger.Warn("Failed To Deliver Message - Ending ConsumerGroup Session For Redelivery",
+		zap.Int32("Partition", message.Partition), zap.Int64("Offset", message.Offset), zap.Error(err))
+	return fmt.Errorf("failed to deliver message at partition %d offset %d (will be redelivered): %v", message.Partition, message.Offset, err)
+}
+
+//
+// Consume The ConsumerGroupClaim's Messages With The Handler's Concurrency Worth Of Worker Goroutines
+//
+// Messages are sharded across the workers by a hash of their Kafka key, so that messages with the same key are
+// always delivered in order (by the same worker), while messages with different keys are delivered in parallel.
+// Messages without a key (and all messages in the "unordered" mode) have no ordering guarantee and are distributed
+// round-robin.  Messages are only marked
+// once they, and every message before them in the partition, have been consumed, so that a re-balance or restart
+// never commits an offset beyond a message whose delivery has not finished (at-least-once delivery is preserved).
+//
+func (h *Handler) consumeClaimConcurrently(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim, deliveryCtx context.Context, destinationURL *url.URL, replyURL *url.URL) error {
+
+	// Start The Workers, Each Consuming Its Own Queue Of Messages & Reporting Them (And Any Error) When Consumed
+	concurrency := h.concurrency()
+	completedChan := make(chan consumedMessage, concurrency)
+	workerChans := make([]chan *sarama.ConsumerMessage, concurrency)
+	waitGroup := sync.WaitGroup{}
+	for index := range workerChans {
+		workerChans[index] = make(chan *sarama.ConsumerMessage, 1)
+		waitGroup.Add(1)
+		go func(workerChan <-chan *sarama.ConsumerMessage) {
+			defer waitGroup.Done()
+			for message := range workerChan {
+
+				// Skip (Buffered) Messages Once The Session Ends - They Are Never Marked & Will Be Redelivered
+				if session.Context().Err() != nil {
+					continue
+				}
+
+				// Consume The Message With The Current Dead Letter Sink & Retry Configuration (Errors Already Retried)
+				deadLetterURL, retryConfig := h.deliveryConfig()
+				err := h.consumeMessage(deliveryCtx, message, destinationURL, replyURL, deadLetterURL, &retryConfig)
+				completedChan <- consumedMessage{message: message, err: err}
+			}
+		}(workerChans[index])
+	}
+
+	// Track The Messages Handed To The Workers So That They Are Marked In Offset Order
+	tracker := h.newOffsetTracker(session)
+
+	// Stop The Workers & Wait For Any In-Flight Deliveries (Marking Them As They Complete)
+	defer func() {
+		for _, workerChan := range workerChans {
+			close(workerChan)
+		}
+		go func() {
+			waitGroup.Wait()
+			close(completedChan)
+		}()
+		for consumed := range completedChan {
+			tracker.complete(consumed.message, consumed.err)
+		}
+	}()
+
+	// Pull Any Available Messages From The ConsumerGroupClaim & Hand Them To The Workers (Until The Channel Closes Or The Session Ends)
+	roundRobin := 0
+	for {
+		select {
+
+		// Stop Processing (Buffered) Messages Once The Session Ends (Re-Balance Or Shutdown) - They Will Be Redelivered
+		case <-session.Context().Done():
+			h.Logger.Info("ConsumerGroup Session Ended - Ceasing Message Consumption")
+			return nil
+
+		// Mark Consumed Messages As They Are Reported By The Workers (Ending The Session If One Must Be Redelivered)
+		case consumed := <-completedChan:
+			if !tracker.complete(consumed.message, consumed.err) {
+				return h.redeliveryError(consumed.message, consumed.err)
+			}
+
+		case message, ok := <-claim.Messages():
+			if !ok || session.Context().Err() != nil {
+				return nil // Claim Closed Or Session Ended While Waiting (Don't Start A New Delivery)
+			}
+
+			// Select The Worker For The Message (By Key Hash, Or Round-Robin If The Message Has No Key Or Is Unordered)
+			var workerChan chan *sarama.ConsumerMessage
+			if len(message.Key) > 0 && h.Ordering != constants.SubscriberOrderingUnordered {
+				keyHash := fnv.New32a()
+				_, _ = keyHash.Write(message.Key)
+				workerChan = workerChans[keyHash.Sum32()%uint32(len(workerChans))]
+			} else {
+				workerChan = workerChans[roundRobin%len(workerChans)]
+				roundRobin++
+			}
+
+			// Hand The Message To The Worker (Continuing To Mark Consumed Messages While Waiting For A Busy Worker)
+			tracker.track(message)
+			for dispatched := false; !dispatched; {
+				select {
+				case workerChan <- message:
+					dispatched = true
+				case consumed := <-completedChan:
+					if !tracker.complete(consumed.message, consumed.err) {
+						return h.redeliveryError(consumed.message, consumed.err)
+					}
+				case <-session.Context().Done():
+					h.Logger.Info("ConsumerGroup Session Ended - Ceasing Message Consumption")
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// A Message Reported By A Worker Once Consumed, Along With Any Error From Its Delivery
+type consumedMessage struct {
+	message *sarama.ConsumerMessage
+	err     error
+}
+
+//
+// Tracks The Messages Being Consumed In Offset Order, Marking Them Only Once All Prior Messages Are Consumed
+//
+// With the "auto" offset commit strategy every consumed message is marked (regardless of whether its delivery
+// succeeded) and Sarama commits the marked offsets in the background.  With the "manual-after-ack" strategy only
+// acknowledged messages (those successfully delivered to the subscriber or dead letter sink, filtered out, or which
+// can never be delivered) are marked, and are committed manually (at most once per CommitInterval).  Marked messages
+// are committed via the Handler's OffsetStore (defaulting to Kafka's native offset management).  A
+// failed message is never marked, and so neither is any later message, while earlier messages still are.  The
+// "ordered" mode likewise never marks a failed message (with either strategy), blocking the partition until the
+// message has been redelivered successfully (or to the dead letter sink).
+//
+type offsetTracker struct {
+	logger       *zap.Logger
+	session      sarama.ConsumerGroupSession
+	committed    func()                    // Invoked after each successful commit of marked messages
+	store        OffsetStore               // The store to which marked messages are committed
+	metadata     string                    // The metadata committed with marked offsets
+	pending      []*sarama.ConsumerMessage // Messages being consumed but not yet marked (in offset order)
+	completed    map[int64]bool            // Offsets of pending messages which have been consumed
+	manualCommit bool                      // Whether only acknowledged messages are marked & then committed manually
+	ordered      bool                      // Whether failed messages block the partition ("ordered" mode) regardless of the commit strategy
+	failed       bool                      // Whether a message has failed (manual commit only) and must be redelivered
+}
+
+// Create A New offsetTracker For The Specified ConsumerGroupSession Per The Handler's Offset Commit Strategy
+func (h *Handler) newOffsetTracker(session sarama.ConsumerGroupSession) *offsetTracker {
+	store := h.OffsetStore
+	if store == nil {
+		store = newKafkaOffsetStore("", nil, h.ManualCommit, h.CommitInterval)
+	}
+	return &offsetTracker{
+		logger:       h.Logger,
+		session:      session,
+		committed:    h.resetFailures,
+		store:        store,
+		metadata:     h.OffsetMetadata,
+		completed:    make(map[int64]bool),
+		manualCommit: h.ManualCommit,
+		ordered:      h.Ordering == constants.SubscriberOrderingOrdered,
+	}
+}
+
+// Track A Message Which Is About To Be Consumed
+func (t *offsetTracker) track(message *sarama.ConsumerMessage) {
+	t.pending = append(t.pending, message)
+}
+
+// Record A Consumed Message & Mark The Contiguous Prefix Of Consumed Messages (Returns False If It Must Be Redelivered)
+func (t *offsetTracker) complete(message *sarama.ConsumerMessage, err error) bool {
+	if (t.manualCommit || t.ordered) && err != nil && err != errUnknownEncoding {
+		t.failed = true // Never Completed, So Remains Pending & Blocks Marking Of All Later Messages
+	} else {
+		t.completed[message.Offset] = true
+	}
+	var marked []*sarama.ConsumerMessage
+	for len(t.pending) > 0 && t.completed[t.pending[0].Offset] {
+		delete(t.completed, t.pending[0].Offset)
+		marked = append(marked, t.pending[0])
+		t.pending[0] = nil
+		t.pending = t.pending[1:]
+	}
+	if len(marked) > 0 {
+		err := t.store.Commit(t.session, marked, t.metadata)
 		if err != nil {
-			h.Logger.Error("Failed To Parse RetryConfig From DeliverySpec - No Retries Will Occur", zap.Error(err))
+			t.logger.Error("Failed To Commit Offsets To OffsetStore", zap.Int64("Offset", marked[len(marked)-1].Offset), zap.Error(err))
 		} else {
-			h.Logger.Info("Successfully Parsed RetryConfig From DeliverySpec", zap.Int("RetryMax", retryConfig.RetryMax))
-			retryConfig.CheckRetry = h.checkRetry // Specify Custom CheckRetry Function
+			t.committed()
 		}
 	}
+	return !t.failed
+}
 
-	// Pull Any Available Messages From The ConsumerGroupClaim (Until The Channel Closes)
-	for message := range claim.Messages() {
+//
+// Create A New Context Which Is Cancelled The DrainTimeout After The Parent Context Is Done
+//
+// The Sarama ConsumerGroupSession's context is cancelled as soon as the session starts to end (re-balance or
+// shutdown), which would abort any in-flight CloudEvent deliveries.  Using this context for deliveries instead
+// allows them to complete (and be marked / committed) as long as they finish within the DrainTimeout.
+//
+func newDrainContext(parent context.Context, drainTimeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-parent.Done():
+			timer := time.NewTimer(drainTimeout)
+			defer timer.Stop()
+			select {
+			case <-ctx.Done():
+			case <-timer.C:
+				cancel()
+			}
+		}
+	}()
+	return ctx, cancel
+}
 
-		// Consume The Message (Ignore Errors - Will have already been retried and we're moving on so as not to block further Topic processing.)
-		_ = h.consumeMessage(session.Context(), message, destinationURL, replyURL, deadLetterURL, &retryConfig)
+//
+// Determine The Dead Letter Sink & Retry Configuration For The Next Message
+//
+// The Subscriber's dead letter sink and retry settings take precedence, falling back to those of the KafkaChannel
+// if the Subscriber does not specify them.  Invalid retry settings are logged and result in no retries.
+//
+func (h *Handler) deliveryConfig() (*url.URL, kncloudevents.RetryConfig) {
 
-		// Mark The Message As Having Been Consumed (Does Not Imply Successful Delivery - Only Full Retry Attempts Made)
-		session.MarkMessage(message, "")
+	// Extract The DeadLetterSink From The Subscriber.Delivery (Falling Back To The KafkaChannel's)
+	var deadLetterURL *url.URL
+	if h.Subscriber.Delivery != nil &&
+		h.Subscriber.Delivery.DeadLetterSink != nil &&
+		h.Subscriber.Delivery.DeadLetterSink.URI != nil &&
+		!h.Subscriber.Delivery.DeadLetterSink.URI.IsEmpty() {
+		deadLetterURL = h.Subscriber.Delivery.DeadLetterSink.URI.URL()
+	} else if h.ChannelDeadLetterURL != nil {
+		deadLetterURL = h.ChannelDeadLetterURL()
 	}
 
-	// Return Success
-	return nil
+	// Get The KafkaChannel's DeliverySpec (Optional)
+	var channelDelivery *eventingduck.DeliverySpec
+	if h.ChannelDelivery != nil {
+		channelDelivery = h.ChannelDelivery()
+	}
+
+	// Create The RetryConfig From The Applicable DeliverySpec (Defaults To NoRetries)
+	retryConfig, err := newRetryConfig(retryDeliverySpec(h.Subscriber.Delivery, channelDelivery), h.checkRetry)
+	if err != nil {
+		h.Logger.Error("Failed To Parse RetryConfig From DeliverySpec - No Retries Will Occur", zap.Error(err))
+	} else {
+		h.Logger.Debug("Successfully Parsed RetryConfig From DeliverySpec", zap.Int("RetryMax", retryConfig.RetryMax))
+	}
+
+	// Return The Dead Letter Sink & RetryConfig
+	return deadLetterURL, retryConfig
 }
 
 // Consume A Single Message
@@ -131,18 +559,278 @@ func (h *Handler) consumeMessage(context context.Context, consumerMessage *saram
 		zap.Int64("Offset", consumerMessage.Offset))
 
 	// Convert The Sarama ConsumerMessage Into A CloudEvents Message
-	message := kafkasaramaprotocol.NewMessageFromConsumerMessage(consumerMessage)
-	if message.ReadEncoding() == binding.EncodingUnknown {
+	kafkaMessage := kafkasaramaprotocol.NewMessageFromConsumerMessage(consumerMessage)
+	if kafkaMessage.ReadEncoding() == binding.EncodingUnknown {
 		h.Logger.Warn("Received A Message With Unknown Encoding - Skipping")
-		return errors.New("received a message with unknown encoding - skipping")
+		return errUnknownEncoding
+	}
+
+	// Strip The CloudEvent Extensions Which The KafkaChannel Does Not Allow (Failing Messages Which Cannot Be Deserialized)
+	var message binding.Message = kafkaMessage
+	if h.ExtensionFilter != nil {
+		filteredMessage, err := h.filterExtensions(context, kafkaMessage)
+		if err != nil {
+			h.Logger.Warn("Failed To Convert Message To Event For Extension Filtering - Not Delivering To Subscriber", zap.Error(err))
+			filterError := fmt.Errorf("failed to convert message to event for extension filtering: %v", err)
+			return h.deadLetter(context, consumerMessage, kafkaMessage, nil, filterError, destinationURL, replyURL, deadLetterURL, retryConfig)
+		}
+		message = filteredMessage
+	}
+
+	// Add The Kafka Record Metadata As CloudEvent Extensions If Enabled (Delivering The Message Unaltered On Failure)
+	if h.KafkaExtensions != nil && h.KafkaExtensions() {
+		extendedMessage, err := addKafkaExtensions(context, message, consumerMessage)
+		if err != nil {
+			h.Logger.Warn("Failed To Add Kafka Extensions To Message - Delivering Without", zap.Error(err))
+		} else {
+			message = extendedMessage
+		}
+	}
+
+	// Drop Messages Which Do Not Pass The Subscriber's Filter (The Caller Still Marks Them So Their Offsets Are Committed)
+	if !h.passesFilter(context, message) {
+		return nil
+	}
+
+	// Mirror A Sample Of The Messages To Any Shadow Destination (Asynchronously - Never Delaying Or Failing The Primary Delivery)
+	h.shadowMessage(context, message)
+
+	// Wait For A Free In-Flight Delivery Slot (Blocking Consumption, And So Kafka Fetching, While At The Limit)
+	if h.InFlight != nil {
+		err := h.InFlight.acquire(context)
+		if err != nil {
+			h.Logger.Warn("Context Done While Waiting For An In-Flight Delivery Slot - Not Delivering Message", zap.Error(err))
+			return err
+		}
+		defer h.InFlight.release()
+	}
+
+	// Start A Child Span Of The Trace Propagated Via The Kafka Message (Unless Tracing Is Disabled)
+	ctx := context
+	if tracing.Enabled() {
+		var span *trace.Span
+		ctx, span = tracing.StartTraceFromMessage(h.Logger.Sugar(), context, kafkaMessage, consumerMessage.Topic)
+		defer span.End()
+	}
+
+	// Dispatch The Message With Configured Retries (Dead Letter Sink Handled Below To Include Failure Metadata)
+	dispatchInfo, dispatchError := h.MessageDispatcher.DispatchMessageWithRetries(ctx, message, nil, destinationURL, replyURL, nil, retryConfig)
+	if dispatchError == nil {
+		return nil
+	}
+	return h.deadLetter(ctx, consumerMessage, message, dispatchInfo, dispatchError, destinationURL, replyURL, deadLetterURL, retryConfig)
+}
+
+//
+// Send A Message Which Could Not Be Delivered To The Dead Letter Topic Or Sink (If Any)
+//
+// Returns the original error if there is neither a dead letter topic nor sink, so that the offset commit strategy
+// and ordering mode determine whether the message is redelivered, and otherwise any error dead-lettering it.
+//
+func (h *Handler) deadLetter(ctx context.Context, consumerMessage *sarama.ConsumerMessage, message binding.Message, dispatchInfo *channel.DispatchExecutionInfo, dispatchError error, destinationURL *url.URL, replyURL *url.URL, deadLetterURL *url.URL, retryConfig *kncloudevents.RetryConfig) error {
+	if deadLetterURL == nil && len(h.DeadLetterTopic) == 0 {
+		return dispatchError
+	}
+
+	// Produce The Failed Message To The Dead Letter Topic (Takes Precedence Over Any Dead Letter Sink) & Return Any Errors
+	if len(h.DeadLetterTopic) > 0 {
+		return h.produceToDeadLetterTopic(ctx, consumerMessage, message, dispatchInfo, dispatchError, destinationURL, replyURL)
+	}
+
+	// Send The Failed Message To The Dead Letter Sink & Return Any Errors
+	return h.dispatchToDeadLetterSink(ctx, message, dispatchInfo, dispatchError, destinationURL, replyURL, deadLetterURL, retryConfig)
+}
+
+// Remove The CloudEvent Extensions Not Allowed By The Handler's ExtensionFilter From The Message
+func (h *Handler) filterExtensions(ctx context.Context, message binding.Message) (binding.Message, error) {
+	cloudEvent, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	if removed := h.ExtensionFilter.Apply(cloudEvent); len(removed) > 0 {
+		h.Logger.Debug("Stripped Disallowed CloudEvent Extensions", zap.String("ID", cloudEvent.ID()), zap.Strings("Extensions", removed))
+	}
+	return binding.ToMessage(cloudEvent), nil
+}
+
+// Add The Kafka Record's Timestamp (If Known), Partition & Offset To The Message As CloudEvent Extensions
+func addKafkaExtensions(ctx context.Context, message binding.Message, consumerMessage *sarama.ConsumerMessage) (binding.Message, error) {
+	cloudEvent, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	if consumerMessage.Timestamp.Unix() > 0 {
+		err = cloudEvent.Context.SetExtension(KafkaTimestampExtension, consumerMessage.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = cloudEvent.Context.SetExtension(KafkaPartitionExtension, consumerMessage.Partition)
+	if err != nil {
+		return nil, err
+	}
+	err = cloudEvent.Context.SetExtension(KafkaOffsetExtension, strconv.FormatInt(consumerMessage.Offset, 10))
+	if err != nil {
+		return nil, err
+	}
+	return binding.ToMessage(cloudEvent), nil
+}
+
+//
+// Asynchronously Deliver A Copy Of The Message To The Subscriber's Shadow Destination (If Sampled)
+//
+// Shadow deliveries are strictly best effort, intended for validating a migration to a new subscriber against
+// real traffic.  They are sampled per the shadow's percent, are never retried, replied to or dead-lettered, and
+// their results are ignored.  Samples are skipped (rather than waiting) while the maximum number of concurrent
+// shadow deliveries are in-flight, so that a slow or failing shadow can never delay consumption of the primary.
+//
+func (h *Handler) shadowMessage(ctx context.Context, message binding.Message) {
+
+	// Nothing To Do Unless The Subscriber Has A Shadow & This Message Is Sampled
+	if h.Shadow == nil || h.shadowSlots == nil {
+		return
+	}
+	shadow := h.Shadow()
+	if shadow == nil || shadow.URI == nil || rand.Int31n(100) >= shadow.Percent {
+		return
+	}
+
+	// Claim A Shadow Delivery Slot Without Blocking (Skipping The Sample If None Are Free)
+	select {
+	case h.shadowSlots <- struct{}{}:
+	default:
+		h.Logger.Debug("Maximum Concurrent Shadow Deliveries In-Flight - Skipping Shadow Delivery")
+		return
 	}
 
-	ctx, span := tracing.StartTraceFromMessage(h.Logger.Sugar(), context, message, consumerMessage.Topic)
-	defer span.End()
+	// Copy The Message As An Event (The Primary Delivery Is Free To Consume The Original)
+	cloudEvent, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		<-h.shadowSlots
+		h.Logger.Debug("Failed To Convert Message To Event For Shadow Delivery - Skipping", zap.Error(err))
+		return
+	}
+	shadowEvent := cloudEvent.Clone()
+	shadowURL := shadow.URI.URL()
+
+	// Deliver The Copy To The Shadow Destination Asynchronously, Ignoring The Result
+	go func() {
+		defer func() { <-h.shadowSlots }()
+		shadowCtx, cancel := context.WithTimeout(context.Background(), shadowDeliveryTimeout)
+		defer cancel()
+		_, err := h.MessageDispatcher.DispatchMessage(shadowCtx, binding.ToMessage(&shadowEvent), nil, shadowURL, nil, nil)
+		if err != nil {
+			h.Logger.Debug("Failed To Deliver Message To Shadow - Ignoring", zap.String("Shadow", shadowURL.String()), zap.Error(err))
+		}
+	}()
+}
 
-	// Dispatch The Message With Configured Retries & Return Any Errors
-	_, dispatchError := h.MessageDispatcher.DispatchMessageWithRetries(ctx, message, nil, destinationURL, replyURL, deadLetterURL, retryConfig)
-	return dispatchError
+// Determine Whether The Message Passes The Subscriber's Filter (Messages Which Cannot Be Deserialized Are Dropped)
+func (h *Handler) passesFilter(ctx context.Context, message binding.Message) bool {
+	if h.Filter == nil || len(h.Filter.Attributes) == 0 {
+		return true
+	}
+	cloudEvent, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		h.Logger.Warn("Failed To Convert Message To Event For Filtering - Skipping", zap.Error(err))
+		return false
+	}
+	if !filterMatches(h.Filter, cloudEvent) {
+		h.Logger.Debug("Message Does Not Match Subscriber Filter - Skipping", zap.String("ID", cloudEvent.ID()), zap.String("Type", cloudEvent.Type()))
+		return false
+	}
+	return true
+}
+
+//
+// Add The Failure Metadata Extensions To A Message Which Failed Delivery
+//
+// The CloudEvent is augmented with the "knativeerrordest" (the URL to which delivery failed) and "knativeerrorcode"
+// (the last HTTP response status code) extensions so that consumers of the dead letter sink / topic can determine
+// why the event ended up there.  If the message cannot be converted to an event it is returned as-is (with the error).
+//
+func addFailureMetadata(ctx context.Context, message binding.Message, dispatchInfo *channel.DispatchExecutionInfo, destinationURL *url.URL, replyURL *url.URL) (binding.Message, int, error) {
+
+	// The Failed Destination Is The Subscriber Unless There Was None (In Which Case It Was The Reply)
+	errorDestinationURL := destinationURL
+	if errorDestinationURL == nil {
+		errorDestinationURL = replyURL
+	}
+
+	// Determine The Response Code Of The Failed Delivery (If Any)
+	errorCode := channel.NoResponse
+	if dispatchInfo != nil {
+		errorCode = dispatchInfo.ResponseCode
+	}
+
+	// Add The Failure Metadata Extensions To The Message
+	event, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		return message, errorCode, err
+	}
+	if errorDestinationURL != nil {
+		event.SetExtension(ErrorDestinationExtension, errorDestinationURL.String())
+	}
+	event.SetExtension(ErrorCodeExtension, strconv.Itoa(errorCode))
+	return binding.ToMessage(event), errorCode, nil
+}
+
+// Dispatch A Message Which Failed Delivery (With Failure Metadata) To The Dead Letter Sink
+func (h *Handler) dispatchToDeadLetterSink(ctx context.Context, message binding.Message, dispatchInfo *channel.DispatchExecutionInfo, dispatchError error, destinationURL *url.URL, replyURL *url.URL, deadLetterURL *url.URL, retryConfig *kncloudevents.RetryConfig) error {
+
+	// Add The Failure Metadata Extensions To The Dead Letter Message
+	deadLetterMessage, errorCode, err := addFailureMetadata(ctx, message, dispatchInfo, destinationURL, replyURL)
+	if err != nil {
+		h.Logger.Warn("Failed To Convert Message To Event - Sending To Dead Letter Sink Without Failure Metadata", zap.Error(err))
+	}
+
+	// Dispatch The Message To The Dead Letter Sink With Configured Retries
+	h.Logger.Info("Failed To Deliver Message - Sending To Dead Letter Sink", zap.String("DeadLetterSink", deadLetterURL.String()), zap.Int("ResponseCode", errorCode), zap.Error(dispatchError))
+	_, deadLetterError := h.MessageDispatcher.DispatchMessageWithRetries(ctx, deadLetterMessage, nil, deadLetterURL, nil, nil, retryConfig)
+	if deadLetterError != nil {
+		return fmt.Errorf("failed to deliver message (%v) and failed to send it to the dead letter sink %s (%v)", dispatchError, deadLetterURL, deadLetterError)
+	}
+	return nil
+}
+
+//
+// Produce A Message Which Failed Delivery (With Failure Metadata) To The Dead Letter Topic
+//
+// The message is written with the same failure metadata extensions as when dispatching to a dead letter sink (as
+// "ce_" headers in binary content mode) and retains the original Kafka record's key, so that the dead letter topic
+// preserves the partitioning of the KafkaChannel's topic.  The Sarama SyncProducer handles any produce retries.
+//
+func (h *Handler) produceToDeadLetterTopic(ctx context.Context, consumerMessage *sarama.ConsumerMessage, message binding.Message, dispatchInfo *channel.DispatchExecutionInfo, dispatchError error, destinationURL *url.URL, replyURL *url.URL) error {
+
+	// Add The Failure Metadata Extensions To The Dead Letter Message
+	deadLetterMessage, errorCode, err := addFailureMetadata(ctx, message, dispatchInfo, destinationURL, replyURL)
+	if err != nil {
+		h.Logger.Warn("Failed To Convert Message To Event - Producing To Dead Letter Topic Without Failure Metadata", zap.Error(err))
+	}
+
+	// Convert The Dead Letter Message Into A Sarama ProducerMessage (Retaining The Original Key)
+	producerMessage := &sarama.ProducerMessage{Topic: h.DeadLetterTopic}
+	if consumerMessage.Key != nil {
+		producerMessage.Key = sarama.ByteEncoder(consumerMessage.Key)
+	}
+	err = kafkasaramaprotocol.WriteProducerMessage(kafkasaramaprotocol.WithSkipKeyMapping(ctx), deadLetterMessage, producerMessage)
+	if err != nil {
+		return fmt.Errorf("failed to deliver message (%v) and failed to produce it to the dead letter topic %s (%v)", dispatchError, h.DeadLetterTopic, err)
+	}
+
+	// Get The (Lazily Created) SyncProducer
+	producer, err := h.DeadLetterProducer()
+	if err != nil {
+		return fmt.Errorf("failed to deliver message (%v) and failed to produce it to the dead letter topic %s (%v)", dispatchError, h.DeadLetterTopic, err)
+	}
+
+	// Produce The Message To The Dead Letter Topic
+	h.Logger.Info("Failed To Deliver Message - Producing To Dead Letter Topic", zap.String("DeadLetterTopic", h.DeadLetterTopic), zap.Int("ResponseCode", errorCode), zap.Error(dispatchError))
+	_, _, err = producer.SendMessage(producerMessage)
+	if err != nil {
+		return fmt.Errorf("failed to deliver message (%v) and failed to produce it to the dead letter topic %s (%v)", dispatchError, h.DeadLetterTopic, err)
+	}
+	return nil
 }
 
 //
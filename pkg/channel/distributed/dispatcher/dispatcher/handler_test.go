@@ -18,20 +18,33 @@ package dispatcher
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/Shopify/sarama"
 	kafkasaramaprotocol "github.com/cloudevents/sdk-go/protocol/kafka_sarama/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
+	cloudevents "github.com/cloudevents/sdk-go/v2/event"
+	cetypes "github.com/cloudevents/sdk-go/v2/types"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	dispatchertesting "knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/testing"
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/channel"
 	"knative.dev/eventing/pkg/kncloudevents"
 	"knative.dev/pkg/apis"
@@ -42,9 +55,11 @@ import (
 // Test Data
 const (
 	testSubscriberUID        = types.UID("123")
+	testDrainTimeout         = 5 * time.Second
 	testSubscriberURIString  = "https://www.foo.bar/test/path"
 	testReplyURIString       = "https://www.something.com/"
 	testDeadLetterURIString  = "https://www.made.up/url"
+	testChannelDLSURIString  = "https://www.made.up/channel"
 	testTopic                = "TestTopic"
 	testPartition            = 0
 	testOffset               = 1
@@ -65,6 +80,7 @@ var (
 	testBackoffPolicy    = eventingduck.BackoffPolicyExponential
 	testBackoffDelay     = "PT1S"
 	testDeadLetterURI, _ = apis.ParseURL(testDeadLetterURIString)
+	testChannelDLSURI, _ = apis.ParseURL(testChannelDLSURIString)
 	testMsgTime          = time.Now().UTC().Format(time.RFC3339)
 )
 
@@ -76,13 +92,17 @@ func TestNewHandler(t *testing.T) {
 // Test The Handler's Setup() Functionality
 func TestHandlerSetup(t *testing.T) {
 	handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+	assert.False(t, handler.Joined())
 	assert.Nil(t, handler.Setup(nil))
+	assert.True(t, handler.Joined())
 }
 
 // Test The Handler's Cleanup() Functionality
 func TestHandlerCleanup(t *testing.T) {
 	handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+	assert.Nil(t, handler.Setup(nil))
 	assert.Nil(t, handler.Cleanup(nil))
+	assert.False(t, handler.Joined())
 }
 
 // Test The Handler's ConsumeClaim() Functionality
@@ -90,12 +110,14 @@ func TestHandlerConsumeClaim(t *testing.T) {
 
 	// Define The TestCase Type
 	type TestCase struct {
-		only           bool
-		name           string
-		destinationUri *apis.URL
-		replyUri       *apis.URL
-		deadLetterUri  *apis.URL
-		retry          bool
+		only                 bool
+		name                 string
+		destinationUri       *apis.URL
+		replyUri             *apis.URL
+		deadLetterUri        *apis.URL
+		channelDeadLetterUri *apis.URL
+		retry                bool
+		dispatchErr          error
 	}
 
 	// Define The TestCases
@@ -136,6 +158,43 @@ func TestHandlerConsumeClaim(t *testing.T) {
 			name:  "Empty Subscriber Configuration",
 			retry: false,
 		},
+		{
+			name:           "Failed Delivery To Subscriber Dead Letter Sink",
+			destinationUri: testSubscriberURI,
+			replyUri:       testReplyURI,
+			deadLetterUri:  testDeadLetterURI,
+			retry:          true,
+			dispatchErr:    errors.New("test dispatch error"),
+		},
+		{
+			name:                 "Failed Delivery To Channel Dead Letter Sink",
+			destinationUri:       testSubscriberURI,
+			replyUri:             testReplyURI,
+			channelDeadLetterUri: testChannelDLSURI,
+			retry:                true,
+			dispatchErr:          errors.New("test dispatch error"),
+		},
+		{
+			name:                 "Failed Delivery Prefers Subscriber Dead Letter Sink",
+			destinationUri:       testSubscriberURI,
+			deadLetterUri:        testDeadLetterURI,
+			channelDeadLetterUri: testChannelDLSURI,
+			retry:                true,
+			dispatchErr:          errors.New("test dispatch error"),
+		},
+		{
+			name:                 "Failed Reply Delivery To Channel Dead Letter Sink",
+			replyUri:             testReplyURI,
+			channelDeadLetterUri: testChannelDLSURI,
+			dispatchErr:          errors.New("test dispatch error"),
+		},
+		{
+			name:           "Failed Delivery Without Dead Letter Sink",
+			destinationUri: testSubscriberURI,
+			replyUri:       testReplyURI,
+			retry:          true,
+			dispatchErr:    errors.New("test dispatch error"),
+		},
 	}
 
 	// Filter To Those With "only" Flag (If Any Specified)
@@ -152,13 +211,13 @@ func TestHandlerConsumeClaim(t *testing.T) {
 	// Execute The Individual Test Cases
 	for _, testCase := range filteredTestCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			performHandlerConsumeClaimTest(t, testCase.destinationUri, testCase.replyUri, testCase.deadLetterUri, testCase.retry)
+			performHandlerConsumeClaimTest(t, testCase.destinationUri, testCase.replyUri, testCase.deadLetterUri, testCase.channelDeadLetterUri, testCase.retry, testCase.dispatchErr)
 		})
 	}
 }
 
 // Test One Permutation Of The Handler's ConsumeClaim() Functionality
-func performHandlerConsumeClaimTest(t *testing.T, destinationUri, replyUri, deadLetterUri *apis.URL, retry bool) {
+func performHandlerConsumeClaimTest(t *testing.T, destinationUri, replyUri, deadLetterUri, channelDeadLetterUri *apis.URL, retry bool, dispatchErr error) {
 
 	// Initialize Destination As Specified
 	var destinationUrl *url.URL
@@ -172,10 +231,12 @@ func performHandlerConsumeClaimTest(t *testing.T, destinationUri, replyUri, dead
 		replyUrl = replyUri.URL()
 	}
 
-	// Initialize DeadLetter As Specified
+	// Initialize DeadLetter As Specified (The Subscriber's Takes Precedence Over The Channel's)
 	var deadLetterUrl *url.URL
 	if deadLetterUri != nil {
 		deadLetterUrl = deadLetterUri.URL()
+	} else if channelDeadLetterUri != nil {
+		deadLetterUrl = channelDeadLetterUri.URL()
 	}
 
 	// Create The Specified DeliverySpec
@@ -193,7 +254,7 @@ func performHandlerConsumeClaimTest(t *testing.T, destinationUri, replyUri, dead
 	// Create Mocks For Testing
 	mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
 	mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
-	mockMessageDispatcher := dispatchertesting.NewMockMessageDispatcher(t, nil, destinationUrl, replyUrl, deadLetterUrl, &retryConfig, nil)
+	mockMessageDispatcher := dispatchertesting.NewMockMessageDispatcher(t, nil, destinationUrl, replyUrl, deadLetterUrl, &retryConfig, dispatchErr)
 
 	// Mock The newMessageDispatcherWrapper Function (And Restore Post-Test)
 	newMessageDispatcherWrapperPlaceholder := newMessageDispatcherWrapper
@@ -204,6 +265,12 @@ func performHandlerConsumeClaimTest(t *testing.T, destinationUri, replyUri, dead
 
 	// Create The Handler To Test
 	handler := createTestHandler(t, destinationUri, replyUri, &deliverySpec)
+	handler.ChannelDeadLetterURL = func() *url.URL {
+		if channelDeadLetterUri == nil {
+			return nil
+		}
+		return channelDeadLetterUri.URL()
+	}
 
 	// Background Start Consuming Claims
 	go func() {
@@ -225,6 +292,898 @@ func performHandlerConsumeClaimTest(t *testing.T, destinationUri, replyUri, dead
 	assert.Equal(t, consumerMessage, markedMessage)
 	assert.NotNil(t, mockMessageDispatcher.Message())
 	verifyDispatchedMessage(t, mockMessageDispatcher.Message())
+
+	// Verify Failed Deliveries Were Sent To The Dead Letter Sink (If Any) With Failure Metadata
+	if dispatchErr == nil || deadLetterUrl == nil {
+		assert.Nil(t, mockMessageDispatcher.DeadLetterMessage())
+	} else {
+		assert.NotNil(t, mockMessageDispatcher.DeadLetterMessage())
+		verifyDispatchedMessage(t, mockMessageDispatcher.DeadLetterMessage())
+		errorDestinationUrl := destinationUrl
+		if errorDestinationUrl == nil {
+			errorDestinationUrl = replyUrl
+		}
+		deadLetterEvent, err := binding.ToEvent(context.TODO(), mockMessageDispatcher.DeadLetterMessage())
+		assert.Nil(t, err)
+		errorDestination, err := deadLetterEvent.Context.GetExtension(ErrorDestinationExtension)
+		assert.Nil(t, err)
+		assert.Equal(t, errorDestinationUrl.String(), errorDestination)
+		errorCode, err := deadLetterEvent.Context.GetExtension(ErrorCodeExtension)
+		assert.Nil(t, err)
+		assert.Equal(t, "500", errorCode)
+	}
+}
+
+// Test The Handler's ConsumeClaim() Retries Failed Deliveries Per The DeliverySpec Before Marking The Message
+func TestHandlerConsumeClaimRetriesExhausted(t *testing.T) {
+
+	// Test Data
+	retry := int32(2)
+	linear := eventingduck.BackoffPolicyLinear
+	exponential := eventingduck.BackoffPolicyExponential
+	delay := "PT0.01S"
+
+	// Define The TestCase Type
+	type TestCase struct {
+		only               bool
+		name               string
+		subscriberDelivery *eventingduck.DeliverySpec
+		channelDelivery    *eventingduck.DeliverySpec
+		deadLetterSink     bool
+		expectedAttempts   int32
+	}
+
+	// Define The TestCases
+	testCases := []TestCase{
+		{
+			name:               "Subscriber Linear Backoff",
+			subscriberDelivery: &eventingduck.DeliverySpec{Retry: &retry, BackoffPolicy: &linear, BackoffDelay: &delay},
+			expectedAttempts:   3,
+		},
+		{
+			name:             "Channel Exponential Backoff",
+			channelDelivery:  &eventingduck.DeliverySpec{Retry: &retry, BackoffPolicy: &exponential, BackoffDelay: &delay},
+			expectedAttempts: 3,
+		},
+		{
+			name:               "Subscriber Overrides Channel",
+			subscriberDelivery: &eventingduck.DeliverySpec{Retry: &retry, BackoffPolicy: &linear, BackoffDelay: &delay},
+			channelDelivery:    &eventingduck.DeliverySpec{BackoffPolicy: &exponential, BackoffDelay: &delay},
+			expectedAttempts:   3,
+		},
+		{
+			name:             "Channel Retries With Dead Letter Sink",
+			channelDelivery:  &eventingduck.DeliverySpec{Retry: &retry, BackoffPolicy: &exponential, BackoffDelay: &delay},
+			deadLetterSink:   true,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "No Retries",
+			expectedAttempts: 1,
+		},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Execute The Individual Test Cases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Subscriber Server Which Always Fails & A Dead Letter Sink Server Which Always Succeeds
+			var subscriberAttempts, deadLetterAttempts int32
+			subscriberServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+				atomic.AddInt32(&subscriberAttempts, 1)
+				writer.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer subscriberServer.Close()
+			deadLetterServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+				atomic.AddInt32(&deadLetterAttempts, 1)
+				writer.WriteHeader(http.StatusAccepted)
+			}))
+			defer deadLetterServer.Close()
+			subscriberUri, err := apis.ParseURL(subscriberServer.URL)
+			assert.Nil(t, err)
+
+			// Create The Handler To Test (With The Real Knative MessageDispatcher)
+			handler := createTestHandler(t, subscriberUri, nil, testCase.subscriberDelivery)
+			handler.ChannelDelivery = func() *eventingduck.DeliverySpec { return testCase.channelDelivery }
+			handler.ChannelDeadLetterURL = func() *url.URL {
+				if !testCase.deadLetterSink {
+					return nil
+				}
+				deadLetterUrl, _ := url.Parse(deadLetterServer.URL)
+				return deadLetterUrl
+			}
+
+			// Background Start Consuming Claims
+			mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+			mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+			go func() {
+				err := handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim)
+				assert.Nil(t, err)
+			}()
+
+			// Perform The Test & Wait For The Message To Be Marked (Offset Committed After Retries Are Exhausted)
+			consumerMessage := createConsumerMessage(t)
+			mockConsumerGroupClaim.MessageChan <- consumerMessage
+			markedMessage := <-mockConsumerGroupSession.MarkMessageChan
+			close(mockConsumerGroupClaim.MessageChan)
+
+			// Verify The Results
+			assert.Equal(t, consumerMessage, markedMessage)
+			assert.Equal(t, testCase.expectedAttempts, atomic.LoadInt32(&subscriberAttempts))
+			if testCase.deadLetterSink {
+				assert.Equal(t, int32(1), atomic.LoadInt32(&deadLetterAttempts))
+			} else {
+				assert.Equal(t, int32(0), atomic.LoadInt32(&deadLetterAttempts))
+			}
+		})
+	}
+}
+
+// Test The Handler's consumeMessage() Produces Failed Deliveries To The Dead Letter Topic (Instead Of The Dead Letter Sink)
+func TestHandlerConsumeMessageDeadLetterTopic(t *testing.T) {
+
+	// Test Data
+	testDeadLetterTopic := "test-dead-letter-topic"
+	testKey := []byte("TestKey")
+	produceErr := errors.New("test produce error")
+
+	// Define The TestCase Type
+	type TestCase struct {
+		name          string
+		dispatchErr   error
+		producerErr   error
+		produceErr    error
+		expectProduce bool
+		expectErr     bool
+	}
+
+	// Define The TestCases
+	testCases := []TestCase{
+		{
+			name: "Delivered",
+		},
+		{
+			name:          "Failed Delivery Produced",
+			dispatchErr:   errors.New("test dispatch error"),
+			expectProduce: true,
+		},
+		{
+			name:        "Producer Creation Failure",
+			dispatchErr: errors.New("test dispatch error"),
+			producerErr: errors.New("test producer error"),
+			expectErr:   true,
+		},
+		{
+			name:        "Produce Failure",
+			dispatchErr: errors.New("test dispatch error"),
+			produceErr:  produceErr,
+			expectErr:   true,
+		},
+	}
+
+	// Execute The Individual Test Cases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The Handler To Test With A Dead Letter Topic (And A Dead Letter Sink Which Should Be Ignored)
+			mockMessageDispatcher := dispatchertesting.NewMockMessageDispatcher(t, nil, testSubscriberURI.URL(), testReplyURI.URL(), nil, &kncloudevents.RetryConfig{}, testCase.dispatchErr)
+			mockSyncProducer := dispatchertesting.NewMockSyncProducer(testCase.produceErr)
+			handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+			handler.MessageDispatcher = mockMessageDispatcher
+			handler.DeadLetterTopic = testDeadLetterTopic
+			handler.DeadLetterProducer = func() (sarama.SyncProducer, error) {
+				if testCase.producerErr != nil {
+					return nil, testCase.producerErr
+				}
+				return mockSyncProducer, nil
+			}
+
+			// Perform The Test
+			consumerMessage := createConsumerMessage(t)
+			consumerMessage.Key = testKey
+			err := handler.consumeMessage(context.TODO(), consumerMessage, testSubscriberURI.URL(), testReplyURI.URL(), testDeadLetterURI.URL(), &kncloudevents.RetryConfig{})
+
+			// Verify The Results
+			assert.Nil(t, mockMessageDispatcher.DeadLetterMessage())
+			assert.Equal(t, testCase.expectErr, err != nil)
+			if testCase.expectErr {
+				assert.Contains(t, err.Error(), testDeadLetterTopic)
+			}
+			if testCase.expectProduce {
+				producerMessage := mockSyncProducer.GetMessage()
+				assert.Equal(t, testDeadLetterTopic, producerMessage.Topic)
+				assert.Equal(t, sarama.ByteEncoder(testKey), producerMessage.Key)
+				headers := make(map[string]string)
+				for _, header := range producerMessage.Headers {
+					headers[string(header.Key)] = string(header.Value)
+				}
+				assert.Equal(t, testMsgId, headers["ce_id"])
+				assert.Equal(t, testSubscriberURIString, headers["ce_"+ErrorDestinationExtension])
+				assert.Equal(t, "500", headers["ce_"+ErrorCodeExtension])
+			}
+		})
+	}
+}
+
+// Test The Handler's ConsumeClaim() Stops Consuming (Buffered) Messages Once The Session Has Ended
+func TestHandlerConsumeClaimSessionEnded(t *testing.T) {
+
+	// Create A Mock Session Whose Context Has Already Ended & A Claim With A Buffered Message
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSessionWithContext(t, ctx)
+	mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+	mockConsumerGroupClaim.MessageChan = make(chan *sarama.ConsumerMessage, 1)
+	mockConsumerGroupClaim.MessageChan <- createConsumerMessage(t)
+
+	// Perform The Test (Would Block On MarkMessageChan If The Message Were Consumed)
+	handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+	err := handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim)
+
+	// Verify The Results (Returning At All Means The Message Was Not Marked)
+	assert.Nil(t, err)
+}
+
+// Test The Handler's ConsumeClaim() With Concurrency Delivers Keyed Messages In Order & Marks All Messages In Offset Order
+func TestHandlerConsumeClaimConcurrency(t *testing.T) {
+
+	// Test Data (Later Messages Are Delivered Faster Than Earlier Ones To Force Out-Of-Order Completion)
+	const messageCount = 40
+	keys := []string{"key-a", "key-b", "key-c", ""}
+	mockMessageDispatcher := &concurrentMessageDispatcher{delay: func(id string) time.Duration {
+		offset, _ := strconv.Atoi(id)
+		return time.Duration((messageCount-offset)%5) * time.Millisecond
+	}}
+
+	// Create The Handler To Test With Multiple Workers
+	handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+	handler.MessageDispatcher = mockMessageDispatcher
+	handler.Concurrency = 4
+
+	// Create Mocks For Testing With All Messages Buffered In The Claim
+	mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+	mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+	mockConsumerGroupClaim.MessageChan = make(chan *sarama.ConsumerMessage, messageCount)
+	for offset := 0; offset < messageCount; offset++ {
+		mockConsumerGroupClaim.MessageChan <- createKeyedConsumerMessage(t, int64(offset), keys[offset%len(keys)])
+	}
+
+	// Perform The Test
+	errChan := make(chan error, 1)
+	go func() { errChan <- handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim) }()
+
+	// Verify Every Message Is Marked, In Offset Order
+	for offset := 0; offset < messageCount; offset++ {
+		select {
+		case markedMessage := <-mockConsumerGroupSession.MarkMessageChan:
+			assert.Equal(t, int64(offset), markedMessage.Offset)
+		case <-time.After(5 * time.Second):
+			assert.FailNow(t, "Timed Out Waiting For Message To Be Marked", "Offset %d", offset)
+		}
+	}
+	close(mockConsumerGroupClaim.MessageChan)
+	assert.Nil(t, <-errChan)
+
+	// Verify Every Message Was Dispatched Once & Messages With The Same Key Were Dispatched In Offset Order
+	dispatched := mockMessageDispatcher.Dispatched()
+	assert.Len(t, dispatched, messageCount)
+	lastOffsets := make(map[string]int)
+	for _, id := range dispatched {
+		offset, err := strconv.Atoi(id)
+		assert.Nil(t, err)
+		key := keys[offset%len(keys)]
+		if len(key) == 0 {
+			continue // Messages Without A Key Have No Ordering Guarantee
+		}
+		if lastOffset, ok := lastOffsets[key]; ok {
+			assert.Greater(t, offset, lastOffset)
+		}
+		lastOffsets[key] = offset
+	}
+}
+
+// Benchmark The Handler's ConsumeClaim() Throughput Against A Slow Subscriber With & Without Concurrency
+func BenchmarkHandlerConsumeClaimConcurrency(b *testing.B) {
+	for _, concurrency := range []int{1, 8} {
+		b.Run(fmt.Sprintf("Concurrency-%d", concurrency), func(b *testing.B) {
+
+			// Create The Handler To Test Against A Subscriber Which Takes A Millisecond Per Message
+			subscriber := &eventingduck.SubscriberSpec{UID: testSubscriberUID, SubscriberURI: testSubscriberURI}
+			handler := NewHandler(zap.NewNop(), subscriber, testDrainTimeout, nil, nil)
+			handler.MessageDispatcher = &concurrentMessageDispatcher{delay: func(string) time.Duration { return time.Millisecond }}
+			handler.Concurrency = concurrency
+
+			// Create Mocks For Testing & Distinctly Keyed Messages
+			mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(b)
+			mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(b)
+			messages := make([]*sarama.ConsumerMessage, b.N)
+			for index := range messages {
+				messages[index] = createKeyedConsumerMessage(b, int64(index), strconv.Itoa(index))
+			}
+
+			// Perform The Benchmark (Until Every Message Has Been Marked)
+			b.ResetTimer()
+			go func() {
+				for _, message := range messages {
+					mockConsumerGroupClaim.MessageChan <- message
+				}
+			}()
+			errChan := make(chan error, 1)
+			go func() { errChan <- handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim) }()
+			for index := 0; index < b.N; index++ {
+				<-mockConsumerGroupSession.MarkMessageChan
+			}
+			b.StopTimer()
+			close(mockConsumerGroupClaim.MessageChan)
+			<-errChan
+		})
+	}
+}
+
+// Test The Handler's ConsumeClaim() Marks & Commits Offsets Per The Offset Commit Strategy
+func TestHandlerConsumeClaimOffsetCommit(t *testing.T) {
+
+	// Test Data (The Delivery Of The Message At Offset 2 Fails)
+	const messageCount = 5
+	const failedOffset = 2
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name           string
+		concurrency    int
+		ordering       string
+		manualCommit   bool
+		expectedMarked []int64
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Auto", concurrency: 1, manualCommit: false, expectedMarked: []int64{0, 1, 2, 3, 4}},
+		{name: "Auto Concurrent", concurrency: 4, manualCommit: false, expectedMarked: []int64{0, 1, 2, 3, 4}},
+		{name: "Auto Unordered", concurrency: 1, ordering: constants.SubscriberOrderingUnordered, manualCommit: false, expectedMarked: []int64{0, 1, 2, 3, 4}},
+		{name: "Auto Ordered", concurrency: 4, ordering: constants.SubscriberOrderingOrdered, manualCommit: false, expectedMarked: []int64{0, 1}},
+		{name: "Manual After Ack", concurrency: 1, manualCommit: true, expectedMarked: []int64{0, 1}},
+		{name: "Manual After Ack Concurrent", concurrency: 4, manualCommit: true, expectedMarked: []int64{0, 1}},
+		{name: "Manual After Ack Ordered", concurrency: 1, ordering: constants.SubscriberOrderingOrdered, manualCommit: true, expectedMarked: []int64{0, 1}},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The Handler To Test
+			handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+			handler.MessageDispatcher = &concurrentMessageDispatcher{fail: func(id string) bool { return id == strconv.Itoa(failedOffset) }}
+			handler.Concurrency = testCase.concurrency
+			handler.Ordering = testCase.ordering
+			handler.ManualCommit = testCase.manualCommit
+
+			// Create Mocks For Testing With All Messages Buffered In The Claim
+			mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+			mockConsumerGroupSession.MarkMessageChan = make(chan *sarama.ConsumerMessage, messageCount)
+			mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+			mockConsumerGroupClaim.MessageChan = make(chan *sarama.ConsumerMessage, messageCount)
+			for offset := 0; offset < messageCount; offset++ {
+				mockConsumerGroupClaim.MessageChan <- createKeyedConsumerMessage(t, int64(offset), "")
+			}
+
+			// Perform The Test ("auto" Consumes Every Message Until The Claim Closes, "manual-after-ack" & "ordered" End On The Failure)
+			blocking := testCase.manualCommit || testCase.ordering == constants.SubscriberOrderingOrdered
+			if !blocking {
+				close(mockConsumerGroupClaim.MessageChan)
+			}
+			err := handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim)
+
+			// Verify The Results
+			close(mockConsumerGroupSession.MarkMessageChan)
+			markedOffsets := make([]int64, 0)
+			for markedMessage := range mockConsumerGroupSession.MarkMessageChan {
+				markedOffsets = append(markedOffsets, markedMessage.Offset)
+			}
+			assert.Equal(t, testCase.expectedMarked, markedOffsets)
+			if testCase.manualCommit {
+				assert.NotNil(t, err)
+				assert.GreaterOrEqual(t, mockConsumerGroupSession.CommitCount(), 1)
+			} else if blocking {
+				assert.NotNil(t, err)
+				assert.Equal(t, 0, mockConsumerGroupSession.CommitCount())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, 0, mockConsumerGroupSession.CommitCount())
+			}
+		})
+	}
+}
+
+// Test The Handler's ConsumeClaim() In "ordered" Mode Never Delivers Or Marks Past An Undelivered Event
+func TestHandlerConsumeClaimOrdered(t *testing.T) {
+
+	// Test Data (The Delivery Of The Message At Offset 2 Fails, Even To The Dead Letter Sink)
+	const messageCount = 5
+	const failedOffset = 2
+
+	// Create The Handler To Test (Concurrency Is Ignored In "ordered" Mode)
+	mockMessageDispatcher := &concurrentMessageDispatcher{fail: func(id string) bool { return id == strconv.Itoa(failedOffset) }}
+	handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+	handler.MessageDispatcher = mockMessageDispatcher
+	handler.Concurrency = 4
+	handler.Ordering = constants.SubscriberOrderingOrdered
+	assert.Equal(t, 1, handler.concurrency())
+
+	// Create Mocks For Testing With All Messages Buffered In The Claim
+	mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+	mockConsumerGroupSession.MarkMessageChan = make(chan *sarama.ConsumerMessage, messageCount)
+	mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+	mockConsumerGroupClaim.MessageChan = make(chan *sarama.ConsumerMessage, messageCount)
+	for offset := 0; offset < messageCount; offset++ {
+		mockConsumerGroupClaim.MessageChan <- createKeyedConsumerMessage(t, int64(offset), "")
+	}
+
+	// Perform The Test
+	err := handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim)
+
+	// Verify The Session Ended For Redelivery Without Delivering Any Later Message
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"0", "1", "2"}, mockMessageDispatcher.Dispatched())
+
+	// Verify No Offset At Or Beyond The Undelivered Event Was Marked
+	close(mockConsumerGroupSession.MarkMessageChan)
+	for markedMessage := range mockConsumerGroupSession.MarkMessageChan {
+		assert.Less(t, markedMessage.Offset, int64(failedOffset))
+	}
+}
+
+// Test The Handler's concurrency() Per The Concurrency & Ordering Mode
+func TestHandlerConcurrency(t *testing.T) {
+	handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+	for _, testCase := range []struct {
+		concurrency int
+		ordering    string
+		expected    int
+	}{
+		{concurrency: 0, ordering: "", expected: 0},
+		{concurrency: 4, ordering: "", expected: 4},
+		{concurrency: 4, ordering: constants.SubscriberOrderingOrdered, expected: 1},
+		{concurrency: 0, ordering: constants.SubscriberOrderingUnordered, expected: constants.DefaultUnorderedSubscriberConcurrency},
+		{concurrency: 16, ordering: constants.SubscriberOrderingUnordered, expected: 16},
+	} {
+		handler.Concurrency = testCase.concurrency
+		handler.Ordering = testCase.ordering
+		assert.Equal(t, testCase.expected, handler.concurrency())
+	}
+}
+
+// Test The offsetTracker Only Commits Manually Once Per CommitInterval
+func TestOffsetTrackerCommitInterval(t *testing.T) {
+
+	// Create An offsetTracker With A Long CommitInterval
+	mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+	mockConsumerGroupSession.MarkMessageChan = make(chan *sarama.ConsumerMessage, 3)
+	handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+	handler.ManualCommit = true
+	handler.CommitInterval = time.Hour
+	tracker := handler.newOffsetTracker(mockConsumerGroupSession)
+
+	// Verify Acknowledged Messages Are Marked But Not Committed Within The Interval
+	for offset := int64(0); offset < 2; offset++ {
+		message := createKeyedConsumerMessage(t, offset, "")
+		tracker.track(message)
+		assert.True(t, tracker.complete(message, nil))
+	}
+	assert.Len(t, mockConsumerGroupSession.MarkMessageChan, 2)
+	assert.Equal(t, 0, mockConsumerGroupSession.CommitCount())
+
+	// Verify A Message Which Can Never Be Delivered Is Acknowledged & Committed Once The Interval Has Elapsed
+	tracker.store.(*kafkaOffsetStore).lastCommit = time.Now().Add(-time.Hour)
+	message := createKeyedConsumerMessage(t, 2, "")
+	tracker.track(message)
+	assert.True(t, tracker.complete(message, errUnknownEncoding))
+	assert.Len(t, mockConsumerGroupSession.MarkMessageChan, 3)
+	assert.Equal(t, 1, mockConsumerGroupSession.CommitCount())
+}
+
+// Test The Handler's Capped Exponential Backoff Before Re-Joining After Failed Sessions (Reset By A Commit)
+func TestHandlerRejoinBackoff(t *testing.T) {
+
+	// Verify There Is No Backoff Before Any Failure
+	handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+	assert.Equal(t, time.Duration(0), handler.rejoinBackoff())
+
+	// Verify The Backoff Doubles With Each Consecutive Failure (Redelivery Or Consume Error) Up To The Maximum
+	message := createKeyedConsumerMessage(t, 0, "")
+	assert.NotNil(t, handler.redeliveryError(message, errors.New("test error")))
+	assert.Equal(t, minRejoinBackoff, handler.rejoinBackoff())
+	handler.recordFailure()
+	assert.Equal(t, 2*minRejoinBackoff, handler.rejoinBackoff())
+	for i := 0; i < 32; i++ {
+		handler.recordFailure()
+	}
+	assert.Equal(t, maxRejoinBackoff, handler.rejoinBackoff())
+
+	// Verify A Successful Commit Resets The Backoff
+	mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+	mockConsumerGroupSession.MarkMessageChan = make(chan *sarama.ConsumerMessage, 1)
+	tracker := handler.newOffsetTracker(mockConsumerGroupSession)
+	tracker.track(message)
+	assert.True(t, tracker.complete(message, nil))
+	assert.Equal(t, time.Duration(0), handler.rejoinBackoff())
+}
+
+// Test The Handler's ConsumeClaim() Drops Messages Not Matching The Subscriber's Filter While Still Marking Them
+func TestHandlerConsumeClaimFilter(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name           string
+		filter         *eventingv1.TriggerFilter
+		expectDispatch bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Filter", filter: nil, expectDispatch: true},
+		{name: "Matching Filter", filter: &eventingv1.TriggerFilter{Attributes: eventingv1.TriggerFilterAttributes{"type": testMsgType, "source": ""}}, expectDispatch: true},
+		{name: "Non-Matching Filter", filter: &eventingv1.TriggerFilter{Attributes: eventingv1.TriggerFilterAttributes{"type": "OtherType"}}, expectDispatch: false},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The Handler To Test With The Filter & A Recording MessageDispatcher
+			mockMessageDispatcher := &concurrentMessageDispatcher{}
+			handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+			handler.MessageDispatcher = mockMessageDispatcher
+			handler.Filter = testCase.filter
+
+			// Create Mocks For Testing
+			mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+			mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+
+			// Perform The Test
+			errChan := make(chan error, 1)
+			go func() { errChan <- handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim) }()
+			consumerMessage := createConsumerMessage(t)
+			mockConsumerGroupClaim.MessageChan <- consumerMessage
+			markedMessage := <-mockConsumerGroupSession.MarkMessageChan
+			close(mockConsumerGroupClaim.MessageChan)
+
+			// Verify The Results (The Message Is Always Marked, But Only Dispatched If It Passes The Filter)
+			assert.Nil(t, <-errChan)
+			assert.Equal(t, consumerMessage, markedMessage)
+			if testCase.expectDispatch {
+				assert.Equal(t, []string{testMsgId}, mockMessageDispatcher.Dispatched())
+			} else {
+				assert.Empty(t, mockMessageDispatcher.Dispatched())
+			}
+		})
+	}
+}
+
+// Test The Handler's ConsumeClaim() Adds The Kafka Record Metadata As (Round-Trippable) CloudEvent Extensions When Enabled
+func TestHandlerConsumeClaimKafkaExtensions(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("Enabled-%t", enabled), func(t *testing.T) {
+
+			// Create The Handler To Test With A Recording MessageDispatcher
+			mockMessageDispatcher := &concurrentMessageDispatcher{}
+			handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+			handler.MessageDispatcher = mockMessageDispatcher
+			handler.KafkaExtensions = func() bool { return enabled }
+
+			// Create Mocks For Testing
+			mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+			mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+
+			// Perform The Test
+			errChan := make(chan error, 1)
+			go func() { errChan <- handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim) }()
+			consumerMessage := createConsumerMessage(t)
+			consumerMessage.Partition = 3
+			consumerMessage.Offset = math.MaxInt32 + 1 // Exceeds The CloudEvent Integer Type
+			mockConsumerGroupClaim.MessageChan <- consumerMessage
+			<-mockConsumerGroupSession.MarkMessageChan
+			close(mockConsumerGroupClaim.MessageChan)
+			assert.Nil(t, <-errChan)
+
+			// Verify The Dispatched Event Still Contains The Original Contents
+			dispatchedEvents := mockMessageDispatcher.Events()
+			assert.Len(t, dispatchedEvents, 1)
+			assert.Equal(t, testMsgId, dispatchedEvents[0].ID())
+			assert.Equal(t, testMsgJsonContentString, string(dispatchedEvents[0].Data()))
+
+			// Verify The Extensions Survive A Serialization Round-Trip With Valid CloudEvent Types
+			eventJson, err := json.Marshal(dispatchedEvents[0])
+			assert.Nil(t, err)
+			roundTripEvent := cloudevents.New()
+			assert.Nil(t, json.Unmarshal(eventJson, &roundTripEvent))
+			extensions := roundTripEvent.Extensions()
+			if !enabled {
+				assert.NotContains(t, extensions, KafkaTimestampExtension)
+				assert.NotContains(t, extensions, KafkaPartitionExtension)
+				assert.NotContains(t, extensions, KafkaOffsetExtension)
+				return
+			}
+			timestamp, err := cetypes.ToTime(extensions[KafkaTimestampExtension])
+			assert.Nil(t, err)
+			assert.True(t, consumerMessage.Timestamp.Equal(timestamp))
+			partition, err := cetypes.ToInteger(extensions[KafkaPartitionExtension])
+			assert.Nil(t, err)
+			assert.Equal(t, int32(3), partition)
+			offset, err := cetypes.ToString(extensions[KafkaOffsetExtension])
+			assert.Nil(t, err)
+			assert.Equal(t, strconv.FormatInt(math.MaxInt32+1, 10), offset)
+		})
+	}
+}
+
+// Test The Handler's ConsumeClaim() Strips The Extensions Not Allowed By The ExtensionFilter In Allow & Deny Modes
+func TestHandlerConsumeClaimExtensionFilter(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name               string
+		extensionFilter    *kafkautil.ExtensionFilter
+		kafkaExtensions    bool
+		expectedExtensions []string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Filter", expectedExtensions: []string{"eventtypeversion", "knativehistory", "custom"}},
+		{name: "Allow Mode", extensionFilter: &kafkautil.ExtensionFilter{Allow: []string{"custom"}}, expectedExtensions: []string{"custom"}},
+		{name: "Deny Mode", extensionFilter: &kafkautil.ExtensionFilter{Deny: []string{"custom"}}, expectedExtensions: []string{"eventtypeversion", "knativehistory"}},
+		{name: "Allow Mode With Kafka Extensions", extensionFilter: &kafkautil.ExtensionFilter{Allow: []string{"custom"}}, kafkaExtensions: true, expectedExtensions: []string{"custom", KafkaTimestampExtension, KafkaPartitionExtension, KafkaOffsetExtension}},
+		{name: "Context Attributes Never Stripped", extensionFilter: &kafkautil.ExtensionFilter{Allow: []string{"type"}, Deny: []string{"id", "source"}}, expectedExtensions: []string{}},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The Handler To Test With The ExtensionFilter & A Recording MessageDispatcher
+			mockMessageDispatcher := &concurrentMessageDispatcher{}
+			handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+			handler.MessageDispatcher = mockMessageDispatcher
+			handler.ExtensionFilter = testCase.extensionFilter
+			handler.KafkaExtensions = func() bool { return testCase.kafkaExtensions }
+
+			// Create Mocks For Testing
+			mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+			mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+
+			// Perform The Test
+			errChan := make(chan error, 1)
+			go func() { errChan <- handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim) }()
+			consumerMessage := createConsumerMessage(t)
+			consumerMessage.Headers = append(consumerMessage.Headers, &sarama.RecordHeader{Key: []byte("ce_custom"), Value: []byte("TestCustom")})
+			mockConsumerGroupClaim.MessageChan <- consumerMessage
+			<-mockConsumerGroupSession.MarkMessageChan
+			close(mockConsumerGroupClaim.MessageChan)
+			assert.Nil(t, <-errChan)
+
+			// Verify The Dispatched Event Has Only The Expected Extensions & All Of Its Context Attributes
+			dispatchedEvents := mockMessageDispatcher.Events()
+			assert.Len(t, dispatchedEvents, 1)
+			assert.Len(t, dispatchedEvents[0].Extensions(), len(testCase.expectedExtensions))
+			for _, name := range testCase.expectedExtensions {
+				assert.Contains(t, dispatchedEvents[0].Extensions(), name)
+			}
+			assert.Equal(t, testMsgId, dispatchedEvents[0].ID())
+			assert.Equal(t, testMsgSource, dispatchedEvents[0].Source())
+			assert.Equal(t, testMsgType, dispatchedEvents[0].Type())
+			assert.Equal(t, testMsgContentType, dispatchedEvents[0].DataContentType())
+			assert.JSONEq(t, testMsgJsonContentString, string(dispatchedEvents[0].Data()))
+		})
+	}
+}
+
+// Test The Handler Fails (Or Dead-Letters) Messages Which Cannot Be Converted For Extension Filtering
+func TestHandlerConsumeMessageExtensionFilterFailure(t *testing.T) {
+
+	// Test Data
+	testDeadLetterTopic := "test-dead-letter-topic"
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name            string
+		deadLetterTopic string
+		expectErr       bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Dead Letter Topic", expectErr: true},
+		{name: "Dead Letter Topic", deadLetterTopic: testDeadLetterTopic},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The Handler To Test With An ExtensionFilter & A Recording MessageDispatcher
+			mockMessageDispatcher := &concurrentMessageDispatcher{}
+			mockSyncProducer := dispatchertesting.NewMockSyncProducer(nil)
+			handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+			handler.MessageDispatcher = mockMessageDispatcher
+			handler.ExtensionFilter = &kafkautil.ExtensionFilter{Deny: []string{"custom"}}
+			handler.DeadLetterTopic = testCase.deadLetterTopic
+			handler.DeadLetterProducer = func() (sarama.SyncProducer, error) { return mockSyncProducer, nil }
+
+			// Perform The Test With A Structured Message Whose Body Is Not A Valid CloudEvent
+			consumerMessage := &sarama.ConsumerMessage{
+				Headers: []*sarama.RecordHeader{{Key: []byte("content-type"), Value: []byte("application/cloudevents+json")}},
+				Value:   []byte("{invalid"),
+				Topic:   testTopic,
+			}
+			err := handler.consumeMessage(context.TODO(), consumerMessage, testSubscriberURI.URL(), testReplyURI.URL(), nil, &kncloudevents.RetryConfig{})
+
+			// Verify The Message Was Never Delivered (Unfiltered) To The Subscriber, But Failed Or Was Dead-Lettered
+			assert.Empty(t, mockMessageDispatcher.Events())
+			assert.Equal(t, testCase.expectErr, err != nil)
+			if len(testCase.deadLetterTopic) > 0 {
+				producerMessage := mockSyncProducer.GetMessage()
+				assert.Equal(t, testDeadLetterTopic, producerMessage.Topic)
+			}
+		})
+	}
+}
+
+// Test The Handler's Asynchronous, Best Effort Delivery To A Subscriber's Shadow Destination
+func TestHandlerConsumeClaimShadow(t *testing.T) {
+
+	// Test Data
+	shadowURI, _ := apis.ParseURL("https://shadow.example.com/test")
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name             string
+		shadow           *consumer.ResolvedSubscriberShadow
+		shadowErr        error
+		shadowBlocked    bool
+		shadowSlotsFull  bool
+		expectedShadowed bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Shadow"},
+		{name: "Shadow Sampled", shadow: &consumer.ResolvedSubscriberShadow{URI: shadowURI, Percent: 100}, expectedShadowed: true},
+		{name: "Shadow Failure Ignored", shadow: &consumer.ResolvedSubscriberShadow{URI: shadowURI, Percent: 100}, shadowErr: errors.New("shadow failed"), expectedShadowed: true},
+		{name: "Slow Shadow Does Not Block", shadow: &consumer.ResolvedSubscriberShadow{URI: shadowURI, Percent: 100}, shadowBlocked: true, expectedShadowed: true},
+		{name: "Shadow Deliveries Exhausted", shadow: &consumer.ResolvedSubscriberShadow{URI: shadowURI, Percent: 100}, shadowSlotsFull: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create The Handler To Test With The Shadow, A Dead Letter Sink & A Recording MessageDispatcher
+			mockMessageDispatcher := &shadowMessageDispatcher{shadowErr: testCase.shadowErr, shadowed: make(chan shadowDispatch, 1), shadowRelease: make(chan struct{})}
+			if !testCase.shadowBlocked {
+				close(mockMessageDispatcher.shadowRelease)
+			}
+			deliverySpec := createDeliverySpec(testDeadLetterURI, false)
+			handler := createTestHandler(t, testSubscriberURI, testReplyURI, &deliverySpec)
+			handler.MessageDispatcher = mockMessageDispatcher
+			handler.Shadow = func() *consumer.ResolvedSubscriberShadow { return testCase.shadow }
+			if testCase.shadowSlotsFull {
+				for i := 0; i < cap(handler.shadowSlots); i++ {
+					handler.shadowSlots <- struct{}{}
+				}
+			}
+
+			// Create Mocks For Testing
+			mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+			mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+
+			// Perform The Test (The Primary Delivery Completes Regardless Of The Shadow Delivery)
+			errChan := make(chan error, 1)
+			go func() { errChan <- handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim) }()
+			mockConsumerGroupClaim.MessageChan <- createConsumerMessage(t)
+			<-mockConsumerGroupSession.MarkMessageChan
+			close(mockConsumerGroupClaim.MessageChan)
+			assert.Nil(t, <-errChan)
+			assert.Equal(t, []string{testMsgId}, mockMessageDispatcher.Dispatched())
+
+			// Release Any Blocked Shadow Delivery & Verify The Shadow Delivery (Never Replied To Or Dead Lettered)
+			if testCase.shadowBlocked {
+				close(mockMessageDispatcher.shadowRelease)
+			}
+			if testCase.expectedShadowed {
+				select {
+				case shadowDispatch := <-mockMessageDispatcher.shadowed:
+					assert.Equal(t, testMsgId, shadowDispatch.id)
+					assert.Equal(t, shadowURI.String(), shadowDispatch.destination.String())
+					assert.Nil(t, shadowDispatch.reply)
+					assert.Nil(t, shadowDispatch.deadLetter)
+				case <-time.After(5 * time.Second):
+					assert.Fail(t, "Timed Out Waiting For Shadow Delivery")
+				}
+			} else {
+				select {
+				case shadowDispatch := <-mockMessageDispatcher.shadowed:
+					assert.Fail(t, "Unexpected Shadow Delivery", shadowDispatch.destination.String())
+				case <-time.After(100 * time.Millisecond):
+				}
+			}
+		})
+	}
+}
+
+// Test The Handler Dispatches Both Binary & Structured Content Mode Kafka Messages
+func TestHandlerConsumeClaimContentMode(t *testing.T) {
+	for _, contentMode := range []binding.Encoding{binding.EncodingBinary, binding.EncodingStructured} {
+		t.Run(contentMode.String(), func(t *testing.T) {
+
+			// Create The Handler To Test With A Recording MessageDispatcher
+			mockMessageDispatcher := &concurrentMessageDispatcher{}
+			handler := createTestHandler(t, testSubscriberURI, testReplyURI, nil)
+			handler.MessageDispatcher = mockMessageDispatcher
+
+			// Create Mocks For Testing
+			mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+			mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+
+			// Create The ConsumerMessage In The Content Mode (Structured Messages Carry The Event As A JSON Envelope)
+			consumerMessage := createConsumerMessage(t)
+			if contentMode == binding.EncodingStructured {
+				consumerMessage = createStructuredConsumerMessage(t, consumerMessage)
+			}
+
+			// Perform The Test
+			errChan := make(chan error, 1)
+			go func() { errChan <- handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim) }()
+			mockConsumerGroupClaim.MessageChan <- consumerMessage
+			<-mockConsumerGroupSession.MarkMessageChan
+			close(mockConsumerGroupClaim.MessageChan)
+			assert.Nil(t, <-errChan)
+
+			// Verify The Dispatched Event Contains The Original Contents
+			dispatchedEvents := mockMessageDispatcher.Events()
+			assert.Len(t, dispatchedEvents, 1)
+			assert.Equal(t, testMsgId, dispatchedEvents[0].ID())
+			assert.Equal(t, testMsgSource, dispatchedEvents[0].Source())
+			assert.Equal(t, testMsgType, dispatchedEvents[0].Type())
+			assert.Equal(t, testMsgContentType, dispatchedEvents[0].DataContentType())
+			assert.Equal(t, testMsgEventTypeVersion, dispatchedEvents[0].Extensions()["eventtypeversion"])
+			assert.JSONEq(t, testMsgJsonContentString, string(dispatchedEvents[0].Data()))
+		})
+	}
+}
+
+// Test The newDrainContext() Functionality
+func TestNewDrainContext(t *testing.T) {
+
+	// Verify The Drain Context Outlives The Parent By The DrainTimeout
+	parentCtx, parentCancel := context.WithCancel(context.Background())
+	drainCtx, drainCancel := newDrainContext(parentCtx, 100*time.Millisecond)
+	defer drainCancel()
+	parentCancel()
+	assert.Nil(t, drainCtx.Err())
+	select {
+	case <-drainCtx.Done():
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "Drain Context Was Not Cancelled After The DrainTimeout")
+	}
+
+	// Verify The Drain Context Can Be Cancelled Directly Before The Parent Is Done
+	parentCtx, parentCancel = context.WithCancel(context.Background())
+	defer parentCancel()
+	drainCtx, drainCancel = newDrainContext(parentCtx, time.Hour)
+	drainCancel()
+	assert.NotNil(t, drainCtx.Err())
 }
 
 // Test The Custom CheckRetry() Implementation
@@ -413,7 +1372,7 @@ func createTestHandler(t *testing.T, subscriberURL *apis.URL, replyUrl *apis.URL
 	}
 
 	// Perform The Test Create The Test Handler
-	handler := NewHandler(logger, testSubscriber)
+	handler := NewHandler(logger, testSubscriber, testDrainTimeout, nil, nil)
 
 	// Verify The Results
 	assert.NotNil(t, handler)
@@ -426,7 +1385,7 @@ func createTestHandler(t *testing.T, subscriberURL *apis.URL, replyUrl *apis.URL
 }
 
 // Utility Function For Creating Valid ConsumerMessages
-func createConsumerMessage(t *testing.T) *sarama.ConsumerMessage {
+func createConsumerMessage(t testing.TB) *sarama.ConsumerMessage {
 
 	// Create The ConsumerMessage To Test (Matches What Comes Out Of Knative MessageReceiver)
 	consumerMessage := &sarama.ConsumerMessage{
@@ -479,3 +1438,102 @@ func createConsumerMessage(t *testing.T) *sarama.ConsumerMessage {
 	// Return The Test ConsumerMessage
 	return consumerMessage
 }
+
+// Utility Function For Converting A Binary ConsumerMessage Into A Structured (JSON Envelope) ConsumerMessage
+func createStructuredConsumerMessage(t testing.TB, consumerMessage *sarama.ConsumerMessage) *sarama.ConsumerMessage {
+	event, err := binding.ToEvent(context.TODO(), kafkasaramaprotocol.NewMessageFromConsumerMessage(consumerMessage))
+	assert.Nil(t, err)
+	eventJson, err := json.Marshal(event)
+	assert.Nil(t, err)
+	structuredMessage := *consumerMessage
+	structuredMessage.Headers = []*sarama.RecordHeader{{Key: []byte("content-type"), Value: []byte(cloudevents.ApplicationCloudEventsJSON)}}
+	structuredMessage.Value = eventJson
+	assert.Equal(t, binding.EncodingStructured, kafkasaramaprotocol.NewMessageFromConsumerMessage(&structuredMessage).ReadEncoding())
+	return &structuredMessage
+}
+
+// Utility Function For Creating Valid ConsumerMessages With The Specified Offset (Also Used As The Event ID) & Key
+func createKeyedConsumerMessage(t testing.TB, offset int64, key string) *sarama.ConsumerMessage {
+	consumerMessage := createConsumerMessage(t)
+	consumerMessage.Offset = offset
+	if len(key) > 0 {
+		consumerMessage.Key = []byte(key)
+	}
+	for _, header := range consumerMessage.Headers {
+		if string(header.Key) == "ce_id" {
+			header.Value = []byte(strconv.FormatInt(offset, 10))
+		}
+	}
+	return consumerMessage
+}
+
+// Thread-Safe MessageDispatcher Which Records The Dispatched Events After An (Optional) Per-Event Delay
+type concurrentMessageDispatcher struct {
+	delay      func(id string) time.Duration
+	fail       func(id string) bool // Optional - Whether The Delivery Of The Event Fails (After Being Recorded)
+	lock       sync.Mutex
+	dispatched []string
+	events     []cloudevents.Event
+}
+
+func (d *concurrentMessageDispatcher) DispatchMessage(ctx context.Context, message binding.Message, additionalHeaders http.Header, destination *url.URL, reply *url.URL, deadLetter *url.URL) (*channel.DispatchExecutionInfo, error) {
+	return d.DispatchMessageWithRetries(ctx, message, additionalHeaders, destination, reply, deadLetter, nil)
+}
+
+func (d *concurrentMessageDispatcher) DispatchMessageWithRetries(ctx context.Context, message binding.Message, _ http.Header, _ *url.URL, _ *url.URL, _ *url.URL, _ *kncloudevents.RetryConfig) (*channel.DispatchExecutionInfo, error) {
+	event, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	if d.delay != nil {
+		time.Sleep(d.delay(event.ID()))
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.dispatched = append(d.dispatched, event.ID())
+	d.events = append(d.events, *event)
+	if d.fail != nil && d.fail(event.ID()) {
+		return &channel.DispatchExecutionInfo{ResponseCode: http.StatusInternalServerError}, errors.New("delivery failed")
+	}
+	return &channel.DispatchExecutionInfo{ResponseCode: http.StatusAccepted}, nil
+}
+
+// Get A Copy Of The IDs Of The Dispatched Events (In Dispatch Order)
+func (d *concurrentMessageDispatcher) Dispatched() []string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return append([]string(nil), d.dispatched...)
+}
+
+// Get A Copy Of The Dispatched Events (In Dispatch Order)
+func (d *concurrentMessageDispatcher) Events() []cloudevents.Event {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return append([]cloudevents.Event(nil), d.events...)
+}
+
+// A Single Delivery To A Shadow Destination
+type shadowDispatch struct {
+	id          string
+	destination *url.URL
+	reply       *url.URL
+	deadLetter  *url.URL
+}
+
+// MessageDispatcher Recording Primary Deliveries (With Retries) Separately From Shadow Deliveries (Without)
+type shadowMessageDispatcher struct {
+	concurrentMessageDispatcher
+	shadowErr     error               // The Error Returned By All Shadow Deliveries
+	shadowRelease chan struct{}       // Shadow Deliveries Block Until Closed
+	shadowed      chan shadowDispatch // Receives Each Completed Shadow Delivery
+}
+
+func (d *shadowMessageDispatcher) DispatchMessage(ctx context.Context, message binding.Message, _ http.Header, destination *url.URL, reply *url.URL, deadLetter *url.URL) (*channel.DispatchExecutionInfo, error) {
+	<-d.shadowRelease
+	event, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	d.shadowed <- shadowDispatch{id: event.ID(), destination: destination, reply: reply, deadLetter: deadLetter}
+	return &channel.DispatchExecutionInfo{ResponseCode: http.StatusAccepted}, d.shadowErr
+}
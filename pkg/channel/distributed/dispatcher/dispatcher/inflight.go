@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	"k8s.io/client-go/tools/cache"
+)
+
+//
+// In-Flight Delivery Limits
+//
+// The number of concurrent in-flight deliveries (including their retries and any dead letter delivery) may be
+// limited per Dispatcher and per Subscription.  A Handler waits for a free slot before delivering each message,
+// which blocks its consumption of the ConsumerGroupClaim.  Sarama then stops fetching from the claimed partitions
+// once its (ChannelBufferSize) buffers are full, applying backpressure to Kafka instead of buffering unboundedly.
+// The Dispatcher's current number of in-flight deliveries is recorded as a gauge.
+//
+
+var (
+	// Gauge Of The Number Of Deliveries Currently In-Flight In The Dispatcher
+	inFlightDeliveries = stats.Int64(
+		"kafka_channel_in_flight_deliveries", // The METRICS_DOMAIN will be prepended to the name.
+		"Kafka Channel In-Flight Deliveries",
+		stats.UnitDimensionless,
+	)
+)
+
+// Register the OpenCensus View Structures
+func init() {
+	err := view.Register(&view.View{
+		Description: inFlightDeliveries.Description(),
+		Measure:     inFlightDeliveries,
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{channelTagKey, namespaceTagKey},
+	})
+	if err != nil {
+		log.Printf("failed to register opencensus views, %v", err)
+	}
+}
+
+// A Counting Semaphore Limiting Concurrent In-Flight Deliveries (A Nil inFlightSemaphore Is Unlimited)
+type inFlightSemaphore chan struct{}
+
+// Create A New inFlightSemaphore With The Specified Limit (Nil / Unlimited If Not Positive)
+func newInFlightSemaphore(limit int32) inFlightSemaphore {
+	if limit <= 0 {
+		return nil
+	}
+	return make(inFlightSemaphore, limit)
+}
+
+// Wait For A Free Slot (Returning The Context's Error If It Is Done First)
+func (s inFlightSemaphore) acquire(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Free A Previously Acquired Slot
+func (s inFlightSemaphore) release() {
+	if s != nil {
+		<-s
+	}
+}
+
+// Limits A Handler's In-Flight Deliveries Per The Dispatcher-Wide (Shared) & Subscription Semaphores
+type inFlightLimiter struct {
+	dispatcher   inFlightSemaphore
+	subscription inFlightSemaphore
+	inFlight     func(delta int64) // Tracks The Dispatcher's In-Flight Delivery Count
+}
+
+// Wait For A Free Slot In Both The Subscription & Dispatcher Semaphores (Always Acquired In That Order)
+func (l *inFlightLimiter) acquire(ctx context.Context) error {
+	err := l.subscription.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	err = l.dispatcher.acquire(ctx)
+	if err != nil {
+		l.subscription.release()
+		return err
+	}
+	l.inFlight(1)
+	return nil
+}
+
+// Free The Slots Acquired For A Completed Delivery
+func (l *inFlightLimiter) release() {
+	l.inFlight(-1)
+	l.dispatcher.release()
+	l.subscription.release()
+}
+
+// Create A New inFlightLimiter For A Subscription (Sharing The Dispatcher-Wide Semaphore)
+func (d *DispatcherImpl) newInFlightLimiter() *inFlightLimiter {
+	return &inFlightLimiter{
+		dispatcher:   d.inFlightSemaphore,
+		subscription: newInFlightSemaphore(d.MaxInFlight.Subscription),
+		inFlight:     d.addInFlightDeliveries,
+	}
+}
+
+// Update & Record The Dispatcher's Current Number Of In-Flight Deliveries
+func (d *DispatcherImpl) addInFlightDeliveries(delta int64) {
+	count := atomic.AddInt64(&d.inFlightDeliveries, delta)
+	namespace, name, err := cache.SplitMetaNamespaceKey(d.ChannelKey)
+	if err != nil {
+		d.Logger.Debug("Failed To Parse ChannelKey - Unable To Record In-Flight Deliveries", zap.String("ChannelKey", d.ChannelKey), zap.Error(err))
+		return
+	}
+	ctx, err := tag.New(context.Background(), tag.Insert(channelTagKey, name), tag.Insert(namespaceTagKey, namespace))
+	if err != nil {
+		d.Logger.Debug("Failed To Create New OpenCensus Tags For In-Flight Deliveries", zap.Error(err))
+		return
+	}
+	recordWrapper(ctx, inFlightDeliveries.M(count))
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"k8s.io/apimachinery/pkg/types"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	dispatchertesting "knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/testing"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/channel"
+	"knative.dev/eventing/pkg/kncloudevents"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The inFlightSemaphore Limits The Acquired Slots (Unless Nil / Unlimited)
+func TestInFlightSemaphore(t *testing.T) {
+
+	// Verify A Non-Positive Limit Is Unlimited
+	unlimited := newInFlightSemaphore(0)
+	assert.Nil(t, unlimited)
+	for index := 0; index < 10; index++ {
+		assert.Nil(t, unlimited.acquire(context.TODO()))
+	}
+	unlimited.release()
+
+	// Verify A Full Semaphore Blocks Until The Context Is Done Or A Slot Is Released
+	semaphore := newInFlightSemaphore(1)
+	assert.Nil(t, semaphore.acquire(context.TODO()))
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, semaphore.acquire(ctx))
+	semaphore.release()
+	assert.Nil(t, semaphore.acquire(context.TODO()))
+}
+
+// Test The inFlightLimiter Releases The Subscription Slot When The Dispatcher Slot Cannot Be Acquired
+func TestInFlightLimiterAcquireFailure(t *testing.T) {
+	inFlight := int64(0)
+	limiter := &inFlightLimiter{
+		dispatcher:   newInFlightSemaphore(1),
+		subscription: newInFlightSemaphore(1),
+		inFlight:     func(delta int64) { inFlight += delta },
+	}
+	limiter.dispatcher <- struct{}{} // Dispatcher-Wide Limit Reached By Another Subscription
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	assert.NotNil(t, limiter.acquire(ctx))
+	assert.Len(t, limiter.subscription, 0)
+	assert.Equal(t, int64(0), inFlight)
+	<-limiter.dispatcher
+	assert.Nil(t, limiter.acquire(context.TODO()))
+	assert.Equal(t, int64(1), inFlight)
+	limiter.release()
+	assert.Equal(t, int64(0), inFlight)
+}
+
+// Test The Dispatcher-Wide & Per-Subscription In-Flight Delivery Limits Are Respected Under Load
+func TestHandlerConsumeClaimInFlightLimit(t *testing.T) {
+
+	// Test Data
+	const subscriptionCount = 3
+	const messageCount = 50
+	maxInFlight := commonconfig.EKMaxInFlightConfig{Dispatcher: 4, Subscription: 2}
+
+	// Replace The recordWrapper With One Capturing The Maximum In-Flight Deliveries Gauge & Restore Post-Test
+	var gaugeLock sync.Mutex
+	maxGauge := int64(0)
+	recordWrapperPlaceholder := recordWrapper
+	recordWrapper = func(ctx context.Context, measurement stats.Measurement, _ ...stats.Options) {
+		assert.Equal(t, inFlightDeliveries.Name(), measurement.Measure().Name())
+		tagMap := tag.FromContext(ctx)
+		channelName, _ := tagMap.Value(channelTagKey)
+		namespace, _ := tagMap.Value(namespaceTagKey)
+		assert.Equal(t, "test-channel", channelName)
+		assert.Equal(t, "test-namespace", namespace)
+		gaugeLock.Lock()
+		defer gaugeLock.Unlock()
+		if int64(measurement.Value()) > maxGauge {
+			maxGauge = int64(measurement.Value())
+		}
+	}
+	defer func() { recordWrapper = recordWrapperPlaceholder }()
+
+	// Create The Dispatcher With The In-Flight Limits
+	dispatcher := NewDispatcher(DispatcherConfig{
+		Logger:      logtesting.TestLogger(t).Desugar(),
+		ChannelKey:  "test-namespace/test-channel",
+		MaxInFlight: maxInFlight,
+	}).(*DispatcherImpl)
+
+	// Create A Recording MessageDispatcher Tracking The Maximum Concurrent Deliveries (Overall & Per Subscription)
+	mockMessageDispatcher := &inFlightMessageDispatcher{subscriptionInFlight: make(map[string]*int32), subscriptionMax: make(map[string]int32)}
+
+	// Consume Every Subscription's Messages Concurrently With More Workers Than The Limits
+	waitGroup := sync.WaitGroup{}
+	for subscription := 0; subscription < subscriptionCount; subscription++ {
+		uid := strconv.Itoa(subscription)
+		inFlight := int32(0)
+		mockMessageDispatcher.subscriptionInFlight[uid] = &inFlight
+
+		// Create The Subscription's Handler (Destination Identifies The Subscription)
+		subscriberURI := *testSubscriberURI
+		subscriberURI.Path = "/" + uid
+		handler := NewHandler(logtesting.TestLogger(t).Desugar(), &eventingduck.SubscriberSpec{UID: types.UID(uid), SubscriberURI: &subscriberURI}, testDrainTimeout, nil, nil)
+		handler.MessageDispatcher = mockMessageDispatcher
+		handler.Ordering = constants.SubscriberOrderingUnordered
+		handler.InFlight = dispatcher.newInFlightLimiter()
+
+		// Create Mocks For Testing With All Messages Buffered In The Claim
+		mockConsumerGroupSession := dispatchertesting.NewMockConsumerGroupSession(t)
+		mockConsumerGroupClaim := dispatchertesting.NewMockConsumerGroupClaim(t)
+		mockConsumerGroupClaim.MessageChan = make(chan *sarama.ConsumerMessage, messageCount)
+		for offset := 0; offset < messageCount; offset++ {
+			mockConsumerGroupClaim.MessageChan <- createKeyedConsumerMessage(t, int64(offset), "")
+		}
+
+		// Perform The Test (Until Every Message Has Been Marked)
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			errChan := make(chan error, 1)
+			go func() { errChan <- handler.ConsumeClaim(mockConsumerGroupSession, mockConsumerGroupClaim) }()
+			for offset := 0; offset < messageCount; offset++ {
+				select {
+				case <-mockConsumerGroupSession.MarkMessageChan:
+				case <-time.After(10 * time.Second):
+					assert.Fail(t, "Timed Out Waiting For Message To Be Marked", "Subscription %s Offset %d", uid, offset)
+					return
+				}
+			}
+			close(mockConsumerGroupClaim.MessageChan)
+			assert.Nil(t, <-errChan)
+		}()
+	}
+	waitGroup.Wait()
+
+	// Verify The Results (The Limits Were Never Exceeded, And Every Message Was Delivered)
+	assert.Equal(t, int32(subscriptionCount*messageCount), atomic.LoadInt32(&mockMessageDispatcher.delivered))
+	assert.LessOrEqual(t, mockMessageDispatcher.max, maxInFlight.Dispatcher)
+	for uid, subscriptionMax := range mockMessageDispatcher.subscriptionMax {
+		assert.LessOrEqual(t, subscriptionMax, maxInFlight.Subscription, "Subscription %s", uid)
+	}
+	assert.LessOrEqual(t, maxGauge, int64(maxInFlight.Dispatcher))
+	assert.Greater(t, maxGauge, int64(0))
+	assert.Equal(t, int64(0), atomic.LoadInt64(&dispatcher.inFlightDeliveries))
+}
+
+// Mock MessageDispatcher Tracking The Maximum Concurrent Deliveries (Subscriptions Identified By Destination Path)
+type inFlightMessageDispatcher struct {
+	lock                 sync.Mutex
+	inFlight             int32
+	max                  int32
+	delivered            int32
+	subscriptionInFlight map[string]*int32
+	subscriptionMax      map[string]int32
+}
+
+func (d *inFlightMessageDispatcher) DispatchMessage(ctx context.Context, message binding.Message, additionalHeaders http.Header, destination *url.URL, reply *url.URL, deadLetter *url.URL) (*channel.DispatchExecutionInfo, error) {
+	return d.DispatchMessageWithRetries(ctx, message, additionalHeaders, destination, reply, deadLetter, nil)
+}
+
+func (d *inFlightMessageDispatcher) DispatchMessageWithRetries(_ context.Context, _ binding.Message, _ http.Header, destination *url.URL, _ *url.URL, _ *url.URL, _ *kncloudevents.RetryConfig) (*channel.DispatchExecutionInfo, error) {
+	uid := destination.Path[1:]
+	d.lock.Lock()
+	d.inFlight++
+	if d.inFlight > d.max {
+		d.max = d.inFlight
+	}
+	*d.subscriptionInFlight[uid]++
+	if *d.subscriptionInFlight[uid] > d.subscriptionMax[uid] {
+		d.subscriptionMax[uid] = *d.subscriptionInFlight[uid]
+	}
+	d.lock.Unlock()
+	time.Sleep(time.Millisecond)
+	d.lock.Lock()
+	d.inFlight--
+	*d.subscriptionInFlight[uid]--
+	d.lock.Unlock()
+	atomic.AddInt32(&d.delivered, 1)
+	return &channel.DispatchExecutionInfo{ResponseCode: http.StatusAccepted}, nil
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/metrics"
+)
+
+//
+// Consumer Lag Metrics
+//
+// The lag of each subscriber's ConsumerGroup (the difference between the high-water mark and the committed
+// offset of each partition of the KafkaChannel's Topic) is periodically recorded as a gauge, so that it can
+// be alerted on (or used to scale) without scraping Kafka directly.
+//
+
+const (
+
+	// Metric Labels
+	LabelChannel      = "channel"
+	LabelNamespace    = "namespace"
+	LabelSubscription = "subscription"
+	LabelPartition    = "partition"
+)
+
+var (
+	// Gauge Of The Number Of Messages Each Subscription's ConsumerGroup Is Behind, Per Partition
+	consumerLag = stats.Int64(
+		"kafka_channel_consumer_lag", // The METRICS_DOMAIN will be prepended to the name.
+		"Kafka Channel Consumer Lag",
+		stats.UnitDimensionless,
+	)
+
+	// Tag Keys For The Consumer Lag Metric
+	channelTagKey      = tag.MustNewKey(LabelChannel)
+	namespaceTagKey    = tag.MustNewKey(LabelNamespace)
+	subscriptionTagKey = tag.MustNewKey(LabelSubscription)
+	partitionTagKey    = tag.MustNewKey(LabelPartition)
+)
+
+// Register the OpenCensus View Structures
+func init() {
+	err := view.Register(&view.View{
+		Description: consumerLag.Description(),
+		Measure:     consumerLag,
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{channelTagKey, namespaceTagKey, subscriptionTagKey, partitionTagKey},
+	})
+	if err != nil {
+		log.Printf("failed to register opencensus views, %v", err)
+	}
+}
+
+//
+// Record The Consumer Lag Of Each Subscriber's ConsumerGroup
+//
+// The subscription tag is the Subscription's UID (the SubscriberSpec does not include its name).  Partitions
+// for which a ConsumerGroup has not yet committed an offset are skipped, as their lag depends on the initial
+// offset with which consumption will start.
+//
+func (d *DispatcherImpl) ReportConsumerLag() {
+
+	// Snapshot The Subscribers' UIDs & ConsumerGroup IDs
+	groupIds := d.subscriberGroupIds()
+	if len(groupIds) == 0 {
+		return
+	}
+
+	// Get The KafkaChannel Namespace & Name From The ChannelKey
+	namespace, name, err := cache.SplitMetaNamespaceKey(d.ChannelKey)
+	if err != nil {
+		d.Logger.Error("Failed To Parse ChannelKey - Unable To Report Consumer Lag", zap.String("ChannelKey", d.ChannelKey), zap.Error(err))
+		return
+	}
+
+	// Create An OffsetFetcher For The Kafka Brokers
+	offsetFetcher, err := newOffsetFetcherWrapper(d.Brokers, d.SaramaConfig)
+	if err != nil {
+		d.Logger.Warn("Failed To Create Kafka Client - Unable To Report Consumer Lag", zap.Error(err))
+		return
+	}
+	defer func() { _ = offsetFetcher.Close() }()
+
+	// Get The High-Water Mark Of Each Partition Of The Topic
+	partitions, err := offsetFetcher.Partitions(d.Topic)
+	if err != nil {
+		d.Logger.Warn("Failed To Get Topic Partitions - Unable To Report Consumer Lag", zap.String("Topic", d.Topic), zap.Error(err))
+		return
+	}
+	highWaterMarks := make(map[int32]int64, len(partitions))
+	for _, partition := range partitions {
+		highWaterMark, err := offsetFetcher.HighWaterMark(d.Topic, partition)
+		if err != nil {
+			d.Logger.Warn("Failed To Get Partition High-Water Mark", zap.String("Topic", d.Topic), zap.Int32("Partition", partition), zap.Error(err))
+			continue
+		}
+		highWaterMarks[partition] = highWaterMark
+	}
+
+	// Record The Lag Of Each Subscriber's ConsumerGroup For Each Partition
+	for uid, groupId := range groupIds {
+		committedOffsets, err := offsetFetcher.CommittedOffsets(groupId, d.Topic, partitions)
+		if err != nil {
+			d.Logger.Warn("Failed To Get ConsumerGroup Committed Offsets", zap.String("GroupId", groupId), zap.Error(err))
+			continue
+		}
+		for partition, highWaterMark := range highWaterMarks {
+			committedOffset, ok := committedOffsets[partition]
+			if !ok || committedOffset < 0 {
+				continue // No Offset Committed Yet
+			}
+			lag := highWaterMark - committedOffset
+			if lag < 0 {
+				lag = 0 // High-Water Mark Fetched Before A More Recent Commit
+			}
+			d.recordConsumerLag(namespace, name, uid, partition, lag)
+		}
+	}
+}
+
+// Get The ConsumerGroup ID Of Each Subscriber, Keyed By The Subscriber's UID
+func (d *DispatcherImpl) subscriberGroupIds() map[string]string {
+	d.consumerUpdateLock.Lock()
+	defer d.consumerUpdateLock.Unlock()
+	groupIds := make(map[string]string, len(d.subscribers))
+	for uid, subscriber := range d.subscribers {
+		groupIds[string(uid)] = subscriber.GroupId
+	}
+	return groupIds
+}
+
+// Metrics Record Function Variable To Facilitate Unit Testing
+var recordWrapper = metrics.Record
+
+// Record The Consumer Lag Of A Single Subscription / Partition
+func (d *DispatcherImpl) recordConsumerLag(namespace string, name string, subscription string, partition int32, lag int64) {
+	ctx, err := tag.New(context.Background(),
+		tag.Insert(channelTagKey, name),
+		tag.Insert(namespaceTagKey, namespace),
+		tag.Insert(subscriptionTagKey, subscription),
+		tag.Insert(partitionTagKey, strconv.Itoa(int(partition))),
+	)
+	if err != nil {
+		d.Logger.Error("Failed To Create New OpenCensus Tags For Consumer Lag", zap.String("Subscription", subscription), zap.Error(err))
+		return
+	}
+	recordWrapper(ctx, consumerLag.M(lag))
+}
+
+// The Kafka Offsets Required To Determine Consumer Lag (And To Replay ConsumerGroups From A Timestamp)
+type offsetFetcher interface {
+	Partitions(topic string) ([]int32, error)
+	HighWaterMark(topic string, partition int32) (int64, error)
+	OffsetForTime(topic string, partition int32, timestamp time.Time) (int64, error)
+	CommittedOffsets(groupId string, topic string, partitions []int32) (map[int32]int64, error)
+	CommittedMetadata(groupId string, topic string, partitions []int32) (map[int32]string, error)
+	Close() error
+}
+
+// OffsetFetcher Creation Wrapper To Facilitate Unit Testing
+var newOffsetFetcherWrapper = func(brokers []string, config *sarama.Config) (offsetFetcher, error) {
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+	clusterAdmin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+	return &saramaOffsetFetcher{client: client, clusterAdmin: clusterAdmin}, nil
+}
+
+// Sarama Client / ClusterAdmin Based OffsetFetcher Implementation
+type saramaOffsetFetcher struct {
+	client       sarama.Client
+	clusterAdmin sarama.ClusterAdmin
+}
+
+// Get The Partitions Of The Specified Topic
+func (f *saramaOffsetFetcher) Partitions(topic string) ([]int32, error) {
+	return f.client.Partitions(topic)
+}
+
+// Get The High-Water Mark (Offset Of The Next Message To Be Produced) Of The Specified Topic Partition
+func (f *saramaOffsetFetcher) HighWaterMark(topic string, partition int32) (int64, error) {
+	return f.client.GetOffset(topic, partition, sarama.OffsetNewest)
+}
+
+// Get The Offset Of The First Message At Or After The Specified Timestamp In The Topic Partition (-1 If None)
+func (f *saramaOffsetFetcher) OffsetForTime(topic string, partition int32, timestamp time.Time) (int64, error) {
+	return f.client.GetOffset(topic, partition, timestamp.UnixNano()/int64(time.Millisecond))
+}
+
+// Get The Specified ConsumerGroup's Committed Offsets For The Topic Partitions (-1 If None Committed)
+func (f *saramaOffsetFetcher) CommittedOffsets(groupId string, topic string, partitions []int32) (map[int32]int64, error) {
+	offsetFetchResponse, err := f.clusterAdmin.ListConsumerGroupOffsets(groupId, map[string][]int32{topic: partitions})
+	if err != nil {
+		return nil, err
+	}
+	committedOffsets := make(map[int32]int64, len(partitions))
+	for _, partition := range partitions {
+		block := offsetFetchResponse.GetBlock(topic, partition)
+		if block == nil {
+			continue
+		}
+		if block.Err != sarama.ErrNoError {
+			return nil, fmt.Errorf("failed to fetch committed offset of partition %d: %w", partition, block.Err)
+		}
+		committedOffsets[partition] = block.Offset
+	}
+	return committedOffsets, nil
+}
+
+// Get The Metadata Committed With The Specified ConsumerGroup's Offsets For The Topic Partitions
+func (f *saramaOffsetFetcher) CommittedMetadata(groupId string, topic string, partitions []int32) (map[int32]string, error) {
+	offsetFetchResponse, err := f.clusterAdmin.ListConsumerGroupOffsets(groupId, map[string][]int32{topic: partitions})
+	if err != nil {
+		return nil, err
+	}
+	committedMetadata := make(map[int32]string, len(partitions))
+	for _, partition := range partitions {
+		block := offsetFetchResponse.GetBlock(topic, partition)
+		if block == nil {
+			continue
+		}
+		if block.Err != sarama.ErrNoError {
+			return nil, fmt.Errorf("failed to fetch committed offset metadata of partition %d: %w", partition, block.Err)
+		}
+		committedMetadata[partition] = block.Metadata
+	}
+	return committedMetadata, nil
+}
+
+// Close The ClusterAdmin (And Its Underlying Client)
+func (f *saramaOffsetFetcher) Close() error {
+	return f.clusterAdmin.Close()
+}
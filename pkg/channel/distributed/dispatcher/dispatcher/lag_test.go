@@ -0,0 +1,212 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"k8s.io/apimachinery/pkg/types"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Recorded Consumer Lag Measurement (Tags & Value)
+type recordedLag struct {
+	channel      string
+	namespace    string
+	subscription string
+	partition    string
+	lag          int64
+}
+
+// Mock OffsetFetcher Implementation
+type mockOffsetFetcher struct {
+	partitions       []int32
+	partitionsErr    error
+	highWaterMarks   map[int32]int64
+	committedOffsets map[string]map[int32]int64 // Keyed By GroupId (Missing GroupId Is An Error)
+	timeOffsets      map[int32]int64            // Offsets For The Replay Timestamp (Missing Partition Is An Error)
+	metadata         map[int32]string           // Committed Offset Metadata (Nil Is An Error)
+	closed           bool
+}
+
+func (m *mockOffsetFetcher) Partitions(_ string) ([]int32, error) {
+	return m.partitions, m.partitionsErr
+}
+
+func (m *mockOffsetFetcher) HighWaterMark(_ string, partition int32) (int64, error) {
+	highWaterMark, ok := m.highWaterMarks[partition]
+	if !ok {
+		return 0, errors.New("test high-water mark error")
+	}
+	return highWaterMark, nil
+}
+
+func (m *mockOffsetFetcher) CommittedOffsets(groupId string, _ string, _ []int32) (map[int32]int64, error) {
+	committedOffsets, ok := m.committedOffsets[groupId]
+	if !ok {
+		return nil, errors.New("test committed offsets error")
+	}
+	return committedOffsets, nil
+}
+
+func (m *mockOffsetFetcher) OffsetForTime(_ string, partition int32, _ time.Time) (int64, error) {
+	offset, ok := m.timeOffsets[partition]
+	if !ok {
+		return 0, errors.New("test offset for time error")
+	}
+	return offset, nil
+}
+
+func (m *mockOffsetFetcher) CommittedMetadata(_ string, _ string, _ []int32) (map[int32]string, error) {
+	if m.metadata == nil {
+		return nil, errors.New("test committed metadata error")
+	}
+	return m.metadata, nil
+}
+
+func (m *mockOffsetFetcher) Close() error {
+	m.closed = true
+	return nil
+}
+
+// Test The Dispatcher's ReportConsumerLag() Functionality
+func TestReportConsumerLag(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name             string
+		channelKey       string
+		groupIds         map[types.UID]string
+		offsetFetcher    *mockOffsetFetcher
+		offsetFetcherErr error
+		expectedLags     []recordedLag
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:       "No Subscribers",
+			channelKey: "test-namespace/test-channel",
+			groupIds:   map[types.UID]string{},
+		},
+		{
+			name:       "Invalid ChannelKey",
+			channelKey: "too/many/parts",
+			groupIds:   map[types.UID]string{"uid1": "kafka.uid1"},
+		},
+		{
+			name:             "Kafka Client Error",
+			channelKey:       "test-namespace/test-channel",
+			groupIds:         map[types.UID]string{"uid1": "kafka.uid1"},
+			offsetFetcherErr: errors.New("test client error"),
+		},
+		{
+			name:          "Partitions Error",
+			channelKey:    "test-namespace/test-channel",
+			groupIds:      map[types.UID]string{"uid1": "kafka.uid1"},
+			offsetFetcher: &mockOffsetFetcher{partitionsErr: errors.New("test partitions error")},
+		},
+		{
+			name:       "Consumer Lag Recorded",
+			channelKey: "test-namespace/test-channel",
+			groupIds:   map[types.UID]string{"uid1": "kafka.uid1"},
+			offsetFetcher: &mockOffsetFetcher{
+				partitions:       []int32{0, 1, 2, 3},
+				highWaterMarks:   map[int32]int64{0: 100, 1: 50, 2: 10}, // Partition 3 Fails
+				committedOffsets: map[string]map[int32]int64{"kafka.uid1": {0: 90, 1: 51, 2: -1, 3: 5}},
+			},
+			expectedLags: []recordedLag{
+				{channel: "test-channel", namespace: "test-namespace", subscription: "uid1", partition: "0", lag: 10},
+				{channel: "test-channel", namespace: "test-namespace", subscription: "uid1", partition: "1", lag: 0},
+			},
+		},
+		{
+			name:       "Committed Offsets Error",
+			channelKey: "test-namespace/test-channel",
+			groupIds:   map[types.UID]string{"uid1": "kafka.uid1"},
+			offsetFetcher: &mockOffsetFetcher{
+				partitions:     []int32{0},
+				highWaterMarks: map[int32]int64{0: 100},
+			},
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Replace The recordWrapper With One Capturing The Measurements & Restore After TestCase
+			recordedLags := make([]recordedLag, 0)
+			recordWrapperPlaceholder := recordWrapper
+			recordWrapper = func(ctx context.Context, measurement stats.Measurement, _ ...stats.Options) {
+				tagMap := tag.FromContext(ctx)
+				channel, _ := tagMap.Value(channelTagKey)
+				namespace, _ := tagMap.Value(namespaceTagKey)
+				subscription, _ := tagMap.Value(subscriptionTagKey)
+				partition, _ := tagMap.Value(partitionTagKey)
+				assert.Equal(t, consumerLag.Name(), measurement.Measure().Name())
+				recordedLags = append(recordedLags, recordedLag{
+					channel:      channel,
+					namespace:    namespace,
+					subscription: subscription,
+					partition:    partition,
+					lag:          int64(measurement.Value()),
+				})
+			}
+			defer func() { recordWrapper = recordWrapperPlaceholder }()
+
+			// Replace The newOffsetFetcherWrapper With A Mock & Restore After TestCase
+			offsetFetcherCreated := false
+			newOffsetFetcherWrapperPlaceholder := newOffsetFetcherWrapper
+			newOffsetFetcherWrapper = func(_ []string, _ *sarama.Config) (offsetFetcher, error) {
+				offsetFetcherCreated = true
+				if testCase.offsetFetcherErr != nil {
+					return nil, testCase.offsetFetcherErr
+				}
+				return testCase.offsetFetcher, nil
+			}
+			defer func() { newOffsetFetcherWrapper = newOffsetFetcherWrapperPlaceholder }()
+
+			// Create The Dispatcher To Test With The Specified Subscribers
+			dispatcher := &DispatcherImpl{
+				DispatcherConfig: DispatcherConfig{Logger: logtesting.TestLogger(t).Desugar(), ChannelKey: testCase.channelKey, Topic: "TestTopic"},
+				subscribers:      make(map[types.UID]*SubscriberWrapper),
+			}
+			for uid, groupId := range testCase.groupIds {
+				dispatcher.subscribers[uid] = NewSubscriberWrapper(eventingduck.SubscriberSpec{UID: uid}, groupId, nil)
+			}
+
+			// Perform The Test
+			dispatcher.ReportConsumerLag()
+
+			// Verify The Results
+			assert.ElementsMatch(t, testCase.expectedLags, recordedLags)
+			assert.Equal(t, len(testCase.groupIds) > 0 && testCase.channelKey != "too/many/parts", offsetFetcherCreated)
+			if testCase.offsetFetcher != nil {
+				assert.True(t, testCase.offsetFetcher.closed)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+)
+
+//
+// OffsetStore Persists The Offsets Of The Messages Consumed By A Subscriber's ConsumerGroup
+//
+// The default store delegates to Kafka's native offset management (marking the consumed messages in the
+// ConsumerGroupSession, from which Sarama commits them).  Alternate stores persist the offsets elsewhere, and
+// the Handler restores each claimed partition's offset from them when the ConsumerGroup session is set up.
+//
+type OffsetStore interface {
+
+	// Commit The Consumed Messages Of A Single Partition (Contiguous & In Offset Order) With The Specified Metadata
+	Commit(session sarama.ConsumerGroupSession, messages []*sarama.ConsumerMessage, metadata string) error
+
+	// Fetch The Offset Of The Next Message To Consume From A Partition & Its Metadata (A Negative Offset If None)
+	Fetch(session sarama.ConsumerGroupSession, topic string, partition int32) (int64, string, error)
+}
+
+// Verify The Stores Implement The OffsetStore Interface
+var _ OffsetStore = &kafkaOffsetStore{}
+var _ OffsetStore = &configMapOffsetStore{}
+
+//
+// Kafka Native OffsetStore Implementation
+//
+// Consumed messages are marked in the ConsumerGroupSession and committed by Sarama, either in the background
+// ("auto" offset commit strategy) or by the store itself at most once per CommitInterval ("manual-after-ack").
+//
+type kafkaOffsetStore struct {
+	groupId        string
+	newFetcher     func() (offsetFetcher, error) // Creates The OffsetFetcher Used To Fetch Committed Offsets
+	manualCommit   bool                          // Whether The Store Commits The Marked Offsets Itself
+	commitInterval time.Duration                 // The Minimum Time Between Manual Commits
+	lastCommit     time.Time                     // The Time Of The Last Manual Commit
+	lock           sync.Mutex
+}
+
+// Create A New Kafka Native OffsetStore
+func newKafkaOffsetStore(groupId string, newFetcher func() (offsetFetcher, error), manualCommit bool, commitInterval time.Duration) *kafkaOffsetStore {
+	return &kafkaOffsetStore{
+		groupId:        groupId,
+		newFetcher:     newFetcher,
+		manualCommit:   manualCommit,
+		commitInterval: commitInterval,
+		lastCommit:     time.Now(),
+	}
+}
+
+// Mark The Consumed Messages In The Session & Commit Them If Manually Committing (And The CommitInterval Has Elapsed)
+func (s *kafkaOffsetStore) Commit(session sarama.ConsumerGroupSession, messages []*sarama.ConsumerMessage, metadata string) error {
+	for _, message := range messages {
+		session.MarkMessage(message, metadata)
+	}
+	if len(messages) > 0 && s.manualCommit {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		if time.Since(s.lastCommit) >= s.commitInterval {
+			session.Commit()
+			s.lastCommit = time.Now()
+		}
+	}
+	return nil
+}
+
+// Fetch The ConsumerGroup's Offset & Metadata Committed To Kafka For The Partition
+func (s *kafkaOffsetStore) Fetch(_ sarama.ConsumerGroupSession, topic string, partition int32) (int64, string, error) {
+	if s.newFetcher == nil {
+		return -1, "", fmt.Errorf("no kafka offset fetcher available for consumer group %s", s.groupId)
+	}
+	fetcher, err := s.newFetcher()
+	if err != nil {
+		return -1, "", fmt.Errorf("failed to create kafka offset fetcher: %w", err)
+	}
+	defer func() { _ = fetcher.Close() }()
+	offsets, err := fetcher.CommittedOffsets(s.groupId, topic, []int32{partition})
+	if err != nil {
+		return -1, "", err
+	}
+	offset, ok := offsets[partition]
+	if !ok || offset < 0 {
+		return -1, "", nil
+	}
+	metadata, err := fetcher.CommittedMetadata(s.groupId, topic, []int32{partition})
+	if err != nil {
+		return -1, "", err
+	}
+	return offset, metadata[partition], nil
+}
+
+//
+// Kubernetes ConfigMap OffsetStore Implementation
+//
+// The offset of the next message to consume from each partition is persisted (along with its metadata) in the
+// Data of a single ConfigMap per Dispatcher, keyed by the ConsumerGroup, topic and partition.  The ConfigMap is
+// created when first committed to, and updated after every commit, so this store suits low volume KafkaChannels.
+//
+type configMapOffsetStore struct {
+	client    corev1client.ConfigMapsGetter
+	namespace string
+	name      string
+	groupId   string
+}
+
+// Create A New ConfigMap OffsetStore
+func newConfigMapOffsetStore(client corev1client.ConfigMapsGetter, namespace string, name string, groupId string) *configMapOffsetStore {
+	return &configMapOffsetStore{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		groupId:   groupId,
+	}
+}
+
+// Persist The Offset Following The Last Consumed Message In The ConfigMap (Creating It If Necessary)
+func (s *configMapOffsetStore) Commit(_ sarama.ConsumerGroupSession, messages []*sarama.ConsumerMessage, metadata string) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	last := messages[len(messages)-1]
+	key := s.key(last.Topic, last.Partition)
+	value := formatStoredOffset(last.Offset+1, metadata)
+
+	// Retry Conflicting Updates From Other Dispatcher Replicas (Or Concurrent Creation Of The ConfigMap)
+	retriable := func(err error) bool { return k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err) }
+	return retry.OnError(retry.DefaultRetry, retriable, func() error {
+		configMaps := s.client.ConfigMaps(s.namespace)
+		configMap, err := configMaps.Get(context.Background(), s.name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: s.name, Namespace: s.namespace},
+				Data:       map[string]string{key: value},
+			}
+			_, err = configMaps.Create(context.Background(), configMap, metav1.CreateOptions{})
+			return err
+		} else if err != nil {
+			return err
+		}
+		if configMap.Data[key] == value {
+			return nil
+		}
+		configMap = configMap.DeepCopy()
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+		configMap.Data[key] = value
+		_, err = configMaps.Update(context.Background(), configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// Fetch The Offset & Metadata Persisted In The ConfigMap For The Partition (A Negative Offset If None)
+func (s *configMapOffsetStore) Fetch(_ sarama.ConsumerGroupSession, topic string, partition int32) (int64, string, error) {
+	configMap, err := s.client.ConfigMaps(s.namespace).Get(context.Background(), s.name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return -1, "", nil
+	} else if err != nil {
+		return -1, "", err
+	}
+	value, ok := configMap.Data[s.key(topic, partition)]
+	if !ok {
+		return -1, "", nil
+	}
+	return parseStoredOffset(value)
+}
+
+// Get The ConfigMap Data Key For The ConsumerGroup's Topic Partition
+func (s *configMapOffsetStore) key(topic string, partition int32) string {
+	return fmt.Sprintf("%s.%s.%d", s.groupId, topic, partition)
+}
+
+// Format A Stored Offset & Its (Optional) Metadata As "<offset>[:<metadata>]"
+func formatStoredOffset(offset int64, metadata string) string {
+	if len(metadata) > 0 {
+		return strconv.FormatInt(offset, 10) + ":" + metadata
+	}
+	return strconv.FormatInt(offset, 10)
+}
+
+// Parse A Stored "<offset>[:<metadata>]" Value
+func parseStoredOffset(value string) (int64, string, error) {
+	parts := strings.SplitN(value, ":", 2)
+	offset, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return -1, "", fmt.Errorf("invalid stored offset '%s': %w", value, err)
+	}
+	if len(parts) > 1 {
+		return offset, parts[1], nil
+	}
+	return offset, "", nil
+}
+
+// Create The OffsetStore For A Subscriber's ConsumerGroup Per The Configured Offset Store
+func (d *DispatcherImpl) newOffsetStore(logger *zap.Logger, groupId string, manualCommit bool, commitInterval time.Duration) OffsetStore {
+	if d.OffsetCommit.Store == commonconfig.OffsetStoreConfigMap {
+		if d.ConfigMapsClient != nil && len(d.OffsetConfigMapName) > 0 {
+			return newConfigMapOffsetStore(d.ConfigMapsClient, d.OffsetConfigMapNamespace, d.OffsetConfigMapName, groupId)
+		}
+		logger.Warn("No Kubernetes Client Or ConfigMap For The ConfigMap Offset Store - Using Kafka Offset Management")
+	}
+	newFetcher := func() (offsetFetcher, error) { return newOffsetFetcherWrapper(d.Brokers, d.SaramaConfig) }
+	return newKafkaOffsetStore(groupId, newFetcher, manualCommit, commitInterval)
+}
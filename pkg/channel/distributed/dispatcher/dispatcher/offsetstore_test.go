@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	dispatchertesting "knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/testing"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test Data
+const (
+	testOffsetGroupId       = "kafka.test-group-id"
+	testOffsetTopic         = "test-topic"
+	testOffsetConfigMapName = "test-dispatcher-offsets"
+	testOffsetNamespace     = "knative-eventing"
+)
+
+// Mock ConsumerGroupSession Recording The Marked & Reset Offsets Of Its Claimed Partitions
+type restoreSession struct {
+	dispatchertesting.MockConsumerGroupSession
+	claims   map[string][]int32
+	marks    map[int32]int64
+	resets   map[int32]int64
+	metadata map[int32]string
+}
+
+func (s *restoreSession) Claims() map[string][]int32 {
+	return s.claims
+}
+
+func (s *restoreSession) MarkOffset(_ string, partition int32, offset int64, metadata string) {
+	s.marks[partition] = offset
+	s.metadata[partition] = metadata
+}
+
+func (s *restoreSession) ResetOffset(_ string, partition int32, offset int64, metadata string) {
+	s.resets[partition] = offset
+	s.metadata[partition] = metadata
+}
+
+// Test The Kafka OffsetStore Marks Every Committed Message & Manually Commits Once Per CommitInterval
+func TestKafkaOffsetStoreCommit(t *testing.T) {
+
+	// Verify Messages Are Only Marked With The "auto" Strategy
+	session := dispatchertesting.NewMockConsumerGroupSession(t)
+	session.MarkMessageChan = make(chan *sarama.ConsumerMessage, 4)
+	store := newKafkaOffsetStore(testOffsetGroupId, nil, false, 0)
+	assert.Nil(t, store.Commit(session, []*sarama.ConsumerMessage{{Offset: 0}, {Offset: 1}}, ""))
+	assert.Len(t, session.MarkMessageChan, 2)
+	assert.Equal(t, 0, session.CommitCount())
+
+	// Verify Messages Are Marked & Committed (Once The Interval Has Elapsed) With The "manual-after-ack" Strategy
+	store = newKafkaOffsetStore(testOffsetGroupId, nil, true, time.Hour)
+	assert.Nil(t, store.Commit(session, []*sarama.ConsumerMessage{{Offset: 2}}, ""))
+	assert.Equal(t, 0, session.CommitCount())
+	store.lastCommit = time.Now().Add(-time.Hour)
+	assert.Nil(t, store.Commit(session, []*sarama.ConsumerMessage{{Offset: 3}}, ""))
+	assert.Len(t, session.MarkMessageChan, 4)
+	assert.Equal(t, 1, session.CommitCount())
+
+	// Verify Nothing Is Committed Without Messages
+	store.lastCommit = time.Now().Add(-time.Hour)
+	assert.Nil(t, store.Commit(session, nil, ""))
+	assert.Equal(t, 1, session.CommitCount())
+}
+
+// Test The Kafka OffsetStore Fetches The ConsumerGroup's Committed Offsets & Metadata
+func TestKafkaOffsetStoreFetch(t *testing.T) {
+
+	// Verify An Error Is Returned Without An OffsetFetcher Or If It Cannot Be Created
+	_, _, err := newKafkaOffsetStore(testOffsetGroupId, nil, false, 0).Fetch(nil, testOffsetTopic, 0)
+	assert.NotNil(t, err)
+	fetcherErr := errors.New("test offset fetcher error")
+	_, _, err = newKafkaOffsetStore(testOffsetGroupId, func() (offsetFetcher, error) { return nil, fetcherErr }, false, 0).Fetch(nil, testOffsetTopic, 0)
+	assert.True(t, errors.Is(err, fetcherErr))
+
+	// Verify The Committed Offset & Metadata Are Returned (Negative If None Was Committed)
+	fetcher := &mockOffsetFetcher{
+		committedOffsets: map[string]map[int32]int64{testOffsetGroupId: {0: 5, 1: -1}},
+		metadata:         map[int32]string{0: "test-metadata"},
+	}
+	store := newKafkaOffsetStore(testOffsetGroupId, func() (offsetFetcher, error) { return fetcher, nil }, false, 0)
+	offset, metadata, err := store.Fetch(nil, testOffsetTopic, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), offset)
+	assert.Equal(t, "test-metadata", metadata)
+	assert.True(t, fetcher.closed)
+	for _, partition := range []int32{1, 2} {
+		offset, _, err = store.Fetch(nil, testOffsetTopic, partition)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(-1), offset)
+	}
+
+	// Verify Committed Offset Errors Are Returned
+	store.groupId = "unknown-group-id"
+	_, _, err = store.Fetch(nil, testOffsetTopic, 0)
+	assert.NotNil(t, err)
+}
+
+// Test The ConfigMap OffsetStore Persists & Fetches Offsets
+func TestConfigMapOffsetStore(t *testing.T) {
+
+	// Create A ConfigMap OffsetStore With A Fake K8S Client (Without The ConfigMap)
+	client := fake.NewSimpleClientset()
+	store := newConfigMapOffsetStore(client.CoreV1(), testOffsetNamespace, testOffsetConfigMapName, testOffsetGroupId)
+
+	// Verify Nothing Is Fetched Before The ConfigMap Exists
+	offset, metadata, err := store.Fetch(nil, testOffsetTopic, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-1), offset)
+	assert.Empty(t, metadata)
+
+	// Verify The ConfigMap Is Created With The Offset Following The Last Committed Message
+	assert.Nil(t, store.Commit(nil, nil, ""))
+	messages := []*sarama.ConsumerMessage{{Topic: testOffsetTopic, Partition: 0, Offset: 3}, {Topic: testOffsetTopic, Partition: 0, Offset: 4}}
+	assert.Nil(t, store.Commit(nil, messages, ""))
+	offset, metadata, err = store.Fetch(nil, testOffsetTopic, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), offset)
+	assert.Empty(t, metadata)
+
+	// Verify The ConfigMap Is Updated With Later Offsets & Metadata (Other Partitions Being Unaffected)
+	assert.Nil(t, store.Commit(nil, []*sarama.ConsumerMessage{{Topic: testOffsetTopic, Partition: 1, Offset: 9}}, "replay:test"))
+	offset, metadata, err = store.Fetch(nil, testOffsetTopic, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), offset)
+	assert.Equal(t, "replay:test", metadata)
+	configMap, err := client.CoreV1().ConfigMaps(testOffsetNamespace).Get(context.TODO(), testOffsetConfigMapName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		testOffsetGroupId + "." + testOffsetTopic + ".0": "5",
+		testOffsetGroupId + "." + testOffsetTopic + ".1": "10:replay:test",
+	}, configMap.Data)
+
+	// Verify Unknown Partitions Are Not Fetched & Invalid Stored Offsets Return An Error
+	offset, _, err = store.Fetch(nil, testOffsetTopic, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-1), offset)
+	configMap.Data[testOffsetGroupId+"."+testOffsetTopic+".2"] = "invalid"
+	_, err = client.CoreV1().ConfigMaps(testOffsetNamespace).Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+	_, _, err = store.Fetch(nil, testOffsetTopic, 2)
+	assert.NotNil(t, err)
+}
+
+// Test The Handler Restores The Claimed Partitions' Offsets From A Non-Kafka OffsetStore When Set Up
+func TestHandlerSetupRestoreOffsets(t *testing.T) {
+
+	// Create A Handler With A ConfigMap OffsetStore Containing An Offset For Partition 0 Only
+	client := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: testOffsetConfigMapName, Namespace: testOffsetNamespace},
+		Data:       map[string]string{testOffsetGroupId + "." + testOffsetTopic + ".0": "7:test-metadata"},
+	})
+	handler := &Handler{Logger: logtesting.TestLogger(t).Desugar()}
+	handler.OffsetStore = newConfigMapOffsetStore(client.CoreV1(), testOffsetNamespace, testOffsetConfigMapName, testOffsetGroupId)
+
+	// Verify Only The Stored Offset Is Both Marked & Reset
+	session := &restoreSession{
+		claims:   map[string][]int32{testOffsetTopic: {0, 1}},
+		marks:    make(map[int32]int64),
+		resets:   make(map[int32]int64),
+		metadata: make(map[int32]string),
+	}
+	assert.Nil(t, handler.Setup(session))
+	assert.True(t, handler.Joined())
+	assert.Equal(t, map[int32]int64{0: 7}, session.marks)
+	assert.Equal(t, map[int32]int64{0: 7}, session.resets)
+	assert.Equal(t, map[int32]string{0: "test-metadata"}, session.metadata)
+
+	// Verify The Session Fails If The Stored Offsets Cannot Be Fetched
+	configMap, err := client.CoreV1().ConfigMaps(testOffsetNamespace).Get(context.TODO(), testOffsetConfigMapName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	configMap.Data[testOffsetGroupId+"."+testOffsetTopic+".1"] = "invalid"
+	_, err = client.CoreV1().ConfigMaps(testOffsetNamespace).Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+	assert.NotNil(t, handler.Setup(session))
+
+	// Verify The Kafka OffsetStore Leaves Restoring Offsets To Sarama (The Session Would Panic On Claims)
+	handler.OffsetStore = newKafkaOffsetStore(testOffsetGroupId, nil, false, 0)
+	assert.Nil(t, handler.Setup(dispatchertesting.NewMockConsumerGroupSession(t)))
+}
+
+// Test The Dispatcher Creates The Configured OffsetStore
+func TestNewOffsetStore(t *testing.T) {
+	logger := logtesting.TestLogger(t).Desugar()
+	dispatcher := &DispatcherImpl{}
+
+	// Verify The Kafka OffsetStore Is The Default
+	store := dispatcher.newOffsetStore(logger, testOffsetGroupId, true, time.Second)
+	kafkaStore, ok := store.(*kafkaOffsetStore)
+	assert.True(t, ok)
+	assert.Equal(t, testOffsetGroupId, kafkaStore.groupId)
+	assert.True(t, kafkaStore.manualCommit)
+	assert.Equal(t, time.Second, kafkaStore.commitInterval)
+
+	// Verify The ConfigMap OffsetStore Falls Back To Kafka Without A K8S Client
+	dispatcher.OffsetCommit.Store = commonconfig.OffsetStoreConfigMap
+	_, ok = dispatcher.newOffsetStore(logger, testOffsetGroupId, false, 0).(*kafkaOffsetStore)
+	assert.True(t, ok)
+
+	// Verify The ConfigMap OffsetStore Is Created When Configured
+	dispatcher.ConfigMapsClient = fake.NewSimpleClientset().CoreV1()
+	dispatcher.OffsetConfigMapNamespace = testOffsetNamespace
+	dispatcher.OffsetConfigMapName = testOffsetConfigMapName
+	configMapStore, ok := dispatcher.newOffsetStore(logger, testOffsetGroupId, false, 0).(*configMapOffsetStore)
+	assert.True(t, ok)
+	assert.Equal(t, testOffsetConfigMapName, configMapStore.name)
+	assert.Equal(t, testOffsetGroupId, configMapStore.groupId)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+//
+// Determine Whether The Dispatcher Is Ready To Consume From Kafka
+//
+// The Dispatcher is considered ready only once all of its subscribers' ConsumerGroups have
+// joined (have an active session) and at least one of the Kafka Brokers is reachable.  This
+// is polled periodically to drive the /readyz endpoint so that it fails on broker loss.
+//
+func (d *DispatcherImpl) KafkaReady() bool {
+
+	// Verify All The Subscribers' ConsumerGroups Have Joined
+	if !d.consumerGroupsJoined() {
+		return false
+	}
+
+	// Verify The Kafka Brokers Are Reachable
+	return d.brokersReachable()
+}
+
+// Determine Whether All The Subscribers' ConsumerGroups Currently Have An Active Session
+func (d *DispatcherImpl) consumerGroupsJoined() bool {
+
+	// Thread Safe ;)
+	d.consumerUpdateLock.Lock()
+	defer d.consumerUpdateLock.Unlock()
+
+	// Any Subscriber Without An Active ConsumerGroup Session Is Not Ready
+	for _, subscriber := range d.subscribers {
+		if subscriber.Handler == nil || !subscriber.Handler.Joined() {
+			d.Logger.Debug("ConsumerGroup Has Not Joined", zap.String("GroupId", subscriber.GroupId))
+			return false
+		}
+	}
+	return true
+}
+
+// Determine Whether At Least One Of The Kafka Brokers Is Reachable
+func (d *DispatcherImpl) brokersReachable() bool {
+	for _, broker := range d.Brokers {
+		connected, err := brokerConnectedWrapper(broker, d.SaramaConfig)
+		if connected {
+			return true
+		}
+		d.Logger.Warn("Kafka Broker Is Not Reachable", zap.String("Broker", broker), zap.Error(err))
+	}
+	return false
+}
+
+// Broker Connectivity Check Wrapper To Facilitate Unit Testing
+var brokerConnectedWrapper = func(address string, config *sarama.Config) (bool, error) {
+	broker := sarama.NewBroker(address)
+	err := broker.Open(config)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = broker.Close() }()
+	return broker.Connected()
+}
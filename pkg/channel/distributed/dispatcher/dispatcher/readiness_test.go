@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The Dispatcher's KafkaReady() Functionality
+func TestKafkaReady(t *testing.T) {
+
+	// Test Data
+	brokers := []string{"TestBroker1", "TestBroker2"}
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name             string
+		joined           []bool
+		reachableBrokers map[string]bool
+		expectedReady    bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "No Subscribers", joined: []bool{}, reachableBrokers: map[string]bool{"TestBroker1": true}, expectedReady: true},
+		{name: "All Joined", joined: []bool{true, true}, reachableBrokers: map[string]bool{"TestBroker1": true}, expectedReady: true},
+		{name: "Second Broker Reachable", joined: []bool{true}, reachableBrokers: map[string]bool{"TestBroker2": true}, expectedReady: true},
+		{name: "Not All Joined", joined: []bool{true, false}, reachableBrokers: map[string]bool{"TestBroker1": true}, expectedReady: false},
+		{name: "No Brokers Reachable", joined: []bool{true, true}, reachableBrokers: map[string]bool{}, expectedReady: false},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Replace The brokerConnectedWrapper With A Mock & Restore After TestCase
+			brokerConnectedWrapperPlaceholder := brokerConnectedWrapper
+			brokerConnectedWrapper = func(address string, _ *sarama.Config) (bool, error) {
+				if testCase.reachableBrokers[address] {
+					return true, nil
+				}
+				return false, errors.New("test broker not reachable")
+			}
+			defer func() { brokerConnectedWrapper = brokerConnectedWrapperPlaceholder }()
+
+			// Create Subscribers With Handlers In The Specified Joined State
+			subscribers := make(map[types.UID]*SubscriberWrapper)
+			for index, joined := range testCase.joined {
+				uid := types.UID(string(rune('a' + index)))
+				subscriber := NewSubscriberWrapper(eventingduck.SubscriberSpec{UID: uid}, "kafka."+string(uid), nil)
+				subscriber.Handler = NewHandler(logtesting.TestLogger(t).Desugar(), &subscriber.SubscriberSpec, 0, nil, nil)
+				if joined {
+					assert.Nil(t, subscriber.Handler.Setup(nil))
+				}
+				subscribers[uid] = subscriber
+			}
+
+			// Create The Dispatcher To Test
+			dispatcher := &DispatcherImpl{
+				DispatcherConfig: DispatcherConfig{
+					Logger:  logtesting.TestLogger(t).Desugar(),
+					Brokers: brokers,
+				},
+				subscribers: subscribers,
+			}
+
+			// Perform The Test & Verify Results
+			assert.Equal(t, testCase.expectedReady, dispatcher.KafkaReady())
+		})
+	}
+}
+
+// Test The Dispatcher's KafkaReady() Functionality With A Subscriber That Has Not Started Consuming
+func TestKafkaReadyNoHandler(t *testing.T) {
+	subscriber := NewSubscriberWrapper(eventingduck.SubscriberSpec{UID: uid123}, "kafka."+id123, nil)
+	dispatcher := &DispatcherImpl{
+		DispatcherConfig: DispatcherConfig{Logger: logtesting.TestLogger(t).Desugar()},
+		subscribers:      map[types.UID]*SubscriberWrapper{uid123: subscriber},
+	}
+	assert.False(t, dispatcher.KafkaReady())
+}
+
+// Test The Real brokerConnectedWrapper Against An Unreachable Broker
+func TestBrokerConnectedWrapperUnreachable(t *testing.T) {
+	config := sarama.NewConfig()
+	config.Net.DialTimeout = 100 * time.Millisecond
+	connected, err := brokerConnectedWrapper("127.0.0.1:1", config)
+	assert.False(t, connected)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"fmt"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
+)
+
+//
+// Replay The ConsumerGroup Session's Claimed Partitions From The ReplayFromTimestamp
+//
+// Each claimed partition's offset is reset to that of the first message at or after the timestamp, before the
+// session starts consuming from it.  Offsets committed after a replay carry the replay's metadata marker, so that
+// partitions which have already been replayed (by this or another Dispatcher replica, or before a restart) are
+// skipped rather than replayed again.  Sarama only resets offsets backwards, so partitions whose committed offset
+// is already before the timestamp (including those of new ConsumerGroups) are unaffected.
+//
+func (d *DispatcherImpl) replayOffsets(logger *zap.Logger, session sarama.ConsumerGroupSession, groupId string) error {
+
+	// The Metadata Marker Identifying This Replay
+	metadata := consumer.ReplayOffsetMetadata(d.ReplayFromTimestamp)
+
+	// Create An OffsetFetcher To Lookup The Committed Metadata & Offsets For The Timestamp
+	offsetFetcher, err := newOffsetFetcherWrapper(d.Brokers, d.SaramaConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create kafka offset fetcher: %w", err)
+	}
+	defer func() { _ = offsetFetcher.Close() }()
+
+	// Loop Over The Session's Claimed Partitions
+	for topic, partitions := range session.Claims() {
+
+		// Get The Metadata Committed With The ConsumerGroup's Offsets
+		committedMetadata, err := offsetFetcher.CommittedMetadata(groupId, topic, partitions)
+		if err != nil {
+			return fmt.Errorf("failed to fetch committed offset metadata of topic %s: %w", topic, err)
+		}
+
+		for _, partition := range partitions {
+
+			// Skip Partitions Which Have Already Been Replayed
+			if committedMetadata[partition] == metadata {
+				continue
+			}
+
+			// Determine The Offset Of The First Message At Or After The Timestamp (-1 If There Are None)
+			offset, err := offsetFetcher.OffsetForTime(topic, partition, d.ReplayFromTimestamp)
+			if err != nil {
+				return fmt.Errorf("failed to fetch offset for replay timestamp of partition %d: %w", partition, err)
+			} else if offset < 0 {
+				continue
+			}
+
+			// Reset The Partition's Offset To Replay From The Timestamp
+			session.ResetOffset(topic, partition, offset, metadata)
+			logger.Info("Replaying Partition From Timestamp", zap.String("Topic", topic), zap.Int32("Partition", partition), zap.Int64("Offset", offset), zap.Time("Timestamp", d.ReplayFromTimestamp))
+		}
+	}
+
+	// Return Success
+	return nil
+}
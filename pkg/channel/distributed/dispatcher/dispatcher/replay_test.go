@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
+	dispatchertesting "knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/testing"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Mock ConsumerGroupSession Recording Offset Resets Of Its Claimed Partitions
+type replaySession struct {
+	dispatchertesting.MockConsumerGroupSession
+	claims   map[string][]int32
+	resets   map[int32]int64
+	metadata map[int32]string
+}
+
+func (s *replaySession) Claims() map[string][]int32 {
+	return s.claims
+}
+
+func (s *replaySession) ResetOffset(_ string, partition int32, offset int64, metadata string) {
+	s.resets[partition] = offset
+	s.metadata[partition] = metadata
+}
+
+// Test The Dispatcher's replayOffsets() Functionality
+func TestReplayOffsets(t *testing.T) {
+
+	// Test Data
+	replayTimestamp := time.Date(2020, 11, 12, 13, 14, 15, 0, time.UTC)
+	replayedMetadata := consumer.ReplayOffsetMetadata(replayTimestamp)
+	otherMetadata := consumer.ReplayOffsetMetadata(replayTimestamp.Add(-time.Hour))
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name             string
+		offsetFetcher    *mockOffsetFetcher
+		offsetFetcherErr error
+		expectedResets   map[int32]int64
+		expectErr        bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:           "Replay All Partitions",
+			offsetFetcher:  &mockOffsetFetcher{metadata: map[int32]string{}, timeOffsets: map[int32]int64{0: 10, 1: 20}},
+			expectedResets: map[int32]int64{0: 10, 1: 20},
+		},
+		{
+			name:           "Skip Already Replayed Partitions",
+			offsetFetcher:  &mockOffsetFetcher{metadata: map[int32]string{0: replayedMetadata, 1: otherMetadata}, timeOffsets: map[int32]int64{1: 20}},
+			expectedResets: map[int32]int64{1: 20},
+		},
+		{
+			name:           "Skip Partitions Without Messages Since Timestamp",
+			offsetFetcher:  &mockOffsetFetcher{metadata: map[int32]string{}, timeOffsets: map[int32]int64{0: -1, 1: 20}},
+			expectedResets: map[int32]int64{1: 20},
+		},
+		{
+			name:             "Kafka Client Error",
+			offsetFetcherErr: errors.New("test kafka client error"),
+			expectedResets:   map[int32]int64{},
+			expectErr:        true,
+		},
+		{
+			name:           "Committed Metadata Error",
+			offsetFetcher:  &mockOffsetFetcher{timeOffsets: map[int32]int64{0: 10, 1: 20}},
+			expectedResets: map[int32]int64{},
+			expectErr:      true,
+		},
+		{
+			name:           "Offset For Time Error",
+			offsetFetcher:  &mockOffsetFetcher{metadata: map[int32]string{}, timeOffsets: map[int32]int64{0: 10}},
+			expectedResets: map[int32]int64{0: 10},
+			expectErr:      true,
+		},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Replace The newOffsetFetcherWrapper With A Mock & Restore After TestCase
+			newOffsetFetcherWrapperPlaceholder := newOffsetFetcherWrapper
+			newOffsetFetcherWrapper = func(_ []string, _ *sarama.Config) (offsetFetcher, error) {
+				if testCase.offsetFetcherErr != nil {
+					return nil, testCase.offsetFetcherErr
+				}
+				return testCase.offsetFetcher, nil
+			}
+			defer func() { newOffsetFetcherWrapper = newOffsetFetcherWrapperPlaceholder }()
+
+			// Create The Dispatcher & Session To Test With
+			logger := logtesting.TestLogger(t).Desugar()
+			dispatcher := &DispatcherImpl{DispatcherConfig: DispatcherConfig{Logger: logger, Topic: "TestTopic", ReplayFromTimestamp: replayTimestamp}}
+			session := &replaySession{claims: map[string][]int32{"TestTopic": {0, 1}}, resets: make(map[int32]int64), metadata: make(map[int32]string)}
+
+			// Perform The Test
+			err := dispatcher.replayOffsets(logger, session, "TestGroupId")
+
+			// Verify The Results
+			assert.Equal(t, testCase.expectErr, err != nil)
+			assert.Equal(t, testCase.expectedResets, session.resets)
+			for partition := range session.resets {
+				assert.Equal(t, replayedMetadata, session.metadata[partition])
+			}
+			if testCase.offsetFetcher != nil {
+				assert.True(t, testCase.offsetFetcher.closed)
+			}
+		})
+	}
+}
+
+// Test The Handler's Setup() Performs Any Replay Before Joining
+func TestHandlerSetupReplay(t *testing.T) {
+
+	// Create A Handler With A Failing Replay
+	replayErr := errors.New("test replay error")
+	handler := &Handler{Logger: logtesting.TestLogger(t).Desugar()}
+	handler.Replay = func(_ sarama.ConsumerGroupSession) error { return replayErr }
+
+	// Verify The Session Fails (And Is Not Joined) If The Replay Fails
+	session := &replaySession{}
+	assert.Equal(t, replayErr, handler.Setup(session))
+	assert.False(t, handler.Joined())
+
+	// Verify The Session Is Joined Once The Replay Succeeds
+	replayed := false
+	handler.Replay = func(replaySession sarama.ConsumerGroupSession) error {
+		replayed = replaySession == session
+		return nil
+	}
+	assert.Nil(t, handler.Setup(session))
+	assert.True(t, replayed)
+	assert.True(t, handler.Joined())
+}
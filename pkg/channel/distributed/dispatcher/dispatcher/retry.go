@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/rickb777/date/period"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/kncloudevents"
+)
+
+const (
+	// The BackoffDelay Used When A Retry Count Is Specified Without One
+	defaultBackoffDelay = time.Second
+
+	// Upper Bound On Any Single Backoff (Prevents Exponential Backoff From Stalling A Partition Indefinitely)
+	maxBackoffDuration = 10 * time.Minute
+)
+
+//
+// Select The DeliverySpec Whose Retry Settings Apply To A Subscriber
+//
+// The retry settings (Retry, BackoffPolicy & BackoffDelay) are taken as a whole from the Subscriber's DeliverySpec
+// if it specifies any of them, and otherwise from the KafkaChannel's DeliverySpec (which may be nil).
+//
+func retryDeliverySpec(subscriberDelivery *eventingduck.DeliverySpec, channelDelivery *eventingduck.DeliverySpec) *eventingduck.DeliverySpec {
+	if hasRetrySettings(subscriberDelivery) {
+		return subscriberDelivery
+	}
+	return channelDelivery
+}
+
+// Determine Whether The Specified DeliverySpec Specifies Any Retry Settings
+func hasRetrySettings(delivery *eventingduck.DeliverySpec) bool {
+	return delivery != nil && (delivery.Retry != nil || delivery.BackoffPolicy != nil || delivery.BackoffDelay != nil)
+}
+
+//
+// Create A RetryConfig From The Retry Settings Of The Specified DeliverySpec
+//
+// The number of retries defaults to zero (no retries), the BackoffPolicy to exponential, and the BackoffDelay (an
+// ISO-8601 duration) to one second.  The Nth retry (starting at 1) is delayed by BackoffDelay * N for the linear
+// policy, or BackoffDelay * 2^(N-1) for the exponential policy, in either case capped at the maxBackoffDuration.
+// Any invalid settings result in an error and a RetryConfig which does not retry.
+//
+func newRetryConfig(delivery *eventingduck.DeliverySpec, checkRetry kncloudevents.CheckRetry) (kncloudevents.RetryConfig, error) {
+
+	// No Retries Unless A Positive Retry Count Is Specified
+	if delivery == nil || delivery.Retry == nil || *delivery.Retry <= 0 {
+		return kncloudevents.NoRetries(), nil
+	}
+
+	// Default The BackoffPolicy To Exponential
+	backoffPolicy := eventingduck.BackoffPolicyExponential
+	if delivery.BackoffPolicy != nil {
+		backoffPolicy = *delivery.BackoffPolicy
+	}
+
+	// Parse The BackoffDelay (Defaulting If Not Specified)
+	backoffDelay := defaultBackoffDelay
+	if delivery.BackoffDelay != nil {
+		delayPeriod, err := period.Parse(*delivery.BackoffDelay)
+		if err != nil {
+			return kncloudevents.NoRetries(), fmt.Errorf("failed to parse BackoffDelay %q: %w", *delivery.BackoffDelay, err)
+		}
+		backoffDelay = delayPeriod.DurationApprox()
+		if backoffDelay < 0 {
+			return kncloudevents.NoRetries(), fmt.Errorf("invalid negative BackoffDelay %q", *delivery.BackoffDelay)
+		}
+	}
+
+	// Create The Backoff Function For The BackoffPolicy (The Sender's attemptNum Is Zero Based)
+	var backoff kncloudevents.Backoff
+	switch backoffPolicy {
+	case eventingduck.BackoffPolicyLinear:
+		backoff = func(attemptNum int, _ *http.Response) time.Duration {
+			return capBackoff(float64(backoffDelay) * float64(attemptNum+1))
+		}
+	case eventingduck.BackoffPolicyExponential:
+		backoff = func(attemptNum int, _ *http.Response) time.Duration {
+			return capBackoff(float64(backoffDelay) * math.Exp2(float64(attemptNum)))
+		}
+	default:
+		return kncloudevents.NoRetries(), fmt.Errorf("invalid BackoffPolicy %q", backoffPolicy)
+	}
+
+	// Return The RetryConfig
+	return kncloudevents.RetryConfig{
+		RetryMax:      int(*delivery.Retry),
+		BackoffDelay:  delivery.BackoffDelay,
+		BackoffPolicy: delivery.BackoffPolicy,
+		CheckRetry:    checkRetry,
+		Backoff:       backoff,
+	}, nil
+}
+
+// Limit The Specified Backoff (In Nanoseconds) To The maxBackoffDuration
+func capBackoff(backoff float64) time.Duration {
+	if backoff >= float64(maxBackoffDuration) {
+		return maxBackoffDuration
+	}
+	return time.Duration(backoff)
+}
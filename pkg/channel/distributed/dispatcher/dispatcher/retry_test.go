@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
+)
+
+// Test The retryDeliverySpec() Functionality
+func TestRetryDeliverySpec(t *testing.T) {
+
+	retry := int32(3)
+	linear := eventingduck.BackoffPolicyLinear
+	delay := "PT2S"
+	channelDelivery := &eventingduck.DeliverySpec{Retry: &retry}
+
+	assert.Nil(t, retryDeliverySpec(nil, nil))
+	assert.Equal(t, channelDelivery, retryDeliverySpec(nil, channelDelivery))
+	assert.Equal(t, channelDelivery, retryDeliverySpec(&eventingduck.DeliverySpec{}, channelDelivery))
+	for _, subscriberDelivery := range []*eventingduck.DeliverySpec{{Retry: &retry}, {BackoffPolicy: &linear}, {BackoffDelay: &delay}} {
+		assert.Equal(t, subscriberDelivery, retryDeliverySpec(subscriberDelivery, channelDelivery))
+	}
+}
+
+// Test The newRetryConfig() Functionality
+func TestNewRetryConfig(t *testing.T) {
+
+	// Test Data
+	zero := int32(0)
+	retry := int32(5)
+	linear := eventingduck.BackoffPolicyLinear
+	exponential := eventingduck.BackoffPolicyExponential
+	invalidPolicy := eventingduck.BackoffPolicyType("invalid")
+	delay := "PT2S"
+	hugeDelay := "PT1H"
+	invalidDelay := "2 seconds"
+	checkRetry := func(context.Context, *http.Response, error) (bool, error) { return true, nil }
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only     bool
+		name     string
+		delivery *eventingduck.DeliverySpec
+		retryMax int
+		backoffs []time.Duration // Expected Backoff Of Each Retry (Starting With The First)
+		err      bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{
+			name:     "Nil DeliverySpec",
+			delivery: nil,
+		},
+		{
+			name:     "No Retry",
+			delivery: &eventingduck.DeliverySpec{BackoffPolicy: &linear, BackoffDelay: &delay},
+		},
+		{
+			name:     "Zero Retry",
+			delivery: &eventingduck.DeliverySpec{Retry: &zero, BackoffPolicy: &linear, BackoffDelay: &delay},
+		},
+		{
+			name:     "Linear Backoff",
+			delivery: &eventingduck.DeliverySpec{Retry: &retry, BackoffPolicy: &linear, BackoffDelay: &delay},
+			retryMax: 5,
+			backoffs: []time.Duration{2 * time.Second, 4 * time.Second, 6 * time.Second, 8 * time.Second, 10 * time.Second},
+		},
+		{
+			name:     "Exponential Backoff",
+			delivery: &eventingduck.DeliverySpec{Retry: &retry, BackoffPolicy: &exponential, BackoffDelay: &delay},
+			retryMax: 5,
+			backoffs: []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 32 * time.Second},
+		},
+		{
+			name:     "Default BackoffPolicy",
+			delivery: &eventingduck.DeliverySpec{Retry: &retry, BackoffDelay: &delay},
+			retryMax: 5,
+			backoffs: []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 32 * time.Second},
+		},
+		{
+			name:     "Default BackoffDelay",
+			delivery: &eventingduck.DeliverySpec{Retry: &retry, BackoffPolicy: &linear},
+			retryMax: 5,
+			backoffs: []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 4 * time.Second, 5 * time.Second},
+		},
+		{
+			name:     "Capped Backoff",
+			delivery: &eventingduck.DeliverySpec{Retry: &retry, BackoffPolicy: &exponential, BackoffDelay: &hugeDelay},
+			retryMax: 5,
+			backoffs: []time.Duration{maxBackoffDuration, maxBackoffDuration, maxBackoffDuration, maxBackoffDuration, maxBackoffDuration},
+		},
+		{
+			name:     "Invalid BackoffDelay",
+			delivery: &eventingduck.DeliverySpec{Retry: &retry, BackoffPolicy: &linear, BackoffDelay: &invalidDelay},
+			err:      true,
+		},
+		{
+			name:     "Invalid BackoffPolicy",
+			delivery: &eventingduck.DeliverySpec{Retry: &retry, BackoffPolicy: &invalidPolicy, BackoffDelay: &delay},
+			err:      true,
+		},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			retryConfig, err := newRetryConfig(testCase.delivery, checkRetry)
+
+			assert.Equal(t, testCase.err, err != nil)
+			assert.Equal(t, testCase.retryMax, retryConfig.RetryMax)
+			assert.NotNil(t, retryConfig.CheckRetry)
+			assert.NotNil(t, retryConfig.Backoff)
+			if testCase.retryMax > 0 {
+				retry, _ := retryConfig.CheckRetry(context.TODO(), nil, nil)
+				assert.True(t, retry) // The Specified CheckRetry Is Used
+			}
+			for attemptNum, expectedBackoff := range testCase.backoffs {
+				assert.Equal(t, expectedBackoff, retryConfig.Backoff(attemptNum, nil))
+			}
+		})
+	}
+}
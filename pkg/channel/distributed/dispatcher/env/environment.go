@@ -17,8 +17,16 @@ limitations under the License.
 package env
 
 import (
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"go.uber.org/zap"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/consumer"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/constants"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 )
 
 // Environment Structure
@@ -41,9 +49,36 @@ type Environment struct {
 	ChannelKey   string // Required
 	ServiceName  string // Required
 
+	// Kafka Consumer Configuration
+	KafkaConsumerConfigOverrides    map[string]string                   // Optional
+	KafkaSubscriberConcurrency      map[string]int                      // Optional
+	KafkaSubscriberOrdering         map[string]string                   // Optional
+	KafkaSubscriberDeadLetterTopics map[string]string                   // Optional
+	KafkaSubscriberInitialOffsets   map[string]string                   // Optional
+	KafkaSubscriberFilters          map[string]eventingv1.TriggerFilter // Optional
+	KafkaReplayFromTimestamp        time.Time                           // Optional (Zero For None)
+	KafkaExtensionFilter            *kafkautil.ExtensionFilter          // Optional (Nil Propagates All Extensions)
+
+	// Kafka Connectivity Readiness Configuration
+	KafkaReadinessIntervalSeconds int64 // Optional
+
+	// Shutdown Configuration
+	DrainTimeoutSeconds int64 // Optional
+
+	// Consumer Lag Metric Configuration
+	ConsumerLagIntervalSeconds int64 // Optional
+
+	// Kafka Rack Configuration (Explicit Rack ID Or Node Name For Deriving It From The Node's Zone)
+	KafkaRackId string // Optional
+	NodeName    string // Optional
+
 	// Kafka Authorization
-	KafkaUsername string // Optional
-	KafkaPassword string // Optional
+	KafkaUsername      string // Optional
+	KafkaPassword      string // Optional
+	KafkaSaslMechanism string // Optional
+	KafkaTLSCert       string // Optional
+	KafkaTLSKey        string // Optional
+	KafkaTLSEnable     string // Optional
 }
 
 // Get The Environment
@@ -109,17 +144,157 @@ func GetEnvironment(logger *zap.Logger) (*Environment, error) {
 		return nil, err
 	}
 
+	// Get The Optional KafkaConsumerConfigOverrides Config Value (JSON Encoded Map)
+	kafkaConsumerConfigOverrides := env.GetOptionalConfigValue(logger, env.KafkaConsumerConfigOverridesEnvVarKey, "")
+	if len(kafkaConsumerConfigOverrides) > 0 {
+		err = json.Unmarshal([]byte(kafkaConsumerConfigOverrides), &environment.KafkaConsumerConfigOverrides)
+		if err != nil {
+			logger.Error("Invalid Kafka Consumer Config Overrides", zap.String("Value", kafkaConsumerConfigOverrides), zap.Error(err))
+			return nil, fmt.Errorf("invalid (non json map) value '%s' for environment variable '%s'", kafkaConsumerConfigOverrides, env.KafkaConsumerConfigOverridesEnvVarKey)
+		}
+	}
+
+	// Get The Optional KafkaSubscriberConcurrency Config Value (JSON Encoded Map Of Subscription UID To Worker Count)
+	kafkaSubscriberConcurrency := env.GetOptionalConfigValue(logger, env.KafkaSubscriberConcurrencyEnvVarKey, "")
+	if len(kafkaSubscriberConcurrency) > 0 {
+		err = json.Unmarshal([]byte(kafkaSubscriberConcurrency), &environment.KafkaSubscriberConcurrency)
+		if err == nil {
+			err = consumer.ValidateSubscriberConcurrency(environment.KafkaSubscriberConcurrency)
+		}
+		if err != nil {
+			logger.Error("Invalid Kafka Subscriber Concurrency", zap.String("Value", kafkaSubscriberConcurrency), zap.Error(err))
+			return nil, fmt.Errorf("invalid (non json map of positive worker counts) value '%s' for environment variable '%s'", kafkaSubscriberConcurrency, env.KafkaSubscriberConcurrencyEnvVarKey)
+		}
+	}
+
+	// Get The Optional KafkaSubscriberOrdering Config Value (JSON Encoded Map Of Subscription UID To Ordering Mode)
+	kafkaSubscriberOrdering := env.GetOptionalConfigValue(logger, env.KafkaSubscriberOrderingEnvVarKey, "")
+	if len(kafkaSubscriberOrdering) > 0 {
+		err = json.Unmarshal([]byte(kafkaSubscriberOrdering), &environment.KafkaSubscriberOrdering)
+		if err == nil {
+			err = consumer.ValidateSubscriberOrdering(environment.KafkaSubscriberOrdering)
+		}
+		if err != nil {
+			logger.Error("Invalid Kafka Subscriber Ordering", zap.String("Value", kafkaSubscriberOrdering), zap.Error(err))
+			return nil, fmt.Errorf("invalid (non json map of ordering modes) value '%s' for environment variable '%s'", kafkaSubscriberOrdering, env.KafkaSubscriberOrderingEnvVarKey)
+		}
+	}
+
+	// Get The Optional KafkaSubscriberDeadLetterTopics Config Value (JSON Encoded Map Of Subscription UID To Kafka Topic Name)
+	kafkaSubscriberDeadLetterTopics := env.GetOptionalConfigValue(logger, env.KafkaSubscriberDeadLetterTopicsEnvVarKey, "")
+	if len(kafkaSubscriberDeadLetterTopics) > 0 {
+		err = json.Unmarshal([]byte(kafkaSubscriberDeadLetterTopics), &environment.KafkaSubscriberDeadLetterTopics)
+		if err == nil {
+			err = consumer.ValidateSubscriberDeadLetterTopics(environment.KafkaSubscriberDeadLetterTopics)
+		}
+		if err != nil {
+			logger.Error("Invalid Kafka Subscriber Dead Letter Topics", zap.String("Value", kafkaSubscriberDeadLetterTopics), zap.Error(err))
+			return nil, fmt.Errorf("invalid (non json map of kafka topic names) value '%s' for environment variable '%s'", kafkaSubscriberDeadLetterTopics, env.KafkaSubscriberDeadLetterTopicsEnvVarKey)
+		}
+	}
+
+	// Get The Optional KafkaSubscriberInitialOffsets Config Value (JSON Encoded Map Of Subscription UID To Initial Offset Policy)
+	kafkaSubscriberInitialOffsets := env.GetOptionalConfigValue(logger, env.KafkaSubscriberInitialOffsetsEnvVarKey, "")
+	if len(kafkaSubscriberInitialOffsets) > 0 {
+		err = json.Unmarshal([]byte(kafkaSubscriberInitialOffsets), &environment.KafkaSubscriberInitialOffsets)
+		if err == nil {
+			err = consumer.ValidateSubscriberInitialOffsets(environment.KafkaSubscriberInitialOffsets)
+		}
+		if err != nil {
+			logger.Error("Invalid Kafka Subscriber Initial Offsets", zap.String("Value", kafkaSubscriberInitialOffsets), zap.Error(err))
+			return nil, fmt.Errorf("invalid (non json map of initial offset policies) value '%s' for environment variable '%s'", kafkaSubscriberInitialOffsets, env.KafkaSubscriberInitialOffsetsEnvVarKey)
+		}
+	}
+
+	// Get The Optional KafkaSubscriberFilters Config Value (JSON Encoded Map Of Subscription UID To Attribute Filter)
+	kafkaSubscriberFilters := env.GetOptionalConfigValue(logger, env.KafkaSubscriberFiltersEnvVarKey, "")
+	if len(kafkaSubscriberFilters) > 0 {
+		err = json.Unmarshal([]byte(kafkaSubscriberFilters), &environment.KafkaSubscriberFilters)
+		if err == nil {
+			err = consumer.ValidateSubscriberFilters(environment.KafkaSubscriberFilters)
+		}
+		if err != nil {
+			logger.Error("Invalid Kafka Subscriber Filters", zap.String("Value", kafkaSubscriberFilters), zap.Error(err))
+			return nil, fmt.Errorf("invalid (non json map of attribute filters) value '%s' for environment variable '%s'", kafkaSubscriberFilters, env.KafkaSubscriberFiltersEnvVarKey)
+		}
+	}
+
+	// Get The Optional KafkaExtensionFilter Config Value (JSON Encoded CloudEvent Extension Allow / Deny Lists)
+	kafkaExtensionFilter := env.GetOptionalConfigValue(logger, env.KafkaExtensionFilterEnvVarKey, "")
+	if len(kafkaExtensionFilter) > 0 {
+		extensionFilter := &kafkautil.ExtensionFilter{}
+		err = json.Unmarshal([]byte(kafkaExtensionFilter), extensionFilter)
+		if err == nil {
+			environment.KafkaExtensionFilter, err = kafkautil.NewExtensionFilter(extensionFilter.Allow, extensionFilter.Deny)
+		}
+		if err != nil {
+			logger.Error("Invalid Kafka Extension Filter", zap.String("Value", kafkaExtensionFilter), zap.Error(err))
+			return nil, fmt.Errorf("invalid (non json extension allow / deny lists) value '%s' for environment variable '%s'", kafkaExtensionFilter, env.KafkaExtensionFilterEnvVarKey)
+		}
+	}
+
+	// Get The Optional KafkaReplayFromTimestamp Config Value (RFC3339 Timestamp)
+	kafkaReplayFromTimestamp := env.GetOptionalConfigValue(logger, env.KafkaReplayFromTimestampEnvVarKey, "")
+	if len(kafkaReplayFromTimestamp) > 0 {
+		environment.KafkaReplayFromTimestamp, err = time.Parse(time.RFC3339, kafkaReplayFromTimestamp)
+		if err != nil {
+			logger.Error("Invalid Kafka Replay From Timestamp", zap.String("Value", kafkaReplayFromTimestamp), zap.Error(err))
+			return nil, fmt.Errorf("invalid (non RFC3339 timestamp) value '%s' for environment variable '%s'", kafkaReplayFromTimestamp, env.KafkaReplayFromTimestampEnvVarKey)
+		}
+	}
+
+	// Get The Optional KafkaReadinessIntervalSeconds Config Value (Must Be Positive)
+	environment.KafkaReadinessIntervalSeconds, err = env.GetOptionalConfigInt64(logger, env.KafkaReadinessIntervalEnvVarKey, constants.DefaultKafkaReadinessIntervalSeconds, "KafkaReadinessIntervalSeconds")
+	if err != nil {
+		return nil, err
+	} else if environment.KafkaReadinessIntervalSeconds <= 0 {
+		return nil, fmt.Errorf("invalid (non positive) value '%d' for environment variable '%s'", environment.KafkaReadinessIntervalSeconds, env.KafkaReadinessIntervalEnvVarKey)
+	}
+
+	// Get The Optional DrainTimeoutSeconds Config Value (Must Not Be Negative)
+	environment.DrainTimeoutSeconds, err = env.GetOptionalConfigInt64(logger, env.DrainTimeoutEnvVarKey, constants.DefaultDrainTimeoutSeconds, "DrainTimeoutSeconds")
+	if err != nil {
+		return nil, err
+	} else if environment.DrainTimeoutSeconds < 0 {
+		return nil, fmt.Errorf("invalid (negative) value '%d' for environment variable '%s'", environment.DrainTimeoutSeconds, env.DrainTimeoutEnvVarKey)
+	}
+
+	// Get The Optional ConsumerLagIntervalSeconds Config Value (Must Be Positive)
+	environment.ConsumerLagIntervalSeconds, err = env.GetOptionalConfigInt64(logger, env.ConsumerLagIntervalEnvVarKey, constants.DefaultConsumerLagIntervalSeconds, "ConsumerLagIntervalSeconds")
+	if err != nil {
+		return nil, err
+	} else if environment.ConsumerLagIntervalSeconds <= 0 {
+		return nil, fmt.Errorf("invalid (non positive) value '%d' for environment variable '%s'", environment.ConsumerLagIntervalSeconds, env.ConsumerLagIntervalEnvVarKey)
+	}
+
+	// Get The Optional KafkaRackId & NodeName Config Values
+	environment.KafkaRackId = env.GetOptionalConfigValue(logger, env.KafkaRackIdEnvVarKey, "")
+	environment.NodeName = env.GetOptionalConfigValue(logger, env.NodeNameEnvVarKey, "")
+
 	// Get The Optional KafkaUsername Config Value
 	environment.KafkaUsername = env.GetOptionalConfigValue(logger, env.KafkaUsernameEnvVarKey, "")
 
 	// Get The Optional KafkaPassword Config Value
 	environment.KafkaPassword = env.GetOptionalConfigValue(logger, env.KafkaPasswordEnvVarKey, "")
 
-	// Clone The Environment & Mask The Password For Safe Logging
+	// Get The Optional KafkaSaslMechanism Config Value
+	environment.KafkaSaslMechanism = env.GetOptionalConfigValue(logger, env.KafkaSaslMechanismEnvVarKey, "")
+
+	// Get The Optional KafkaTLSCert & KafkaTLSKey Config Values (mTLS)
+	environment.KafkaTLSCert = env.GetOptionalConfigValue(logger, env.KafkaTLSCertEnvVarKey, "")
+	environment.KafkaTLSKey = env.GetOptionalConfigValue(logger, env.KafkaTLSKeyEnvVarKey, "")
+
+	// Get The Optional KafkaTLSEnable Config Value (Kafka Secret Override Of The ConfigMap's Net.TLS.Enable)
+	environment.KafkaTLSEnable = env.GetOptionalConfigValue(logger, env.KafkaTLSEnableEnvVarKey, "")
+
+	// Clone The Environment & Mask The Password / TLS Key For Safe Logging
 	safeEnvironment := *environment
 	if len(safeEnvironment.KafkaPassword) > 0 {
 		safeEnvironment.KafkaPassword = "*************"
 	}
+	if len(safeEnvironment.KafkaTLSKey) > 0 {
+		safeEnvironment.KafkaTLSKey = "*************"
+	}
 
 	// Log The Dispatcher Configuration Loaded From Environment Variables
 	logger.Info("Environment Variables", zap.Any("Environment", safeEnvironment))
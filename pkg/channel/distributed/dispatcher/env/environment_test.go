@@ -22,42 +22,72 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	commonenv "knative.dev/eventing-kafka/pkg/channel/distributed/common/env"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/dispatcher/constants"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 )
 
 // Test Constants
 const (
-	metricsPort   = "9999"
-	metricsDomain = "kafka-eventing"
-	healthPort    = "1234"
-	kafkaBrokers  = "TestKafkaBrokers"
-	kafkaTopic    = "TestKafkaTopic"
-	channelKey    = "TestChannelKey"
-	serviceName   = "TestServiceName"
-	kafkaUsername = "TestKafkaUsername"
-	kafkaPassword = "TestKafkaPassword"
-	podName       = "TestPod"
-	containerName = "TestContainer"
+	metricsPort                     = "9999"
+	metricsDomain                   = "kafka-eventing"
+	healthPort                      = "1234"
+	kafkaBrokers                    = "TestKafkaBrokers"
+	kafkaTopic                      = "TestKafkaTopic"
+	channelKey                      = "TestChannelKey"
+	serviceName                     = "TestServiceName"
+	kafkaUsername                   = "TestKafkaUsername"
+	kafkaPassword                   = "TestKafkaPassword"
+	kafkaConsumerConfigOverrides    = `{"fetch.max":"1048576"}`
+	kafkaSubscriberConcurrency      = `{"TestSubscriptionUID":4}`
+	kafkaSubscriberOrdering         = `{"TestSubscriptionUID":"unordered"}`
+	kafkaSubscriberDeadLetterTopics = `{"TestSubscriptionUID":"TestDeadLetterTopic"}`
+	kafkaSubscriberInitialOffsets   = `{"TestSubscriptionUID":"latest"}`
+	kafkaSubscriberFilters          = `{"TestSubscriptionUID":{"attributes":{"type":"TestType"}}}`
+	kafkaReplayFromTimestamp        = "2020-11-12T13:14:15Z"
+	kafkaExtensionFilter            = `{"allow":["traceparent","custom"],"deny":["internal"]}`
+	kafkaReadinessInterval          = "15"
+	drainTimeout                    = "45"
+	consumerLagInterval             = "60"
+	kafkaRackId                     = "TestKafkaRackId"
+	nodeName                        = "TestNodeName"
+	podName                         = "TestPod"
+	containerName                   = "TestContainer"
 )
 
 // Define The TestCase Struct
 type TestCase struct {
-	name          string
-	metricsPort   string
-	metricsDomain string
-	healthPort    string
-	kafkaBrokers  string
-	kafkaTopic    string
-	channelKey    string
-	serviceName   string
-	kafkaUsername string
-	kafkaPassword string
-	podName       string
-	containerName string
-	expectedError error
+	name                            string
+	metricsPort                     string
+	metricsDomain                   string
+	healthPort                      string
+	kafkaBrokers                    string
+	kafkaTopic                      string
+	channelKey                      string
+	serviceName                     string
+	kafkaUsername                   string
+	kafkaPassword                   string
+	kafkaConsumerConfigOverrides    string
+	kafkaSubscriberConcurrency      string
+	kafkaSubscriberOrdering         string
+	kafkaSubscriberDeadLetterTopics string
+	kafkaSubscriberInitialOffsets   string
+	kafkaSubscriberFilters          string
+	kafkaReplayFromTimestamp        string
+	kafkaExtensionFilter            string
+	kafkaReadinessInterval          string
+	drainTimeout                    string
+	consumerLagInterval             string
+	kafkaRackId                     string
+	nodeName                        string
+	podName                         string
+	containerName                   string
+	expectedError                   error
 }
 
 // Test All Permutations Of The GetEnvironment() Functionality
@@ -116,6 +146,121 @@ func TestGetEnvironment(t *testing.T) {
 	testCase.expectedError = getMissingRequiredEnvironmentVariableError(commonenv.ServiceNameEnvVarKey)
 	testCases = append(testCases, testCase)
 
+	testCase = getValidTestCase("Invalid Config - KafkaConsumerConfigOverrides")
+	testCase.kafkaConsumerConfigOverrides = "NOT JSON"
+	testCase.expectedError = fmt.Errorf("invalid (non json map) value '%s' for environment variable '%s'", testCase.kafkaConsumerConfigOverrides, commonenv.KafkaConsumerConfigOverridesEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - KafkaSubscriberConcurrency")
+	testCase.kafkaSubscriberConcurrency = `{"TestSubscriptionUID":0}`
+	testCase.expectedError = fmt.Errorf("invalid (non json map of positive worker counts) value '%s' for environment variable '%s'", testCase.kafkaSubscriberConcurrency, commonenv.KafkaSubscriberConcurrencyEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - KafkaSubscriberConcurrency")
+	testCase.kafkaSubscriberConcurrency = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - KafkaSubscriberOrdering")
+	testCase.kafkaSubscriberOrdering = `{"TestSubscriptionUID":"sometimes"}`
+	testCase.expectedError = fmt.Errorf("invalid (non json map of ordering modes) value '%s' for environment variable '%s'", testCase.kafkaSubscriberOrdering, commonenv.KafkaSubscriberOrderingEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - KafkaSubscriberOrdering")
+	testCase.kafkaSubscriberOrdering = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - KafkaSubscriberDeadLetterTopics")
+	testCase.kafkaSubscriberDeadLetterTopics = `{"TestSubscriptionUID":"dead/letter"}`
+	testCase.expectedError = fmt.Errorf("invalid (non json map of kafka topic names) value '%s' for environment variable '%s'", testCase.kafkaSubscriberDeadLetterTopics, commonenv.KafkaSubscriberDeadLetterTopicsEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - KafkaSubscriberDeadLetterTopics")
+	testCase.kafkaSubscriberDeadLetterTopics = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - KafkaSubscriberInitialOffsets")
+	testCase.kafkaSubscriberInitialOffsets = `{"TestSubscriptionUID":"newest"}`
+	testCase.expectedError = fmt.Errorf("invalid (non json map of initial offset policies) value '%s' for environment variable '%s'", testCase.kafkaSubscriberInitialOffsets, commonenv.KafkaSubscriberInitialOffsetsEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - KafkaSubscriberInitialOffsets")
+	testCase.kafkaSubscriberInitialOffsets = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - KafkaSubscriberFilters")
+	testCase.kafkaSubscriberFilters = `{"TestSubscriptionUID":{"attributes":{"Type":"TestType"}}}`
+	testCase.expectedError = fmt.Errorf("invalid (non json map of attribute filters) value '%s' for environment variable '%s'", testCase.kafkaSubscriberFilters, commonenv.KafkaSubscriberFiltersEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - KafkaSubscriberFilters")
+	testCase.kafkaSubscriberFilters = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - KafkaReplayFromTimestamp")
+	testCase.kafkaReplayFromTimestamp = "yesterday"
+	testCase.expectedError = fmt.Errorf("invalid (non RFC3339 timestamp) value '%s' for environment variable '%s'", testCase.kafkaReplayFromTimestamp, commonenv.KafkaReplayFromTimestampEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - KafkaReplayFromTimestamp")
+	testCase.kafkaReplayFromTimestamp = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - KafkaExtensionFilter")
+	testCase.kafkaExtensionFilter = `{"deny":["id"]}`
+	testCase.expectedError = fmt.Errorf("invalid (non json extension allow / deny lists) value '%s' for environment variable '%s'", testCase.kafkaExtensionFilter, commonenv.KafkaExtensionFilterEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - KafkaExtensionFilter")
+	testCase.kafkaExtensionFilter = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - KafkaReadinessInterval")
+	testCase.kafkaReadinessInterval = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - KafkaReadinessInterval")
+	testCase.kafkaReadinessInterval = "NAN"
+	testCase.expectedError = fmt.Errorf("invalid (non int64) value '%s' for environment variable '%s'", testCase.kafkaReadinessInterval, commonenv.KafkaReadinessIntervalEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - KafkaReadinessInterval Non Positive")
+	testCase.kafkaReadinessInterval = "0"
+	testCase.expectedError = fmt.Errorf("invalid (non positive) value '%s' for environment variable '%s'", testCase.kafkaReadinessInterval, commonenv.KafkaReadinessIntervalEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - DrainTimeout")
+	testCase.drainTimeout = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - DrainTimeout")
+	testCase.drainTimeout = "NAN"
+	testCase.expectedError = fmt.Errorf("invalid (non int64) value '%s' for environment variable '%s'", testCase.drainTimeout, commonenv.DrainTimeoutEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - DrainTimeout Negative")
+	testCase.drainTimeout = "-1"
+	testCase.expectedError = fmt.Errorf("invalid (negative) value '%s' for environment variable '%s'", testCase.drainTimeout, commonenv.DrainTimeoutEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - KafkaRackId & NodeName")
+	testCase.kafkaRackId = ""
+	testCase.nodeName = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Missing Optional Config - ConsumerLagInterval")
+	testCase.consumerLagInterval = ""
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - ConsumerLagInterval")
+	testCase.consumerLagInterval = "NAN"
+	testCase.expectedError = fmt.Errorf("invalid (non int64) value '%s' for environment variable '%s'", testCase.consumerLagInterval, commonenv.ConsumerLagIntervalEnvVarKey)
+	testCases = append(testCases, testCase)
+
+	testCase = getValidTestCase("Invalid Config - ConsumerLagInterval Non Positive")
+	testCase.consumerLagInterval = "0"
+	testCase.expectedError = fmt.Errorf("invalid (non positive) value '%s' for environment variable '%s'", testCase.consumerLagInterval, commonenv.ConsumerLagIntervalEnvVarKey)
+	testCases = append(testCases, testCase)
+
 	testCase = getValidTestCase("Missing Required Config - PodName")
 	testCase.podName = ""
 	testCase.expectedError = getMissingRequiredEnvironmentVariableError(commonenv.PodNameEnvVarKey)
@@ -140,6 +285,19 @@ func TestGetEnvironment(t *testing.T) {
 		assertSetenv(t, commonenv.ServiceNameEnvVarKey, testCase.serviceName)
 		assertSetenv(t, commonenv.KafkaUsernameEnvVarKey, testCase.kafkaUsername)
 		assertSetenv(t, commonenv.KafkaPasswordEnvVarKey, testCase.kafkaPassword)
+		assertSetenv(t, commonenv.KafkaConsumerConfigOverridesEnvVarKey, testCase.kafkaConsumerConfigOverrides)
+		assertSetenvNonempty(t, commonenv.KafkaSubscriberConcurrencyEnvVarKey, testCase.kafkaSubscriberConcurrency)
+		assertSetenvNonempty(t, commonenv.KafkaSubscriberOrderingEnvVarKey, testCase.kafkaSubscriberOrdering)
+		assertSetenvNonempty(t, commonenv.KafkaSubscriberDeadLetterTopicsEnvVarKey, testCase.kafkaSubscriberDeadLetterTopics)
+		assertSetenvNonempty(t, commonenv.KafkaSubscriberInitialOffsetsEnvVarKey, testCase.kafkaSubscriberInitialOffsets)
+		assertSetenvNonempty(t, commonenv.KafkaSubscriberFiltersEnvVarKey, testCase.kafkaSubscriberFilters)
+		assertSetenvNonempty(t, commonenv.KafkaReplayFromTimestampEnvVarKey, testCase.kafkaReplayFromTimestamp)
+		assertSetenvNonempty(t, commonenv.KafkaExtensionFilterEnvVarKey, testCase.kafkaExtensionFilter)
+		assertSetenvNonempty(t, commonenv.KafkaReadinessIntervalEnvVarKey, testCase.kafkaReadinessInterval)
+		assertSetenvNonempty(t, commonenv.DrainTimeoutEnvVarKey, testCase.drainTimeout)
+		assertSetenvNonempty(t, commonenv.ConsumerLagIntervalEnvVarKey, testCase.consumerLagInterval)
+		assertSetenvNonempty(t, commonenv.KafkaRackIdEnvVarKey, testCase.kafkaRackId)
+		assertSetenvNonempty(t, commonenv.NodeNameEnvVarKey, testCase.nodeName)
 		assertSetenv(t, commonenv.PodNameEnvVarKey, testCase.podName)
 		assertSetenv(t, commonenv.ContainerNameEnvVarKEy, testCase.containerName)
 
@@ -159,6 +317,59 @@ func TestGetEnvironment(t *testing.T) {
 			assert.Equal(t, testCase.serviceName, environment.ServiceName)
 			assert.Equal(t, testCase.kafkaUsername, environment.KafkaUsername)
 			assert.Equal(t, testCase.kafkaPassword, environment.KafkaPassword)
+			assert.Equal(t, map[string]string{"fetch.max": "1048576"}, environment.KafkaConsumerConfigOverrides)
+			if len(testCase.kafkaSubscriberConcurrency) > 0 {
+				assert.Equal(t, map[string]int{"TestSubscriptionUID": 4}, environment.KafkaSubscriberConcurrency)
+			} else {
+				assert.Nil(t, environment.KafkaSubscriberConcurrency)
+			}
+			if len(testCase.kafkaSubscriberOrdering) > 0 {
+				assert.Equal(t, map[string]string{"TestSubscriptionUID": "unordered"}, environment.KafkaSubscriberOrdering)
+			} else {
+				assert.Nil(t, environment.KafkaSubscriberOrdering)
+			}
+			if len(testCase.kafkaSubscriberDeadLetterTopics) > 0 {
+				assert.Equal(t, map[string]string{"TestSubscriptionUID": "TestDeadLetterTopic"}, environment.KafkaSubscriberDeadLetterTopics)
+			} else {
+				assert.Nil(t, environment.KafkaSubscriberDeadLetterTopics)
+			}
+			if len(testCase.kafkaSubscriberInitialOffsets) > 0 {
+				assert.Equal(t, map[string]string{"TestSubscriptionUID": "latest"}, environment.KafkaSubscriberInitialOffsets)
+			} else {
+				assert.Nil(t, environment.KafkaSubscriberInitialOffsets)
+			}
+			if len(testCase.kafkaSubscriberFilters) > 0 {
+				assert.Equal(t, map[string]eventingv1.TriggerFilter{"TestSubscriptionUID": {Attributes: eventingv1.TriggerFilterAttributes{"type": "TestType"}}}, environment.KafkaSubscriberFilters)
+			} else {
+				assert.Nil(t, environment.KafkaSubscriberFilters)
+			}
+			if len(testCase.kafkaExtensionFilter) > 0 {
+				assert.Equal(t, &kafkautil.ExtensionFilter{Allow: []string{"traceparent", "custom"}, Deny: []string{"internal"}}, environment.KafkaExtensionFilter)
+			} else {
+				assert.Nil(t, environment.KafkaExtensionFilter)
+			}
+			if len(testCase.kafkaReplayFromTimestamp) > 0 {
+				assert.Equal(t, time.Date(2020, 11, 12, 13, 14, 15, 0, time.UTC), environment.KafkaReplayFromTimestamp.UTC())
+			} else {
+				assert.True(t, environment.KafkaReplayFromTimestamp.IsZero())
+			}
+			if len(testCase.kafkaReadinessInterval) > 0 {
+				assert.Equal(t, testCase.kafkaReadinessInterval, strconv.FormatInt(environment.KafkaReadinessIntervalSeconds, 10))
+			} else {
+				assert.Equal(t, constants.DefaultKafkaReadinessIntervalSeconds, strconv.FormatInt(environment.KafkaReadinessIntervalSeconds, 10))
+			}
+			if len(testCase.drainTimeout) > 0 {
+				assert.Equal(t, testCase.drainTimeout, strconv.FormatInt(environment.DrainTimeoutSeconds, 10))
+			} else {
+				assert.Equal(t, constants.DefaultDrainTimeoutSeconds, strconv.FormatInt(environment.DrainTimeoutSeconds, 10))
+			}
+			if len(testCase.consumerLagInterval) > 0 {
+				assert.Equal(t, testCase.consumerLagInterval, strconv.FormatInt(environment.ConsumerLagIntervalSeconds, 10))
+			} else {
+				assert.Equal(t, constants.DefaultConsumerLagIntervalSeconds, strconv.FormatInt(environment.ConsumerLagIntervalSeconds, 10))
+			}
+			assert.Equal(t, testCase.kafkaRackId, environment.KafkaRackId)
+			assert.Equal(t, testCase.nodeName, environment.NodeName)
 			assert.Equal(t, testCase.podName, environment.PodName)
 			assert.Equal(t, testCase.containerName, environment.ContainerName)
 
@@ -183,19 +394,32 @@ func assertSetenvNonempty(t *testing.T, envKey string, value string) {
 // Get The Base / Valid Test Case - All Config Specified / No Errors
 func getValidTestCase(name string) TestCase {
 	return TestCase{
-		name:          name,
-		metricsPort:   metricsPort,
-		metricsDomain: metricsDomain,
-		healthPort:    healthPort,
-		kafkaBrokers:  kafkaBrokers,
-		kafkaTopic:    kafkaTopic,
-		channelKey:    channelKey,
-		serviceName:   serviceName,
-		kafkaUsername: kafkaUsername,
-		kafkaPassword: kafkaPassword,
-		podName:       podName,
-		containerName: containerName,
-		expectedError: nil,
+		name:                            name,
+		metricsPort:                     metricsPort,
+		metricsDomain:                   metricsDomain,
+		healthPort:                      healthPort,
+		kafkaBrokers:                    kafkaBrokers,
+		kafkaTopic:                      kafkaTopic,
+		channelKey:                      channelKey,
+		serviceName:                     serviceName,
+		kafkaUsername:                   kafkaUsername,
+		kafkaPassword:                   kafkaPassword,
+		kafkaConsumerConfigOverrides:    kafkaConsumerConfigOverrides,
+		kafkaSubscriberConcurrency:      kafkaSubscriberConcurrency,
+		kafkaSubscriberOrdering:         kafkaSubscriberOrdering,
+		kafkaSubscriberDeadLetterTopics: kafkaSubscriberDeadLetterTopics,
+		kafkaSubscriberInitialOffsets:   kafkaSubscriberInitialOffsets,
+		kafkaSubscriberFilters:          kafkaSubscriberFilters,
+		kafkaReplayFromTimestamp:        kafkaReplayFromTimestamp,
+		kafkaExtensionFilter:            kafkaExtensionFilter,
+		kafkaReadinessInterval:          kafkaReadinessInterval,
+		drainTimeout:                    drainTimeout,
+		consumerLagInterval:             consumerLagInterval,
+		kafkaRackId:                     kafkaRackId,
+		nodeName:                        nodeName,
+		podName:                         podName,
+		containerName:                   containerName,
+		expectedError:                   nil,
 	}
 }
 
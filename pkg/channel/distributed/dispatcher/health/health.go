@@ -17,6 +17,7 @@ limitations under the License.
 package health
 
 import (
+	"net/http"
 	"sync"
 
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/health"
@@ -29,15 +30,18 @@ type Server struct {
 
 	// Additional Synchronization Mutexes
 	dispatcherMutex sync.Mutex // Synchronizes access to the dispatcherReady flag
+	kafkaMutex      sync.Mutex // Synchronizes access to the kafkaReady flag
 
 	// Additional Internal Flags
 	dispatcherReady bool // A flag that the producer sets when it is ready
+	kafkaReady      bool // A flag that is set when the ConsumerGroups have joined and the Kafka Brokers are reachable
 }
 
 // Creates A New Server With Specified Configuration
 func NewDispatcherHealthServer(httpPort string) *Server {
 	dispatcherHealth := &Server{}
 	dispatcherHealth.Server = *health.NewHealthServer(httpPort, dispatcherHealth)
+	dispatcherHealth.Server.HandleFunc(health.KafkaReadinessPath, dispatcherHealth.HandleKafkaReadiness)
 
 	// Return The Server
 	return dispatcherHealth
@@ -50,10 +54,18 @@ func (chs *Server) SetDispatcherReady(isReady bool) {
 	chs.dispatcherMutex.Unlock()
 }
 
+// Synchronized Function To Set Kafka Ready Flag
+func (chs *Server) SetKafkaReady(isReady bool) {
+	chs.kafkaMutex.Lock()
+	chs.kafkaReady = isReady
+	chs.kafkaMutex.Unlock()
+}
+
 // Set All Liveness And Readiness Flags To False
 func (chs *Server) Shutdown() {
 	chs.Server.Shutdown()
 	chs.SetDispatcherReady(false)
+	chs.SetKafkaReady(false)
 }
 
 // Access Function For DispatcherReady Flag
@@ -61,6 +73,26 @@ func (chs *Server) DispatcherReady() bool {
 	return chs.dispatcherReady
 }
 
+// Synchronized Access Function For KafkaReady Flag
+func (chs *Server) KafkaReady() bool {
+	chs.kafkaMutex.Lock()
+	defer chs.kafkaMutex.Unlock()
+	return chs.kafkaReady
+}
+
+// HTTP Request Handler For Kafka Connectivity Readiness Requests (/readyz)
+func (chs *Server) HandleKafkaReadiness(responseWriter http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		responseWriter.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if chs.Ready() && chs.KafkaReady() {
+		responseWriter.WriteHeader(http.StatusOK)
+	} else {
+		responseWriter.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
 // Functions That Implement The HealthInterface
 
 // Response Function For Readiness Requests (/healthy)
@@ -26,8 +26,9 @@ import (
 )
 
 const (
-	testHttpPort  = "0"
-	readinessPath = "/healthy"
+	testHttpPort       = "0"
+	readinessPath      = "/healthy"
+	kafkaReadinessPath = "/readyz"
 )
 
 // Test The NewDispatcherHealthServer() Functionality
@@ -53,6 +54,12 @@ func TestReadinessFlagWrites(t *testing.T) {
 	assert.Equal(t, false, chs.DispatcherReady())
 	chs.SetDispatcherReady(true)
 	assert.Equal(t, true, chs.DispatcherReady())
+
+	// Test Kafka Readiness Flags
+	chs.SetKafkaReady(false)
+	assert.Equal(t, false, chs.KafkaReady())
+	chs.SetKafkaReady(true)
+	assert.Equal(t, true, chs.KafkaReady())
 }
 
 // Test The Dispatcher Health Server Via The HTTP Handlers
@@ -76,6 +83,37 @@ func TestDispatcherHealthHandler(t *testing.T) {
 	getEventToHandler(t, chs.HandleReadiness, readinessPath, http.StatusInternalServerError)
 }
 
+// Test The Dispatcher Kafka Readiness (/readyz) Via The HTTP Handlers
+func TestDispatcherKafkaReadinessHandler(t *testing.T) {
+
+	// Create A New Health Server
+	chs := NewDispatcherHealthServer(testHttpPort)
+
+	// Verify that initially the kafka readiness status is false
+	getEventToHandler(t, chs.HandleKafkaReadiness, kafkaReadinessPath, http.StatusInternalServerError)
+
+	// Verify that the kafka readiness status requires both the dispatcher and kafka readiness flags
+	chs.SetKafkaReady(true)
+	getEventToHandler(t, chs.HandleKafkaReadiness, kafkaReadinessPath, http.StatusInternalServerError)
+	chs.SetDispatcherReady(true)
+	getEventToHandler(t, chs.HandleKafkaReadiness, kafkaReadinessPath, http.StatusOK)
+
+	// Verify that losing kafka connectivity flips the kafka readiness status
+	chs.SetKafkaReady(false)
+	getEventToHandler(t, chs.HandleKafkaReadiness, kafkaReadinessPath, http.StatusInternalServerError)
+
+	// Verify that the shutdown process sets the kafka readiness status to not ready
+	chs.SetKafkaReady(true)
+	getEventToHandler(t, chs.HandleKafkaReadiness, kafkaReadinessPath, http.StatusOK)
+	chs.Shutdown()
+	getEventToHandler(t, chs.HandleKafkaReadiness, kafkaReadinessPath, http.StatusInternalServerError)
+
+	// Verify that non-GET requests are not allowed
+	responseRecorder := httptest.NewRecorder()
+	chs.HandleKafkaReadiness(responseRecorder, createNewRequest(t, http.MethodPost, kafkaReadinessPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, responseRecorder.Code)
+}
+
 //
 // Private Utility Functions
 //
@@ -20,6 +20,7 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"sync/atomic"
 	"testing"
 
 	"github.com/Shopify/sarama"
@@ -45,6 +46,7 @@ type MockMessageDispatcher struct {
 	expectedDeadLetterUrl  *url.URL
 	expectedRetryConfig    *kncloudevents.RetryConfig
 	message                cloudevents.Message
+	deadLetterMessage      cloudevents.Message
 	response               error
 }
 
@@ -75,26 +77,41 @@ func (m *MockMessageDispatcher) DispatchMessage(ctx context.Context, message clo
 
 func (m *MockMessageDispatcher) DispatchMessageWithRetries(ctx context.Context, message cloudevents.Message, headers http.Header, destinationUrl *url.URL, replyUrl *url.URL, deadLetterUrl *url.URL, retryConfig *kncloudevents.RetryConfig) (*channel.DispatchExecutionInfo, error) {
 
-	// Validate The Expected Args
+	// Validate The Expected Common Args
 	assert.NotNil(m.t, ctx)
 	assert.NotNil(m.t, message)
 	assert.Equal(m.t, m.expectedHeaders, headers)
-	assert.Equal(m.t, m.expectedDestinationUrl, destinationUrl)
-	assert.Equal(m.t, m.expectedReplyUrl, replyUrl)
-	assert.Equal(m.t, m.expectedDeadLetterUrl, deadLetterUrl)
+	assert.Nil(m.t, deadLetterUrl) // The Handler Sends To The Dead Letter Sink Itself (With Failure Metadata)
 	assert.Equal(m.t, m.expectedRetryConfig.RetryMax, retryConfig.RetryMax)
 
-	// Track The Received Message
+	// A Subsequent Dispatch (After A Failed Delivery) Is To The Dead Letter Sink
+	if m.message != nil {
+		assert.Equal(m.t, m.expectedDeadLetterUrl, destinationUrl)
+		assert.Nil(m.t, replyUrl)
+		m.deadLetterMessage = message
+		return &channel.DispatchExecutionInfo{ResponseCode: http.StatusAccepted}, nil
+	}
+
+	// Validate The Expected Subscriber Args & Track The Received Message
+	assert.Equal(m.t, m.expectedDestinationUrl, destinationUrl)
+	assert.Equal(m.t, m.expectedReplyUrl, replyUrl)
 	m.message = message
 
 	// Return The Desired Error Response
-	return &channel.DispatchExecutionInfo{}, m.response
+	if m.response != nil {
+		return &channel.DispatchExecutionInfo{ResponseCode: http.StatusInternalServerError}, m.response
+	}
+	return &channel.DispatchExecutionInfo{ResponseCode: http.StatusAccepted}, nil
 }
 
 func (m *MockMessageDispatcher) Message() cloudevents.Message {
 	return m.message
 }
 
+func (m *MockMessageDispatcher) DeadLetterMessage() cloudevents.Message {
+	return m.deadLetterMessage
+}
+
 //
 // Mock ConsumerGroupSession Implementation
 //
@@ -104,13 +121,20 @@ var _ sarama.ConsumerGroupSession = &MockConsumerGroupSession{}
 
 // Define The Mock ConsumerGroupSession
 type MockConsumerGroupSession struct {
-	t               *testing.T
+	t               testing.TB
+	ctx             context.Context
 	MarkMessageChan chan *sarama.ConsumerMessage
+	commitCount     *int32
 }
 
 // Mock ConsumerGroupSession Constructor
-func NewMockConsumerGroupSession(t *testing.T) MockConsumerGroupSession {
-	return MockConsumerGroupSession{t: t, MarkMessageChan: make(chan *sarama.ConsumerMessage)}
+func NewMockConsumerGroupSession(t testing.TB) MockConsumerGroupSession {
+	return NewMockConsumerGroupSessionWithContext(t, context.TODO())
+}
+
+// Mock ConsumerGroupSession Constructor With The Specified (Session) Context
+func NewMockConsumerGroupSessionWithContext(t testing.TB, ctx context.Context) MockConsumerGroupSession {
+	return MockConsumerGroupSession{t: t, ctx: ctx, MarkMessageChan: make(chan *sarama.ConsumerMessage), commitCount: new(int32)}
 }
 
 func (m MockConsumerGroupSession) Claims() map[string][]int32 {
@@ -139,11 +163,16 @@ func (m MockConsumerGroupSession) MarkMessage(msg *sarama.ConsumerMessage, metad
 }
 
 func (m MockConsumerGroupSession) Context() context.Context {
-	return context.TODO()
+	return m.ctx
 }
 
 func (m MockConsumerGroupSession) Commit() {
-	panic("implement me")
+	atomic.AddInt32(m.commitCount, 1)
+}
+
+// Get The Number Of Times Commit() Was Called
+func (m MockConsumerGroupSession) CommitCount() int {
+	return int(atomic.LoadInt32(m.commitCount))
 }
 
 //
@@ -155,12 +184,12 @@ var _ sarama.ConsumerGroupClaim = &MockConsumerGroupClaim{}
 
 // Define The Mock ConsumerGroupSession
 type MockConsumerGroupClaim struct {
-	t           *testing.T
+	t           testing.TB
 	MessageChan chan *sarama.ConsumerMessage
 }
 
 // Mock ConsumerGroupClaim Constructor
-func NewMockConsumerGroupClaim(t *testing.T) MockConsumerGroupClaim {
+func NewMockConsumerGroupClaim(t testing.TB) MockConsumerGroupClaim {
 	return MockConsumerGroupClaim{t: t, MessageChan: make(chan *sarama.ConsumerMessage)}
 }
 
@@ -183,3 +212,54 @@ func (m MockConsumerGroupClaim) HighWaterMarkOffset() int64 {
 func (m MockConsumerGroupClaim) Messages() <-chan *sarama.ConsumerMessage {
 	return m.MessageChan
 }
+
+//
+// Mock Sarama SyncProducer Implementation
+//
+
+// Verify The Mock SyncProducer Implements The Interface
+var _ sarama.SyncProducer = &MockSyncProducer{}
+
+// Define The Mock SyncProducer
+type MockSyncProducer struct {
+	producerMessages chan sarama.ProducerMessage
+	response         error
+	offset           int64
+	closed           bool
+}
+
+// Mock SyncProducer Constructor (Responds To Every SendMessage With The Specified Error)
+func NewMockSyncProducer(response error) *MockSyncProducer {
+	return &MockSyncProducer{
+		producerMessages: make(chan sarama.ProducerMessage, 1),
+		response:         response,
+	}
+}
+
+func (p *MockSyncProducer) SendMessage(msg *sarama.ProducerMessage) (partition int32, offset int64, err error) {
+	if p.response != nil {
+		return -1, -1, p.response
+	}
+	p.producerMessages <- *msg
+	p.offset = p.offset + 1
+	return 1, p.offset, nil
+}
+
+func (p *MockSyncProducer) SendMessages(_ []*sarama.ProducerMessage) error {
+	// Not Currently In Use - No Need To Mock
+	return nil
+}
+
+func (p *MockSyncProducer) GetMessage() sarama.ProducerMessage {
+	return <-p.producerMessages
+}
+
+func (p *MockSyncProducer) Close() error {
+	p.closed = true
+	close(p.producerMessages)
+	return nil
+}
+
+func (p *MockSyncProducer) Closed() bool {
+	return p.closed
+}
@@ -19,10 +19,14 @@ package channel
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"go.uber.org/zap"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	k8sclientcmd "k8s.io/client-go/tools/clientcmd"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/receiver/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/receiver/health"
 	kafkaclientset "knative.dev/eventing-kafka/pkg/client/clientset/versioned"
 	kafkainformers "knative.dev/eventing-kafka/pkg/client/informers/externalversions"
@@ -122,6 +126,89 @@ func ValidateKafkaChannel(channelReference eventingChannel.ChannelReference) err
 	return nil
 }
 
+// Get The CloudEvent Attribute Selected As The Partition Key For The Specified KafkaChannel
+// An Empty String Is Returned (Default Partition Key Behavior) If Not Annotated Or Not Found
+func PartitionKeyAttribute(channelReference eventingChannel.ChannelReference) string {
+
+	// Attempt To Get The KafkaChannel From The KafkaChannel Lister
+	kafkaChannel, err := kafkaChannelLister.KafkaChannels(channelReference.Namespace).Get(channelReference.Name)
+	if err != nil || kafkaChannel == nil {
+		return ""
+	}
+
+	// Return The Normalized (CloudEvent Attribute Names Are Lowercase) Annotation Value
+	return strings.ToLower(strings.TrimSpace(kafkaChannel.Annotations[constants.PartitionKeyAnnotation]))
+}
+
+// Get The CloudEvent Content Mode ("binary" or "structured") Annotated On The Specified KafkaChannel
+// An Empty String Is Returned (Default Content Mode Behavior) If Not Annotated Or Not Found
+func ContentMode(channelReference eventingChannel.ChannelReference) string {
+
+	// Attempt To Get The KafkaChannel From The KafkaChannel Lister
+	kafkaChannel, err := kafkaChannelLister.KafkaChannels(channelReference.Namespace).Get(channelReference.Name)
+	if err != nil || kafkaChannel == nil {
+		return ""
+	}
+
+	// Return The Normalized Annotation Value (Invalid Values Are Rejected By The Webhook)
+	return strings.ToLower(strings.TrimSpace(kafkaChannel.Annotations[kafkaconstants.ContentModeAnnotation]))
+}
+
+// Get The Producer Required Acks (NoResponse, WaitForLocal or WaitForAll) Annotated On The Specified KafkaChannel
+// An Empty String Is Returned (The Producer's Configured RequiredAcks Apply) If Not Annotated Or Not Found
+func RequiredAcks(channelReference eventingChannel.ChannelReference) string {
+
+	// Attempt To Get The KafkaChannel From The KafkaChannel Lister
+	kafkaChannel, err := kafkaChannelLister.KafkaChannels(channelReference.Namespace).Get(channelReference.Name)
+	if err != nil || kafkaChannel == nil {
+		return ""
+	}
+
+	// Return The Trimmed Annotation Value (Invalid Values Are Rejected By The Webhook)
+	return strings.TrimSpace(kafkaChannel.Annotations[kafkaconstants.RequiredAcksAnnotation])
+}
+
+// Get The Maximum Event Size (In Bytes) Annotated On The Specified KafkaChannel
+// Zero Is Returned (Only The Producer's Sarama Limit Applies) If Not Annotated, Invalid Or Not Found
+func MaxMessageBytes(channelReference eventingChannel.ChannelReference) int32 {
+
+	// Attempt To Get The KafkaChannel From The KafkaChannel Lister
+	kafkaChannel, err := kafkaChannelLister.KafkaChannels(channelReference.Namespace).Get(channelReference.Name)
+	if err != nil || kafkaChannel == nil {
+		return 0
+	}
+
+	// Return The Parsed Annotation Value (Invalid Values Are Rejected By The Webhook)
+	maxMessageBytes, err := kafkautil.ParseMaxMessageBytes(kafkaChannel.Annotations[kafkaconstants.MaxMessageBytesAnnotation])
+	if err != nil {
+		logger.Warn("Invalid KafkaChannel Max Message Bytes Annotation - Ignoring", zap.Error(err))
+		return 0
+	}
+	return maxMessageBytes
+}
+
+// Get The CloudEvent ExtensionFilter For The Specified KafkaChannel (Its Annotations Overriding The Default Allow / Deny Lists)
+// The Default Lists Apply If Not Annotated, Invalid Or Not Found (A Nil Filter Propagates All Extensions)
+func ExtensionFilter(channelReference eventingChannel.ChannelReference, defaultAllow []string, defaultDeny []string) *kafkautil.ExtensionFilter {
+
+	// Get The Default ExtensionFilter (Already Validated When Loading The Config)
+	defaultFilter, _ := kafkautil.NewExtensionFilter(defaultAllow, defaultDeny)
+
+	// Attempt To Get The KafkaChannel From The KafkaChannel Lister
+	kafkaChannel, err := kafkaChannelLister.KafkaChannels(channelReference.Namespace).Get(channelReference.Name)
+	if err != nil || kafkaChannel == nil {
+		return defaultFilter
+	}
+
+	// Return The ExtensionFilter From The Annotations (Invalid Values Are Rejected By The Webhook)
+	extensionFilter, err := kafkautil.ExtensionFilterFor(kafkaChannel.Annotations, defaultAllow, defaultDeny)
+	if err != nil {
+		logger.Warn("Invalid KafkaChannel Extension Annotations - Ignoring", zap.Error(err))
+		return defaultFilter
+	}
+	return extensionFilter
+}
+
 // Close The Channel Lister (Stop Processing)
 func Close() {
 	if stopChan != nil {
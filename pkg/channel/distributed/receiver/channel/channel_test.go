@@ -22,10 +22,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/receiver/constants"
 	channelhealth "knative.dev/eventing-kafka/pkg/channel/distributed/receiver/health"
 	receivertesting "knative.dev/eventing-kafka/pkg/channel/distributed/receiver/testing"
 	kafkaclientset "knative.dev/eventing-kafka/pkg/client/clientset/versioned"
 	fakeclientset "knative.dev/eventing-kafka/pkg/client/clientset/versioned/fake"
+	kafkalisters "knative.dev/eventing-kafka/pkg/client/listers/messaging/v1beta1"
 	"knative.dev/pkg/logging"
 	logtesting "knative.dev/pkg/logging/testing"
 )
@@ -86,6 +91,146 @@ func performValidateKafkaChannelTest(t *testing.T, channelName string, channelNa
 	assert.Equal(t, err, validationError != nil)
 }
 
+// Test The PartitionKeyAttribute() Functionality
+func TestPartitionKeyAttribute(t *testing.T) {
+
+	// Test Data
+	channelName := "TestChannelName"
+	channelNamespace := "TestChannelNamespace"
+	channelReference := receivertesting.CreateChannelReference(channelName, channelNamespace)
+
+	// Verify The Default (Empty) Attribute When The KafkaChannel Is Not Found
+	kafkaChannelLister = receivertesting.NewMockKafkaChannelLister(channelName, channelNamespace, false, corev1.ConditionTrue, false)
+	assert.Equal(t, "", PartitionKeyAttribute(channelReference))
+
+	// Verify The Default (Empty) Attribute When The KafkaChannel Is Not Annotated
+	kafkaChannelLister = receivertesting.NewMockKafkaChannelLister(channelName, channelNamespace, true, corev1.ConditionTrue, false)
+	assert.Equal(t, "", PartitionKeyAttribute(channelReference))
+
+	// Verify The Normalized Attribute When The KafkaChannel Is Annotated
+	kafkaChannel := receivertesting.CreateKafkaChannel(channelName, channelNamespace, corev1.ConditionTrue)
+	kafkaChannel.Annotations = map[string]string{constants.PartitionKeyAnnotation: " Subject "}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.Nil(t, indexer.Add(kafkaChannel))
+	kafkaChannelLister = kafkalisters.NewKafkaChannelLister(indexer)
+	assert.Equal(t, "subject", PartitionKeyAttribute(channelReference))
+}
+
+// Test The ContentMode() Functionality
+func TestContentMode(t *testing.T) {
+
+	// Test Data
+	channelName := "TestChannelName"
+	channelNamespace := "TestChannelNamespace"
+	channelReference := receivertesting.CreateChannelReference(channelName, channelNamespace)
+
+	// Verify The Default (Empty) Content Mode When The KafkaChannel Is Not Found Or Not Annotated
+	kafkaChannelLister = receivertesting.NewMockKafkaChannelLister(channelName, channelNamespace, false, corev1.ConditionTrue, false)
+	assert.Equal(t, "", ContentMode(channelReference))
+	kafkaChannelLister = receivertesting.NewMockKafkaChannelLister(channelName, channelNamespace, true, corev1.ConditionTrue, false)
+	assert.Equal(t, "", ContentMode(channelReference))
+
+	// Verify The Normalized Content Mode When The KafkaChannel Is Annotated
+	kafkaChannel := receivertesting.CreateKafkaChannel(channelName, channelNamespace, corev1.ConditionTrue)
+	kafkaChannel.Annotations = map[string]string{kafkaconstants.ContentModeAnnotation: " Structured "}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.Nil(t, indexer.Add(kafkaChannel))
+	kafkaChannelLister = kafkalisters.NewKafkaChannelLister(indexer)
+	assert.Equal(t, kafkaconstants.ContentModeStructured, ContentMode(channelReference))
+}
+
+// Test The RequiredAcks() Functionality
+func TestRequiredAcks(t *testing.T) {
+
+	// Test Data
+	channelName := "TestChannelName"
+	channelNamespace := "TestChannelNamespace"
+	channelReference := receivertesting.CreateChannelReference(channelName, channelNamespace)
+
+	// Verify The Default (Empty) Required Acks When The KafkaChannel Is Not Found Or Not Annotated
+	kafkaChannelLister = receivertesting.NewMockKafkaChannelLister(channelName, channelNamespace, false, corev1.ConditionTrue, false)
+	assert.Equal(t, "", RequiredAcks(channelReference))
+	kafkaChannelLister = receivertesting.NewMockKafkaChannelLister(channelName, channelNamespace, true, corev1.ConditionTrue, false)
+	assert.Equal(t, "", RequiredAcks(channelReference))
+
+	// Verify The Trimmed Required Acks When The KafkaChannel Is Annotated
+	kafkaChannel := receivertesting.CreateKafkaChannel(channelName, channelNamespace, corev1.ConditionTrue)
+	kafkaChannel.Annotations = map[string]string{kafkaconstants.RequiredAcksAnnotation: " WaitForAll "}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.Nil(t, indexer.Add(kafkaChannel))
+	kafkaChannelLister = kafkalisters.NewKafkaChannelLister(indexer)
+	assert.Equal(t, "WaitForAll", RequiredAcks(channelReference))
+}
+
+// Test The MaxMessageBytes() Functionality
+func TestMaxMessageBytes(t *testing.T) {
+
+	// Set The Package Level Logger To A Test Logger
+	logger = logtesting.TestLogger(t).Desugar()
+
+	// Test Data
+	channelName := "TestChannelName"
+	channelNamespace := "TestChannelNamespace"
+	channelReference := receivertesting.CreateChannelReference(channelName, channelNamespace)
+
+	// Verify Zero When The KafkaChannel Is Not Found Or Not Annotated
+	kafkaChannelLister = receivertesting.NewMockKafkaChannelLister(channelName, channelNamespace, false, corev1.ConditionTrue, false)
+	assert.Equal(t, int32(0), MaxMessageBytes(channelReference))
+	kafkaChannelLister = receivertesting.NewMockKafkaChannelLister(channelName, channelNamespace, true, corev1.ConditionTrue, false)
+	assert.Equal(t, int32(0), MaxMessageBytes(channelReference))
+
+	// Verify The Annotated Value (And Zero For An Invalid Value)
+	for annotation, expected := range map[string]int32{"2097152": 2097152, "invalid": 0} {
+		kafkaChannel := receivertesting.CreateKafkaChannel(channelName, channelNamespace, corev1.ConditionTrue)
+		kafkaChannel.Annotations = map[string]string{kafkaconstants.MaxMessageBytesAnnotation: annotation}
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		assert.Nil(t, indexer.Add(kafkaChannel))
+		kafkaChannelLister = kafkalisters.NewKafkaChannelLister(indexer)
+		assert.Equal(t, expected, MaxMessageBytes(channelReference))
+	}
+}
+
+// Test The ExtensionFilter() Functionality
+func TestExtensionFilter(t *testing.T) {
+
+	// Set The Package Level Logger To A Test Logger
+	logger = logtesting.TestLogger(t).Desugar()
+
+	// Test Data
+	channelName := "TestChannelName"
+	channelNamespace := "TestChannelNamespace"
+	channelReference := receivertesting.CreateChannelReference(channelName, channelNamespace)
+	defaultAllow := []string{"traceparent"}
+	defaultDeny := []string{"internal"}
+	defaultFilter := &kafkautil.ExtensionFilter{Allow: defaultAllow, Deny: defaultDeny}
+
+	// Verify The Defaults When The KafkaChannel Is Not Found Or Not Annotated
+	kafkaChannelLister = receivertesting.NewMockKafkaChannelLister(channelName, channelNamespace, false, corev1.ConditionTrue, false)
+	assert.Equal(t, defaultFilter, ExtensionFilter(channelReference, defaultAllow, defaultDeny))
+	kafkaChannelLister = receivertesting.NewMockKafkaChannelLister(channelName, channelNamespace, true, corev1.ConditionTrue, false)
+	assert.Equal(t, defaultFilter, ExtensionFilter(channelReference, defaultAllow, defaultDeny))
+	assert.Nil(t, ExtensionFilter(channelReference, nil, nil))
+
+	// Verify The Annotated Allow & Deny Lists (And The Defaults For Invalid Values)
+	for annotations, expected := range map[string]*kafkautil.ExtensionFilter{
+		kafkaconstants.ExtensionsAllowAnnotation: {Allow: []string{"partitionkey", "custom"}, Deny: defaultDeny},
+		kafkaconstants.ExtensionsDenyAnnotation:  {Allow: defaultAllow, Deny: []string{"partitionkey", "custom"}},
+	} {
+		kafkaChannel := receivertesting.CreateKafkaChannel(channelName, channelNamespace, corev1.ConditionTrue)
+		kafkaChannel.Annotations = map[string]string{annotations: "partitionkey, custom"}
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		assert.Nil(t, indexer.Add(kafkaChannel))
+		kafkaChannelLister = kafkalisters.NewKafkaChannelLister(indexer)
+		assert.Equal(t, expected, ExtensionFilter(channelReference, defaultAllow, defaultDeny))
+	}
+	kafkaChannel := receivertesting.CreateKafkaChannel(channelName, channelNamespace, corev1.ConditionTrue)
+	kafkaChannel.Annotations = map[string]string{kafkaconstants.ExtensionsDenyAnnotation: "id"}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.Nil(t, indexer.Add(kafkaChannel))
+	kafkaChannelLister = kafkalisters.NewKafkaChannelLister(indexer)
+	assert.Equal(t, defaultFilter, ExtensionFilter(channelReference, defaultAllow, defaultDeny))
+}
+
 // Test The Close() Functionality
 func TestClose(t *testing.T) {
 
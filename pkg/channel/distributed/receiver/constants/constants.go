@@ -24,8 +24,14 @@ const (
 
 	MetricsInterval = 5 * time.Second
 
+	// The HTTP Port On Which Events Are Received (Must Match The Controller's HttpContainerPortNumber)
+	HttpPort = 8080
+
 	ExtensionKeyPartitionKey = "partitionkey"
 
+	// PartitionKey Annotation - Selects The CloudEvent Attribute (e.g. "subject") Used As The Kafka Message Key For A KafkaChannel
+	PartitionKeyAnnotation = "kafka.eventing.knative.dev/partition-key"
+
 	KafkaHeaderKeyContentType = "content-type"
 
 	CeKafkaHeaderKeySpecVersion  = "ce_specversion"
@@ -40,8 +40,12 @@ type Environment struct {
 	ServiceName  string // Required
 
 	// Kafka Authorization
-	KafkaUsername string // Optional
-	KafkaPassword string // Optional
+	KafkaUsername      string // Optional
+	KafkaPassword      string // Optional
+	KafkaSaslMechanism string // Optional
+	KafkaTLSCert       string // Optional
+	KafkaTLSKey        string // Optional
+	KafkaTLSEnable     string // Optional
 }
 
 // Get The Environment
@@ -101,11 +105,24 @@ func GetEnvironment(logger *zap.Logger) (*Environment, error) {
 	// Get The Optional KafkaPassword Config Value
 	environment.KafkaPassword = env.GetOptionalConfigValue(logger, env.KafkaPasswordEnvVarKey, "")
 
-	// Clone The Environment & Mask The Password For Safe Logging
+	// Get The Optional KafkaSaslMechanism Config Value
+	environment.KafkaSaslMechanism = env.GetOptionalConfigValue(logger, env.KafkaSaslMechanismEnvVarKey, "")
+
+	// Get The Optional KafkaTLSCert & KafkaTLSKey Config Values (mTLS)
+	environment.KafkaTLSCert = env.GetOptionalConfigValue(logger, env.KafkaTLSCertEnvVarKey, "")
+	environment.KafkaTLSKey = env.GetOptionalConfigValue(logger, env.KafkaTLSKeyEnvVarKey, "")
+
+	// Get The Optional KafkaTLSEnable Config Value (Kafka Secret Override Of The ConfigMap's Net.TLS.Enable)
+	environment.KafkaTLSEnable = env.GetOptionalConfigValue(logger, env.KafkaTLSEnableEnvVarKey, "")
+
+	// Clone The Environment & Mask The Password / TLS Key For Safe Logging
 	safeEnvironment := *environment
 	if len(safeEnvironment.KafkaPassword) > 0 {
 		safeEnvironment.KafkaPassword = "*************"
 	}
+	if len(safeEnvironment.KafkaTLSKey) > 0 {
+		safeEnvironment.KafkaTLSKey = "*************"
+	}
 
 	// Log The Receiver Configuration Loaded From Environment Variables
 	logger.Info("Environment Variables", zap.Any("Environment", safeEnvironment))
@@ -18,7 +18,12 @@ package producer
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
 	"time"
 
 	"knative.dev/eventing-kafka/pkg/common/tracing"
@@ -28,11 +33,15 @@ import (
 	"github.com/Shopify/sarama"
 	kafkasaramaprotocol "github.com/cloudevents/sdk-go/protocol/kafka_sarama/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	"github.com/cloudevents/sdk-go/v2/types"
 	gometrics "github.com/rcrowley/go-metrics"
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
 	kafkaproducer "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/producer"
 	kafkasarama "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/metrics"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/receiver/constants"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/receiver/health"
@@ -42,15 +51,24 @@ import (
 
 // Producer Struct
 type Producer struct {
-	logger             *zap.Logger
-	kafkaProducer      sarama.SyncProducer
-	healthServer       *health.Server
-	statsReporter      metrics.StatsReporter
-	metricsRegistry    gometrics.Registry
-	metricsStopChan    chan struct{}
-	metricsStoppedChan chan struct{}
-	configuration      *sarama.Config
-	brokers            []string
+	logger                *zap.Logger
+	kafkaProducer         sarama.SyncProducer
+	healthServer          *health.Server
+	statsReporter         metrics.StatsReporter
+	metricsRegistry       gometrics.Registry
+	metricsStopChan       chan struct{}
+	metricsStoppedChan    chan struct{}
+	configuration         *sarama.Config
+	brokers               []string
+	tlsEnable             string
+	requiredAcksProducers map[sarama.RequiredAcks]*requiredAcksProducer
+	requiredAcksMutex     sync.Mutex
+}
+
+// The Sarama SyncProducer (And Its Metrics Registry) Created For KafkaChannels Annotated With Non-Default RequiredAcks
+type requiredAcksProducer struct {
+	kafkaProducer   sarama.SyncProducer
+	metricsRegistry gometrics.Registry
 }
 
 // Initialize The Producer
@@ -60,6 +78,9 @@ func NewProducer(logger *zap.Logger,
 	statsReporter metrics.StatsReporter,
 	healthServer *health.Server) (*Producer, error) {
 
+	// Hash Keyed Messages (Preserves Per-Key Ordering) & Round-Robin Those Without A Key
+	config.Producer.Partitioner = kafkasarama.NewKeyedPartitioner
+
 	// Create The Kafka Producer Using The Specified Kafka Authentication
 	kafkaProducer, metricsRegistry, err := createSyncProducerWrapper(config, brokers)
 	if err != nil {
@@ -71,15 +92,16 @@ func NewProducer(logger *zap.Logger,
 
 	// Create A New Producer
 	producer := &Producer{
-		logger:             logger,
-		kafkaProducer:      kafkaProducer,
-		healthServer:       healthServer,
-		statsReporter:      statsReporter,
-		metricsRegistry:    metricsRegistry,
-		metricsStopChan:    make(chan struct{}),
-		metricsStoppedChan: make(chan struct{}),
-		configuration:      config,
-		brokers:            brokers,
+		logger:                logger,
+		kafkaProducer:         kafkaProducer,
+		healthServer:          healthServer,
+		statsReporter:         statsReporter,
+		metricsRegistry:       metricsRegistry,
+		metricsStopChan:       make(chan struct{}),
+		metricsStoppedChan:    make(chan struct{}),
+		configuration:         config,
+		brokers:               brokers,
+		requiredAcksProducers: make(map[sarama.RequiredAcks]*requiredAcksProducer),
 	}
 
 	// Start Observing Metrics
@@ -93,11 +115,204 @@ func NewProducer(logger *zap.Logger,
 	return producer, nil
 }
 
+// Set The Kafka Secret Override Of Whether TLS Is Enabled (Re-Applied When The ConfigMap Changes)
+func (p *Producer) SetTLSEnable(tlsEnable string) {
+	p.tlsEnable = tlsEnable
+}
+
 // Wrapper Around Common Kafka SyncProducer Creation To Facilitate Unit Testing
 var createSyncProducerWrapper = func(config *sarama.Config, brokers []string) (sarama.SyncProducer, gometrics.Registry, error) {
 	return kafkaproducer.CreateSyncProducer(brokers, config)
 }
 
+// Context Key For The Partition Key Attribute
+type partitionKeyAttributeKey struct{}
+
+// Return A Copy Of The Context Selecting The CloudEvent Attribute Used As The Kafka Message Key
+// An Empty Attribute Retains The Default Behavior Of Using The "partitionkey" Extension (If Present)
+func WithPartitionKeyAttribute(ctx context.Context, attribute string) context.Context {
+	return context.WithValue(ctx, partitionKeyAttributeKey{}, attribute)
+}
+
+// Get The CloudEvent Attribute Used As The Kafka Message Key From The Context (Empty If Not Specified)
+func partitionKeyAttributeFromContext(ctx context.Context) string {
+	if attribute, ok := ctx.Value(partitionKeyAttributeKey{}).(string); ok {
+		return attribute
+	}
+	return ""
+}
+
+// Context Key For The KafkaChannel's Maximum Message Bytes
+type maxMessageBytesKey struct{}
+
+// Return A Copy Of The Context Limiting The Size Of The Produced Kafka Message For The KafkaChannel
+// Zero Retains The Default Behavior Of Only Applying The Producer's Sarama Producer.MaxMessageBytes
+func WithMaxMessageBytes(ctx context.Context, maxMessageBytes int32) context.Context {
+	return context.WithValue(ctx, maxMessageBytesKey{}, maxMessageBytes)
+}
+
+// Get The KafkaChannel's Maximum Message Bytes From The Context (Zero If Not Specified)
+func maxMessageBytesFromContext(ctx context.Context) int32 {
+	if maxMessageBytes, ok := ctx.Value(maxMessageBytesKey{}).(int32); ok {
+		return maxMessageBytes
+	}
+	return 0
+}
+
+// Context Key For The KafkaChannel's CloudEvent Content Mode
+type contentModeKey struct{}
+
+// Return A Copy Of The Context Selecting The CloudEvent Content Mode ("binary" or "structured") Of The Kafka Message
+// An Empty Content Mode Retains The Default Behavior Of Preserving The Encoding Of The Incoming Message
+func WithContentMode(ctx context.Context, contentMode string) context.Context {
+	return context.WithValue(ctx, contentModeKey{}, contentMode)
+}
+
+// Get The KafkaChannel's CloudEvent Content Mode From The Context (Empty If Not Specified)
+func contentModeFromContext(ctx context.Context) string {
+	if contentMode, ok := ctx.Value(contentModeKey{}).(string); ok {
+		return contentMode
+	}
+	return ""
+}
+
+// Context Key For The KafkaChannel's Producer Required Acks
+type requiredAcksKey struct{}
+
+// Return A Copy Of The Context Selecting The Producer RequiredAcks ("NoResponse", "WaitForLocal" or "WaitForAll") For The KafkaChannel
+// An Empty Value Retains The Default Behavior Of Using The Producer's Configured Sarama Producer.RequiredAcks
+func WithRequiredAcks(ctx context.Context, requiredAcks string) context.Context {
+	return context.WithValue(ctx, requiredAcksKey{}, requiredAcks)
+}
+
+// Get The KafkaChannel's Producer Required Acks From The Context (Empty If Not Specified)
+func requiredAcksFromContext(ctx context.Context) string {
+	if requiredAcks, ok := ctx.Value(requiredAcksKey{}).(string); ok {
+		return requiredAcks
+	}
+	return ""
+}
+
+// Context Key For The KafkaChannel's CloudEvent ExtensionFilter
+type extensionFilterKey struct{}
+
+// Return A Copy Of The Context Limiting The CloudEvent Extensions Propagated To The Kafka Message
+// A Nil ExtensionFilter Retains The Default Behavior Of Propagating All Extensions
+func WithExtensionFilter(ctx context.Context, extensionFilter *kafkautil.ExtensionFilter) context.Context {
+	return context.WithValue(ctx, extensionFilterKey{}, extensionFilter)
+}
+
+// Get The KafkaChannel's CloudEvent ExtensionFilter From The Context (Nil If Not Specified)
+func extensionFilterFromContext(ctx context.Context) *kafkautil.ExtensionFilter {
+	if extensionFilter, ok := ctx.Value(extensionFilterKey{}).(*kafkautil.ExtensionFilter); ok {
+		return extensionFilter
+	}
+	return nil
+}
+
+//
+// Get The Sarama SyncProducer For The Specified KafkaChannel Required Acks
+//
+// Sarama applies RequiredAcks to the entire Producer rather than to individual messages, so a separate
+// SyncProducer is lazily created (and then reused) for each RequiredAcks value which differs from that of
+// the Producer's configuration.  An invalid value is ignored, as is any value when the Producer is
+// idempotent (since idempotence requires WaitForAll), in favor of the default SyncProducer.
+//
+func (p *Producer) syncProducer(logger *zap.Logger, requiredAcks string) (sarama.SyncProducer, error) {
+
+	// Use The Default SyncProducer Unless A Different (Valid) RequiredAcks Is Specified
+	if len(requiredAcks) <= 0 || p.configuration == nil {
+		return p.kafkaProducer, nil
+	}
+	acks, err := kafkasarama.ParseRequiredAcks(requiredAcks)
+	if err != nil {
+		logger.Warn("Invalid KafkaChannel Required Acks - Ignoring", zap.Error(err))
+		return p.kafkaProducer, nil
+	}
+	if acks == p.configuration.Producer.RequiredAcks {
+		return p.kafkaProducer, nil
+	}
+	if p.configuration.Producer.Idempotent {
+		logger.Warn("KafkaChannel Required Acks Conflicts With Idempotent Producer - Ignoring", zap.String("RequiredAcks", requiredAcks))
+		return p.kafkaProducer, nil
+	}
+
+	// Return The Existing SyncProducer For The RequiredAcks If Already Created
+	p.requiredAcksMutex.Lock()
+	defer p.requiredAcksMutex.Unlock()
+	if existingProducer, ok := p.requiredAcksProducers[acks]; ok {
+		return existingProducer.kafkaProducer, nil
+	}
+
+	// Otherwise Create A New SyncProducer From A Copy Of The Config (With A Fresh Metrics Registry & The RequiredAcks)
+	config := *p.configuration
+	config.MetricRegistry = gometrics.NewRegistry()
+	config.Producer.RequiredAcks = acks
+	kafkaProducer, metricsRegistry, err := createSyncProducerWrapper(&config, p.brokers)
+	if err != nil {
+		logger.Error("Failed To Create Kafka SyncProducer For Required Acks", zap.String("RequiredAcks", requiredAcks), zap.Error(err))
+		return nil, err
+	}
+	logger.Info("Successfully Created Kafka SyncProducer For Required Acks", zap.String("RequiredAcks", requiredAcks))
+	p.requiredAcksProducers[acks] = &requiredAcksProducer{kafkaProducer: kafkaProducer, metricsRegistry: metricsRegistry}
+	return kafkaProducer, nil
+}
+
+// Get The Metrics Registries Of The SyncProducers Created For Non-Default RequiredAcks
+func (p *Producer) requiredAcksMetricsRegistries() []gometrics.Registry {
+	p.requiredAcksMutex.Lock()
+	defer p.requiredAcksMutex.Unlock()
+	registries := make([]gometrics.Registry, 0, len(p.requiredAcksProducers))
+	for _, requiredAcksProducer := range p.requiredAcksProducers {
+		registries = append(registries, requiredAcksProducer.metricsRegistry)
+	}
+	return registries
+}
+
+// Get The Size Of The Specified Sarama ProducerMessage's Key, Value & Headers (Excluding Kafka Record Overhead)
+func producerMessageSize(producerMessage *sarama.ProducerMessage) int {
+	size := 0
+	if producerMessage.Key != nil {
+		size += producerMessage.Key.Length()
+	}
+	if producerMessage.Value != nil {
+		size += producerMessage.Value.Length()
+	}
+	for _, header := range producerMessage.Headers {
+		size += len(header.Key) + len(header.Value)
+	}
+	return size
+}
+
+// Create A Transformer Which Sets The Sarama ProducerMessage Key From The Specified CloudEvent Attribute
+// The Attribute May Be A Context Attribute (e.g. "subject") Or An Extension, And If Absent The Key Is Left
+// Unset So That The Message Is Round-Robined Across Partitions.
+func partitionKeyTransformer(attribute string, producerMessage *sarama.ProducerMessage) binding.TransformerFunc {
+	return func(reader binding.MessageMetadataReader, _ binding.MessageMetadataWriter) error {
+
+		// Get The Attribute Value From The Context Attributes (Per The Message's Spec Version) Or Extensions
+		var value interface{}
+		specVersion, _ := reader.GetAttribute(spec.SpecVersion)
+		if specVersion != nil && specVersion.Version().Attribute(attribute) != nil {
+			_, value = reader.GetAttribute(specVersion.Version().Attribute(attribute).Kind())
+		} else {
+			value = reader.GetExtension(attribute)
+		}
+
+		// Set The Key From The Formatted Value (If Present)
+		if !types.IsZero(value) {
+			key, err := types.Format(value)
+			if err != nil {
+				return err
+			}
+			if len(key) > 0 {
+				producerMessage.Key = sarama.StringEncoder(key)
+			}
+		}
+		return nil
+	}
+}
+
 // Produce A KafkaMessage From The Specified CloudEvent To The Specified Topic And Wait For The Delivery Report
 func (p *Producer) ProduceKafkaMessage(ctx context.Context, channelReference eventingChannel.ChannelReference, message binding.Message, transformers ...binding.Transformer) error {
 
@@ -114,28 +329,97 @@ func (p *Producer) ProduceKafkaMessage(ctx context.Context, channelReference eve
 	// Initialize The Sarama ProducerMessage With The Specified Topic Name
 	producerMessage := &sarama.ProducerMessage{Topic: topicName}
 
+	// Strip The Incoming Extensions Which The KafkaChannel Does Not Allow (Preserving A Structured Encoding)
+	if extensionFilter := extensionFilterFromContext(ctx); extensionFilter != nil {
+		event, err := binding.ToEvent(ctx, message, transformers...)
+		if err != nil {
+			p.logger.Error("Failed To Convert BindingMessage To CloudEvent For Extension Filtering", zap.Error(err))
+			return p.produceFailed(ctx, channelReference, &kafkaproducer.ProduceError{ErrorType: kafkaproducer.ProduceErrorTypeInvalidMessage, StatusCode: http.StatusBadRequest, Err: err})
+		}
+		if removed := extensionFilter.Apply(event); len(removed) > 0 {
+			logger.Debug("Stripped Disallowed CloudEvent Extensions", zap.Strings("Extensions", removed))
+		}
+		if message.ReadEncoding() == binding.EncodingStructured {
+			ctx = binding.WithPreferredEventEncoding(ctx, binding.EncodingStructured)
+		}
+		message = binding.ToMessage(event)
+		transformers = nil
+	}
+
+	// Get The Span Of The Incoming Request (Unless Tracing Is Disabled)
+	var span *trace.Span
+	if tracing.Enabled() {
+		span = trace.FromContext(ctx)
+	}
+
+	// Add The CloudEvents Distributed Tracing Extension To The Message If Not Already Present
+	if span != nil {
+		transformers = append(transformers[:len(transformers):len(transformers)], tracing.DistributedTracingExtensionTransformer(span.SpanContext()))
+	}
+
+	// Derive The Kafka Message Key From The Selected CloudEvent Attribute (Instead Of The "partitionkey" Extension)
+	if attribute := partitionKeyAttributeFromContext(ctx); len(attribute) > 0 {
+		ctx = kafkasaramaprotocol.WithSkipKeyMapping(ctx)
+		transformers = append(transformers[:len(transformers):len(transformers)], partitionKeyTransformer(attribute, producerMessage))
+	}
+
+	// Force The KafkaChannel's CloudEvent Content Mode (Binary = "ce_" Headers & Raw Data, Structured = JSON Envelope)
+	switch contentModeFromContext(ctx) {
+	case kafkaconstants.ContentModeBinary:
+		ctx = binding.WithForceBinary(ctx)
+	case kafkaconstants.ContentModeStructured:
+		ctx = binding.WithForceStructured(ctx)
+	}
+
 	// Use The SaramaKafka Protocol To Convert The Binding Message To A ProducerMessage
 	err := kafkasaramaprotocol.WriteProducerMessage(ctx, message, producerMessage, transformers...)
 	if err != nil {
 		p.logger.Error("Failed To Convert BindingMessage To Sarama ProducerMessage", zap.Error(err))
-		return err
+		return p.produceFailed(ctx, channelReference, &kafkaproducer.ProduceError{ErrorType: kafkaproducer.ProduceErrorTypeInvalidMessage, StatusCode: http.StatusBadRequest, Err: err})
 	}
 
 	// Add The "traceparent" And "tracestate" Headers To The Message (Helps Tie Related Messages Together In Traces)
-	producerMessage.Headers = append(producerMessage.Headers, tracing.SerializeTrace(trace.FromContext(ctx).SpanContext())...)
+	if span != nil {
+		producerMessage.Headers = append(producerMessage.Headers, tracing.SerializeTrace(span.SpanContext())...)
+	}
+
+	// Reject Messages Exceeding The KafkaChannel's Max Message Bytes (Rather Than An Opaque Kafka / Sarama Error)
+	messageSize := producerMessageSize(producerMessage)
+	if maxMessageBytes := maxMessageBytesFromContext(ctx); maxMessageBytes > 0 && messageSize > int(maxMessageBytes) {
+		logger.Warn("Message Exceeds KafkaChannel Max Message Bytes - Rejecting", zap.Int("Size", messageSize), zap.Int32("MaxMessageBytes", maxMessageBytes))
+		err = fmt.Errorf("event of %d bytes exceeds the maximum message size of %d bytes for KafkaChannel %s/%s", messageSize, maxMessageBytes, channelReference.Namespace, channelReference.Name)
+		return p.produceFailed(ctx, channelReference, &kafkaproducer.ProduceError{ErrorType: kafkaproducer.ProduceErrorTypeMessageTooLarge, StatusCode: http.StatusRequestEntityTooLarge, Err: err})
+	}
+
+	// Select The SyncProducer For The KafkaChannel's Required Acks
+	kafkaProducer, err := p.syncProducer(logger, requiredAcksFromContext(ctx))
+	if err != nil {
+		return p.produceFailed(ctx, channelReference, kafkaproducer.NewProduceError(err))
+	}
 
 	// Produce The Kafka Message To The Kafka Topic
 	logger.Debug("Producing Kafka Message", zap.Any("Headers", producerMessage.Headers), zap.Any("Message", producerMessage.Value))
-	partition, offset, err := p.kafkaProducer.SendMessage(producerMessage)
-	if err != nil {
-		logger.Error("Failed To Send Message To Kafka", zap.Error(err))
-		return err
+	partition, offset, err := kafkaProducer.SendMessage(producerMessage)
+	if errors.Is(err, sarama.ErrMessageSizeTooLarge) {
+		logger.Warn("Message Exceeds Kafka Max Message Size - Rejecting", zap.Int("Size", messageSize), zap.Error(err))
+		return p.produceFailed(ctx, channelReference, kafkaproducer.NewProduceError(fmt.Errorf("event of %d bytes exceeds the maximum message size allowed by the kafka producer or topic %s: %w", messageSize, topicName, err)))
+	} else if err != nil {
+		produceError := kafkaproducer.NewProduceError(err)
+		logger.Error("Failed To Send Message To Kafka", zap.String("ErrorType", produceError.ErrorType), zap.Error(err))
+		return p.produceFailed(ctx, channelReference, produceError)
 	} else {
 		logger.Debug("Successfully Sent Message To Kafka", zap.Int32("Partition", partition), zap.Int64("Offset", offset))
 		return nil
 	}
 }
 
+// Record A Failed Produce (Produce Error Metric & Response Status) And Return The ProduceError To The Caller
+func (p *Producer) produceFailed(ctx context.Context, channelReference eventingChannel.ChannelReference, produceError *kafkaproducer.ProduceError) error {
+	p.statsReporter.ReportProduceError(channelReference.String(), produceError.ErrorType)
+	setProduceError(ctx, produceError)
+	return produceError
+}
+
 // Async Process For Observing Kafka Metrics
 func (p *Producer) ObserveMetrics(interval time.Duration) {
 
@@ -158,6 +442,11 @@ func (p *Producer) ObserveMetrics(interval time.Duration) {
 
 				// Forward Metrics To Prometheus For Observation
 				p.statsReporter.Report(kafkaMetrics)
+
+				// Forward The Metrics Of Any SyncProducers Created For Non-Default RequiredAcks
+				for _, metricsRegistry := range p.requiredAcksMetricsRegistries() {
+					p.statsReporter.Report(metricsRegistry.GetAll())
+				}
 			}
 		}
 	}()
@@ -180,6 +469,17 @@ func (p *Producer) Close() {
 	} else {
 		p.logger.Info("Successfully Closed Kafka Producer")
 	}
+
+	// Close Any Kafka Producers Created For Non-Default RequiredAcks
+	p.requiredAcksMutex.Lock()
+	defer p.requiredAcksMutex.Unlock()
+	for acks, requiredAcksProducer := range p.requiredAcksProducers {
+		err = requiredAcksProducer.kafkaProducer.Close()
+		if err != nil {
+			p.logger.Error("Failed To Close Kafka Producer For Required Acks", zap.Int16("RequiredAcks", int16(acks)), zap.Error(err))
+		}
+		delete(p.requiredAcksProducers, acks)
+	}
 }
 
 // ConfigChanged is called by the configMapObserver handler function in main() so that
@@ -207,11 +507,32 @@ func (p *Producer) ConfigChanged(configMap *v1.ConfigMap) *Producer {
 
 		// Some of the current config settings may not be overridden by the configmap (username, password, etc.)
 		kafkasarama.UpdateSaramaConfig(newConfig, p.configuration.ClientID, p.configuration.Net.SASL.User, p.configuration.Net.SASL.Password)
+		err = kafkasarama.UpdateSaramaConfigSaslMechanism(newConfig, string(p.configuration.Net.SASL.Mechanism))
+		if err != nil {
+			p.logger.Error("Unable to carry forward SASL mechanism", zap.Error(err))
+			return nil
+		}
+		kafkasarama.UpdateSaramaConfigTLSCertificates(newConfig, kafkasarama.TLSCertificates(p.configuration))
+		err = kafkasarama.UpdateSaramaConfigTLSEnable(newConfig, p.tlsEnable)
+		if err != nil {
+			p.logger.Error("Unable to carry forward TLS enable override", zap.Error(err))
+			return nil
+		}
 
-		// Enable Sarama Logging If Specified In ConfigMap
+		// Enable Sarama Logging & Apply RequiredAcks / Idempotent Producer Settings If Specified In ConfigMap
 		if ekConfig, err := kafkasarama.LoadEventingKafkaSettings(configMap); err == nil && ekConfig != nil {
 			kafkasarama.EnableSaramaLogging(ekConfig.Kafka.EnableSaramaLogging)
 			p.logger.Debug("Updated Sarama logging", zap.Bool("Kafka.EnableSaramaLogging", ekConfig.Kafka.EnableSaramaLogging))
+			err = kafkasarama.UpdateSaramaConfigRequiredAcks(newConfig, ekConfig.Kafka.RequiredAcks, ekConfig.Kafka.EnableIdempotentProducer)
+			if err != nil {
+				p.logger.Error("Invalid Producer RequiredAcks Configuration - Ignoring New Configuration", zap.Error(err))
+				return nil
+			}
+			err = kafkasarama.UpdateSaramaConfigIdempotentProducer(newConfig, ekConfig.Kafka.EnableIdempotentProducer)
+			if err != nil {
+				p.logger.Error("Invalid Idempotent Producer Configuration - Ignoring New Configuration", zap.Error(err))
+				return nil
+			}
 		} else {
 			p.logger.Error("Could Not Extract Eventing-Kafka Setting From Updated ConfigMap", zap.Error(err))
 		}
@@ -231,8 +552,67 @@ func (p *Producer) ConfigChanged(configMap *v1.ConfigMap) *Producer {
 		p.logger.Fatal("Failed To Create Kafka Producer With New Configuration", zap.Error(err))
 		return nil
 	}
+	reconfiguredKafkaProducer.tlsEnable = p.tlsEnable
 
 	// Successfully Created New Producer - Close Old One And Return New One
 	p.logger.Info("Successfully Created New Producer")
 	return reconfiguredKafkaProducer
 }
+
+// RootCAsChanged is called by the rootCAConfigMapObserver handler function in main() so that the Producer
+// may be recreated with the rotated Root CAs.  This is necessary because the ConfigChanged() comparison
+// is unable to detect changes to the (opaque) CertPool.  All other existing config is reused.
+func (p *Producer) RootCAsChanged(certPool *x509.CertPool) *Producer {
+
+	// Nothing To Do Without An Existing Configuration & New Root CAs
+	if p.configuration == nil || certPool == nil {
+		return nil
+	}
+
+	// Copy The Current Sarama Config (With A Fresh Metrics Registry) & Update Its Root CAs
+	newConfig := *p.configuration
+	newConfig.MetricRegistry = gometrics.NewRegistry()
+	kafkasarama.UpdateSaramaConfigRootCAs(&newConfig, certPool)
+
+	// Create A New Producer With The New Configuration
+	p.logger.Info("Root CAs Changed - Closing & Recreating Producer")
+	p.Close()
+	reconfiguredKafkaProducer, err := NewProducer(p.logger, &newConfig, p.brokers, p.statsReporter, p.healthServer)
+	if err != nil {
+		p.logger.Fatal("Failed To Create Kafka Producer With New Root CAs", zap.Error(err))
+		return nil
+	}
+	reconfiguredKafkaProducer.tlsEnable = p.tlsEnable
+
+	// Successfully Created New Producer - Return It
+	p.logger.Info("Successfully Created New Producer")
+	return reconfiguredKafkaProducer
+}
+
+// BrokersChanged is called by the BrokerResolver handler function in main() so that the Producer may be
+// recreated with the re-resolved Kafka brokers (from a DNS SRV record).  All other existing config is reused.
+func (p *Producer) BrokersChanged(brokers []string) *Producer {
+
+	// Nothing To Do Without An Existing Configuration & New (Different) Brokers
+	if p.configuration == nil || len(brokers) <= 0 || reflect.DeepEqual(brokers, p.brokers) {
+		return nil
+	}
+
+	// Copy The Current Sarama Config (With A Fresh Metrics Registry)
+	newConfig := *p.configuration
+	newConfig.MetricRegistry = gometrics.NewRegistry()
+
+	// Create A New Producer With The New Brokers
+	p.logger.Info("Kafka Brokers Changed - Closing & Recreating Producer", zap.Strings("Previous", p.brokers), zap.Strings("Current", brokers))
+	p.Close()
+	reconfiguredKafkaProducer, err := NewProducer(p.logger, &newConfig, brokers, p.statsReporter, p.healthServer)
+	if err != nil {
+		p.logger.Fatal("Failed To Create Kafka Producer With New Brokers", zap.Error(err))
+		return nil
+	}
+	reconfiguredKafkaProducer.tlsEnable = p.tlsEnable
+
+	// Successfully Created New Producer - Return It
+	p.logger.Info("Successfully Created New Producer")
+	return reconfiguredKafkaProducer
+}
@@ -18,22 +18,36 @@ package producer
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
 
 	"github.com/Shopify/sarama"
+	kafkasaramaprotocol "github.com/cloudevents/sdk-go/protocol/kafka_sarama/v2"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	bindingtest "github.com/cloudevents/sdk-go/v2/binding/test"
+	"github.com/cloudevents/sdk-go/v2/extensions"
 	"github.com/ghodss/yaml"
 	gometrics "github.com/rcrowley/go-metrics"
 	"github.com/stretchr/testify/assert"
+	"go.opencensus.io/trace"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	kafkaconstants "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	kafkaproducer "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/producer"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/common/metrics"
 	"knative.dev/eventing-kafka/pkg/channel/distributed/receiver/constants"
 	channelhealth "knative.dev/eventing-kafka/pkg/channel/distributed/receiver/health"
 	receivertesting "knative.dev/eventing-kafka/pkg/channel/distributed/receiver/testing"
 	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	"knative.dev/eventing-kafka/pkg/common/tracing"
 	logtesting "knative.dev/pkg/logging/testing"
 	"knative.dev/pkg/system"
 
@@ -113,6 +127,16 @@ ClientID: ` + receivertesting.ClientId + `
 	TestEventingKafka = `
 kafka:
   enableSaramaLogging: true`
+	TestEventingKafkaIdempotent = `
+kafka:
+  enableIdempotentProducer: true`
+	TestEventingKafkaRequiredAcks = `
+kafka:
+  requiredAcks: NoResponse`
+	TestEventingKafkaRequiredAcksConflict = `
+kafka:
+  enableIdempotentProducer: true
+  requiredAcks: WaitForLocal`
 )
 
 // Test The NewProducer Constructor
@@ -161,6 +185,417 @@ func TestProduceKafkaMessage(t *testing.T) {
 	receivertesting.ValidateProducerMessageHeader(t, producerMessage.Headers, constants.CeKafkaHeaderKeyPartitionKey, receivertesting.PartitionKey)
 }
 
+// Test The ProduceKafkaMessage() Functionality With A Selected Partition Key Attribute
+func TestProduceKafkaMessagePartitionKey(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		only      bool
+		name      string
+		version   string
+		attribute string
+		wantKey   string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Default (PartitionKey Extension)", version: cloudevents.VersionV1, attribute: "", wantKey: receivertesting.PartitionKey},
+		{name: "PartitionKey Extension", version: cloudevents.VersionV1, attribute: constants.ExtensionKeyPartitionKey, wantKey: receivertesting.PartitionKey},
+		{name: "Subject", version: cloudevents.VersionV1, attribute: "subject", wantKey: receivertesting.EventSubject},
+		{name: "Subject (V03)", version: cloudevents.VersionV03, attribute: "subject", wantKey: receivertesting.EventSubject},
+		{name: "Source", version: cloudevents.VersionV1, attribute: "source", wantKey: receivertesting.EventSource},
+		{name: "ID", version: cloudevents.VersionV1, attribute: "id", wantKey: receivertesting.EventId},
+		{name: "Absent Attribute (Round-Robin)", version: cloudevents.VersionV1, attribute: "missing", wantKey: ""},
+	}
+
+	// Filter To Those With "only" Flag (If Any Specified)
+	filteredTestCases := make([]TestCase, 0)
+	for _, testCase := range testCases {
+		if testCase.only {
+			filteredTestCases = append(filteredTestCases, testCase)
+		}
+	}
+	if len(filteredTestCases) == 0 {
+		filteredTestCases = testCases
+	}
+
+	// Run The Filtered TestCases
+	for _, testCase := range filteredTestCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create Test Data
+			mockSyncProducer := receivertesting.NewMockSyncProducer()
+			producer := createTestProducer(t, mockSyncProducer)
+			channelReference := receivertesting.CreateChannelReference(receivertesting.ChannelName, receivertesting.ChannelNamespace)
+			ctx := WithPartitionKeyAttribute(context.Background(), testCase.attribute)
+
+			// Perform The Test
+			err := producer.ProduceKafkaMessage(ctx, channelReference, receivertesting.CreateBindingMessage(testCase.version))
+
+			// Verify The Results
+			assert.Nil(t, err)
+			producerMessage := mockSyncProducer.GetMessage()
+			if len(testCase.wantKey) > 0 {
+				assert.NotNil(t, producerMessage.Key)
+				key, err := producerMessage.Key.Encode()
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.wantKey, string(key))
+			} else {
+				assert.Nil(t, producerMessage.Key)
+			}
+			receivertesting.ValidateProducerMessageHeader(t, producerMessage.Headers, constants.CeKafkaHeaderKeyPartitionKey, receivertesting.PartitionKey)
+		})
+	}
+}
+
+// Test The ProduceKafkaMessage() Functionality With A KafkaChannel Max Message Bytes Limit
+func TestProduceKafkaMessageMaxMessageBytes(t *testing.T) {
+
+	// Create Test Data
+	mockSyncProducer := receivertesting.NewMockSyncProducer()
+	producer := createTestProducer(t, mockSyncProducer)
+	channelReference := receivertesting.CreateChannelReference(receivertesting.ChannelName, receivertesting.ChannelNamespace)
+
+	// Verify A Message Exceeding The Limit Is Rejected With A Clear Error
+	err := producer.ProduceKafkaMessage(WithMaxMessageBytes(context.Background(), 10), channelReference, receivertesting.CreateBindingMessage(cloudevents.VersionV1))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "exceeds the maximum message size of 10 bytes for KafkaChannel")
+
+	// Verify A Message Within The Limit Is Produced
+	err = producer.ProduceKafkaMessage(WithMaxMessageBytes(context.Background(), 1000000), channelReference, receivertesting.CreateBindingMessage(cloudevents.VersionV1))
+	assert.Nil(t, err)
+	assert.Equal(t, receivertesting.TopicName, mockSyncProducer.GetMessage().Topic)
+
+	// Verify The Sarama Message Size Error Is Wrapped With A Clear Error
+	producer.kafkaProducer = &messageSizeTooLargeSyncProducer{MockSyncProducer: mockSyncProducer}
+	err = producer.ProduceKafkaMessage(context.Background(), channelReference, receivertesting.CreateBindingMessage(cloudevents.VersionV1))
+	assert.True(t, errors.Is(err, sarama.ErrMessageSizeTooLarge))
+	assert.Contains(t, err.Error(), "exceeds the maximum message size allowed by the kafka producer or topic")
+}
+
+// Mock SyncProducer Rejecting All Messages As Too Large
+type messageSizeTooLargeSyncProducer struct {
+	*receivertesting.MockSyncProducer
+}
+
+func (p *messageSizeTooLargeSyncProducer) SendMessage(_ *sarama.ProducerMessage) (int32, int64, error) {
+	return -1, -1, sarama.ErrMessageSizeTooLarge
+}
+
+// Test The ProduceKafkaMessage() Functionality When The Kafka Produce Fails
+func TestProduceKafkaMessageProduceError(t *testing.T) {
+
+	// Create Test Data
+	mockSyncProducer := receivertesting.NewMockSyncProducer()
+	producer := createTestProducer(t, mockSyncProducer)
+	mockStatsReporter := &produceErrorStatsReporter{produceErrors: make(map[string]int)}
+	producer.statsReporter = mockStatsReporter
+	channelReference := receivertesting.CreateChannelReference(receivertesting.ChannelName, receivertesting.ChannelNamespace)
+	channelName := receivertesting.ChannelNamespace + "/" + receivertesting.ChannelName
+
+	// Verify A Rejected Produce Surfaces The Specific Sarama Error With Its Type & Status Code
+	producer.kafkaProducer = &errorSyncProducer{MockSyncProducer: mockSyncProducer, err: sarama.ErrNotEnoughReplicas}
+	err := producer.ProduceKafkaMessage(context.Background(), channelReference, receivertesting.CreateBindingMessage(cloudevents.VersionV1))
+	assert.True(t, errors.Is(err, sarama.ErrNotEnoughReplicas))
+	var produceError *kafkaproducer.ProduceError
+	assert.True(t, errors.As(err, &produceError))
+	assert.Equal(t, kafkaproducer.ProduceErrorTypeNotEnoughReplicas, produceError.ErrorType)
+	assert.Equal(t, http.StatusServiceUnavailable, produceError.StatusCode)
+
+	// Verify An Oversized Message Is Reported As Too Large
+	producer.kafkaProducer = mockSyncProducer
+	err = producer.ProduceKafkaMessage(WithMaxMessageBytes(context.Background(), 10), channelReference, receivertesting.CreateBindingMessage(cloudevents.VersionV1))
+	assert.True(t, errors.As(err, &produceError))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, produceError.StatusCode)
+
+	// Verify The Produce Errors Were Counted By Channel & Error Type
+	assert.Equal(t, map[string]int{
+		channelName + "|" + kafkaproducer.ProduceErrorTypeNotEnoughReplicas: 1,
+		channelName + "|" + kafkaproducer.ProduceErrorTypeMessageTooLarge:   1,
+	}, mockStatsReporter.produceErrors)
+}
+
+// Test The ProduceKafkaMessage() Functionality With A KafkaChannel Required Acks
+func TestProduceKafkaMessageRequiredAcks(t *testing.T) {
+
+	// Create Test Data
+	mockSyncProducer := receivertesting.NewMockSyncProducer()
+	producer := createTestProducer(t, mockSyncProducer)
+	channelReference := receivertesting.CreateChannelReference(receivertesting.ChannelName, receivertesting.ChannelNamespace)
+	producer.configuration.Producer.RequiredAcks = sarama.WaitForLocal
+
+	// Stub The Kafka Producer Creation Wrapper To Track The SyncProducers Created For Required Acks
+	requiredAcksSyncProducers := make(map[sarama.RequiredAcks]*receivertesting.MockSyncProducer)
+	createSyncProducerWrapperPlaceholder := createSyncProducerWrapper
+	createSyncProducerWrapper = func(config *sarama.Config, brokers []string) (sarama.SyncProducer, gometrics.Registry, error) {
+		assert.Equal(t, []string{receivertesting.KafkaBrokers}, brokers)
+		assert.NotEqual(t, producer.configuration.MetricRegistry, config.MetricRegistry)
+		requiredAcksSyncProducer := receivertesting.NewMockSyncProducer()
+		requiredAcksSyncProducers[config.Producer.RequiredAcks] = requiredAcksSyncProducer
+		return requiredAcksSyncProducer, config.MetricRegistry, nil
+	}
+	defer func() { createSyncProducerWrapper = createSyncProducerWrapperPlaceholder }()
+
+	// Verify The Default, Matching & Invalid Required Acks Use The Default SyncProducer
+	for _, requiredAcks := range []string{"", "WaitForLocal", "invalid"} {
+		err := producer.ProduceKafkaMessage(WithRequiredAcks(context.Background(), requiredAcks), channelReference, receivertesting.CreateBindingMessage(cloudevents.VersionV1))
+		assert.Nil(t, err)
+		assert.Equal(t, receivertesting.TopicName, mockSyncProducer.GetMessage().Topic)
+	}
+	assert.Len(t, requiredAcksSyncProducers, 0)
+
+	// Verify A Different Required Acks Creates (Once) And Uses A Separate SyncProducer
+	for i := 0; i < 2; i++ {
+		err := producer.ProduceKafkaMessage(WithRequiredAcks(context.Background(), "WaitForAll"), channelReference, receivertesting.CreateBindingMessage(cloudevents.VersionV1))
+		assert.Nil(t, err)
+		assert.Len(t, requiredAcksSyncProducers, 1)
+		assert.Equal(t, receivertesting.TopicName, requiredAcksSyncProducers[sarama.WaitForAll].GetMessage().Topic)
+	}
+	assert.Equal(t, sarama.WaitForLocal, producer.configuration.Producer.RequiredAcks)
+
+	// Verify The Required Acks Is Ignored For An Idempotent Producer
+	producer.configuration.Producer.Idempotent = true
+	err := producer.ProduceKafkaMessage(WithRequiredAcks(context.Background(), "NoResponse"), channelReference, receivertesting.CreateBindingMessage(cloudevents.VersionV1))
+	assert.Nil(t, err)
+	assert.Equal(t, receivertesting.TopicName, mockSyncProducer.GetMessage().Topic)
+	assert.Len(t, requiredAcksSyncProducers, 1)
+
+	// Verify Closing The Producer Closes The Required Acks SyncProducers
+	producer.Close()
+	assert.True(t, mockSyncProducer.Closed())
+	assert.True(t, requiredAcksSyncProducers[sarama.WaitForAll].Closed())
+}
+
+// Mock SyncProducer Rejecting All Messages With The Specified Error
+type errorSyncProducer struct {
+	*receivertesting.MockSyncProducer
+	err error
+}
+
+func (p *errorSyncProducer) SendMessage(_ *sarama.ProducerMessage) (int32, int64, error) {
+	return -1, -1, p.err
+}
+
+// Mock StatsReporter Counting The Reported Produce Errors By "Channel|ErrorType"
+type produceErrorStatsReporter struct {
+	produceErrors map[string]int
+}
+
+func (r *produceErrorStatsReporter) Report(_ map[string]map[string]interface{}) {}
+
+func (r *produceErrorStatsReporter) ReportProduceError(channelName string, produceErrorType string) {
+	r.produceErrors[channelName+"|"+produceErrorType]++
+}
+
+// Test The ProduceKafkaMessage() Content Modes Round-Trip Through The Kafka Message (As Read By The Dispatcher)
+func TestProduceKafkaMessageContentMode(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name            string
+		contentMode     string
+		wantEncoding    binding.Encoding
+		wantContentType string
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Default", contentMode: "", wantEncoding: binding.EncodingBinary, wantContentType: receivertesting.EventDataContentType},
+		{name: "Binary", contentMode: kafkaconstants.ContentModeBinary, wantEncoding: binding.EncodingBinary, wantContentType: receivertesting.EventDataContentType},
+		{name: "Structured", contentMode: kafkaconstants.ContentModeStructured, wantEncoding: binding.EncodingStructured, wantContentType: cloudevents.ApplicationCloudEventsJSON},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create Test Data
+			mockSyncProducer := receivertesting.NewMockSyncProducer()
+			producer := createTestProducer(t, mockSyncProducer)
+			channelReference := receivertesting.CreateChannelReference(receivertesting.ChannelName, receivertesting.ChannelNamespace)
+			cloudEvent := receivertesting.CreateCloudEvent(cloudevents.VersionV1)
+			ctx := WithContentMode(context.Background(), testCase.contentMode)
+
+			// Perform The Test
+			err := producer.ProduceKafkaMessage(ctx, channelReference, binding.ToMessage(cloudEvent))
+			assert.Nil(t, err)
+
+			// Verify The Kafka Message Encoding & Key
+			producerMessage := mockSyncProducer.GetMessage()
+			receivertesting.ValidateProducerMessageHeader(t, producerMessage.Headers, constants.KafkaHeaderKeyContentType, testCase.wantContentType)
+			assert.Equal(t, testCase.wantEncoding == binding.EncodingBinary, receivertesting.GetProducerMessageHeader(t, producerMessage.Headers, constants.CeKafkaHeaderKeyId) != nil)
+			key, err := producerMessage.Key.Encode()
+			assert.Nil(t, err)
+			assert.Equal(t, receivertesting.PartitionKey, string(key))
+
+			// Convert The ProducerMessage To A ConsumerMessage & Verify The CloudEvent Survives The Round-Trip
+			value, err := producerMessage.Value.Encode()
+			assert.Nil(t, err)
+			consumerMessage := &sarama.ConsumerMessage{Topic: producerMessage.Topic, Key: key, Value: value}
+			for index := range producerMessage.Headers {
+				consumerMessage.Headers = append(consumerMessage.Headers, &producerMessage.Headers[index])
+			}
+			kafkaMessage := kafkasaramaprotocol.NewMessageFromConsumerMessage(consumerMessage)
+			assert.Equal(t, testCase.wantEncoding, kafkaMessage.ReadEncoding())
+			roundTripEvent, err := binding.ToEvent(context.Background(), kafkaMessage)
+			assert.Nil(t, err)
+			assert.Equal(t, cloudEvent.ID(), roundTripEvent.ID())
+			assert.Equal(t, cloudEvent.Type(), roundTripEvent.Type())
+			assert.Equal(t, cloudEvent.Source(), roundTripEvent.Source())
+			assert.Equal(t, cloudEvent.Subject(), roundTripEvent.Subject())
+			assert.Equal(t, cloudEvent.DataContentType(), roundTripEvent.DataContentType())
+			assert.Equal(t, cloudEvent.Extensions()[constants.ExtensionKeyPartitionKey], roundTripEvent.Extensions()[constants.ExtensionKeyPartitionKey])
+			assert.JSONEq(t, string(cloudEvent.Data()), string(roundTripEvent.Data()))
+		})
+	}
+}
+
+// Test The ProduceKafkaMessage() Extension Filter In Allow & Deny Modes (Round-Tripped Through The Kafka Message)
+func TestProduceKafkaMessageExtensionFilter(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name               string
+		extensionFilter    *kafkautil.ExtensionFilter
+		message            func(event cloudevents.Event) binding.Message
+		expectedEncoding   binding.Encoding
+		expectedExtensions []string
+	}
+
+	// Create The TestCases
+	binaryMessage := func(event cloudevents.Event) binding.Message { return binding.ToMessage(&event) }
+	structuredMessage := func(event cloudevents.Event) binding.Message {
+		return bindingtest.MustCreateMockStructuredMessage(t, event)
+	}
+	testCases := []TestCase{
+		{name: "No Filter", message: binaryMessage, expectedEncoding: binding.EncodingBinary, expectedExtensions: []string{constants.ExtensionKeyPartitionKey, "custom", "internal"}},
+		{name: "Allow Mode", extensionFilter: &kafkautil.ExtensionFilter{Allow: []string{constants.ExtensionKeyPartitionKey, "custom"}}, message: binaryMessage, expectedEncoding: binding.EncodingBinary, expectedExtensions: []string{constants.ExtensionKeyPartitionKey, "custom"}},
+		{name: "Deny Mode", extensionFilter: &kafkautil.ExtensionFilter{Deny: []string{"internal"}}, message: binaryMessage, expectedEncoding: binding.EncodingBinary, expectedExtensions: []string{constants.ExtensionKeyPartitionKey, "custom"}},
+		{name: "Deny Mode Structured", extensionFilter: &kafkautil.ExtensionFilter{Deny: []string{"custom", "internal"}}, message: structuredMessage, expectedEncoding: binding.EncodingStructured, expectedExtensions: []string{constants.ExtensionKeyPartitionKey}},
+		{name: "Context Attributes Never Stripped", extensionFilter: &kafkautil.ExtensionFilter{Allow: []string{"id", "source"}, Deny: []string{"type", "subject"}}, message: binaryMessage, expectedEncoding: binding.EncodingBinary, expectedExtensions: []string{}},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create Test Data
+			mockSyncProducer := receivertesting.NewMockSyncProducer()
+			producer := createTestProducer(t, mockSyncProducer)
+			channelReference := receivertesting.CreateChannelReference(receivertesting.ChannelName, receivertesting.ChannelNamespace)
+			cloudEvent := receivertesting.CreateCloudEvent(cloudevents.VersionV1)
+			cloudEvent.SetExtension("custom", "TestCustom")
+			cloudEvent.SetExtension("internal", "TestInternal")
+			ctx := WithExtensionFilter(context.Background(), testCase.extensionFilter)
+
+			// Perform The Test
+			err := producer.ProduceKafkaMessage(ctx, channelReference, testCase.message(*cloudEvent))
+			assert.Nil(t, err)
+
+			// Convert The ProducerMessage To A ConsumerMessage & Verify The Encoding Is Preserved
+			producerMessage := mockSyncProducer.GetMessage()
+			value, err := producerMessage.Value.Encode()
+			assert.Nil(t, err)
+			consumerMessage := &sarama.ConsumerMessage{Topic: producerMessage.Topic, Value: value}
+			for index := range producerMessage.Headers {
+				consumerMessage.Headers = append(consumerMessage.Headers, &producerMessage.Headers[index])
+			}
+			kafkaMessage := kafkasaramaprotocol.NewMessageFromConsumerMessage(consumerMessage)
+			assert.Equal(t, testCase.expectedEncoding, kafkaMessage.ReadEncoding())
+
+			// Verify Only The Expected Extensions Survive & The Context Attributes Are Untouched
+			roundTripEvent, err := binding.ToEvent(context.Background(), kafkaMessage)
+			assert.Nil(t, err)
+			assert.Len(t, roundTripEvent.Extensions(), len(testCase.expectedExtensions))
+			for _, name := range testCase.expectedExtensions {
+				assert.Equal(t, cloudEvent.Extensions()[name], roundTripEvent.Extensions()[name])
+			}
+			assert.Equal(t, cloudEvent.ID(), roundTripEvent.ID())
+			assert.Equal(t, cloudEvent.Type(), roundTripEvent.Type())
+			assert.Equal(t, cloudEvent.Source(), roundTripEvent.Source())
+			assert.Equal(t, cloudEvent.Subject(), roundTripEvent.Subject())
+			assert.Equal(t, cloudEvent.DataContentType(), roundTripEvent.DataContentType())
+		})
+	}
+}
+
+// Test The ProduceKafkaMessage() Partition Key Attribute Preserves Per-Entity Ordering
+func TestProduceKafkaMessagePartitionKeyOrdering(t *testing.T) {
+
+	// Create Test Data
+	mockSyncProducer := receivertesting.NewMockSyncProducer()
+	producer := createTestProducer(t, mockSyncProducer)
+	channelReference := receivertesting.CreateChannelReference(receivertesting.ChannelName, receivertesting.ChannelNamespace)
+	ctx := WithPartitionKeyAttribute(context.Background(), "subject")
+	partitioner := producer.configuration.Producer.Partitioner(receivertesting.TopicName)
+	numPartitions := int32(8)
+	subjects := []string{"entity-a", "entity-b", "entity-c"}
+
+	// Produce Interleaved Events About Each Subject & Track The Sequence Received By Each Partition
+	partitionSequences := make(map[int32][]string)
+	subjectPartitions := make(map[string]int32)
+	for sequence := 0; sequence < 5; sequence++ {
+		for _, subject := range subjects {
+			cloudEvent := receivertesting.CreateCloudEvent(cloudevents.VersionV1)
+			cloudEvent.SetSubject(subject)
+			cloudEvent.SetID(fmt.Sprintf("%s-%d", subject, sequence))
+			assert.Nil(t, producer.ProduceKafkaMessage(ctx, channelReference, binding.ToMessage(cloudEvent)))
+			producerMessage := mockSyncProducer.GetMessage()
+			partition, err := partitioner.Partition(&producerMessage, numPartitions)
+			assert.Nil(t, err)
+			partitionSequences[partition] = append(partitionSequences[partition], cloudEvent.ID())
+
+			// Verify Every Event About A Subject Is Assigned The Same Partition
+			if expectedPartition, ok := subjectPartitions[subject]; ok {
+				assert.Equal(t, expectedPartition, partition)
+			} else {
+				subjectPartitions[subject] = partition
+			}
+		}
+	}
+
+	// Verify Each Subject's Events Arrive At Their Partition In The Order They Were Produced
+	for _, subject := range subjects {
+		subjectSequence := make([]string, 0)
+		for _, id := range partitionSequences[subjectPartitions[subject]] {
+			if strings.HasPrefix(id, subject+"-") {
+				subjectSequence = append(subjectSequence, id)
+			}
+		}
+		assert.Equal(t, []string{subject + "-0", subject + "-1", subject + "-2", subject + "-3", subject + "-4"}, subjectSequence)
+	}
+}
+
+// Test The ProduceKafkaMessage() Functionality Propagates The Trace Of The Incoming Request (Unless Tracing Is Disabled)
+func TestProduceKafkaMessageTracing(t *testing.T) {
+
+	// Create Test Data
+	mockSyncProducer := receivertesting.NewMockSyncProducer()
+	producer := createTestProducer(t, mockSyncProducer)
+	channelReference := receivertesting.CreateChannelReference(receivertesting.ChannelName, receivertesting.ChannelNamespace)
+	ctx, span := trace.StartSpan(context.Background(), "TestSpan")
+	defer span.End()
+	traceParent := extensions.FromSpanContext(span.SpanContext()).TraceParent
+
+	// Verify The Trace Headers & Distributed Tracing Extension Are Added While Tracing Is Enabled
+	err := producer.ProduceKafkaMessage(ctx, channelReference, receivertesting.CreateBindingMessage(cloudevents.VersionV1))
+	assert.Nil(t, err)
+	producerMessage := mockSyncProducer.GetMessage()
+	assert.NotNil(t, producerMessage)
+	receivertesting.ValidateProducerMessageHeader(t, producerMessage.Headers, "traceparent", traceParent)
+	receivertesting.ValidateProducerMessageHeader(t, producerMessage.Headers, "ce_traceparent", traceParent)
+
+	// Verify No Trace Headers Are Added While Tracing Is Disabled
+	tracing.SetEnabled(false)
+	defer tracing.SetEnabled(true)
+	err = producer.ProduceKafkaMessage(ctx, channelReference, receivertesting.CreateBindingMessage(cloudevents.VersionV1))
+	assert.Nil(t, err)
+	producerMessage = mockSyncProducer.GetMessage()
+	assert.NotNil(t, producerMessage)
+	assert.Nil(t, receivertesting.GetProducerMessageHeader(t, producerMessage.Headers, "traceparent"))
+	assert.Nil(t, receivertesting.GetProducerMessageHeader(t, producerMessage.Headers, "ce_traceparent"))
+}
+
 func getBaseConfigMap() *corev1.ConfigMap {
 	return &corev1.ConfigMap{
 		TypeMeta: v1.TypeMeta{
@@ -207,6 +642,82 @@ func TestConfigChanged(t *testing.T) {
 	// Verify that having eventing-kafka settings in the configmap doesn't cause trouble
 	producer = runConfigChangedTest(t, producer, getBaseConfigMap(), TestConfigBase, TestEventingKafka, false)
 	assert.NotNil(t, producer)
+
+	// Verify that the Kafka Secret's TLS enable override takes precedence over the configmap in the new Producer
+	producer.SetTLSEnable("true")
+	producer = runConfigChangedTest(t, producer, getBaseConfigMap(), TestConfigProducerChange, "", true)
+	assert.True(t, producer.configuration.Net.TLS.Enable)
+	assert.Equal(t, "true", producer.tlsEnable)
+
+	// Verify that the required acks setting recreates the Producer with the specified RequiredAcks
+	producer = runConfigChangedTest(t, producer, getBaseConfigMap(), TestConfigBase, TestEventingKafkaRequiredAcks, true)
+	assert.Equal(t, sarama.NoResponse, producer.configuration.Producer.RequiredAcks)
+
+	// Verify that required acks conflicting with the idempotent producer are ignored
+	producer = runConfigChangedTest(t, producer, getBaseConfigMap(), TestConfigBase, TestEventingKafkaRequiredAcksConflict, false)
+
+	// Verify that enabling the idempotent producer recreates the Producer with the dependent settings
+	producer = runConfigChangedTest(t, producer, getBaseConfigMap(), TestConfigBase, TestEventingKafkaIdempotent, true)
+	assert.True(t, producer.configuration.Producer.Idempotent)
+	assert.Equal(t, sarama.WaitForAll, producer.configuration.Producer.RequiredAcks)
+	assert.Equal(t, 1, producer.configuration.Net.MaxOpenRequests)
+}
+
+// Test The RootCAsChanged() Functionality
+func TestRootCAsChanged(t *testing.T) {
+
+	// Create A Test Producer
+	producer := createTestProducer(t, receivertesting.NewMockSyncProducer())
+	originalConfig := producer.configuration
+
+	// Stub The Kafka Producer Creation Wrapper With Test Version Returning A New SyncProducer
+	createSyncProducerWrapperPlaceholder := createSyncProducerWrapper
+	createSyncProducerWrapper = func(config *sarama.Config, brokers []string) (sarama.SyncProducer, gometrics.Registry, error) {
+		return receivertesting.NewMockSyncProducer(), config.MetricRegistry, nil
+	}
+	defer func() { createSyncProducerWrapper = createSyncProducerWrapperPlaceholder }()
+
+	// Verify Nil RootCAs Do Not Recreate The Producer
+	assert.Nil(t, producer.RootCAsChanged(nil))
+
+	// Verify New RootCAs Recreate The Producer With The Existing Config Carried Forward
+	rootCAs := x509.NewCertPool()
+	newProducer := producer.RootCAsChanged(rootCAs)
+	assert.NotNil(t, newProducer)
+	assert.Equal(t, rootCAs, newProducer.configuration.Net.TLS.Config.RootCAs)
+	assert.Equal(t, originalConfig.Net.SASL.Mechanism, newProducer.configuration.Net.SASL.Mechanism)
+	assert.Nil(t, originalConfig.Net.TLS.Config.RootCAs)
+	assert.True(t, newProducer.healthServer.ProducerReady())
+	newProducer.Close()
+}
+
+// Test The Producer's BrokersChanged() Functionality
+func TestBrokersChanged(t *testing.T) {
+
+	// Create A Test Producer
+	producer := createTestProducer(t, receivertesting.NewMockSyncProducer())
+	originalConfig := producer.configuration
+	originalBrokers := producer.brokers
+
+	// Stub The Kafka Producer Creation Wrapper With Test Version Returning A New SyncProducer
+	createSyncProducerWrapperPlaceholder := createSyncProducerWrapper
+	createSyncProducerWrapper = func(config *sarama.Config, brokers []string) (sarama.SyncProducer, gometrics.Registry, error) {
+		return receivertesting.NewMockSyncProducer(), config.MetricRegistry, nil
+	}
+	defer func() { createSyncProducerWrapper = createSyncProducerWrapperPlaceholder }()
+
+	// Verify Empty Or Unchanged Brokers Do Not Recreate The Producer
+	assert.Nil(t, producer.BrokersChanged(nil))
+	assert.Nil(t, producer.BrokersChanged(originalBrokers))
+
+	// Verify New Brokers Recreate The Producer With The Existing Config Carried Forward
+	newBrokers := []string{"broker-0:9092", "broker-1:9092"}
+	newProducer := producer.BrokersChanged(newBrokers)
+	assert.NotNil(t, newProducer)
+	assert.Equal(t, newBrokers, newProducer.brokers)
+	assert.Equal(t, originalConfig.Net.SASL.Mechanism, newProducer.configuration.Net.SASL.Mechanism)
+	assert.True(t, newProducer.healthServer.ProducerReady())
+	newProducer.Close()
 }
 
 func runConfigChangedTest(t *testing.T, originalProducer *Producer, base *corev1.ConfigMap, changed string, eventingKafka string, expectedNewProducer bool) *Producer {
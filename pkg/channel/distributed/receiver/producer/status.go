@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package producer
+
+import (
+	"context"
+	"net/http"
+
+	kafkaproducer "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/producer"
+)
+
+//
+// Produce Error Response Status
+//
+// The Knative Eventing MessageReceiver responds to every error returned by the message handler with a
+// generic 500 Internal Server Error.  The ProduceErrorStatusHandler wraps the MessageReceiver and places
+// a produceErrorHolder in the request context, into which ProduceKafkaMessage() records any ProduceError
+// so that the 500 response can be replaced with the status code appropriate to the specific Kafka error
+// (e.g. 503 Service Unavailable for NotEnoughReplicas, or 413 Request Entity Too Large).
+//
+
+// Context Key For The Produce Error Holder
+type produceErrorHolderKey struct{}
+
+// Holder For The ProduceError (If Any) Of A Single Request
+type produceErrorHolder struct {
+	produceError *kafkaproducer.ProduceError
+}
+
+// Record The Specified ProduceError In The Context's Holder (If The Request Is Being Handled By A ProduceErrorStatusHandler)
+func setProduceError(ctx context.Context, produceError *kafkaproducer.ProduceError) {
+	if holder, ok := ctx.Value(produceErrorHolderKey{}).(*produceErrorHolder); ok {
+		holder.produceError = produceError
+	}
+}
+
+// Create A New HTTP Handler Which Responds With The Status Code Of Any ProduceError Instead Of The Wrapped Handler's 500
+func NewProduceErrorStatusHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		holder := &produceErrorHolder{}
+		request = request.WithContext(context.WithValue(request.Context(), produceErrorHolderKey{}, holder))
+		handler.ServeHTTP(&produceErrorResponseWriter{ResponseWriter: response, holder: holder}, request)
+	})
+}
+
+// ResponseWriter Replacing A 500 Internal Server Error With The Status Code Of The Holder's ProduceError
+type produceErrorResponseWriter struct {
+	http.ResponseWriter
+	holder *produceErrorHolder
+}
+
+// Write The Response Header With The ProduceError's Status Code (If Any) In Place Of A 500 Internal Server Error
+func (w *produceErrorResponseWriter) WriteHeader(statusCode int) {
+	if statusCode == http.StatusInternalServerError && w.holder.produceError != nil {
+		statusCode = w.holder.produceError.StatusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
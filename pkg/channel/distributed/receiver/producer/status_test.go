@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package producer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	kafkaproducer "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/producer"
+)
+
+// Test The NewProduceErrorStatusHandler() Functionality
+func TestNewProduceErrorStatusHandler(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name           string
+		produceError   *kafkaproducer.ProduceError
+		statusCode     int
+		expectedStatus int
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Success", statusCode: http.StatusAccepted, expectedStatus: http.StatusAccepted},
+		{name: "Non-Produce Error", statusCode: http.StatusInternalServerError, expectedStatus: http.StatusInternalServerError},
+		{name: "Not Found", produceError: kafkaproducer.NewProduceError(sarama.ErrNotEnoughReplicas), statusCode: http.StatusNotFound, expectedStatus: http.StatusNotFound},
+		{name: "Not Enough Replicas", produceError: kafkaproducer.NewProduceError(sarama.ErrNotEnoughReplicas), statusCode: http.StatusInternalServerError, expectedStatus: http.StatusServiceUnavailable},
+		{name: "Message Too Large", produceError: kafkaproducer.NewProduceError(sarama.ErrMessageSizeTooLarge), statusCode: http.StatusInternalServerError, expectedStatus: http.StatusRequestEntityTooLarge},
+		{name: "Unknown", produceError: kafkaproducer.NewProduceError(errors.New("test error")), statusCode: http.StatusInternalServerError, expectedStatus: http.StatusInternalServerError},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			// Create A Handler Which Records The ProduceError (If Any) Before Responding Like The MessageReceiver
+			handler := NewProduceErrorStatusHandler(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+				if testCase.produceError != nil {
+					setProduceError(request.Context(), testCase.produceError)
+				}
+				response.WriteHeader(testCase.statusCode)
+			}))
+
+			// Perform The Test
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+
+			// Verify The Results
+			assert.Equal(t, testCase.expectedStatus, recorder.Code)
+		})
+	}
+}
+
+// Test The setProduceError() Functionality Outside Of A ProduceErrorStatusHandler
+func TestSetProduceErrorWithoutHolder(t *testing.T) {
+	assert.NotPanics(t, func() {
+		setProduceError(context.Background(), kafkaproducer.NewProduceError(sarama.ErrNotEnoughReplicas))
+	})
+}
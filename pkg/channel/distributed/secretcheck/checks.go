@@ -0,0 +1,302 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	corev1 "k8s.io/api/core/v1"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	kafkasarama "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+)
+
+//
+// Individual Kafka Secret Checks
+//
+// Each check validates a single aspect of a Kafka Secret (and the Sarama configuration derived from it) and
+// returns a Result describing the outcome, so that they may be unit tested in isolation and run in sequence
+// by Run().  The network based checks use the package-level wrapper functions to facilitate testing.
+//
+
+// Check Names
+const (
+	CheckSecretKeys       = "Secret Keys"
+	CheckSaramaConfig     = "Sarama Config"
+	CheckBrokersReachable = "Brokers Reachable"
+	CheckTLSValid         = "TLS Valid"
+	CheckSASLAccepted     = "SASL Accepted"
+	CheckAdminConnection  = "Admin Connection"
+)
+
+// The Known Kafka Secret Data Keys (Any Other Key Is Most Likely A Typo)
+var knownSecretKeys = []string{
+	constants.KafkaSecretKeyBrokers,
+	constants.KafkaSecretKeyNamespace,
+	constants.KafkaSecretKeyUsername,
+	constants.KafkaSecretKeyPassword,
+	constants.KafkaSecretKeySaslMechanism,
+	constants.KafkaSecretKeyUserCert,
+	constants.KafkaSecretKeyUserKey,
+	constants.KafkaSecretKeyTLSEnable,
+	constants.KafkaSecretKeyRestEndpoint,
+	constants.KafkaSecretKeyClusterId,
+}
+
+// Wrapper Around net.DialTimeout() To Facilitate Unit Testing
+var dialWrapper = func(address string, timeout time.Duration) (net.Conn, error) {
+	return net.DialTimeout("tcp", address, timeout)
+}
+
+// Wrapper Around tls.DialWithDialer() To Facilitate Unit Testing
+var tlsDialWrapper = func(address string, timeout time.Duration, tlsConfig *tls.Config) (*tls.Conn, error) {
+	return tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", address, tlsConfig)
+}
+
+// Wrapper Around Opening A Sarama Broker (Including Any TLS & SASL Authentication) To Facilitate Unit Testing
+var openBrokerWrapper = func(address string, config *sarama.Config) error {
+	broker := sarama.NewBroker(address)
+	err := broker.Open(config)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = broker.Close() }()
+	_, err = broker.Connected() // Blocks Until The Connection (And Authentication) Attempt Has Completed
+	return err
+}
+
+// Get The Trimmed Brokers From The Specified Kafka Secret
+func SecretBrokers(secret *corev1.Secret) []string {
+	brokers := make([]string, 0)
+	if secret == nil {
+		return brokers
+	}
+	for _, broker := range strings.Split(string(secret.Data[constants.KafkaSecretKeyBrokers]), ",") {
+		broker = strings.TrimSpace(broker)
+		if len(broker) > 0 {
+			brokers = append(brokers, broker)
+		}
+	}
+	return brokers
+}
+
+// Check The Kafka Secret Contains Only Known Keys, Well-Formed Brokers & Matching Username / Password
+func SecretKeys(secret *corev1.Secret) Result {
+
+	// Validate The Secret Was Found
+	if secret == nil {
+		return fail(CheckSecretKeys, "kafka secret not found")
+	}
+
+	// Check For Unknown (Misspelled) Keys
+	unknownKeys := make([]string, 0)
+	for key := range secret.Data {
+		if !isKnownSecretKey(key) {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+	if len(unknownKeys) > 0 {
+		sort.Strings(unknownKeys)
+		return fail(CheckSecretKeys, "unknown key(s) %v - expected only %v", unknownKeys, knownSecretKeys)
+	}
+
+	// Check The Brokers Are Present & Each Of The Form host:port
+	brokers := SecretBrokers(secret)
+	if len(brokers) <= 0 {
+		return fail(CheckSecretKeys, "missing or empty '%s' key", constants.KafkaSecretKeyBrokers)
+	}
+	for _, broker := range brokers {
+		host, port, err := net.SplitHostPort(broker)
+		if err != nil || len(host) <= 0 || len(port) <= 0 {
+			return fail(CheckSecretKeys, "invalid broker '%s' - expected a comma separated list of host:port", broker)
+		}
+	}
+
+	// Check The Username & Password Are Either Both Present Or Both Absent
+	username := string(secret.Data[constants.KafkaSecretKeyUsername])
+	password := string(secret.Data[constants.KafkaSecretKeyPassword])
+	if (len(username) > 0) != (len(password) > 0) {
+		return fail(CheckSecretKeys, "'%s' and '%s' must be specified together", constants.KafkaSecretKeyUsername, constants.KafkaSecretKeyPassword)
+	}
+
+	return pass(CheckSecretKeys, "found %d broker(s) %v", len(brokers), brokers)
+}
+
+// Determine Whether The Specified Key Is A Known Kafka Secret Key
+func isKnownSecretKey(key string) bool {
+	for _, knownKey := range knownSecretKeys {
+		if key == knownKey {
+			return true
+		}
+	}
+	return false
+}
+
+//
+// Check The Sarama Config Can Be Created For The Kafka Secret (Returning The Config If So)
+//
+// The Sarama settings are loaded from the eventing-kafka ConfigMap via the same LoadSettings() (and therefore
+// MergeSaramaSettings()) path as the controller, which surfaces malformed YAML and CA PEMs, and the Kafka
+// Secret's credentials, SASL mechanism, client certificate and TLS enable override are then applied as the Kafka AdminClient does.
+// The Provided Context Must Have A Kubernetes Client Associated With It.
+//
+func SaramaConfig(ctx context.Context, secret *corev1.Secret, clientId string) (*sarama.Config, Result) {
+
+	// Load The Sarama Settings From The ConfigMap
+	config, _, err := kafkasarama.LoadSettings(ctx)
+	if err != nil {
+		return nil, fail(CheckSaramaConfig, "failed to load sarama settings: %v", err)
+	}
+
+	// Apply The Kafka Secret's Credentials, SASL Mechanism, Client Certificate & TLS Enable Override
+	kafkasarama.UpdateSaramaConfig(config, clientId, string(secret.Data[constants.KafkaSecretKeyUsername]), string(secret.Data[constants.KafkaSecretKeyPassword]))
+	err = kafkasarama.UpdateSaramaConfigSaslMechanism(config, string(secret.Data[constants.KafkaSecretKeySaslMechanism]))
+	if err != nil {
+		return nil, fail(CheckSaramaConfig, "invalid '%s': %v", constants.KafkaSecretKeySaslMechanism, err)
+	}
+	certificate, err := kafkasarama.ParseTLSCertificate(string(secret.Data[constants.KafkaSecretKeyUserCert]), string(secret.Data[constants.KafkaSecretKeyUserKey]))
+	if err != nil {
+		return nil, fail(CheckSaramaConfig, "invalid '%s' / '%s': %v", constants.KafkaSecretKeyUserCert, constants.KafkaSecretKeyUserKey, err)
+	} else if certificate != nil {
+		kafkasarama.UpdateSaramaConfigTLSCertificates(config, []tls.Certificate{*certificate})
+	}
+	err = kafkasarama.UpdateSaramaConfigTLSEnable(config, string(secret.Data[constants.KafkaSecretKeyTLSEnable]))
+	if err != nil {
+		return nil, fail(CheckSaramaConfig, "invalid '%s': %v", constants.KafkaSecretKeyTLSEnable, err)
+	}
+
+	// Validate The Resulting Config As Sarama Will
+	err = config.Validate()
+	if err != nil {
+		return nil, fail(CheckSaramaConfig, "invalid sarama config: %v", err)
+	}
+
+	return config, pass(CheckSaramaConfig, "version %s, tls %s, sasl %s", config.Version, enabled(config.Net.TLS.Enable), saslDescription(config))
+}
+
+// Check Every Broker Accepts A TCP Connection Within The Timeout
+func BrokersReachable(brokers []string, timeout time.Duration) Result {
+	failures := make([]string, 0)
+	for _, broker := range brokers {
+		conn, err := dialWrapper(broker, timeout)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s (%v)", broker, err))
+			continue
+		}
+		_ = conn.Close()
+	}
+	if len(failures) > 0 {
+		return fail(CheckBrokersReachable, "%d of %d broker(s) unreachable: %s", len(failures), len(brokers), strings.Join(failures, ", "))
+	}
+	return pass(CheckBrokersReachable, "%d broker(s) reachable", len(brokers))
+}
+
+// Check Every Broker Completes A TLS Handshake With The Sarama TLS Config (Skipped If TLS Is Disabled)
+func TLSValid(config *sarama.Config, brokers []string, timeout time.Duration) Result {
+	if !config.Net.TLS.Enable {
+		return skip(CheckTLSValid, "tls is not enabled")
+	}
+	failures := make([]string, 0)
+	for _, broker := range brokers {
+		conn, err := tlsDialWrapper(broker, timeout, brokerTLSConfig(broker, config.Net.TLS.Config))
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s (%v)", broker, err))
+			continue
+		}
+		_ = conn.Close()
+	}
+	if len(failures) > 0 {
+		return fail(CheckTLSValid, "%d of %d broker(s) failed the tls handshake: %s", len(failures), len(brokers), strings.Join(failures, ", "))
+	}
+	if kafkasarama.InsecureSkipVerify(config) {
+		return pass(CheckTLSValid, "tls handshake succeeded with %d broker(s) WITHOUT certificate verification (insecureSkipVerify)", len(brokers))
+	}
+	return pass(CheckTLSValid, "tls handshake succeeded with %d broker(s)", len(brokers))
+}
+
+// Get The TLS Config For The Specified Broker (Defaulting The ServerName To The Broker Host As Sarama Does)
+func brokerTLSConfig(broker string, tlsConfig *tls.Config) *tls.Config {
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	} else {
+		tlsConfig = tlsConfig.Clone()
+	}
+	if len(tlsConfig.ServerName) <= 0 {
+		if host, _, err := net.SplitHostPort(broker); err == nil {
+			tlsConfig.ServerName = host
+		}
+	}
+	return tlsConfig
+}
+
+// Check Every Broker Accepts The SASL Credentials (Skipped If SASL Is Disabled)
+func SASLAccepted(config *sarama.Config, brokers []string) Result {
+	if !config.Net.SASL.Enable {
+		return skip(CheckSASLAccepted, "sasl is not enabled")
+	}
+	failures := make([]string, 0)
+	for _, broker := range brokers {
+		err := openBrokerWrapper(broker, config)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s (%v)", broker, err))
+		}
+	}
+	if len(failures) > 0 {
+		return fail(CheckSASLAccepted, "%d of %d broker(s) rejected the %s credentials: %s", len(failures), len(brokers), saslDescription(config), strings.Join(failures, ", "))
+	}
+	return pass(CheckSASLAccepted, "%s credentials accepted by %d broker(s)", saslDescription(config), len(brokers))
+}
+
+// Check A Short-Lived Sarama ClusterAdmin Can Connect To The Cluster & Describe It
+func AdminConnection(config *sarama.Config, brokers []string) Result {
+	clusterAdmin, err := kafkaadmin.NewClusterAdminWrapper(brokers, config)
+	if err != nil {
+		return fail(CheckAdminConnection, "failed to create kafka admin client: %v", err)
+	}
+	defer func() { _ = clusterAdmin.Close() }()
+	clusterBrokers, controllerId, err := clusterAdmin.DescribeCluster()
+	if err != nil {
+		return fail(CheckAdminConnection, "failed to describe kafka cluster: %v", err)
+	}
+	return pass(CheckAdminConnection, "cluster has %d broker(s) with controller id %d", len(clusterBrokers), controllerId)
+}
+
+// Describe Whether A Setting Is Enabled
+func enabled(value bool) string {
+	if value {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// Describe The SASL Configuration (Mechanism If Enabled)
+func saslDescription(config *sarama.Config) string {
+	if !config.Net.SASL.Enable {
+		return enabled(false)
+	}
+	if len(config.Net.SASL.Mechanism) <= 0 {
+		return sarama.SASLTypePlaintext
+	}
+	return string(config.Net.SASL.Mechanism)
+}
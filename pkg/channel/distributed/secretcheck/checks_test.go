@@ -0,0 +1,306 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+	injectionclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/system"
+)
+
+// Test Data
+const (
+	testSecretName = "test-kafka-secret"
+	testClientId   = "test-client-id"
+	testBroker1    = "broker1.example.com:9093"
+	testBroker2    = "broker2.example.com:9093"
+	testUsername   = "test-username"
+	testPassword   = "test-password"
+	testTimeout    = time.Second
+)
+
+// Test The SecretKeys() Check
+func TestSecretKeys(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name           string
+		secret         *corev1.Secret
+		expectedStatus Status
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Valid", secret: createTestSecret(nil), expectedStatus: StatusPass},
+		{name: "Valid Without Credentials", secret: createTestSecret(map[string]string{constants.KafkaSecretKeyUsername: "", constants.KafkaSecretKeyPassword: ""}), expectedStatus: StatusPass},
+		{name: "Missing Secret", secret: nil, expectedStatus: StatusFail},
+		{name: "Missing Brokers", secret: createTestSecret(map[string]string{constants.KafkaSecretKeyBrokers: " "}), expectedStatus: StatusFail},
+		{name: "Invalid Broker", secret: createTestSecret(map[string]string{constants.KafkaSecretKeyBrokers: testBroker1 + ",broker2"}), expectedStatus: StatusFail},
+		{name: "Unknown Key", secret: createTestSecret(map[string]string{"user": testUsername}), expectedStatus: StatusFail},
+		{name: "Username Without Password", secret: createTestSecret(map[string]string{constants.KafkaSecretKeyPassword: ""}), expectedStatus: StatusFail},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := SecretKeys(testCase.secret)
+			assert.Equal(t, CheckSecretKeys, result.Check)
+			assert.Equal(t, testCase.expectedStatus, result.Status, result.Message)
+		})
+	}
+}
+
+// Test The SecretBrokers() Functionality
+func TestSecretBrokers(t *testing.T) {
+	assert.Equal(t, []string{}, SecretBrokers(nil))
+	assert.Equal(t, []string{testBroker1, testBroker2}, SecretBrokers(createTestSecret(map[string]string{constants.KafkaSecretKeyBrokers: " " + testBroker1 + " , " + testBroker2 + ","})))
+}
+
+// Test The SaramaConfig() Check
+func TestSaramaConfig(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name           string
+		saramaConfig   string
+		secret         *corev1.Secret
+		expectedStatus Status
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Valid", saramaConfig: commontesting.OldSaramaConfig, secret: createTestSecret(nil), expectedStatus: StatusPass},
+		{name: "Malformed Sarama Settings", saramaConfig: "Net: [", secret: createTestSecret(nil), expectedStatus: StatusFail},
+		{name: "Invalid Root PEM", saramaConfig: "Net:\n  TLS:\n    Enable: true\n    Config:\n      RootPEMs:\n      - not-a-pem\n", secret: createTestSecret(nil), expectedStatus: StatusFail},
+		{name: "Invalid SASL Mechanism", saramaConfig: commontesting.OldSaramaConfig, secret: createTestSecret(map[string]string{constants.KafkaSecretKeySaslMechanism: "INVALID"}), expectedStatus: StatusFail},
+		{name: "Client Certificate Without Key", saramaConfig: commontesting.OldSaramaConfig, secret: createTestSecret(map[string]string{constants.KafkaSecretKeyUserCert: "cert"}), expectedStatus: StatusFail},
+		{name: "TLS Enable Override", saramaConfig: commontesting.OldSaramaConfig, secret: createTestSecret(map[string]string{constants.KafkaSecretKeyTLSEnable: "true"}), expectedStatus: StatusPass},
+		{name: "Invalid TLS Enable", saramaConfig: commontesting.OldSaramaConfig, secret: createTestSecret(map[string]string{constants.KafkaSecretKeyTLSEnable: "maybe"}), expectedStatus: StatusFail},
+		{name: "SASL Without Credentials", saramaConfig: commontesting.OldSaramaConfig, secret: createTestSecret(map[string]string{constants.KafkaSecretKeyUsername: "", constants.KafkaSecretKeyPassword: ""}), expectedStatus: StatusFail},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := getTestContext(t, testCase.saramaConfig)
+			config, result := SaramaConfig(ctx, testCase.secret, testClientId)
+			assert.Equal(t, CheckSaramaConfig, result.Check)
+			assert.Equal(t, testCase.expectedStatus, result.Status, result.Message)
+			if testCase.expectedStatus == StatusPass {
+				assert.NotNil(t, config)
+				assert.Equal(t, testClientId, config.ClientID)
+				assert.Equal(t, testUsername, config.Net.SASL.User)
+				assert.Equal(t, testPassword, config.Net.SASL.Password)
+			} else {
+				assert.Nil(t, config)
+			}
+		})
+	}
+}
+
+// Test The BrokersReachable() Check
+func TestBrokersReachable(t *testing.T) {
+
+	// Mock The Dialer (Only testBroker1 Is Reachable)
+	dialWrapperPlaceholder := dialWrapper
+	dialWrapper = func(address string, timeout time.Duration) (net.Conn, error) {
+		assert.Equal(t, testTimeout, timeout)
+		if address == testBroker1 {
+			conn, _ := net.Pipe()
+			return conn, nil
+		}
+		return nil, errors.New("connection refused")
+	}
+	defer func() { dialWrapper = dialWrapperPlaceholder }()
+
+	// Verify The Results
+	result := BrokersReachable([]string{testBroker1}, testTimeout)
+	assert.Equal(t, CheckBrokersReachable, result.Check)
+	assert.Equal(t, StatusPass, result.Status)
+	result = BrokersReachable([]string{testBroker1, testBroker2}, testTimeout)
+	assert.Equal(t, StatusFail, result.Status)
+	assert.Contains(t, result.Message, testBroker2)
+	assert.NotContains(t, result.Message, testBroker1)
+}
+
+// Test The TLSValid() Check
+func TestTLSValid(t *testing.T) {
+
+	// Mock The TLS Dialer (Only testBroker1 Completes The Handshake)
+	var serverNames []string
+	tlsDialWrapperPlaceholder := tlsDialWrapper
+	tlsDialWrapper = func(address string, timeout time.Duration, tlsConfig *tls.Config) (*tls.Conn, error) {
+		serverNames = append(serverNames, tlsConfig.ServerName)
+		if address == testBroker1 {
+			conn, _ := net.Pipe()
+			return tls.Client(conn, tlsConfig), nil
+		}
+		return nil, errors.New("x509: certificate signed by unknown authority")
+	}
+	defer func() { tlsDialWrapper = tlsDialWrapperPlaceholder }()
+
+	// Verify TLS Disabled Is Skipped
+	config := sarama.NewConfig()
+	result := TLSValid(config, []string{testBroker1}, testTimeout)
+	assert.Equal(t, CheckTLSValid, result.Check)
+	assert.Equal(t, StatusSkip, result.Status)
+
+	// Verify A Successful Handshake Defaults The ServerName To The Broker Host
+	config.Net.TLS.Enable = true
+	result = TLSValid(config, []string{testBroker1}, testTimeout)
+	assert.Equal(t, StatusPass, result.Status)
+	assert.Equal(t, []string{"broker1.example.com"}, serverNames)
+
+	// Verify A Configured ServerName Is Used & Failed Handshakes Are Reported
+	serverNames = nil
+	config.Net.TLS.Config = &tls.Config{ServerName: "kafka-proxy.example.com"}
+	result = TLSValid(config, []string{testBroker1, testBroker2}, testTimeout)
+	assert.Equal(t, StatusFail, result.Status)
+	assert.Contains(t, result.Message, testBroker2)
+	assert.Contains(t, result.Message, "unknown authority")
+	assert.Equal(t, []string{"kafka-proxy.example.com", "kafka-proxy.example.com"}, serverNames)
+}
+
+// Test The SASLAccepted() Check
+func TestSASLAccepted(t *testing.T) {
+
+	// Mock The Broker Connection (Only testBroker1 Accepts The Credentials)
+	openBrokerWrapperPlaceholder := openBrokerWrapper
+	openBrokerWrapper = func(address string, config *sarama.Config) error {
+		if address == testBroker1 {
+			return nil
+		}
+		return sarama.ErrSASLAuthenticationFailed
+	}
+	defer func() { openBrokerWrapper = openBrokerWrapperPlaceholder }()
+
+	// Verify SASL Disabled Is Skipped
+	config := sarama.NewConfig()
+	result := SASLAccepted(config, []string{testBroker1})
+	assert.Equal(t, CheckSASLAccepted, result.Check)
+	assert.Equal(t, StatusSkip, result.Status)
+
+	// Verify Accepted & Rejected Credentials
+	config.Net.SASL.Enable = true
+	config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+	result = SASLAccepted(config, []string{testBroker1})
+	assert.Equal(t, StatusPass, result.Status)
+	assert.Contains(t, result.Message, sarama.SASLTypeSCRAMSHA512)
+	result = SASLAccepted(config, []string{testBroker1, testBroker2})
+	assert.Equal(t, StatusFail, result.Status)
+	assert.Contains(t, result.Message, testBroker2)
+}
+
+// Test The AdminConnection() Check
+func TestAdminConnection(t *testing.T) {
+
+	// Mock The ClusterAdmin Creation
+	var clusterAdmin *mockClusterAdmin
+	var clusterAdminErr error
+	newClusterAdminWrapperPlaceholder := kafkaadmin.NewClusterAdminWrapper
+	kafkaadmin.NewClusterAdminWrapper = func(brokers []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+		assert.Equal(t, []string{testBroker1}, brokers)
+		if clusterAdminErr != nil {
+			return nil, clusterAdminErr
+		}
+		return clusterAdmin, nil
+	}
+	defer func() { kafkaadmin.NewClusterAdminWrapper = newClusterAdminWrapperPlaceholder }()
+
+	// Verify A Successful Connection Is Closed
+	clusterAdmin = &mockClusterAdmin{brokers: []*sarama.Broker{sarama.NewBroker(testBroker1)}}
+	result := AdminConnection(sarama.NewConfig(), []string{testBroker1})
+	assert.Equal(t, CheckAdminConnection, result.Check)
+	assert.Equal(t, StatusPass, result.Status, result.Message)
+	assert.True(t, clusterAdmin.closed)
+
+	// Verify A Failed DescribeCluster
+	clusterAdmin = &mockClusterAdmin{describeErr: sarama.ErrClusterAuthorizationFailed}
+	result = AdminConnection(sarama.NewConfig(), []string{testBroker1})
+	assert.Equal(t, StatusFail, result.Status)
+	assert.True(t, clusterAdmin.closed)
+
+	// Verify A Failed Connection
+	clusterAdminErr = sarama.ErrOutOfBrokers
+	result = AdminConnection(sarama.NewConfig(), []string{testBroker1})
+	assert.Equal(t, StatusFail, result.Status)
+	assert.Contains(t, result.Message, sarama.ErrOutOfBrokers.Error())
+}
+
+// Create A Valid Test Kafka Secret With The Specified Data Overrides (Empty Values Remove The Key)
+func createTestSecret(overrides map[string]string) *corev1.Secret {
+	data := map[string][]byte{
+		constants.KafkaSecretKeyBrokers:  []byte(testBroker1 + "," + testBroker2),
+		constants.KafkaSecretKeyUsername: []byte(testUsername),
+		constants.KafkaSecretKeyPassword: []byte(testPassword),
+	}
+	for key, value := range overrides {
+		if len(value) > 0 {
+			data[key] = []byte(value)
+		} else {
+			delete(data, key)
+		}
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: testSecretName, Namespace: commonconstants.KnativeEventingNamespace},
+		Data:       data,
+	}
+}
+
+// Get A Context With A Fake K8S Client Containing The eventing-kafka ConfigMap (& Any Specified Objects)
+func getTestContext(t *testing.T, saramaConfig string, secrets ...*corev1.Secret) context.Context {
+	assert.Nil(t, os.Setenv(system.NamespaceEnvKey, commonconstants.KnativeEventingNamespace))
+	fakeK8sClient := fake.NewSimpleClientset(commontesting.GetTestSaramaConfigMap(saramaConfig, commontesting.TestEKConfig))
+	for _, secret := range secrets {
+		_, err := fakeK8sClient.CoreV1().Secrets(secret.Namespace).Create(context.TODO(), secret, metav1.CreateOptions{})
+		assert.Nil(t, err)
+	}
+	return context.WithValue(context.Background(), injectionclient.Key{}, fakeK8sClient)
+}
+
+// Mock Sarama ClusterAdmin (Only DescribeCluster & Close Are Implemented)
+type mockClusterAdmin struct {
+	sarama.ClusterAdmin
+	brokers     []*sarama.Broker
+	describeErr error
+	closed      bool
+}
+
+func (m *mockClusterAdmin) DescribeCluster() ([]*sarama.Broker, int32, error) {
+	return m.brokers, 1, m.describeErr
+}
+
+func (m *mockClusterAdmin) Close() error {
+	m.closed = true
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretcheck
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/client/injection/kube/client"
+)
+
+//
+// Kafka Secret Validation
+//
+// Operators frequently deploy Kafka Secrets with misspelled keys, malformed CA PEMs, or brokers which are
+// unreachable from the cluster, which only surface as opaque connection failures in the controller logs.
+// Run() validates a Kafka Secret with the same configuration loading as the controller and produces a
+// Report with a PASS / FAIL / SKIP Result per check.  Checks which depend upon an earlier failed check
+// (e.g. connecting without a valid Sarama config) are skipped rather than reporting misleading failures.
+//
+
+// Check Result Statuses
+type Status string
+
+const (
+	StatusPass Status = "PASS"
+	StatusFail Status = "FAIL"
+	StatusSkip Status = "SKIP"
+)
+
+// The Result Of A Single Check
+type Result struct {
+	Check   string
+	Status  Status
+	Message string
+}
+
+// Create A Passed Result
+func pass(check string, format string, args ...interface{}) Result {
+	return Result{Check: check, Status: StatusPass, Message: fmt.Sprintf(format, args...)}
+}
+
+// Create A Failed Result
+func fail(check string, format string, args ...interface{}) Result {
+	return Result{Check: check, Status: StatusFail, Message: fmt.Sprintf(format, args...)}
+}
+
+// Create A Skipped Result
+func skip(check string, format string, args ...interface{}) Result {
+	return Result{Check: check, Status: StatusSkip, Message: fmt.Sprintf(format, args...)}
+}
+
+// The Results Of All Checks Performed Against A Kafka Secret
+type Report []Result
+
+// Determine Whether None Of The Report's Checks Failed
+func (r Report) Passed() bool {
+	for _, result := range r {
+		if result.Status == StatusFail {
+			return false
+		}
+	}
+	return true
+}
+
+// Write The Report In A Human Readable Form (One Line Per Check Followed By A Summary)
+func (r Report) Write(writer io.Writer) {
+	for _, result := range r {
+		_, _ = fmt.Fprintf(writer, "[%s] %-18s %s\n", result.Status, result.Check, result.Message)
+	}
+	if r.Passed() {
+		_, _ = fmt.Fprintln(writer, "Kafka Secret Validation PASSED")
+	} else {
+		_, _ = fmt.Fprintln(writer, "Kafka Secret Validation FAILED")
+	}
+}
+
+//
+// Run All Checks Against The Named Kafka Secret In The Specified Namespace
+//
+// The Provided Context Must Have A Kubernetes Client Associated With It, and the SYSTEM_NAMESPACE must be set to
+// the namespace of the eventing-kafka ConfigMap (as it is for the controller).
+//
+func Run(ctx context.Context, namespace string, secretName string, clientId string, timeout time.Duration) Report {
+	report := make(Report, 0)
+
+	// Get The Kafka Secret & Check Its Keys (Nothing Else Can Be Checked Without Valid Brokers)
+	secret, err := client.Get(ctx).CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		report = append(report, fail(CheckSecretKeys, "failed to get kafka secret %s/%s: %v", namespace, secretName, err))
+		return skipRemaining(report, CheckSaramaConfig, CheckBrokersReachable, CheckTLSValid, CheckSASLAccepted, CheckAdminConnection)
+	}
+	report = append(report, SecretKeys(secret))
+	if !report.Passed() {
+		return skipRemaining(report, CheckSaramaConfig, CheckBrokersReachable, CheckTLSValid, CheckSASLAccepted, CheckAdminConnection)
+	}
+	brokers := SecretBrokers(secret)
+
+	// Create The Sarama Config & Check The Brokers Are Reachable (Independent Of The Config)
+	config, configResult := SaramaConfig(ctx, secret, clientId)
+	report = append(report, configResult, BrokersReachable(brokers, timeout))
+	if !report.Passed() {
+		return skipRemaining(report, CheckTLSValid, CheckSASLAccepted, CheckAdminConnection)
+	}
+
+	// Keep The Sarama Connection Attempts Short-Lived (Failing Fast Rather Than Retrying)
+	config.Net.DialTimeout = timeout
+	config.Metadata.Retry.Max = 0
+
+	// Check The TLS Handshake & SASL Authentication Before Attempting A Full Admin Connection
+	report = append(report, TLSValid(config, brokers, timeout), SASLAccepted(config, brokers))
+	if !report.Passed() {
+		return skipRemaining(report, CheckAdminConnection)
+	}
+	return append(report, AdminConnection(config, brokers))
+}
+
+// Append Skipped Results For The Specified Checks (Which Depend Upon An Earlier Failed Check)
+func skipRemaining(report Report, checks ...string) Report {
+	for _, check := range checks {
+		report = append(report, skip(check, "skipped due to earlier failure"))
+	}
+	return report
+}
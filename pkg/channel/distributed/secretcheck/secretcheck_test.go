@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretcheck
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	commontesting "knative.dev/eventing-kafka/pkg/channel/distributed/common/testing"
+	commonconstants "knative.dev/eventing-kafka/pkg/common/constants"
+)
+
+// Test The Run() Functionality
+func TestRun(t *testing.T) {
+
+	// Mock The Network Connections (Brokers Reachable Unless Specified, TLS & SASL Succeed)
+	reachable := true
+	dialWrapperPlaceholder := dialWrapper
+	tlsDialWrapperPlaceholder := tlsDialWrapper
+	openBrokerWrapperPlaceholder := openBrokerWrapper
+	newClusterAdminWrapperPlaceholder := kafkaadmin.NewClusterAdminWrapper
+	dialWrapper = func(address string, timeout time.Duration) (net.Conn, error) {
+		if !reachable {
+			return nil, errors.New("connection refused")
+		}
+		conn, _ := net.Pipe()
+		return conn, nil
+	}
+	tlsDialWrapper = func(address string, timeout time.Duration, tlsConfig *tls.Config) (*tls.Conn, error) {
+		conn, _ := net.Pipe()
+		return tls.Client(conn, tlsConfig), nil
+	}
+	openBrokerWrapper = func(address string, config *sarama.Config) error {
+		assert.Equal(t, testTimeout, config.Net.DialTimeout)
+		return nil
+	}
+	kafkaadmin.NewClusterAdminWrapper = func(brokers []string, config *sarama.Config) (sarama.ClusterAdmin, error) {
+		return &mockClusterAdmin{}, nil
+	}
+	defer func() {
+		dialWrapper = dialWrapperPlaceholder
+		tlsDialWrapper = tlsDialWrapperPlaceholder
+		openBrokerWrapper = openBrokerWrapperPlaceholder
+		kafkaadmin.NewClusterAdminWrapper = newClusterAdminWrapperPlaceholder
+	}()
+
+	// Verify A Valid Kafka Secret Passes Every Check
+	ctx := getTestContext(t, commontesting.OldSaramaConfig, createTestSecret(nil))
+	report := Run(ctx, commonconstants.KnativeEventingNamespace, testSecretName, testClientId, testTimeout)
+	assert.True(t, report.Passed())
+	assertStatuses(t, report, StatusPass, StatusPass, StatusPass, StatusPass, StatusPass, StatusPass)
+
+	// Verify A Missing Kafka Secret Skips The Remaining Checks
+	report = Run(ctx, commonconstants.KnativeEventingNamespace, "missing-secret", testClientId, testTimeout)
+	assert.False(t, report.Passed())
+	assertStatuses(t, report, StatusFail, StatusSkip, StatusSkip, StatusSkip, StatusSkip, StatusSkip)
+
+	// Verify Unreachable Brokers Skip The Connection Checks
+	reachable = false
+	report = Run(ctx, commonconstants.KnativeEventingNamespace, testSecretName, testClientId, testTimeout)
+	assert.False(t, report.Passed())
+	assertStatuses(t, report, StatusPass, StatusPass, StatusFail, StatusSkip, StatusSkip, StatusSkip)
+}
+
+// Test The Report's Write() Functionality
+func TestReportWrite(t *testing.T) {
+
+	// Verify A Passed Report
+	buffer := &bytes.Buffer{}
+	Report{pass(CheckSecretKeys, "found %d broker(s)", 1), skip(CheckTLSValid, "tls is not enabled")}.Write(buffer)
+	assert.Equal(t, "[PASS] Secret Keys        found 1 broker(s)\n[SKIP] TLS Valid          tls is not enabled\nKafka Secret Validation PASSED\n", buffer.String())
+
+	// Verify A Failed Report
+	buffer.Reset()
+	Report{fail(CheckBrokersReachable, "unreachable")}.Write(buffer)
+	assert.Equal(t, "[FAIL] Brokers Reachable  unreachable\nKafka Secret Validation FAILED\n", buffer.String())
+}
+
+// Verify The Report's Checks (In Order) Have The Specified Statuses
+func assertStatuses(t *testing.T, report Report, statuses ...Status) {
+	checks := []string{CheckSecretKeys, CheckSaramaConfig, CheckBrokersReachable, CheckTLSValid, CheckSASLAccepted, CheckAdminConnection}
+	assert.Len(t, report, len(statuses))
+	for index, result := range report {
+		assert.Equal(t, checks[index], result.Check)
+		assert.Equal(t, statuses[index], result.Status, result.Message)
+	}
+}
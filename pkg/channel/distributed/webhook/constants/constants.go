@@ -0,0 +1,44 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+import "time"
+
+const (
+
+	// The Webhook's Component Name (Needs To Be DNS Safe!)
+	WebhookComponentName = "eventing-kafka-channel-webhook"
+
+	// The Webhook's K8S Service & Certificate Secret Names
+	WebhookServiceName = "eventing-kafka-channel-webhook"
+	WebhookSecretName  = "eventing-kafka-channel-webhook-certs"
+	WebhookPort        = 8443
+
+	// The KafkaChannel Defaulting Webhook Name & Path
+	DefaultingWebhookName = "defaulting.webhook.distributed.kafka.messaging.knative.dev"
+	DefaultingWebhookPath = "/defaulting"
+
+	// The KafkaChannel Validation Webhook Name & Path
+	ValidationWebhookName = "validation.webhook.distributed.kafka.messaging.knative.dev"
+	ValidationWebhookPath = "/resource-validation"
+
+	// The Duration For Which The Live Kafka Broker Count Is Cached
+	BrokerCountCacheDuration = 30 * time.Second
+
+	// The Maximum Time To Wait For The Kafka Cluster To Be Described Before Falling Back To Structural Validation
+	DescribeClusterTimeout = 5 * time.Second
+)
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	"knative.dev/pkg/logging"
+)
+
+//
+// KafkaChannel Admission Defaulting
+//
+// The standard KafkaChannel defaulting (subscribable duck version, cleanup policy, etc.) is applied first, after
+// which unspecified (zero) NumPartitions / ReplicationFactor values are defaulted from the config-eventing-kafka
+// ConfigMap (taking precedence over the static defaults) and written back onto the KafkaChannel spec, so that the
+// values actually used for the Kafka Topic are visible on the resource instead of being silently applied by the
+// controller.  Explicit values (including invalid negative ones, which are left to be rejected by the validation)
+// are preserved.  The ConfigMap is watched, so changes to the topic defaults apply to subsequent admissions.
+//
+
+// Set The Standard & ConfigMap Topic Defaults On The KafkaChannel (The Latter If The EventingKafkaConfig Is In The Context)
+func (c *KafkaChannel) SetDefaults(ctx context.Context) {
+
+	// Retain The Original Spec As The Standard Defaulting Replaces Unspecified Topic Settings With Static Defaults
+	original := c.KafkaChannel.DeepCopy()
+	c.KafkaChannel.SetDefaults(ctx)
+
+	// Apply The ConfigMap Topic Defaults Over The Static Defaults
+	if configuration := getTopicDefaultsConfig(ctx); configuration != nil {
+		applyTopicDefaults(&c.KafkaChannel, original, configuration, logging.FromContext(ctx).Desugar())
+	}
+}
+
+// Topic Defaults Config Context Key
+type topicDefaultsConfigKey struct{}
+
+// Add The EventingKafkaConfig Providing The Topic Defaults To The Context
+func WithTopicDefaultsConfig(ctx context.Context, configuration *commonconfig.EventingKafkaConfig) context.Context {
+	return context.WithValue(ctx, topicDefaultsConfigKey{}, configuration)
+}
+
+// Get The EventingKafkaConfig Providing The Topic Defaults From The Context (Nil If Not Present)
+func getTopicDefaultsConfig(ctx context.Context) *commonconfig.EventingKafkaConfig {
+	configuration, _ := ctx.Value(topicDefaultsConfigKey{}).(*commonconfig.EventingKafkaConfig)
+	return configuration
+}
+
+// Apply The ConfigMap Topic Defaults To Any Originally Unspecified (Zero) KafkaChannel Topic Settings (Negative Values Are Left To Be Rejected)
+func applyTopicDefaults(channel *kafkav1beta1.KafkaChannel, original *kafkav1beta1.KafkaChannel, configuration *commonconfig.EventingKafkaConfig, logger *zap.Logger) {
+	if original.Spec.NumPartitions == 0 && configuration.Kafka.Topic.DefaultNumPartitions > 0 {
+		logger.Debug("Kafka Channel Spec 'NumPartitions' Not Specified - Using ConfigMap Default", zap.Int32("Value", configuration.Kafka.Topic.DefaultNumPartitions))
+		channel.Spec.NumPartitions = configuration.Kafka.Topic.DefaultNumPartitions
+	}
+	if original.Spec.ReplicationFactor == 0 && configuration.Kafka.Topic.DefaultReplicationFactor > 0 {
+		logger.Debug("Kafka Channel Spec 'ReplicationFactor' Not Specified - Using ConfigMap Default", zap.Int16("Value", configuration.Kafka.Topic.DefaultReplicationFactor))
+		channel.Spec.ReplicationFactor = configuration.Kafka.Topic.DefaultReplicationFactor
+	}
+}
+
+// Tracks The Most Recent Valid EventingKafkaConfig Providing The Topic Defaults (Updated As The ConfigMap Changes)
+type topicDefaultsConfigStore struct {
+	logger        *zap.Logger
+	configuration *commonconfig.EventingKafkaConfig
+	mutex         *sync.RWMutex
+}
+
+// Create A New topicDefaultsConfigStore With The Initial EventingKafkaConfig
+func newTopicDefaultsConfigStore(logger *zap.Logger, configuration *commonconfig.EventingKafkaConfig) *topicDefaultsConfigStore {
+	return &topicDefaultsConfigStore{
+		logger:        logger,
+		configuration: configuration,
+		mutex:         &sync.RWMutex{},
+	}
+}
+
+// Get The Current EventingKafkaConfig
+func (s *topicDefaultsConfigStore) Load() *commonconfig.EventingKafkaConfig {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.configuration
+}
+
+// configMapObserver is the callback function that handles changes to the config-eventing-kafka ConfigMap,
+// retaining the previous configuration if the updated settings are invalid.
+func (s *topicDefaultsConfigStore) configMapObserver(configMap *corev1.ConfigMap) {
+	configuration, err := sarama.LoadEventingKafkaSettings(configMap)
+	if err != nil || configuration == nil {
+		s.logger.Error("Invalid Eventing-Kafka Settings In Updated ConfigMap - Retaining Previous Topic Defaults", zap.Error(err))
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.configuration = configuration
+	s.logger.Info("Updated Topic Defaults From ConfigMap",
+		zap.Int32("DefaultNumPartitions", configuration.Kafka.Topic.DefaultNumPartitions),
+		zap.Int16("DefaultReplicationFactor", configuration.Kafka.Topic.DefaultReplicationFactor))
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/common/constants"
+	"knative.dev/eventing/pkg/apis/messaging"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test The KafkaChannel SetDefaults() Functionality
+func TestKafkaChannelSetDefaults(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name                      string
+		numPartitions             int32
+		replicationFactor         int16
+		noConfig                  bool
+		expectedNumPartitions     int32
+		expectedReplicationFactor int16
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Both Unset", expectedNumPartitions: defaultNumPartitions, expectedReplicationFactor: defaultReplicationFactor},
+		{name: "NumPartitions Unset", replicationFactor: 3, expectedNumPartitions: defaultNumPartitions, expectedReplicationFactor: 3},
+		{name: "ReplicationFactor Unset", numPartitions: 8, expectedNumPartitions: 8, expectedReplicationFactor: defaultReplicationFactor},
+		{name: "Both Set", numPartitions: 8, replicationFactor: 3, expectedNumPartitions: 8, expectedReplicationFactor: 3},
+		{name: "Negative Values Preserved", numPartitions: -1, replicationFactor: -1, expectedNumPartitions: -1, expectedReplicationFactor: -1},
+		{name: "No Config In Context", noConfig: true, expectedNumPartitions: constants.DefaultNumPartitions, expectedReplicationFactor: constants.DefaultReplicationFactor},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.TODO()
+			if !testCase.noConfig {
+				ctx = WithTopicDefaultsConfig(ctx, newTestTopicDefaultsConfig())
+			}
+			channel := newTestKafkaChannel(testCase.numPartitions, testCase.replicationFactor)
+			channel.SetDefaults(ctx)
+			assert.Equal(t, testCase.expectedNumPartitions, channel.Spec.NumPartitions)
+			assert.Equal(t, testCase.expectedReplicationFactor, channel.Spec.ReplicationFactor)
+			assert.Empty(t, channel.Spec.RetentionDuration)
+			assert.Equal(t, constants.DefaultCleanupPolicy, channel.Spec.CleanupPolicy)
+			assert.Equal(t, "v1", channel.Annotations[messaging.SubscribableDuckVersionAnnotation])
+		})
+	}
+}
+
+// Test The topicDefaultsConfigStore's configMapObserver() Functionality
+func TestTopicDefaultsConfigStoreConfigMapObserver(t *testing.T) {
+
+	// Create A topicDefaultsConfigStore With The Initial Test Topic Defaults
+	initialConfiguration := newTestTopicDefaultsConfig()
+	store := newTopicDefaultsConfigStore(logtesting.TestLogger(t).Desugar(), initialConfiguration)
+	assert.Same(t, initialConfiguration, store.Load())
+
+	// Verify Invalid Eventing-Kafka Settings Retain The Previous Topic Defaults
+	store.configMapObserver(newTestTopicDefaultsConfigMap("kafka: [invalid"))
+	assert.Same(t, initialConfiguration, store.Load())
+
+	// Verify Updated Topic Defaults Are Applied To Subsequent Defaulting
+	store.configMapObserver(newTestTopicDefaultsConfigMap(`
+kafka:
+  topic:
+    defaultNumPartitions: 12
+    defaultReplicationFactor: 2
+`))
+	assert.Equal(t, int32(12), store.Load().Kafka.Topic.DefaultNumPartitions)
+	assert.Equal(t, int16(2), store.Load().Kafka.Topic.DefaultReplicationFactor)
+	channel := newTestKafkaChannel(0, 0)
+	channel.SetDefaults(WithTopicDefaultsConfig(context.TODO(), store.Load()))
+	assert.Equal(t, int32(12), channel.Spec.NumPartitions)
+	assert.Equal(t, int16(2), channel.Spec.ReplicationFactor)
+}
+
+// Create A New Test config-eventing-kafka ConfigMap With The Specified Eventing-Kafka Settings
+func newTestTopicDefaultsConfigMap(eventingKafkaSettings string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		Data: map[string]string{commonconfig.EventingKafkaSettingsConfigKey: eventingKafkaSettings},
+	}
+}
+
+// Create A New Test EventingKafkaConfig With The Test Topic Defaults
+func newTestTopicDefaultsConfig() *commonconfig.EventingKafkaConfig {
+	return &commonconfig.EventingKafkaConfig{
+		Kafka: commonconfig.EKKafkaConfig{
+			Topic: commonconfig.EKKafkaTopicConfig{
+				DefaultNumPartitions:     defaultNumPartitions,
+				DefaultReplicationFactor: defaultReplicationFactor,
+			},
+		},
+	}
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	kafkasarama "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	kafkautil "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/util"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/controller/util"
+	webhookconstants "knative.dev/eventing-kafka/pkg/channel/distributed/webhook/constants"
+	"knative.dev/pkg/apis"
+)
+
+//
+// KafkaChannel Admission Validation
+//
+// The distributed KafkaChannel falls back to the config-eventing-kafka ConfigMap defaults for any
+// unspecified (zero) NumPartitions / ReplicationFactor, so the KafkaChannel is wrapped here in order
+// to apply those defaults before performing the usual structural validation (they are normally already
+// written onto the spec by the defaulting webhook, but may be absent if it was bypassed).  The topic settings are
+// then validated against the capabilities of the Kafka cluster (the live broker count for Kafka, or
+// the partition limits for Azure EventHubs).  If the cluster cannot be described the capability
+// validation is skipped so that an unreachable cluster does not block all KafkaChannel admissions.
+//
+
+// KafkaChannel Wrapper Providing Distributed KafkaChannel Validation
+type KafkaChannel struct {
+	kafkav1beta1.KafkaChannel
+}
+
+// Validate The KafkaChannel Structure & Against The Broker Capabilities (If A Validator Is In The Context)
+func (c *KafkaChannel) Validate(ctx context.Context) *apis.FieldError {
+
+	// Apply The ConfigMap Topic Defaults To A Copy Of The KafkaChannel
+	validator := getBrokerCapabilityValidator(ctx)
+	channel := c.KafkaChannel.DeepCopy()
+	if validator != nil {
+		validator.applyTopicDefaults(channel)
+	}
+
+	// Perform The Structural Validation (Including The Max Message Bytes, Content Mode & Required Acks Annotations)
+	errs := channel.Validate(ctx).
+		Also(validateMaxMessageBytes(channel, validator.producerMaxMessageBytes())).
+		Also(validateContentMode(channel)).
+		Also(validateExtensions(channel)).
+		Also(validateRequiredAcks(channel, validator.idempotentProducer()))
+	if errs != nil || validator == nil {
+		return errs
+	}
+
+	// Perform The Broker Capability Validation
+	return validator.Validate(ctx, channel)
+}
+
+// Deep Copy The KafkaChannel Wrapper (Required To Decode Admission Requests Into The Wrapper Type)
+func (c *KafkaChannel) DeepCopyObject() runtime.Object {
+	return &KafkaChannel{KafkaChannel: *c.KafkaChannel.DeepCopy()}
+}
+
+// Broker Capability Validator Context Key
+type brokerCapabilityValidatorKey struct{}
+
+// Add The Specified BrokerCapabilityValidator To The Context
+func WithBrokerCapabilityValidator(ctx context.Context, validator *BrokerCapabilityValidator) context.Context {
+	return context.WithValue(ctx, brokerCapabilityValidatorKey{}, validator)
+}
+
+// Get The BrokerCapabilityValidator From The Context (Nil If Not Present)
+func getBrokerCapabilityValidator(ctx context.Context) *BrokerCapabilityValidator {
+	validator, _ := ctx.Value(brokerCapabilityValidatorKey{}).(*BrokerCapabilityValidator)
+	return validator
+}
+
+// Kafka AdminClient Creation Wrapper To Facilitate Unit Testing
+var createAdminClientWrapper = kafkaadmin.CreateAdminClient
+
+// Time Wrapper To Facilitate Unit Testing
+var nowWrapper = time.Now
+
+// BrokerCapabilityValidator Validates KafkaChannel Topic Settings Against The Kafka Cluster (With A Cached Broker Count)
+type BrokerCapabilityValidator struct {
+	logger          *zap.Logger
+	ctx             context.Context
+	saramaConfig    *sarama.Config
+	config          *commonconfig.EventingKafkaConfig
+	adminClientType kafkaadmin.AdminClientType
+	mutex           *sync.Mutex
+	brokerCount     int
+	brokerCountErr  error
+	brokerCountTime time.Time
+}
+
+// Create A New BrokerCapabilityValidator (The Context Must Contain A K8S Client For Creating Kafka AdminClients)
+func NewBrokerCapabilityValidator(ctx context.Context, logger *zap.Logger, saramaConfig *sarama.Config, config *commonconfig.EventingKafkaConfig) *BrokerCapabilityValidator {
+	return &BrokerCapabilityValidator{
+		logger:          logger,
+		ctx:             ctx,
+		saramaConfig:    saramaConfig,
+		config:          config,
+		adminClientType: util.AdminClientType(config, logger),
+		mutex:           &sync.Mutex{},
+	}
+}
+
+// Validate The Specified (Defaulted) KafkaChannel's Topic Settings Against The Kafka Cluster's Capabilities
+func (v *BrokerCapabilityValidator) Validate(ctx context.Context, channel *kafkav1beta1.KafkaChannel) *apis.FieldError {
+
+	// Only Validate Updates Which Change The Topic Settings (Existing Topics Are Not Affected By The Current Brokers)
+	if baseline, ok := apis.GetBaseline(ctx).(*KafkaChannel); ok && baseline != nil {
+		original := baseline.KafkaChannel.DeepCopy()
+		v.applyTopicDefaults(original)
+		if original.Spec.NumPartitions == channel.Spec.NumPartitions && original.Spec.ReplicationFactor == channel.Spec.ReplicationFactor {
+			return nil
+		}
+	}
+
+	// Validate Based On The Kafka AdminClient Type
+	switch v.adminClientType {
+	case kafkaadmin.EventHub:
+		return validateEventHubPartitions(channel.Spec.NumPartitions)
+	case kafkaadmin.Kafka:
+		return v.validateReplicationFactor(channel.Spec.ReplicationFactor)
+	default:
+		return nil
+	}
+}
+
+// Apply The Standard & ConfigMap Topic Defaults To Any Unspecified (Zero) KafkaChannel Topic Settings (As The Defaulting Webhook Would)
+func (v *BrokerCapabilityValidator) applyTopicDefaults(channel *kafkav1beta1.KafkaChannel) {
+	original := channel.DeepCopy()
+	channel.Spec.SetDefaults(v.ctx)
+	applyTopicDefaults(channel, original, v.config, v.logger)
+}
+
+// Get The Receiver's Sarama Producer MaxMessageBytes From The ConfigMap (Zero If Not Available)
+func (v *BrokerCapabilityValidator) producerMaxMessageBytes() int {
+	if v == nil || v.saramaConfig == nil {
+		return 0
+	}
+	return v.saramaConfig.Producer.MaxMessageBytes
+}
+
+// Get Whether The Receiver's Producer Is Idempotent From The ConfigMap (False If Not Available)
+func (v *BrokerCapabilityValidator) idempotentProducer() bool {
+	return v != nil && v.config != nil && v.config.Kafka.EnableIdempotentProducer
+}
+
+//
+// Validate The KafkaChannel's Max Message Bytes Annotation
+//
+// The value must be a positive integer and, since the single Receiver producer rejects any event larger than
+// its configured Sarama Producer.MaxMessageBytes before the Topic's limit applies, it may not exceed that limit
+// (when known) or the Topic would accept events which the Receiver is unable to produce.
+//
+func validateMaxMessageBytes(channel *kafkav1beta1.KafkaChannel, producerMaxMessageBytes int) *apis.FieldError {
+	annotation, ok := channel.Annotations[constants.MaxMessageBytesAnnotation]
+	if !ok {
+		return nil
+	}
+	maxMessageBytes, err := kafkautil.ParseMaxMessageBytes(annotation)
+	if err != nil || maxMessageBytes <= 0 {
+		fe := apis.ErrInvalidValue(annotation, constants.MaxMessageBytesAnnotation)
+		fe.Details = "max message bytes must be a positive integer"
+		return fe.ViaField("metadata", "annotations")
+	}
+	if producerMaxMessageBytes > 0 && int(maxMessageBytes) > producerMaxMessageBytes {
+		fe := apis.ErrInvalidValue(annotation, constants.MaxMessageBytesAnnotation)
+		fe.Details = fmt.Sprintf("max message bytes cannot exceed the receiver's Sarama Producer.MaxMessageBytes (%d)", producerMaxMessageBytes)
+		return fe.ViaField("metadata", "annotations")
+	}
+	return nil
+}
+
+// Validate The KafkaChannel's Content Mode Annotation (If Present) Is A Supported CloudEvent Encoding
+func validateContentMode(channel *kafkav1beta1.KafkaChannel) *apis.FieldError {
+	annotation, ok := channel.Annotations[constants.ContentModeAnnotation]
+	if !ok {
+		return nil
+	}
+	contentMode := strings.ToLower(strings.TrimSpace(annotation))
+	if contentMode != constants.ContentModeBinary && contentMode != constants.ContentModeStructured {
+		fe := apis.ErrInvalidValue(annotation, constants.ContentModeAnnotation)
+		fe.Details = fmt.Sprintf("content mode must be either '%s' or '%s'", constants.ContentModeBinary, constants.ContentModeStructured)
+		return fe.ViaField("metadata", "annotations")
+	}
+	return nil
+}
+
+// Validate The KafkaChannel's CloudEvent Extension Allow / Deny Annotations (If Present) List Valid Extension Names
+func validateExtensions(channel *kafkav1beta1.KafkaChannel) *apis.FieldError {
+	var errs *apis.FieldError
+	if annotation, ok := channel.Annotations[constants.ExtensionsAllowAnnotation]; ok {
+		if _, err := kafkautil.NewExtensionFilter(kafkautil.ParseExtensionNames(annotation), nil); err != nil {
+			fe := apis.ErrInvalidValue(annotation, constants.ExtensionsAllowAnnotation)
+			fe.Details = err.Error()
+			errs = errs.Also(fe.ViaField("metadata", "annotations"))
+		}
+	}
+	if annotation, ok := channel.Annotations[constants.ExtensionsDenyAnnotation]; ok {
+		if _, err := kafkautil.NewExtensionFilter(nil, kafkautil.ParseExtensionNames(annotation)); err != nil {
+			fe := apis.ErrInvalidValue(annotation, constants.ExtensionsDenyAnnotation)
+			fe.Details = err.Error()
+			errs = errs.Also(fe.ViaField("metadata", "annotations"))
+		}
+	}
+	return errs
+}
+
+//
+// Validate The KafkaChannel's Required Acks Annotation
+//
+// The value must be one of the Sarama RequiredAcks names and, since an idempotent Receiver producer requires
+// WaitForAll, weaker acknowledgement is rejected when idempotence is enabled (rather than silently ignored).
+//
+func validateRequiredAcks(channel *kafkav1beta1.KafkaChannel, idempotentProducer bool) *apis.FieldError {
+	annotation, ok := channel.Annotations[constants.RequiredAcksAnnotation]
+	if !ok {
+		return nil
+	}
+	requiredAcks, err := kafkasarama.ParseRequiredAcks(annotation)
+	if err != nil {
+		fe := apis.ErrInvalidValue(annotation, constants.RequiredAcksAnnotation)
+		fe.Details = fmt.Sprintf("required acks must be one of '%s', '%s' or '%s'", kafkasarama.RequiredAcksNoResponse, kafkasarama.RequiredAcksWaitForLocal, kafkasarama.RequiredAcksWaitForAll)
+		return fe.ViaField("metadata", "annotations")
+	}
+	if idempotentProducer && requiredAcks != sarama.WaitForAll {
+		fe := apis.ErrInvalidValue(annotation, constants.RequiredAcksAnnotation)
+		fe.Details = fmt.Sprintf("required acks must be '%s' when the receiver's idempotent producer is enabled", kafkasarama.RequiredAcksWaitForAll)
+		return fe.ViaField("metadata", "annotations")
+	}
+	return nil
+}
+
+// Validate The NumPartitions Against The Azure EventHub Limits
+func validateEventHubPartitions(numPartitions int32) *apis.FieldError {
+	if numPartitions < constants.MinEventHubPartitions || numPartitions > constants.MaxEventHubPartitions {
+		fe := apis.ErrInvalidValue(numPartitions, "numPartitions")
+		fe.Details = fmt.Sprintf("azure eventhubs support between %d and %d partitions", constants.MinEventHubPartitions, constants.MaxEventHubPartitions)
+		return fe.ViaField("spec")
+	}
+	return nil
+}
+
+// Validate The ReplicationFactor Against The Live Kafka Broker Count (Skipped If The Cluster Cannot Be Described)
+func (v *BrokerCapabilityValidator) validateReplicationFactor(replicationFactor int16) *apis.FieldError {
+	brokerCount, err := v.liveBrokerCount()
+	if err != nil {
+		v.logger.Warn("Unable To Determine Live Kafka Broker Count - Skipping ReplicationFactor Validation", zap.Error(err))
+		return nil
+	}
+	if int(replicationFactor) > brokerCount {
+		fe := apis.ErrInvalidValue(replicationFactor, "replicationFactor")
+		fe.Details = fmt.Sprintf("replication factor cannot exceed the number of available Kafka brokers (%d)", brokerCount)
+		return fe.ViaField("spec")
+	}
+	return nil
+}
+
+// Get The Live Kafka Broker Count (Cached, Including Failures, To Avoid Slowing / Overwhelming Admissions)
+func (v *BrokerCapabilityValidator) liveBrokerCount() (int, error) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	// Return The Cached Result If Still Valid
+	now := nowWrapper()
+	if !v.brokerCountTime.IsZero() && now.Sub(v.brokerCountTime) < webhookconstants.BrokerCountCacheDuration {
+		return v.brokerCount, v.brokerCountErr
+	}
+
+	// Describe The Cluster In The Background, Giving Up After The Timeout (Sarama Calls Are Not Context Aware)
+	type describeResult struct {
+		brokerCount int
+		err         error
+	}
+	resultChan := make(chan describeResult, 1)
+	go func() {
+		brokerCount, err := v.describeBrokerCount()
+		resultChan <- describeResult{brokerCount: brokerCount, err: err}
+	}()
+	select {
+	case result := <-resultChan:
+		v.brokerCount, v.brokerCountErr = result.brokerCount, result.err
+	case <-time.After(webhookconstants.DescribeClusterTimeout):
+		v.brokerCount, v.brokerCountErr = 0, fmt.Errorf("timed out describing the Kafka cluster after %v", webhookconstants.DescribeClusterTimeout)
+	}
+	v.brokerCountTime = now
+	return v.brokerCount, v.brokerCountErr
+}
+
+// Create A Kafka AdminClient & Describe The Cluster To Get The Live Broker Count
+func (v *BrokerCapabilityValidator) describeBrokerCount() (int, error) {
+
+	// Create A New Kafka AdminClient
+	adminClient, err := createAdminClientWrapper(v.ctx, v.saramaConfig, webhookconstants.WebhookComponentName, v.adminClientType)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if closeErr := adminClient.Close(); closeErr != nil {
+			v.logger.Warn("Failed To Close Kafka AdminClient", zap.Error(closeErr))
+		}
+	}()
+
+	// Describe The Cluster & Return The Number Of Brokers
+	brokers, err := adminClient.DescribeCluster(v.ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(brokers), nil
+}
@@ -0,0 +1,344 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	kafkaadmin "knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/admin"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/constants"
+	controllertesting "knative.dev/eventing-kafka/pkg/channel/distributed/controller/testing"
+	"knative.dev/pkg/apis"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+// Test Data
+const (
+	defaultNumPartitions     = 4
+	defaultReplicationFactor = 1
+)
+
+// Test The KafkaChannel Validate() Functionality
+func TestKafkaChannelValidate(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name              string
+		adminType         string
+		numPartitions     int32
+		replicationFactor int16
+		brokerCount       int
+		describeErr       error
+		noValidator       bool
+		expectErr         bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Kafka Defaults", adminType: "kafka", brokerCount: 3},
+		{name: "Kafka Valid ReplicationFactor", adminType: "kafka", numPartitions: 8, replicationFactor: 3, brokerCount: 3},
+		{name: "Kafka ReplicationFactor Exceeds Brokers", adminType: "kafka", numPartitions: 8, replicationFactor: 4, brokerCount: 3, expectErr: true},
+		{name: "Kafka Negative NumPartitions", adminType: "kafka", numPartitions: -1, replicationFactor: 1, brokerCount: 3, expectErr: true},
+		{name: "Kafka Cluster Unreachable", adminType: "kafka", numPartitions: 8, replicationFactor: 4, describeErr: errors.New("unreachable")},
+		{name: "EventHub Valid NumPartitions", adminType: "azure", numPartitions: 32, replicationFactor: 5},
+		{name: "EventHub Too Many Partitions", adminType: "azure", numPartitions: 33, replicationFactor: 1, expectErr: true},
+		{name: "Custom Structural Only", adminType: "custom", numPartitions: 100, replicationFactor: 100},
+		{name: "No Validator Structural Only", noValidator: true, numPartitions: 8, replicationFactor: 4},
+		{name: "No Validator Unspecified NumPartitions", noValidator: true, replicationFactor: 1, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			stubCreateAdminClientWrapper(t, testCase.brokerCount, testCase.describeErr)
+			defer restoreCreateAdminClientWrapper()
+
+			ctx := context.TODO()
+			if !testCase.noValidator {
+				ctx = WithBrokerCapabilityValidator(ctx, newTestValidator(t, testCase.adminType))
+			}
+
+			channel := newTestKafkaChannel(testCase.numPartitions, testCase.replicationFactor)
+			errs := channel.Validate(ctx)
+			assert.Equal(t, testCase.expectErr, errs != nil, errs.Error())
+		})
+	}
+}
+
+// Test The KafkaChannel Validate() Functionality For Updates Which Do Not Change The Topic Settings
+func TestKafkaChannelValidateUpdate(t *testing.T) {
+
+	stubCreateAdminClientWrapper(t, 1, nil)
+	defer restoreCreateAdminClientWrapper()
+
+	ctx := WithBrokerCapabilityValidator(context.TODO(), newTestValidator(t, "kafka"))
+
+	// An Unchanged ReplicationFactor Exceeding The Current Brokers Is Allowed
+	original := newTestKafkaChannel(0, 3)
+	updated := newTestKafkaChannel(defaultNumPartitions, 3)
+	assert.Nil(t, updated.Validate(apis.WithinUpdate(ctx, original)))
+
+	// A Changed ReplicationFactor Exceeding The Current Brokers Is Rejected
+	updated = newTestKafkaChannel(defaultNumPartitions, 2)
+	assert.NotNil(t, updated.Validate(apis.WithinUpdate(ctx, original)))
+}
+
+// Test The KafkaChannel Validate() Functionality For The Max Message Bytes Annotation
+func TestKafkaChannelValidateMaxMessageBytes(t *testing.T) {
+
+	stubCreateAdminClientWrapper(t, 3, nil)
+	defer restoreCreateAdminClientWrapper()
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		annotation  string
+		noValidator bool
+		expectErr   bool
+	}
+
+	// Create The TestCases (The Test Validator Uses The Sarama Default Producer.MaxMessageBytes Of 1000000)
+	testCases := []TestCase{
+		{name: "Valid", annotation: "500000"},
+		{name: "Equal To Producer Limit", annotation: "1000000"},
+		{name: "Exceeds Producer Limit", annotation: "2000000", expectErr: true},
+		{name: "No Validator Exceeds Producer Limit", annotation: "2000000", noValidator: true},
+		{name: "Empty", annotation: "", expectErr: true},
+		{name: "Zero", annotation: "0", expectErr: true},
+		{name: "Negative", annotation: "-1", expectErr: true},
+		{name: "Not A Number", annotation: "1MB", expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.TODO()
+			if !testCase.noValidator {
+				ctx = WithBrokerCapabilityValidator(ctx, newTestValidator(t, "kafka"))
+			}
+			channel := newTestKafkaChannel(defaultNumPartitions, defaultReplicationFactor)
+			channel.Annotations = map[string]string{constants.MaxMessageBytesAnnotation: testCase.annotation}
+			errs := channel.Validate(ctx)
+			assert.Equal(t, testCase.expectErr, errs != nil, errs.Error())
+		})
+	}
+}
+
+// Test The KafkaChannel Content Mode Annotation Validation
+func TestKafkaChannelValidateContentMode(t *testing.T) {
+	for annotation, expectErr := range map[string]bool{"binary": false, "structured": false, " Structured ": false, "": true, "json": true} {
+		t.Run(annotation, func(t *testing.T) {
+			channel := newTestKafkaChannel(defaultNumPartitions, defaultReplicationFactor)
+			channel.Annotations = map[string]string{constants.ContentModeAnnotation: annotation}
+			errs := channel.Validate(context.TODO())
+			assert.Equal(t, expectErr, errs != nil, errs.Error())
+		})
+	}
+}
+
+// Test The KafkaChannel Extension Allow / Deny Annotation Validation
+func TestKafkaChannelValidateExtensions(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name        string
+		annotations map[string]string
+		expectErr   bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "Allow List", annotations: map[string]string{constants.ExtensionsAllowAnnotation: "traceparent, PartitionKey"}},
+		{name: "Deny List", annotations: map[string]string{constants.ExtensionsDenyAnnotation: "internal"}},
+		{name: "Empty Lists", annotations: map[string]string{constants.ExtensionsAllowAnnotation: "", constants.ExtensionsDenyAnnotation: ""}},
+		{name: "Allow Context Attribute", annotations: map[string]string{constants.ExtensionsAllowAnnotation: "subject,traceparent"}},
+		{name: "Deny Context Attribute", annotations: map[string]string{constants.ExtensionsDenyAnnotation: "internal,type"}, expectErr: true},
+		{name: "Invalid Allow Name", annotations: map[string]string{constants.ExtensionsAllowAnnotation: "trace-parent"}, expectErr: true},
+		{name: "Invalid Deny Name", annotations: map[string]string{constants.ExtensionsDenyAnnotation: "in_ternal"}, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			channel := newTestKafkaChannel(defaultNumPartitions, defaultReplicationFactor)
+			channel.Annotations = testCase.annotations
+			errs := channel.Validate(context.TODO())
+			assert.Equal(t, testCase.expectErr, errs != nil, errs.Error())
+		})
+	}
+}
+
+// Test The Validation Of The Required Acks Annotation (With & Without The Idempotent Producer)
+func TestKafkaChannelValidateRequiredAcks(t *testing.T) {
+
+	// Define The TestCase Struct
+	type TestCase struct {
+		name       string
+		annotation string
+		idempotent bool
+		expectErr  bool
+	}
+
+	// Create The TestCases
+	testCases := []TestCase{
+		{name: "NoResponse", annotation: "NoResponse"},
+		{name: "WaitForLocal", annotation: "WaitForLocal"},
+		{name: "WaitForAll", annotation: "WaitForAll"},
+		{name: "Case Insensitive", annotation: " waitforall "},
+		{name: "Numeric", annotation: "1", expectErr: true},
+		{name: "Empty", annotation: "", expectErr: true},
+		{name: "Idempotent WaitForAll", annotation: "WaitForAll", idempotent: true},
+		{name: "Idempotent WaitForLocal", annotation: "WaitForLocal", idempotent: true, expectErr: true},
+		{name: "Idempotent NoResponse", annotation: "NoResponse", idempotent: true, expectErr: true},
+	}
+
+	// Run The TestCases
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			validator := newTestValidator(t, "custom")
+			validator.config.Kafka.EnableIdempotentProducer = testCase.idempotent
+			ctx := WithBrokerCapabilityValidator(context.TODO(), validator)
+			channel := newTestKafkaChannel(defaultNumPartitions, defaultReplicationFactor)
+			channel.Annotations = map[string]string{constants.RequiredAcksAnnotation: testCase.annotation}
+			errs := channel.Validate(ctx)
+			assert.Equal(t, testCase.expectErr, errs != nil, errs.Error())
+		})
+	}
+}
+
+// Test The BrokerCapabilityValidator Caches The Live Broker Count
+func TestBrokerCapabilityValidatorCache(t *testing.T) {
+
+	createCount := stubCreateAdminClientWrapper(t, 3, nil)
+	defer restoreCreateAdminClientWrapper()
+
+	// Control The Current Time
+	now := time.Now()
+	nowWrapper = func() time.Time { return now }
+	defer func() { nowWrapper = time.Now }()
+
+	validator := newTestValidator(t, "kafka")
+
+	// Verify The Broker Count Is Only Described Once Within The Cache Duration
+	for i := 0; i < 3; i++ {
+		brokerCount, err := validator.liveBrokerCount()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, brokerCount)
+	}
+	assert.Equal(t, 1, *createCount)
+
+	// Verify The Broker Count Is Described Again Once The Cache Expires
+	now = now.Add(time.Hour)
+	brokerCount, err := validator.liveBrokerCount()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, brokerCount)
+	assert.Equal(t, 2, *createCount)
+}
+
+// Test The BrokerCapabilityValidator Handles AdminClient Creation Failures
+func TestBrokerCapabilityValidatorCreateAdminClientError(t *testing.T) {
+	createAdminClientWrapper = func(context.Context, *sarama.Config, string, kafkaadmin.AdminClientType) (kafkaadmin.AdminClientInterface, error) {
+		return nil, errors.New("test create error")
+	}
+	defer restoreCreateAdminClientWrapper()
+
+	validator := newTestValidator(t, "kafka")
+	brokerCount, err := validator.liveBrokerCount()
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, brokerCount)
+	assert.Nil(t, validator.Validate(context.TODO(), &newTestKafkaChannel(1, 3).KafkaChannel))
+}
+
+// Test The KafkaChannel Wrapper Decodes Admission Requests Strictly & Deep Copies Correctly
+func TestKafkaChannelDecodeAndDeepCopy(t *testing.T) {
+
+	// Decode A KafkaChannel Into The Wrapper (As The Admission Controller Does)
+	channelJson := `{"apiVersion":"messaging.knative.dev/v1beta1","kind":"KafkaChannel","metadata":{"name":"TestChannelName"},"spec":{"numPartitions":8,"replicationFactor":3}}`
+	decoder := json.NewDecoder(bytes.NewBufferString(channelJson))
+	decoder.DisallowUnknownFields()
+	channel := &KafkaChannel{}
+	assert.Nil(t, decoder.Decode(channel))
+	assert.Equal(t, "TestChannelName", channel.Name)
+	assert.Equal(t, int32(8), channel.Spec.NumPartitions)
+	assert.Equal(t, int16(3), channel.Spec.ReplicationFactor)
+
+	// Verify The DeepCopyObject() Returns An Equal Wrapper
+	channelCopy, ok := channel.DeepCopyObject().(*KafkaChannel)
+	assert.True(t, ok)
+	assert.Equal(t, channel, channelCopy)
+	assert.NotSame(t, channel, channelCopy)
+}
+
+//
+// Utilities
+//
+
+// Create A New Test BrokerCapabilityValidator For The Specified AdminType
+func newTestValidator(t *testing.T, adminType string) *BrokerCapabilityValidator {
+	configuration := &commonconfig.EventingKafkaConfig{
+		Kafka: commonconfig.EKKafkaConfig{
+			AdminType: adminType,
+			Topic: commonconfig.EKKafkaTopicConfig{
+				DefaultNumPartitions:     defaultNumPartitions,
+				DefaultReplicationFactor: defaultReplicationFactor,
+			},
+		},
+	}
+	return NewBrokerCapabilityValidator(context.TODO(), logtesting.TestLogger(t).Desugar(), sarama.NewConfig(), configuration)
+}
+
+// Create A New Test KafkaChannel Wrapper With The Specified Topic Settings
+func newTestKafkaChannel(numPartitions int32, replicationFactor int16) *KafkaChannel {
+	channel := &KafkaChannel{}
+	channel.Name = "TestChannelName"
+	channel.Namespace = "TestChannelNamespace"
+	channel.Spec = kafkav1beta1.KafkaChannelSpec{NumPartitions: numPartitions, ReplicationFactor: replicationFactor}
+	return channel
+}
+
+// Replace The createAdminClientWrapper With One Returning A Mock AdminClient & Counting Creations
+func stubCreateAdminClientWrapper(t *testing.T, brokerCount int, describeErr error) *int {
+	createCount := 0
+	createAdminClientWrapper = func(_ context.Context, _ *sarama.Config, clientId string, _ kafkaadmin.AdminClientType) (kafkaadmin.AdminClientInterface, error) {
+		assert.NotEmpty(t, clientId)
+		createCount++
+		return &controllertesting.MockAdminClient{
+			MockDescribeClusterFunc: func(context.Context) ([]*sarama.Broker, error) {
+				if describeErr != nil {
+					return nil, describeErr
+				}
+				return make([]*sarama.Broker, brokerCount), nil
+			},
+		}, nil
+	}
+	return &createCount
+}
+
+// Restore The Original createAdminClientWrapper
+func restoreCreateAdminClientWrapper() {
+	createAdminClientWrapper = kafkaadmin.CreateAdminClient
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkachannel
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kafkav1beta1 "knative.dev/eventing-kafka/pkg/apis/messaging/v1beta1"
+	commonconfig "knative.dev/eventing-kafka/pkg/channel/distributed/common/config"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/common/kafka/sarama"
+	"knative.dev/eventing-kafka/pkg/channel/distributed/webhook/constants"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection/sharedmain"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/signals"
+	"knative.dev/pkg/webhook"
+	"knative.dev/pkg/webhook/certificates"
+	"knative.dev/pkg/webhook/resourcesemantics"
+	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
+	"knative.dev/pkg/webhook/resourcesemantics/validation"
+)
+
+// The Resources Defaulted & Validated By The Webhook (Only v1beta1 Is Served By The Distributed KafkaChannel CRD)
+var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
+	kafkav1beta1.SchemeGroupVersion.WithKind("KafkaChannel"): &KafkaChannel{},
+}
+
+// Run The Distributed KafkaChannel Webhook With The Specified Options (Blocking)
+func Main(component string, options webhook.Options) {
+	ctx := webhook.WithOptions(signals.NewContext(), options)
+	sharedmain.MainWithContext(ctx, component,
+		certificates.NewController,
+		NewDefaultingAdmissionController,
+		NewValidationAdmissionController,
+	)
+}
+
+// Create A New KafkaChannel Defaulting Admission Controller
+func NewDefaultingAdmissionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+
+	// Get A Logger
+	logger := logging.FromContext(ctx).Desugar()
+
+	// Load The Eventing-Kafka Settings (Providing The Topic Defaults) From The ConfigMap
+	_, configuration, err := sarama.LoadSettings(ctx)
+	if err != nil {
+		logger.Fatal("Failed To Load Eventing-Kafka Settings", zap.Error(err))
+	}
+
+	// Watch The ConfigMap So That Changes To The Topic Defaults Apply Without Restarting The Webhook
+	store := newTopicDefaultsConfigStore(logger, configuration)
+	cmw.Watch(commonconfig.SettingsConfigMapName, store.configMapObserver)
+
+	// Create The Defaulting Admission Controller
+	return defaulting.NewAdmissionController(ctx,
+
+		// Name of the resource webhook.
+		constants.DefaultingWebhookName,
+
+		// The path on which to serve the webhook.
+		constants.DefaultingWebhookPath,
+
+		// The resources to default.
+		types,
+
+		// A function that infuses the context passed to SetDefaults with the EventingKafkaConfig.
+		func(ctx context.Context) context.Context {
+			return WithTopicDefaultsConfig(ctx, store.Load())
+		},
+
+		// Whether to disallow unknown fields.
+		true,
+	)
+}
+
+// Create A New KafkaChannel Validation Admission Controller
+func NewValidationAdmissionController(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+
+	// Get A Logger
+	logger := logging.FromContext(ctx).Desugar()
+
+	// Load The Sarama & Eventing-Kafka Settings From The ConfigMap
+	saramaConfig, configuration, err := sarama.LoadSettings(ctx)
+	if err != nil {
+		logger.Fatal("Failed To Load Eventing-Kafka Settings", zap.Error(err))
+	}
+
+	// Create The BrokerCapabilityValidator To Be Made Available To The KafkaChannel Validation
+	validator := NewBrokerCapabilityValidator(ctx, logger, saramaConfig, configuration)
+
+	// Create The Validation Admission Controller
+	return validation.NewAdmissionController(ctx,
+
+		// Name of the resource webhook.
+		constants.ValidationWebhookName,
+
+		// The path on which to serve the webhook.
+		constants.ValidationWebhookPath,
+
+		// The resources to validate.
+		types,
+
+		// A function that infuses the context passed to Validate with the BrokerCapabilityValidator.
+		func(ctx context.Context) context.Context {
+			return WithBrokerCapabilityValidator(ctx, validator)
+		},
+
+		// Whether to disallow unknown fields.
+		true,
+	)
+}
@@ -21,6 +21,7 @@ const (
 	// KafkaChannel Spec Defaults
 	DefaultNumPartitions     = 1
 	DefaultReplicationFactor = 1
+	DefaultCleanupPolicy     = "delete"
 
 	// Knative Eventing Namespace
 	KnativeEventingNamespace = "knative-eventing"
@@ -18,9 +18,12 @@ package tracing
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/Shopify/sarama"
 	protocolkafka "github.com/cloudevents/sdk-go/protocol/kafka_sarama/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/extensions"
 	"go.opencensus.io/plugin/ochttp/propagation/tracecontext"
 	"go.opencensus.io/trace"
 	"go.uber.org/zap"
@@ -29,10 +32,33 @@ import (
 const (
 	traceParentHeader = "traceparent"
 	traceStateHeader  = "tracestate"
+
+	// The CloudEvents distributed tracing extension attributes, as headers of binary mode Kafka messages
+	ceTraceParentHeader = "ce_" + extensions.TraceParentExtension
+	ceTraceStateHeader  = "ce_" + extensions.TraceStateExtension
 )
 
 var format = &tracecontext.HTTPFormat{}
 
+// enabled is non-zero while tracing is enabled (the default, so that components which do not
+// watch the tracing ConfigMap are unaffected).
+var enabled int32 = 1
+
+// SetEnabled enables or disables trace propagation through the channel (e.g. based on whether the
+// tracing ConfigMap specifies a backend), so that it adds no overhead while tracing is disabled.
+func SetEnabled(value bool) {
+	if value {
+		atomic.StoreInt32(&enabled, 1)
+	} else {
+		atomic.StoreInt32(&enabled, 0)
+	}
+}
+
+// Enabled returns whether trace propagation through the channel is currently enabled.
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
 // SerializeTrace returns the traceparent and tracestate values from a span context as a slice
 // of sarama.RecordHeader structs that can be appended to an existing sarama.ProducerMessage
 func SerializeTrace(spanContext trace.SpanContext) []sarama.RecordHeader {
@@ -54,6 +80,19 @@ func SerializeTrace(spanContext trace.SpanContext) []sarama.RecordHeader {
 	}}
 }
 
+// DistributedTracingExtensionTransformer returns a binding.Transformer which sets the CloudEvents distributed
+// tracing extension attributes (traceparent and tracestate) from a span context, unless the message already
+// has them, so that the trace survives regardless of the content mode in which the message is delivered.
+func DistributedTracingExtensionTransformer(spanContext trace.SpanContext) binding.TransformerFunc {
+	return func(reader binding.MessageMetadataReader, writer binding.MessageMetadataWriter) error {
+		if traceParent := reader.GetExtension(extensions.TraceParentExtension); traceParent != nil && traceParent != "" {
+			return nil // Event messages return an empty string for missing extensions
+		}
+		extension := extensions.FromSpanContext(spanContext)
+		return extension.WriteTransformer()(reader, writer)
+	}
+}
+
 // StartTraceFromMessage extracts the headers from a message (traceparent and tracestate) and
 // uses them to start a span, which can be used with whatever tracing backend is set up (e.g. Zipkin)
 // in order to trace the flow of a message.  Multiple spans may be part of a single trace, for
@@ -71,16 +110,25 @@ func StartTraceFromMessage(logger *zap.SugaredLogger, inCtx context.Context, mes
 
 // ParseSpanContext takes the "traceparent" and "tracestate" headers and regenerates the
 // trace span context from them.  This context can then be used to start a new span
-// that uses the same trace ID as a different (but related) span.
+// that uses the same trace ID as a different (but related) span.  If the headers are not
+// present, the CloudEvents distributed tracing extension attributes are used instead.
 func ParseSpanContext(headers map[string][]byte) (sc trace.SpanContext, ok bool) {
-	traceParentBytes, ok := headers[traceParentHeader]
+	if sc, ok = parseSpanContext(headers, traceParentHeader, traceStateHeader); ok {
+		return sc, ok
+	}
+	return parseSpanContext(headers, ceTraceParentHeader, ceTraceStateHeader)
+}
+
+// parseSpanContext regenerates the trace span context from the specified traceparent and tracestate headers.
+func parseSpanContext(headers map[string][]byte, traceParentKey string, traceStateKey string) (sc trace.SpanContext, ok bool) {
+	traceParentBytes, ok := headers[traceParentKey]
 	if !ok {
 		return trace.SpanContext{}, false
 	}
 	traceParent := string(traceParentBytes)
 
 	traceState := ""
-	if traceStateBytes, ok := headers[traceStateHeader]; ok {
+	if traceStateBytes, ok := headers[traceStateKey]; ok {
 		traceState = string(traceStateBytes)
 	}
 
@@ -20,7 +20,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/Shopify/sarama"
 	protocolkafka "github.com/cloudevents/sdk-go/protocol/kafka_sarama/v2"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/extensions"
 	logtesting "knative.dev/pkg/logging/testing"
 
 	"github.com/stretchr/testify/require"
@@ -102,3 +106,72 @@ func TestStartTraceFromMessage(t *testing.T) {
 	require.NotNil(t, ctx)
 	require.NotNil(t, span)
 }
+
+// Verify that tracing can be disabled and re-enabled (and is enabled by default).
+func TestEnabled(t *testing.T) {
+	require.True(t, Enabled())
+	SetEnabled(false)
+	require.False(t, Enabled())
+	SetEnabled(true)
+	require.True(t, Enabled())
+}
+
+// Verify that the span context is parsed from the CloudEvents distributed tracing extension
+// headers if the "traceparent" header is not present (and that the latter takes precedence).
+func TestParseSpanContextFromExtension(t *testing.T) {
+	extension := extensions.FromSpanContext(sampleSpanContext)
+	headers := map[string][]byte{
+		ceTraceParentHeader: []byte(extension.TraceParent),
+		ceTraceStateHeader:  []byte(extension.TraceState),
+	}
+
+	outSpanContext, ok := ParseSpanContext(headers)
+	require.True(t, ok)
+	require.Equal(t, sampleSpanContext, outSpanContext)
+
+	_, span := trace.StartSpan(context.TODO(), "aaa")
+	for _, h := range SerializeTrace(span.SpanContext()) {
+		headers[string(h.Key)] = h.Value
+	}
+	outSpanContext, ok = ParseSpanContext(headers)
+	require.True(t, ok)
+	require.Equal(t, span.SpanContext(), outSpanContext)
+
+	_, ok = ParseSpanContext(map[string][]byte{})
+	require.False(t, ok)
+}
+
+// Verify that the DistributedTracingExtensionTransformer adds the distributed tracing extension
+// attributes to a message which does not have them, and preserves those of one which does.
+func TestDistributedTracingExtensionTransformer(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("id")
+	event.SetSource("source")
+	event.SetType("type")
+
+	// Translate the (binary mode) Kafka message headers back into a map
+	writeHeaders := func(event cloudevents.Event) map[string][]byte {
+		event = event.Clone() // The transformers modify event messages in place
+		producerMessage := &sarama.ProducerMessage{}
+		err := protocolkafka.WriteProducerMessage(context.TODO(), binding.ToMessage(&event), producerMessage, DistributedTracingExtensionTransformer(sampleSpanContext))
+		require.NoError(t, err)
+		headers := make(map[string][]byte)
+		for _, h := range producerMessage.Headers {
+			headers[string(h.Key)] = h.Value
+		}
+		return headers
+	}
+
+	expectedExtension := extensions.FromSpanContext(sampleSpanContext)
+	headers := writeHeaders(event)
+	require.Equal(t, expectedExtension.TraceParent, string(headers[ceTraceParentHeader]))
+	require.Equal(t, expectedExtension.TraceState, string(headers[ceTraceStateHeader]))
+
+	_, span := trace.StartSpan(context.TODO(), "aaa")
+	existingExtension := extensions.FromSpanContext(span.SpanContext())
+	existingExtension.AddTracingAttributes(&event)
+	headers = writeHeaders(event)
+	require.Equal(t, existingExtension.TraceParent, string(headers[ceTraceParentHeader]))
+	_, ok := headers[ceTraceStateHeader]
+	require.False(t, ok)
+}